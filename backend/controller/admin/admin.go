@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sync"
 
 	"connectrpc.com/connect"
 
@@ -13,16 +14,30 @@ import (
 )
 
 type AdminService struct {
-	cm *cf.Manager[cf.Configuration]
-	sm *cf.Manager[cf.Secrets]
+	cm        *cf.Manager[cf.Configuration]
+	sm        *cf.Manager[cf.Secrets]
+	resources ResourceStore
+	// moduleScope is nil if requests can't be scoped to projects.
+	moduleScope ModuleScope
+
+	// Serialises changes to config and secret resources, so that their
+	// versions are checked atomically with respect to this service.
+	lock sync.Mutex
 }
 
 var _ ftlv1connect.AdminServiceHandler = (*AdminService)(nil)
 
-func NewAdminService(cm *cf.Manager[cf.Configuration], sm *cf.Manager[cf.Secrets]) *AdminService {
+// NewAdminService creates an AdminService.
+//
+// "resources" stores projects, quotas and ingress domains, and is nil if they
+// can't be managed, eg. without a controller. "moduleScope" limits requests
+// scoped to a project to the config and secrets of that project's modules.
+func NewAdminService(cm *cf.Manager[cf.Configuration], sm *cf.Manager[cf.Secrets], resources ResourceStore, moduleScope ModuleScope) *AdminService {
 	return &AdminService{
-		cm: cm,
-		sm: sm,
+		cm:          cm,
+		sm:          sm,
+		resources:   resources,
+		moduleScope: moduleScope,
 	}
 }
 
@@ -36,6 +51,10 @@ func (s *AdminService) ConfigList(ctx context.Context, req *connect.Request[ftlv
 	if err != nil {
 		return nil, err
 	}
+	inScope, err := s.refScope(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	configs := []*ftlv1.ListConfigResponse_Config{}
 	for _, config := range listing {
@@ -43,6 +62,9 @@ func (s *AdminService) ConfigList(ctx context.Context, req *connect.Request[ftlv
 		if *req.Msg.Module != "" && module != *req.Msg.Module {
 			continue
 		}
+		if !inScope(config.Ref) {
+			continue
+		}
 
 		ref := config.Name
 		if ok {
@@ -72,8 +94,12 @@ func (s *AdminService) ConfigList(ctx context.Context, req *connect.Request[ftlv
 
 // ConfigGet returns the configuration value for a given ref string.
 func (s *AdminService) ConfigGet(ctx context.Context, req *connect.Request[ftlv1.GetConfigRequest]) (*connect.Response[ftlv1.GetConfigResponse], error) {
+	ref := cf.NewRef(*req.Msg.Ref.Module, req.Msg.Ref.Name)
+	if err := s.checkRefScope(ctx, ref, false); err != nil {
+		return nil, err
+	}
 	var value any
-	err := s.cm.Get(ctx, cf.NewRef(*req.Msg.Ref.Module, req.Msg.Ref.Name), &value)
+	err := s.cm.Get(ctx, ref, &value)
 	if err != nil {
 		return nil, err
 	}
@@ -101,8 +127,12 @@ func configProviderKey(p *ftlv1.ConfigProvider) string {
 
 // ConfigSet sets the configuration at the given ref to the provided value.
 func (s *AdminService) ConfigSet(ctx context.Context, req *connect.Request[ftlv1.SetConfigRequest]) (*connect.Response[ftlv1.SetConfigResponse], error) {
+	ref := cf.NewRef(*req.Msg.Ref.Module, req.Msg.Ref.Name)
+	if err := s.checkRefScope(ctx, ref, true); err != nil {
+		return nil, err
+	}
 	pkey := configProviderKey(req.Msg.Provider)
-	err := s.cm.SetJSON(ctx, pkey, cf.NewRef(*req.Msg.Ref.Module, req.Msg.Ref.Name), req.Msg.Value)
+	err := s.cm.SetJSON(ctx, pkey, ref, req.Msg.Value)
 	if err != nil {
 		return nil, err
 	}
@@ -111,8 +141,12 @@ func (s *AdminService) ConfigSet(ctx context.Context, req *connect.Request[ftlv1
 
 // ConfigUnset unsets the config value at the given ref.
 func (s *AdminService) ConfigUnset(ctx context.Context, req *connect.Request[ftlv1.UnsetConfigRequest]) (*connect.Response[ftlv1.UnsetConfigResponse], error) {
+	ref := cf.NewRef(*req.Msg.Ref.Module, req.Msg.Ref.Name)
+	if err := s.checkRefScope(ctx, ref, true); err != nil {
+		return nil, err
+	}
 	pkey := configProviderKey(req.Msg.Provider)
-	err := s.cm.Unset(ctx, pkey, cf.NewRef(*req.Msg.Ref.Module, req.Msg.Ref.Name))
+	err := s.cm.Unset(ctx, pkey, ref)
 	if err != nil {
 		return nil, err
 	}
@@ -125,12 +159,19 @@ func (s *AdminService) SecretsList(ctx context.Context, req *connect.Request[ftl
 	if err != nil {
 		return nil, err
 	}
+	inScope, err := s.refScope(ctx)
+	if err != nil {
+		return nil, err
+	}
 	secrets := []*ftlv1.ListSecretsResponse_Secret{}
 	for _, secret := range listing {
 		module, ok := secret.Module.Get()
 		if *req.Msg.Module != "" && module != *req.Msg.Module {
 			continue
 		}
+		if !inScope(secret.Ref) {
+			continue
+		}
 		ref := secret.Name
 		if ok {
 			ref = fmt.Sprintf("%s.%s", module, secret.Name)
@@ -157,8 +198,12 @@ func (s *AdminService) SecretsList(ctx context.Context, req *connect.Request[ftl
 
 // SecretGet returns the secret value for a given ref string.
 func (s *AdminService) SecretGet(ctx context.Context, req *connect.Request[ftlv1.GetSecretRequest]) (*connect.Response[ftlv1.GetSecretResponse], error) {
+	ref := cf.NewRef(*req.Msg.Ref.Module, req.Msg.Ref.Name)
+	if err := s.checkRefScope(ctx, ref, false); err != nil {
+		return nil, err
+	}
 	var value any
-	err := s.sm.Get(ctx, cf.NewRef(*req.Msg.Ref.Module, req.Msg.Ref.Name), &value)
+	err := s.sm.Get(ctx, ref, &value)
 	if err != nil {
 		return nil, err
 	}
@@ -190,8 +235,12 @@ func secretProviderKey(p *ftlv1.SecretProvider) string {
 
 // SecretSet sets the secret at the given ref to the provided value.
 func (s *AdminService) SecretSet(ctx context.Context, req *connect.Request[ftlv1.SetSecretRequest]) (*connect.Response[ftlv1.SetSecretResponse], error) {
+	ref := cf.NewRef(*req.Msg.Ref.Module, req.Msg.Ref.Name)
+	if err := s.checkRefScope(ctx, ref, true); err != nil {
+		return nil, err
+	}
 	pkey := secretProviderKey(req.Msg.Provider)
-	err := s.sm.SetJSON(ctx, pkey, cf.NewRef(*req.Msg.Ref.Module, req.Msg.Ref.Name), req.Msg.Value)
+	err := s.sm.SetJSON(ctx, pkey, ref, req.Msg.Value)
 	if err != nil {
 		return nil, err
 	}
@@ -200,8 +249,12 @@ func (s *AdminService) SecretSet(ctx context.Context, req *connect.Request[ftlv1
 
 // SecretUnset unsets the secret value at the given ref.
 func (s *AdminService) SecretUnset(ctx context.Context, req *connect.Request[ftlv1.UnsetSecretRequest]) (*connect.Response[ftlv1.UnsetSecretResponse], error) {
+	ref := cf.NewRef(*req.Msg.Ref.Module, req.Msg.Ref.Name)
+	if err := s.checkRefScope(ctx, ref, true); err != nil {
+		return nil, err
+	}
 	pkey := secretProviderKey(req.Msg.Provider)
-	err := s.sm.Unset(ctx, pkey, cf.NewRef(*req.Msg.Ref.Module, req.Msg.Ref.Name))
+	err := s.sm.Unset(ctx, pkey, ref)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,590 @@
+package admin
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/types/optional"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	"github.com/TBD54566975/ftl/backend/controller/quotas"
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	cf "github.com/TBD54566975/ftl/common/configuration"
+	"github.com/TBD54566975/ftl/db/dalerrs"
+	"github.com/TBD54566975/ftl/internal/rpc"
+)
+
+// ResourceStore stores the resources managed with the admin resource API
+// other than configuration and secrets.
+type ResourceStore interface {
+	ListAdminResources(ctx context.Context, kind dal.AdminResourceKind) ([]dal.AdminResource, error)
+	GetAdminResource(ctx context.Context, kind dal.AdminResourceKind, name string) (dal.AdminResource, error)
+	CreateAdminResource(ctx context.Context, kind dal.AdminResourceKind, name string, spec []byte) (dal.AdminResource, error)
+	UpdateAdminResource(ctx context.Context, kind dal.AdminResourceKind, name string, spec []byte, version optional.Option[int64]) (dal.AdminResource, error)
+	DeleteAdminResource(ctx context.Context, kind dal.AdminResourceKind, name string, version optional.Option[int64]) error
+}
+
+var storedResourceKinds = map[ftlv1.AdminResourceKind]dal.AdminResourceKind{
+	ftlv1.AdminResourceKind_ADMIN_RESOURCE_KIND_PROJECT:        dal.AdminResourceProject,
+	ftlv1.AdminResourceKind_ADMIN_RESOURCE_KIND_QUOTA:          dal.AdminResourceQuota,
+	ftlv1.AdminResourceKind_ADMIN_RESOURCE_KIND_INGRESS_DOMAIN: dal.AdminResourceIngressDomain,
+}
+
+var (
+	projectNameRe   = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+	ingressDomainRe = regexp.MustCompile(`^(\*\.)?([a-z0-9]([a-z0-9-]*[a-z0-9])?\.)*[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+)
+
+// ListAdminResources lists the resources of a kind, ordered by name.
+func (s *AdminService) ListAdminResources(ctx context.Context, req *connect.Request[ftlv1.ListAdminResourcesRequest]) (*connect.Response[ftlv1.ListAdminResourcesResponse], error) {
+	var resources []*ftlv1.AdminResource
+	switch req.Msg.Kind {
+	case ftlv1.AdminResourceKind_ADMIN_RESOURCE_KIND_CONFIG:
+		entries, err := s.cm.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		inScope, err := s.refScope(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if !inScope(entry.Ref) {
+				continue
+			}
+			resource, err := s.configResource(ctx, entry)
+			if err != nil {
+				return nil, err
+			}
+			resources = append(resources, resource)
+		}
+
+	case ftlv1.AdminResourceKind_ADMIN_RESOURCE_KIND_SECRET_REF:
+		entries, err := s.sm.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		inScope, err := s.refScope(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if inScope(entry.Ref) {
+				resources = append(resources, secretRefResource(entry))
+			}
+		}
+
+	default:
+		kind, err := s.storedResourceKind(req.Msg.Kind)
+		if err != nil {
+			return nil, err
+		}
+		stored, err := s.resources.ListAdminResources(ctx, kind)
+		if err != nil {
+			return nil, resourceError(err)
+		}
+		for _, resource := range stored {
+			out, err := storedResourceToProto(resource)
+			if err != nil {
+				return nil, err
+			}
+			resources = append(resources, out)
+		}
+	}
+	return connect.NewResponse(&ftlv1.ListAdminResourcesResponse{Resources: resources}), nil
+}
+
+// GetAdminResource returns a resource.
+func (s *AdminService) GetAdminResource(ctx context.Context, req *connect.Request[ftlv1.GetAdminResourceRequest]) (*connect.Response[ftlv1.GetAdminResourceResponse], error) {
+	var resource *ftlv1.AdminResource
+	switch req.Msg.Kind {
+	case ftlv1.AdminResourceKind_ADMIN_RESOURCE_KIND_CONFIG:
+		entry, err := findEntry(ctx, s.cm, req.Msg.Name)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.checkRefScope(ctx, entry.Ref, false); err != nil {
+			return nil, err
+		}
+		resource, err = s.configResource(ctx, entry)
+		if err != nil {
+			return nil, err
+		}
+
+	case ftlv1.AdminResourceKind_ADMIN_RESOURCE_KIND_SECRET_REF:
+		entry, err := findEntry(ctx, s.sm, req.Msg.Name)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.checkRefScope(ctx, entry.Ref, false); err != nil {
+			return nil, err
+		}
+		resource = secretRefResource(entry)
+
+	default:
+		kind, err := s.storedResourceKind(req.Msg.Kind)
+		if err != nil {
+			return nil, err
+		}
+		stored, err := s.resources.GetAdminResource(ctx, kind, req.Msg.Name)
+		if err != nil {
+			return nil, resourceError(err)
+		}
+		resource, err = storedResourceToProto(stored)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return connect.NewResponse(&ftlv1.GetAdminResourceResponse{Resource: resource}), nil
+}
+
+// CreateAdminResource creates a resource, failing with AlreadyExists if it
+// already exists.
+func (s *AdminService) CreateAdminResource(ctx context.Context, req *connect.Request[ftlv1.CreateAdminResourceRequest]) (*connect.Response[ftlv1.CreateAdminResourceResponse], error) {
+	resource := req.Msg.Resource
+	if resource == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("resource is required"))
+	}
+	out, err := s.putResource(ctx, resource, true)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&ftlv1.CreateAdminResourceResponse{Resource: out}), nil
+}
+
+// UpdateAdminResource updates an existing resource, failing with Aborted if a
+// version is given and the resource has changed since.
+func (s *AdminService) UpdateAdminResource(ctx context.Context, req *connect.Request[ftlv1.UpdateAdminResourceRequest]) (*connect.Response[ftlv1.UpdateAdminResourceResponse], error) {
+	resource := req.Msg.Resource
+	if resource == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("resource is required"))
+	}
+	out, err := s.putResource(ctx, resource, false)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&ftlv1.UpdateAdminResourceResponse{Resource: out}), nil
+}
+
+// DeleteAdminResource deletes a resource, failing with Aborted if a version
+// is given and the resource has changed since.
+//
+// Deleting a secret reference doesn't delete the secret it refers to.
+func (s *AdminService) DeleteAdminResource(ctx context.Context, req *connect.Request[ftlv1.DeleteAdminResourceRequest]) (*connect.Response[ftlv1.DeleteAdminResourceResponse], error) {
+	switch req.Msg.Kind {
+	case ftlv1.AdminResourceKind_ADMIN_RESOURCE_KIND_CONFIG:
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		entry, err := findEntry(ctx, s.cm, req.Msg.Name)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.checkRefScope(ctx, entry.Ref, true); err != nil {
+			return nil, err
+		}
+		current, err := s.configResource(ctx, entry)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkVersion(current, req.Msg.Version); err != nil {
+			return nil, err
+		}
+		if err := s.cm.Unset(ctx, entry.Accessor.Scheme, entry.Ref); err != nil {
+			return nil, err
+		}
+
+	case ftlv1.AdminResourceKind_ADMIN_RESOURCE_KIND_SECRET_REF:
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		entry, err := findEntry(ctx, s.sm, req.Msg.Name)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.checkRefScope(ctx, entry.Ref, true); err != nil {
+			return nil, err
+		}
+		if err := checkVersion(secretRefResource(entry), req.Msg.Version); err != nil {
+			return nil, err
+		}
+		if err := s.sm.UnsetKey(ctx, entry.Ref); err != nil {
+			return nil, err
+		}
+
+	default:
+		kind, err := s.storedResourceKind(req.Msg.Kind)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkResourceScope(ctx, kind); err != nil {
+			return nil, err
+		}
+		version, err := parseStoredVersion(req.Msg.Version)
+		if err != nil {
+			return nil, err
+		}
+		if kind == dal.AdminResourceProject {
+			if err := s.checkProjectUnused(ctx, req.Msg.Name); err != nil {
+				return nil, err
+			}
+		}
+		if err := s.resources.DeleteAdminResource(ctx, kind, req.Msg.Name, version); err != nil {
+			return nil, resourceError(err)
+		}
+	}
+	return connect.NewResponse(&ftlv1.DeleteAdminResourceResponse{}), nil
+}
+
+// putResource creates "resource" if "create" is true, and otherwise updates it.
+func (s *AdminService) putResource(ctx context.Context, resource *ftlv1.AdminResource, create bool) (*ftlv1.AdminResource, error) {
+	switch spec := resource.Spec.(type) {
+	case *ftlv1.AdminResource_Config:
+		return s.putConfig(ctx, resource.Name, resource.Version, spec.Config, create)
+
+	case *ftlv1.AdminResource_SecretRef:
+		return s.putSecretRef(ctx, resource.Name, resource.Version, spec.SecretRef, create)
+
+	case *ftlv1.AdminResource_Project:
+		if !projectNameRe.MatchString(resource.Name) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid project name %q", resource.Name))
+		}
+		return s.putStoredResource(ctx, dal.AdminResourceProject, resource, spec.Project, create)
+
+	case *ftlv1.AdminResource_Quota:
+		if err := validateQuota(resource.Name, spec.Quota); err != nil {
+			return nil, err
+		}
+		return s.putStoredResource(ctx, dal.AdminResourceQuota, resource, spec.Quota, create)
+
+	case *ftlv1.AdminResource_IngressDomain:
+		if !ingressDomainRe.MatchString(resource.Name) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid ingress domain %q, domains must be lower case", resource.Name))
+		}
+		if s.resources != nil {
+			if _, err := s.resources.GetAdminResource(ctx, dal.AdminResourceProject, spec.IngressDomain.Project); err != nil {
+				if errors.Is(err, dalerrs.ErrNotFound) {
+					return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("project %q does not exist", spec.IngressDomain.Project))
+				}
+				return nil, resourceError(err)
+			}
+		}
+		return s.putStoredResource(ctx, dal.AdminResourceIngressDomain, resource, spec.IngressDomain, create)
+
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("resource spec is required"))
+	}
+}
+
+func (s *AdminService) putConfig(ctx context.Context, name, version string, spec *ftlv1.ConfigSpec, create bool) (*ftlv1.AdminResource, error) {
+	ref, err := parseResourceRef(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkRefScope(ctx, ref, true); err != nil {
+		return nil, err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	entry, err := findEntry(ctx, s.cm, name)
+	exists := err == nil
+	if err != nil && connect.CodeOf(err) != connect.CodeNotFound {
+		return nil, err
+	}
+	pkey := configProviderKey(spec.Provider)
+	switch {
+	case create && exists:
+		return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("config %q already exists", name))
+	case create && pkey == "":
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("a provider is required to create config"))
+	case !create && !exists:
+		return nil, err
+	case !create:
+		current, err := s.configResource(ctx, entry)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkVersion(current, version); err != nil {
+			return nil, err
+		}
+		if pkey == "" {
+			pkey = entry.Accessor.Scheme
+		} else if pkey != entry.Accessor.Scheme {
+			// Move the value to its new provider.
+			if err := s.cm.Unset(ctx, entry.Accessor.Scheme, ref); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := s.cm.SetJSON(ctx, pkey, ref, spec.Value); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	entry, err = findEntry(ctx, s.cm, name)
+	if err != nil {
+		return nil, err
+	}
+	return s.configResource(ctx, entry)
+}
+
+func (s *AdminService) putSecretRef(ctx context.Context, name, version string, spec *ftlv1.SecretRefSpec, create bool) (*ftlv1.AdminResource, error) {
+	ref, err := parseResourceRef(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkRefScope(ctx, ref, true); err != nil {
+		return nil, err
+	}
+	key, err := url.Parse(spec.Url)
+	if err != nil || key.Scheme == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid secret URL %q", spec.Url))
+	}
+	if key.Scheme == "inline" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("inline secrets can not be referenced, set their value with SecretSet"))
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	entry, err := findEntry(ctx, s.sm, name)
+	exists := err == nil
+	if err != nil && connect.CodeOf(err) != connect.CodeNotFound {
+		return nil, err
+	}
+	switch {
+	case create && exists:
+		return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("secret %q already exists", name))
+	case !create && !exists:
+		return nil, err
+	case !create:
+		if err := checkVersion(secretRefResource(entry), version); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.sm.SetKey(ctx, ref, key); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return secretRefResource(cf.Entry{Ref: ref, Accessor: key}), nil
+}
+
+func (s *AdminService) putStoredResource(ctx context.Context, kind dal.AdminResourceKind, resource *ftlv1.AdminResource, spec proto.Message, create bool) (*ftlv1.AdminResource, error) {
+	if s.resources == nil {
+		return nil, errNoResourceStore(kind)
+	}
+	if err := checkResourceScope(ctx, kind); err != nil {
+		return nil, err
+	}
+	data, err := protojson.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	var stored dal.AdminResource
+	if create {
+		stored, err = s.resources.CreateAdminResource(ctx, kind, resource.Name, data)
+		if errors.Is(err, dalerrs.ErrConflict) {
+			return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("%s %q already exists", kind, resource.Name))
+		}
+	} else {
+		version, verr := parseStoredVersion(resource.Version)
+		if verr != nil {
+			return nil, verr
+		}
+		stored, err = s.resources.UpdateAdminResource(ctx, kind, resource.Name, data, version)
+	}
+	if err != nil {
+		return nil, resourceError(err)
+	}
+	return storedResourceToProto(stored)
+}
+
+// checkProjectUnused returns an error if any ingress domains are routed to "project".
+func (s *AdminService) checkProjectUnused(ctx context.Context, project string) error {
+	domains, err := s.resources.ListAdminResources(ctx, dal.AdminResourceIngressDomain)
+	if err != nil {
+		return resourceError(err)
+	}
+	for _, domain := range domains {
+		resource, err := storedResourceToProto(domain)
+		if err != nil {
+			return err
+		}
+		if resource.GetIngressDomain().Project == project {
+			return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("project %q is used by ingress domain %q", project, domain.Name))
+		}
+	}
+	return nil
+}
+
+func (s *AdminService) storedResourceKind(kind ftlv1.AdminResourceKind) (dal.AdminResourceKind, error) {
+	stored, ok := storedResourceKinds[kind]
+	if !ok {
+		return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid resource kind %s", kind))
+	}
+	if s.resources == nil {
+		return "", errNoResourceStore(stored)
+	}
+	return stored, nil
+}
+
+func errNoResourceStore(kind dal.AdminResourceKind) error {
+	return connect.NewError(connect.CodeUnimplemented, fmt.Errorf("%s resources can only be managed through a controller", kind))
+}
+
+// checkResourceScope returns an error if resources of "kind" can't be changed
+// by requests made with "ctx".
+func checkResourceScope(ctx context.Context, kind dal.AdminResourceKind) error {
+	// Projects, quotas and ingress domains affect every project, so only
+	// admins can change them.
+	if project, ok := rpc.ProjectFromContext(ctx).Get(); ok {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("%s resources can not be changed by requests scoped to project %q", kind, project))
+	}
+	return nil
+}
+
+// configResource returns the config resource of "entry".
+func (s *AdminService) configResource(ctx context.Context, entry cf.Entry) (*ftlv1.AdminResource, error) {
+	var value any
+	if err := s.cm.Get(ctx, entry.Ref, &value); err != nil {
+		return nil, fmt.Errorf("failed to get value for %s: %w", entry.Ref, err)
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal value for %s: %w", entry.Ref, err)
+	}
+	spec := &ftlv1.ConfigSpec{Value: data}
+	for provider := range ftlv1.ConfigProvider_name {
+		provider := ftlv1.ConfigProvider(provider)
+		if configProviderKey(&provider) == entry.Accessor.Scheme {
+			spec.Provider = &provider
+		}
+	}
+	return &ftlv1.AdminResource{
+		Name:    entry.Ref.String(),
+		Version: contentVersion(entry.Accessor.Scheme, string(data)),
+		Spec:    &ftlv1.AdminResource_Config{Config: spec},
+	}, nil
+}
+
+// secretRefResource returns the secret reference resource of "entry".
+//
+// The URLs of inline secrets contain their value, so are not reported.
+func secretRefResource(entry cf.Entry) *ftlv1.AdminResource {
+	key := entry.Accessor.String()
+	if entry.Accessor.Scheme == "inline" {
+		key = "inline:"
+	}
+	return &ftlv1.AdminResource{
+		Name:    entry.Ref.String(),
+		Version: contentVersion(key),
+		Spec:    &ftlv1.AdminResource_SecretRef{SecretRef: &ftlv1.SecretRefSpec{Url: key}},
+	}
+}
+
+// findEntry returns the entry for "name", which must match exactly rather than
+// falling back to a global value.
+func findEntry[R cf.Role](ctx context.Context, manager *cf.Manager[R], name string) (cf.Entry, error) {
+	ref, err := parseResourceRef(name)
+	if err != nil {
+		return cf.Entry{}, err
+	}
+	entries, err := manager.List(ctx)
+	if err != nil {
+		return cf.Entry{}, err
+	}
+	for _, entry := range entries {
+		if entry.Ref == ref {
+			return entry, nil
+		}
+	}
+	var role R
+	return cf.Entry{}, connect.NewError(connect.CodeNotFound, fmt.Errorf("%s %q not found", role, name))
+}
+
+func parseResourceRef(name string) (cf.Ref, error) {
+	ref, err := cf.ParseRef(name)
+	if err != nil || ref.Name == "" {
+		return cf.Ref{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid ref %q", name))
+	}
+	return ref, nil
+}
+
+// contentVersion returns a version derived from the content of a resource
+// that is not stored by the controller.
+//
+// Content versions are only checked under [AdminService.lock], so they are
+// advisory across controllers, unlike the versions of stored resources.
+func contentVersion(parts ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\n")))
+	return hex.EncodeToString(sum[:8])
+}
+
+func checkVersion(current *ftlv1.AdminResource, version string) error {
+	if version != "" && version != current.Version {
+		return connect.NewError(connect.CodeAborted, fmt.Errorf("%q has changed since version %s", current.Name, version))
+	}
+	return nil
+}
+
+func parseStoredVersion(version string) (optional.Option[int64], error) {
+	if version == "" {
+		return optional.None[int64](), nil
+	}
+	n, err := strconv.ParseInt(version, 10, 64)
+	if err != nil {
+		return optional.None[int64](), connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid version %q", version))
+	}
+	return optional.Some(n), nil
+}
+
+func validateQuota(name string, spec *ftlv1.QuotaSpec) error {
+	quota, scope, ok := strings.Cut(name, "/")
+	if !ok || scope == "" {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid quota name %q, expected <quota>/<scope>", name))
+	}
+	if err := (&quotas.Config{}).SetLimit(quota, scope, int(spec.Limit)); err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if spec.Limit < 0 {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("quota limit must not be negative"))
+	}
+	return nil
+}
+
+func storedResourceToProto(resource dal.AdminResource) (*ftlv1.AdminResource, error) {
+	out := &ftlv1.AdminResource{Name: resource.Name, Version: strconv.FormatInt(resource.Version, 10)}
+	var spec proto.Message
+	switch resource.Kind {
+	case dal.AdminResourceProject:
+		project := &ftlv1.ProjectSpec{}
+		out.Spec, spec = &ftlv1.AdminResource_Project{Project: project}, project
+	case dal.AdminResourceQuota:
+		quota := &ftlv1.QuotaSpec{}
+		out.Spec, spec = &ftlv1.AdminResource_Quota{Quota: quota}, quota
+	case dal.AdminResourceIngressDomain:
+		domain := &ftlv1.IngressDomainSpec{}
+		out.Spec, spec = &ftlv1.AdminResource_IngressDomain{IngressDomain: domain}, domain
+	default:
+		return nil, fmt.Errorf("unknown resource kind %q", resource.Kind)
+	}
+	if err := protojson.Unmarshal(resource.Spec, spec); err != nil {
+		return nil, fmt.Errorf("invalid spec for %s %q: %w", resource.Kind, resource.Name, err)
+	}
+	return out, nil
+}
+
+func resourceError(err error) error {
+	switch {
+	case errors.Is(err, dalerrs.ErrNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, dalerrs.ErrConflict):
+		return connect.NewError(connect.CodeAborted, err)
+	default:
+		return err
+	}
+}
@@ -0,0 +1,206 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	cf "github.com/TBD54566975/ftl/common/configuration"
+	"github.com/TBD54566975/ftl/db/dalerrs"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/rpc"
+)
+
+type memoryResourceStore struct {
+	resources map[string]dal.AdminResource
+}
+
+var _ ResourceStore = (*memoryResourceStore)(nil)
+
+func (m *memoryResourceStore) key(kind dal.AdminResourceKind, name string) string {
+	return fmt.Sprintf("%s:%s", kind, name)
+}
+
+func (m *memoryResourceStore) ListAdminResources(ctx context.Context, kind dal.AdminResourceKind) ([]dal.AdminResource, error) {
+	out := []dal.AdminResource{}
+	for _, resource := range m.resources {
+		if resource.Kind == kind {
+			out = append(out, resource)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out, nil
+}
+
+func (m *memoryResourceStore) GetAdminResource(ctx context.Context, kind dal.AdminResourceKind, name string) (dal.AdminResource, error) {
+	resource, ok := m.resources[m.key(kind, name)]
+	if !ok {
+		return dal.AdminResource{}, dalerrs.ErrNotFound
+	}
+	return resource, nil
+}
+
+func (m *memoryResourceStore) CreateAdminResource(ctx context.Context, kind dal.AdminResourceKind, name string, spec []byte) (dal.AdminResource, error) {
+	if _, ok := m.resources[m.key(kind, name)]; ok {
+		return dal.AdminResource{}, dalerrs.ErrConflict
+	}
+	resource := dal.AdminResource{Kind: kind, Name: name, Spec: spec, Version: 1}
+	m.resources[m.key(kind, name)] = resource
+	return resource, nil
+}
+
+func (m *memoryResourceStore) UpdateAdminResource(ctx context.Context, kind dal.AdminResourceKind, name string, spec []byte, version optional.Option[int64]) (dal.AdminResource, error) {
+	resource, err := m.GetAdminResource(ctx, kind, name)
+	if err != nil {
+		return resource, err
+	}
+	if v, ok := version.Get(); ok && v != resource.Version {
+		return dal.AdminResource{}, dalerrs.ErrConflict
+	}
+	resource.Spec = spec
+	resource.Version++
+	m.resources[m.key(kind, name)] = resource
+	return resource, nil
+}
+
+func (m *memoryResourceStore) DeleteAdminResource(ctx context.Context, kind dal.AdminResourceKind, name string, version optional.Option[int64]) error {
+	resource, err := m.GetAdminResource(ctx, kind, name)
+	if err != nil {
+		return err
+	}
+	if v, ok := version.Get(); ok && v != resource.Version {
+		return dalerrs.ErrConflict
+	}
+	delete(m.resources, m.key(kind, name))
+	return nil
+}
+
+func TestAdminResources(t *testing.T) {
+	config := tempConfigPath(t, "testdata/ftl-project.toml", "resources")
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+
+	cm, err := cf.NewConfigurationManager(ctx, cf.ProjectConfigResolver[cf.Configuration]{Config: config})
+	assert.NoError(t, err)
+	sm, err := cf.New(ctx,
+		cf.ProjectConfigResolver[cf.Secrets]{Config: config},
+		[]cf.Provider[cf.Secrets]{
+			cf.EnvarProvider[cf.Secrets]{},
+			cf.InlineProvider[cf.Secrets]{},
+		})
+	assert.NoError(t, err)
+	admin := NewAdminService(cm, sm, &memoryResourceStore{resources: map[string]dal.AdminResource{}}, nil)
+
+	create := func(ctx context.Context, resource *ftlv1.AdminResource) (*ftlv1.AdminResource, error) {
+		resp, err := admin.CreateAdminResource(ctx, connect.NewRequest(&ftlv1.CreateAdminResourceRequest{Resource: resource}))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg.Resource, nil
+	}
+	update := func(resource *ftlv1.AdminResource) (*ftlv1.AdminResource, error) {
+		resp, err := admin.UpdateAdminResource(ctx, connect.NewRequest(&ftlv1.UpdateAdminResourceRequest{Resource: resource}))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg.Resource, nil
+	}
+	remove := func(kind ftlv1.AdminResourceKind, name, version string) error {
+		_, err := admin.DeleteAdminResource(ctx, connect.NewRequest(&ftlv1.DeleteAdminResourceRequest{Kind: kind, Name: name, Version: version}))
+		return err
+	}
+	project := func(name string) *ftlv1.AdminResource {
+		return &ftlv1.AdminResource{Name: name, Spec: &ftlv1.AdminResource_Project{Project: &ftlv1.ProjectSpec{Owner: "payments"}}}
+	}
+	domain := func(name, project string) *ftlv1.AdminResource {
+		return &ftlv1.AdminResource{Name: name, Spec: &ftlv1.AdminResource_IngressDomain{IngressDomain: &ftlv1.IngressDomainSpec{Project: project}}}
+	}
+
+	t.Run("StoredResources", func(t *testing.T) {
+		_, err := create(ctx, domain("api.example.com", "payments"))
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+		created, err := create(ctx, project("payments"))
+		assert.NoError(t, err)
+		assert.Equal(t, "1", created.Version)
+		_, err = create(ctx, project("payments"))
+		assert.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+
+		updated, err := update(&ftlv1.AdminResource{Name: "payments", Version: "1", Spec: &ftlv1.AdminResource_Project{Project: &ftlv1.ProjectSpec{Owner: "billing"}}})
+		assert.NoError(t, err)
+		assert.Equal(t, "2", updated.Version)
+		assert.Equal(t, "billing", updated.GetProject().Owner)
+		_, err = update(&ftlv1.AdminResource{Name: "payments", Version: "1", Spec: &ftlv1.AdminResource_Project{Project: &ftlv1.ProjectSpec{}}})
+		assert.Equal(t, connect.CodeAborted, connect.CodeOf(err))
+
+		_, err = create(ctx, domain("API.example.com", "payments"))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+		_, err = create(ctx, domain("*.example.com", "payments"))
+		assert.NoError(t, err)
+		err = remove(ftlv1.AdminResourceKind_ADMIN_RESOURCE_KIND_PROJECT, "payments", "")
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+		assert.NoError(t, remove(ftlv1.AdminResourceKind_ADMIN_RESOURCE_KIND_INGRESS_DOMAIN, "*.example.com", ""))
+		err = remove(ftlv1.AdminResourceKind_ADMIN_RESOURCE_KIND_PROJECT, "payments", "1")
+		assert.Equal(t, connect.CodeAborted, connect.CodeOf(err))
+		assert.NoError(t, remove(ftlv1.AdminResourceKind_ADMIN_RESOURCE_KIND_PROJECT, "payments", "2"))
+
+		_, err = admin.GetAdminResource(ctx, connect.NewRequest(&ftlv1.GetAdminResourceRequest{Kind: ftlv1.AdminResourceKind_ADMIN_RESOURCE_KIND_PROJECT, Name: "payments"}))
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+
+	t.Run("Quotas", func(t *testing.T) {
+		quota := func(name string, limit int64) *ftlv1.AdminResource {
+			return &ftlv1.AdminResource{Name: name, Spec: &ftlv1.AdminResource_Quota{Quota: &ftlv1.QuotaSpec{Limit: limit}}}
+		}
+		_, err := create(ctx, quota("artefact-size", 10))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+		_, err = create(ctx, quota("artefact-size/payments", -1))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+		_, err = create(ctx, quota("artefact-size/payments", 1024))
+		assert.NoError(t, err)
+
+		_, err = create(rpc.WithProject(ctx, "payments"), quota("artefact-size/payments", 2048))
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+
+	t.Run("Config", func(t *testing.T) {
+		inline := ftlv1.ConfigProvider_CONFIG_INLINE
+		_, err := create(ctx, &ftlv1.AdminResource{Name: "echo.greeting", Spec: &ftlv1.AdminResource_Config{Config: &ftlv1.ConfigSpec{Value: []byte(`"hello"`)}}})
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+		created, err := create(ctx, &ftlv1.AdminResource{Name: "echo.greeting", Spec: &ftlv1.AdminResource_Config{Config: &ftlv1.ConfigSpec{Value: []byte(`"hello"`), Provider: &inline}}})
+		assert.NoError(t, err)
+		assert.Equal(t, `"hello"`, string(created.GetConfig().Value))
+
+		updated, err := update(&ftlv1.AdminResource{Name: "echo.greeting", Version: created.Version, Spec: &ftlv1.AdminResource_Config{Config: &ftlv1.ConfigSpec{Value: []byte(`"hi"`)}}})
+		assert.NoError(t, err)
+		assert.NotEqual(t, created.Version, updated.Version)
+		_, err = update(&ftlv1.AdminResource{Name: "echo.greeting", Version: created.Version, Spec: &ftlv1.AdminResource_Config{Config: &ftlv1.ConfigSpec{Value: []byte(`"hey"`)}}})
+		assert.Equal(t, connect.CodeAborted, connect.CodeOf(err))
+
+		assert.NoError(t, remove(ftlv1.AdminResourceKind_ADMIN_RESOURCE_KIND_CONFIG, "echo.greeting", updated.Version))
+		err = remove(ftlv1.AdminResourceKind_ADMIN_RESOURCE_KIND_CONFIG, "echo.greeting", "")
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+
+	t.Run("SecretRefs", func(t *testing.T) {
+		resp, err := admin.ListAdminResources(ctx, connect.NewRequest(&ftlv1.ListAdminResourcesRequest{Kind: ftlv1.AdminResourceKind_ADMIN_RESOURCE_KIND_SECRET_REF}))
+		assert.NoError(t, err)
+		urls := map[string]string{}
+		for _, resource := range resp.Msg.Resources {
+			urls[resource.Name] = resource.GetSecretRef().Url
+		}
+		assert.Equal(t, map[string]string{"bar": "envar://baza", "foo": "inline:"}, urls)
+
+		_, err = create(ctx, &ftlv1.AdminResource{Name: "token", Spec: &ftlv1.AdminResource_SecretRef{SecretRef: &ftlv1.SecretRefSpec{Url: "inline://InRva2VuIg"}}})
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+		_, err = create(ctx, &ftlv1.AdminResource{Name: "token", Spec: &ftlv1.AdminResource_SecretRef{SecretRef: &ftlv1.SecretRefSpec{Url: "envar://token"}}})
+		assert.NoError(t, err)
+		assert.NoError(t, remove(ftlv1.AdminResourceKind_ADMIN_RESOURCE_KIND_SECRET_REF, "token", ""))
+	})
+}
@@ -0,0 +1,55 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+
+	cf "github.com/TBD54566975/ftl/common/configuration"
+	"github.com/TBD54566975/ftl/internal/rpc"
+)
+
+// ModuleScope returns a function that returns true if "module" is visible to
+// requests made with "ctx".
+type ModuleScope func(ctx context.Context) (func(module string) bool, error)
+
+// refScope returns a function that returns true if the config or secret
+// "ref" is visible to requests made with "ctx".
+//
+// Global refs are visible to every project.
+func (s *AdminService) refScope(ctx context.Context) (func(ref cf.Ref) bool, error) {
+	if _, ok := rpc.ProjectFromContext(ctx).Get(); !ok || s.moduleScope == nil {
+		return func(cf.Ref) bool { return true }, nil
+	}
+	inScope, err := s.moduleScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(ref cf.Ref) bool {
+		module, ok := ref.Module.Get()
+		return !ok || inScope(module)
+	}, nil
+}
+
+// checkRefScope returns an error if the config or secret "ref" can't be read
+// by requests made with "ctx", or changed if "change" is true.
+//
+// Global refs affect every project, so only unscoped requests can change them.
+func (s *AdminService) checkRefScope(ctx context.Context, ref cf.Ref, change bool) error {
+	project, ok := rpc.ProjectFromContext(ctx).Get()
+	if !ok {
+		return nil
+	}
+	if _, ok := ref.Module.Get(); !ok && change {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("global %q can not be changed by requests scoped to project %q", ref, project))
+	}
+	inScope, err := s.refScope(ctx)
+	if err != nil {
+		return err
+	}
+	if !inScope(ref) {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("%q does not belong to project %q", ref, project))
+	}
+	return nil
+}
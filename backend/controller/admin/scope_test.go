@@ -0,0 +1,57 @@
+package admin
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/assert/v2"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	cf "github.com/TBD54566975/ftl/common/configuration"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/rpc"
+	"github.com/TBD54566975/ftl/internal/slices"
+)
+
+func TestConfigProjectScope(t *testing.T) {
+	config := tempConfigPath(t, "testdata/ftl-project.toml", "scope")
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	cm, err := cf.NewConfigurationManager(ctx, cf.ProjectConfigResolver[cf.Configuration]{Config: config})
+	assert.NoError(t, err)
+	sm, err := cf.New(ctx, cf.ProjectConfigResolver[cf.Secrets]{Config: config}, []cf.Provider[cf.Secrets]{cf.InlineProvider[cf.Secrets]{}})
+	assert.NoError(t, err)
+	// The echo module belongs to another project.
+	admin := NewAdminService(cm, sm, nil, func(ctx context.Context) (func(module string) bool, error) {
+		return func(module string) bool { return module != "echo" }, nil
+	})
+	billing := rpc.WithProject(ctx, "billing")
+	inline := ftlv1.ConfigProvider_CONFIG_INLINE
+	module := func(module string) *string { return &module }
+	includeValues := false
+
+	list, err := admin.ConfigList(billing, connect.NewRequest(&ftlv1.ListConfigRequest{Module: module(""), IncludeValues: &includeValues}))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"bar", "foo", "mutable"}, slices.Map(list.Msg.Configs, func(c *ftlv1.ListConfigResponse_Config) string { return c.RefPath }))
+	list, err = admin.ConfigList(ctx, connect.NewRequest(&ftlv1.ListConfigRequest{Module: module(""), IncludeValues: &includeValues}))
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(list.Msg.Configs), "unscoped requests should see every module's config")
+
+	_, err = admin.ConfigGet(billing, connect.NewRequest(&ftlv1.GetConfigRequest{Ref: &ftlv1.ConfigRef{Module: module("echo"), Name: "default"}}))
+	assert.EqualError(t, err, `permission_denied: "echo.default" does not belong to project "billing"`)
+	_, err = admin.ConfigGet(billing, connect.NewRequest(&ftlv1.GetConfigRequest{Ref: &ftlv1.ConfigRef{Module: module(""), Name: "foo"}}))
+	assert.NoError(t, err, "global config should be readable from every project")
+
+	_, err = admin.ConfigSet(billing, connect.NewRequest(&ftlv1.SetConfigRequest{Provider: &inline, Ref: &ftlv1.ConfigRef{Module: module("echo"), Name: "default"}, Value: []byte(`"billing"`)}))
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	_, err = admin.ConfigSet(billing, connect.NewRequest(&ftlv1.SetConfigRequest{Provider: &inline, Ref: &ftlv1.ConfigRef{Module: module(""), Name: "foo"}, Value: []byte(`"billing"`)}))
+	assert.EqualError(t, err, `permission_denied: global "foo" can not be changed by requests scoped to project "billing"`)
+	_, err = admin.ConfigSet(billing, connect.NewRequest(&ftlv1.SetConfigRequest{Provider: &inline, Ref: &ftlv1.ConfigRef{Module: module("invoices"), Name: "currency"}, Value: []byte(`"AUD"`)}))
+	assert.NoError(t, err)
+
+	_, err = admin.SecretUnset(billing, connect.NewRequest(&ftlv1.UnsetSecretRequest{Ref: &ftlv1.ConfigRef{Module: module("echo"), Name: "password"}}))
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	_, err = admin.GetAdminResource(billing, connect.NewRequest(&ftlv1.GetAdminResourceRequest{Kind: ftlv1.AdminResourceKind_ADMIN_RESOURCE_KIND_CONFIG, Name: "echo.default"}))
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+}
@@ -0,0 +1,92 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	"github.com/TBD54566975/ftl/backend/controller/quotas"
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/internal/slices"
+)
+
+// syncAdminResources synchronises the quota overrides and ingress domains
+// managed with the admin resource API from the database.
+func (s *Service) syncAdminResources(ctx context.Context) (time.Duration, error) {
+	overrides := quotas.Config{}
+	resources, err := s.dal.ListAdminResources(ctx, dal.AdminResourceQuota)
+	if err != nil {
+		return 0, err
+	}
+	for _, resource := range resources {
+		spec := &ftlv1.QuotaSpec{}
+		if err := protojson.Unmarshal(resource.Spec, spec); err != nil {
+			return 0, fmt.Errorf("invalid quota %q: %w", resource.Name, err)
+		}
+		quota, scope, _ := strings.Cut(resource.Name, "/")
+		if err := overrides.SetLimit(quota, scope, int(spec.Limit)); err != nil {
+			return 0, fmt.Errorf("invalid quota %q: %w", resource.Name, err)
+		}
+	}
+	s.quotas.SetOverrides(overrides)
+
+	domains := map[string]string{}
+	resources, err = s.dal.ListAdminResources(ctx, dal.AdminResourceIngressDomain)
+	if err != nil {
+		return 0, err
+	}
+	for _, resource := range resources {
+		spec := &ftlv1.IngressDomainSpec{}
+		if err := protojson.Unmarshal(resource.Spec, spec); err != nil {
+			return 0, fmt.Errorf("invalid ingress domain %q: %w", resource.Name, err)
+		}
+		domains[resource.Name] = spec.Project
+	}
+	s.ingressDomains.Store(domains)
+	return time.Second * 5, nil
+}
+
+// ingressDomainProject returns the project whose modules serve ingress
+// requests for "host", if it is an ingress domain.
+//
+// Wildcard domains, eg. "*.example.com", match any subdomain that isn't an
+// ingress domain itself.
+func (s *Service) ingressDomainProject(host string) (string, bool) {
+	domains := s.ingressDomains.Load()
+	if len(domains) == 0 {
+		return "", false
+	}
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.ToLower(host)
+	if project, ok := domains[host]; ok {
+		return project, true
+	}
+	if _, parent, ok := strings.Cut(host, "."); ok {
+		project, ok := domains["*."+parent]
+		return project, ok
+	}
+	return "", false
+}
+
+// filterIngressDomainRoutes returns the routes that serve ingress requests for
+// "host", which are those of the modules of its project if it is an ingress
+// domain, and otherwise every route.
+func (s *Service) filterIngressDomainRoutes(ctx context.Context, host string, routes []dal.IngressRoute) ([]dal.IngressRoute, error) {
+	project, ok := s.ingressDomainProject(host)
+	if !ok {
+		return routes, nil
+	}
+	deployments, err := s.dal.GetActiveDeployments(ctx)
+	if err != nil {
+		return nil, err
+	}
+	projects := moduleProjects(deployments)
+	return slices.Filter(routes, func(route dal.IngressRoute) bool { return projects[route.Module] == project }), nil
+}
@@ -0,0 +1,101 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/internal/compression"
+	"github.com/TBD54566975/ftl/internal/rpc/headers"
+	"github.com/TBD54566975/ftl/internal/sha256"
+)
+
+// decompressArtefactUpload decompresses the content of an artefact upload in
+// place, if it is compressed.
+//
+// Uploads are rejected as soon as their decompressed content exceeds "limit"
+// bytes.
+func decompressArtefactUpload(req *connect.Request[ftlv1.UploadArtefactRequest], limit int) error {
+	name, ok := headers.GetCompression(req.Header()).Get()
+	if !ok {
+		return nil
+	}
+	if err := compression.Check(name); err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	var err error
+	req.Msg.Content, err = compression.DecompressLimit(req.Msg.Content, limit)
+	if err != nil {
+		return decompressionError(err)
+	}
+	// The chunks of an artefact share its limit.
+	remaining := limit - len(req.Msg.Content)
+	for _, chunk := range req.Msg.Chunks {
+		chunk.Content, err = compression.DecompressLimit(chunk.Content, remaining)
+		if err != nil {
+			return decompressionError(fmt.Errorf("chunk %s: %w", chunk.Digest, err))
+		}
+		remaining -= len(chunk.Content)
+	}
+	return nil
+}
+
+func decompressionError(err error) error {
+	if errors.Is(err, compression.ErrTooLarge) {
+		return connect.NewError(connect.CodeResourceExhausted, err)
+	}
+	return connect.NewError(connect.CodeInvalidArgument, err)
+}
+
+// assembleArtefactChunks returns the content of an artefact uploaded in
+// chunks, fetching the chunks that were uploaded without their content from
+// the database.
+func (s *Service) assembleArtefactChunks(ctx context.Context, chunks []*ftlv1.ArtefactChunk) ([]byte, error) {
+	digests := make([]sha256.SHA256, len(chunks))
+	contents := map[sha256.SHA256][]byte{}
+	for i, chunk := range chunks {
+		digest, err := sha256.ParseSHA256(chunk.Digest)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid chunk digest: %w", err))
+		}
+		digests[i] = digest
+		if len(chunk.Content) == 0 {
+			continue
+		}
+		if sha256.Sum(chunk.Content) != digest {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("content of chunk %s does not match its digest", digest))
+		}
+		contents[digest] = chunk.Content
+	}
+	var stored []sha256.SHA256
+	for _, digest := range digests {
+		if _, ok := contents[digest]; !ok {
+			stored = append(stored, digest)
+		}
+	}
+	if len(stored) > 0 {
+		found, err := s.dal.GetArtefactChunks(ctx, stored)
+		if err != nil {
+			return nil, err
+		}
+		for digest, content := range found {
+			contents[digest] = content
+		}
+	}
+	size := 0
+	for _, digest := range digests {
+		content, ok := contents[digest]
+		if !ok {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("chunk %s was uploaded without its content, but is not stored", digest))
+		}
+		size += len(content)
+	}
+	content := make([]byte, 0, size)
+	for _, digest := range digests {
+		content = append(content, contents[digest]...)
+	}
+	return content, nil
+}
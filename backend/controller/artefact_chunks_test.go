@@ -0,0 +1,43 @@
+package controller
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/assert/v2"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/internal/compression"
+	"github.com/TBD54566975/ftl/internal/rpc/headers"
+)
+
+func TestDecompressArtefactUpload(t *testing.T) {
+	req := connect.NewRequest(&ftlv1.UploadArtefactRequest{Chunks: []*ftlv1.ArtefactChunk{
+		{Digest: "a", Content: compression.Compress([]byte("hello"))},
+		{Digest: "b"},
+	}})
+	headers.SetCompression(req.Header(), compression.Zstd)
+	assert.NoError(t, decompressArtefactUpload(req, 1024))
+	assert.Equal(t, []byte("hello"), req.Msg.Chunks[0].Content)
+	assert.Equal(t, 0, len(req.Msg.Chunks[1].Content))
+
+	uncompressed := connect.NewRequest(&ftlv1.UploadArtefactRequest{Content: []byte("hello")})
+	assert.NoError(t, decompressArtefactUpload(uncompressed, 1024))
+	assert.Equal(t, []byte("hello"), uncompressed.Msg.Content)
+
+	corrupt := connect.NewRequest(&ftlv1.UploadArtefactRequest{Content: []byte("hello")})
+	headers.SetCompression(corrupt.Header(), compression.Zstd)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(decompressArtefactUpload(corrupt, 1024)))
+
+	unsupported := connect.NewRequest(&ftlv1.UploadArtefactRequest{})
+	headers.SetCompression(unsupported.Header(), "gzip")
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(decompressArtefactUpload(unsupported, 1024)))
+
+	// The decompressed content of all chunks counts towards the limit.
+	large := connect.NewRequest(&ftlv1.UploadArtefactRequest{Chunks: []*ftlv1.ArtefactChunk{
+		{Digest: "a", Content: compression.Compress(make([]byte, 600))},
+		{Digest: "b", Content: compression.Compress(make([]byte, 600))},
+	}})
+	headers.SetCompression(large.Header(), compression.Zstd)
+	assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(decompressArtefactUpload(large, 1024)))
+}
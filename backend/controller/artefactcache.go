@@ -0,0 +1,83 @@
+package controller
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+// runnerArtefactCaches holds the artefact cache statistics most recently
+// reported in the heartbeats of each runner connected to this controller.
+type runnerArtefactCaches struct {
+	lock  sync.Mutex
+	stats map[string]*ftlv1.ArtefactCacheStats
+}
+
+func newRunnerArtefactCaches() *runnerArtefactCaches {
+	return &runnerArtefactCaches{stats: map[string]*ftlv1.ArtefactCacheStats{}}
+}
+
+func (r *runnerArtefactCaches) update(runner model.RunnerKey, stats *ftlv1.ArtefactCacheStats) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if stats == nil {
+		delete(r.stats, runner.String())
+		return
+	}
+	r.stats[runner.String()] = stats
+}
+
+func (r *runnerArtefactCaches) remove(runner model.RunnerKey) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delete(r.stats, runner.String())
+}
+
+// registerArtefactCacheMetrics exposes the artefact cache statistics of each
+// runner connected to this controller.
+func (s *Service) registerArtefactCacheMetrics() error {
+	meter := otel.GetMeterProvider().Meter("ftl.controller")
+	hits, err := meter.Int64ObservableCounter("ftl.runner.artefact_cache.hits",
+		metric.WithDescription("number of artefacts a runner used from its cache rather than downloading"),
+		metric.WithUnit("{count}"))
+	if err != nil {
+		return err
+	}
+	misses, err := meter.Int64ObservableCounter("ftl.runner.artefact_cache.misses",
+		metric.WithDescription("number of artefacts a runner downloaded because they were not in its cache"),
+		metric.WithUnit("{count}"))
+	if err != nil {
+		return err
+	}
+	evictions, err := meter.Int64ObservableCounter("ftl.runner.artefact_cache.evictions",
+		metric.WithDescription("number of artefacts evicted from the cache of a runner"),
+		metric.WithUnit("{count}"))
+	if err != nil {
+		return err
+	}
+	size, err := meter.Int64ObservableGauge("ftl.runner.artefact_cache.size",
+		metric.WithDescription("size of the artefacts in the cache of a runner"),
+		metric.WithUnit("By"))
+	if err != nil {
+		return err
+	}
+	_, err = meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
+		s.artefactCaches.lock.Lock()
+		defer s.artefactCaches.lock.Unlock()
+		for runner, stats := range s.artefactCaches.stats {
+			attrs := metric.WithAttributes(attribute.String("ftl.runner.key", runner))
+			observer.ObserveInt64(hits, stats.Hits, attrs)
+			observer.ObserveInt64(misses, stats.Misses, attrs)
+			observer.ObserveInt64(evictions, stats.Evictions, attrs)
+			observer.ObserveInt64(size, stats.SizeBytes, attrs)
+		}
+		return nil
+	}, hits, misses, evictions, size)
+	return err
+}
@@ -0,0 +1,194 @@
+// Package backup exports the state of an FTL cluster to a portable archive and
+// restores it, for disaster recovery and for cloning environments.
+//
+// An archive is a gzipped tarball containing a JSON manifest and the content
+// of each artefact of the backed up deployments, named by its digest.
+package backup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/TBD54566975/ftl/internal/model"
+	"github.com/TBD54566975/ftl/internal/sha256"
+)
+
+// Version of the archive format, which is incremented on incompatible changes.
+const Version = 1
+
+const (
+	manifestName   = "manifest.json"
+	artefactPrefix = "artefacts/"
+)
+
+// Manifest describes the contents of a backup.
+type Manifest struct {
+	Version       int             `json:"version"`
+	Created       time.Time       `json:"created"`
+	Deployments   []Deployment    `json:"deployments"`
+	Configuration []Configuration `json:"configuration,omitempty"`
+	FeatureFlags  []FeatureFlag   `json:"featureFlags,omitempty"`
+	FSMInstances  []FSMInstance   `json:"fsmInstances,omitempty"`
+}
+
+// Deployment is an active deployment of a module.
+type Deployment struct {
+	Key         string       `json:"key"`
+	Language    string       `json:"language"`
+	MinReplicas int          `json:"minReplicas"`
+	Labels      model.Labels `json:"labels,omitempty"`
+	// Schema of the module, encoded as protobuf JSON.
+	Schema    json.RawMessage `json:"schema"`
+	Artefacts []Artefact      `json:"artefacts"`
+}
+
+type Artefact struct {
+	Digest     sha256.SHA256 `json:"digest"`
+	Path       string        `json:"path"`
+	Executable bool          `json:"executable,omitempty"`
+}
+
+// Configuration is a configuration value stored by the controller.
+type Configuration struct {
+	// Module is empty for global configuration.
+	Module string          `json:"module,omitempty"`
+	Name   string          `json:"name"`
+	Value  json.RawMessage `json:"value"`
+}
+
+type FeatureFlag struct {
+	Module string          `json:"module"`
+	Name   string          `json:"name"`
+	Rules  json.RawMessage `json:"rules"`
+}
+
+// FSMInstance is the state of an instance of an FSM, such as "payments.order".
+type FSMInstance struct {
+	FSM          string `json:"fsm"`
+	Key          string `json:"key"`
+	Status       string `json:"status"`
+	CurrentState string `json:"currentState,omitempty"`
+}
+
+// WriteArchive writes "manifest" and the artefacts of its deployments to "w",
+// retrieving the content of each artefact with "content".
+func WriteArchive(w io.Writer, manifest Manifest, content func(digest sha256.SHA256) ([]byte, error)) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	manifestJSON, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode manifest: %w", err)
+	}
+	if err := writeFile(tw, manifestName, manifestJSON, manifest.Created); err != nil {
+		return err
+	}
+	written := map[sha256.SHA256]bool{}
+	for _, deployment := range manifest.Deployments {
+		for _, artefact := range deployment.Artefacts {
+			if written[artefact.Digest] {
+				continue
+			}
+			written[artefact.Digest] = true
+			data, err := content(artefact.Digest)
+			if err != nil {
+				return fmt.Errorf("%s: failed to read artefact %s: %w", deployment.Key, artefact.Path, err)
+			}
+			if err := writeFile(tw, artefactPrefix+artefact.Digest.String(), data, manifest.Created); err != nil {
+				return err
+			}
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("failed to write archive: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return fmt.Errorf("failed to write archive: %w", err)
+	}
+	return nil
+}
+
+func writeFile(tw *tar.Writer, name string, data []byte, modTime time.Time) error {
+	err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(data)), ModTime: modTime})
+	if err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	if _, err := tw.Write(data); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	return nil
+}
+
+// ReadArchive reads an archive written by [WriteArchive], returning its
+// manifest and the content of its artefacts.
+//
+// The digest of each artefact is verified, and every artefact of the
+// manifest's deployments must be present.
+func ReadArchive(r io.Reader) (Manifest, map[sha256.SHA256][]byte, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return Manifest{}, nil, fmt.Errorf("invalid backup archive: %w", err)
+	}
+	defer gz.Close()
+	tr := tar.NewReader(gz)
+	var manifest Manifest
+	haveManifest := false
+	artefacts := map[sha256.SHA256][]byte{}
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return Manifest{}, nil, fmt.Errorf("invalid backup archive: %w", err)
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return Manifest{}, nil, fmt.Errorf("failed to read %s: %w", header.Name, err)
+		}
+		switch {
+		case header.Name == manifestName:
+			if err := json.Unmarshal(data, &manifest); err != nil {
+				return Manifest{}, nil, fmt.Errorf("invalid backup manifest: %w", err)
+			}
+			haveManifest = true
+
+		case strings.HasPrefix(header.Name, artefactPrefix):
+			digest, err := sha256.ParseSHA256(strings.TrimPrefix(header.Name, artefactPrefix))
+			if err != nil {
+				return Manifest{}, nil, fmt.Errorf("invalid artefact %s: %w", header.Name, err)
+			}
+			if sha256.Sum(data) != digest {
+				return Manifest{}, nil, fmt.Errorf("artefact %s is corrupt", digest)
+			}
+			artefacts[digest] = data
+
+		default:
+			return Manifest{}, nil, fmt.Errorf("unexpected file %s in backup archive", header.Name)
+		}
+	}
+	if !haveManifest {
+		return Manifest{}, nil, fmt.Errorf("backup archive has no %s", manifestName)
+	}
+	if manifest.Version > Version {
+		return Manifest{}, nil, fmt.Errorf("backup archive version %d is newer than the supported version %d", manifest.Version, Version)
+	}
+	var missing []string
+	for _, deployment := range manifest.Deployments {
+		for _, artefact := range deployment.Artefacts {
+			if _, ok := artefacts[artefact.Digest]; !ok {
+				missing = append(missing, fmt.Sprintf("%s (%s)", artefact.Path, deployment.Key))
+			}
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return Manifest{}, nil, fmt.Errorf("backup archive is missing artefacts: %s", strings.Join(missing, ", "))
+	}
+	return manifest, artefacts, nil
+}
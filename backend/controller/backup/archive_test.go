@@ -0,0 +1,110 @@
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/sha256"
+)
+
+func testManifest(artefacts ...[]byte) Manifest {
+	deployment := Deployment{
+		Key:         "dpl-echo-sjkfislfjslfas",
+		Language:    "go",
+		MinReplicas: 1,
+		Schema:      json.RawMessage(`{"name":"echo"}`),
+	}
+	for i, content := range artefacts {
+		deployment.Artefacts = append(deployment.Artefacts, Artefact{Digest: sha256.Sum(content), Path: []string{"main", "schema.pb"}[i], Executable: i == 0})
+	}
+	return Manifest{
+		Version:       Version,
+		Created:       time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+		Deployments:   []Deployment{deployment, deployment},
+		Configuration: []Configuration{{Module: "echo", Name: "greeting", Value: json.RawMessage(`"hello"`)}},
+		FeatureFlags:  []FeatureFlag{{Module: "echo", Name: "loud", Rules: json.RawMessage(`{"default":false}`)}},
+		FSMInstances:  []FSMInstance{{FSM: "payments.order", Key: "1", Status: "running", CurrentState: "payments.paid"}},
+	}
+}
+
+func TestArchiveRoundTrip(t *testing.T) {
+	main := []byte("#!/bin/sh\necho hello\n")
+	schemaPB := []byte("schema")
+	manifest := testManifest(main, schemaPB)
+	contents := map[sha256.SHA256][]byte{sha256.Sum(main): main, sha256.Sum(schemaPB): schemaPB}
+	reads := 0
+
+	buf := &bytes.Buffer{}
+	err := WriteArchive(buf, manifest, func(digest sha256.SHA256) ([]byte, error) {
+		reads++
+		return contents[digest], nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, reads, "artefacts shared by deployments should only be written once")
+
+	restored, artefacts, err := ReadArchive(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, contents, artefacts)
+	// Raw JSON is reindented in the manifest, so compare it compacted.
+	expected, err := json.Marshal(manifest)
+	assert.NoError(t, err)
+	actual, err := json.Marshal(restored)
+	assert.NoError(t, err)
+	assert.Equal(t, string(expected), string(actual))
+}
+
+func TestReadInvalidArchive(t *testing.T) {
+	main := []byte("main")
+	archive := func(manifest Manifest, files map[string][]byte) *bytes.Buffer {
+		buf := &bytes.Buffer{}
+		gz := gzip.NewWriter(buf)
+		tw := tar.NewWriter(gz)
+		manifestJSON, err := json.Marshal(manifest)
+		assert.NoError(t, err)
+		assert.NoError(t, writeFile(tw, manifestName, manifestJSON, manifest.Created))
+		for name, data := range files {
+			assert.NoError(t, writeFile(tw, name, data, manifest.Created))
+		}
+		assert.NoError(t, tw.Close())
+		assert.NoError(t, gz.Close())
+		return buf
+	}
+
+	_, _, err := ReadArchive(archive(testManifest(main), nil))
+	assert.EqualError(t, err, "backup archive is missing artefacts: main (dpl-echo-sjkfislfjslfas), main (dpl-echo-sjkfislfjslfas)")
+
+	_, _, err = ReadArchive(archive(testManifest(main), map[string][]byte{artefactPrefix + sha256.Sum(main).String(): []byte("changed")}))
+	assert.EqualError(t, err, "artefact "+sha256.Sum(main).String()+" is corrupt")
+
+	newer := testManifest()
+	newer.Version = Version + 1
+	_, _, err = ReadArchive(archive(newer, nil))
+	assert.EqualError(t, err, "backup archive version 2 is newer than the supported version 1")
+
+	_, _, err = ReadArchive(bytes.NewBufferString("not an archive"))
+	assert.Error(t, err)
+}
+
+func TestFSMInstanceState(t *testing.T) {
+	state, err := fsmInstanceState(FSMInstance{FSM: "payments.order", Key: "1", Status: "running", CurrentState: "payments.paid"})
+	assert.NoError(t, err)
+	assert.Equal(t, dal.FSMInstanceState{
+		FSM:          schema.RefKey{Module: "payments", Name: "order"},
+		Key:          "1",
+		Status:       dal.FSMStatusRunning,
+		CurrentState: optional.Some(schema.RefKey{Module: "payments", Name: "paid"}),
+	}, state)
+
+	state, err = fsmInstanceState(FSMInstance{FSM: "payments.order", Key: "2", Status: "failed"})
+	assert.NoError(t, err)
+	assert.Equal(t, optional.None[schema.RefKey](), state.CurrentState)
+}
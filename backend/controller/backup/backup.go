@@ -0,0 +1,213 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/alecthomas/types/optional"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/TBD54566975/ftl/backend/controller/cronjobs"
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	"github.com/TBD54566975/ftl/backend/controller/ingress"
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+	"github.com/TBD54566975/ftl/backend/schema"
+	cfdal "github.com/TBD54566975/ftl/common/configuration/dal"
+	"github.com/TBD54566975/ftl/db/dalerrs"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/model"
+	"github.com/TBD54566975/ftl/internal/sha256"
+	"github.com/TBD54566975/ftl/internal/slices"
+)
+
+// Export writes a backup of the active deployments, configuration, feature
+// flags and FSM instances of a cluster to "w".
+//
+// Secrets are not included.
+func Export(ctx context.Context, d *dal.DAL, configDAL *cfdal.DAL, w io.Writer) (Manifest, error) {
+	manifest := Manifest{Version: Version, Created: time.Now().UTC()}
+	deployments, err := d.GetActiveDeployments(ctx)
+	if err != nil {
+		return Manifest{}, fmt.Errorf("failed to get deployments: %w", err)
+	}
+	artefacts := map[sha256.SHA256]*model.Artefact{}
+	for _, deployment := range deployments {
+		loaded, err := d.GetDeployment(ctx, deployment.Key)
+		if err != nil {
+			return Manifest{}, fmt.Errorf("%s: failed to get deployment: %w", deployment.Key, err)
+		}
+		schemaJSON, err := protojson.Marshal(loaded.Schema.ToProto())
+		if err != nil {
+			return Manifest{}, fmt.Errorf("%s: failed to encode schema: %w", deployment.Key, err)
+		}
+		for _, artefact := range loaded.Artefacts {
+			artefacts[artefact.Digest] = artefact
+		}
+		manifest.Deployments = append(manifest.Deployments, Deployment{
+			Key:         deployment.Key.String(),
+			Language:    deployment.Language,
+			MinReplicas: deployment.MinReplicas,
+			Labels:      deployment.Labels,
+			Schema:      schemaJSON,
+			Artefacts: slices.Map(loaded.Artefacts, func(a *model.Artefact) Artefact {
+				return Artefact{Digest: a.Digest, Path: a.Path, Executable: a.Executable}
+			}),
+		})
+	}
+
+	configuration, err := configDAL.ListModuleConfiguration(ctx)
+	if err != nil {
+		return Manifest{}, fmt.Errorf("failed to get configuration: %w", err)
+	}
+	for _, config := range configuration {
+		manifest.Configuration = append(manifest.Configuration, Configuration{
+			Module: config.Module.Default(""),
+			Name:   config.Name,
+			Value:  config.Value,
+		})
+	}
+
+	flags, err := configDAL.ListModuleFeatureFlags(ctx, optional.None[string]())
+	if err != nil {
+		return Manifest{}, fmt.Errorf("failed to get feature flags: %w", err)
+	}
+	for _, flag := range flags {
+		manifest.FeatureFlags = append(manifest.FeatureFlags, FeatureFlag{Module: flag.Module, Name: flag.Name, Rules: flag.Rules})
+	}
+
+	instances, err := d.GetFSMInstances(ctx)
+	if err != nil {
+		return Manifest{}, fmt.Errorf("failed to get FSM instances: %w", err)
+	}
+	for _, instance := range instances {
+		backup := FSMInstance{FSM: instance.FSM.String(), Key: instance.Key, Status: string(instance.Status)}
+		if current, ok := instance.CurrentState.Get(); ok {
+			backup.CurrentState = current.String()
+		}
+		manifest.FSMInstances = append(manifest.FSMInstances, backup)
+	}
+
+	err = WriteArchive(w, manifest, func(digest sha256.SHA256) ([]byte, error) {
+		artefact := artefacts[digest]
+		defer artefact.Content.Close()
+		return io.ReadAll(artefact.Content)
+	})
+	if err != nil {
+		return Manifest{}, err
+	}
+	return manifest, nil
+}
+
+// Restore restores a backup written by [Export] from "r".
+//
+// Deployments are recreated and replace the active deployments of their
+// modules, configuration and feature flags are overwritten, and FSM instances
+// that don't already exist are created in the last state they reached.
+// Restoring the same backup again is a no-op.
+func Restore(ctx context.Context, d *dal.DAL, configDAL *cfdal.DAL, r io.Reader) (Manifest, error) {
+	logger := log.FromContext(ctx)
+	manifest, artefacts, err := ReadArchive(r)
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	digests := make([]sha256.SHA256, 0, len(artefacts))
+	for digest := range artefacts {
+		digests = append(digests, digest)
+	}
+	missing, err := d.GetMissingArtefacts(ctx, digests)
+	if err != nil {
+		return Manifest{}, fmt.Errorf("failed to check for existing artefacts: %w", err)
+	}
+	for _, digest := range missing {
+		if _, err := d.CreateArtefact(ctx, artefacts[digest]); err != nil {
+			return Manifest{}, fmt.Errorf("failed to restore artefact %s: %w", digest, err)
+		}
+	}
+
+	for _, deployment := range manifest.Deployments {
+		key, err := restoreDeployment(ctx, d, deployment)
+		if err != nil {
+			return Manifest{}, fmt.Errorf("%s: %w", deployment.Key, err)
+		}
+		logger.Debugf("Restored %s as %s", deployment.Key, key)
+	}
+
+	for _, config := range manifest.Configuration {
+		module := optional.Zero(config.Module)
+		if err := configDAL.SetModuleConfiguration(ctx, module, config.Name, config.Value); err != nil {
+			return Manifest{}, fmt.Errorf("failed to restore configuration %s: %w", config.Name, err)
+		}
+	}
+
+	for _, flag := range manifest.FeatureFlags {
+		if err := configDAL.SetModuleFeatureFlag(ctx, flag.Module, flag.Name, flag.Rules); err != nil {
+			return Manifest{}, fmt.Errorf("failed to restore feature flag %s.%s: %w", flag.Module, flag.Name, err)
+		}
+	}
+
+	for _, instance := range manifest.FSMInstances {
+		state, err := fsmInstanceState(instance)
+		if err != nil {
+			return Manifest{}, err
+		}
+		if err := d.RestoreFSMInstance(ctx, state); err != nil {
+			return Manifest{}, fmt.Errorf("failed to restore FSM instance %s %s: %w", instance.FSM, instance.Key, err)
+		}
+	}
+	return manifest, nil
+}
+
+// restoreDeployment creates a deployment from a backup and activates it,
+// returning its key.
+//
+// The deployment created is reused if the same deployment was already
+// restored.
+func restoreDeployment(ctx context.Context, d *dal.DAL, deployment Deployment) (model.DeploymentKey, error) {
+	pb := &schemapb.Module{}
+	if err := protojson.Unmarshal(deployment.Schema, pb); err != nil {
+		return model.DeploymentKey{}, fmt.Errorf("invalid schema: %w", err)
+	}
+	module, err := schema.ModuleFromProto(pb)
+	if err != nil {
+		return model.DeploymentKey{}, fmt.Errorf("invalid schema: %w", err)
+	}
+	cronJobs, err := cronjobs.NewCronJobs(pb, time.Now().UTC())
+	if err != nil {
+		return model.DeploymentKey{}, fmt.Errorf("could not generate cron jobs: %w", err)
+	}
+	artefacts := slices.Map(deployment.Artefacts, func(a Artefact) dal.DeploymentArtefact {
+		return dal.DeploymentArtefact{Digest: a.Digest, Path: a.Path, Executable: a.Executable}
+	})
+	key, err := d.CreateDeployment(ctx, deployment.Language, module, deployment.Labels, artefacts, ingress.RoutingEntries(pb), cronJobs)
+	if err != nil {
+		return model.DeploymentKey{}, fmt.Errorf("could not create deployment: %w", err)
+	}
+	if deployment.MinReplicas == 0 {
+		return key, nil
+	}
+	err = d.ReplaceDeployment(ctx, key, deployment.MinReplicas)
+	if err != nil && !errors.Is(err, dalerrs.ErrConflict) {
+		return model.DeploymentKey{}, fmt.Errorf("could not activate deployment: %w", err)
+	}
+	return key, nil
+}
+
+func fsmInstanceState(instance FSMInstance) (dal.FSMInstanceState, error) {
+	fsm, err := schema.ParseRef(instance.FSM)
+	if err != nil {
+		return dal.FSMInstanceState{}, fmt.Errorf("invalid FSM %q: %w", instance.FSM, err)
+	}
+	state := dal.FSMInstanceState{FSM: fsm.ToRefKey(), Key: instance.Key, Status: dal.FSMStatus(instance.Status)}
+	if instance.CurrentState != "" {
+		current, err := schema.ParseRef(instance.CurrentState)
+		if err != nil {
+			return dal.FSMInstanceState{}, fmt.Errorf("invalid state %q of FSM instance %s %s: %w", instance.CurrentState, instance.FSM, instance.Key, err)
+		}
+		state.CurrentState = optional.Some(current.ToRefKey())
+	}
+	return state, nil
+}
@@ -0,0 +1,132 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/types/either"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	"github.com/TBD54566975/ftl/backend/controller/ingress"
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/db/dalerrs"
+	"github.com/TBD54566975/ftl/internal/rpc"
+	"github.com/TBD54566975/ftl/internal/rpc/headers"
+	"github.com/TBD54566975/ftl/internal/slices"
+)
+
+// CallAsync enqueues a call to a verb made with ftl.CallAsync.
+//
+// The call is executed by the async call executor like any other async call,
+// retrying according to the requested retry policy or that of the verb. Calls
+// that exhaust their retries are kept in the error state.
+func (s *Service) CallAsync(ctx context.Context, req *connect.Request[ftlv1.CallAsyncRequest]) (*connect.Response[ftlv1.CallAsyncResponse], error) {
+	if req.Msg.Verb == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("verb is required"))
+	}
+	verbRef := schema.RefFromProto(req.Msg.Verb)
+	if err := s.config.Load().CallPayload.check(verbRef, PayloadRequest, len(req.Msg.Body)); err != nil {
+		return nil, err
+	}
+	callers, err := headers.GetCallers(req.Header())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if len(callers) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("async calls must be made by a verb"))
+	}
+
+	sch, err := s.getActiveSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	verb := &schema.Verb{}
+	if err := sch.ResolveToType(verbRef, verb); err != nil {
+		if errors.Is(err, schema.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, err
+	}
+	if verb.IsStream() {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("verb %q streams its responses and can not be called asynchronously", verbRef))
+	}
+	if err := ingress.ValidateCallBody(req.Msg.Body, verb, sch); err != nil {
+		return nil, err
+	}
+	if err := s.checkCallers(ctx, verbRef, verb, callers); err != nil {
+		return nil, err
+	}
+	retryParams, err := asyncCallRetryParams(verb, req.Msg.Retry)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	requestKey, err := rpc.RequestKeyFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	caller := callers[len(callers)-1]
+	id, err := s.dal.CreateAsyncCall(ctx, caller.ToRefKey(), verbRef.ToRefKey(), req.Msg.Body, retryParams, requestKey)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&ftlv1.CallAsyncResponse{Id: id}), nil
+}
+
+// asyncCallRetryParams returns the retry policy of an async call to "verb".
+//
+// A policy requested by the caller takes precedence over the +retry metadata of
+// the verb. Calls to verbs without either are not retried.
+func asyncCallRetryParams(verb *schema.Verb, retry *ftlv1.CallAsyncRequest_Retry) (schema.RetryParams, error) {
+	if retry != nil {
+		if retry.Count < 0 {
+			return schema.RetryParams{}, fmt.Errorf("retry count must not be negative")
+		}
+		backoff := max(retry.MinBackoff.AsDuration(), schema.MinBackoffLimit)
+		if backoff > schema.MaxBackoffLimit {
+			return schema.RetryParams{}, fmt.Errorf("retry backoff can not be larger than %v", schema.MaxBackoffLimitStr)
+		}
+		return schema.RetryParams{Count: int(retry.Count), MinBackoff: backoff, MaxBackoff: schema.MaxBackoffLimit}, nil
+	}
+	if md, ok := slices.FindVariant[*schema.MetadataRetry](verb.Metadata); ok {
+		return md.RetryParams()
+	}
+	return schema.RetryParams{}, nil
+}
+
+// GetAsyncCallResult returns the result of a call made with ftl.CallAsync.
+//
+// Results can only be retrieved by verbs in the module that made the call.
+func (s *Service) GetAsyncCallResult(ctx context.Context, req *connect.Request[ftlv1.GetAsyncCallResultRequest]) (*connect.Response[ftlv1.GetAsyncCallResultResponse], error) {
+	callers, err := headers.GetCallers(req.Header())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if len(callers) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("async call results must be retrieved by a verb"))
+	}
+	notFound := connect.NewError(connect.CodeNotFound, fmt.Errorf("async call %d not found", req.Msg.Id))
+	origin, result, err := s.dal.GetAsyncCallResult(ctx, req.Msg.Id)
+	if errors.Is(err, dalerrs.ErrNotFound) {
+		return nil, notFound
+	} else if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	// Calls made by other modules, or by FSMs and subscriptions, are not visible.
+	if origin, ok := origin.(dal.AsyncOriginCall); !ok || origin.Caller.Module != callers[len(callers)-1].Module {
+		return nil, notFound
+	}
+	resp := &ftlv1.GetAsyncCallResultResponse{}
+	if result, ok := result.Get(); ok {
+		switch result := result.(type) {
+		case either.Left[[]byte, string]:
+			resp.Result = &ftlv1.GetAsyncCallResultResponse_Body{Body: result.Get()}
+		case either.Right[[]byte, string]:
+			resp.Result = &ftlv1.GetAsyncCallResultResponse_Error{Error: result.Get()}
+		}
+	}
+	return connect.NewResponse(resp), nil
+}
@@ -0,0 +1,40 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+func TestAsyncCallRetryParams(t *testing.T) {
+	count := 3
+	verb := &schema.Verb{Name: "echo", Metadata: []schema.Metadata{&schema.MetadataRetry{Count: &count, MinBackoff: "5s", MaxBackoff: "1m"}}}
+
+	params, err := asyncCallRetryParams(&schema.Verb{Name: "echo"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, schema.RetryParams{}, params)
+
+	params, err = asyncCallRetryParams(verb, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, schema.RetryParams{Count: 3, MinBackoff: 5 * time.Second, MaxBackoff: time.Minute}, params)
+
+	params, err = asyncCallRetryParams(verb, &ftlv1.CallAsyncRequest_Retry{Count: 10, MinBackoff: durationpb.New(time.Minute)})
+	assert.NoError(t, err)
+	assert.Equal(t, schema.RetryParams{Count: 10, MinBackoff: time.Minute, MaxBackoff: schema.MaxBackoffLimit}, params)
+
+	// Backoff is raised to the minimum.
+	params, err = asyncCallRetryParams(verb, &ftlv1.CallAsyncRequest_Retry{Count: 0})
+	assert.NoError(t, err)
+	assert.Equal(t, schema.RetryParams{MinBackoff: schema.MinBackoffLimit, MaxBackoff: schema.MaxBackoffLimit}, params)
+
+	_, err = asyncCallRetryParams(verb, &ftlv1.CallAsyncRequest_Retry{Count: -1})
+	assert.EqualError(t, err, "retry count must not be negative")
+
+	_, err = asyncCallRetryParams(verb, &ftlv1.CallAsyncRequest_Retry{Count: 1, MinBackoff: durationpb.New(48 * time.Hour)})
+	assert.EqualError(t, err, "retry backoff can not be larger than 1d")
+}
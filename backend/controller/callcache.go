@@ -0,0 +1,106 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/jellydator/ttlcache/v3"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/protobuf/proto"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/sha256"
+)
+
+var (
+	callCacheHits   = newCallCacheCounter("ftl.call.cache.hits", "number of verb calls served from the controller's cache")
+	callCacheMisses = newCallCacheCounter("ftl.call.cache.misses", "number of calls to cacheable verbs that were not in the controller's cache")
+)
+
+func newCallCacheCounter(name, description string) metric.Int64Counter {
+	counter, err := otel.GetMeterProvider().Meter("ftl.controller").Int64Counter(name,
+		metric.WithDescription(description),
+		metric.WithUnit("{count}"))
+	if err != nil {
+		panic(err)
+	}
+	return counter
+}
+
+// callCache caches the responses of verbs with +cache metadata.
+//
+// Error responses are never cached.
+type callCache struct {
+	cache *ttlcache.Cache[string, *ftlv1.CallResponse]
+}
+
+func newCallCache(capacity uint64) *callCache {
+	return &callCache{cache: ttlcache.New(
+		ttlcache.WithCapacity[string, *ftlv1.CallResponse](capacity),
+		ttlcache.WithDisableTouchOnHit[string, *ftlv1.CallResponse](),
+	)}
+}
+
+// key returns the cache key of a call to "ref" with "body", which is the
+// request JSON or, if the verb varies its cache on request fields, the values
+// of those fields.
+func (c *callCache) key(ref *schema.Ref, cache *schema.MetadataCache, body []byte) (string, error) {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var request any
+	if err := dec.Decode(&request); err != nil {
+		return "", fmt.Errorf("invalid request body: %w", err)
+	}
+	if len(cache.Vary) > 0 {
+		fields, _ := request.(map[string]any)
+		values := make([]any, len(cache.Vary))
+		for i, field := range cache.Vary {
+			values[i] = fields[field]
+		}
+		request = values
+	}
+	// Re-encoding sorts object keys, so that equivalent requests share a key.
+	canonical, err := json.Marshal(request)
+	if err != nil {
+		return "", err
+	}
+	return ref.String() + ":" + sha256.Sum(canonical).String(), nil
+}
+
+func (c *callCache) get(ctx context.Context, ref *schema.Ref, key string) (*ftlv1.CallResponse, bool) {
+	attrs := metric.WithAttributes(attribute.String("ftl.verb.ref", ref.String()))
+	item := c.cache.Get(key)
+	if item == nil {
+		callCacheMisses.Add(ctx, 1, attrs)
+		return nil, false
+	}
+	callCacheHits.Add(ctx, 1, attrs)
+	return proto.Clone(item.Value()).(*ftlv1.CallResponse), true //nolint:forcetypeassert
+}
+
+func (c *callCache) set(key string, cache *schema.MetadataCache, response *ftlv1.CallResponse) {
+	if response.GetError() != nil {
+		return
+	}
+	ttl, err := cache.TTLDuration()
+	if err != nil {
+		return
+	}
+	c.cache.Set(key, proto.Clone(response).(*ftlv1.CallResponse), ttl) //nolint:forcetypeassert
+}
+
+// invalidate drops the cached responses of the verbs of "module", eg. when
+// it is redeployed.
+func (c *callCache) invalidate(module string) {
+	for _, key := range c.cache.Keys() {
+		if strings.HasPrefix(key, module+".") {
+			c.cache.Delete(key)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+func TestCallCache(t *testing.T) {
+	ctx := context.Background()
+	c := newCallCache(10)
+	ref := &schema.Ref{Module: "users", Name: "get"}
+	cache := &schema.MetadataCache{TTL: "1m"}
+
+	key, err := c.key(ref, cache, []byte(`{"id": 1, "region": "au"}`))
+	assert.NoError(t, err)
+	equivalent, err := c.key(ref, cache, []byte(`{"region":"au","id":1}`))
+	assert.NoError(t, err)
+	assert.Equal(t, key, equivalent)
+	other, err := c.key(ref, cache, []byte(`{"id": 2, "region": "au"}`))
+	assert.NoError(t, err)
+	assert.NotEqual(t, key, other)
+
+	vary := &schema.MetadataCache{TTL: "1m", Vary: []string{"id"}}
+	varyKey, err := c.key(ref, vary, []byte(`{"id": 1, "region": "au"}`))
+	assert.NoError(t, err)
+	varyEquivalent, err := c.key(ref, vary, []byte(`{"id": 1, "region": "us"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, varyKey, varyEquivalent)
+
+	_, ok := c.get(ctx, ref, key)
+	assert.False(t, ok)
+	c.set(key, cache, &ftlv1.CallResponse{Response: &ftlv1.CallResponse_Body{Body: []byte(`{"name":"alice"}`)}})
+	resp, ok := c.get(ctx, ref, key)
+	assert.True(t, ok)
+	assert.Equal(t, []byte(`{"name":"alice"}`), resp.GetBody())
+
+	c.set(other, cache, &ftlv1.CallResponse{Response: &ftlv1.CallResponse_Error_{Error: &ftlv1.CallResponse_Error{Message: "not found"}}})
+	_, ok = c.get(ctx, ref, other)
+	assert.False(t, ok, "error responses should not be cached")
+
+	c.invalidate("orders")
+	_, ok = c.get(ctx, ref, key)
+	assert.True(t, ok)
+	c.invalidate("users")
+	_, ok = c.get(ctx, ref, key)
+	assert.False(t, ok)
+}
@@ -0,0 +1,90 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+// CallPayloadConfig limits the size of the bodies of verb calls routed through
+// the controller, so that oversized payloads are rejected before they reach
+// a runner.
+type CallPayloadConfig struct {
+	MaxRequestBytes  int            `help:"Maximum size in bytes of verb request bodies (0 for no limit)." default:"0" env:"FTL_CONTROLLER_CALL_PAYLOAD_MAX_REQUEST_BYTES"`
+	MaxResponseBytes int            `help:"Maximum size in bytes of verb response bodies (0 for no limit)." default:"0" env:"FTL_CONTROLLER_CALL_PAYLOAD_MAX_RESPONSE_BYTES"`
+	VerbLimits       map[string]int `help:"Per-verb overrides of both limits." mapsep:"," env:"FTL_CONTROLLER_CALL_PAYLOAD_VERB_LIMITS" placeholder:"MODULE.VERB=BYTES,…"`
+}
+
+// PayloadKind is the body of a call that a [PayloadTooLargeError] refers to.
+type PayloadKind string
+
+const (
+	PayloadRequest  PayloadKind = "request"
+	PayloadResponse PayloadKind = "response"
+)
+
+// PayloadTooLargeError is returned, wrapped in a [connect.Error] with
+// [connect.CodeResourceExhausted], for calls whose request or response body
+// exceeds the limit for their verb.
+type PayloadTooLargeError struct {
+	Verb  schema.Ref
+	Kind  PayloadKind
+	Size  int
+	Limit int
+}
+
+func (e *PayloadTooLargeError) Error() string {
+	return fmt.Sprintf("%s to %s is %d bytes, exceeding the limit of %d bytes", e.Kind, &e.Verb, e.Size, e.Limit)
+}
+
+// limit returns the maximum size of "kind" bodies of calls to "verb", or 0 if
+// there is no limit.
+func (c CallPayloadConfig) limit(verb *schema.Ref, kind PayloadKind) int {
+	if limit, ok := c.VerbLimits[verb.Module+"."+verb.Name]; ok {
+		return limit
+	}
+	if kind == PayloadRequest {
+		return c.MaxRequestBytes
+	}
+	return c.MaxResponseBytes
+}
+
+// check returns a [PayloadTooLargeError] if a "kind" body of "size" bytes
+// exceeds the limit for "verb".
+func (c CallPayloadConfig) check(verb *schema.Ref, kind PayloadKind, size int) error {
+	if limit := c.limit(verb, kind); limit > 0 && size > limit {
+		return connect.NewError(connect.CodeResourceExhausted, &PayloadTooLargeError{Verb: *verb, Kind: kind, Size: size, Limit: limit})
+	}
+	return nil
+}
+
+var (
+	callRequestSize  = newCallSizeHistogram("ftl.call.request.size", "size of verb request bodies")
+	callResponseSize = newCallSizeHistogram("ftl.call.response.size", "size of verb response bodies")
+)
+
+func newCallSizeHistogram(name, description string) metric.Int64Histogram {
+	histogram, err := otel.GetMeterProvider().Meter("ftl.controller").Int64Histogram(name,
+		metric.WithDescription(description),
+		metric.WithUnit("By"),
+		metric.WithExplicitBucketBoundaries(256, 1024, 4096, 16384, 65536, 262144, 1048576, 4194304, 16777216))
+	if err != nil {
+		panic(err)
+	}
+	return histogram
+}
+
+// recordCallSizes records the sizes of the bodies of a call to "verb".
+func recordCallSizes(ctx context.Context, verb *schema.Ref, requestSize, responseSize int64, hasResponse bool) {
+	attrs := metric.WithAttributes(attribute.String("ftl.verb.ref", verb.String()))
+	callRequestSize.Record(ctx, requestSize, attrs)
+	if hasResponse {
+		callResponseSize.Record(ctx, responseSize, attrs)
+	}
+}
@@ -0,0 +1,37 @@
+package controller
+
+import (
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+func TestCallPayloadLimits(t *testing.T) {
+	config := CallPayloadConfig{
+		MaxRequestBytes:  10,
+		MaxResponseBytes: 100,
+		VerbLimits:       map[string]int{"echo.upload": 1000},
+	}
+	echo := &schema.Ref{Module: "echo", Name: "echo"}
+	upload := &schema.Ref{Module: "echo", Name: "upload"}
+
+	assert.NoError(t, config.check(echo, PayloadRequest, 10))
+	assert.NoError(t, config.check(echo, PayloadResponse, 100))
+	assert.NoError(t, config.check(upload, PayloadRequest, 1000))
+
+	err := config.check(echo, PayloadRequest, 11)
+	assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+	var tooLarge *PayloadTooLargeError
+	assert.True(t, errors.As(err, &tooLarge))
+	assert.Equal(t, PayloadTooLargeError{Verb: *echo, Kind: PayloadRequest, Size: 11, Limit: 10}, *tooLarge)
+	assert.EqualError(t, tooLarge, "request to echo.echo is 11 bytes, exceeding the limit of 10 bytes")
+
+	assert.Error(t, config.check(echo, PayloadResponse, 101))
+	assert.Error(t, config.check(upload, PayloadResponse, 1001))
+
+	assert.NoError(t, CallPayloadConfig{}.check(echo, PayloadRequest, 1<<30), "zero limits should be unlimited")
+}
@@ -0,0 +1,139 @@
+// Package coldstorage moves the content of artefacts that are only used by
+// inactive deployments out of the controller database to a cheaper blob
+// store, and fetches it again when those artefacts are read.
+//
+// Digests and the rest of the artefact metadata stay in the database.
+package coldstorage
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/alecthomas/types/optional"
+	"github.com/jpillora/backoff"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/TBD54566975/ftl/backend/controller/scheduledtask"
+	"github.com/TBD54566975/ftl/internal/compression"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/sha256"
+)
+
+// Maximum number of artefacts moved to cold storage at once.
+const batchSize = 10
+
+type Config struct {
+	URL   *url.URL      `help:"Move the artefacts of inactive deployments to S3 (s3://BUCKET/PREFIX), GCS (gs://BUCKET/PREFIX) or a directory (file:///DIR)." env:"FTL_CONTROLLER_COLD_STORAGE_URL" placeholder:"URL"`
+	After time.Duration `help:"Time a deployment must be inactive for before its artefacts are moved to cold storage." default:"720h" env:"FTL_CONTROLLER_COLD_STORAGE_AFTER"`
+}
+
+type DAL interface {
+	GetInactiveArtefacts(ctx context.Context, inactiveSince time.Time, limit int) ([]sha256.SHA256, error)
+	GetArtefactContent(ctx context.Context, digest sha256.SHA256) ([]byte, error)
+	MoveArtefactToColdStorage(ctx context.Context, digest sha256.SHA256, compression optional.Option[string]) error
+}
+
+type Scheduler interface {
+	Singleton(retry backoff.Backoff, job scheduledtask.Job)
+}
+
+var artefactsMoved = func() metric.Int64Counter {
+	counter, err := otel.GetMeterProvider().Meter("ftl.controller").Int64Counter("ftl.artefacts.cold_storage.moved",
+		metric.WithDescription("number of artefacts moved to cold storage"),
+		metric.WithUnit("{count}"))
+	if err != nil {
+		panic(err)
+	}
+	return counter
+}()
+
+var bytesMoved = func() metric.Int64Counter {
+	counter, err := otel.GetMeterProvider().Meter("ftl.controller").Int64Counter("ftl.artefacts.cold_storage.moved_bytes",
+		metric.WithDescription("size of the artefacts moved to cold storage"),
+		metric.WithUnit("By"))
+	if err != nil {
+		panic(err)
+	}
+	return counter
+}()
+
+var artefactsFetched = func() metric.Int64Counter {
+	counter, err := otel.GetMeterProvider().Meter("ftl.controller").Int64Counter("ftl.artefacts.cold_storage.fetches",
+		metric.WithDescription("number of artefacts fetched from cold storage"),
+		metric.WithUnit("{count}"))
+	if err != nil {
+		panic(err)
+	}
+	return counter
+}()
+
+// Tierer periodically moves the artefacts of inactive deployments to a
+// [Store], and fetches them from it on demand.
+type Tierer struct {
+	dal   DAL
+	store Store
+	after time.Duration
+}
+
+// New creates a [Tierer] storing artefacts at config.URL and schedules it to
+// run on a single controller.
+func New(ctx context.Context, config Config, dal DAL, scheduler Scheduler) (*Tierer, error) {
+	store, err := NewStore(ctx, config.URL)
+	if err != nil {
+		return nil, err
+	}
+	t := &Tierer{dal: dal, store: store, after: config.After}
+	scheduler.Singleton(backoff.Backoff{
+		Min:    time.Second * 10,
+		Max:    time.Minute * 5,
+		Jitter: true,
+		Factor: 2,
+	}, t.tier)
+	log.FromContext(ctx).Debugf("Moving artefacts inactive for %s to %s", config.After, config.URL.Redacted())
+	return t, nil
+}
+
+// Get fetches the content of an artefact from cold storage.
+func (t *Tierer) Get(ctx context.Context, digest sha256.SHA256) ([]byte, error) {
+	content, err := t.store.Get(ctx, digest)
+	artefactsFetched.Add(ctx, 1, metric.WithAttributes(attribute.Bool("ftl.failed", err != nil)))
+	return content, err
+}
+
+func (t *Tierer) tier(ctx context.Context) (time.Duration, error) {
+	logger := log.FromContext(ctx)
+	digests, err := t.dal.GetInactiveArtefacts(ctx, time.Now().Add(-t.after), batchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get inactive artefacts: %w", err)
+	}
+	for _, digest := range digests {
+		content, err := t.dal.GetArtefactContent(ctx, digest)
+		if err != nil {
+			return 0, fmt.Errorf("failed to read artefact %s: %w", digest, err)
+		}
+		stored, contentCompression := content, optional.None[string]()
+		if compressed, ok := compression.CompressIfSmaller(content); ok {
+			stored, contentCompression = compressed, optional.Some(compression.Zstd)
+		}
+		// The content is only removed from the database once it is safely in
+		// the store, so a failure part way through leaves the artefact intact.
+		if err := t.store.Put(ctx, digest, stored); err != nil {
+			return 0, err
+		}
+		if err := t.dal.MoveArtefactToColdStorage(ctx, digest, contentCompression); err != nil {
+			return 0, fmt.Errorf("failed to move artefact %s to cold storage: %w", digest, err)
+		}
+		artefactsMoved.Add(ctx, 1)
+		bytesMoved.Add(ctx, int64(len(stored)))
+		logger.Debugf("Moved artefact %s (%d bytes, %d stored) to cold storage", digest, len(content), len(stored))
+	}
+	if len(digests) == batchSize {
+		// There are likely more artefacts waiting.
+		return 0, nil
+	}
+	return time.Hour, nil
+}
@@ -0,0 +1,107 @@
+package coldstorage
+
+import (
+	"bytes"
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/internal/compression"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/sha256"
+)
+
+type fakeDAL struct {
+	// Content of the artefacts in the database, by digest.
+	artefacts map[sha256.SHA256][]byte
+	inactive  []sha256.SHA256
+	// Compression of the artefacts moved to cold storage, by digest.
+	compression map[sha256.SHA256]optional.Option[string]
+}
+
+func (f *fakeDAL) GetInactiveArtefacts(ctx context.Context, inactiveSince time.Time, limit int) ([]sha256.SHA256, error) {
+	if len(f.inactive) > limit {
+		return f.inactive[:limit], nil
+	}
+	return f.inactive, nil
+}
+
+func (f *fakeDAL) GetArtefactContent(ctx context.Context, digest sha256.SHA256) ([]byte, error) {
+	return f.artefacts[digest], nil
+}
+
+func (f *fakeDAL) MoveArtefactToColdStorage(ctx context.Context, digest sha256.SHA256, compression optional.Option[string]) error {
+	delete(f.artefacts, digest)
+	f.compression[digest] = compression
+	for i, inactive := range f.inactive {
+		if inactive == digest {
+			f.inactive = append(f.inactive[:i], f.inactive[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+func TestTier(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	old := []byte("old")
+	large := bytes.Repeat([]byte("large "), 1024)
+	current := []byte("current")
+	dal := &fakeDAL{
+		artefacts:   map[sha256.SHA256][]byte{sha256.Sum(old): old, sha256.Sum(large): large, sha256.Sum(current): current},
+		inactive:    []sha256.SHA256{sha256.Sum(old), sha256.Sum(large)},
+		compression: map[sha256.SHA256]optional.Option[string]{},
+	}
+	store, err := NewStore(ctx, &url.URL{Scheme: "file", Path: t.TempDir()})
+	assert.NoError(t, err)
+	tierer := &Tierer{dal: dal, store: store, after: time.Hour}
+
+	next, err := tierer.tier(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Hour, next)
+	assert.Equal(t, map[sha256.SHA256][]byte{sha256.Sum(current): current}, dal.artefacts)
+
+	content, err := tierer.Get(ctx, sha256.Sum(old))
+	assert.NoError(t, err)
+	assert.Equal(t, old, content)
+	assert.Equal(t, optional.None[string](), dal.compression[sha256.Sum(old)], "artefacts that don't compress are stored as is")
+
+	content, err = tierer.Get(ctx, sha256.Sum(large))
+	assert.NoError(t, err)
+	assert.Equal(t, optional.Some(compression.Zstd), dal.compression[sha256.Sum(large)])
+	content, err = compression.Decompress(content)
+	assert.NoError(t, err)
+	assert.Equal(t, large, content)
+
+	_, err = tierer.Get(ctx, sha256.Sum(current))
+	assert.Error(t, err)
+}
+
+func TestNewStore(t *testing.T) {
+	ctx := context.Background()
+	_, err := NewStore(ctx, &url.URL{Scheme: "ftp", Host: "example.com"})
+	assert.EqualError(t, err, `unsupported cold storage URL "ftp://example.com", expected s3://, gs:// or file://`)
+
+	_, err = NewStore(ctx, &url.URL{Scheme: "file"})
+	assert.EqualError(t, err, `cold storage URL "file:" has no directory`)
+
+	u, err := url.Parse("s3://artefacts/ftl?region=eu-west-1")
+	assert.NoError(t, err)
+	store, err := NewStore(ctx, u)
+	assert.NoError(t, err)
+	s3 := store.(*s3Store) //nolint:forcetypeassert
+	assert.Equal(t, "https://artefacts.s3.eu-west-1.amazonaws.com", s3.bucket.String())
+	assert.Equal(t, "ftl", s3.prefix)
+
+	u, err = url.Parse("gs://artefacts")
+	assert.NoError(t, err)
+	store, err = NewStore(ctx, u)
+	assert.NoError(t, err)
+	gcs := store.(*s3Store) //nolint:forcetypeassert
+	assert.Equal(t, "https://storage.googleapis.com/artefacts", gcs.bucket.String())
+	assert.Equal(t, "auto", gcs.region)
+}
@@ -0,0 +1,196 @@
+package coldstorage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/config"
+
+	"github.com/TBD54566975/ftl/internal/sha256"
+)
+
+// A Store holds the content of artefacts by digest.
+type Store interface {
+	// Put stores the content of an artefact, replacing it if it already exists.
+	Put(ctx context.Context, digest sha256.SHA256, content []byte) error
+	Get(ctx context.Context, digest sha256.SHA256) ([]byte, error)
+}
+
+// NewStore creates a [Store] for the URL "u", selected by its scheme.
+//
+//   - s3://BUCKET/PREFIX stores artefacts in an S3 bucket, using the default
+//     AWS credentials. The "region" and "endpoint" query parameters select
+//     the region and an S3 compatible endpoint.
+//   - gs://BUCKET/PREFIX stores artefacts in a GCS bucket through its
+//     S3 compatible API, using HMAC keys in the AWS credential variables.
+//   - file:///DIR stores artefacts in a directory, such as a mounted bucket.
+func NewStore(ctx context.Context, u *url.URL) (Store, error) {
+	switch u.Scheme {
+	case "s3", "gs":
+		return newS3Store(ctx, u)
+	case "file":
+		if u.Path == "" {
+			return nil, fmt.Errorf("cold storage URL %q has no directory", u.Redacted())
+		}
+		return &dirStore{dir: u.Path}, nil
+	default:
+		return nil, fmt.Errorf("unsupported cold storage URL %q, expected s3://, gs:// or file://", u.Redacted())
+	}
+}
+
+type dirStore struct {
+	dir string
+}
+
+func (d *dirStore) Put(ctx context.Context, digest sha256.SHA256, content []byte) error {
+	if err := os.MkdirAll(d.dir, 0700); err != nil {
+		return fmt.Errorf("failed to create cold storage directory: %w", err)
+	}
+	// Write to a temporary file first so that partially written artefacts are
+	// never read.
+	tmp, err := os.CreateTemp(d.dir, digest.String()+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to store artefact %s: %w", digest, err)
+	}
+	defer os.Remove(tmp.Name()) //nolint:errcheck
+	if _, err := tmp.Write(content); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to store artefact %s: %w", digest, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to store artefact %s: %w", digest, err)
+	}
+	if err := os.Rename(tmp.Name(), filepath.Join(d.dir, digest.String())); err != nil {
+		return fmt.Errorf("failed to store artefact %s: %w", digest, err)
+	}
+	return nil
+}
+
+func (d *dirStore) Get(ctx context.Context, digest sha256.SHA256) ([]byte, error) {
+	content, err := os.ReadFile(filepath.Join(d.dir, digest.String()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read artefact %s: %w", digest, err)
+	}
+	return content, nil
+}
+
+// s3Store stores artefacts in a bucket through the S3 REST API.
+type s3Store struct {
+	client      *http.Client
+	credentials aws.CredentialsProvider
+	signer      *v4.Signer
+	region      string
+	// Base URL of the bucket.
+	bucket *url.URL
+	prefix string
+}
+
+func newS3Store(ctx context.Context, u *url.URL) (*s3Store, error) {
+	if u.Host == "" {
+		return nil, fmt.Errorf("cold storage URL %q has no bucket", u.Redacted())
+	}
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS configuration: %w", err)
+	}
+	region := u.Query().Get("region")
+	if region == "" {
+		region = cfg.Region
+	}
+	endpoint := u.Query().Get("endpoint")
+	switch {
+	case u.Scheme == "gs":
+		region = "auto"
+		endpoint = "https://storage.googleapis.com"
+	case region == "":
+		region = "us-east-1"
+	}
+	var bucket *url.URL
+	if endpoint != "" {
+		// Path style, which S3 compatible stores support.
+		bucket, err = url.Parse(strings.TrimSuffix(endpoint, "/") + "/" + u.Host)
+	} else {
+		bucket, err = url.Parse(fmt.Sprintf("https://%s.s3.%s.amazonaws.com", u.Host, region))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid cold storage endpoint %q: %w", endpoint, err)
+	}
+	return &s3Store{
+		client:      &http.Client{Timeout: time.Minute * 5},
+		credentials: cfg.Credentials,
+		signer:      v4.NewSigner(),
+		region:      region,
+		bucket:      bucket,
+		prefix:      strings.Trim(u.Path, "/"),
+	}, nil
+}
+
+func (s *s3Store) Put(ctx context.Context, digest sha256.SHA256, content []byte) error {
+	resp, err := s.do(ctx, http.MethodPut, digest, content)
+	if err != nil {
+		return fmt.Errorf("failed to store artefact %s: %w", digest, err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body) //nolint:errcheck
+	return nil
+}
+
+func (s *s3Store) Get(ctx context.Context, digest sha256.SHA256) ([]byte, error) {
+	resp, err := s.do(ctx, http.MethodGet, digest, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read artefact %s: %w", digest, err)
+	}
+	defer resp.Body.Close()
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read artefact %s: %w", digest, err)
+	}
+	if sha256.Sum(content) != digest {
+		return nil, fmt.Errorf("artefact %s in cold storage is corrupt", digest)
+	}
+	return content, nil
+}
+
+// do sends a signed request for the object of an artefact, returning an
+// error if it does not succeed.
+func (s *s3Store) do(ctx context.Context, method string, digest sha256.SHA256, body []byte) (*http.Response, error) {
+	object := digest.String()
+	if s.prefix != "" {
+		object = s.prefix + "/" + object
+	}
+	req, err := http.NewRequestWithContext(ctx, method, s.bucket.JoinPath(object).String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	credentials, err := s.credentials.Retrieve(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve credentials: %w", err)
+	}
+	payloadHash := sha256.Sum(body).String()
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	err = s.signer.SignHTTP(ctx, credentials, req, payloadHash, "s3", s.region, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign request: %w", err)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode/100 != 2 {
+		defer resp.Body.Close()
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024)) //nolint:errcheck
+		return nil, errors.New(resp.Status + ": " + strings.TrimSpace(string(message)))
+	}
+	return resp, nil
+}
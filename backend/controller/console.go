@@ -6,10 +6,12 @@ import (
 	"errors"
 	"fmt"
 	"github.com/reugn/go-quartz/logger"
+	"sort"
 	"time"
 
 	"connectrpc.com/connect"
 	"github.com/alecthomas/types/optional"
+	"golang.org/x/exp/maps"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -20,6 +22,7 @@ import (
 	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
 	"github.com/TBD54566975/ftl/backend/schema"
 	"github.com/TBD54566975/ftl/buildengine"
+	"github.com/TBD54566975/ftl/db/dalerrs"
 	"github.com/TBD54566975/ftl/internal/log"
 	"github.com/TBD54566975/ftl/internal/model"
 	"github.com/TBD54566975/ftl/internal/slices"
@@ -59,6 +62,17 @@ func visitNode(sch *schema.Schema, n schema.Node, verbString *string) error {
 	})
 }
 
+// egressToProto returns the external origins declared by the verbs of
+// "module", sorted by URL.
+func egressToProto(module *schema.Module) []*pbconsole.Egress {
+	egress := module.Egress()
+	urls := maps.Keys(egress)
+	sort.Strings(urls)
+	return slices.Map(urls, func(url string) *pbconsole.Egress {
+		return &pbconsole.Egress{Url: url, Verbs: egress[url]}
+	})
+}
+
 func verbSchemaString(sch *schema.Schema, verb *schema.Verb) (string, error) {
 	var verbString string
 	err := visitNode(sch, verb.Request, &verbString)
@@ -150,7 +164,8 @@ func (c *ConsoleService) GetModules(ctx context.Context, req *connect.Request[pb
 			}
 		}
 
-		modules = append(modules, &pbconsole.Module{
+		metadata := moduleMetadataFromLabels(deployment.Labels)
+		module := &pbconsole.Module{
 			Name:          deployment.Module,
 			DeploymentKey: deployment.Key.String(),
 			Language:      deployment.Language,
@@ -158,8 +173,18 @@ func (c *ConsoleService) GetModules(ctx context.Context, req *connect.Request[pb
 			Data:          data,
 			Secrets:       secrets,
 			Configs:       configs,
+			Egress:        egressToProto(deployment.Schema),
 			Schema:        deployment.Schema.String(),
-		})
+			Comments:      deployment.Schema.Comments,
+			Links:         metadata.Links,
+		}
+		if metadata.Owner != "" {
+			module.Owner = &metadata.Owner
+		}
+		if metadata.Readme != "" {
+			module.Readme = &metadata.Readme
+		}
+		modules = append(modules, module)
 	}
 
 	sorted, err := buildengine.TopologicalSort(graph(sch))
@@ -216,6 +241,207 @@ func (c *ConsoleService) GetEvents(ctx context.Context, req *connect.Request[pbc
 	return connect.NewResponse(response), nil
 }
 
+func (c *ConsoleService) GetErrorGroups(ctx context.Context, req *connect.Request[pbconsole.GetErrorGroupsRequest]) (*connect.Response[pbconsole.GetErrorGroupsResponse], error) {
+	// Default to the last day of failed calls.
+	since := time.Now().Add(-24 * time.Hour)
+	if req.Msg.Since != nil {
+		since = req.Msg.Since.AsTime()
+	}
+	groups, err := c.dal.GetCallErrorGroups(ctx, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get error groups: %w", err)
+	}
+	return connect.NewResponse(&pbconsole.GetErrorGroupsResponse{
+		Groups: slices.Map(groups, func(group dal.CallErrorGroup) *pbconsole.ErrorGroup {
+			return &pbconsole.ErrorGroup{
+				Fingerprint: group.Fingerprint,
+				Verb:        group.Verb.ToProto().(*schemapb.Ref), //nolint:forcetypeassert
+				Count:       group.Count,
+				FirstSeen:   timestamppb.New(group.FirstSeen),
+				LastSeen:    timestamppb.New(group.LastSeen),
+				Error:       group.Error,
+				Stack:       group.Stack.Ptr(),
+			}
+		}),
+	}), nil
+}
+
+func (c *ConsoleService) GetCallGraph(ctx context.Context, req *connect.Request[pbconsole.GetCallGraphRequest]) (*connect.Response[pbconsole.GetCallGraphResponse], error) {
+	// Default to the last hour of calls.
+	now := time.Now()
+	since := now.Add(-time.Hour)
+	if req.Msg.Since != nil {
+		since = req.Msg.Since.AsTime()
+	}
+	deployments, err := c.dal.GetDeploymentsWithMinReplicas(ctx)
+	if err != nil {
+		return nil, err
+	}
+	observed, err := c.dal.GetCallGraphEdges(ctx, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get call graph: %w", err)
+	}
+	graph := callGraph(deployments, observed, now.Sub(since))
+	graph.Since = timestamppb.New(since)
+	return connect.NewResponse(graph), nil
+}
+
+// callGraph merges the calls declared in the schemas of "deployments" with
+// the calls observed in the call log over "window".
+func callGraph(deployments []dal.Deployment, observed []dal.CallGraphEdge, window time.Duration) *pbconsole.GetCallGraphResponse {
+	type edgeKey struct{ source, destination string }
+	out := &pbconsole.GetCallGraphResponse{}
+	edges := map[edgeKey]*pbconsole.CallGraphEdge{}
+	for _, deployment := range deployments {
+		module := &pbconsole.CallGraphModule{Name: deployment.Module, DeploymentKey: deployment.Key.String()}
+		for _, decl := range deployment.Schema.Decls {
+			verb, ok := decl.(*schema.Verb)
+			if !ok {
+				continue
+			}
+			module.Verbs = append(module.Verbs, verb.Name)
+			source := schema.Ref{Module: deployment.Module, Name: verb.Name}
+			for _, md := range verb.Metadata {
+				calls, ok := md.(*schema.MetadataCalls)
+				if !ok {
+					continue
+				}
+				for _, call := range calls.Calls {
+					destination := schema.Ref{Module: call.Module, Name: call.Name}
+					edge := &pbconsole.CallGraphEdge{
+						Source:      &schemapb.Ref{Module: source.Module, Name: source.Name},
+						Destination: &schemapb.Ref{Module: destination.Module, Name: destination.Name},
+						Declared:    true,
+					}
+					edges[edgeKey{source.String(), destination.String()}] = edge
+					out.Edges = append(out.Edges, edge)
+				}
+			}
+		}
+		out.Modules = append(out.Modules, module)
+	}
+	for _, call := range observed {
+		key := edgeKey{destination: call.Destination.String()}
+		if source, ok := call.Source.Get(); ok {
+			key.source = source.String()
+		}
+		edge, ok := edges[key]
+		if !ok {
+			edge = &pbconsole.CallGraphEdge{Destination: &schemapb.Ref{Module: call.Destination.Module, Name: call.Destination.Name}}
+			if source, ok := call.Source.Get(); ok {
+				edge.Source = &schemapb.Ref{Module: source.Module, Name: source.Name}
+			}
+			out.Edges = append(out.Edges, edge)
+		}
+		edge.Calls = call.Calls
+		edge.Errors = call.Errors
+		if window > 0 {
+			edge.Rate = float64(call.Calls) / window.Seconds()
+		}
+		edge.AverageLatency = durationpb.New(call.AverageDuration)
+		edge.P95Latency = durationpb.New(call.P95Duration)
+		edge.MaxLatency = durationpb.New(call.MaxDuration)
+	}
+	sort.Slice(out.Modules, func(i, j int) bool { return out.Modules[i].Name < out.Modules[j].Name })
+	sort.SliceStable(out.Edges, func(i, j int) bool {
+		a, b := out.Edges[i], out.Edges[j]
+		if a.Source.GetModule() != b.Source.GetModule() {
+			return a.Source.GetModule() < b.Source.GetModule()
+		}
+		if a.Source.GetName() != b.Source.GetName() {
+			return a.Source.GetName() < b.Source.GetName()
+		}
+		if a.Destination.Module != b.Destination.Module {
+			return a.Destination.Module < b.Destination.Module
+		}
+		return a.Destination.Name < b.Destination.Name
+	})
+	return out
+}
+
+func (c *ConsoleService) GetRequestTimeline(ctx context.Context, req *connect.Request[pbconsole.GetRequestTimelineRequest]) (*connect.Response[pbconsole.GetRequestTimelineResponse], error) {
+	key, err := model.ParseRequestKey(req.Msg.RequestKey)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid request key: %w", err))
+	}
+	timeline, err := c.dal.GetRequestTimeline(ctx, key)
+	if errors.Is(err, dalerrs.ErrNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	} else if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(requestTimeline(timeline)), nil
+}
+
+// requestTimeline orders the calls, logs and async calls correlated with a
+// request into a single timeline, starting with the request entering the cluster.
+func requestTimeline(timeline *dal.RequestTimeline) *pbconsole.GetRequestTimelineResponse {
+	entries := make([]*pbconsole.RequestTimelineEntry, 0, len(timeline.Events)+len(timeline.AsyncCalls)+1)
+	for _, event := range timeline.Events {
+		pb := eventDALToProto(event)
+		entry := &pbconsole.RequestTimelineEntry{TimeStamp: pb.TimeStamp}
+		switch pb := pb.Entry.(type) {
+		case *pbconsole.Event_Call:
+			entry.Entry = &pbconsole.RequestTimelineEntry_Call{Call: pb.Call}
+		case *pbconsole.Event_Log:
+			entry.Entry = &pbconsole.RequestTimelineEntry_Log{Log: pb.Log}
+		default:
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	for _, call := range timeline.AsyncCalls {
+		entry := &pbconsole.RequestTimelineEntry{TimeStamp: timestamppb.New(call.CreatedAt)}
+		verb := &schemapb.Ref{Module: call.Verb.Module, Name: call.Verb.Name}
+		if origin, ok := call.Origin.(dal.AsyncOriginFSM); ok {
+			entry.Entry = &pbconsole.RequestTimelineEntry_FsmTransition{
+				FsmTransition: &pbconsole.RequestFSMTransition{
+					AsyncCallId:      call.ID,
+					Fsm:              &schemapb.Ref{Module: origin.FSM.Module, Name: origin.FSM.Name},
+					InstanceKey:      origin.Key,
+					DestinationState: verb,
+					State:            string(call.State),
+					ScheduledAt:      timestamppb.New(call.ScheduledAt),
+					Error:            call.Error.Ptr(),
+				},
+			}
+		} else {
+			entry.Entry = &pbconsole.RequestTimelineEntry_AsyncCall{
+				AsyncCall: &pbconsole.RequestAsyncCall{
+					Id:          call.ID,
+					Verb:        verb,
+					Origin:      call.Origin.String(),
+					State:       string(call.State),
+					ScheduledAt: timestamppb.New(call.ScheduledAt),
+					Error:       call.Error.Ptr(),
+				},
+			}
+		}
+		entries = append(entries, entry)
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].TimeStamp.AsTime().Before(entries[j].TimeStamp.AsTime())
+	})
+
+	ingress := &pbconsole.RequestTimelineEntry{
+		Entry: &pbconsole.RequestTimelineEntry_Ingress{
+			Ingress: &pbconsole.RequestIngress{
+				Origin:      string(timeline.Key.Payload.Origin),
+				Description: timeline.Key.Payload.Key,
+				SourceAddr:  timeline.SourceAddr,
+			},
+		},
+	}
+	// Requests aren't timestamped, so the request entered the cluster when its first call was made.
+	if len(entries) > 0 {
+		ingress.TimeStamp = entries[0].TimeStamp
+	}
+	return &pbconsole.GetRequestTimelineResponse{
+		RequestKey: timeline.Key.String(),
+		Entries:    append([]*pbconsole.RequestTimelineEntry{ingress}, entries...),
+	}
+}
+
 func (c *ConsoleService) StreamEvents(ctx context.Context, req *connect.Request[pbconsole.StreamEventsRequest], stream *connect.ServerStream[pbconsole.StreamEventsResponse]) error {
 	// Default to 1 second interval if not specified.
 	updateInterval := 1 * time.Second
@@ -383,11 +609,13 @@ func eventDALToProto(event dal.Event) *pbconsole.Event {
 						Module: event.DestVerb.Module,
 						Name:   event.DestVerb.Name,
 					},
-					Duration: durationpb.New(event.Duration),
-					Request:  string(event.Request),
-					Response: string(event.Response),
-					Error:    event.Error.Ptr(),
-					Stack:    event.Stack.Ptr(),
+					Duration:     durationpb.New(event.Duration),
+					Request:      string(event.Request),
+					Response:     string(event.Response),
+					Error:        event.Error.Ptr(),
+					Stack:        event.Stack.Ptr(),
+					RequestSize:  event.RequestSize,
+					ResponseSize: event.ResponseSize,
 				},
 			},
 		}
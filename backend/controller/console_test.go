@@ -1,10 +1,23 @@
 package controller
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
-	"github.com/TBD54566975/ftl/backend/schema"
 	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+	"google.golang.org/protobuf/runtime/protoimpl"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	"github.com/TBD54566975/ftl/backend/controller/sql"
+	pbconsole "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/console"
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/model"
+	"github.com/TBD54566975/ftl/internal/slices"
 )
 
 func TestVerbSchemaString(t *testing.T) {
@@ -168,3 +181,136 @@ verb Ingress(builtin.HttpRequest<foo.FooRequest>) builtin.HttpResponse<foo.FooRe
 	assert.NoError(t, err)
 	assert.Equal(t, expected, schemaString)
 }
+
+func TestCallGraph(t *testing.T) {
+	sch, err := schema.ParseString("", `
+		module echo {
+			export verb echo(Unit) Unit
+		}
+
+		module greeter {
+			export verb greet(Unit) Unit
+				+calls echo.echo
+		}
+	`)
+	assert.NoError(t, err)
+	echoKey := model.NewDeploymentKey("echo")
+	greeterKey := model.NewDeploymentKey("greeter")
+	deployments := []dal.Deployment{
+		{Key: greeterKey, Module: "greeter", Schema: sch.Module("greeter").MustGet()},
+		{Key: echoKey, Module: "echo", Schema: sch.Module("echo").MustGet()},
+	}
+	observed := []dal.CallGraphEdge{
+		{
+			Source:          optional.Some(schema.Ref{Module: "greeter", Name: "greet"}),
+			Destination:     schema.Ref{Module: "echo", Name: "echo"},
+			Calls:           120,
+			Errors:          3,
+			AverageDuration: 5 * time.Millisecond,
+			P95Duration:     9 * time.Millisecond,
+			MaxDuration:     20 * time.Millisecond,
+		},
+		{Destination: schema.Ref{Module: "greeter", Name: "greet"}, Calls: 60},
+	}
+
+	graph := callGraph(deployments, observed, time.Minute)
+	assert.Equal(t, []*pbconsole.CallGraphModule{
+		{Name: "echo", DeploymentKey: echoKey.String(), Verbs: []string{"echo"}},
+		{Name: "greeter", DeploymentKey: greeterKey.String(), Verbs: []string{"greet"}},
+	}, graph.Modules, assert.Exclude[protoimpl.MessageState]())
+	assert.Equal(t, []*pbconsole.CallGraphEdge{
+		{
+			Destination:    &schemapb.Ref{Module: "greeter", Name: "greet"},
+			Calls:          60,
+			Rate:           1,
+			AverageLatency: durationpb.New(0),
+			P95Latency:     durationpb.New(0),
+			MaxLatency:     durationpb.New(0),
+		},
+		{
+			Source:         &schemapb.Ref{Module: "greeter", Name: "greet"},
+			Destination:    &schemapb.Ref{Module: "echo", Name: "echo"},
+			Declared:       true,
+			Calls:          120,
+			Errors:         3,
+			Rate:           2,
+			AverageLatency: durationpb.New(5 * time.Millisecond),
+			P95Latency:     durationpb.New(9 * time.Millisecond),
+			MaxLatency:     durationpb.New(20 * time.Millisecond),
+		},
+	}, graph.Edges, assert.Exclude[protoimpl.MessageState]())
+}
+
+func TestRequestTimeline(t *testing.T) {
+	start := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	requestKey := model.NewRequestKey(model.OriginIngress, "GET /echo")
+	deploymentKey := model.NewDeploymentKey("echo")
+	fsm := dal.AsyncOriginFSM{FSM: schema.RefKey{Module: "payments", Name: "payment"}, Key: "invoice-1"}
+	timeline := &dal.RequestTimeline{
+		Key:        requestKey,
+		SourceAddr: "127.0.0.1:1234",
+		Events: []dal.Event{
+			&dal.CallEvent{
+				ID:            1,
+				DeploymentKey: deploymentKey,
+				RequestKey:    optional.Some(requestKey),
+				Time:          start,
+				DestVerb:      schema.Ref{Module: "echo", Name: "echo"},
+			},
+			&dal.LogEvent{
+				ID:            3,
+				DeploymentKey: deploymentKey,
+				RequestKey:    optional.Some(requestKey),
+				Time:          start.Add(3 * time.Millisecond),
+				Message:       "echoing",
+			},
+		},
+		AsyncCalls: []dal.RequestAsyncCall{
+			{
+				ID:          7,
+				CreatedAt:   start.Add(2 * time.Millisecond),
+				ScheduledAt: start.Add(2 * time.Millisecond),
+				Verb:        schema.RefKey{Module: "payments", Name: "paid"},
+				Origin:      fsm,
+				State:       sql.AsyncCallStateError,
+				Error:       optional.Some("declined"),
+			},
+			{
+				ID:          8,
+				CreatedAt:   start.Add(4 * time.Millisecond),
+				ScheduledAt: start.Add(5 * time.Millisecond),
+				Verb:        schema.RefKey{Module: "audit", Name: "record"},
+				Origin:      dal.AsyncOriginPubSub{Subscription: schema.RefKey{Module: "audit", Name: "events"}},
+				State:       sql.AsyncCallStatePending,
+			},
+		},
+	}
+
+	response := requestTimeline(timeline)
+	assert.Equal(t, requestKey.String(), response.RequestKey)
+	kinds := slices.Map(response.Entries, func(entry *pbconsole.RequestTimelineEntry) string {
+		return fmt.Sprintf("%T@%s", entry.Entry, entry.TimeStamp.AsTime().Sub(start))
+	})
+	assert.Equal(t, []string{
+		"*pbconsole.RequestTimelineEntry_Ingress@0s",
+		"*pbconsole.RequestTimelineEntry_Call@0s",
+		"*pbconsole.RequestTimelineEntry_FsmTransition@2ms",
+		"*pbconsole.RequestTimelineEntry_Log@3ms",
+		"*pbconsole.RequestTimelineEntry_AsyncCall@4ms",
+	}, kinds)
+	assert.Equal(t, &pbconsole.RequestIngress{
+		Origin:      "ingress",
+		Description: requestKey.Payload.Key,
+		SourceAddr:  "127.0.0.1:1234",
+	}, response.Entries[0].GetIngress(), assert.Exclude[protoimpl.MessageState]())
+	assert.Equal(t, &pbconsole.RequestFSMTransition{
+		AsyncCallId:      7,
+		Fsm:              &schemapb.Ref{Module: "payments", Name: "payment"},
+		InstanceKey:      "invoice-1",
+		DestinationState: &schemapb.Ref{Module: "payments", Name: "paid"},
+		State:            "error",
+		ScheduledAt:      timestamppb.New(start.Add(2 * time.Millisecond)),
+		Error:            optional.Some("declined").Ptr(),
+	}, response.Entries[2].GetFsmTransition(), assert.Exclude[protoimpl.MessageState]())
+	assert.Equal(t, "sub:audit.events", response.Entries[4].GetAsyncCall().Origin)
+}
@@ -0,0 +1,237 @@
+// Package contract records ingress requests and their responses as fixtures,
+// and replays them against a new build of a module to detect changes in its
+// behaviour before it is deployed.
+package contract
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Redacted replaces the values of sensitive headers, query parameters and
+// JSON fields in fixtures.
+//
+// A redacted value in a recorded response matches any value when verifying.
+const Redacted = "[REDACTED]"
+
+// Names redacted from every recording, compared case-insensitively.
+var defaultRedactions = []string{
+	"access_token", "api_key", "apikey", "authorization", "password", "refresh_token", "secret", "token",
+}
+
+// Headers that are never recorded, because they carry credentials or differ
+// between otherwise identical requests and responses.
+var droppedHeaders = []string{
+	"Accept-Encoding", "Authorization", "Connection", "Content-Length", "Cookie", "Date",
+	"Proxy-Authorization", "Set-Cookie", "X-Api-Key",
+}
+
+type Config struct {
+	Dir    string   `help:"Record sanitised ingress requests and their responses as fixtures in this directory, for \"ftl contract verify\" to replay." env:"FTL_CONTROLLER_CONTRACT_DIR" placeholder:"DIR"`
+	Limit  int      `help:"Maximum number of distinct requests recorded for each route." default:"20" env:"FTL_CONTROLLER_CONTRACT_LIMIT"`
+	Redact []string `help:"Names of additional headers, query parameters and JSON fields to redact from recordings." env:"FTL_CONTROLLER_CONTRACT_REDACT" placeholder:"NAME"`
+}
+
+// Route is the ingress route of a verb.
+type Route struct {
+	Module string `json:"module"`
+	Verb   string `json:"verb"`
+	Method string `json:"method"`
+	// Path pattern of the route, eg. /users/{id}.
+	Path string `json:"path"`
+}
+
+func (r Route) String() string { return r.Module + "." + r.Verb }
+
+// Fixture is the exchanges recorded for a route.
+type Fixture struct {
+	Route
+	Exchanges []Exchange `json:"exchanges"`
+}
+
+// Exchange is a recorded request and the response to it.
+type Exchange struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+type Request struct {
+	Method  string      `json:"method"`
+	Path    string      `json:"path"`
+	Query   url.Values  `json:"query,omitempty"`
+	Headers http.Header `json:"headers,omitempty"`
+	// Set if the body is JSON, otherwise Text is.
+	Body json.RawMessage `json:"body,omitempty"`
+	Text string          `json:"text,omitempty"`
+}
+
+// Equal returns true if "r" and "other" are the same request, ignoring headers.
+func (r Request) Equal(other Request) bool {
+	return r.Method == other.Method && r.Path == other.Path && r.Query.Encode() == other.Query.Encode() &&
+		string(r.body()) == string(other.body())
+}
+
+func (r Request) body() []byte {
+	if r.Body != nil {
+		return r.Body
+	}
+	return []byte(r.Text)
+}
+
+type Response struct {
+	Status  int         `json:"status"`
+	Headers http.Header `json:"headers,omitempty"`
+	// Set if the body is JSON, otherwise Text is.
+	Body json.RawMessage `json:"body,omitempty"`
+	Text string          `json:"text,omitempty"`
+}
+
+// sanitiser removes credentials and other sensitive values from exchanges.
+type sanitiser struct {
+	redact map[string]bool
+}
+
+func newSanitiser(redact []string) sanitiser {
+	s := sanitiser{redact: map[string]bool{}}
+	for _, name := range append(defaultRedactions, redact...) {
+		s.redact[strings.ToLower(name)] = true
+	}
+	return s
+}
+
+func (s sanitiser) request(method string, u *url.URL, headers http.Header, body []byte) Request {
+	query := url.Values{}
+	for key, values := range u.Query() {
+		if s.redact[strings.ToLower(key)] {
+			values = []string{Redacted}
+		}
+		query[key] = values
+	}
+	if len(query) == 0 {
+		query = nil
+	}
+	req := Request{Method: method, Path: u.Path, Query: query, Headers: s.headers(headers)}
+	req.Body, req.Text = s.body(body)
+	return req
+}
+
+func (s sanitiser) response(status int, headers http.Header, body []byte) Response {
+	resp := Response{Status: status, Headers: s.headers(headers)}
+	resp.Body, resp.Text = s.body(body)
+	return resp
+}
+
+func (s sanitiser) headers(headers http.Header) http.Header {
+	out := headers.Clone()
+	for _, name := range droppedHeaders {
+		out.Del(name)
+	}
+	for name := range out {
+		if s.redact[strings.ToLower(name)] {
+			out[name] = []string{Redacted}
+		}
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
+// body returns "data" with sensitive fields redacted if it is JSON, or as text if it is not.
+func (s sanitiser) body(data []byte) (json.RawMessage, string) {
+	if len(data) == 0 {
+		return nil, ""
+	}
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, string(data)
+	}
+	redacted, err := json.Marshal(s.value(value))
+	if err != nil {
+		return nil, string(data)
+	}
+	return redacted, ""
+}
+
+func (s sanitiser) value(value any) any {
+	switch value := value.(type) {
+	case map[string]any:
+		for key, field := range value {
+			if s.redact[strings.ToLower(key)] {
+				value[key] = Redacted
+			} else {
+				value[key] = s.value(field)
+			}
+		}
+	case []any:
+		for i, element := range value {
+			value[i] = s.value(element)
+		}
+	}
+	return value
+}
+
+func fixturePath(dir string, route Route) string {
+	return filepath.Join(dir, route.String()+".json")
+}
+
+// LoadFixture loads the fixture recorded for "route" in "dir", if any.
+func LoadFixture(dir string, route Route) (Fixture, error) {
+	data, err := os.ReadFile(fixturePath(dir, route))
+	if errors.Is(err, os.ErrNotExist) {
+		return Fixture{Route: route}, nil
+	} else if err != nil {
+		return Fixture{}, err
+	}
+	var fixture Fixture
+	if err := json.Unmarshal(data, &fixture); err != nil {
+		return Fixture{}, fmt.Errorf("invalid fixture for %s: %w", route, err)
+	}
+	fixture.Route = route
+	return fixture, nil
+}
+
+// LoadFixtures loads every fixture in "dir", ordered by route.
+func LoadFixtures(dir string) ([]Fixture, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	fixtures := make([]Fixture, 0, len(paths))
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		var fixture Fixture
+		if err := json.Unmarshal(data, &fixture); err != nil {
+			return nil, fmt.Errorf("invalid fixture %s: %w", path, err)
+		}
+		fixtures = append(fixtures, fixture)
+	}
+	sort.Slice(fixtures, func(i, j int) bool { return fixtures[i].Route.String() < fixtures[j].Route.String() })
+	return fixtures, nil
+}
+
+// SaveFixture writes "fixture" to "dir", replacing any existing fixture for its route.
+func SaveFixture(dir string, fixture Fixture) error {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(fixture, "", "  ")
+	if err != nil {
+		return err
+	}
+	path := fixturePath(dir, fixture.Route)
+	if err := os.WriteFile(path+".tmp", data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
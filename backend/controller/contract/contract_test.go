@@ -0,0 +1,112 @@
+package contract
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+func echoRoute(r *http.Request) (Route, bool) {
+	if !strings.HasPrefix(r.URL.Path, "/users/") {
+		return Route{}, false
+	}
+	return Route{Module: "users", Verb: "get", Method: http.MethodGet, Path: "/users/{id}"}, true
+}
+
+// usersHandler responds with a user whose name is prefixed by "greeting".
+func usersHandler(greeting string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		_, _ = fmt.Fprintf(w, `{"id":%q,"name":"%s %s","token":"%s"}`, //nolint:errcheck
+			strings.TrimPrefix(r.URL.Path, "/users/"), greeting, r.URL.Query().Get("name"), r.URL.Query().Get("token"))
+	})
+}
+
+func TestRecordAndVerify(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	dir := t.TempDir()
+	recorder, err := NewRecorder(Config{Dir: dir, Limit: 2}, echoRoute)
+	assert.NoError(t, err)
+	recording := httptest.NewServer(recorder.Middleware(usersHandler("hello")))
+	t.Cleanup(recording.Close)
+
+	for _, path := range []string{"/users/1?name=alice&token=abc", "/users/1?name=alice&token=abc", "/users/2?name=bob", "/users/3", "/health"} {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, recording.URL+path, nil)
+		assert.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer secret")
+		resp, err := http.DefaultClient.Do(req)
+		assert.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	fixtures, err := LoadFixtures(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(fixtures))
+	fixture := fixtures[0]
+	assert.Equal(t, "users.get", fixture.Route.String())
+	assert.Equal(t, 2, len(fixture.Exchanges), "duplicate requests and requests over the limit should not be recorded")
+	first := fixture.Exchanges[0]
+	assert.Equal(t, url.Values{"name": {"alice"}, "token": {Redacted}}, first.Request.Query)
+	assert.Equal(t, "", first.Request.Headers.Get("Authorization"))
+	assert.Equal(t, http.StatusOK, first.Response.Status)
+	body := &bytes.Buffer{}
+	assert.NoError(t, json.Compact(body, first.Response.Body))
+	assert.Equal(t, `{"id":"1","name":"hello alice","token":"[REDACTED]"}`, body.String())
+
+	credentials := http.Header{"Authorization": {"Bearer secret"}}
+	same := httptest.NewServer(usersHandler("hello"))
+	t.Cleanup(same.Close)
+	mismatches, err := Verify(ctx, http.DefaultClient, mustParseURL(t, same.URL), credentials, fixture)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(mismatches))
+
+	changed := httptest.NewServer(usersHandler("hi"))
+	t.Cleanup(changed.Close)
+	mismatches, err = Verify(ctx, http.DefaultClient, mustParseURL(t, changed.URL), credentials, fixture)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(mismatches))
+	assert.Equal(t, []string{`body.name: expected "hello alice", got "hi alice"`}, mismatches[0].Differences)
+
+	mismatches, err = Verify(ctx, http.DefaultClient, mustParseURL(t, same.URL), nil, fixture)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(mismatches))
+	assert.Equal(t, `status: expected 200, got 401`, mismatches[0].Differences[0])
+}
+
+func TestCompareValues(t *testing.T) {
+	decode := func(data string) any {
+		var value any
+		assert.NoError(t, json.Unmarshal([]byte(data), &value))
+		return value
+	}
+	differences := compareValues("body",
+		decode(`{"a":1,"b":[1,2],"c":"[REDACTED]","d":{"e":true}}`),
+		decode(`{"a":2,"b":[1],"c":"anything","d":{"e":true,"f":null}}`),
+		nil)
+	assert.Equal(t, []string{
+		"body.a: expected 1, got 2",
+		"body.b: expected 2 elements, got 1",
+		"body.d.f: unexpected null",
+	}, differences)
+}
+
+func mustParseURL(t *testing.T, s string) *url.URL {
+	t.Helper()
+	u, err := url.Parse(s)
+	assert.NoError(t, err)
+	return u
+}
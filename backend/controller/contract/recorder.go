@@ -0,0 +1,122 @@
+package contract
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+// Maximum size of a request or response body that is recorded.
+const maxBodySize = 1024 * 1024
+
+// RouteFunc returns the ingress route that will handle "r", if any.
+type RouteFunc func(r *http.Request) (Route, bool)
+
+// Recorder records sanitised ingress exchanges as fixtures, one per route.
+//
+// Only the first response to each distinct request is recorded, so that
+// fixtures reflect the behaviour of the build they were recorded against.
+type Recorder struct {
+	dir       string
+	limit     int
+	sanitiser sanitiser
+	route     RouteFunc
+
+	lock sync.Mutex
+}
+
+// NewRecorder creates a [Recorder] writing fixtures to config.Dir.
+func NewRecorder(config Config, route RouteFunc) (*Recorder, error) {
+	if config.Limit <= 0 {
+		return nil, fmt.Errorf("contract recording limit must be positive, got %d", config.Limit)
+	}
+	return &Recorder{dir: config.Dir, limit: config.Limit, sanitiser: newSanitiser(config.Redact), route: route}, nil
+}
+
+// Middleware records the exchanges handled by "next".
+func (r *Recorder) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		route, ok := r.route(req)
+		if !ok {
+			next.ServeHTTP(w, req)
+			return
+		}
+		body, err := io.ReadAll(io.LimitReader(req.Body, maxBodySize+1))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		req.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), req.Body))
+		recording := &recordingWriter{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recording, req)
+
+		if len(body) > maxBodySize || recording.body.Len() > maxBodySize {
+			return
+		}
+		exchange := Exchange{
+			Request:  r.sanitiser.request(req.Method, req.URL, req.Header, body),
+			Response: r.sanitiser.response(recording.status, recording.sentHeaders(), recording.body.Bytes()),
+		}
+		if err := r.record(route, exchange); err != nil {
+			log.FromContext(req.Context()).Warnf("Failed to record %s %s for %s: %s", req.Method, req.URL.Path, route, err)
+		}
+	})
+}
+
+func (r *Recorder) record(route Route, exchange Exchange) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	fixture, err := LoadFixture(r.dir, route)
+	if err != nil {
+		return err
+	}
+	if len(fixture.Exchanges) >= r.limit {
+		return nil
+	}
+	for _, existing := range fixture.Exchanges {
+		if existing.Request.Equal(exchange.Request) {
+			return nil
+		}
+	}
+	fixture.Exchanges = append(fixture.Exchanges, exchange)
+	return SaveFixture(r.dir, fixture)
+}
+
+// recordingWriter captures the status, headers and body of a response.
+type recordingWriter struct {
+	http.ResponseWriter
+	status int
+	// Headers as they were when the response was written, because changes
+	// after that are not sent.
+	headers http.Header
+	body    bytes.Buffer
+}
+
+func (w *recordingWriter) WriteHeader(status int) {
+	if w.headers == nil {
+		w.status = status
+		w.headers = w.Header().Clone()
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *recordingWriter) Write(data []byte) (int, error) {
+	if w.headers == nil {
+		w.headers = w.Header().Clone()
+	}
+	if w.body.Len() <= maxBodySize {
+		w.body.Write(data)
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *recordingWriter) sentHeaders() http.Header {
+	if w.headers == nil {
+		return w.Header()
+	}
+	return w.headers
+}
@@ -0,0 +1,163 @@
+package contract
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Mismatch is a recorded exchange whose response changed when replayed.
+type Mismatch struct {
+	Request Request
+	// Descriptions of each difference from the recorded response.
+	Differences []string
+}
+
+// Verify replays the exchanges of "fixture" against the ingress at
+// "endpoint", returning each exchange whose response differs from the one
+// recorded.
+//
+// Recorded headers are sent with each request, overridden by "headers", eg.
+// to supply the credentials that were dropped while recording.
+//
+// Responses match if their status, Content-Type and bodies are the same. JSON
+// bodies are compared semantically, and redacted values match anything.
+func Verify(ctx context.Context, client *http.Client, endpoint *url.URL, headers http.Header, fixture Fixture) ([]Mismatch, error) {
+	sanitiser := newSanitiser(nil)
+	var mismatches []Mismatch
+	for _, exchange := range fixture.Exchanges {
+		resp, err := replay(ctx, client, endpoint, headers, exchange.Request)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %w", exchange.Request.Method, exchange.Request.Path, err)
+		}
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %w", exchange.Request.Method, exchange.Request.Path, err)
+		}
+		actual := sanitiser.response(resp.StatusCode, resp.Header, body)
+		if differences := compareResponses(exchange.Response, actual); len(differences) > 0 {
+			mismatches = append(mismatches, Mismatch{Request: exchange.Request, Differences: differences})
+		}
+	}
+	return mismatches, nil
+}
+
+func replay(ctx context.Context, client *http.Client, endpoint *url.URL, headers http.Header, recorded Request) (*http.Response, error) {
+	u := endpoint.JoinPath(recorded.Path)
+	u.RawQuery = recorded.Query.Encode()
+	req, err := http.NewRequestWithContext(ctx, recorded.Method, u.String(), bytes.NewReader(recorded.body()))
+	if err != nil {
+		return nil, err
+	}
+	for name, values := range recorded.Headers {
+		req.Header[name] = values
+	}
+	for name, values := range headers {
+		req.Header[http.CanonicalHeaderKey(name)] = values
+	}
+	return client.Do(req)
+}
+
+func compareResponses(expected, actual Response) []string {
+	var differences []string
+	if expected.Status != actual.Status {
+		differences = append(differences, fmt.Sprintf("status: expected %d, got %d", expected.Status, actual.Status))
+	}
+	if contentType := expected.Headers.Get("Content-Type"); contentType != "" && contentType != actual.Headers.Get("Content-Type") {
+		differences = append(differences, fmt.Sprintf("Content-Type: expected %q, got %q", contentType, actual.Headers.Get("Content-Type")))
+	}
+	switch {
+	case expected.Body != nil && actual.Body != nil:
+		var expectedValue, actualValue any
+		if err := json.Unmarshal(expected.Body, &expectedValue); err != nil {
+			return append(differences, fmt.Sprintf("body: invalid recorded JSON: %s", err))
+		}
+		if err := json.Unmarshal(actual.Body, &actualValue); err != nil {
+			return append(differences, fmt.Sprintf("body: invalid JSON: %s", err))
+		}
+		differences = compareValues("body", expectedValue, actualValue, differences)
+	case expected.Body != nil || actual.Body != nil:
+		differences = append(differences, fmt.Sprintf("body: expected %s, got %s", describeBody(expected), describeBody(actual)))
+	case strings.TrimSpace(expected.Text) != strings.TrimSpace(actual.Text):
+		differences = append(differences, fmt.Sprintf("body: expected %q, got %q", expected.Text, actual.Text))
+	}
+	return differences
+}
+
+func describeBody(resp Response) string {
+	if resp.Body != nil {
+		return string(resp.Body)
+	}
+	return fmt.Sprintf("%q", resp.Text)
+}
+
+// compareValues appends a description of each difference between the
+// decoded JSON values "expected" and "actual" at "path" to "differences".
+func compareValues(path string, expected, actual any, differences []string) []string {
+	if expected == Redacted {
+		return differences
+	}
+	switch expected := expected.(type) {
+	case map[string]any:
+		actual, ok := actual.(map[string]any)
+		if !ok {
+			break
+		}
+		keys := make([]string, 0, len(expected)+len(actual))
+		for key := range expected {
+			keys = append(keys, key)
+		}
+		for key := range actual {
+			if _, ok := expected[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			expectedField, inExpected := expected[key]
+			actualField, inActual := actual[key]
+			switch {
+			case !inActual:
+				differences = append(differences, fmt.Sprintf("%s.%s: missing", path, key))
+			case !inExpected:
+				differences = append(differences, fmt.Sprintf("%s.%s: unexpected %s", path, key, encode(actualField)))
+			default:
+				differences = compareValues(path+"."+key, expectedField, actualField, differences)
+			}
+		}
+		return differences
+
+	case []any:
+		actual, ok := actual.([]any)
+		if !ok {
+			break
+		}
+		if len(expected) != len(actual) {
+			return append(differences, fmt.Sprintf("%s: expected %d elements, got %d", path, len(expected), len(actual)))
+		}
+		for i := range expected {
+			differences = compareValues(fmt.Sprintf("%s[%d]", path, i), expected[i], actual[i], differences)
+		}
+		return differences
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		differences = append(differences, fmt.Sprintf("%s: expected %s, got %s", path, encode(expected), encode(actual)))
+	}
+	return differences
+}
+
+func encode(value any) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
@@ -9,7 +9,9 @@ import (
 	"math/rand"
 	"net/http"
 	"net/url"
+	"os"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -26,31 +28,41 @@ import (
 	"golang.org/x/exp/maps"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/TBD54566975/ftl"
 	"github.com/TBD54566975/ftl/backend/controller/admin"
+	"github.com/TBD54566975/ftl/backend/controller/coldstorage"
+	"github.com/TBD54566975/ftl/backend/controller/contract"
 	"github.com/TBD54566975/ftl/backend/controller/cronjobs"
 	"github.com/TBD54566975/ftl/backend/controller/dal"
+	"github.com/TBD54566975/ftl/backend/controller/eventexport"
 	"github.com/TBD54566975/ftl/backend/controller/ingress"
 	"github.com/TBD54566975/ftl/backend/controller/leases"
+	"github.com/TBD54566975/ftl/backend/controller/provisioner"
 	"github.com/TBD54566975/ftl/backend/controller/pubsub"
+	"github.com/TBD54566975/ftl/backend/controller/quotas"
 	"github.com/TBD54566975/ftl/backend/controller/scaling"
 	"github.com/TBD54566975/ftl/backend/controller/scaling/localscaling"
 	"github.com/TBD54566975/ftl/backend/controller/scheduledtask"
+	"github.com/TBD54566975/ftl/backend/controller/webhooks"
 	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
 	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/console/pbconsoleconnect"
 	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
 	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
 	"github.com/TBD54566975/ftl/backend/schema"
 	cf "github.com/TBD54566975/ftl/common/configuration"
+	cfdal "github.com/TBD54566975/ftl/common/configuration/dal"
 	"github.com/TBD54566975/ftl/db/dalerrs"
 	frontend "github.com/TBD54566975/ftl/frontend"
+	"github.com/TBD54566975/ftl/internal/compression"
 	"github.com/TBD54566975/ftl/internal/cors"
 	ftlhttp "github.com/TBD54566975/ftl/internal/http"
 	"github.com/TBD54566975/ftl/internal/log"
 	ftlmaps "github.com/TBD54566975/ftl/internal/maps"
+	"github.com/TBD54566975/ftl/internal/metrics"
 	"github.com/TBD54566975/ftl/internal/model"
 	"github.com/TBD54566975/ftl/internal/modulecontext"
 	ftlreflect "github.com/TBD54566975/ftl/internal/reflect"
@@ -62,26 +74,41 @@ import (
 
 // CommonConfig between the production controller and development server.
 type CommonConfig struct {
-	AllowOrigins   []*url.URL    `help:"Allow CORS requests to ingress endpoints from these origins." env:"FTL_CONTROLLER_ALLOW_ORIGIN"`
-	NoConsole      bool          `help:"Disable the console."`
-	IdleRunners    int           `help:"Number of idle runners to keep around (not supported in production)." default:"3"`
-	WaitFor        []string      `help:"Wait for these modules to be deployed before becoming ready." placeholder:"MODULE"`
-	CronJobTimeout time.Duration `help:"Timeout for cron jobs." default:"5m"`
+	AllowOrigins   []*url.URL      `help:"Allow CORS requests to ingress endpoints from these origins." env:"FTL_CONTROLLER_ALLOW_ORIGIN"`
+	NoConsole      bool            `help:"Disable the console."`
+	IdleRunners    int             `help:"Number of idle runners to keep around (not supported in production)." default:"3"`
+	WaitFor        []string        `help:"Wait for these modules to be deployed before becoming ready." placeholder:"MODULE"`
+	CronJobTimeout time.Duration   `help:"Timeout for cron jobs." default:"5m"`
+	Contract       contract.Config `embed:"" prefix:"contract-"`
+	Quota          quotas.Config   `embed:"" prefix:"quota-"`
 }
 
 type Config struct {
-	Bind                         *url.URL            `help:"Socket to bind to." default:"http://localhost:8892" env:"FTL_CONTROLLER_BIND"`
-	IngressBind                  *url.URL            `help:"Socket to bind to for ingress." default:"http://localhost:8891" env:"FTL_CONTROLLER_INGRESS_BIND"`
-	Key                          model.ControllerKey `help:"Controller key (auto)." placeholder:"KEY"`
-	DSN                          string              `help:"DAL DSN." default:"postgres://localhost:15432/ftl?sslmode=disable&user=postgres&password=secret" env:"FTL_CONTROLLER_DSN"`
-	Advertise                    *url.URL            `help:"Endpoint the Controller should advertise (must be unique across the cluster, defaults to --bind if omitted)." env:"FTL_CONTROLLER_ADVERTISE"`
-	ConsoleURL                   *url.URL            `help:"The public URL of the console (for CORS)." env:"FTL_CONTROLLER_CONSOLE_URL"`
-	ContentTime                  time.Time           `help:"Time to use for console resource timestamps." default:"${timestamp=1970-01-01T00:00:00Z}"`
-	RunnerTimeout                time.Duration       `help:"Runner heartbeat timeout." default:"10s"`
-	ControllerTimeout            time.Duration       `help:"Controller heartbeat timeout." default:"10s"`
-	DeploymentReservationTimeout time.Duration       `help:"Deployment reservation timeout." default:"120s"`
-	ModuleUpdateFrequency        time.Duration       `help:"Frequency to send module updates." default:"30s"`
-	ArtefactChunkSize            int                 `help:"Size of each chunk streamed to the client." default:"1048576"`
+	Bind                         *url.URL                `help:"Socket to bind to." default:"http://localhost:8892" env:"FTL_CONTROLLER_BIND"`
+	IngressBind                  *url.URL                `help:"Socket to bind to for ingress." default:"http://localhost:8891" env:"FTL_CONTROLLER_INGRESS_BIND"`
+	IngressJWTSecret             string                  `help:"Secret used to verify HS256 JWT bearer tokens on ingress requests. The claims of verified tokens are available to verbs." env:"FTL_CONTROLLER_INGRESS_JWT_SECRET"`
+	Key                          model.ControllerKey     `help:"Controller key (auto)." placeholder:"KEY"`
+	DSN                          string                  `help:"DAL DSN." default:"postgres://localhost:15432/ftl?sslmode=disable&user=postgres&password=secret" env:"FTL_CONTROLLER_DSN"`
+	Advertise                    *url.URL                `help:"Endpoint the Controller should advertise (must be unique across the cluster, defaults to --bind if omitted)." env:"FTL_CONTROLLER_ADVERTISE"`
+	ConsoleURL                   *url.URL                `help:"The public URL of the console (for CORS)." env:"FTL_CONTROLLER_CONSOLE_URL"`
+	ContentTime                  time.Time               `help:"Time to use for console resource timestamps." default:"${timestamp=1970-01-01T00:00:00Z}"`
+	RunnerTimeout                time.Duration           `help:"Runner heartbeat timeout." default:"10s"`
+	RunnerDrainTimeout           time.Duration           `help:"Time to wait for in-flight calls to complete before terminating a runner's deployment." default:"10s"`
+	ControllerTimeout            time.Duration           `help:"Controller heartbeat timeout." default:"10s"`
+	DeploymentReservationTimeout time.Duration           `help:"Deployment reservation timeout." default:"120s"`
+	ModuleUpdateFrequency        time.Duration           `help:"Frequency to send module updates." default:"30s"`
+	ArtefactChunkSize            int                     `help:"Size of each chunk streamed to the client." default:"1048576"`
+	PrefetchWindow               time.Duration           `help:"Idle runners prefetch artefacts for the newest deployment of a module if it was created within this window, before it is scaled up." default:"5m"`
+	CallCacheSize                uint64                  `help:"Maximum number of responses of verbs with +cache metadata cached by the controller." default:"10000" env:"FTL_CONTROLLER_CALL_CACHE_SIZE"`
+	FaultInjection               bool                    `help:"Enable the admin API for injecting faults into calls, for game days and integration tests." env:"FTL_CONTROLLER_FAULT_INJECTION"`
+	IngressAccessLog             ingress.AccessLogConfig `embed:"" prefix:"ingress-access-log-"`
+	CallPayload                  CallPayloadConfig       `embed:"" prefix:"call-payload-"`
+	SLO                          SLOConfig               `embed:"" prefix:"slo-"`
+	Reconcile                    ReconcileConfig         `embed:"" prefix:"reconcile-"`
+	EventExport                  eventexport.Config      `embed:"" prefix:"event-export-"`
+	ColdStorage                  coldstorage.Config      `embed:"" prefix:"cold-storage-"`
+	Webhooks                     webhooks.Config         `embed:"" prefix:"webhooks-"`
+	DatabaseProvisioner          provisioner.Config      `embed:"" prefix:"database-provisioner-"`
 	CommonConfig
 }
 
@@ -95,7 +122,10 @@ func (c *Config) SetDefaults() {
 }
 
 // Start the Controller. Blocks until the context is cancelled.
-func Start(ctx context.Context, config Config, runnerScaling scaling.RunnerScaling, dal *dal.DAL) error {
+//
+// Each configuration received from "reloads", if not nil, is applied to the
+// running controller with [Service.Reload].
+func Start(ctx context.Context, config Config, runnerScaling scaling.RunnerScaling, dal *dal.DAL, configDAL *cfdal.DAL, reloads <-chan Config) error {
 	config.SetDefaults()
 
 	logger := log.FromContext(ctx)
@@ -116,19 +146,30 @@ func Start(ctx context.Context, config Config, runnerScaling scaling.RunnerScali
 		logger.Infof("Web console available at: %s", config.Bind)
 	}
 
-	svc, err := New(ctx, dal, config, runnerScaling)
+	svc, err := New(ctx, dal, configDAL, config, runnerScaling)
 	if err != nil {
 		return err
 	}
 	logger.Debugf("Listening on %s", config.Bind)
+	if reloads != nil {
+		go svc.watchReloads(ctx, reloads)
+	}
 
 	cm := cf.ConfigFromContext(ctx)
 	sm := cf.SecretsFromContext(ctx)
 
-	admin := admin.NewAdminService(cm, sm)
+	admin := admin.NewAdminService(cm, sm, dal, svc.moduleScope)
 	console := NewConsoleService(dal)
 
 	ingressHandler := http.Handler(svc)
+	if config.Contract.Dir != "" {
+		recorder, err := contract.NewRecorder(config.Contract, svc.ingressRouteFor)
+		if err != nil {
+			return err
+		}
+		ingressHandler = recorder.Middleware(ingressHandler)
+		logger.Infof("Recording ingress contract fixtures to %s", config.Contract.Dir)
+	}
 	if len(config.AllowOrigins) > 0 {
 		ingressHandler = cors.Middleware(slices.Map(config.AllowOrigins, func(u *url.URL) string { return u.String() }), ingressHandler)
 	}
@@ -141,11 +182,13 @@ func Start(ctx context.Context, config Config, runnerScaling scaling.RunnerScali
 	})
 
 	g.Go(func() error {
+		dbUnavailable := connect.WithInterceptors(dbUnavailableInterceptor{})
 		return rpc.Serve(ctx, config.Bind,
-			rpc.GRPC(ftlv1connect.NewVerbServiceHandler, svc),
-			rpc.GRPC(ftlv1connect.NewControllerServiceHandler, svc),
-			rpc.GRPC(ftlv1connect.NewAdminServiceHandler, admin),
-			rpc.GRPC(pbconsoleconnect.NewConsoleServiceHandler, console),
+			rpc.GRPC(ftlv1connect.NewVerbServiceHandler, svc, dbUnavailable),
+			rpc.GRPC(ftlv1connect.NewControllerServiceHandler, svc, dbUnavailable),
+			rpc.GRPC(ftlv1connect.NewAdminServiceHandler, admin, dbUnavailable),
+			rpc.GRPC(pbconsoleconnect.NewConsoleServiceHandler, console, dbUnavailable),
+			rpc.HTTP(metrics.Path, metrics.TextHandler(svc.aggregateMetrics)),
 			rpc.HTTP("/", consoleHandler),
 		)
 	})
@@ -169,6 +212,7 @@ type ControllerListListener interface {
 
 type Service struct {
 	dal                *dal.DAL
+	configDAL          *cfdal.DAL
 	key                model.ControllerKey
 	deploymentLogsSink *deploymentLogsSink
 
@@ -179,54 +223,140 @@ type Service struct {
 
 	// Map from endpoint to client.
 	clients *ttlcache.Cache[string, clients]
+	// Connections to runners, drained when their clients expire.
+	runnerPool *rpc.Pool
 
 	// Complete schema synchronised from the database.
 	schema atomic.Value[*schema.Schema]
 
 	routes        atomic.Value[map[string][]dal.Route]
-	config        Config
+	config        atomic.Value[Config]
 	runnerScaling scaling.RunnerScaling
 
-	increaseReplicaFailures map[string]int
-	asyncCallsLock          sync.Mutex
+	asyncCallsLock sync.Mutex
+	// Consecutive failures to reconcile each deployment.
+	reconcileBackoff *reconcileBackoff
+	quotas           *quotas.Quotas
+	artefactCaches   *runnerArtefactCaches
+	callCache        *callCache
+	webhooks         *webhooks.Notifier
+	crashLoops       *webhooks.CrashLoopDetector
+	// Provisions the databases of modules on deployment, if configured.
+	provisioner optional.Option[*provisioner.Service]
+
+	// Most recently measured SLO status of each verb, for metrics.
+	sloStatus atomic.Value[[]*ftlv1.VerbSLOStatus]
+	// Faults injected into calls, if fault injection is enabled.
+	faults atomic.Value[[]dal.Fault]
+	// Project of each ingress domain managed with the admin resource API.
+	ingressDomains atomic.Value[map[string]string]
 }
 
-func New(ctx context.Context, db *dal.DAL, config Config, runnerScaling scaling.RunnerScaling) (*Service, error) {
+// overrideDevelDefaults overrides some defaults during development mode.
+func overrideDevelDefaults(config *Config, runnerScaling scaling.RunnerScaling) {
+	if _, devel := runnerScaling.(*localscaling.LocalScaling); devel {
+		config.RunnerTimeout = time.Second * 5
+		config.ControllerTimeout = time.Second * 5
+	}
+}
+
+func New(ctx context.Context, db *dal.DAL, configDAL *cfdal.DAL, config Config, runnerScaling scaling.RunnerScaling) (*Service, error) {
 	key := config.Key
 	if config.Key.IsZero() {
 		key = model.NewControllerKey(config.Bind.Hostname(), config.Bind.Port())
 	}
 	config.SetDefaults()
-
-	// Override some defaults during development mode.
-	_, devel := runnerScaling.(*localscaling.LocalScaling)
-	if devel {
-		config.RunnerTimeout = time.Second * 5
-		config.ControllerTimeout = time.Second * 5
+	if err := config.SLO.validate(); err != nil {
+		return nil, err
+	}
+	if err := config.Reconcile.validate(); err != nil {
+		return nil, err
 	}
+	if err := config.IngressAccessLog.Validate(); err != nil {
+		return nil, err
+	}
+
+	overrideDevelDefaults(&config, runnerScaling)
 
 	svc := &Service{
-		tasks:                   scheduledtask.New(ctx, key, db),
-		dal:                     db,
-		key:                     key,
-		deploymentLogsSink:      newDeploymentLogsSink(ctx, db),
-		clients:                 ttlcache.New(ttlcache.WithTTL[string, clients](time.Minute)),
-		config:                  config,
-		runnerScaling:           runnerScaling,
-		increaseReplicaFailures: map[string]int{},
-	}
+		tasks:              scheduledtask.New(ctx, key, db),
+		dal:                db,
+		configDAL:          configDAL,
+		key:                key,
+		deploymentLogsSink: newDeploymentLogsSink(ctx, db),
+		clients:            ttlcache.New(ttlcache.WithTTL[string, clients](time.Minute)),
+		runnerPool:         rpc.NewPool(),
+		runnerScaling:      runnerScaling,
+		reconcileBackoff:   newReconcileBackoff(config.Reconcile),
+		quotas:             quotas.New(config.Quota),
+		artefactCaches:     newRunnerArtefactCaches(),
+		callCache:          newCallCache(config.CallCacheSize),
+		crashLoops:         webhooks.NewCrashLoopDetector(config.Webhooks),
+	}
+	svc.config.Store(config)
 	svc.routes.Store(map[string][]dal.Route{})
+	svc.clients.OnEviction(func(_ context.Context, _ ttlcache.EvictionReason, item *ttlcache.Item[string, clients]) {
+		svc.runnerPool.Drain(item.Key())
+	})
+	go svc.clients.Start()
+	go svc.callCache.cache.Start()
+	go func() {
+		<-ctx.Done()
+		svc.clients.Stop()
+		svc.callCache.cache.Stop()
+		svc.runnerPool.Close()
+	}()
 	svc.schema.Store(&schema.Schema{})
+	if err := svc.registerSLOMetrics(); err != nil {
+		return nil, fmt.Errorf("failed to register SLO metrics: %w", err)
+	}
+	if err := svc.registerReconcileMetrics(); err != nil {
+		return nil, fmt.Errorf("failed to register reconciliation metrics: %w", err)
+	}
+	if err := svc.registerArtefactCacheMetrics(); err != nil {
+		return nil, fmt.Errorf("failed to register artefact cache metrics: %w", err)
+	}
+	notifier, err := webhooks.New(ctx, config.Webhooks)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start webhooks: %w", err)
+	}
+	svc.webhooks = notifier
 
-	cronSvc := cronjobs.New(ctx, key, svc.config.Advertise.Host, cronjobs.Config{Timeout: config.CronJobTimeout}, db, svc.tasks, svc.callWithRequest)
+	cronSvc := cronjobs.New(ctx, key, svc.config.Load().Advertise.Host, cronjobs.Config{Timeout: config.CronJobTimeout}, db, svc.tasks, svc.callWithRequest)
 	svc.cronJobs = cronSvc
 	svc.controllerListListeners = append(svc.controllerListListeners, cronSvc)
 
 	pubSub := pubsub.New(ctx, db, svc.tasks, svc)
 	svc.pubSub = pubSub
 
+	if config.EventExport.URL != nil {
+		if _, err := eventexport.New(ctx, config.EventExport, db, svc.tasks); err != nil {
+			return nil, fmt.Errorf("failed to start event export: %w", err)
+		}
+	}
+
+	if config.ColdStorage.URL != nil {
+		tierer, err := coldstorage.New(ctx, config.ColdStorage, db, svc.tasks)
+		if err != nil {
+			return nil, fmt.Errorf("failed to start cold storage: %w", err)
+		}
+		db.SetColdStore(tierer)
+	}
+
+	if config.DatabaseProvisioner.URL != nil {
+		p, err := provisioner.NewProvisioner(ctx, config.DatabaseProvisioner.URL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to start database provisioner: %w", err)
+		}
+		svc.provisioner = optional.Some(provisioner.New(db, p, config.DatabaseProvisioner.SecretProvider))
+	}
+
+	if err := svc.checkStoredSchemas(ctx); err != nil {
+		return nil, fmt.Errorf("schema self-check failed: %w", err)
+	}
 	go svc.syncSchema(ctx)
 
+	_, devel := runnerScaling.(*localscaling.LocalScaling)
 	// Use min, max backoff if we are running in production, otherwise use
 	// (1s, 1s) (or develBackoff). Will also wrap the job such that it its next
 	// runtime is capped at 1s.
@@ -254,6 +384,11 @@ func New(ctx context.Context, db *dal.DAL, config Config, runnerScaling scaling.
 	svc.tasks.Parallel(maybeDevelTask(svc.heartbeatController, time.Second, time.Second*3, time.Second*5))
 	svc.tasks.Parallel(maybeDevelTask(svc.updateControllersList, time.Second, time.Second*5, time.Second*5))
 	svc.tasks.Parallel(maybeDevelTask(svc.executeAsyncCalls, time.Second, time.Second*5, time.Second*10))
+	svc.tasks.Parallel(maybeDevelTask(svc.refreshSLOStatus, time.Second*10, time.Second*5, time.Second*30))
+	if config.FaultInjection {
+		svc.tasks.Parallel(maybeDevelTask(svc.syncFaults, time.Second, time.Second, time.Second*5))
+	}
+	svc.tasks.Parallel(maybeDevelTask(svc.syncAdminResources, time.Second, time.Second, time.Second*5))
 
 	// This should be a singleton task, but because this is the task that
 	// actually expires the leases used to run singleton tasks, it must be
@@ -270,6 +405,19 @@ func New(ctx context.Context, db *dal.DAL, config Config, runnerScaling scaling.
 }
 
 func (s *Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	recorder := ingress.NewAccessRecorder(w, r)
+	requestKey := model.NewRequestKey(model.OriginIngress, fmt.Sprintf("%s %s", r.Method, r.URL.Path))
+	entry := ingress.AccessLogEntry{Time: start.UTC(), Method: r.Method, Path: r.URL.Path, RemoteAddr: r.RemoteAddr}
+	defer func() {
+		entry.Status = recorder.Status()
+		entry.Latency = time.Since(start)
+		entry.RequestBytes = recorder.RequestBytes()
+		entry.ResponseBytes = recorder.ResponseBytes()
+		s.logIngressRequest(r.Context(), entry)
+	}()
+	w = recorder
+
 	routes, err := s.dal.GetIngressRoutes(r.Context(), r.Method)
 	if err != nil {
 		if errors.Is(err, dalerrs.ErrNotFound) {
@@ -279,13 +427,82 @@ func (s *Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+	routes, err = s.filterIngressDomainRoutes(r.Context(), r.Host, routes)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
 	sch, err := s.getActiveSchema(r.Context())
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-	requestKey := model.NewRequestKey(model.OriginIngress, fmt.Sprintf("%s %s", r.Method, r.URL.Path))
-	ingress.Handle(sch, requestKey, routes, w, r, s.callWithRequest)
+	principal, err := ingress.AuthenticateRequest(r, s.config.Load().IngressJWTSecret)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	entry.Principal = ingress.PrincipalSubject(principal)
+	if route := ingress.Handle(sch, requestKey, principal, routes, w, r, s.callWithRequest); route != nil {
+		entry.Route = route.Path
+		entry.Module = route.Module
+		entry.Verb = route.Verb
+		entry.Deployment = route.Deployment.String()
+		entry.RequestKey = requestKey.String()
+	}
+}
+
+// logIngressRequest writes "entry" to the ingress access log, if it is
+// enabled and the request is sampled.
+func (s *Service) logIngressRequest(ctx context.Context, entry ingress.AccessLogEntry) {
+	config := s.config.Load().IngressAccessLog
+	if !config.Sampled(entry.Status, rand.Float64()) { //nolint:gosec
+		return
+	}
+	switch config.Output {
+	case ingress.AccessLogStdout:
+		if err := ingress.WriteAccessLog(os.Stdout, config.Format, entry); err != nil {
+			log.FromContext(ctx).Warnf("Failed to write ingress access log: %s", err)
+		}
+
+	case ingress.AccessLogDeployment:
+		// Requests that didn't match a route have no deployment to log to.
+		if entry.Deployment == "" {
+			return
+		}
+		attrs := map[string]string{
+			"deployment":    entry.Deployment,
+			"request":       entry.RequestKey,
+			"method":        entry.Method,
+			"path":          entry.Path,
+			"route":         entry.Route,
+			"verb":          entry.Module + "." + entry.Verb,
+			"status":        strconv.Itoa(entry.Status),
+			"latencyMs":     strconv.FormatInt(entry.Latency.Milliseconds(), 10),
+			"requestBytes":  strconv.FormatInt(entry.RequestBytes, 10),
+			"responseBytes": strconv.FormatInt(entry.ResponseBytes, 10),
+		}
+		if entry.Principal != "" {
+			attrs["principal"] = entry.Principal
+		}
+		err := s.deploymentLogsSink.Log(log.Entry{Time: entry.Time, Level: log.Info, Attributes: attrs, Message: entry.String()})
+		if err != nil {
+			log.FromContext(ctx).Warnf("Failed to write ingress access log: %s", err)
+		}
+	}
+}
+
+// ingressRouteFor returns the ingress route that will handle "r", if any.
+func (s *Service) ingressRouteFor(r *http.Request) (contract.Route, bool) {
+	routes, err := s.dal.GetIngressRoutes(r.Context(), r.Method)
+	if err != nil {
+		return contract.Route{}, false
+	}
+	route, err := ingress.GetIngressRoute(routes, r.Method, r.URL.Path)
+	if err != nil {
+		return contract.Route{}, false
+	}
+	return contract.Route{Module: route.Module, Verb: route.Verb, Method: r.Method, Path: route.Path}, true
 }
 
 func (s *Service) ProcessList(ctx context.Context, req *connect.Request[ftlv1.ProcessListRequest]) (*connect.Response[ftlv1.ProcessListResponse], error) {
@@ -293,6 +510,16 @@ func (s *Service) ProcessList(ctx context.Context, req *connect.Request[ftlv1.Pr
 	if err != nil {
 		return nil, err
 	}
+	processes = slices.Filter(processes, func(p dal.Process) bool { return inProjectScope(ctx, p.Labels) })
+	deployments, err := s.dal.GetActiveDeployments(ctx)
+	if err != nil {
+		return nil, err
+	}
+	schemas := map[string]*schema.Module{}
+	for _, deployment := range deployments {
+		schemas[deployment.Key.String()] = deployment.Schema
+	}
+	now := time.Now()
 	out, err := slices.MapErr(processes, func(p dal.Process) (*ftlv1.ProcessListResponse_Process, error) {
 		var runner *ftlv1.ProcessListResponse_ProcessRunner
 		if dbr, ok := p.Runner.Get(); ok {
@@ -310,12 +537,24 @@ func (s *Service) ProcessList(ctx context.Context, req *connect.Request[ftlv1.Pr
 		if err != nil {
 			return nil, fmt.Errorf("could not marshal labels for deployment %s: %w", p.Deployment, err)
 		}
-		return &ftlv1.ProcessListResponse_Process{
+		out := &ftlv1.ProcessListResponse_Process{
 			Deployment:  p.Deployment.String(),
 			MinReplicas: int32(p.MinReplicas),
 			Labels:      labels,
 			Runner:      runner,
-		}, nil
+			Crashes:     int32(p.Crashes),
+			Unhealthy:   s.config.Load().Reconcile.unhealthy(p.Crashes, p.LastCrashedAt, now),
+		}
+		metadata := moduleMetadataFromLabels(p.Labels)
+		if metadata.Owner != "" {
+			out.Owner = &metadata.Owner
+		}
+		if module, ok := schemas[p.Deployment.String()]; ok {
+			if description := metadata.description(module); description != "" {
+				out.Description = &description
+			}
+		}
+		return out, nil
 	})
 	if err != nil {
 		return nil, err
@@ -328,8 +567,14 @@ func (s *Service) Status(ctx context.Context, req *connect.Request[ftlv1.StatusR
 	if err != nil {
 		return nil, fmt.Errorf("could not get status: %w", err)
 	}
+	status = scopeStatus(ctx, status)
+	inScope := map[string]bool{}
+	for _, deployment := range status.Deployments {
+		inScope[deployment.Key.String()] = true
+	}
 	sroutes := s.routes.Load()
 	routes := slices.FlatMap(maps.Values(sroutes), func(routes []dal.Route) (out []*ftlv1.StatusResponse_Route) {
+		routes = slices.Filter(routes, func(route dal.Route) bool { return inScope[route.Deployment.String()] })
 		out = make([]*ftlv1.StatusResponse_Route, len(routes))
 		for i, route := range routes {
 			out[i] = &ftlv1.StatusResponse_Route{
@@ -451,15 +696,38 @@ func (s *Service) GetSchema(ctx context.Context, c *connect.Request[ftlv1.GetSch
 	modules = append(modules, slices.Map(schemas, func(d *schema.Module) *schemapb.Module {
 		return d.ToProto().(*schemapb.Module) //nolint:forcetypeassert
 	})...)
+	modules, err = slices.MapErr(modules, func(m *schemapb.Module) (*schemapb.Module, error) {
+		return moduleForClient(ctx, m)
+	})
+	if err != nil {
+		return nil, err
+	}
 	return connect.NewResponse(&ftlv1.GetSchemaResponse{Schema: &schemapb.Schema{Modules: modules}}), nil
 }
 
 func (s *Service) PullSchema(ctx context.Context, req *connect.Request[ftlv1.PullSchemaRequest], stream *connect.ServerStream[ftlv1.PullSchemaResponse]) error {
 	return s.watchModuleChanges(ctx, func(response *ftlv1.PullSchemaResponse) error {
+		if response.Schema != nil {
+			module, err := moduleForClient(ctx, response.Schema)
+			if err != nil {
+				return err
+			}
+			response.Schema = module
+		}
 		return stream.Send(response)
 	})
 }
 
+// moduleForClient downgrades a module to the version of the schema wire format
+// understood by the client of the current request.
+func moduleForClient(ctx context.Context, module *schemapb.Module) (*schemapb.Module, error) {
+	out, err := schema.DowngradeModuleProto(module, rpc.SchemaVersionFromContext(ctx))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	return out, nil
+}
+
 func (s *Service) UpdateDeploy(ctx context.Context, req *connect.Request[ftlv1.UpdateDeployRequest]) (response *connect.Response[ftlv1.UpdateDeployResponse], err error) {
 	deploymentKey, err := model.ParseDeploymentKey(req.Msg.DeploymentKey)
 	if err != nil {
@@ -469,6 +737,14 @@ func (s *Service) UpdateDeploy(ctx context.Context, req *connect.Request[ftlv1.U
 	logger := s.getDeploymentLogger(ctx, deploymentKey)
 	logger.Debugf("Update deployment for: %s", deploymentKey)
 
+	deployment, err := s.checkDeploymentScope(ctx, deploymentKey)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkProjectQuotas(ctx, deploymentProject(deployment.Labels), deployment.Module, int(req.Msg.MinReplicas)); err != nil {
+		return nil, err
+	}
+
 	err = s.dal.SetDeploymentReplicas(ctx, deploymentKey, int(req.Msg.MinReplicas))
 	if err != nil {
 		if errors.Is(err, dalerrs.ErrNotFound) {
@@ -491,6 +767,14 @@ func (s *Service) ReplaceDeploy(ctx context.Context, c *connect.Request[ftlv1.Re
 	logger := s.getDeploymentLogger(ctx, newDeploymentKey)
 	logger.Debugf("Replace deployment for: %s", newDeploymentKey)
 
+	deployment, err := s.checkDeploymentScope(ctx, newDeploymentKey)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkProjectQuotas(ctx, deploymentProject(deployment.Labels), deployment.Module, int(c.Msg.MinReplicas)); err != nil {
+		return nil, err
+	}
+
 	err = s.dal.ReplaceDeployment(ctx, newDeploymentKey, int(c.Msg.MinReplicas))
 	if err != nil {
 		if errors.Is(err, dalerrs.ErrNotFound) {
@@ -533,6 +817,7 @@ func (s *Service) RegisterRunner(ctx context.Context, stream *connect.ClientStre
 		if !initialised {
 			// Deregister the runner if the Runner disconnects.
 			defer func() {
+				s.artefactCaches.remove(runnerKey)
 				err := s.dal.DeregisterRunner(context.Background(), runnerKey)
 				if err != nil {
 					logger.Errorf(err, "Could not deregister runner %s", runnerStr)
@@ -561,6 +846,10 @@ func (s *Service) RegisterRunner(ctx context.Context, stream *connect.ClientStre
 		} else if err != nil {
 			return nil, err
 		}
+		s.artefactCaches.update(runnerKey, msg.ArtefactCache)
+		if deployment, ok := maybeDeployment.Get(); ok && msg.Error != nil {
+			s.reportCrash(ctx, deployment, *msg.Error)
+		}
 
 		routes, err := s.dal.GetRoutingTable(ctx, nil)
 		if errors.Is(err, dalerrs.ErrNotFound) {
@@ -576,11 +865,39 @@ func (s *Service) RegisterRunner(ctx context.Context, stream *connect.ClientStre
 	return connect.NewResponse(&ftlv1.RegisterRunnerResponse{}), nil
 }
 
+// reportCrash records that a replica of "deployment" terminated with an
+// error, delaying its replacement with exponential backoff, and notifies
+// webhooks if the deployment is crash looping.
+func (s *Service) reportCrash(ctx context.Context, deployment model.DeploymentKey, reason string) {
+	logger := s.getDeploymentLogger(ctx, deployment)
+	crashes, err := s.dal.RecordDeploymentCrash(ctx, deployment, s.config.Load().Reconcile.CrashResetAfter)
+	if err != nil {
+		logger.Errorf(err, "Could not record crash of deployment %s", deployment)
+	} else {
+		delay := s.config.Load().Reconcile.crashRestartDelay(crashes)
+		if crashes >= s.config.Load().Reconcile.CrashLoopThreshold {
+			logger.Errorf(errors.New(reason), "Deployment %s is unhealthy after crashing %d times in a row, restarting in %s", deployment, crashes, delay)
+		} else {
+			logger.Warnf("Deployment %s crashed (%d), restarting in %s: %s", deployment, crashes, delay, reason)
+		}
+	}
+	if !s.crashLoops.Crashed(deployment.String(), time.Now()) {
+		return
+	}
+	s.getDeploymentLogger(ctx, deployment).Warnf("Deployment %s is crash looping: %s", deployment, reason)
+	s.webhooks.Notify(ctx, webhooks.Event{
+		Type:          webhooks.EventDeploymentCrashLoop,
+		Module:        deployment.Payload.Module,
+		DeploymentKey: deployment.String(),
+		Message:       fmt.Sprintf("Deployment %s is crash looping: %s", deployment, reason),
+	})
+}
+
 // Check if we can contact the runner.
 func (s *Service) pingRunner(ctx context.Context, endpoint *url.URL) error {
-	client := rpc.Dial(ftlv1connect.NewRunnerServiceClient, endpoint.String(), log.Error)
+	client := rpc.DialPool(s.runnerPool, ftlv1connect.NewRunnerServiceClient, endpoint.String(), log.Error)
 	retry := backoff.Backoff{}
-	heartbeatCtx, cancel := context.WithTimeout(ctx, s.config.RunnerTimeout)
+	heartbeatCtx, cancel := context.WithTimeout(ctx, s.config.Load().RunnerTimeout)
 	defer cancel()
 	err := rpc.Wait(heartbeatCtx, retry, client)
 	if err != nil {
@@ -598,12 +915,29 @@ func (s *Service) GetDeployment(ctx context.Context, req *connect.Request[ftlv1.
 	logger := s.getDeploymentLogger(ctx, deployment.Key)
 	logger.Debugf("Get deployment for: %s", deployment.Key.String())
 
+	module, err := moduleForClient(ctx, deployment.Schema.ToProto().(*schemapb.Module)) //nolint:forcetypeassert
+	if err != nil {
+		return nil, err
+	}
 	return connect.NewResponse(&ftlv1.GetDeploymentResponse{
-		Schema:    deployment.Schema.ToProto().(*schemapb.Module), //nolint:forcetypeassert
+		Schema:    module,
 		Artefacts: slices.Map(deployment.Artefacts, ftlv1.ArtefactToProto),
 	}), nil
 }
 
+func (s *Service) GetPrefetchCandidates(ctx context.Context, req *connect.Request[ftlv1.GetPrefetchCandidatesRequest]) (*connect.Response[ftlv1.GetPrefetchCandidatesResponse], error) {
+	if req.Msg.Limit <= 0 {
+		return connect.NewResponse(&ftlv1.GetPrefetchCandidatesResponse{}), nil
+	}
+	keys, err := s.dal.GetPrefetchCandidates(ctx, req.Msg.Languages, time.Now().Add(-s.config.Load().PrefetchWindow), int(req.Msg.Limit))
+	if err != nil {
+		return nil, fmt.Errorf("could not get prefetch candidates: %w", err)
+	}
+	return connect.NewResponse(&ftlv1.GetPrefetchCandidatesResponse{
+		DeploymentKeys: slices.Map(keys, func(key model.DeploymentKey) string { return key.String() }),
+	}), nil
+}
+
 func (s *Service) GetDeploymentArtefacts(ctx context.Context, req *connect.Request[ftlv1.GetDeploymentArtefactsRequest], resp *connect.ServerStream[ftlv1.GetDeploymentArtefactsResponse]) error {
 	deployment, err := s.getDeployment(ctx, req.Msg.DeploymentKey)
 	if err != nil {
@@ -614,7 +948,13 @@ func (s *Service) GetDeploymentArtefacts(ctx context.Context, req *connect.Reque
 	logger := s.getDeploymentLogger(ctx, deployment.Key)
 	logger.Debugf("Get deployment artefacts for: %s", deployment.Key.String())
 
-	chunk := make([]byte, s.config.ArtefactChunkSize)
+	// Each chunk is compressed separately, so that the chunks of an artefact
+	// decompress as a whole once they're concatenated.
+	compress := headers.AcceptsCompression(req.Header(), compression.Zstd)
+	if compress {
+		headers.SetCompression(resp.ResponseHeader(), compression.Zstd)
+	}
+	chunk := make([]byte, s.config.Load().ArtefactChunkSize)
 nextArtefact:
 	for _, artefact := range deployment.Artefacts {
 		for _, clientArtefact := range req.Msg.HaveArtefacts {
@@ -622,15 +962,25 @@ nextArtefact:
 				continue nextArtefact
 			}
 		}
+		// Send blocks while the client's flow control window is full, so a
+		// slow client is never sent more than it can receive.
+		sent := false
 		for {
 			n, err := artefact.Content.Read(chunk)
-			if n != 0 {
+			// Empty artefacts are sent as a single empty chunk so that the
+			// client still creates them.
+			if n != 0 || (!sent && errors.Is(err, io.EOF)) {
+				out := chunk[:n]
+				if compress && n != 0 {
+					out = compression.Compress(out)
+				}
 				if err := resp.Send(&ftlv1.GetDeploymentArtefactsResponse{
 					Artefact: ftlv1.ArtefactToProto(artefact),
-					Chunk:    chunk[:n],
+					Chunk:    out,
 				}); err != nil {
 					return fmt.Errorf("could not send artefact chunk: %w", err)
 				}
+				sent = true
 			}
 			if errors.Is(err, io.EOF) {
 				break
@@ -643,7 +993,7 @@ nextArtefact:
 }
 
 func (s *Service) Ping(ctx context.Context, req *connect.Request[ftlv1.PingRequest]) (*connect.Response[ftlv1.PingResponse], error) {
-	if len(s.config.WaitFor) == 0 {
+	if len(s.config.Load().WaitFor) == 0 {
 		return connect.NewResponse(&ftlv1.PingResponse{}), nil
 	}
 
@@ -654,7 +1004,7 @@ func (s *Service) Ping(ctx context.Context, req *connect.Request[ftlv1.PingReque
 	}
 	var missing []string
 nextModule:
-	for _, module := range s.config.WaitFor {
+	for _, module := range s.config.Load().WaitFor {
 		for _, m := range modules {
 			replicas, ok := m.Replicas.Get()
 			if ok && replicas > 0 && m.Module == module {
@@ -694,13 +1044,23 @@ func (s *Service) GetModuleContext(ctx context.Context, req *connect.Request[ftl
 		if err != nil {
 			return connect.NewError(connect.CodeInternal, fmt.Errorf("could not get databases: %w", err))
 		}
+		flags, err := s.moduleFeatureFlags(ctx, name)
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("could not get feature flags: %w", err))
+		}
+		logLevel, err := s.dal.GetModuleLogLevel(ctx, name)
+		if err != nil {
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("could not get log level: %w", err))
+		}
 
 		checksum := configurationMapChecksum(configs)
 		checksum = (checksum * 115163) + configurationMapChecksum(secrets)
 		checksum = (checksum * 454213) + configurationDatabaseChecksum(databases)
+		checksum = (checksum * 324889) + configurationMapChecksum(flags)
+		checksum = (checksum * 275987) + int64(logLevel)
 
 		if checksum != lastChecksum {
-			response := modulecontext.NewBuilder(name).AddConfigs(configs).AddSecrets(secrets).AddDatabases(databases).Build().ToProto()
+			response := modulecontext.NewBuilder(name).AddConfigs(configs).AddSecrets(secrets).AddDatabases(databases).AddFlags(flags).SetLogLevel(logLevel).Build().ToProto()
 
 			if err := resp.Send(response); err != nil {
 				return connect.NewError(connect.CodeInternal, fmt.Errorf("could not send response: %w", err))
@@ -712,7 +1072,7 @@ func (s *Service) GetModuleContext(ctx context.Context, req *connect.Request[ftl
 		select {
 		case <-ctx.Done():
 			return nil
-		case <-time.After(s.config.ModuleUpdateFrequency):
+		case <-time.After(s.config.Load().ModuleUpdateFrequency):
 		}
 	}
 }
@@ -776,28 +1136,57 @@ func (s *Service) Call(ctx context.Context, req *connect.Request[ftlv1.CallReque
 	return s.callWithRequest(ctx, req, optional.None[model.RequestKey](), "")
 }
 
-func (s *Service) SendFSMEvent(ctx context.Context, req *connect.Request[ftlv1.SendFSMEventRequest]) (resp *connect.Response[ftlv1.SendFSMEventResponse], err error) {
+func (s *Service) SendFSMEvent(ctx context.Context, req *connect.Request[ftlv1.SendFSMEventRequest]) (*connect.Response[ftlv1.SendFSMEventResponse], error) {
 	msg := req.Msg
+	eventType := schema.TypeFromProto(msg.Event)
+	err := s.startFSMTransition(ctx, schema.RefFromProto(msg.Fsm), msg.Instance, msg.Body, "type "+eventType.String(), func(_ *schema.Ref, verb *schema.Verb) bool {
+		return eventType.Equal(verb.Request)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&ftlv1.SendFSMEventResponse{}), nil
+}
+
+func (s *Service) SendFSMTransition(ctx context.Context, req *connect.Request[ftlv1.SendFSMTransitionRequest]) (*connect.Response[ftlv1.SendFSMTransitionResponse], error) {
+	msg := req.Msg
+	fsmRef := schema.RefFromProto(msg.Fsm)
+	state := schema.RefFromProto(msg.State)
+	if state.Module == "" {
+		state.Module = fsmRef.Module
+	}
+	err := s.startFSMTransition(ctx, fsmRef, msg.Instance, msg.Body, "state "+state.String(), func(ref *schema.Ref, _ *schema.Verb) bool {
+		return ref.ToRefKey() == state.ToRefKey()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&ftlv1.SendFSMTransitionResponse{}), nil
+}
+
+// startFSMTransition starts a transition of an FSM instance to the first
+// valid destination state accepted by match.
+//
+// "target" describes what is being matched, for error messages.
+func (s *Service) startFSMTransition(ctx context.Context, fsmRef *schema.Ref, instanceKey string, body []byte, target string, match func(ref *schema.Ref, verb *schema.Verb) bool) (err error) {
 	sch := s.schema.Load()
 	// Resolve the FSM.
 	fsm := &schema.FSM{}
-	if err := sch.ResolveToType(schema.RefFromProto(msg.Fsm), fsm); err != nil {
-		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("fsm not found: %w", err))
+	if err := sch.ResolveToType(fsmRef, fsm); err != nil {
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("fsm not found: %w", err))
 	}
 
-	eventType := schema.TypeFromProto(msg.Event)
-
-	fsmKey := schema.RefFromProto(msg.Fsm).ToRefKey()
+	fsmKey := fsmRef.ToRefKey()
 
 	tx, err := s.dal.Begin(ctx)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("could not start transaction: %w", err))
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("could not start transaction: %w", err))
 	}
 	defer tx.CommitOrRollback(ctx, &err)
 
-	instance, err := tx.AcquireFSMInstance(ctx, fsmKey, msg.Instance)
+	instance, err := tx.AcquireFSMInstance(ctx, fsmKey, instanceKey)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("could not acquire fsm instance: %w", err))
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("could not acquire fsm instance: %w", err))
 	}
 	defer instance.Release() //nolint:errcheck
 
@@ -813,7 +1202,7 @@ func (s *Service) SendFSMEvent(ctx context.Context, req *connect.Request[ftlv1.S
 			return false, connect.NewError(connect.CodeNotFound, fmt.Errorf("fsm: destination verb %s not found: %w", ref, err))
 		}
 		candidates = append(candidates, verb.Name)
-		if !eventType.Equal(verb.Request) {
+		if !match(ref, verb) {
 			return false, nil
 		}
 
@@ -826,7 +1215,7 @@ func (s *Service) SendFSMEvent(ctx context.Context, req *connect.Request[ftlv1.S
 	if !instance.CurrentState.Ok() {
 		for _, start := range fsm.Start {
 			if brk, err := updateCandidates(start); err != nil {
-				return nil, err
+				return err
 			} else if brk {
 				break
 			}
@@ -839,7 +1228,7 @@ func (s *Service) SendFSMEvent(ctx context.Context, req *connect.Request[ftlv1.S
 				continue
 			}
 			if brk, err := updateCandidates(transition.To); err != nil {
-				return nil, err
+				return err
 			} else if brk {
 				break
 			}
@@ -848,27 +1237,35 @@ func (s *Service) SendFSMEvent(ctx context.Context, req *connect.Request[ftlv1.S
 
 	if destinationRef == nil {
 		if len(candidates) > 0 {
-			return nil, connect.NewError(connect.CodeFailedPrecondition,
-				fmt.Errorf("no transition found from state %s for type %s, candidates are %s", instance.CurrentState, eventType, strings.Join(candidates, ", ")))
+			return connect.NewError(connect.CodeFailedPrecondition,
+				fmt.Errorf("no transition found from state %s for %s, candidates are %s", instance.CurrentState, target, strings.Join(candidates, ", ")))
 		}
-		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("no transition found from state %s for type %s", instance.CurrentState, eventType))
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("no transition found from state %s for %s", instance.CurrentState, target))
 	}
 
 	retryParams, err := schema.RetryParamsForFSMTransition(fsm, destinationVerb)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return connect.NewError(connect.CodeInternal, err)
 	}
 
-	err = tx.StartFSMTransition(ctx, instance.FSM, instance.Key, destinationRef.ToRefKey(), msg.Body, retryParams)
+	requestKey, err := rpc.RequestKeyFromContext(ctx)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("could not start fsm transition: %w", err))
+		return connect.NewError(connect.CodeInvalidArgument, err)
 	}
-	return connect.NewResponse(&ftlv1.SendFSMEventResponse{}), nil
+	err = tx.StartFSMTransition(ctx, instance.FSM, instance.Key, destinationRef.ToRefKey(), body, retryParams, requestKey)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("could not start fsm transition: %w", err))
+	}
+	return nil
 }
 
 func (s *Service) PublishEvent(ctx context.Context, req *connect.Request[ftlv1.PublishEventRequest]) (*connect.Response[ftlv1.PublishEventResponse], error) {
 	// Publish the event.
-	err := s.dal.PublishEventForTopic(ctx, req.Msg.Topic.Module, req.Msg.Topic.Name, req.Msg.Body)
+	requestKey, err := rpc.RequestKeyFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	err = s.dal.PublishEventForTopic(ctx, req.Msg.Topic.Module, req.Msg.Topic.Name, req.Msg.Body, requestKey, optional.Ptr(req.Msg.OrderingKey))
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to publish a event to topic %s:%s: %w", req.Msg.Topic.Module, req.Msg.Topic.Name, err))
 	}
@@ -882,52 +1279,190 @@ func (s *Service) callWithRequest(
 	sourceAddress string,
 ) (*connect.Response[ftlv1.CallResponse], error) {
 	start := time.Now()
+	ctx, call, err := s.prepareCall(ctx, req, key, sourceAddress, false)
+	if err != nil {
+		return nil, err
+	}
+
+	cache, cacheable := call.verb.GetMetadataCache().Get()
+	var cacheKey string
+	if cacheable {
+		cacheKey, err = s.callCache.key(call.verbRef, cache, req.Msg.Body)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		if !headers.IsCacheBypass(req.Header()) {
+			if cached, ok := s.callCache.get(ctx, call.verbRef, cacheKey); ok {
+				s.recordCall(ctx, &Call{
+					deploymentKey: call.route.Deployment,
+					requestKey:    call.requestKey,
+					startTime:     start,
+					destVerb:      call.verbRef,
+					callers:       call.callers,
+					deprecated:    call.deprecated,
+					request:       req.Msg,
+					response:      optional.Some(cached),
+				})
+				return connect.NewResponse(cached), nil
+			}
+		}
+	}
+
+	var response *connect.Response[ftlv1.CallResponse]
+	err = s.injectCallFaults(ctx, call.verbRef)
+	if err == nil {
+		response, err = call.client.verb.Call(ctx, req)
+	}
+	var resp *connect.Response[ftlv1.CallResponse]
+	var maybeResponse optional.Option[*ftlv1.CallResponse]
+	if err == nil {
+		maybeResponse = optional.Some(response.Msg)
+		err = s.config.Load().CallPayload.check(call.verbRef, PayloadResponse, len(response.Msg.GetBody()))
+	}
+	if err == nil {
+		resp = connect.NewResponse(response.Msg)
+		if cacheable {
+			s.callCache.set(cacheKey, cache, resp.Msg)
+		}
+	}
+	s.recordCall(ctx, &Call{
+		deploymentKey: call.route.Deployment,
+		requestKey:    call.requestKey,
+		startTime:     start,
+		destVerb:      call.verbRef,
+		callers:       call.callers,
+		deprecated:    call.deprecated,
+		callError:     optional.Nil(err),
+		request:       req.Msg,
+		response:      maybeResponse,
+	})
+	return resp, err
+}
+
+func (s *Service) CallStream(ctx context.Context, req *connect.Request[ftlv1.CallRequest], stream *connect.ServerStream[ftlv1.CallResponse]) error {
+	start := time.Now()
+	ctx, call, err := s.prepareCall(ctx, req, optional.None[model.RequestKey](), "", true)
+	if err != nil {
+		return err
+	}
+
+	err = s.injectCallFaults(ctx, call.verbRef)
+	if err == nil {
+		err = s.forwardCallStream(ctx, call.client, req, stream)
+	}
+	// Only the final response is recorded, as streams may be arbitrarily long.
+	s.recordCall(ctx, &Call{
+		deploymentKey: call.route.Deployment,
+		requestKey:    call.requestKey,
+		startTime:     start,
+		destVerb:      call.verbRef,
+		callers:       call.callers,
+		deprecated:    call.deprecated,
+		callError:     optional.Nil(err),
+		request:       req.Msg,
+	})
+	return err
+}
+
+func (s *Service) forwardCallStream(ctx context.Context, client clients, req *connect.Request[ftlv1.CallRequest], stream *connect.ServerStream[ftlv1.CallResponse]) error {
+	upstream, err := client.verb.CallStream(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer upstream.Close()
+	verbRef := schema.RefFromProto(req.Msg.Verb)
+	for upstream.Receive() {
+		if err := s.config.Load().CallPayload.check(verbRef, PayloadResponse, len(upstream.Msg().GetBody())); err != nil {
+			return err
+		}
+		if err := stream.Send(upstream.Msg()); err != nil {
+			return err
+		}
+	}
+	return upstream.Err()
+}
+
+// preparedCall is a validated call routed to a deployment.
+type preparedCall struct {
+	verbRef    *schema.Ref
+	verb       *schema.Verb
+	route      dal.Route
+	client     clients
+	callers    []*schema.Ref
+	requestKey model.RequestKey
+	deprecated bool
+}
+
+// prepareCall validates a call, resolves its route and request key, and
+// updates the request headers for forwarding it to the runner.
+func (s *Service) prepareCall(
+	ctx context.Context,
+	req *connect.Request[ftlv1.CallRequest],
+	key optional.Option[model.RequestKey],
+	sourceAddress string,
+	streaming bool,
+) (context.Context, preparedCall, error) {
 	if req.Msg.Verb == nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("verb is required"))
+		return nil, preparedCall{}, connect.NewError(connect.CodeInvalidArgument, errors.New("verb is required"))
 	}
 	if req.Msg.Body == nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("body is required"))
+		return nil, preparedCall{}, connect.NewError(connect.CodeInvalidArgument, errors.New("body is required"))
+	}
+
+	verbRef := schema.RefFromProto(req.Msg.Verb)
+	// Checked before the body is parsed, so that oversized bodies are cheap to reject.
+	if err := s.config.Load().CallPayload.check(verbRef, PayloadRequest, len(req.Msg.Body)); err != nil {
+		return nil, preparedCall{}, err
 	}
 
 	sch, err := s.getActiveSchema(ctx)
 	if err != nil {
-		return nil, err
+		return nil, preparedCall{}, err
 	}
 
-	verbRef := schema.RefFromProto(req.Msg.Verb)
 	verb := &schema.Verb{}
 
 	if err = sch.ResolveToType(verbRef, verb); err != nil {
 		if errors.Is(err, schema.ErrNotFound) {
-			return nil, connect.NewError(connect.CodeNotFound, err)
+			return nil, preparedCall{}, connect.NewError(connect.CodeNotFound, err)
 		}
-		return nil, err
+		return nil, preparedCall{}, err
+	}
+
+	if verb.IsStream() && !streaming {
+		return nil, preparedCall{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("verb %q streams its responses and must be called with CallStream", verbRef))
+	} else if !verb.IsStream() && streaming {
+		return nil, preparedCall{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("verb %q does not stream its responses and must be called with Call", verbRef))
 	}
 
 	err = ingress.ValidateCallBody(req.Msg.Body, verb, sch)
 	if err != nil {
-		return nil, err
+		return nil, preparedCall{}, err
 	}
 
 	module := verbRef.Module
+	if err := s.quotas.AllowCall(module, time.Now()); err != nil {
+		return nil, preparedCall{}, err
+	}
 	routes, ok := s.routes.Load()[module]
 	if !ok {
-		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no routes for module %q", module))
+		return nil, preparedCall{}, connect.NewError(connect.CodeNotFound, fmt.Errorf("no routes for module %q", module))
 	}
 	route := routes[rand.Intn(len(routes))] //nolint:gosec
 	client := s.clientsForEndpoint(route.Endpoint)
 
 	callers, err := headers.GetCallers(req.Header())
 	if err != nil {
-		return nil, err
+		return nil, preparedCall{}, err
 	}
 
-	if !verb.IsExported() {
-		for _, caller := range callers {
-			if caller.Module != module {
-				return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("verb %q is not exported", verbRef))
-			}
-		}
+	if err := s.checkCallers(ctx, verbRef, verb, callers); err != nil {
+		return nil, preparedCall{}, err
+	}
+
+	deprecated, isDeprecated := verb.GetMetadataDeprecated().Get()
+	if isDeprecated {
+		logDeprecatedCall(ctx, verbRef, callers, deprecated)
 	}
 
 	var requestKey model.RequestKey
@@ -938,11 +1473,14 @@ func (s *Service) callWithRequest(
 	} else {
 		k, ok, err := headers.GetRequestKey(req.Header())
 		if err != nil {
-			return nil, err
+			return nil, preparedCall{}, err
 		} else if !ok {
 			requestKey = model.NewRequestKey(model.OriginIngress, "grpc")
 			sourceAddress = req.Peer().Addr
 			isNewRequestKey = true
+			// Principals are only authenticated by HTTP ingress.
+			req.Header().Del(headers.PrincipalHeader)
+			ctx = rpc.WithPrincipal(ctx, nil)
 		} else {
 			requestKey = k
 		}
@@ -950,30 +1488,78 @@ func (s *Service) callWithRequest(
 	if isNewRequestKey {
 		headers.SetRequestKey(req.Header(), requestKey)
 		if err = s.dal.CreateRequest(ctx, requestKey, sourceAddress); err != nil {
-			return nil, err
+			return nil, preparedCall{}, err
 		}
 	}
 
 	ctx = rpc.WithVerbs(ctx, append(callers, verbRef))
 	headers.AddCaller(req.Header(), schema.RefFromProto(req.Msg.Verb))
+	return ctx, preparedCall{
+		verbRef:    verbRef,
+		verb:       verb,
+		route:      route,
+		client:     client,
+		callers:    callers,
+		requestKey: requestKey,
+		deprecated: isDeprecated,
+	}, nil
+}
 
-	response, err := client.verb.Call(ctx, req)
-	resp := connect.NewResponse(response.Msg)
-	var maybeResponse optional.Option[*ftlv1.CallResponse]
-	if resp != nil {
-		maybeResponse = optional.Some(resp.Msg)
+// checkCallers returns an error if any of the verbs in a call chain are not
+// allowed to call "verb".
+func (s *Service) checkCallers(ctx context.Context, verbRef *schema.Ref, verb *schema.Verb, callers []*schema.Ref) error {
+	if !verb.IsExported() {
+		for _, caller := range callers {
+			if caller.Module != verbRef.Module {
+				return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("verb %q is not exported", verbRef))
+			}
+		}
 	}
-	s.recordCall(ctx, &Call{
-		deploymentKey: route.Deployment,
-		requestKey:    requestKey,
-		startTime:     start,
-		destVerb:      verbRef,
-		callers:       callers,
-		callError:     optional.Nil(err),
-		request:       req.Msg,
-		response:      maybeResponse,
-	})
-	return resp, err
+	if verb.Internal {
+		if err := s.checkInternalCall(ctx, verbRef, callers); err != nil {
+			return err
+		}
+	}
+	return checkAllowedCaller(verb, verbRef, callers)
+}
+
+// checkInternalCall returns an error if a verb that is internal to its project
+// is called from a module deployed from a different project.
+func (s *Service) checkInternalCall(ctx context.Context, verbRef *schema.Ref, callers []*schema.Ref) error {
+	deployments, err := s.dal.GetActiveDeployments(ctx)
+	if err != nil {
+		return err
+	}
+	projects := moduleProjects(deployments)
+	project := projects[verbRef.Module]
+	for _, caller := range callers {
+		if caller.Module == verbRef.Module {
+			continue
+		}
+		if project == "" || projects[caller.Module] != project {
+			return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("verb %q is internal to its project and can not be called from module %q", verbRef, caller.Module))
+		}
+	}
+	return nil
+}
+
+// checkAllowedCaller returns an error if "verb" restricts the modules that can
+// call it and the module of its immediate caller is not one of them.
+//
+// Calls that are not made by a verb, such as from ingress or "ftl call", are
+// always allowed. Callers are identified by the Verb header, which is set by
+// the module making the call and not authenticated, so the check guards
+// against accidental dependencies rather than untrusted clients.
+func checkAllowedCaller(verb *schema.Verb, verbRef *schema.Ref, callers []*schema.Ref) error {
+	allow, ok := verb.GetMetadataAllow().Get()
+	if !ok || len(callers) == 0 {
+		return nil
+	}
+	caller := callers[len(callers)-1]
+	if caller.Module == verbRef.Module || allow.Allows(caller.Module) {
+		return nil
+	}
+	return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("verb %q can not be called from module %q", verbRef, caller.Module))
 }
 
 func (s *Service) GetArtefactDiffs(ctx context.Context, req *connect.Request[ftlv1.GetArtefactDiffsRequest]) (*connect.Response[ftlv1.GetArtefactDiffsResponse], error) {
@@ -985,14 +1571,58 @@ func (s *Service) GetArtefactDiffs(ctx context.Context, req *connect.Request[ftl
 	if err != nil {
 		return nil, err
 	}
-	return connect.NewResponse(&ftlv1.GetArtefactDiffsResponse{
-		MissingDigests: slices.Map(need, func(s sha256.SHA256) string { return s.String() }),
-	}), nil
+	chunkDigests, err := slices.MapErr(req.Msg.ClientChunkDigests, sha256.ParseSHA256)
+	if err != nil {
+		return nil, err
+	}
+	var needChunks []sha256.SHA256
+	if len(chunkDigests) > 0 {
+		needChunks, err = s.dal.GetMissingArtefactChunks(ctx, chunkDigests)
+		if err != nil {
+			return nil, err
+		}
+	}
+	resp := connect.NewResponse(&ftlv1.GetArtefactDiffsResponse{
+		MissingDigests:      slices.Map(need, func(s sha256.SHA256) string { return s.String() }),
+		MissingChunkDigests: slices.Map(needChunks, func(s sha256.SHA256) string { return s.String() }),
+		AcceptsChunks:       true,
+	})
+	// Artefacts can be uploaded compressed.
+	headers.SetAcceptCompression(resp.Header(), compression.Zstd)
+	return resp, nil
 }
 
+// UploadArtefact stores an artefact uploaded either with all of its content,
+// or in chunks. Chunks the controller already has, as reported by
+// GetArtefactDiffs, can be uploaded without their content.
+//
+// If the request has a compression header the content, or that of each
+// chunk, is compressed. Decompression stops as soon as the content exceeds
+// the artefact size quota of the project.
 func (s *Service) UploadArtefact(ctx context.Context, req *connect.Request[ftlv1.UploadArtefactRequest]) (*connect.Response[ftlv1.UploadArtefactResponse], error) {
 	logger := log.FromContext(ctx)
-	digest, err := s.dal.CreateArtefact(ctx, req.Msg.Content)
+	limit, ok := s.quotas.ArtefactSizeLimit(rpc.ProjectFromContext(ctx).Default(""))
+	if !ok {
+		limit = compression.MaxDecompressedSize
+	}
+	if err := decompressArtefactUpload(req, limit); err != nil {
+		return nil, err
+	}
+	content := req.Msg.Content
+	if len(req.Msg.Chunks) > 0 {
+		if len(content) > 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("an artefact can be uploaded with either its content or its chunks, not both"))
+		}
+		var err error
+		content, err = s.assembleArtefactChunks(ctx, req.Msg.Chunks)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := s.quotas.CheckArtefactSize(rpc.ProjectFromContext(ctx).Default(""), len(content)); err != nil {
+		return nil, err
+	}
+	digest, err := s.dal.CreateArtefact(ctx, content)
 	if err != nil {
 		return nil, err
 	}
@@ -1034,14 +1664,47 @@ func (s *Service) CreateDeployment(ctx context.Context, req *connect.Request[ftl
 		return nil, fmt.Errorf("invalid module schema: %w", err)
 	}
 
-	ingressRoutes := extractIngressRoutingEntries(req.Msg)
+	ingressRoutes := ingress.RoutingEntries(req.Msg.Schema)
 	cronJobs, err := s.cronJobs.NewCronJobsForModule(ctx, req.Msg.Schema)
 	if err != nil {
 		logger.Errorf(err, "Could not generate cron jobs for new deployment")
 		return nil, fmt.Errorf("could not generate cron jobs for new deployment: %w", err)
 	}
 
-	dkey, err := s.dal.CreateDeployment(ctx, ms.Runtime.Language, module, artefacts, ingressRoutes, cronJobs)
+	project := ms.Runtime.GetProject()
+	if scope, ok := rpc.ProjectFromContext(ctx).Get(); ok {
+		if project != "" && project != scope {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("module %q was built for project %q, not %q", module.Name, project, scope))
+		}
+		project = scope
+	}
+	activeDeployments, err := s.dal.GetActiveDeployments(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get active deployments: %w", err)
+	}
+	if err := checkModuleOwner(activeDeployments, module.Name, project); err != nil {
+		logger.Errorf(err, "Could not create deployment")
+		return nil, err
+	}
+	labels := model.Labels{}
+	if project != "" {
+		labels[model.ProjectLabel] = project
+	}
+	// Deployments built for a platform can only be run by runners on it.
+	if os, arch := ms.Runtime.GetOs(), ms.Runtime.GetArch(); os != "" && arch != "" {
+		labels[model.OSLabel] = os
+		labels[model.ArchLabel] = arch
+	}
+	moduleMetadataFromRuntime(ms.Runtime).addLabels(labels)
+
+	if databases, ok := s.provisioner.Get(); ok {
+		if err := databases.ProvisionModule(ctx, module); err != nil {
+			logger.Errorf(err, "Could not provision databases")
+			return nil, fmt.Errorf("could not provision databases: %w", err)
+		}
+	}
+
+	dkey, err := s.dal.CreateDeployment(ctx, ms.Runtime.Language, module, labels, artefacts, ingressRoutes, cronJobs)
 	if err != nil {
 		logger.Errorf(err, "Could not create deployment")
 		return nil, fmt.Errorf("could not create deployment: %w", err)
@@ -1092,8 +1755,8 @@ func (s *Service) clientsForEndpoint(endpoint string) clients {
 		return clientItem.Value()
 	}
 	client := clients{
-		runner: rpc.Dial(ftlv1connect.NewRunnerServiceClient, endpoint, log.Error),
-		verb:   rpc.Dial(ftlv1connect.NewVerbServiceClient, endpoint, log.Error),
+		runner: rpc.DialPool(s.runnerPool, ftlv1connect.NewRunnerServiceClient, endpoint, log.Error),
+		verb:   rpc.DialPool(s.runnerPool, ftlv1connect.NewVerbServiceClient, endpoint, log.Error),
 	}
 	s.clients.Set(endpoint, client, time.Minute)
 	return client
@@ -1101,13 +1764,13 @@ func (s *Service) clientsForEndpoint(endpoint string) clients {
 
 func (s *Service) reapStaleRunners(ctx context.Context) (time.Duration, error) {
 	logger := log.FromContext(ctx)
-	count, err := s.dal.KillStaleRunners(context.Background(), s.config.RunnerTimeout)
+	count, err := s.dal.KillStaleRunners(context.Background(), s.config.Load().RunnerTimeout)
 	if err != nil {
 		return 0, fmt.Errorf("failed to delete stale runners: %w", err)
 	} else if count > 0 {
 		logger.Debugf("Reaped %d stale runners", count)
 	}
-	return s.config.RunnerTimeout, nil
+	return s.config.Load().RunnerTimeout, nil
 }
 
 // Release any expired runner deployment reservations.
@@ -1119,87 +1782,54 @@ func (s *Service) releaseExpiredReservations(ctx context.Context) (time.Duration
 	} else if count > 0 {
 		logger.Warnf("Expired %d runner reservations", count)
 	}
-	return s.config.DeploymentReservationTimeout, nil
+	return s.config.Load().DeploymentReservationTimeout, nil
 }
 
 // Attempt to bring the converge the active number of replicas for each
 // deployment with the desired number.
+//
+// Deployments that repeatedly fail to reconcile are skipped until their
+// backoff expires.
 func (s *Service) reconcileDeployments(ctx context.Context) (time.Duration, error) {
 	reconciliation, err := s.dal.GetDeploymentsNeedingReconciliation(ctx)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get deployments needing reconciliation: %w", err)
 	}
-	oldFailures := make(map[string]int)
-	for k, v := range s.increaseReplicaFailures {
-		oldFailures[k] = v
-	}
+	s.reconcileBackoff.retain(reconciliation)
 
-	var lock sync.Mutex
+	now := time.Now()
 	wg, ctx := concurrency.New(ctx, concurrency.WithConcurrencyLimit(4))
 	for _, reconcile := range reconciliation {
-		deploymentLogger := s.getDeploymentLogger(ctx, reconcile.Deployment)
-		deploymentLogger.Debugf("Reconciling %s", reconcile.Deployment)
-		deployment := model.Deployment{
-			Module:   reconcile.Module,
-			Language: reconcile.Language,
-			Key:      reconcile.Deployment,
-		}
-
-		delete(oldFailures, reconcile.Deployment.String())
-
-		require := reconcile.RequiredReplicas - reconcile.AssignedReplicas
-		if require > 0 {
-			deploymentLogger.Debugf("Need %d more runners for %s", require, reconcile.Deployment)
-			wg.Go(func(ctx context.Context) error {
-				if err := s.deploy(ctx, deployment); err != nil {
-					lock.Lock()
-					failureCount := s.increaseReplicaFailures[deployment.Key.String()] + 1
-					s.increaseReplicaFailures[deployment.Key.String()] = failureCount
-					lock.Unlock()
-
-					if failureCount >= 5 {
-						deploymentLogger.Errorf(err, "Failed to increase deployment replicas")
-					} else {
-						deploymentLogger.Debugf("Failed to increase deployment replicas (%d): %s", failureCount, err)
-					}
-				} else {
-					lock.Lock()
-					delete(s.increaseReplicaFailures, deployment.Key.String())
-					lock.Unlock()
-
-					deploymentLogger.Debugf("Reconciled %s to %d/%d replicas", reconcile.Deployment, reconcile.AssignedReplicas+1, reconcile.RequiredReplicas)
-					if reconcile.AssignedReplicas+1 == reconcile.RequiredReplicas {
-						deploymentLogger.Infof("Deployed %s", reconcile.Deployment)
-					}
+		if !s.reconcileBackoff.ready(reconcile.Deployment, now) {
+			continue
+		}
+		// Crashed replicas are replaced with backoff, so that crash looping
+		// deployments don't monopolise runners.
+		if reconcile.AssignedReplicas < reconcile.RequiredReplicas && now.Before(s.config.Load().Reconcile.crashRestartAt(reconcile.Crashes, reconcile.LastCrashedAt)) {
+			continue
+		}
+		wg.Go(func(ctx context.Context) error {
+			deploymentLogger := s.getDeploymentLogger(ctx, reconcile.Deployment)
+			if err := s.reconcileDeployment(ctx, reconcile); err != nil {
+				failureCount, delay := s.reconcileBackoff.failed(ctx, reconcile, time.Now())
+				if failureCount == reconcileFailureThreshold {
+					s.webhooks.Notify(ctx, webhooks.Event{
+						Type:          webhooks.EventDeploymentFailed,
+						Module:        reconcile.Module,
+						DeploymentKey: reconcile.Deployment.String(),
+						Message:       fmt.Sprintf("Failed to deploy %s %d times: %s", reconcile.Deployment, failureCount, err),
+					})
 				}
-				return nil
-			})
-		} else if require < 0 {
-			deploymentLogger.Debugf("Need %d less runners for %s", -require, reconcile.Deployment)
-			wg.Go(func(ctx context.Context) error {
-				ok, err := s.terminateRandomRunner(ctx, deployment.Key)
-				if err != nil {
-					deploymentLogger.Warnf("Failed to terminate runner: %s", err)
-				} else if ok {
-					deploymentLogger.Debugf("Reconciled %s to %d/%d replicas", reconcile.Deployment, reconcile.AssignedReplicas-1, reconcile.RequiredReplicas)
-					if reconcile.AssignedReplicas-1 == reconcile.RequiredReplicas {
-						deploymentLogger.Infof("Stopped %s", reconcile.Deployment)
-					}
+				if failureCount >= reconcileFailureThreshold {
+					deploymentLogger.Errorf(err, "Failed to reconcile deployment %d times, retrying in %s", failureCount, delay)
 				} else {
-					deploymentLogger.Warnf("Failed to terminate runner: no runners found")
+					deploymentLogger.Warnf("Failed to reconcile deployment (%d), retrying in %s: %s", failureCount, delay, err)
 				}
-				return nil
-			})
-		}
-	}
-
-	// Clean up old failures, which can happen if a deployment was removed before successfully reconciling.
-	if len(oldFailures) > 0 {
-		lock.Lock()
-		for k := range oldFailures {
-			delete(s.increaseReplicaFailures, k)
-		}
-		lock.Unlock()
+			} else {
+				s.reconcileBackoff.succeeded(reconcile.Deployment)
+			}
+			return nil
+		})
 	}
 
 	if err := wg.Wait(); err != nil {
@@ -1208,6 +1838,49 @@ func (s *Service) reconcileDeployments(ctx context.Context) (time.Duration, erro
 	return time.Second, nil
 }
 
+// reconcileDeployment moves the number of replicas of a deployment one step
+// towards the number required.
+func (s *Service) reconcileDeployment(ctx context.Context, reconcile dal.Reconciliation) error {
+	deploymentLogger := s.getDeploymentLogger(ctx, reconcile.Deployment)
+	deploymentLogger.Debugf("Reconciling %s", reconcile.Deployment)
+	deployment := model.Deployment{
+		Module:   reconcile.Module,
+		Language: reconcile.Language,
+		Key:      reconcile.Deployment,
+	}
+
+	require := reconcile.RequiredReplicas - reconcile.AssignedReplicas
+	if require > 0 {
+		deploymentLogger.Debugf("Need %d more runners for %s", require, reconcile.Deployment)
+		if err := s.deploy(ctx, deployment); err != nil {
+			return fmt.Errorf("failed to increase deployment replicas: %w", err)
+		}
+		deploymentLogger.Debugf("Reconciled %s to %d/%d replicas", reconcile.Deployment, reconcile.AssignedReplicas+1, reconcile.RequiredReplicas)
+		if reconcile.AssignedReplicas+1 == reconcile.RequiredReplicas {
+			deploymentLogger.Infof("Deployed %s", reconcile.Deployment)
+			s.webhooks.Notify(ctx, webhooks.Event{
+				Type:          webhooks.EventDeploymentSucceeded,
+				Module:        reconcile.Module,
+				DeploymentKey: reconcile.Deployment.String(),
+				Message:       fmt.Sprintf("Deployed %s with %d replicas", reconcile.Deployment, reconcile.RequiredReplicas),
+			})
+		}
+	} else if require < 0 {
+		deploymentLogger.Debugf("Need %d less runners for %s", -require, reconcile.Deployment)
+		ok, err := s.terminateRandomRunner(ctx, deployment.Key)
+		if err != nil {
+			return fmt.Errorf("failed to terminate runner: %w", err)
+		} else if !ok {
+			return errors.New("failed to terminate runner: no runners found")
+		}
+		deploymentLogger.Debugf("Reconciled %s to %d/%d replicas", reconcile.Deployment, reconcile.AssignedReplicas-1, reconcile.RequiredReplicas)
+		if reconcile.AssignedReplicas-1 == reconcile.RequiredReplicas {
+			deploymentLogger.Infof("Stopped %s", reconcile.Deployment)
+		}
+	}
+	return nil
+}
+
 // Attempt to bring the number of active runners in line with the number of active deployments.
 func (s *Service) reconcileRunners(ctx context.Context) (time.Duration, error) {
 	activeDeployments, err := s.dal.GetActiveDeployments(ctx)
@@ -1215,7 +1888,7 @@ func (s *Service) reconcileRunners(ctx context.Context) (time.Duration, error) {
 		return 0, fmt.Errorf("failed to get deployments needing reconciliation: %w", err)
 	}
 
-	totalRunners := s.config.IdleRunners
+	totalRunners := s.config.Load().IdleRunners
 	for _, deployment := range activeDeployments {
 		totalRunners += deployment.MinReplicas
 	}
@@ -1272,7 +1945,12 @@ func (s *Service) executeAsyncCalls(ctx context.Context) (time.Duration, error)
 		Verb: call.Verb.ToProto(),
 		Body: call.Request,
 	}
-	resp, err := s.callWithRequest(ctx, connect.NewRequest(req), optional.None[model.RequestKey](), s.config.Advertise.String())
+	var resp *connect.Response[ftlv1.CallResponse]
+	// Dropped calls fail without being called, so they are retried as usual.
+	err = s.injectAsyncFaults(ctx, call.Verb.ToRef())
+	if err == nil {
+		resp, err = s.callWithRequest(ctx, connect.NewRequest(req), optional.None[model.RequestKey](), s.config.Load().Advertise.String())
+	}
 	var callResult either.Either[[]byte, string]
 	failed := false
 	if err != nil {
@@ -1295,11 +1973,15 @@ func (s *Service) executeAsyncCalls(ctx context.Context) (time.Duration, error)
 		// Allow for handling of completion based on origin
 		switch origin := call.Origin.(type) {
 		case dal.AsyncOriginFSM:
-			return s.onAsyncFSMCallCompletion(ctx, tx, origin, failed)
+			return s.onAsyncFSMCallCompletion(ctx, tx, call, origin, failed)
 
 		case dal.AsyncOriginPubSub:
 			return s.pubSub.OnCallCompletion(ctx, tx, origin, failed)
 
+		case dal.AsyncOriginCall:
+			// The result is stored with the call.
+			return nil
+
 		default:
 			panic(fmt.Errorf("unsupported async call origin: %v", call.Origin))
 		}
@@ -1311,7 +1993,15 @@ func (s *Service) executeAsyncCalls(ctx context.Context) (time.Duration, error)
 		// Post-commit notification based on origin
 		switch origin := call.Origin.(type) {
 		case dal.AsyncOriginFSM:
-			break
+			if failed && call.RemainingAttempts == 0 {
+				s.webhooks.Notify(ctx, webhooks.Event{
+					Type:    webhooks.EventFSMFailed,
+					Module:  origin.FSM.Module,
+					FSM:     origin.FSM.String(),
+					FSMKey:  origin.Key,
+					Message: fmt.Sprintf("FSM %s instance %s failed calling %s: %s", origin.FSM, origin.Key, call.Verb, callResult.(either.Right[[]byte, string]).Get()), //nolint:forcetypeassert
+				})
+			}
 
 		case dal.AsyncOriginPubSub:
 			s.pubSub.AsyncCallDidCommit(ctx, origin)
@@ -1324,7 +2014,7 @@ func (s *Service) executeAsyncCalls(ctx context.Context) (time.Duration, error)
 	return 0, nil
 }
 
-func (s *Service) onAsyncFSMCallCompletion(ctx context.Context, tx *dal.Tx, origin dal.AsyncOriginFSM, failed bool) error {
+func (s *Service) onAsyncFSMCallCompletion(ctx context.Context, tx *dal.Tx, call *dal.AsyncCall, origin dal.AsyncOriginFSM, failed bool) error {
 	logger := log.FromContext(ctx).Scope(origin.FSM.String())
 
 	instance, err := tx.AcquireFSMInstance(ctx, origin.FSM, origin.Key)
@@ -1333,6 +2023,17 @@ func (s *Service) onAsyncFSMCallCompletion(ctx context.Context, tx *dal.Tx, orig
 	}
 	defer instance.Release() //nolint:errcheck
 
+	sch := s.schema.Load()
+
+	fsm := &schema.FSM{}
+	resolveErr := sch.ResolveToType(origin.FSM.ToRef(), fsm)
+	if resolveErr == nil {
+		// Sagas transition automatically, including on failure.
+		if saga, ok := fsm.GetMetadataSaga().Get(); ok {
+			return s.onSagaCallCompletion(ctx, tx, fsm, saga, instance, call.Request, failed)
+		}
+	}
+
 	if failed {
 		logger.Warnf("FSM %s failed async call", origin.FSM)
 		err := tx.FailFSMInstance(ctx, origin.FSM, origin.Key)
@@ -1342,12 +2043,8 @@ func (s *Service) onAsyncFSMCallCompletion(ctx context.Context, tx *dal.Tx, orig
 		return nil
 	}
 
-	sch := s.schema.Load()
-
-	fsm := &schema.FSM{}
-	err = sch.ResolveToType(origin.FSM.ToRef(), fsm)
-	if err != nil {
-		return fmt.Errorf("could not resolve FSM: %w", err)
+	if resolveErr != nil {
+		return fmt.Errorf("could not resolve FSM: %w", resolveErr)
 	}
 
 	destinationState, _ := instance.DestinationState.Get()
@@ -1389,7 +2086,10 @@ func (s *Service) terminateRandomRunner(ctx context.Context, key model.Deploymen
 	}
 	runner := runners[rand.Intn(len(runners))] //nolint:gosec
 	client := s.clientsForEndpoint(runner.Endpoint)
-	resp, err := client.runner.Terminate(ctx, connect.NewRequest(&ftlv1.TerminateRequest{DeploymentKey: key.String()}))
+	resp, err := client.runner.Terminate(ctx, connect.NewRequest(&ftlv1.TerminateRequest{
+		DeploymentKey: key.String(),
+		DrainTimeout:  durationpb.New(s.config.Load().RunnerDrainTimeout),
+	}))
 	if err != nil {
 		return false, err
 	}
@@ -1417,9 +2117,9 @@ func (s *Service) deploy(ctx context.Context, reconcile model.Deployment) error
 
 func (s *Service) reserveRunner(ctx context.Context, reconcile model.Deployment) (client clients, err error) {
 	// A timeout context applied to the transaction and the Runner.Reserve() Call.
-	reservationCtx, cancel := context.WithTimeout(ctx, s.config.DeploymentReservationTimeout)
+	reservationCtx, cancel := context.WithTimeout(ctx, s.config.Load().DeploymentReservationTimeout)
 	defer cancel()
-	claim, err := s.dal.ReserveRunnerForDeployment(reservationCtx, reconcile.Key, s.config.DeploymentReservationTimeout, model.Labels{
+	claim, err := s.dal.ReserveRunnerForDeployment(reservationCtx, reconcile.Key, s.config.Load().DeploymentReservationTimeout, model.Labels{
 		"languages": []string{reconcile.Language},
 	})
 	if err != nil {
@@ -1437,7 +2137,7 @@ func (s *Service) reserveRunner(ctx context.Context, reconcile model.Deployment)
 // Periodically remove stale (ie. have not heartbeat recently) controllers from the database.
 func (s *Service) reapStaleControllers(ctx context.Context) (time.Duration, error) {
 	logger := log.FromContext(ctx)
-	count, err := s.dal.KillStaleControllers(context.Background(), s.config.ControllerTimeout)
+	count, err := s.dal.KillStaleControllers(context.Background(), s.config.Load().ControllerTimeout)
 	if err != nil {
 		return 0, fmt.Errorf("failed to delete stale controllers: %w", err)
 	} else if count > 0 {
@@ -1448,7 +2148,7 @@ func (s *Service) reapStaleControllers(ctx context.Context) (time.Duration, erro
 
 // Periodically update the DB with the current state of the controller.
 func (s *Service) heartbeatController(ctx context.Context) (time.Duration, error) {
-	_, err := s.dal.UpsertController(ctx, s.key, s.config.Advertise.String())
+	_, err := s.dal.UpsertController(ctx, s.key, s.config.Load().Advertise.String())
 	if err != nil {
 		return 0, fmt.Errorf("failed to heartbeat controller: %w", err)
 	}
@@ -1528,6 +2228,7 @@ func (s *Service) watchModuleChanges(ctx context.Context, sendChange func(respon
 					CreateTime:  timestamppb.New(message.CreatedAt),
 					MinReplicas: int32(message.MinReplicas),
 				}
+				moduleMetadataFromLabels(message.Labels).setRuntime(moduleSchema.Runtime)
 				moduleSchemaBytes, err := proto.Marshal(moduleSchema)
 				if err != nil {
 					return err
@@ -1615,9 +2316,12 @@ func (s *Service) syncSchema(ctx context.Context) {
 					return err
 				}
 				modulesByName[moduleSchema.Name] = moduleSchema
+				// Responses cached from other deployments may be stale.
+				s.callCache.invalidate(moduleSchema.Name)
 
 			case ftlv1.DeploymentChangeType_DEPLOYMENT_REMOVED:
 				delete(modulesByName, response.ModuleName)
+				s.callCache.invalidate(response.ModuleName)
 			}
 
 			orderedModules := maps.Values(modulesByName)
@@ -1654,37 +2358,6 @@ func (s *Service) getActiveSchema(ctx context.Context) (*schema.Schema, error) {
 	})
 }
 
-func extractIngressRoutingEntries(req *ftlv1.CreateDeploymentRequest) []dal.IngressRoutingEntry {
-	var ingressRoutes []dal.IngressRoutingEntry
-	for _, decl := range req.Schema.Decls {
-		if verb, ok := decl.Value.(*schemapb.Decl_Verb); ok {
-			for _, metadata := range verb.Verb.Metadata {
-				if ingress, ok := metadata.Value.(*schemapb.Metadata_Ingress); ok {
-					ingressRoutes = append(ingressRoutes, dal.IngressRoutingEntry{
-						Verb:   verb.Verb.Name,
-						Method: ingress.Ingress.Method,
-						Path:   ingressPathString(ingress.Ingress.Path),
-					})
-				}
-			}
-		}
-	}
-	return ingressRoutes
-}
-
-func ingressPathString(path []*schemapb.IngressPathComponent) string {
-	pathString := make([]string, len(path))
-	for i, p := range path {
-		switch p.Value.(type) {
-		case *schemapb.IngressPathComponent_IngressPathLiteral:
-			pathString[i] = p.GetIngressPathLiteral().Text
-		case *schemapb.IngressPathComponent_IngressPathParameter:
-			pathString[i] = fmt.Sprintf("{%s}", p.GetIngressPathParameter().Name)
-		}
-	}
-	return "/" + strings.Join(pathString, "/")
-}
-
 func makeBackoff(min, max time.Duration) backoff.Backoff {
 	return backoff.Backoff{
 		Min:    min,
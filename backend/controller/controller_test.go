@@ -0,0 +1,40 @@
+package controller
+
+import (
+	"net/http"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/rpc/headers"
+)
+
+func TestCheckAllowedCaller(t *testing.T) {
+	verbRef := &schema.Ref{Module: "payments", Name: "charge"}
+	verb := &schema.Verb{Name: "charge", Export: true, Metadata: []schema.Metadata{&schema.MetadataAllow{Modules: []string{"billing", "orders"}}}}
+
+	assert.NoError(t, checkAllowedCaller(verb, verbRef, nil), "calls from outside of a module should be allowed")
+	assert.NoError(t, checkAllowedCaller(verb, verbRef, []*schema.Ref{{Module: "billing", Name: "invoice"}}))
+	assert.NoError(t, checkAllowedCaller(verb, verbRef, []*schema.Ref{{Module: "payments", Name: "refund"}}))
+	assert.NoError(t, checkAllowedCaller(verb, verbRef, []*schema.Ref{{Module: "users", Name: "signup"}, {Module: "orders", Name: "place"}}))
+
+	err := checkAllowedCaller(verb, verbRef, []*schema.Ref{{Module: "orders", Name: "place"}, {Module: "users", Name: "signup"}})
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	assert.EqualError(t, err, `permission_denied: verb "payments.charge" can not be called from module "users"`)
+
+	// Callers are taken from the unauthenticated Verb header, so a request that
+	// omits it or names an allowed module is not restricted.
+	header := http.Header{}
+	callers, err := headers.GetCallers(header)
+	assert.NoError(t, err)
+	assert.NoError(t, checkAllowedCaller(verb, verbRef, callers))
+	headers.AddCaller(header, &schema.Ref{Module: "billing", Name: "forged"})
+	callers, err = headers.GetCallers(header)
+	assert.NoError(t, err)
+	assert.NoError(t, checkAllowedCaller(verb, verbRef, callers))
+
+	unrestricted := &schema.Verb{Name: "charge", Export: true}
+	assert.NoError(t, checkAllowedCaller(unrestricted, verbRef, []*schema.Ref{{Module: "users", Name: "signup"}}))
+}
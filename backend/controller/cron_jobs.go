@@ -0,0 +1,104 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/types/optional"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/db/dalerrs"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/model"
+	"github.com/TBD54566975/ftl/internal/slices"
+)
+
+func (s *Service) ListCronJobs(ctx context.Context, req *connect.Request[ftlv1.ListCronJobsRequest]) (*connect.Response[ftlv1.ListCronJobsResponse], error) {
+	jobs, err := s.dal.GetCronJobs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get cron jobs: %w", err)
+	}
+	inScope, err := s.moduleScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	module := optional.Ptr(req.Msg.Module)
+	jobs = slices.Filter(jobs, func(job model.CronJob) bool {
+		if m, ok := module.Get(); ok && job.Verb.Module != m {
+			return false
+		}
+		return inScope(job.Verb.Module)
+	})
+	return connect.NewResponse(&ftlv1.ListCronJobsResponse{
+		Jobs: slices.Map(jobs, func(job model.CronJob) *ftlv1.ListCronJobsResponse_CronJob {
+			return &ftlv1.ListCronJobsResponse_CronJob{
+				Verb:          job.Verb.ToProto().(*schemapb.Ref), //nolint:forcetypeassert
+				Schedule:      job.Schedule,
+				NextExecution: timestamppb.New(job.NextExecution),
+				Paused:        job.Paused,
+				Executing:     job.State == model.CronJobStateExecuting,
+			}
+		}),
+	}), nil
+}
+
+func (s *Service) PauseCronJobs(ctx context.Context, req *connect.Request[ftlv1.PauseCronJobsRequest]) (*connect.Response[ftlv1.PauseCronJobsResponse], error) {
+	if err := s.setCronJobsPaused(ctx, req.Msg.Module, optional.Ptr(req.Msg.Verb), true); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&ftlv1.PauseCronJobsResponse{}), nil
+}
+
+func (s *Service) ResumeCronJobs(ctx context.Context, req *connect.Request[ftlv1.ResumeCronJobsRequest]) (*connect.Response[ftlv1.ResumeCronJobsResponse], error) {
+	if err := s.setCronJobsPaused(ctx, req.Msg.Module, optional.Ptr(req.Msg.Verb), false); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&ftlv1.ResumeCronJobsResponse{}), nil
+}
+
+func (s *Service) setCronJobsPaused(ctx context.Context, module string, verb optional.Option[string], paused bool) error {
+	if module == "" {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("module is required"))
+	}
+	if err := s.checkModuleScope(ctx, module); err != nil {
+		return err
+	}
+	keys, err := s.dal.SetCronJobsPaused(ctx, module, verb, paused)
+	if err != nil {
+		return fmt.Errorf("could not update cron jobs: %w", err)
+	}
+	if len(keys) == 0 {
+		if name, ok := verb.Get(); ok {
+			return connect.NewError(connect.CodeNotFound, fmt.Errorf("no cron job for %s.%s", module, name))
+		}
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("module %s has no cron jobs", module))
+	}
+	action := "Resumed"
+	if paused {
+		action = "Paused"
+	}
+	log.FromContext(ctx).Infof("%s %d cron jobs of %s", action, len(keys), module)
+	return nil
+}
+
+func (s *Service) TriggerCronJob(ctx context.Context, req *connect.Request[ftlv1.TriggerCronJobRequest]) (*connect.Response[ftlv1.TriggerCronJobResponse], error) {
+	if req.Msg.Verb == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("verb is required"))
+	}
+	verb := schema.RefFromProto(req.Msg.Verb)
+	if err := s.checkModuleScope(ctx, verb.Module); err != nil {
+		return nil, err
+	}
+	err := s.cronJobs.TriggerJob(ctx, *verb)
+	if errors.Is(err, dalerrs.ErrNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no cron job for %s", verb))
+	} else if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&ftlv1.TriggerCronJobResponse{}), nil
+}
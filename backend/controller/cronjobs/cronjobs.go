@@ -20,6 +20,7 @@ import (
 	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
 	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
 	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/db/dalerrs"
 	"github.com/TBD54566975/ftl/internal/cron"
 	"github.com/TBD54566975/ftl/internal/log"
 	"github.com/TBD54566975/ftl/internal/model"
@@ -120,7 +121,12 @@ func NewForTesting(ctx context.Context, key model.ControllerKey, requestSource s
 }
 
 func (s *Service) NewCronJobsForModule(ctx context.Context, module *schemapb.Module) ([]model.CronJob, error) {
-	start := s.clock.Now().UTC()
+	return NewCronJobs(module, s.clock.Now().UTC())
+}
+
+// NewCronJobs returns the cron jobs of the verbs of "module", scheduled to
+// start at "start".
+func NewCronJobs(module *schemapb.Module, start time.Time) ([]model.CronJob, error) {
 	newJobs := []model.CronJob{}
 	merr := []error{}
 	for _, decl := range module.Decls {
@@ -194,13 +200,27 @@ func (s *Service) syncJobsWithNewDeploymentKey(ctx context.Context, deploymentKe
 	return nil
 }
 
-func (s *Service) executeJob(ctx context.Context, job model.CronJob) {
-	logger := log.FromContext(ctx)
+// TriggerJob executes the cron job of "verb" immediately, whether or not it is
+// paused, without affecting its schedule.
+func (s *Service) TriggerJob(ctx context.Context, verb schema.Ref) error {
+	jobs, err := s.dal.GetCronJobs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get cron jobs: %w", err)
+	}
+	for _, job := range jobs {
+		if job.Verb.Module == verb.Module && job.Verb.Name == verb.Name {
+			log.FromContext(ctx).Infof("triggering job %v", job.Key)
+			return s.callJob(ctx, job)
+		}
+	}
+	return fmt.Errorf("no cron job for %s: %w", verb, dalerrs.ErrNotFound)
+}
+
+func (s *Service) callJob(ctx context.Context, job model.CronJob) error {
 	requestBody := map[string]any{}
 	requestJSON, err := json.Marshal(requestBody)
 	if err != nil {
-		logger.Errorf(err, "could not build body for cron job: %v", job.Key)
-		return
+		return fmt.Errorf("could not build body for cron job %v: %w", job.Key, err)
 	}
 
 	req := connect.NewRequest(&ftlv1.CallRequest{
@@ -214,6 +234,18 @@ func (s *Service) executeJob(ctx context.Context, job model.CronJob) {
 	defer cancel()
 	_, err = s.call(callCtx, req, optional.Some(requestKey), s.requestSource)
 	if err != nil {
+		return fmt.Errorf("failed to execute cron job %v: %w", job.Key, err)
+	}
+	return nil
+}
+
+func (s *Service) executeJob(ctx context.Context, job model.CronJob) {
+	logger := log.FromContext(ctx)
+	if job.Paused {
+		// Paused jobs still advance to their next execution, so that they
+		// don't all execute at once when resumed.
+		logger.Debugf("skipping paused job %v", job.Key)
+	} else if err := s.callJob(ctx, job); err != nil {
 		logger.Errorf(err, "failed to execute cron job %v", job.Key)
 		// Do not return, continue to end the job and schedule the next execution
 	}
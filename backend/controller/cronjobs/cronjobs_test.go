@@ -15,6 +15,7 @@ import (
 	db "github.com/TBD54566975/ftl/backend/controller/dal"
 	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
 	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/db/dalerrs"
 	"github.com/TBD54566975/ftl/internal/log"
 	"github.com/TBD54566975/ftl/internal/model"
 	"github.com/TBD54566975/ftl/internal/slices"
@@ -60,7 +61,7 @@ func TestHashRing(t *testing.T) {
 
 	deploymentKey, err := mockDal.CreateDeployment(ctx, "go", &schema.Module{
 		Name: moduleName,
-	}, []db.DeploymentArtefact{}, []db.IngressRoutingEntry{}, jobsToCreate)
+	}, nil, []db.DeploymentArtefact{}, []db.IngressRoutingEntry{}, jobsToCreate)
 	assert.NoError(t, err)
 
 	err = mockDal.ReplaceDeployment(ctx, deploymentKey, 1)
@@ -122,3 +123,59 @@ func TestHashRing(t *testing.T) {
 	}
 	assert.True(t, hasFoundNonMatchingKeys, "expected at least one verb to have different controllers assigned")
 }
+
+func TestPausedAndTriggeredJobs(t *testing.T) {
+	t.Parallel()
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	ctx, cancel := context.WithCancel(ctx)
+	t.Cleanup(cancel)
+
+	clk := clock.NewMock()
+	clk.Add(time.Second)
+
+	mockDal := &mockDAL{
+		clock:           clk,
+		lock:            sync.Mutex{},
+		attemptCountMap: map[string]int{},
+	}
+	jobs := newJobs(t, "initial", "*/2 * * * * * *", clk, 2)
+	jobs[0].Paused = true
+	_, err := mockDal.CreateDeployment(ctx, "go", &schema.Module{Name: "initial"}, nil, nil, nil, jobs)
+	assert.NoError(t, err)
+
+	called := map[string]int{}
+	lock := sync.Mutex{}
+	service := NewForTesting(ctx, model.NewControllerKey("localhost", "8080"), "test.com", Config{Timeout: time.Minute}, mockDal, &mockScheduler{},
+		func(ctx context.Context, r *connect.Request[ftlv1.CallRequest], o optional.Option[model.RequestKey], s string) (*connect.Response[ftlv1.CallResponse], error) {
+			lock.Lock()
+			defer lock.Unlock()
+			called[r.Msg.Verb.Name]++
+			return &connect.Response[ftlv1.CallResponse]{}, nil
+		}, clk)
+
+	// Paused jobs advance to their next execution without being called.
+	clk.Add(time.Second)
+	attempted, err := mockDal.StartCronJobs(ctx, jobs)
+	assert.NoError(t, err)
+	for _, job := range attempted {
+		service.executeJob(ctx, job.CronJob)
+	}
+	assert.Equal(t, map[string]int{"verb1": 1}, called)
+	ended, err := mockDal.GetCronJobs(ctx)
+	assert.NoError(t, err)
+	for _, job := range ended {
+		assert.Equal(t, model.CronJobState(model.CronJobStateIdle), job.State)
+		assert.True(t, job.NextExecution.After(clk.Now()), "expected %s to be rescheduled", job.Key)
+	}
+
+	// Triggering a job calls it whether or not it is paused, without rescheduling it.
+	err = service.TriggerJob(ctx, jobs[0].Verb)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"verb0": 1, "verb1": 1}, called)
+	triggered, err := mockDal.GetCronJobs(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, ended, triggered)
+
+	err = service.TriggerJob(ctx, schema.Ref{Module: "initial", Name: "missing"})
+	assert.IsError(t, err, dalerrs.ErrNotFound)
+}
@@ -25,7 +25,7 @@ import (
 
 type ExtendedDAL interface {
 	DAL
-	CreateDeployment(ctx context.Context, language string, moduleSchema *schema.Module, artefacts []db.DeploymentArtefact, ingressRoutes []db.IngressRoutingEntry, cronJobs []model.CronJob) (key model.DeploymentKey, err error)
+	CreateDeployment(ctx context.Context, language string, moduleSchema *schema.Module, labels model.Labels, artefacts []db.DeploymentArtefact, ingressRoutes []db.IngressRoutingEntry, cronJobs []model.CronJob) (key model.DeploymentKey, err error)
 	ReplaceDeployment(ctx context.Context, newDeploymentKey model.DeploymentKey, minReplicas int) (err error)
 }
 
@@ -38,7 +38,7 @@ type mockDAL struct {
 
 var _ ExtendedDAL = &mockDAL{}
 
-func (d *mockDAL) CreateDeployment(ctx context.Context, language string, moduleSchema *schema.Module, artefacts []db.DeploymentArtefact, ingressRoutes []db.IngressRoutingEntry, cronJobs []model.CronJob) (key model.DeploymentKey, err error) {
+func (d *mockDAL) CreateDeployment(ctx context.Context, language string, moduleSchema *schema.Module, labels model.Labels, artefacts []db.DeploymentArtefact, ingressRoutes []db.IngressRoutingEntry, cronJobs []model.CronJob) (key model.DeploymentKey, err error) {
 	deploymentKey := model.NewDeploymentKey(moduleSchema.Name)
 	d.jobs = []model.CronJob{}
 	for _, job := range cronJobs {
@@ -226,7 +226,7 @@ func testServiceWithDal(ctx context.Context, t *testing.T, dal ExtendedDAL, clk
 
 	deploymentKey, err := dal.CreateDeployment(ctx, "go", &schema.Module{
 		Name: moduleName,
-	}, []db.DeploymentArtefact{}, []db.IngressRoutingEntry{}, jobsToCreate)
+	}, nil, []db.DeploymentArtefact{}, []db.IngressRoutingEntry{}, jobsToCreate)
 	assert.NoError(t, err)
 
 	err = dal.ReplaceDeployment(ctx, deploymentKey, 1)
@@ -0,0 +1,104 @@
+package dal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/sql"
+	"github.com/TBD54566975/ftl/db/dalerrs"
+	"github.com/TBD54566975/ftl/internal/slices"
+)
+
+// AdminResourceKind is the kind of an [AdminResource].
+type AdminResourceKind string
+
+const (
+	AdminResourceProject       AdminResourceKind = "project"
+	AdminResourceQuota         AdminResourceKind = "quota"
+	AdminResourceIngressDomain AdminResourceKind = "ingress_domain"
+)
+
+// AdminResource is a resource managed with the admin resource API that is
+// stored by the controller.
+type AdminResource struct {
+	Kind AdminResourceKind
+	Name string
+	// Spec is the JSON encoded spec of the resource.
+	Spec []byte
+	// Version is incremented each time the resource is updated.
+	Version int64
+}
+
+func adminResourceFromRow(row sql.AdminResource) AdminResource {
+	return AdminResource{Kind: AdminResourceKind(row.Kind), Name: row.Name, Spec: row.Spec, Version: row.Version}
+}
+
+// ListAdminResources returns the resources of "kind", ordered by name.
+func (d *DAL) ListAdminResources(ctx context.Context, kind AdminResourceKind) ([]AdminResource, error) {
+	rows, err := d.db.ListAdminResources(ctx, string(kind))
+	if err != nil {
+		return nil, dalerrs.TranslatePGError(err)
+	}
+	return slices.Map(rows, adminResourceFromRow), nil
+}
+
+// GetAdminResource returns a resource, or [dalerrs.ErrNotFound].
+func (d *DAL) GetAdminResource(ctx context.Context, kind AdminResourceKind, name string) (AdminResource, error) {
+	row, err := d.db.GetAdminResource(ctx, string(kind), name)
+	if err != nil {
+		return AdminResource{}, dalerrs.TranslatePGError(err)
+	}
+	return adminResourceFromRow(row), nil
+}
+
+// CreateAdminResource creates a resource, returning [dalerrs.ErrConflict] if
+// it already exists.
+func (d *DAL) CreateAdminResource(ctx context.Context, kind AdminResourceKind, name string, spec []byte) (AdminResource, error) {
+	row, err := d.db.CreateAdminResource(ctx, string(kind), name, spec)
+	if err != nil {
+		return AdminResource{}, dalerrs.TranslatePGError(err)
+	}
+	return adminResourceFromRow(row), nil
+}
+
+// UpdateAdminResource replaces the spec of a resource.
+//
+// If "version" is set and the resource is at a different version
+// [dalerrs.ErrConflict] is returned.
+func (d *DAL) UpdateAdminResource(ctx context.Context, kind AdminResourceKind, name string, spec []byte, version optional.Option[int64]) (AdminResource, error) {
+	row, err := d.db.UpdateAdminResource(ctx, sql.UpdateAdminResourceParams{Spec: spec, Kind: string(kind), Name: name, Version: version})
+	if err != nil {
+		return AdminResource{}, d.adminResourceVersionError(ctx, kind, name, err)
+	}
+	return adminResourceFromRow(row), nil
+}
+
+// DeleteAdminResource deletes a resource.
+//
+// If "version" is set and the resource is at a different version
+// [dalerrs.ErrConflict] is returned.
+func (d *DAL) DeleteAdminResource(ctx context.Context, kind AdminResourceKind, name string, version optional.Option[int64]) error {
+	_, err := d.db.DeleteAdminResource(ctx, string(kind), name, version)
+	if err != nil {
+		return d.adminResourceVersionError(ctx, kind, name, err)
+	}
+	return nil
+}
+
+// adminResourceVersionError translates "err" from a statement conditional on
+// the version of a resource, which matches no rows if the resource either
+// doesn't exist or is at another version.
+func (d *DAL) adminResourceVersionError(ctx context.Context, kind AdminResourceKind, name string, err error) error {
+	err = dalerrs.TranslatePGError(err)
+	if !errors.Is(err, dalerrs.ErrNotFound) {
+		return err
+	}
+	current, getErr := d.GetAdminResource(ctx, kind, name)
+	if getErr != nil {
+		return getErr
+	}
+	return fmt.Errorf("%s %q is at version %d: %w", kind, name, current.Version, dalerrs.ErrConflict)
+}
@@ -0,0 +1,64 @@
+package dal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alecthomas/types/optional"
+	sets "github.com/deckarep/golang-set/v2"
+
+	"github.com/TBD54566975/ftl/db/dalerrs"
+	"github.com/TBD54566975/ftl/internal/compression"
+	"github.com/TBD54566975/ftl/internal/sha256"
+	"github.com/TBD54566975/ftl/internal/slices"
+)
+
+// GetMissingArtefactChunks returns the digests of the artefact chunks that are
+// missing from the database.
+func (d *DAL) GetMissingArtefactChunks(ctx context.Context, digests []sha256.SHA256) ([]sha256.SHA256, error) {
+	have, err := d.db.GetArtefactChunkDigests(ctx, sha256esToBytes(digests))
+	if err != nil {
+		return nil, dalerrs.TranslatePGError(err)
+	}
+	return sets.NewSet(digests...).Difference(sets.NewSet(slices.Map(have, sha256.FromBytes)...)).ToSlice(), nil
+}
+
+// GetArtefactChunks returns the uncompressed content of the artefact chunks in
+// the database with the given digests.
+func (d *DAL) GetArtefactChunks(ctx context.Context, digests []sha256.SHA256) (map[sha256.SHA256][]byte, error) {
+	rows, err := d.db.GetArtefactChunks(ctx, sha256esToBytes(digests))
+	if err != nil {
+		return nil, dalerrs.TranslatePGError(err)
+	}
+	chunks := make(map[sha256.SHA256][]byte, len(rows))
+	for _, row := range rows {
+		digest := sha256.FromBytes(row.Digest)
+		content, err := decompressArtefactContent(row.Content, row.Compression)
+		if err != nil {
+			return nil, fmt.Errorf("artefact chunk %s: %w", digest, err)
+		}
+		chunks[digest] = content
+	}
+	return chunks, nil
+}
+
+// storedArtefactContent returns artefact content as it is stored, compressed
+// if that makes it smaller, along with its compression.
+func storedArtefactContent(content []byte) ([]byte, optional.Option[string]) {
+	if compressed, ok := compression.CompressIfSmaller(content); ok {
+		return compressed, optional.Some(compression.Zstd)
+	}
+	return content, optional.None[string]()
+}
+
+// decompressArtefactContent decompresses stored artefact content.
+func decompressArtefactContent(content []byte, contentCompression optional.Option[string]) ([]byte, error) {
+	name, ok := contentCompression.Get()
+	if !ok {
+		return content, nil
+	}
+	if err := compression.Check(name); err != nil {
+		return nil, err
+	}
+	return compression.Decompress(content)
+}
@@ -9,10 +9,12 @@ import (
 
 	"github.com/alecthomas/participle/v2"
 	"github.com/alecthomas/types/either"
+	"github.com/alecthomas/types/optional"
 
 	"github.com/TBD54566975/ftl/backend/controller/sql"
 	"github.com/TBD54566975/ftl/backend/schema"
 	"github.com/TBD54566975/ftl/db/dalerrs"
+	"github.com/TBD54566975/ftl/internal/model"
 )
 
 type asyncOriginParseRoot struct {
@@ -20,7 +22,7 @@ type asyncOriginParseRoot struct {
 }
 
 var asyncOriginParser = participle.MustBuild[asyncOriginParseRoot](
-	participle.Union[AsyncOrigin](AsyncOriginFSM{}, AsyncOriginPubSub{}),
+	participle.Union[AsyncOrigin](AsyncOriginFSM{}, AsyncOriginPubSub{}, AsyncOriginCall{}),
 )
 
 // AsyncOrigin is a sum type representing the originator of an async call.
@@ -60,6 +62,20 @@ func (AsyncOriginPubSub) asyncOrigin()     {}
 func (a AsyncOriginPubSub) Origin() string { return "sub" }
 func (a AsyncOriginPubSub) String() string { return fmt.Sprintf("sub:%s", a.Subscription) }
 
+// AsyncOriginCall represents the context for the originator of a call made
+// with ftl.CallAsync.
+//
+// It is in the form call:<module>.<verb>, where <module>.<verb> is the caller.
+type AsyncOriginCall struct {
+	Caller schema.RefKey `parser:"'call' ':' @@"`
+}
+
+var _ AsyncOrigin = AsyncOriginCall{}
+
+func (AsyncOriginCall) asyncOrigin()     {}
+func (a AsyncOriginCall) Origin() string { return "call" }
+func (a AsyncOriginCall) String() string { return fmt.Sprintf("call:%s", a.Caller) }
+
 // ParseAsyncOrigin parses an async origin key.
 func ParseAsyncOrigin(origin string) (AsyncOrigin, error) {
 	root, err := asyncOriginParser.ParseString("", origin)
@@ -80,6 +96,9 @@ type AsyncCall struct {
 	RemainingAttempts int32
 	Backoff           time.Duration
 	MaxBackoff        time.Duration
+	// Calls sharing an ordering key are executed one at a time, in the order
+	// they were created.
+	OrderingKey optional.Option[string]
 }
 
 // AcquireAsyncCall acquires a pending async call to execute.
@@ -100,6 +119,10 @@ func (d *DAL) AcquireAsyncCall(ctx context.Context) (call *AsyncCall, err error)
 		if errors.Is(err, dalerrs.ErrConstraint) {
 			return nil, fmt.Errorf("no pending async calls: %w", dalerrs.ErrNotFound)
 		}
+		// A conflict means another controller concurrently acquired a call with the same ordering key.
+		if errors.Is(err, dalerrs.ErrConflict) {
+			return nil, fmt.Errorf("no pending async calls: %w", dalerrs.ErrNotFound)
+		}
 		return nil, fmt.Errorf("failed to acquire async call: %w", err)
 	}
 	origin, err := ParseAsyncOrigin(row.Origin)
@@ -117,6 +140,7 @@ func (d *DAL) AcquireAsyncCall(ctx context.Context) (call *AsyncCall, err error)
 		RemainingAttempts: row.RemainingAttempts,
 		Backoff:           row.Backoff,
 		MaxBackoff:        row.MaxBackoff,
+		OrderingKey:       row.OrderingKey,
 	}, nil
 }
 
@@ -162,6 +186,54 @@ func (d *DAL) CompleteAsyncCall(ctx context.Context, call *AsyncCall, result eit
 	return finalise(tx)
 }
 
+// CreateAsyncCall enqueues a call to "verb" made by "caller" with
+// ftl.CallAsync, returning its ID.
+//
+// Note: no validation of the call is performed.
+func (d *DAL) CreateAsyncCall(ctx context.Context, caller schema.RefKey, verb schema.RefKey, request json.RawMessage, retryParams schema.RetryParams, parentRequestKey optional.Option[model.RequestKey]) (int64, error) {
+	var parentRequest optional.Option[string]
+	if key, ok := parentRequestKey.Get(); ok {
+		parentRequest = optional.Some(key.String())
+	}
+	id, err := d.db.CreateAsyncCall(ctx, sql.CreateAsyncCallParams{
+		Verb:              verb,
+		Origin:            AsyncOriginCall{Caller: caller}.String(),
+		Request:           request,
+		RemainingAttempts: int32(retryParams.Count),
+		Backoff:           retryParams.MinBackoff,
+		MaxBackoff:        retryParams.MaxBackoff,
+		ParentRequestKey:  parentRequest,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to create async call: %w", dalerrs.TranslatePGError(err))
+	}
+	return id, nil
+}
+
+// GetAsyncCallResult returns the origin of the async call with the given ID
+// and, if it has completed, its result, following retries of the call.
+//
+// "result" is either a []byte representing the successful response, or a string
+// representing a failure message.
+func (d *DAL) GetAsyncCallResult(ctx context.Context, id int64) (origin AsyncOrigin, result optional.Option[either.Either[[]byte, string]], err error) {
+	row, err := d.db.GetLatestAsyncCallAttempt(ctx, id)
+	if err != nil {
+		return nil, result, dalerrs.TranslatePGError(err)
+	}
+	origin, err = ParseAsyncOrigin(row.Origin)
+	if err != nil {
+		return nil, result, fmt.Errorf("failed to parse origin key %q: %w", row.Origin, err)
+	}
+	switch row.State {
+	case sql.AsyncCallStateSuccess:
+		result = optional.Some[either.Either[[]byte, string]](either.LeftOf[string](row.Response))
+	case sql.AsyncCallStateError:
+		result = optional.Some[either.Either[[]byte, string]](either.RightOf[[]byte](row.Error.Default("unknown error")))
+	case sql.AsyncCallStatePending, sql.AsyncCallStateExecuting:
+	}
+	return origin, result, nil
+}
+
 func (d *DAL) LoadAsyncCall(ctx context.Context, id int64) (*AsyncCall, error) {
 	row, err := d.db.LoadAsyncCall(ctx, id)
 	if err != nil {
@@ -172,9 +244,10 @@ func (d *DAL) LoadAsyncCall(ctx context.Context, id int64) (*AsyncCall, error) {
 		return nil, fmt.Errorf("failed to parse origin key %q: %w", row.Origin, err)
 	}
 	return &AsyncCall{
-		ID:      row.ID,
-		Verb:    row.Verb,
-		Origin:  origin,
-		Request: row.Request,
+		ID:          row.ID,
+		Verb:        row.Verb,
+		Origin:      origin,
+		Request:     row.Request,
+		OrderingKey: row.OrderingKey,
 	}, nil
 }
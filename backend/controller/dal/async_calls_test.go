@@ -0,0 +1,114 @@
+package dal
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/either"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/sql"
+	"github.com/TBD54566975/ftl/backend/controller/sql/sqltest"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/db/dalerrs"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+func TestAsyncCallOrderingKeys(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	conn := sqltest.OpenForTesting(ctx, t)
+	dal, err := New(ctx, conn)
+	assert.NoError(t, err)
+
+	createCall := func(orderingKey optional.Option[string]) int64 {
+		t.Helper()
+		id, err := dal.db.CreateAsyncCall(ctx, sql.CreateAsyncCallParams{
+			Verb:        schema.RefKey{Module: "module", Name: "verb"},
+			Origin:      AsyncOriginPubSub{Subscription: schema.RefKey{Module: "module", Name: "sub"}}.String(),
+			Request:     []byte(`{}`),
+			OrderingKey: orderingKey,
+		})
+		assert.NoError(t, err)
+		return id
+	}
+	first := createCall(optional.Some("customer/1"))
+	second := createCall(optional.Some("customer/1"))
+	unordered := createCall(optional.None[string]())
+
+	// The second call must wait for the first, but the unordered call doesn't.
+	acquired := map[int64]*AsyncCall{}
+	for range 2 {
+		call, err := dal.AcquireAsyncCall(ctx)
+		assert.NoError(t, err)
+		acquired[call.ID] = call
+	}
+	assert.Equal(t, []int64{first, unordered}, sortedKeys(acquired))
+	assert.HasPrefix(t, acquired[first].Lease.String(), "/system/async_call_order/")
+	assert.Equal(t, optional.Some("customer/1"), acquired[first].OrderingKey)
+
+	_, err = dal.AcquireAsyncCall(ctx)
+	assert.IsError(t, err, dalerrs.ErrNotFound)
+
+	err = dal.CompleteAsyncCall(ctx, acquired[first], either.LeftOf[string]([]byte(`{}`)), func(tx *Tx) error { return nil })
+	assert.NoError(t, err)
+	_, err = dal.AcquireAsyncCall(ctx)
+	assert.IsError(t, err, dalerrs.ErrNotFound) // The lease on the ordering key is still held.
+
+	assert.NoError(t, acquired[first].Lease.Release())
+	call, err := dal.AcquireAsyncCall(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, second, call.ID)
+	assert.NoError(t, call.Lease.Release())
+	assert.NoError(t, acquired[unordered].Lease.Release())
+}
+
+func TestAsyncCallResultFollowsRetries(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	conn := sqltest.OpenForTesting(ctx, t)
+	dal, err := New(ctx, conn)
+	assert.NoError(t, err)
+
+	caller := schema.RefKey{Module: "module", Name: "caller"}
+	id, err := dal.CreateAsyncCall(ctx, caller, schema.RefKey{Module: "module", Name: "verb"}, []byte(`{}`), schema.RetryParams{Count: 1}, optional.None[model.RequestKey]())
+	assert.NoError(t, err)
+
+	origin, result, err := dal.GetAsyncCallResult(ctx, id)
+	assert.NoError(t, err)
+	assert.Equal(t, AsyncOrigin(AsyncOriginCall{Caller: caller}), origin)
+	assert.False(t, result.Ok())
+
+	// The first attempt fails and is retried, so the call has not completed.
+	call, err := dal.AcquireAsyncCall(ctx)
+	assert.NoError(t, err)
+	err = dal.CompleteAsyncCall(ctx, call, either.RightOf[[]byte]("failed"), func(tx *Tx) error { return nil })
+	assert.NoError(t, err)
+	assert.NoError(t, call.Lease.Release())
+	_, result, err = dal.GetAsyncCallResult(ctx, id)
+	assert.NoError(t, err)
+	assert.False(t, result.Ok())
+
+	retry, err := dal.AcquireAsyncCall(ctx)
+	assert.NoError(t, err)
+	assert.NotEqual(t, id, retry.ID)
+	err = dal.CompleteAsyncCall(ctx, retry, either.LeftOf[string]([]byte(`{"ok":true}`)), func(tx *Tx) error { return nil })
+	assert.NoError(t, err)
+	assert.NoError(t, retry.Lease.Release())
+	_, result, err = dal.GetAsyncCallResult(ctx, id)
+	assert.NoError(t, err)
+	assert.Equal(t, optional.Some[either.Either[[]byte, string]](either.LeftOf[string]([]byte(`{"ok":true}`))), result)
+
+	_, _, err = dal.GetAsyncCallResult(ctx, id+100)
+	assert.IsError(t, err, dalerrs.ErrNotFound)
+}
+
+func sortedKeys[V any](m map[int64]V) []int64 {
+	keys := make([]int64, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
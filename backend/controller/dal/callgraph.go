@@ -0,0 +1,73 @@
+package dal
+
+import (
+	"context"
+	"time"
+
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/sql"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/db/dalerrs"
+	"github.com/TBD54566975/ftl/internal/slices"
+)
+
+// CallGraphEdge aggregates the calls from one verb to another.
+type CallGraphEdge struct {
+	// Absent for calls that did not originate from a verb, such as ingress.
+	Source          optional.Option[schema.Ref]
+	Destination     schema.Ref
+	Calls           int64
+	Errors          int64
+	AverageDuration time.Duration
+	P95Duration     time.Duration
+	MaxDuration     time.Duration
+}
+
+// GetCallGraphEdges returns the calls in the call log since "since",
+// aggregated by source and destination verb.
+func (d *DAL) GetCallGraphEdges(ctx context.Context, since time.Time) ([]CallGraphEdge, error) {
+	rows, err := d.db.GetCallGraphEdges(ctx, since)
+	if err != nil {
+		return nil, dalerrs.TranslatePGError(err)
+	}
+	return slices.Map(rows, func(row sql.GetCallGraphEdgesRow) CallGraphEdge {
+		edge := CallGraphEdge{
+			Destination:     schema.Ref{Module: row.DestModule, Name: row.DestVerb},
+			Calls:           row.Calls,
+			Errors:          row.Errors,
+			AverageDuration: time.Duration(row.AverageDurationMs * float64(time.Millisecond)),
+			P95Duration:     time.Duration(row.P95DurationMs * float64(time.Millisecond)),
+			MaxDuration:     time.Duration(row.MaxDurationMs) * time.Millisecond,
+		}
+		if row.SourceModule != "" {
+			edge.Source = optional.Some(schema.Ref{Module: row.SourceModule, Name: row.SourceVerb})
+		}
+		return edge
+	}), nil
+}
+
+// VerbCallStats aggregates the calls to a verb.
+type VerbCallStats struct {
+	Verb        schema.Ref
+	Calls       int64
+	Errors      int64
+	P95Duration time.Duration
+}
+
+// GetVerbCallStats returns the calls in the call log since "since",
+// aggregated by destination verb.
+func (d *DAL) GetVerbCallStats(ctx context.Context, since time.Time) ([]VerbCallStats, error) {
+	rows, err := d.db.GetVerbCallStats(ctx, since)
+	if err != nil {
+		return nil, dalerrs.TranslatePGError(err)
+	}
+	return slices.Map(rows, func(row sql.GetVerbCallStatsRow) VerbCallStats {
+		return VerbCallStats{
+			Verb:        schema.Ref{Module: row.Module, Name: row.Verb},
+			Calls:       row.Calls,
+			Errors:      row.Errors,
+			P95Duration: time.Duration(row.P95DurationMs * float64(time.Millisecond)),
+		}
+	}), nil
+}
@@ -0,0 +1,64 @@
+package dal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/db/dalerrs"
+	"github.com/TBD54566975/ftl/internal/sha256"
+	"github.com/TBD54566975/ftl/internal/slices"
+)
+
+// ColdStore holds the content of artefacts that have been moved out of the
+// database, by digest.
+type ColdStore interface {
+	Get(ctx context.Context, digest sha256.SHA256) ([]byte, error)
+}
+
+// SetColdStore sets the store the content of artefacts in cold storage is
+// fetched from when they are read.
+func (d *DAL) SetColdStore(store ColdStore) {
+	d.coldStore = store
+}
+
+// GetInactiveArtefacts returns up to "limit" artefacts stored in the database
+// that are only used by deployments that have not been active since
+// "inactiveSince".
+func (d *DAL) GetInactiveArtefacts(ctx context.Context, inactiveSince time.Time, limit int) ([]sha256.SHA256, error) {
+	digests, err := d.db.GetInactiveArtefacts(ctx, inactiveSince, int32(limit))
+	if err != nil {
+		return nil, dalerrs.TranslatePGError(err)
+	}
+	return slices.Map(digests, sha256.FromBytes), nil
+}
+
+// GetArtefactContent returns the uncompressed content of an artefact stored in
+// the database.
+func (d *DAL) GetArtefactContent(ctx context.Context, digest sha256.SHA256) ([]byte, error) {
+	parts, err := d.db.GetArtefactContent(ctx, digest[:])
+	if err != nil {
+		return nil, dalerrs.TranslatePGError(err)
+	}
+	if len(parts) == 0 {
+		return nil, dalerrs.ErrNotFound
+	}
+	var content []byte
+	for _, part := range parts {
+		decompressed, err := decompressArtefactContent(part.Content, part.Compression)
+		if err != nil {
+			return nil, fmt.Errorf("artefact %s: %w", digest, err)
+		}
+		content = append(content, decompressed...)
+	}
+	return content, nil
+}
+
+// MoveArtefactToColdStorage removes the content of an artefact from the
+// database, once it has been copied to the cold store with "compression".
+func (d *DAL) MoveArtefactToColdStorage(ctx context.Context, digest sha256.SHA256, compression optional.Option[string]) error {
+	err := d.db.MoveArtefactToColdStorage(ctx, compression, digest[:])
+	return dalerrs.TranslatePGError(err)
+}
@@ -2,11 +2,11 @@
 package dal
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
 	"strings"
 	"time"
 
@@ -14,12 +14,12 @@ import (
 	"github.com/alecthomas/types/pubsub"
 	sets "github.com/deckarep/golang-set/v2"
 	"github.com/jackc/pgx/v5/pgxpool"
-	"google.golang.org/protobuf/proto"
 
 	"github.com/TBD54566975/ftl/backend/controller/sql"
 	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
 	"github.com/TBD54566975/ftl/backend/schema"
 	"github.com/TBD54566975/ftl/db/dalerrs"
+	"github.com/TBD54566975/ftl/internal/cdc"
 	"github.com/TBD54566975/ftl/internal/log"
 	"github.com/TBD54566975/ftl/internal/maps"
 	"github.com/TBD54566975/ftl/internal/model"
@@ -113,6 +113,10 @@ type Reconciliation struct {
 
 	AssignedReplicas int
 	RequiredReplicas int
+
+	// Consecutive crashes of the replicas of the deployment.
+	Crashes       int
+	LastCrashedAt optional.Option[time.Time]
 }
 
 type RunnerState string
@@ -213,8 +217,10 @@ func New(ctx context.Context, pool *pgxpool.Pool) (*DAL, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to acquire PG PubSub connection: %w", err)
 	}
+	resilientConn := sql.NewResilientConn(ctx, pool, sql.DefaultResilienceConfig)
 	dal := &DAL{
-		db:                sql.NewDB(pool),
+		db:                sql.NewDB(resilientConn),
+		conn:              resilientConn,
 		DeploymentChanges: pubsub.New[DeploymentNotification](),
 	}
 	go dal.runListener(ctx, conn.Hijack())
@@ -222,7 +228,10 @@ func New(ctx context.Context, pool *pgxpool.Pool) (*DAL, error) {
 }
 
 type DAL struct {
-	db sql.DBI
+	db   sql.DBI
+	conn *sql.ResilientConn
+	// Store of artefacts that have been moved out of the database, if any.
+	coldStore ColdStore
 
 	// DeploymentChanges is a Topic that receives changes to the deployments table.
 	DeploymentChanges *pubsub.Topic[DeploymentNotification]
@@ -274,10 +283,17 @@ func (d *DAL) Begin(ctx context.Context) (*Tx, error) {
 	}
 	return &Tx{&DAL{
 		db:                tx,
+		conn:              d.conn,
 		DeploymentChanges: d.DeploymentChanges,
 	}}, nil
 }
 
+// InjectDBUnavailable makes the database appear unavailable to the DAL until
+// called with false, for testing how the controller handles outages.
+func (d *DAL) InjectDBUnavailable(unavailable bool) {
+	d.conn.InjectUnavailable(unavailable)
+}
+
 func (d *DAL) GetActiveControllers(ctx context.Context) ([]Controller, error) {
 	controllers, err := d.db.GetActiveControllers(ctx)
 	if err != nil {
@@ -419,11 +435,34 @@ func (d *DAL) GetMissingArtefacts(ctx context.Context, digests []sha256.SHA256)
 	return sets.NewSet(digests...).Difference(sets.NewSet(haveStr...)).ToSlice(), nil
 }
 
-// CreateArtefact inserts a new artefact into the database and returns its ID.
+// CreateArtefact inserts a new artefact into the database and returns its digest.
+//
+// The content is split into content-defined chunks, which are shared with
+// any other artefacts that contain them. Each chunk is stored compressed
+// unless that doesn't make it smaller.
 func (d *DAL) CreateArtefact(ctx context.Context, content []byte) (digest sha256.SHA256, err error) {
-	sha256digest := sha256.Sum(content)
-	_, err = d.db.CreateArtefact(ctx, sha256digest[:], content)
-	return sha256digest, dalerrs.TranslatePGError(err)
+	digest = sha256.Sum(content)
+	tx, err := d.db.Begin(ctx)
+	if err != nil {
+		return digest, fmt.Errorf("could not start transaction: %w", err)
+	}
+	defer tx.CommitOrRollback(ctx, &err)
+	id, err := tx.CreateArtefact(ctx, digest[:], nil)
+	if err != nil {
+		return digest, dalerrs.TranslatePGError(err)
+	}
+	for i, chunk := range cdc.Split(content) {
+		chunkDigest := sha256.Sum(chunk)
+		stored, chunkCompression := storedArtefactContent(chunk)
+		chunkID, err := tx.UpsertArtefactChunk(ctx, chunkDigest[:], stored, chunkCompression)
+		if err != nil {
+			return digest, fmt.Errorf("could not create artefact chunk: %w", dalerrs.TranslatePGError(err))
+		}
+		if err := tx.AssociateArtefactChunk(ctx, id, chunkID, int32(i)); err != nil {
+			return digest, fmt.Errorf("could not associate chunk with artefact: %w", dalerrs.TranslatePGError(err))
+		}
+	}
+	return digest, nil
 }
 
 type IngressRoutingEntry struct {
@@ -436,7 +475,7 @@ type IngressRoutingEntry struct {
 // previously created artefacts with it.
 //
 // If an existing deployment with identical artefacts exists, it is returned.
-func (d *DAL) CreateDeployment(ctx context.Context, language string, moduleSchema *schema.Module, artefacts []DeploymentArtefact, ingressRoutes []IngressRoutingEntry, cronJobs []model.CronJob) (key model.DeploymentKey, err error) {
+func (d *DAL) CreateDeployment(ctx context.Context, language string, moduleSchema *schema.Module, labels model.Labels, artefacts []DeploymentArtefact, ingressRoutes []IngressRoutingEntry, cronJobs []model.CronJob) (key model.DeploymentKey, err error) {
 	logger := log.FromContext(ctx)
 
 	// Start the transaction
@@ -459,7 +498,7 @@ func (d *DAL) CreateDeployment(ctx context.Context, language string, moduleSchem
 		return in.Digest, in
 	})
 
-	schemaBytes, err := proto.Marshal(moduleSchema.ToProto())
+	schemaBytes, err := schema.ModuleToCompressedBytes(moduleSchema)
 	if err != nil {
 		return model.DeploymentKey{}, fmt.Errorf("failed to marshal schema: %w", err)
 	}
@@ -487,10 +526,23 @@ func (d *DAL) CreateDeployment(ctx context.Context, language string, moduleSchem
 		}
 	}
 
+	if labels == nil {
+		labels = model.Labels{}
+	}
+	labelBytes, err := json.Marshal(labels)
+	if err != nil {
+		return model.DeploymentKey{}, fmt.Errorf("failed to JSON encode deployment labels: %w", err)
+	}
+
 	deploymentKey := model.NewDeploymentKey(moduleSchema.Name)
 
 	// Create the deployment
-	err = tx.CreateDeployment(ctx, moduleSchema.Name, schemaBytes, deploymentKey)
+	err = tx.CreateDeployment(ctx, sql.CreateDeploymentParams{
+		ModuleName: moduleSchema.Name,
+		Schema:     schemaBytes,
+		Key:        deploymentKey,
+		Labels:     labelBytes,
+	})
 	if err != nil {
 		return model.DeploymentKey{}, fmt.Errorf("failed to create deployment: %w", dalerrs.TranslatePGError(err))
 	}
@@ -815,10 +867,47 @@ func (d *DAL) GetDeploymentsNeedingReconciliation(ctx context.Context) ([]Reconc
 			Language:         t.Language,
 			AssignedReplicas: int(t.AssignedRunnersCount),
 			RequiredReplicas: int(t.RequiredRunnersCount),
+			Crashes:          int(t.CrashCount),
+			LastCrashedAt:    t.LastCrashedAt,
 		}
 	}), nil
 }
 
+// RecordDeploymentCrash counts a crash of a replica of "key", returning its
+// number of consecutive crashes.
+//
+// Crashes are no longer consecutive once the deployment has run for
+// "resetAfter" without crashing.
+func (d *DAL) RecordDeploymentCrash(ctx context.Context, key model.DeploymentKey, resetAfter time.Duration) (int, error) {
+	count, err := d.db.RecordDeploymentCrash(ctx, resetAfter, key)
+	if err != nil {
+		return 0, dalerrs.TranslatePGError(err)
+	}
+	return int(count), nil
+}
+
+// ResetDeploymentCrashes forgets the crashes of the deployments of "module".
+func (d *DAL) ResetDeploymentCrashes(ctx context.Context, module string) error {
+	return dalerrs.TranslatePGError(d.db.ResetDeploymentCrashes(ctx, module))
+}
+
+// GetPrefetchCandidates returns up to "limit" deployments in one of
+// "languages" that are likely to need runners soon, most likely first.
+//
+// These are deployments with fewer runners than required, followed by the
+// newest deployment of each module if it was created after "createdAfter" and
+// hasn't been scaled up yet.
+func (d *DAL) GetPrefetchCandidates(ctx context.Context, languages []string, createdAfter time.Time, limit int) ([]model.DeploymentKey, error) {
+	keys, err := d.db.GetPrefetchCandidates(ctx, languages, createdAfter, int32(limit))
+	if err != nil {
+		if dalerrs.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, dalerrs.TranslatePGError(err)
+	}
+	return keys, nil
+}
+
 // GetActiveDeployments returns all active deployments.
 func (d *DAL) GetActiveDeployments(ctx context.Context) ([]Deployment, error) {
 	rows, err := d.db.GetActiveDeployments(ctx)
@@ -829,6 +918,10 @@ func (d *DAL) GetActiveDeployments(ctx context.Context) ([]Deployment, error) {
 		return nil, dalerrs.TranslatePGError(err)
 	}
 	return slices.MapErr(rows, func(in sql.GetActiveDeploymentsRow) (Deployment, error) {
+		labels := model.Labels{}
+		if err := json.Unmarshal(in.Deployment.Labels, &labels); err != nil {
+			return Deployment{}, fmt.Errorf("%q: invalid labels in database: %w", in.ModuleName, err)
+		}
 		return Deployment{
 			Key:         in.Deployment.Key,
 			Module:      in.ModuleName,
@@ -837,6 +930,7 @@ func (d *DAL) GetActiveDeployments(ctx context.Context) ([]Deployment, error) {
 			Replicas:    optional.Some(int(in.Replicas)),
 			Schema:      in.Deployment.Schema,
 			CreatedAt:   in.Deployment.CreatedAt,
+			Labels:      labels,
 		}, nil
 	})
 }
@@ -850,6 +944,10 @@ func (d *DAL) GetDeploymentsWithMinReplicas(ctx context.Context) ([]Deployment,
 		return nil, dalerrs.TranslatePGError(err)
 	}
 	return slices.MapErr(rows, func(in sql.GetDeploymentsWithMinReplicasRow) (Deployment, error) {
+		labels := model.Labels{}
+		if err := json.Unmarshal(in.Deployment.Labels, &labels); err != nil {
+			return Deployment{}, fmt.Errorf("%q: invalid labels in database: %w", in.ModuleName, err)
+		}
 		return Deployment{
 			Key:         in.Deployment.Key,
 			Module:      in.ModuleName,
@@ -857,6 +955,7 @@ func (d *DAL) GetDeploymentsWithMinReplicas(ctx context.Context) ([]Deployment,
 			MinReplicas: int(in.Deployment.MinReplicas),
 			Schema:      in.Deployment.Schema,
 			CreatedAt:   in.Deployment.CreatedAt,
+			Labels:      labels,
 		}, nil
 	})
 }
@@ -880,6 +979,10 @@ type Process struct {
 	MinReplicas int
 	Labels      model.Labels
 	Runner      optional.Option[ProcessRunner]
+
+	// Consecutive crashes of the replicas of the deployment.
+	Crashes       int
+	LastCrashedAt optional.Option[time.Time]
 }
 
 // GetProcessList returns a list of all "processes".
@@ -907,10 +1010,12 @@ func (d *DAL) GetProcessList(ctx context.Context) ([]Process, error) {
 			return Process{}, fmt.Errorf("invalid labels JSON for deployment %s: %w", row.DeploymentKey, err)
 		}
 		return Process{
-			Deployment:  row.DeploymentKey,
-			Labels:      labels,
-			MinReplicas: int(row.MinReplicas),
-			Runner:      runner,
+			Deployment:    row.DeploymentKey,
+			Labels:        labels,
+			MinReplicas:   int(row.MinReplicas),
+			Runner:        runner,
+			Crashes:       int(row.CrashCount),
+			LastCrashedAt: row.LastCrashedAt,
 		}, nil
 	})
 }
@@ -1009,6 +1114,7 @@ func cronJobFromRow(row sql.GetCronJobsRow) model.CronJob {
 		StartTime:     row.StartTime,
 		NextExecution: row.NextExecution,
 		State:         row.State,
+		Paused:        row.Paused,
 	}
 }
 
@@ -1048,6 +1154,7 @@ func (d *DAL) StartCronJobs(ctx context.Context, jobs []model.CronJob) (attempte
 				StartTime:     row.StartTime,
 				NextExecution: row.NextExecution,
 				State:         row.State,
+				Paused:        row.Paused,
 			},
 			DidStartExecution: row.Updated,
 			HasMinReplicas:    row.HasMinReplicas,
@@ -1078,6 +1185,17 @@ func (d *DAL) GetStaleCronJobs(ctx context.Context, duration time.Duration) ([]m
 	}), nil
 }
 
+// SetCronJobsPaused pauses or resumes the cron jobs of the active deployment of
+// "module", or only the job of "verb" if it is set, returning the keys of the
+// jobs that were updated.
+func (d *DAL) SetCronJobsPaused(ctx context.Context, module string, verb optional.Option[string], paused bool) ([]model.CronJobKey, error) {
+	keys, err := d.db.SetCronJobsPaused(ctx, paused, module, verb)
+	if err != nil {
+		return nil, dalerrs.TranslatePGError(err)
+	}
+	return keys, nil
+}
+
 func (d *DAL) InsertLogEvent(ctx context.Context, log *LogEvent) error {
 	attributes, err := json.Marshal(log.Attributes)
 	if err != nil {
@@ -1105,17 +1223,22 @@ func (d *DAL) loadDeployment(ctx context.Context, deployment sql.GetDeploymentRo
 		Language: deployment.Language,
 		Key:      deployment.Deployment.Key,
 		Schema:   deployment.Deployment.Schema,
+		Labels:   model.Labels{},
+	}
+	if err := json.Unmarshal(deployment.Deployment.Labels, &out.Labels); err != nil {
+		return nil, fmt.Errorf("invalid labels for deployment %s: %w", deployment.Deployment.Key, err)
 	}
 	artefacts, err := d.db.GetDeploymentArtefacts(ctx, deployment.Deployment.ID)
 	if err != nil {
 		return nil, dalerrs.TranslatePGError(err)
 	}
 	out.Artefacts = slices.Map(artefacts, func(row sql.GetDeploymentArtefactsRow) *model.Artefact {
+		digest := sha256.FromBytes(row.Digest)
 		return &model.Artefact{
 			Path:       row.Path,
 			Executable: row.Executable,
-			Content:    &artefactReader{id: row.ID, db: d.db},
-			Digest:     sha256.FromBytes(row.Digest),
+			Content:    d.newArtefactReader(digest, row.Cold, row.Compression),
+			Digest:     digest,
 		}
 	})
 	return out, nil
@@ -1162,6 +1285,10 @@ func (d *DAL) InsertCallEvent(ctx context.Context, call *CallEvent) error {
 	if rn, ok := call.RequestKey.Get(); ok {
 		requestKey = optional.Some(rn.String())
 	}
+	var fingerprint optional.Option[string]
+	if callError, ok := call.Error.Get(); ok {
+		fingerprint = optional.Some(errorFingerprint(call.DestVerb, callError, call.Stack))
+	}
 	return dalerrs.TranslatePGError(d.db.InsertCallEvent(ctx, sql.InsertCallEventParams{
 		DeploymentKey: call.DeploymentKey,
 		RequestKey:    requestKey,
@@ -1175,6 +1302,9 @@ func (d *DAL) InsertCallEvent(ctx context.Context, call *CallEvent) error {
 		Response:      call.Response,
 		Error:         call.Error,
 		Stack:         call.Stack,
+		Fingerprint:   fingerprint,
+		RequestSize:   call.RequestSize,
+		ResponseSize:  call.ResponseSize,
 	}))
 }
 
@@ -1190,7 +1320,7 @@ func (d *DAL) GetActiveRunners(ctx context.Context) ([]Runner, error) {
 
 // Check if a deployment exists that exactly matches the given artefacts and schema.
 func (*DAL) checkForExistingDeployments(ctx context.Context, tx *sql.Tx, moduleSchema *schema.Module, artefacts []DeploymentArtefact) (model.DeploymentKey, error) {
-	schemaBytes, err := schema.ModuleToBytes(moduleSchema)
+	schemaBytes, err := schema.ModuleToCompressedBytes(moduleSchema)
 	if err != nil {
 		return model.DeploymentKey{}, fmt.Errorf("failed to marshal schema: %w", err)
 	}
@@ -1212,24 +1342,44 @@ func sha256esToBytes(digests []sha256.SHA256) [][]byte {
 	return slices.Map(digests, func(digest sha256.SHA256) []byte { return digest[:] })
 }
 
+// artefactReader reads the content of an artefact, fetching all of it from the
+// database or the cold store in a single round trip on the first read.
+//
+// The content is verified against the artefact's digest before it is read.
 type artefactReader struct {
-	id     int64
-	db     sql.DBI
-	offset int32
+	digest  sha256.SHA256
+	fetch   func(ctx context.Context, digest sha256.SHA256) ([]byte, error)
+	content *bytes.Reader
+}
+
+func (d *DAL) newArtefactReader(digest sha256.SHA256, cold bool, compression optional.Option[string]) *artefactReader {
+	if !cold {
+		return &artefactReader{digest: digest, fetch: d.GetArtefactContent}
+	}
+	return &artefactReader{digest: digest, fetch: func(ctx context.Context, digest sha256.SHA256) ([]byte, error) {
+		if d.coldStore == nil {
+			return nil, fmt.Errorf("artefact %s is in cold storage, but no cold store is configured", digest)
+		}
+		content, err := d.coldStore.Get(ctx, digest)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch artefact %s from cold storage: %w", digest, err)
+		}
+		return decompressArtefactContent(content, compression)
+	}}
 }
 
 func (r *artefactReader) Close() error { return nil }
 
 func (r *artefactReader) Read(p []byte) (n int, err error) {
-	content, err := r.db.GetArtefactContentRange(context.Background(), r.offset+1, int32(len(p)), r.id)
-	if err != nil {
-		return 0, dalerrs.TranslatePGError(err)
-	}
-	copy(p, content)
-	clen := len(content)
-	r.offset += int32(clen)
-	if clen == 0 {
-		err = io.EOF
+	if r.content == nil {
+		content, err := r.fetch(context.Background(), r.digest)
+		if err != nil {
+			return 0, err
+		}
+		if sha256.Sum(content) != r.digest {
+			return 0, fmt.Errorf("artefact %s is corrupt", r.digest)
+		}
+		r.content = bytes.NewReader(content)
 	}
-	return clen, err
+	return r.content.Read(p)
 }
@@ -56,7 +56,7 @@ func TestDAL(t *testing.T) {
 	module := &schema.Module{Name: "test"}
 	var deploymentKey model.DeploymentKey
 	t.Run("CreateDeployment", func(t *testing.T) {
-		deploymentKey, err = dal.CreateDeployment(ctx, "go", module, []DeploymentArtefact{{
+		deploymentKey, err = dal.CreateDeployment(ctx, "go", module, nil, []DeploymentArtefact{{
 			Digest:     testSha,
 			Executable: true,
 			Path:       "dir/filename",
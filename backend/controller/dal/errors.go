@@ -0,0 +1,84 @@
+package dal
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/sql"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/db/dalerrs"
+	"github.com/TBD54566975/ftl/internal/slices"
+)
+
+// CallErrorGroup is a group of failed calls to a verb with the same error fingerprint.
+type CallErrorGroup struct {
+	Fingerprint string
+	Verb        schema.Ref
+	Count       int64
+	FirstSeen   time.Time
+	LastSeen    time.Time
+	// Error and stack trace of the most recent failed call in the group.
+	Error string
+	Stack optional.Option[string]
+}
+
+// GetCallErrorGroups returns the failed calls since "since", grouped by the
+// fingerprint of their error, most frequent first.
+func (d *DAL) GetCallErrorGroups(ctx context.Context, since time.Time) ([]CallErrorGroup, error) {
+	rows, err := d.db.GetCallErrorGroups(ctx, since)
+	if err != nil {
+		return nil, dalerrs.TranslatePGError(err)
+	}
+	return slices.Map(rows, func(row sql.GetCallErrorGroupsRow) CallErrorGroup {
+		group := CallErrorGroup{
+			Fingerprint: row.Fingerprint,
+			Verb:        schema.Ref{Module: row.DestModule, Name: row.DestVerb},
+			Count:       row.Count,
+			FirstSeen:   row.FirstSeen,
+			LastSeen:    row.LastSeen,
+			Error:       row.Error,
+		}
+		if row.Stack != "" {
+			group.Stack = optional.Some(row.Stack)
+		}
+		return group
+	}), nil
+}
+
+var (
+	// Arguments of frames in a Go stack trace, eg. "(0x140001, {0x0, 0x1})",
+	// and the goroutine a goroutine was created in.
+	stackVariablesRe = regexp.MustCompile(`\(.*\)$| in goroutine [0-9]+$`)
+	// Variable parts of error messages, such as IDs and numbers.
+	messageVariablesRe = regexp.MustCompile(`(?i)[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}|0x[0-9a-f]+|[0-9]+`)
+)
+
+// errorFingerprint identifies failed calls to "verb" that are likely to share
+// a cause, so they can be grouped together.
+//
+// If the error has a stack trace, eg. because the verb panicked, the
+// fingerprint is derived from the functions in the stack. Otherwise it is
+// derived from the error message, ignoring numbers and IDs.
+func errorFingerprint(verb schema.Ref, message string, stack optional.Option[string]) string {
+	h := sha256.New()
+	h.Write([]byte(verb.String())) //nolint:errcheck
+	if stack, ok := stack.Get(); ok {
+		for _, line := range strings.Split(stack, "\n") {
+			// Skip goroutine headers and file:line locations, which change as
+			// unrelated code is edited.
+			if line == "" || strings.HasPrefix(line, "\t") || strings.HasPrefix(line, "goroutine ") {
+				continue
+			}
+			h.Write([]byte("\x00" + stackVariablesRe.ReplaceAllString(line, ""))) //nolint:errcheck
+		}
+	} else {
+		h.Write([]byte("\x00" + messageVariablesRe.ReplaceAllString(message, "_"))) //nolint:errcheck
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
@@ -0,0 +1,34 @@
+package dal
+
+import (
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+func TestErrorFingerprint(t *testing.T) {
+	verb := schema.Ref{Module: "orders", Name: "create"}
+	stack := func(goroutine, arg, line string) optional.Option[string] {
+		return optional.Some(`goroutine ` + goroutine + ` [running]:
+runtime/debug.Stack()
+	/usr/local/go/src/runtime/debug/stack.go:24 +0x5e
+ftl/orders.Create({0x1012f4b60, 0x` + arg + `}, {0x0, 0x0})
+	/src/orders/orders.go:` + line + ` +0x3c
+created by net/http.(*Server).Serve in goroutine ` + goroutine + `
+	/usr/local/go/src/net/http/server.go:3285 +0x3f8
+`)
+	}
+
+	panicked := errorFingerprint(verb, "runtime error: index out of range [3] with length 2", stack("12", "14000a", "42"))
+	assert.Equal(t, panicked, errorFingerprint(verb, "runtime error: index out of range [5] with length 1", stack("97", "2f00b1", "44")),
+		"variable parts of the stack should not affect the fingerprint")
+	assert.NotEqual(t, panicked, errorFingerprint(schema.Ref{Module: "orders", Name: "update"}, "runtime error: index out of range [3] with length 2", stack("12", "14000a", "42")))
+
+	failed := errorFingerprint(verb, "order 1234 not found", optional.None[string]())
+	assert.Equal(t, failed, errorFingerprint(verb, "order 5678 not found", optional.None[string]()))
+	assert.NotEqual(t, failed, errorFingerprint(verb, "insufficient funds for order 1234", optional.None[string]()))
+	assert.NotEqual(t, failed, panicked)
+}
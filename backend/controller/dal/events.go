@@ -60,8 +60,13 @@ type CallEvent struct {
 	Duration      time.Duration
 	Request       []byte
 	Response      []byte
-	Error         optional.Option[string]
-	Stack         optional.Option[string]
+	// Sizes in bytes of the request and response bodies, as sent over the
+	// wire. Response bodies that were rejected for being too large are not
+	// recorded, but their size is.
+	RequestSize  int64
+	ResponseSize int64
+	Error        optional.Option[string]
+	Stack        optional.Option[string]
 }
 
 func (e *CallEvent) GetID() int64 { return e.ID }
@@ -174,11 +179,13 @@ func FilterDescending() EventFilter {
 
 // The internal JSON payload of a call event.
 type eventCallJSON struct {
-	DurationMS int64                   `json:"duration_ms"`
-	Request    json.RawMessage         `json:"request"`
-	Response   json.RawMessage         `json:"response"`
-	Error      optional.Option[string] `json:"error,omitempty"`
-	Stack      optional.Option[string] `json:"stack,omitempty"`
+	DurationMS   int64                   `json:"duration_ms"`
+	Request      json.RawMessage         `json:"request"`
+	Response     json.RawMessage         `json:"response"`
+	Error        optional.Option[string] `json:"error,omitempty"`
+	Stack        optional.Option[string] `json:"stack,omitempty"`
+	RequestSize  optional.Option[int64]  `json:"request_size,omitempty"`
+	ResponseSize optional.Option[int64]  `json:"response_size,omitempty"`
 }
 
 type eventLogJSON struct {
@@ -386,8 +393,12 @@ func transformRowsToEvents(deploymentKeys map[int64]model.DeploymentKey, rows pg
 				Duration:      time.Duration(jsonPayload.DurationMS) * time.Millisecond,
 				Request:       jsonPayload.Request,
 				Response:      jsonPayload.Response,
-				Error:         jsonPayload.Error,
-				Stack:         jsonPayload.Stack,
+				// Events recorded before sizes were tracked fall back to the
+				// size of the stored bodies.
+				RequestSize:  jsonPayload.RequestSize.Default(int64(len(jsonPayload.Request))),
+				ResponseSize: jsonPayload.ResponseSize.Default(int64(len(jsonPayload.Response))),
+				Error:        jsonPayload.Error,
+				Stack:        jsonPayload.Stack,
 			})
 
 		case sql.EventTypeDeploymentCreated:
@@ -0,0 +1,87 @@
+package dal
+
+import (
+	"context"
+	"time"
+
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/sql"
+	"github.com/TBD54566975/ftl/db/dalerrs"
+	"github.com/TBD54566975/ftl/internal/slices"
+)
+
+type FaultKind string
+
+const (
+	// FaultLatency delays calls to a verb by the fault's latency.
+	FaultLatency FaultKind = "latency"
+	// FaultError fails calls to a verb with the fault's message.
+	FaultError FaultKind = "error"
+	// FaultDropAsync fails async calls to a verb without calling it, as if
+	// they were lost.
+	FaultDropAsync FaultKind = "drop_async"
+	// FaultDelayScheduling delays the execution of async calls to a verb by
+	// the fault's latency.
+	FaultDelayScheduling FaultKind = "delay_scheduling"
+)
+
+// Fault injected into a percentage of calls to the verbs of a module.
+type Fault struct {
+	ID     int64
+	Kind   FaultKind
+	Module string
+	// Verb the fault applies to, or every verb of the module if absent.
+	Verb      optional.Option[string]
+	Percent   float64
+	Latency   time.Duration
+	Message   string
+	ExpiresAt time.Time
+}
+
+// CreateFault injects "fault" until it expires, returning its ID.
+func (d *DAL) CreateFault(ctx context.Context, fault Fault) (int64, error) {
+	id, err := d.db.CreateFault(ctx, sql.CreateFaultParams{
+		Kind:      string(fault.Kind),
+		Module:    fault.Module,
+		Verb:      fault.Verb,
+		Percent:   fault.Percent,
+		LatencyMs: fault.Latency.Milliseconds(),
+		Message:   fault.Message,
+		ExpiresAt: fault.ExpiresAt,
+	})
+	if err != nil {
+		return 0, dalerrs.TranslatePGError(err)
+	}
+	return id, nil
+}
+
+// GetActiveFaults returns the faults that have not expired.
+func (d *DAL) GetActiveFaults(ctx context.Context) ([]Fault, error) {
+	rows, err := d.db.GetActiveFaults(ctx)
+	if err != nil {
+		return nil, dalerrs.TranslatePGError(err)
+	}
+	return slices.Map(rows, func(row sql.Fault) Fault {
+		return Fault{
+			ID:        row.ID,
+			Kind:      FaultKind(row.Kind),
+			Module:    row.Module,
+			Verb:      row.Verb,
+			Percent:   row.Percent,
+			Latency:   time.Duration(row.LatencyMs) * time.Millisecond,
+			Message:   row.Message,
+			ExpiresAt: row.ExpiresAt,
+		}
+	}), nil
+}
+
+// DeleteFaults removes the fault with "id", or every fault if it is absent,
+// returning the number of faults removed.
+func (d *DAL) DeleteFaults(ctx context.Context, id optional.Option[int64]) (int, error) {
+	count, err := d.db.DeleteFaults(ctx, id)
+	if err != nil {
+		return 0, dalerrs.TranslatePGError(err)
+	}
+	return int(count), nil
+}
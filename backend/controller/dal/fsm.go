@@ -13,6 +13,8 @@ import (
 	"github.com/TBD54566975/ftl/backend/controller/sql"
 	"github.com/TBD54566975/ftl/backend/schema"
 	"github.com/TBD54566975/ftl/db/dalerrs"
+	"github.com/TBD54566975/ftl/internal/model"
+	"github.com/TBD54566975/ftl/internal/slices"
 )
 
 // StartFSMTransition sends an event to an executing instance of an FSM.
@@ -20,7 +22,8 @@ import (
 // If the instance doesn't exist a new one will be created.
 //
 // [name] is the name of the state machine to execute, [executionKey] is the
-// unique identifier for this execution of the FSM.
+// unique identifier for this execution of the FSM. [parentRequestKey] is the
+// request that sent the event, if any.
 //
 // Returns ErrConflict if the state machine is already executing a transition.
 //
@@ -28,7 +31,12 @@ import (
 // future execution.
 //
 // Note: no validation of the FSM is performed.
-func (d *DAL) StartFSMTransition(ctx context.Context, fsm schema.RefKey, executionKey string, destinationState schema.RefKey, request json.RawMessage, retryParams schema.RetryParams) (err error) {
+func (d *DAL) StartFSMTransition(ctx context.Context, fsm schema.RefKey, executionKey string, destinationState schema.RefKey, request json.RawMessage, retryParams schema.RetryParams, parentRequestKey optional.Option[model.RequestKey]) (err error) {
+	var parentRequest optional.Option[string]
+	if key, ok := parentRequestKey.Get(); ok {
+		parentRequest = optional.Some(key.String())
+	}
+
 	// Create an async call for the event.
 	origin := AsyncOriginFSM{FSM: fsm, Key: executionKey}
 	asyncCallID, err := d.db.CreateAsyncCall(ctx, sql.CreateAsyncCallParams{
@@ -38,6 +46,7 @@ func (d *DAL) StartFSMTransition(ctx context.Context, fsm schema.RefKey, executi
 		RemainingAttempts: int32(retryParams.Count),
 		Backoff:           retryParams.MinBackoff,
 		MaxBackoff:        retryParams.MaxBackoff,
+		ParentRequestKey:  parentRequest,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create FSM async call: %w", dalerrs.TranslatePGError(err))
@@ -65,6 +74,13 @@ func (d *DAL) FinishFSMTransition(ctx context.Context, fsm schema.RefKey, instan
 	return dalerrs.TranslatePGError(err)
 }
 
+// AbortFSMTransition abandons the transition an FSM instance is executing,
+// leaving it in its current state so that another transition can be started.
+func (d *DAL) AbortFSMTransition(ctx context.Context, fsm schema.RefKey, instanceKey string) error {
+	_, err := d.db.AbortFSMTransition(ctx, fsm, instanceKey)
+	return dalerrs.TranslatePGError(err)
+}
+
 func (d *DAL) FailFSMInstance(ctx context.Context, fsm schema.RefKey, instanceKey string) error {
 	_, err := d.db.FailFSMInstance(ctx, fsm, instanceKey)
 	return dalerrs.TranslatePGError(err)
@@ -119,3 +135,61 @@ func (d *DAL) AcquireFSMInstance(ctx context.Context, fsm schema.RefKey, instanc
 		DestinationState: row.DestinationState,
 	}, nil
 }
+
+// GetFSMInstance returns the state of an FSM instance, without acquiring a lease on it.
+//
+// Returns ErrNotFound if the instance doesn't exist.
+func (d *DAL) GetFSMInstance(ctx context.Context, fsm schema.RefKey, instanceKey string) (FSMInstanceState, error) {
+	row, err := d.db.GetFSMInstance(ctx, fsm, instanceKey)
+	if err != nil {
+		return FSMInstanceState{}, dalerrs.TranslatePGError(err)
+	}
+	return FSMInstanceState{
+		FSM:              row.Fsm,
+		Key:              row.Key,
+		Status:           row.Status,
+		CurrentState:     row.CurrentState,
+		DestinationState: row.DestinationState,
+	}, nil
+}
+
+// FSMInstanceState is the state of an FSM instance, as stored in backups.
+type FSMInstanceState struct {
+	FSM              schema.RefKey
+	Key              string
+	Status           FSMStatus
+	CurrentState     optional.Option[schema.RefKey]
+	DestinationState optional.Option[schema.RefKey]
+}
+
+// GetFSMInstances returns the state of every FSM instance.
+func (d *DAL) GetFSMInstances(ctx context.Context) ([]FSMInstanceState, error) {
+	rows, err := d.db.GetFSMInstances(ctx)
+	if err != nil {
+		return nil, dalerrs.TranslatePGError(err)
+	}
+	return slices.Map(rows, func(row sql.FsmInstance) FSMInstanceState {
+		return FSMInstanceState{
+			FSM:              row.Fsm,
+			Key:              row.Key,
+			Status:           row.Status,
+			CurrentState:     row.CurrentState,
+			DestinationState: row.DestinationState,
+		}
+	}), nil
+}
+
+// RestoreFSMInstance creates an FSM instance in the given state, unless it
+// already exists.
+//
+// Transitions that were in progress are not restored, so the instance is
+// restored in the last state it reached.
+func (d *DAL) RestoreFSMInstance(ctx context.Context, instance FSMInstanceState) error {
+	err := d.db.RestoreFSMInstance(ctx, sql.RestoreFSMInstanceParams{
+		Fsm:          instance.FSM,
+		Key:          instance.Key,
+		Status:       instance.Status,
+		CurrentState: instance.CurrentState,
+	})
+	return dalerrs.TranslatePGError(err)
+}
@@ -58,6 +58,21 @@ func TestFSM(t *testing.T) {
 	)
 }
 
+func TestFSMSend(t *testing.T) {
+	logFilePath := filepath.Join(t.TempDir(), "fsm.log")
+	t.Setenv("FSM_LOG_FILE", logFilePath)
+	in.Run(t, "",
+		in.CopyModule("fsm"),
+		in.Deploy("fsm"),
+
+		in.Exec("ftl", "fsm", "send", "fsm.fsm", "1", "start", "--body", `{instance: "1"}`),
+		in.FileContains(logFilePath, "start 1"),
+
+		in.Exec("ftl", "fsm", "send", "fsm.fsm", "1", "fsm.middle", "--body", `{instance: "1"}`),
+		in.FileContains(logFilePath, "middle 1"),
+	)
+}
+
 func TestFSMRetry(t *testing.T) {
 	checkRetries := func(origin, verb string, delays []time.Duration) in.Action {
 		return func(t testing.TB, ic in.TestContext) {
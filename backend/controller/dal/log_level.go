@@ -0,0 +1,46 @@
+package dal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/TBD54566975/ftl/db/dalerrs"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+// SetModuleLogLevel overrides the log level of the deployments of "module"
+// until "expiresAt", replacing any existing override.
+func (d *DAL) SetModuleLogLevel(ctx context.Context, module string, level log.Level, expiresAt time.Time) error {
+	err := d.db.SetModuleLogLevel(ctx, module, level.String(), expiresAt)
+	if err != nil {
+		return dalerrs.TranslatePGError(err)
+	}
+	return nil
+}
+
+// ClearModuleLogLevel removes any log level override of "module".
+func (d *DAL) ClearModuleLogLevel(ctx context.Context, module string) error {
+	err := d.db.DeleteModuleLogLevel(ctx, module)
+	if err != nil {
+		return dalerrs.TranslatePGError(err)
+	}
+	return nil
+}
+
+// GetModuleLogLevel returns the log level override of "module", or
+// [log.Default] if it has none or it has expired.
+func (d *DAL) GetModuleLogLevel(ctx context.Context, module string) (log.Level, error) {
+	row, err := d.db.GetModuleLogLevel(ctx, module)
+	if err != nil {
+		if dalerrs.IsNotFound(err) {
+			return log.Default, nil
+		}
+		return log.Default, dalerrs.TranslatePGError(err)
+	}
+	level, err := log.ParseLevel(row.Level)
+	if err != nil {
+		return log.Default, fmt.Errorf("invalid log level for module %q: %w", module, err)
+	}
+	return level, nil
+}
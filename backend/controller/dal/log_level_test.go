@@ -0,0 +1,43 @@
+package dal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/backend/controller/sql/sqltest"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+func TestModuleLogLevel(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	conn := sqltest.OpenForTesting(ctx, t)
+	dal, err := New(ctx, conn)
+	assert.NoError(t, err)
+
+	level, err := dal.GetModuleLogLevel(ctx, "echo")
+	assert.NoError(t, err)
+	assert.Equal(t, log.Default, level)
+
+	err = dal.SetModuleLogLevel(ctx, "echo", log.Debug, time.Now().Add(time.Minute))
+	assert.NoError(t, err)
+	err = dal.SetModuleLogLevel(ctx, "echo", log.Trace, time.Now().Add(time.Minute))
+	assert.NoError(t, err)
+	level, err = dal.GetModuleLogLevel(ctx, "echo")
+	assert.NoError(t, err)
+	assert.Equal(t, log.Trace, level)
+
+	err = dal.SetModuleLogLevel(ctx, "time", log.Debug, time.Now().Add(-time.Minute))
+	assert.NoError(t, err)
+	level, err = dal.GetModuleLogLevel(ctx, "time")
+	assert.NoError(t, err)
+	assert.Equal(t, log.Default, level, "expired overrides should be ignored")
+
+	err = dal.ClearModuleLogLevel(ctx, "echo")
+	assert.NoError(t, err)
+	level, err = dal.GetModuleLogLevel(ctx, "echo")
+	assert.NoError(t, err)
+	assert.Equal(t, log.Default, level)
+}
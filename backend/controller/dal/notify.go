@@ -105,6 +105,10 @@ func (d *DAL) publishNotification(ctx context.Context, notification event, logge
 			if err != nil {
 				return Deployment{}, optional.None[model.DeploymentKey](), dalerrs.TranslatePGError(err)
 			}
+			labels := model.Labels{}
+			if err := json.Unmarshal(row.Deployment.Labels, &labels); err != nil {
+				return Deployment{}, optional.None[model.DeploymentKey](), fmt.Errorf("%q: invalid labels in database: %w", row.ModuleName, err)
+			}
 			return Deployment{
 				CreatedAt:   row.Deployment.CreatedAt,
 				Key:         row.Deployment.Key,
@@ -112,6 +116,7 @@ func (d *DAL) publishNotification(ctx context.Context, notification event, logge
 				Schema:      row.Deployment.Schema,
 				MinReplicas: int(row.Deployment.MinReplicas),
 				Language:    row.Language,
+				Labels:      labels,
 			}, optional.None[model.DeploymentKey](), nil
 		})
 		if err != nil {
@@ -0,0 +1,70 @@
+package dal
+
+import (
+	"context"
+	"time"
+
+	"github.com/TBD54566975/ftl/backend/controller/sql"
+	"github.com/TBD54566975/ftl/db/dalerrs"
+	"github.com/TBD54566975/ftl/internal/slices"
+)
+
+// ProvisionedDatabase is a database the controller provisioned for a module.
+type ProvisionedDatabase struct {
+	CreatedAt time.Time
+	Module    string
+	Name      string
+	// Provisioner that created the database.
+	Provisioner string
+	// Provisioner specific identifier of the resources created for the database.
+	Resource string
+}
+
+// CreateProvisionedDatabase records that a database was provisioned for a module.
+//
+// Returns ErrConflict if a database with the same name was already provisioned
+// for the module.
+func (d *DAL) CreateProvisionedDatabase(ctx context.Context, db ProvisionedDatabase) error {
+	err := d.db.CreateProvisionedDatabase(ctx, sql.CreateProvisionedDatabaseParams{
+		Module:      db.Module,
+		Name:        db.Name,
+		Provisioner: db.Provisioner,
+		Resource:    db.Resource,
+	})
+	return dalerrs.TranslatePGError(err)
+}
+
+// GetProvisionedDatabase returns the database provisioned for "module" named "name".
+//
+// Returns ErrNotFound if no such database was provisioned.
+func (d *DAL) GetProvisionedDatabase(ctx context.Context, module, name string) (ProvisionedDatabase, error) {
+	row, err := d.db.GetProvisionedDatabase(ctx, module, name)
+	if err != nil {
+		return ProvisionedDatabase{}, dalerrs.TranslatePGError(err)
+	}
+	return provisionedDatabaseFromRow(row), nil
+}
+
+// GetProvisionedDatabases returns every database provisioned for modules.
+func (d *DAL) GetProvisionedDatabases(ctx context.Context) ([]ProvisionedDatabase, error) {
+	rows, err := d.db.GetProvisionedDatabases(ctx)
+	if err != nil {
+		return nil, dalerrs.TranslatePGError(err)
+	}
+	return slices.Map(rows, provisionedDatabaseFromRow), nil
+}
+
+// DeleteProvisionedDatabase removes the record of a provisioned database.
+func (d *DAL) DeleteProvisionedDatabase(ctx context.Context, module, name string) error {
+	return dalerrs.TranslatePGError(d.db.DeleteProvisionedDatabase(ctx, module, name))
+}
+
+func provisionedDatabaseFromRow(row sql.ProvisionedDatabase) ProvisionedDatabase {
+	return ProvisionedDatabase{
+		CreatedAt:   row.CreatedAt,
+		Module:      row.Module,
+		Name:        row.Name,
+		Provisioner: row.Provisioner,
+		Resource:    row.Resource,
+	}
+}
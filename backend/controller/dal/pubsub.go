@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/alecthomas/types/optional"
+
 	"github.com/TBD54566975/ftl/backend/controller/sql"
 	"github.com/TBD54566975/ftl/backend/schema"
 	"github.com/TBD54566975/ftl/db/dalerrs"
@@ -13,12 +15,23 @@ import (
 	"github.com/TBD54566975/ftl/internal/slices"
 )
 
-func (d *DAL) PublishEventForTopic(ctx context.Context, module, topic string, payload []byte) error {
+// PublishEventForTopic publishes an event to a topic.
+//
+// [requestKey] is the request that published the event, if any.
+// Events sharing an [orderingKey] are processed one at a time, across all
+// subscriptions.
+func (d *DAL) PublishEventForTopic(ctx context.Context, module, topic string, payload []byte, requestKey optional.Option[model.RequestKey], orderingKey optional.Option[string]) error {
+	var publisher optional.Option[string]
+	if key, ok := requestKey.Get(); ok {
+		publisher = optional.Some(key.String())
+	}
 	err := d.db.PublishEventForTopic(ctx, sql.PublishEventForTopicParams{
-		Key:     model.NewTopicEventKey(module, topic),
-		Module:  module,
-		Topic:   topic,
-		Payload: payload,
+		Key:         model.NewTopicEventKey(module, topic),
+		Module:      module,
+		Topic:       topic,
+		Payload:     payload,
+		RequestKey:  publisher,
+		OrderingKey: orderingKey,
 	})
 	if err != nil {
 		return dalerrs.TranslatePGError(err)
@@ -96,6 +109,8 @@ func (d *DAL) ProgressSubscriptions(ctx context.Context, eventConsumptionDelay t
 			RemainingAttempts: subscriber.RetryAttempts,
 			Backoff:           subscriber.Backoff,
 			MaxBackoff:        subscriber.MaxBackoff,
+			ParentRequestKey:  nextCursor.RequestKey,
+			OrderingKey:       nextCursor.OrderingKey,
 		})
 		if err != nil {
 			return 0, fmt.Errorf("failed to schedule async task for subscription: %w", dalerrs.TranslatePGError(err))
@@ -0,0 +1,48 @@
+package dal
+
+import (
+	"context"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/db/dalerrs"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+// RawDeploymentSchema is the schema of a deployment as stored in the
+// database, which may not be parseable by this version of FTL.
+type RawDeploymentSchema struct {
+	Key    model.DeploymentKey
+	Module string
+	Schema []byte
+}
+
+// GetActiveDeploymentRawSchemas returns the stored schemas of every active
+// deployment, without parsing them.
+func (d *DAL) GetActiveDeploymentRawSchemas(ctx context.Context) ([]RawDeploymentSchema, error) {
+	rows, err := d.db.GetActiveDeploymentRawSchemas(ctx)
+	if err != nil {
+		return nil, dalerrs.TranslatePGError(err)
+	}
+	out := make([]RawDeploymentSchema, len(rows))
+	for i, row := range rows {
+		out[i] = RawDeploymentSchema{Key: row.Key, Module: row.ModuleName, Schema: row.Schema}
+	}
+	return out, nil
+}
+
+// QuarantineDeployment scales down a deployment whose schema can't be parsed,
+// and replaces its schema with an empty module so that it no longer breaks
+// queries returning every deployment. The original schema is kept.
+//
+// Returns false if the deployment was already quarantined.
+func (d *DAL) QuarantineDeployment(ctx context.Context, key model.DeploymentKey, module string, reason string) (bool, error) {
+	placeholder := &schema.Module{
+		Name:     module,
+		Comments: []string{"Quarantined by the controller: " + reason},
+	}
+	quarantined, err := d.db.QuarantineDeployment(ctx, reason, key, placeholder)
+	if err != nil {
+		return false, dalerrs.TranslatePGError(err)
+	}
+	return quarantined, nil
+}
@@ -0,0 +1,79 @@
+package dal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/sql"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/db/dalerrs"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+// Maximum number of calls and logs returned for a single request.
+const maxRequestTimelineEvents = 10000
+
+// RequestTimeline is everything correlated with a single request.
+type RequestTimeline struct {
+	Key        model.RequestKey
+	SourceAddr string
+	// Calls and logs made while handling the request, oldest first.
+	Events []Event
+	// Async calls started by the request, such as FSM transitions and pubsub
+	// deliveries, oldest first. Each retry is a separate async call.
+	AsyncCalls []RequestAsyncCall
+}
+
+// RequestAsyncCall is an async call started by a request.
+type RequestAsyncCall struct {
+	ID          int64
+	CreatedAt   time.Time
+	ScheduledAt time.Time
+	Verb        schema.RefKey
+	Origin      AsyncOrigin
+	State       sql.AsyncCallState
+	Error       optional.Option[string]
+}
+
+// GetRequestTimeline returns the calls, logs and async calls correlated with a request.
+//
+// Returns ErrNotFound if the request does not exist.
+func (d *DAL) GetRequestTimeline(ctx context.Context, key model.RequestKey) (*RequestTimeline, error) {
+	request, err := d.db.GetRequest(ctx, key.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get request %s: %w", key, dalerrs.TranslatePGError(err))
+	}
+	events, err := d.QueryEvents(ctx, maxRequestTimelineEvents, FilterRequests(key), FilterTypes(EventTypeCall, EventTypeLog))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get events for request %s: %w", key, err)
+	}
+	rows, err := d.db.GetAsyncCallsForRequest(ctx, key.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get async calls for request %s: %w", key, dalerrs.TranslatePGError(err))
+	}
+	asyncCalls := make([]RequestAsyncCall, 0, len(rows))
+	for _, row := range rows {
+		origin, err := ParseAsyncOrigin(row.Origin)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse origin key %q: %w", row.Origin, err)
+		}
+		asyncCalls = append(asyncCalls, RequestAsyncCall{
+			ID:          row.ID,
+			CreatedAt:   row.CreatedAt,
+			ScheduledAt: row.ScheduledAt,
+			Verb:        row.Verb,
+			Origin:      origin,
+			State:       row.State,
+			Error:       row.Error,
+		})
+	}
+	return &RequestTimeline{
+		Key:        key,
+		SourceAddr: request.SourceAddr,
+		Events:     events,
+		AsyncCalls: asyncCalls,
+	}, nil
+}
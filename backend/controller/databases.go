@@ -0,0 +1,61 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/db/dalerrs"
+	"github.com/TBD54566975/ftl/internal/slices"
+)
+
+func (s *Service) ListProvisionedDatabases(ctx context.Context, req *connect.Request[ftlv1.ListProvisionedDatabasesRequest]) (*connect.Response[ftlv1.ListProvisionedDatabasesResponse], error) {
+	databases, err := s.dal.GetProvisionedDatabases(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get provisioned databases: %w", err)
+	}
+	return connect.NewResponse(&ftlv1.ListProvisionedDatabasesResponse{
+		Databases: slices.Map(databases, func(db dal.ProvisionedDatabase) *ftlv1.ListProvisionedDatabasesResponse_Database {
+			return &ftlv1.ListProvisionedDatabasesResponse_Database{
+				Module:      db.Module,
+				Name:        db.Name,
+				Provisioner: db.Provisioner,
+				CreatedAt:   timestamppb.New(db.CreatedAt),
+			}
+		}),
+	}), nil
+}
+
+func (s *Service) TeardownDatabase(ctx context.Context, req *connect.Request[ftlv1.TeardownDatabaseRequest]) (*connect.Response[ftlv1.TeardownDatabaseResponse], error) {
+	databases, ok := s.provisioner.Get()
+	if !ok {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("the controller has no database provisioner configured"))
+	}
+	modules, err := s.dal.GetActiveDeploymentSchemas(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get active deployments: %w", err)
+	}
+	for _, module := range modules {
+		if module.Name != req.Msg.Module {
+			continue
+		}
+		for _, decl := range module.Decls {
+			if db, ok := decl.(*schema.Database); ok && db.Name == req.Msg.Name {
+				return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("database %s.%s is still declared by an active deployment", req.Msg.Module, req.Msg.Name))
+			}
+		}
+	}
+	if err := databases.Teardown(ctx, req.Msg.Module, req.Msg.Name); err != nil {
+		if errors.Is(err, dalerrs.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, err
+	}
+	return connect.NewResponse(&ftlv1.TeardownDatabaseResponse{}), nil
+}
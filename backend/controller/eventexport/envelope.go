@@ -0,0 +1,132 @@
+package eventexport
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+// EnvelopeVersion is incremented whenever the envelope changes incompatibly.
+const EnvelopeVersion = 1
+
+// Envelope is the JSON format events are published in.
+//
+// Payload is one of [CallPayload], [LogPayload], [DeploymentCreatedPayload]
+// or [DeploymentUpdatedPayload], depending on Type.
+type Envelope struct {
+	Version       int           `json:"version"`
+	ID            int64         `json:"id"`
+	Type          dal.EventType `json:"type"`
+	Time          time.Time     `json:"time"`
+	DeploymentKey string        `json:"deployment_key"`
+	RequestKey    string        `json:"request_key,omitempty"`
+	Payload       any           `json:"payload"`
+}
+
+type CallPayload struct {
+	// Empty for calls from outside FTL, such as ingress.
+	SourceVerb string          `json:"source_verb,omitempty"`
+	DestVerb   string          `json:"dest_verb"`
+	DurationMS int64           `json:"duration_ms"`
+	Request    json.RawMessage `json:"request"`
+	Response   json.RawMessage `json:"response"`
+	Error      string          `json:"error,omitempty"`
+	Stack      string          `json:"stack,omitempty"`
+}
+
+type LogPayload struct {
+	Level      string            `json:"level"`
+	Message    string            `json:"message"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+	Error      string            `json:"error,omitempty"`
+	Stack      string            `json:"stack,omitempty"`
+}
+
+type DeploymentCreatedPayload struct {
+	Module      string `json:"module"`
+	Language    string `json:"language"`
+	MinReplicas int    `json:"min_replicas"`
+	// Key of the deployment this deployment replaced, if any.
+	Replaced string `json:"replaced,omitempty"`
+}
+
+type DeploymentUpdatedPayload struct {
+	MinReplicas     int `json:"min_replicas"`
+	PrevMinReplicas int `json:"prev_min_replicas"`
+}
+
+// NewEnvelope wraps an event in an [Envelope].
+func NewEnvelope(event dal.Event) Envelope {
+	envelope := Envelope{Version: EnvelopeVersion, ID: event.GetID()}
+	switch event := event.(type) {
+	case *dal.CallEvent:
+		envelope.Type = dal.EventTypeCall
+		envelope.Time = event.Time
+		envelope.DeploymentKey = event.DeploymentKey.String()
+		if key, ok := event.RequestKey.Get(); ok {
+			envelope.RequestKey = key.String()
+		}
+		payload := CallPayload{
+			DestVerb:   event.DestVerb.String(),
+			DurationMS: event.Duration.Milliseconds(),
+			Request:    rawJSON(event.Request),
+			Response:   rawJSON(event.Response),
+			Error:      event.Error.Default(""),
+			Stack:      event.Stack.Default(""),
+		}
+		if source, ok := event.SourceVerb.Get(); ok {
+			payload.SourceVerb = source.String()
+		}
+		envelope.Payload = payload
+
+	case *dal.LogEvent:
+		envelope.Type = dal.EventTypeLog
+		envelope.Time = event.Time
+		envelope.DeploymentKey = event.DeploymentKey.String()
+		if key, ok := event.RequestKey.Get(); ok {
+			envelope.RequestKey = key.String()
+		}
+		envelope.Payload = LogPayload{
+			Level:      log.Level(event.Level).String(),
+			Message:    event.Message,
+			Attributes: event.Attributes,
+			Error:      event.Error.Default(""),
+			Stack:      event.Stack.Default(""),
+		}
+
+	case *dal.DeploymentCreatedEvent:
+		envelope.Type = dal.EventTypeDeploymentCreated
+		envelope.Time = event.Time
+		envelope.DeploymentKey = event.DeploymentKey.String()
+		payload := DeploymentCreatedPayload{
+			Module:      event.ModuleName,
+			Language:    event.Language,
+			MinReplicas: event.MinReplicas,
+		}
+		if replaced, ok := event.ReplacedDeployment.Get(); ok {
+			payload.Replaced = replaced.String()
+		}
+		envelope.Payload = payload
+
+	case *dal.DeploymentUpdatedEvent:
+		envelope.Type = dal.EventTypeDeploymentUpdated
+		envelope.Time = event.Time
+		envelope.DeploymentKey = event.DeploymentKey.String()
+		envelope.Payload = DeploymentUpdatedPayload{
+			MinReplicas:     event.MinReplicas,
+			PrevMinReplicas: event.PrevMinReplicas,
+		}
+	}
+	return envelope
+}
+
+// rawJSON returns nil for an empty body, which json.RawMessage would otherwise
+// fail to marshal.
+func rawJSON(data []byte) json.RawMessage {
+	if len(data) == 0 {
+		return nil
+	}
+	return data
+}
@@ -0,0 +1,142 @@
+// Package eventexport publishes the events recorded by the controller to an
+// external message broker.
+package eventexport
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/alecthomas/types/optional"
+	"github.com/jpillora/backoff"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	"github.com/TBD54566975/ftl/backend/controller/scheduledtask"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+// Maximum number of events published at once.
+const batchSize = 500
+
+type Config struct {
+	URL         *url.URL `help:"Publish call, deployment and log events to NATS (nats://HOST:PORT) or to Kafka through a REST proxy (http(s)://HOST:PORT)." env:"FTL_CONTROLLER_EVENT_EXPORT_URL" placeholder:"URL"`
+	TopicPrefix string   `help:"Prefix of the NATS subjects or Kafka topics events are published to." default:"ftl" env:"FTL_CONTROLLER_EVENT_EXPORT_TOPIC_PREFIX"`
+}
+
+type DAL interface {
+	QueryEvents(ctx context.Context, limit int, filters ...dal.EventFilter) ([]dal.Event, error)
+}
+
+type Scheduler interface {
+	Singleton(retry backoff.Backoff, job scheduledtask.Job)
+}
+
+// A Message is published to a topic of a message broker.
+type Message struct {
+	Topic string
+	Key   string
+	Value []byte
+}
+
+// A Publisher publishes messages to a message broker.
+type Publisher interface {
+	// Publish messages, in order, returning once the broker has accepted all of them.
+	Publish(ctx context.Context, messages []Message) error
+}
+
+// Exporter periodically publishes new events to a message broker.
+//
+// Events are published at least once: if publishing fails or the controller
+// exporting events changes, events may be published again. Consumers can
+// deduplicate events by their ID.
+type Exporter struct {
+	dal         DAL
+	publisher   Publisher
+	topicPrefix string
+	// ID of the last event published, if any have been.
+	cursor optional.Option[int64]
+}
+
+// New creates an [Exporter] publishing to the broker at config.URL and
+// schedules it to run on a single controller.
+func New(ctx context.Context, config Config, dal DAL, scheduler Scheduler) (*Exporter, error) {
+	publisher, err := NewPublisher(config.URL)
+	if err != nil {
+		return nil, err
+	}
+	e := &Exporter{dal: dal, publisher: publisher, topicPrefix: config.TopicPrefix}
+	scheduler.Singleton(backoff.Backoff{
+		Min:    time.Second,
+		Max:    time.Second * 30,
+		Jitter: true,
+		Factor: 2,
+	}, e.export)
+	log.FromContext(ctx).Debugf("Exporting events to %s", config.URL.Redacted())
+	return e, nil
+}
+
+// NewPublisher creates a [Publisher] for the broker at "u", selected by its scheme.
+func NewPublisher(u *url.URL) (Publisher, error) {
+	switch u.Scheme {
+	case "nats":
+		return newNATSPublisher(u), nil
+	case "http", "https":
+		return newKafkaRESTPublisher(u), nil
+	default:
+		return nil, fmt.Errorf("unsupported event export URL %q, expected nats:// or http(s)://", u.Redacted())
+	}
+}
+
+func (e *Exporter) export(ctx context.Context) (time.Duration, error) {
+	cursor, ok := e.cursor.Get()
+	if !ok {
+		// Start from the most recent event rather than publishing the entire history.
+		latest, err := e.dal.QueryEvents(ctx, 1, dal.FilterDescending())
+		if err != nil {
+			return 0, fmt.Errorf("failed to get the most recent event: %w", err)
+		}
+		if len(latest) > 0 {
+			cursor = latest[0].GetID()
+		}
+		e.cursor = optional.Some(cursor)
+	}
+	events, err := e.dal.QueryEvents(ctx, batchSize, dal.FilterIDRange(cursor+1, 0))
+	if err != nil {
+		return 0, fmt.Errorf("failed to get events to export: %w", err)
+	}
+	if len(events) == 0 {
+		return time.Second, nil
+	}
+	messages := make([]Message, 0, len(events))
+	for _, event := range events {
+		message, err := e.message(event)
+		if err != nil {
+			return 0, err
+		}
+		messages = append(messages, message)
+	}
+	if err := e.publisher.Publish(ctx, messages); err != nil {
+		return 0, fmt.Errorf("failed to publish %d events: %w", len(messages), err)
+	}
+	e.cursor = optional.Some(events[len(events)-1].GetID())
+	if len(events) == batchSize {
+		// There are likely more events waiting.
+		return 0, nil
+	}
+	return time.Second, nil
+}
+
+func (e *Exporter) message(event dal.Event) (Message, error) {
+	envelope := NewEnvelope(event)
+	value, err := json.Marshal(envelope)
+	if err != nil {
+		return Message{}, fmt.Errorf("failed to marshal event %d: %w", event.GetID(), err)
+	}
+	return Message{
+		Topic: e.topicPrefix + "." + string(envelope.Type),
+		Key:   envelope.DeploymentKey,
+		Value: value,
+	}, nil
+}
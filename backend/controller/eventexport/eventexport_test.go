@@ -0,0 +1,98 @@
+package eventexport
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+// fakeDAL returns each batch of events in turn, ignoring filters.
+type fakeDAL struct {
+	batches [][]dal.Event
+}
+
+func (f *fakeDAL) QueryEvents(ctx context.Context, limit int, filters ...dal.EventFilter) ([]dal.Event, error) {
+	if len(f.batches) == 0 {
+		return nil, nil
+	}
+	batch := f.batches[0]
+	f.batches = f.batches[1:]
+	return batch, nil
+}
+
+type fakePublisher struct {
+	published []Message
+	err       error
+}
+
+func (f *fakePublisher) Publish(ctx context.Context, messages []Message) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.published = append(f.published, messages...)
+	return nil
+}
+
+func TestExport(t *testing.T) {
+	ctx := context.Background()
+	deployment := model.NewDeploymentKey("echo")
+	at := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	events := []dal.Event{
+		&dal.DeploymentUpdatedEvent{ID: 11, DeploymentKey: deployment, Time: at, MinReplicas: 2, PrevMinReplicas: 1},
+		&dal.LogEvent{ID: 12, DeploymentKey: deployment, Time: at, Level: 9, Message: "hello"},
+	}
+	db := &fakeDAL{batches: [][]dal.Event{
+		{&dal.LogEvent{ID: 10, DeploymentKey: deployment}},
+		events,
+		events,
+	}}
+	publisher := &fakePublisher{err: fmt.Errorf("unavailable")}
+	exporter := &Exporter{dal: db, publisher: publisher, topicPrefix: "ftl"}
+
+	// The cursor starts at the most recent event and does not advance if publishing fails.
+	_, err := exporter.export(ctx)
+	assert.EqualError(t, err, "failed to publish 2 events: unavailable")
+	assert.Equal(t, optional.Some[int64](10), exporter.cursor)
+
+	publisher.err = nil
+	next, err := exporter.export(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Second, next)
+	assert.Equal(t, optional.Some[int64](12), exporter.cursor)
+	assert.Equal(t, 2, len(publisher.published))
+	assert.Equal(t, "ftl.deployment_updated", publisher.published[0].Topic)
+	assert.Equal(t, "ftl.log", publisher.published[1].Topic)
+	assert.Equal(t, deployment.String(), publisher.published[1].Key)
+	assert.Equal(t, fmt.Sprintf(`{"version":1,"id":12,"type":"log","time":"2024-06-01T12:00:00Z","deployment_key":%q,"payload":{"level":"info","message":"hello"}}`, deployment),
+		string(publisher.published[1].Value))
+}
+
+func TestEnvelopeCall(t *testing.T) {
+	deployment := model.NewDeploymentKey("echo")
+	request := model.NewRequestKey(model.OriginIngress, "GET /echo")
+	at := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	envelope := NewEnvelope(&dal.CallEvent{
+		ID:            1,
+		DeploymentKey: deployment,
+		RequestKey:    optional.Some(request),
+		Time:          at,
+		DestVerb:      schema.Ref{Module: "echo", Name: "echo"},
+		Duration:      time.Millisecond * 1500,
+		Request:       []byte(`{"name":"Alice"}`),
+		Error:         optional.Some("failed"),
+	})
+	data, err := json.Marshal(envelope)
+	assert.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf(`{"version":1,"id":1,"type":"call","time":"2024-06-01T12:00:00Z","deployment_key":%q,"request_key":%q,`+
+		`"payload":{"dest_verb":"echo.echo","duration_ms":1500,"request":{"name":"Alice"},"response":null,"error":"failed"}}`, deployment, request),
+		string(data))
+}
@@ -0,0 +1,98 @@
+package eventexport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// kafkaRESTPublisher publishes messages to Kafka topics through a REST proxy
+// implementing the Confluent REST Proxy v2 API.
+//
+// See https://docs.confluent.io/platform/current/kafka-rest/api.html#records-v2
+type kafkaRESTPublisher struct {
+	url    *url.URL
+	client *http.Client
+}
+
+func newKafkaRESTPublisher(u *url.URL) *kafkaRESTPublisher {
+	return &kafkaRESTPublisher{url: u, client: http.DefaultClient}
+}
+
+type kafkaRecord struct {
+	Key   string          `json:"key"`
+	Value json.RawMessage `json:"value"`
+}
+
+type kafkaProduceRequest struct {
+	Records []kafkaRecord `json:"records"`
+}
+
+type kafkaProduceResponse struct {
+	Offsets []struct {
+		ErrorCode *int   `json:"error_code"`
+		Error     string `json:"error"`
+	} `json:"offsets"`
+}
+
+func (k *kafkaRESTPublisher) Publish(ctx context.Context, messages []Message) error {
+	// Each request produces to a single topic, so group consecutive messages
+	// for the same topic while preserving their order.
+	for len(messages) > 0 {
+		topic := messages[0].Topic
+		end := 1
+		for end < len(messages) && messages[end].Topic == topic {
+			end++
+		}
+		if err := k.produce(ctx, topic, messages[:end]); err != nil {
+			return err
+		}
+		messages = messages[end:]
+	}
+	return nil
+}
+
+func (k *kafkaRESTPublisher) produce(ctx context.Context, topic string, messages []Message) error {
+	request := kafkaProduceRequest{Records: make([]kafkaRecord, 0, len(messages))}
+	for _, message := range messages {
+		request.Records = append(request.Records, kafkaRecord{Key: message.Key, Value: message.Value})
+	}
+	body, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+	endpoint := k.url.JoinPath("topics", topic)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.String(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/vnd.kafka.json.v2+json")
+	req.Header.Set("Accept", "application/vnd.kafka.v2+json")
+	resp, err := k.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to produce to Kafka topic %s: %w", topic, err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response producing to Kafka topic %s: %w", topic, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to produce to Kafka topic %s: %s: %s", topic, resp.Status, strings.TrimSpace(string(data)))
+	}
+	var response kafkaProduceResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return fmt.Errorf("invalid response producing to Kafka topic %s: %w", topic, err)
+	}
+	for _, offset := range response.Offsets {
+		if offset.ErrorCode != nil {
+			return fmt.Errorf("failed to produce to Kafka topic %s: %s (error code %d)", topic, offset.Error, *offset.ErrorCode)
+		}
+	}
+	return nil
+}
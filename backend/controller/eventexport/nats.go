@@ -0,0 +1,124 @@
+package eventexport
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// natsPublisher publishes messages to NATS core subjects using the NATS client
+// protocol.
+//
+// See https://docs.nats.io/reference/reference-protocols/nats-protocol
+type natsPublisher struct {
+	url *url.URL
+
+	lock   sync.Mutex
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+func newNATSPublisher(u *url.URL) *natsPublisher {
+	return &natsPublisher{url: u}
+}
+
+func (n *natsPublisher) Publish(ctx context.Context, messages []Message) (err error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	if n.conn == nil {
+		if err := n.connect(ctx); err != nil {
+			return err
+		}
+	}
+	defer func() {
+		// Reconnect on the next publish as the connection is in an unknown state.
+		if err != nil {
+			_ = n.conn.Close()
+			n.conn = nil
+		}
+	}()
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = n.conn.SetDeadline(deadline)
+	} else {
+		_ = n.conn.SetDeadline(time.Now().Add(time.Second * 30))
+	}
+	w := bufio.NewWriter(n.conn)
+	for _, message := range messages {
+		// NATS has no message keys, so Message.Key is unused.
+		fmt.Fprintf(w, "PUB %s %d\r\n", message.Topic, len(message.Value))
+		_, _ = w.Write(message.Value)
+		_, _ = w.WriteString("\r\n")
+	}
+	// The server responds to PING once it has processed everything before it.
+	_, _ = w.WriteString("PING\r\n")
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("failed to publish to NATS: %w", err)
+	}
+	return n.awaitPong()
+}
+
+func (n *natsPublisher) connect(ctx context.Context) error {
+	conn, err := (&net.Dialer{Timeout: time.Second * 10}).DialContext(ctx, "tcp", n.url.Host)
+	if err != nil {
+		return fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+	_ = conn.SetDeadline(time.Now().Add(time.Second * 10))
+	reader := bufio.NewReader(conn)
+	line, err := reader.ReadString('\n')
+	if err != nil || !strings.HasPrefix(line, "INFO ") {
+		_ = conn.Close()
+		return fmt.Errorf("expected INFO from NATS server, got %q: %w", line, err)
+	}
+	options := map[string]any{
+		"verbose":  false,
+		"pedantic": false,
+		"name":     "ftl-controller",
+		"lang":     "go",
+	}
+	if user := n.url.User; user != nil {
+		options["user"] = user.Username()
+		if password, ok := user.Password(); ok {
+			options["pass"] = password
+		}
+	}
+	connect, err := json.Marshal(options)
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+	if _, err := fmt.Fprintf(conn, "CONNECT %s\r\n", connect); err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+	n.conn = conn
+	n.reader = reader
+	return nil
+}
+
+func (n *natsPublisher) awaitPong() error {
+	for {
+		line, err := n.reader.ReadString('\n')
+		if err != nil {
+			return fmt.Errorf("failed to read from NATS: %w", err)
+		}
+		line = strings.TrimRight(line, "\r\n")
+		switch {
+		case line == "PONG":
+			return nil
+		case line == "PING":
+			if _, err := n.conn.Write([]byte("PONG\r\n")); err != nil {
+				return fmt.Errorf("failed to write to NATS: %w", err)
+			}
+		case strings.HasPrefix(line, "-ERR"):
+			return fmt.Errorf("NATS error: %s", strings.TrimSpace(strings.TrimPrefix(line, "-ERR")))
+		default:
+			// +OK and INFO updates need no response.
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package eventexport
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+)
+
+func TestNATSPublisher(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	received := make(chan string, 10)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_, _ = conn.Write([]byte(`INFO {"server_id":"test"}` + "\r\n"))
+		reader := bufio.NewReader(conn)
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			line = strings.TrimRight(line, "\r\n")
+			switch {
+			case strings.HasPrefix(line, "CONNECT "):
+				received <- line
+			case strings.HasPrefix(line, "PUB "):
+				fields := strings.Fields(line)
+				size, _ := strconv.Atoi(fields[2]) //nolint:errcheck
+				payload := make([]byte, size+2)
+				if _, err := io.ReadFull(reader, payload); err != nil {
+					return
+				}
+				received <- fields[1] + " " + string(payload[:size])
+			case line == "PING":
+				_, _ = conn.Write([]byte("PONG\r\n"))
+			}
+		}
+	}()
+
+	u, err := url.Parse("nats://ftl:secret@" + listener.Addr().String())
+	assert.NoError(t, err)
+	publisher, err := NewPublisher(u)
+	assert.NoError(t, err)
+	err = publisher.Publish(context.Background(), []Message{
+		{Topic: "ftl.call", Key: "a", Value: []byte(`{"id":1}`)},
+		{Topic: "ftl.log", Key: "a", Value: []byte(`{"id":2}`)},
+	})
+	assert.NoError(t, err)
+
+	var connect map[string]any
+	assert.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(<-received, "CONNECT ")), &connect))
+	assert.Equal(t, "ftl", connect["user"])
+	assert.Equal(t, "secret", connect["pass"])
+	assert.Equal(t, `ftl.call {"id":1}`, <-received)
+	assert.Equal(t, `ftl.log {"id":2}`, <-received)
+}
+
+func TestKafkaRESTPublisher(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body) //nolint:errcheck
+		requests = append(requests, fmt.Sprintf("%s %s %s", r.URL.Path, r.Header.Get("Content-Type"), body))
+		if strings.HasSuffix(r.URL.Path, "/ftl.log") {
+			fmt.Fprint(w, `{"offsets":[{"partition":null,"offset":null,"error_code":40403,"error":"topic not found"}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"offsets":[{"partition":0,"offset":1},{"partition":0,"offset":2}]}`)
+	}))
+	t.Cleanup(server.Close)
+
+	u, err := url.Parse(server.URL + "/kafka")
+	assert.NoError(t, err)
+	publisher, err := NewPublisher(u)
+	assert.NoError(t, err)
+
+	err = publisher.Publish(context.Background(), []Message{
+		{Topic: "ftl.call", Key: "a", Value: []byte(`{"id":1}`)},
+		{Topic: "ftl.call", Key: "b", Value: []byte(`{"id":2}`)},
+		{Topic: "ftl.log", Key: "a", Value: []byte(`{"id":3}`)},
+	})
+	assert.EqualError(t, err, "failed to produce to Kafka topic ftl.log: topic not found (error code 40403)")
+	assert.Equal(t, []string{
+		`/kafka/topics/ftl.call application/vnd.kafka.json.v2+json {"records":[{"key":"a","value":{"id":1}},{"key":"b","value":{"id":2}}]}`,
+		`/kafka/topics/ftl.log application/vnd.kafka.json.v2+json {"records":[{"key":"a","value":{"id":3}}]}`,
+	}, requests)
+}
+
+func TestNewPublisherUnsupportedScheme(t *testing.T) {
+	u, err := url.Parse("kafka://localhost:9092")
+	assert.NoError(t, err)
+	_, err = NewPublisher(u)
+	assert.EqualError(t, err, `unsupported event export URL "kafka://localhost:9092", expected nats:// or http(s)://`)
+}
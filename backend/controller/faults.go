@@ -0,0 +1,223 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/types/optional"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/rpc"
+	"github.com/TBD54566975/ftl/internal/slices"
+)
+
+// Faults are intended for game days and integration tests, so they can't
+// outlive a working day and be forgotten about.
+const maxFaultDuration = time.Hour * 24
+
+// Injected latency is limited so that delayed calls don't outlive the leases
+// of async calls.
+const maxFaultLatency = time.Minute
+
+var faultKindFromProto = map[ftlv1.Fault_Kind]dal.FaultKind{
+	ftlv1.Fault_KIND_LATENCY:          dal.FaultLatency,
+	ftlv1.Fault_KIND_ERROR:            dal.FaultError,
+	ftlv1.Fault_KIND_DROP_ASYNC:       dal.FaultDropAsync,
+	ftlv1.Fault_KIND_DELAY_SCHEDULING: dal.FaultDelayScheduling,
+}
+
+func (s *Service) InjectFault(ctx context.Context, req *connect.Request[ftlv1.InjectFaultRequest]) (*connect.Response[ftlv1.InjectFaultResponse], error) {
+	if err := s.checkFaultInjection(ctx); err != nil {
+		return nil, err
+	}
+	fault := req.Msg.Fault
+	if fault == nil || fault.Module == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("fault module is required"))
+	}
+	kind, ok := faultKindFromProto[fault.Kind]
+	if !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid fault kind %s", fault.Kind))
+	}
+	if fault.Percent <= 0 || fault.Percent > 100 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("fault percent must be between 0 and 100, not %g", fault.Percent))
+	}
+	latency := fault.Latency.AsDuration()
+	if kind == dal.FaultLatency || kind == dal.FaultDelayScheduling {
+		if latency <= 0 || latency > maxFaultLatency {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("fault latency must be between 0 and %s, not %s", maxFaultLatency, latency))
+		}
+	}
+	duration := req.Msg.Duration.AsDuration()
+	if duration <= 0 || duration > maxFaultDuration {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("faults must last between 0 and %s, not %s", maxFaultDuration, duration))
+	}
+	message := fault.GetMessage()
+	if message == "" {
+		message = "fault injected by FTL"
+	}
+	expiresAt := time.Now().Add(duration)
+	id, err := s.dal.CreateFault(ctx, dal.Fault{
+		Kind:      kind,
+		Module:    fault.Module,
+		Verb:      optional.Ptr(fault.Verb),
+		Percent:   fault.Percent,
+		Latency:   latency,
+		Message:   message,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not inject fault: %w", err)
+	}
+	// Other controllers pick the fault up when they next synchronise.
+	if _, err := s.syncFaults(ctx); err != nil {
+		return nil, err
+	}
+	target := fault.Module
+	if fault.Verb != nil {
+		target = fault.Module + "." + *fault.Verb
+	}
+	log.FromContext(ctx).Warnf("Injecting %s fault %d into %g%% of calls to %s for %s", kind, id, fault.Percent, target, duration)
+	return connect.NewResponse(&ftlv1.InjectFaultResponse{Id: id, ExpiresAt: timestamppb.New(expiresAt)}), nil
+}
+
+func (s *Service) ListFaults(ctx context.Context, req *connect.Request[ftlv1.ListFaultsRequest]) (*connect.Response[ftlv1.ListFaultsResponse], error) {
+	if err := s.checkFaultInjection(ctx); err != nil {
+		return nil, err
+	}
+	faults, err := s.dal.GetActiveFaults(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not list faults: %w", err)
+	}
+	return connect.NewResponse(&ftlv1.ListFaultsResponse{
+		Faults: slices.Map(faults, faultToProto),
+	}), nil
+}
+
+func (s *Service) ClearFaults(ctx context.Context, req *connect.Request[ftlv1.ClearFaultsRequest]) (*connect.Response[ftlv1.ClearFaultsResponse], error) {
+	if err := s.checkFaultInjection(ctx); err != nil {
+		return nil, err
+	}
+	cleared, err := s.dal.DeleteFaults(ctx, optional.Ptr(req.Msg.Id))
+	if err != nil {
+		return nil, fmt.Errorf("could not clear faults: %w", err)
+	}
+	if _, err := s.syncFaults(ctx); err != nil {
+		return nil, err
+	}
+	log.FromContext(ctx).Infof("Cleared %d injected faults", cleared)
+	return connect.NewResponse(&ftlv1.ClearFaultsResponse{Cleared: int64(cleared)}), nil
+}
+
+// checkFaultInjection returns an error if faults can't be injected by
+// requests made with "ctx".
+func (s *Service) checkFaultInjection(ctx context.Context) error {
+	if !s.config.Load().FaultInjection {
+		return connect.NewError(connect.CodeFailedPrecondition, errors.New("fault injection is not enabled, start the controller with --fault-injection"))
+	}
+	// Faults affect every project, so only admins can inject them.
+	if project, ok := rpc.ProjectFromContext(ctx).Get(); ok {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("fault injection is not available to requests scoped to project %q", project))
+	}
+	return nil
+}
+
+func faultToProto(fault dal.Fault) *ftlv1.Fault {
+	out := &ftlv1.Fault{
+		Id:        fault.ID,
+		Module:    fault.Module,
+		Verb:      fault.Verb.Ptr(),
+		Percent:   fault.Percent,
+		Message:   &fault.Message,
+		ExpiresAt: timestamppb.New(fault.ExpiresAt),
+	}
+	for kind, dalKind := range faultKindFromProto {
+		if dalKind == fault.Kind {
+			out.Kind = kind
+		}
+	}
+	if fault.Latency > 0 {
+		out.Latency = durationpb.New(fault.Latency)
+	}
+	return out
+}
+
+// syncFaults synchronises the faults injected into calls from the database.
+func (s *Service) syncFaults(ctx context.Context) (time.Duration, error) {
+	faults, err := s.dal.GetActiveFaults(ctx)
+	if err != nil {
+		return 0, err
+	}
+	s.faults.Store(faults)
+	return time.Second, nil
+}
+
+// injectedFaults returns the faults of "kind" to inject into a call to "verb",
+// each chosen with the probability of its percentage.
+func (s *Service) injectedFaults(verb *schema.Ref, kind dal.FaultKind) []dal.Fault {
+	var out []dal.Fault
+	now := time.Now()
+	for _, fault := range s.faults.Load() {
+		if fault.Kind != kind || fault.Module != verb.Module || now.After(fault.ExpiresAt) {
+			continue
+		}
+		if name, ok := fault.Verb.Get(); ok && name != verb.Name {
+			continue
+		}
+		if rand.Float64()*100 < fault.Percent { //nolint:gosec
+			out = append(out, fault)
+		}
+	}
+	return out
+}
+
+// injectCallFaults delays or fails a call to "verb" if latency or error
+// faults are injected into it.
+func (s *Service) injectCallFaults(ctx context.Context, verb *schema.Ref) error {
+	logger := log.FromContext(ctx)
+	for _, fault := range s.injectedFaults(verb, dal.FaultLatency) {
+		logger.Debugf("Injecting %s of latency into call to %s (fault %d)", fault.Latency, verb, fault.ID)
+		if err := sleepContext(ctx, fault.Latency); err != nil {
+			return err
+		}
+	}
+	for _, fault := range s.injectedFaults(verb, dal.FaultError) {
+		logger.Debugf("Injecting error into call to %s (fault %d)", verb, fault.ID)
+		return connect.NewError(connect.CodeUnavailable, errors.New(fault.Message))
+	}
+	return nil
+}
+
+// injectAsyncFaults delays or drops an async call to "verb" if scheduling or
+// drop faults are injected into it.
+func (s *Service) injectAsyncFaults(ctx context.Context, verb *schema.Ref) error {
+	logger := log.FromContext(ctx)
+	for _, fault := range s.injectedFaults(verb, dal.FaultDelayScheduling) {
+		logger.Debugf("Delaying async call to %s by %s (fault %d)", verb, fault.Latency, fault.ID)
+		if err := sleepContext(ctx, fault.Latency); err != nil {
+			return err
+		}
+	}
+	for _, fault := range s.injectedFaults(verb, dal.FaultDropAsync) {
+		logger.Debugf("Dropping async call to %s (fault %d)", verb, fault.ID)
+		return errors.New(fault.Message)
+	}
+	return nil
+}
+
+func sleepContext(ctx context.Context, duration time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(duration):
+		return nil
+	}
+}
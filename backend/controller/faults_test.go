@@ -0,0 +1,56 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/rpc"
+)
+
+func TestInjectedFaults(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour)
+	s := &Service{}
+	s.faults.Store([]dal.Fault{
+		{ID: 1, Kind: dal.FaultError, Module: "echo", Percent: 100, Message: "boom", ExpiresAt: expiresAt},
+		{ID: 2, Kind: dal.FaultError, Module: "time", Verb: optional.Some("time"), Percent: 100, ExpiresAt: expiresAt},
+		{ID: 3, Kind: dal.FaultLatency, Module: "echo", Percent: 100, Latency: time.Millisecond, ExpiresAt: expiresAt},
+		{ID: 4, Kind: dal.FaultError, Module: "time", Percent: 100, ExpiresAt: time.Now().Add(-time.Second)},
+		{ID: 5, Kind: dal.FaultError, Module: "echo", Percent: 0.0000001, ExpiresAt: expiresAt},
+	})
+	ids := func(faults []dal.Fault) []int64 {
+		var out []int64
+		for _, fault := range faults {
+			out = append(out, fault.ID)
+		}
+		return out
+	}
+
+	assert.Equal(t, []int64{1}, ids(s.injectedFaults(&schema.Ref{Module: "echo", Name: "echo"}, dal.FaultError)))
+	assert.Equal(t, []int64{3}, ids(s.injectedFaults(&schema.Ref{Module: "echo", Name: "echo"}, dal.FaultLatency)))
+	assert.Equal(t, []int64{2}, ids(s.injectedFaults(&schema.Ref{Module: "time", Name: "time"}, dal.FaultError)))
+	assert.Equal(t, nil, ids(s.injectedFaults(&schema.Ref{Module: "time", Name: "other"}, dal.FaultError)))
+
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	err := s.injectCallFaults(ctx, &schema.Ref{Module: "echo", Name: "echo"})
+	assert.Equal(t, connect.CodeUnavailable, connect.CodeOf(err))
+	assert.Contains(t, err.Error(), "boom")
+	assert.NoError(t, s.injectCallFaults(ctx, &schema.Ref{Module: "other", Name: "echo"}))
+}
+
+func TestCheckFaultInjection(t *testing.T) {
+	ctx := context.Background()
+	s := &Service{}
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(s.checkFaultInjection(ctx)))
+
+	s.config.Store(Config{FaultInjection: true})
+	assert.NoError(t, s.checkFaultInjection(ctx))
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(s.checkFaultInjection(rpc.WithProject(ctx, "payments"))))
+}
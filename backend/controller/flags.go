@@ -0,0 +1,89 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/types/optional"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	cfsql "github.com/TBD54566975/ftl/common/configuration/sql"
+	"github.com/TBD54566975/ftl/db/dalerrs"
+	"github.com/TBD54566975/ftl/internal/featureflags"
+	"github.com/TBD54566975/ftl/internal/slices"
+)
+
+func (s *Service) ListFeatureFlags(ctx context.Context, req *connect.Request[ftlv1.ListFeatureFlagsRequest]) (*connect.Response[ftlv1.ListFeatureFlagsResponse], error) {
+	flags, err := s.configDAL.ListModuleFeatureFlags(ctx, optional.Ptr(req.Msg.Module))
+	if err != nil {
+		return nil, fmt.Errorf("could not list feature flags: %w", err)
+	}
+	inScope, err := s.moduleScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	flags = slices.Filter(flags, func(flag cfsql.ModuleFeatureFlag) bool { return inScope(flag.Module) })
+	return connect.NewResponse(&ftlv1.ListFeatureFlagsResponse{
+		Flags: slices.Map(flags, func(flag cfsql.ModuleFeatureFlag) *ftlv1.FeatureFlag {
+			return &ftlv1.FeatureFlag{Module: flag.Module, Name: flag.Name, Rules: flag.Rules}
+		}),
+	}), nil
+}
+
+func (s *Service) SetFeatureFlag(ctx context.Context, req *connect.Request[ftlv1.SetFeatureFlagRequest]) (*connect.Response[ftlv1.SetFeatureFlagResponse], error) {
+	flag := req.Msg.Flag
+	if flag == nil || flag.Module == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("flag module is required"))
+	}
+	if err := s.checkModuleScope(ctx, flag.Module); err != nil {
+		return nil, err
+	}
+	if err := featureflags.ValidateName(flag.Name); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	var rules featureflags.Flag
+	if err := json.Unmarshal(flag.Rules, &rules); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid rules for flag %q: %w", flag.Name, err))
+	}
+	if err := rules.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	// Store the rules as normalised by the featureflags package.
+	data, err := json.Marshal(rules)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.configDAL.SetModuleFeatureFlag(ctx, flag.Module, flag.Name, data); err != nil {
+		return nil, fmt.Errorf("could not set feature flag: %w", err)
+	}
+	return connect.NewResponse(&ftlv1.SetFeatureFlagResponse{}), nil
+}
+
+func (s *Service) UnsetFeatureFlag(ctx context.Context, req *connect.Request[ftlv1.UnsetFeatureFlagRequest]) (*connect.Response[ftlv1.UnsetFeatureFlagResponse], error) {
+	if err := s.checkModuleScope(ctx, req.Msg.Module); err != nil {
+		return nil, err
+	}
+	err := s.configDAL.UnsetModuleFeatureFlag(ctx, req.Msg.Module, req.Msg.Name)
+	if errors.Is(err, dalerrs.ErrNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no flag %q in module %q", req.Msg.Name, req.Msg.Module))
+	} else if err != nil {
+		return nil, fmt.Errorf("could not unset feature flag: %w", err)
+	}
+	return connect.NewResponse(&ftlv1.UnsetFeatureFlagResponse{}), nil
+}
+
+// moduleFeatureFlags returns the JSON encoded rules of each feature flag of "module".
+func (s *Service) moduleFeatureFlags(ctx context.Context, module string) (map[string][]byte, error) {
+	flags, err := s.configDAL.ListModuleFeatureFlags(ctx, optional.Some(module))
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string][]byte, len(flags))
+	for _, flag := range flags {
+		out[flag.Name] = flag.Rules
+	}
+	return out, nil
+}
@@ -0,0 +1,168 @@
+package ingress
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/alecthomas/types/optional"
+)
+
+// Where ingress access logs are written.
+const (
+	AccessLogNone       = "none"
+	AccessLogStdout     = "stdout"
+	AccessLogDeployment = "deployment"
+)
+
+type AccessLogConfig struct {
+	Output     string  `help:"Where to log ingress requests: none, stdout, or deployment to store them with the logs of the deployment serving each request." enum:"none,stdout,deployment" default:"none" env:"FTL_CONTROLLER_INGRESS_ACCESS_LOG_OUTPUT"`
+	Format     string  `help:"Format of ingress access logs written to stdout: json or text." enum:"json,text" default:"json" env:"FTL_CONTROLLER_INGRESS_ACCESS_LOG_FORMAT"`
+	SampleRate float64 `help:"Fraction of ingress requests to log, between 0 and 1. Requests failing with a 5xx status are always logged." default:"1" env:"FTL_CONTROLLER_INGRESS_ACCESS_LOG_SAMPLE_RATE"`
+}
+
+func (c AccessLogConfig) Validate() error {
+	if c.SampleRate < 0 || c.SampleRate > 1 {
+		return fmt.Errorf("ingress access log sample rate must be between 0 and 1, got %v", c.SampleRate)
+	}
+	return nil
+}
+
+// Sampled returns true if a request that completed with "status" should be
+// logged, given "roll", a random number in [0, 1).
+func (c AccessLogConfig) Sampled(status int, roll float64) bool {
+	if c.Output == "" || c.Output == AccessLogNone {
+		return false
+	}
+	return status >= 500 || roll < c.SampleRate
+}
+
+// AccessLogEntry records an ingress request.
+type AccessLogEntry struct {
+	Time   time.Time `json:"time"`
+	Method string    `json:"method"`
+	Path   string    `json:"path"`
+	// Route pattern the request matched, if any.
+	Route      string `json:"route,omitempty"`
+	Module     string `json:"module,omitempty"`
+	Verb       string `json:"verb,omitempty"`
+	Deployment string `json:"deployment,omitempty"`
+	RequestKey string `json:"request_key,omitempty"`
+	Status     int    `json:"status"`
+	// Latency from the request being received to its response being written,
+	// encoded in milliseconds.
+	Latency       time.Duration `json:"-"`
+	RequestBytes  int64         `json:"request_bytes"`
+	ResponseBytes int64         `json:"response_bytes"`
+	// Subject of the verified bearer token of the request, if any.
+	Principal  string `json:"principal,omitempty"`
+	RemoteAddr string `json:"remote_addr,omitempty"`
+}
+
+// MarshalJSON encodes the latency in milliseconds, as access logs usually are.
+func (e AccessLogEntry) MarshalJSON() ([]byte, error) {
+	type entry AccessLogEntry
+	return json.Marshal(struct {
+		entry
+		Latency float64 `json:"latency_ms"`
+	}{entry: entry(e), Latency: float64(e.Latency.Microseconds()) / 1000})
+}
+
+// String formats the entry as a single line of text.
+func (e AccessLogEntry) String() string {
+	out := &strings.Builder{}
+	fmt.Fprintf(out, "%s %s %d %s %dB/%dB", e.Method, e.Path, e.Status, e.Latency.Round(time.Microsecond), e.RequestBytes, e.ResponseBytes)
+	if e.Verb != "" {
+		fmt.Fprintf(out, " verb=%s.%s", e.Module, e.Verb)
+	}
+	if e.Principal != "" {
+		fmt.Fprintf(out, " principal=%s", e.Principal)
+	}
+	if e.RequestKey != "" {
+		fmt.Fprintf(out, " request=%s", e.RequestKey)
+	}
+	return out.String()
+}
+
+// WriteAccessLog writes "entry" to "w" as a line in "format".
+func WriteAccessLog(w io.Writer, format string, entry AccessLogEntry) error {
+	if format == "text" {
+		_, err := fmt.Fprintln(w, entry.String())
+		return err
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(data, '\n'))
+	return err
+}
+
+// PrincipalSubject returns the "sub" claim of a principal, if any.
+func PrincipalSubject(principal optional.Option[json.RawMessage]) string {
+	claims, ok := principal.Get()
+	if !ok {
+		return ""
+	}
+	var subject struct {
+		Sub string `json:"sub"`
+	}
+	_ = json.Unmarshal(claims, &subject) //nolint:errcheck
+	return subject.Sub
+}
+
+// AccessRecorder wraps a [http.ResponseWriter] and the body of a request,
+// counting the bytes read and written and recording the response status.
+type AccessRecorder struct {
+	http.ResponseWriter
+	body          *countingReader
+	status        int
+	responseBytes int64
+}
+
+func NewAccessRecorder(w http.ResponseWriter, r *http.Request) *AccessRecorder {
+	body := &countingReader{ReadCloser: r.Body}
+	r.Body = body
+	return &AccessRecorder{ResponseWriter: w, body: body}
+}
+
+func (a *AccessRecorder) WriteHeader(status int) {
+	if a.status == 0 {
+		a.status = status
+	}
+	a.ResponseWriter.WriteHeader(status)
+}
+
+func (a *AccessRecorder) Write(data []byte) (int, error) {
+	if a.status == 0 {
+		a.status = http.StatusOK
+	}
+	n, err := a.ResponseWriter.Write(data)
+	a.responseBytes += int64(n)
+	return n, err
+}
+
+// Status of the response, which is 200 if nothing was written.
+func (a *AccessRecorder) Status() int {
+	if a.status == 0 {
+		return http.StatusOK
+	}
+	return a.status
+}
+
+func (a *AccessRecorder) RequestBytes() int64  { return a.body.n }
+func (a *AccessRecorder) ResponseBytes() int64 { return a.responseBytes }
+
+type countingReader struct {
+	io.ReadCloser
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.ReadCloser.Read(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,70 @@
+package ingress
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+)
+
+func TestAccessLogSampling(t *testing.T) {
+	assert.False(t, AccessLogConfig{Output: AccessLogNone, SampleRate: 1}.Sampled(http.StatusInternalServerError, 0))
+	sampled := AccessLogConfig{Output: AccessLogStdout, SampleRate: 0.1}
+	assert.True(t, sampled.Sampled(http.StatusOK, 0.05))
+	assert.False(t, sampled.Sampled(http.StatusOK, 0.5))
+	assert.False(t, sampled.Sampled(http.StatusNotFound, 0.5))
+	assert.True(t, sampled.Sampled(http.StatusBadGateway, 0.5), "server errors should always be logged")
+	assert.Error(t, AccessLogConfig{SampleRate: 1.5}.Validate())
+}
+
+func TestWriteAccessLog(t *testing.T) {
+	entry := AccessLogEntry{
+		Time:          time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Method:        "POST",
+		Path:          "/users/123",
+		Route:         "/users/{id}",
+		Module:        "users",
+		Verb:          "update",
+		Status:        200,
+		Latency:       time.Millisecond * 1500,
+		RequestBytes:  12,
+		ResponseBytes: 34,
+		Principal:     "alice",
+	}
+	out := &bytes.Buffer{}
+	assert.NoError(t, WriteAccessLog(out, "json", entry))
+	var decoded map[string]any
+	assert.NoError(t, json.Unmarshal(out.Bytes(), &decoded))
+	assert.Equal(t, 1500.0, decoded["latency_ms"])
+	assert.Equal(t, "/users/{id}", decoded["route"])
+	assert.Equal(t, "alice", decoded["principal"])
+	assert.False(t, strings.Contains(out.String(), "Latency"))
+
+	out.Reset()
+	assert.NoError(t, WriteAccessLog(out, "text", entry))
+	assert.Equal(t, "POST /users/123 200 1.5s 12B/34B verb=users.update principal=alice\n", out.String())
+}
+
+func TestAccessRecorder(t *testing.T) {
+	r := httptest.NewRequest("POST", "/echo", strings.NewReader("hello"))
+	w := httptest.NewRecorder()
+	recorder := NewAccessRecorder(w, r)
+	_, err := io.ReadAll(r.Body)
+	assert.NoError(t, err)
+	http.Error(recorder, "not found", http.StatusNotFound)
+	assert.Equal(t, http.StatusNotFound, recorder.Status())
+	assert.Equal(t, int64(5), recorder.RequestBytes())
+	assert.Equal(t, int64(len("not found\n")), recorder.ResponseBytes())
+}
+
+func TestPrincipalSubject(t *testing.T) {
+	assert.Equal(t, "alice", PrincipalSubject(optional.Some(json.RawMessage(`{"sub":"alice","admin":true}`))))
+	assert.Equal(t, "", PrincipalSubject(optional.None[json.RawMessage]()))
+}
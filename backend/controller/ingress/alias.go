@@ -98,7 +98,7 @@ func transformAliasedFields(sch *schema.Schema, t schema.Type, obj any, aliaser
 		return transformAliasedFields(sch, t.Type, obj, aliaser)
 
 	case *schema.Any, *schema.Bool, *schema.Bytes, *schema.Float, *schema.Int,
-		*schema.String, *schema.Time, *schema.Unit:
+		*schema.String, *schema.Time, *schema.Timestamp, *schema.UUID, *schema.Decimal, *schema.Unit:
 	}
 	return nil
 }
@@ -0,0 +1,146 @@
+package ingress
+
+import (
+	"encoding/base64"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+// Compiled patterns of "+validate pattern" constraints.
+var patterns sync.Map
+
+// validateConstraints checks the value of "field" in "request" against the
+// field's validation constraints.
+//
+// "value" must already have been validated against the type of the field.
+func validateConstraints(field *schema.Field, path path, value any, request map[string]any) error {
+	for _, md := range field.Metadata {
+		md, ok := md.(*schema.MetadataValidate)
+		if !ok {
+			continue
+		}
+		if md.Kind == schema.ConstraintRequiredIf {
+			if value == nil && request[md.Value] != nil {
+				return fmt.Errorf("%s is required when %s is set", path, md.Value)
+			}
+			continue
+		}
+		if value == nil {
+			continue
+		}
+		if err := validateConstraint(field.Type, md, value); err != nil {
+			return fmt.Errorf("%s %w", path, err)
+		}
+	}
+	return nil
+}
+
+func validateConstraint(fieldType schema.Type, md *schema.MetadataValidate, value any) error {
+	if opt, ok := fieldType.(*schema.Optional); ok {
+		fieldType = opt.Type
+	}
+	switch md.Kind {
+	case schema.ConstraintMin, schema.ConstraintMax:
+		limit, err := md.Number()
+		if err != nil {
+			return err
+		}
+		number, err := constraintNumber(value)
+		if err != nil {
+			return err
+		}
+		if md.Kind == schema.ConstraintMin && number < limit {
+			return fmt.Errorf("must be at least %s", md.Value)
+		}
+		if md.Kind == schema.ConstraintMax && number > limit {
+			return fmt.Errorf("must be at most %s", md.Value)
+		}
+
+	case schema.ConstraintMinLen, schema.ConstraintMaxLen:
+		limit, err := md.Length()
+		if err != nil {
+			return err
+		}
+		length, err := constraintLength(fieldType, value)
+		if err != nil {
+			return err
+		}
+		if md.Kind == schema.ConstraintMinLen && length < limit {
+			return fmt.Errorf("must have a length of at least %d", limit)
+		}
+		if md.Kind == schema.ConstraintMaxLen && length > limit {
+			return fmt.Errorf("must have a length of at most %d", limit)
+		}
+
+	case schema.ConstraintPattern:
+		str, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("must be a string to match a pattern")
+		}
+		pattern, err := compilePattern(md.Value)
+		if err != nil {
+			return err
+		}
+		if !pattern.MatchString(str) {
+			return fmt.Errorf("must match the pattern %q", md.Value)
+		}
+
+	case schema.ConstraintRequiredIf:
+	}
+	return nil
+}
+
+func constraintNumber(value any) (float64, error) {
+	switch value := value.(type) {
+	case float64:
+		return value, nil
+	case int64:
+		return float64(value), nil
+	case string:
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return 0, fmt.Errorf("must be a number: %w", err)
+		}
+		return number, nil
+	default:
+		return 0, fmt.Errorf("must be a number, found %T", value)
+	}
+}
+
+func constraintLength(fieldType schema.Type, value any) (int, error) {
+	if str, ok := value.(string); ok {
+		if _, ok := fieldType.(*schema.Bytes); ok {
+			bytes, err := base64.StdEncoding.DecodeString(str)
+			if err != nil {
+				return 0, fmt.Errorf("is not a valid base64 string")
+			}
+			return len(bytes), nil
+		}
+		return utf8.RuneCountInString(str), nil
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Map:
+		return rv.Len(), nil
+	default:
+		return 0, fmt.Errorf("has no length, found %T", value)
+	}
+}
+
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	if compiled, ok := patterns.Load(pattern); ok {
+		return compiled.(*regexp.Regexp), nil //nolint:forcetypeassert
+	}
+	compiled, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("has an invalid pattern %q: %w", pattern, err)
+	}
+	patterns.Store(pattern, compiled)
+	return compiled, nil
+}
@@ -0,0 +1,53 @@
+package ingress
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+)
+
+// ETag returns a strong entity tag for a response body.
+func ETag(body []byte) string {
+	sum := sha256.Sum256(body)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// MatchesIfNoneMatch returns true if "etag" matches any of the entity tags in
+// an If-None-Match header.
+//
+// As required for If-None-Match, tags are compared weakly, ignoring any "W/"
+// prefix.
+func MatchesIfNoneMatch(ifNoneMatch string, etag string) bool {
+	if strings.TrimSpace(ifNoneMatch) == "*" {
+		return true
+	}
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(candidate), "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// handleConditionalGet sets the ETag header of a successful response to a GET
+// request, if the verb did not set one, and returns true if the client's cached
+// copy is still current and a 304 Not Modified should be sent instead.
+func handleConditionalGet(r *http.Request, header http.Header, status int, body []byte) bool {
+	if r.Method != http.MethodGet || (status != 0 && status != http.StatusOK) {
+		return false
+	}
+	etag := header.Get("ETag")
+	if etag == "" {
+		etag = ETag(body)
+		header.Set("ETag", etag)
+	}
+	ifNoneMatch := r.Header.Get("If-None-Match")
+	if ifNoneMatch == "" || !MatchesIfNoneMatch(ifNoneMatch, etag) {
+		return false
+	}
+	header.Del("Content-Type")
+	header.Del("Content-Length")
+	return true
+}
@@ -0,0 +1,44 @@
+package ingress
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+)
+
+func TestMatchesIfNoneMatch(t *testing.T) {
+	assert.True(t, MatchesIfNoneMatch(`"abc"`, `"abc"`))
+	assert.True(t, MatchesIfNoneMatch(`"xyz", W/"abc"`, `"abc"`))
+	assert.True(t, MatchesIfNoneMatch(`"abc"`, `W/"abc"`))
+	assert.True(t, MatchesIfNoneMatch(`*`, `"abc"`))
+	assert.False(t, MatchesIfNoneMatch(`"xyz"`, `"abc"`))
+}
+
+func TestHandleConditionalGet(t *testing.T) {
+	body := []byte(`{"name":"foo"}`)
+	etag := ETag(body)
+
+	header := http.Header{"Content-Type": {"application/json"}}
+	assert.False(t, handleConditionalGet(httptest.NewRequest("GET", "/", nil), header, 0, body))
+	assert.Equal(t, etag, header.Get("ETag"))
+
+	r := httptest.NewRequest("GET", "/", nil)
+	r.Header.Set("If-None-Match", etag)
+	header = http.Header{"Content-Type": {"application/json"}}
+	assert.True(t, handleConditionalGet(r, header, http.StatusOK, body))
+	assert.Equal(t, http.Header{"Etag": {etag}}, header)
+
+	header = http.Header{"Etag": {`"v2"`}}
+	assert.False(t, handleConditionalGet(r, header, 0, body), "verb provided ETags should be used")
+	assert.Equal(t, `"v2"`, header.Get("ETag"))
+
+	header = http.Header{}
+	assert.False(t, handleConditionalGet(r, header, http.StatusNotFound, body))
+	assert.Equal(t, "", header.Get("ETag"), "only successful responses should have an ETag")
+
+	r = httptest.NewRequest("POST", "/", nil)
+	r.Header.Set("If-None-Match", etag)
+	assert.False(t, handleConditionalGet(r, http.Header{}, 0, body))
+}
@@ -16,33 +16,35 @@ import (
 	"github.com/TBD54566975/ftl/db/dalerrs"
 	"github.com/TBD54566975/ftl/internal/log"
 	"github.com/TBD54566975/ftl/internal/model"
+	"github.com/TBD54566975/ftl/internal/rpc/headers"
 )
 
-// Handle HTTP ingress routes.
+// Handle HTTP ingress routes, returning the route that handled the request if
+// any matched.
 func Handle(
 	sch *schema.Schema,
 	requestKey model.RequestKey,
+	principal optional.Option[json.RawMessage],
 	routes []dal.IngressRoute,
 	w http.ResponseWriter,
 	r *http.Request,
 	call func(context.Context, *connect.Request[ftlv1.CallRequest], optional.Option[model.RequestKey], string) (*connect.Response[ftlv1.CallResponse], error),
-) {
+) *dal.IngressRoute {
 	logger := log.FromContext(r.Context())
-	logger.Debugf("%s %s", r.Method, r.URL.Path)
 	route, err := GetIngressRoute(routes, r.Method, r.URL.Path)
 	if err != nil {
 		if errors.Is(err, dalerrs.ErrNotFound) {
 			http.NotFound(w, r)
-			return
+			return nil
 		}
 		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
+		return nil
 	}
 
 	body, err := BuildRequestBody(route, r, sch)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
+		return route
 	}
 
 	creq := connect.NewRequest(&ftlv1.CallRequest{
@@ -50,15 +52,20 @@ func Handle(
 		Verb:     &schemapb.Ref{Module: route.Module, Name: route.Verb},
 		Body:     body,
 	})
+	if claims, ok := principal.Get(); ok {
+		headers.SetPrincipal(creq.Header(), claims)
+	}
 
 	resp, err := call(r.Context(), creq, optional.Some(requestKey), r.RemoteAddr)
 	if err != nil {
 		if connectErr := new(connect.Error); errors.As(err, &connectErr) {
 			http.Error(w, err.Error(), connectCodeToHTTP(connectErr.Code()))
+		} else if errors.Is(err, dalerrs.ErrDBUnavailable) {
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
 		} else {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
-		return
+		return route
 	}
 	switch msg := resp.Msg.Response.(type) {
 	case *ftlv1.CallResponse_Body:
@@ -66,7 +73,7 @@ func Handle(
 		err = sch.ResolveToType(&schema.Ref{Name: route.Verb, Module: route.Module}, verb)
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
-			return
+			return route
 		}
 		var responseBody []byte
 
@@ -74,22 +81,27 @@ func Handle(
 			var response HTTPResponse
 			if err := json.Unmarshal(msg.Body, &response); err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
-				return
+				return route
 			}
 
 			var responseHeaders http.Header
-			responseBody, responseHeaders, err = ResponseForVerb(sch, verb, response)
+			var status int
+			responseBody, responseHeaders, status, err = ResponseForVerb(sch, verb, response)
 			if err != nil {
 				http.Error(w, err.Error(), http.StatusInternalServerError)
-				return
+				return route
 			}
 
 			for k, v := range responseHeaders {
 				w.Header()[k] = v
 			}
 
-			if response.Status != 0 {
-				w.WriteHeader(response.Status)
+			if handleConditionalGet(r, w.Header(), status, responseBody) {
+				w.WriteHeader(http.StatusNotModified)
+				return route
+			}
+			if status != 0 {
+				w.WriteHeader(status)
 			}
 		} else {
 			w.WriteHeader(http.StatusOK)
@@ -104,6 +116,7 @@ func Handle(
 	case *ftlv1.CallResponse_Error_:
 		http.Error(w, msg.Error.Message, http.StatusInternalServerError)
 	}
+	return route
 }
 
 // Copied from the Apache-licensed connect-go source.
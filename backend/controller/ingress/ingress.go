@@ -10,7 +10,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
+
 	"github.com/TBD54566975/ftl/backend/controller/dal"
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
 	"github.com/TBD54566975/ftl/backend/schema"
 	"github.com/TBD54566975/ftl/db/dalerrs"
 	"github.com/TBD54566975/ftl/internal/slices"
@@ -114,6 +117,36 @@ func validateValue(fieldType schema.Type, path path, value any, sch *schema.Sche
 		}
 		return nil
 
+	case *schema.Timestamp:
+		str, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("timestamp %s must be an RFC3339 formatted string", path)
+		}
+		if _, err := schema.ParseTimestamp(str); err != nil {
+			return fmt.Errorf("timestamp %s must be an RFC3339 formatted string with an optional time zone: %w", path, err)
+		}
+		return nil
+
+	case *schema.UUID:
+		str, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("uuid %s must be a string", path)
+		}
+		if _, err := uuid.Parse(str); err != nil {
+			return fmt.Errorf("uuid %s is invalid: %w", path, err)
+		}
+		return nil
+
+	case *schema.Decimal:
+		str, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("decimal %s must be a string to preserve precision", path)
+		}
+		if err := fieldType.CheckValue(str); err != nil {
+			return fmt.Errorf("decimal %s is invalid: %w", path, err)
+		}
+		return nil
+
 	case *schema.Int:
 		switch value := value.(type) {
 		case int64, float64:
@@ -275,3 +308,35 @@ func validateValue(fieldType schema.Type, path path, value any, sch *schema.Sche
 	}
 	return nil
 }
+
+// RoutingEntries returns the ingress routes of the verbs of "module".
+func RoutingEntries(module *schemapb.Module) []dal.IngressRoutingEntry {
+	var ingressRoutes []dal.IngressRoutingEntry
+	for _, decl := range module.Decls {
+		if verb, ok := decl.Value.(*schemapb.Decl_Verb); ok {
+			for _, metadata := range verb.Verb.Metadata {
+				if ingress, ok := metadata.Value.(*schemapb.Metadata_Ingress); ok {
+					ingressRoutes = append(ingressRoutes, dal.IngressRoutingEntry{
+						Verb:   verb.Verb.Name,
+						Method: ingress.Ingress.Method,
+						Path:   ingressPathString(ingress.Ingress.Path),
+					})
+				}
+			}
+		}
+	}
+	return ingressRoutes
+}
+
+func ingressPathString(path []*schemapb.IngressPathComponent) string {
+	pathString := make([]string, len(path))
+	for i, p := range path {
+		switch p.Value.(type) {
+		case *schemapb.IngressPathComponent_IngressPathLiteral:
+			pathString[i] = p.GetIngressPathLiteral().Text
+		case *schemapb.IngressPathComponent_IngressPathParameter:
+			pathString[i] = fmt.Sprintf("{%s}", p.GetIngressPathParameter().Name)
+		}
+	}
+	return "/" + strings.Join(pathString, "/")
+}
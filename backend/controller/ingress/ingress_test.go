@@ -78,6 +78,48 @@ func TestValidation(t *testing.T) {
 		{name: "Map",
 			schema:  `module test { data Test { mapValue {String: String} } }`,
 			request: obj{"mapValue": obj{"key1": "value1", "key2": "value2"}}},
+		{name: "UUID",
+			schema:  `module test { data Test { id UUID } }`,
+			request: obj{"id": "0f1e2d3c-4b5a-6978-8796-a5b4c3d2e1f0"}},
+		{name: "InvalidUUID",
+			schema:  `module test { data Test { id UUID } }`,
+			request: obj{"id": "nope"},
+			err:     "uuid id is invalid: invalid UUID length: 4"},
+		{name: "Decimal",
+			schema:  `module test { data Test { amount Decimal(6, 2) } }`,
+			request: obj{"amount": "-1234.50"}},
+		{name: "DecimalNumber",
+			schema:  `module test { data Test { amount Decimal } }`,
+			request: obj{"amount": 1234.5},
+			err:     "decimal amount must be a string to preserve precision"},
+		{name: "DecimalScale",
+			schema:  `module test { data Test { amount Decimal(6, 2) } }`,
+			request: obj{"amount": "12.345"},
+			err:     `decimal amount is invalid: "12.345" has more than 2 digits after the decimal point`},
+		{name: "DecimalPrecision",
+			schema:  `module test { data Test { amount Decimal(6, 2) } }`,
+			request: obj{"amount": "12345.6"},
+			err:     `decimal amount is invalid: "12345.6" has more than 4 digits before the decimal point`},
+		{name: "Timestamp",
+			schema:  `module test { data Test { at Timestamp } }`,
+			request: obj{"at": "2024-03-10T09:00:00-04:00[America/New_York]"}},
+		{name: "InvalidTimestampZone",
+			schema:  `module test { data Test { at Timestamp } }`,
+			request: obj{"at": "2024-03-10T09:00:00-04:00[Nowhere/Special]"},
+			err:     "timestamp at must be an RFC3339 formatted string with an optional time zone: invalid timestamp: unknown time zone Nowhere/Special"},
+		{name: "MapOptionalValues",
+			schema:  `module test { data Test { mapValue {String: Int?} } }`,
+			request: obj{"mapValue": obj{"key1": 10.0, "key2": nil}}},
+		{name: "ArrayOptionalValues",
+			schema:  `module test { data Test { arrayValue [String?]? } }`,
+			request: obj{"arrayValue": []any{"test1", nil}}},
+		{name: "MapEnumKeys",
+			schema:  `module test { enum Color: String { Red = "red" } data Test { mapValue {test.Color: [test.Color]} } }`,
+			request: obj{"mapValue": obj{"red": []any{"red"}}}},
+		{name: "MapInvalidEnumKeys",
+			schema:  `module test { enum Color: String { Red = "red" } data Test { mapValue {test.Color: [test.Color]} } }`,
+			request: obj{"mapValue": obj{"blue": []any{"red"}}},
+			err:     "blue is not a valid variant of enum test.Color"},
 		{name: "DataRef",
 			schema:  `module test { data Nested { intValue Int } data Test { dataRef test.Nested } }`,
 			request: obj{"dataRef": obj{"intValue": 10.0}}},
@@ -136,6 +178,36 @@ func TestValidation(t *testing.T) {
 			request: obj{},
 			err:     "int is required",
 		},
+		{name: "Constraints",
+			schema: `module test { data Test {
+				age Int +validate min 18 +validate max 130
+				name String +validate minlen 1 +validate maxlen 8 +validate pattern "^[a-z]+$"
+				tags [String] +validate maxlen 2
+				email String?
+				verified Bool? +validate requiredif "email"
+			} }`,
+			request: obj{"age": 30.0, "name": "alice", "tags": []any{"a"}, "email": "a@example.com", "verified": true},
+		},
+		{name: "ConstraintMin",
+			schema:  `module test { data Test { age Int +validate min 18 } }`,
+			request: obj{"age": "17"},
+			err:     "age must be at least 18",
+		},
+		{name: "ConstraintMaxLen",
+			schema:  `module test { data Test { tags [String] +validate maxlen 1 } }`,
+			request: obj{"tags": []any{"a", "b"}},
+			err:     "tags must have a length of at most 1",
+		},
+		{name: "ConstraintPattern",
+			schema:  `module test { data Test { name String +validate pattern "^[a-z]+$" } }`,
+			request: obj{"name": "Alice"},
+			err:     `name must match the pattern "^[a-z]+$"`,
+		},
+		{name: "ConstraintRequiredIf",
+			schema:  `module test { data Test { email String? verified Bool? +validate requiredif "email" } }`,
+			request: obj{"email": "a@example.com"},
+			err:     "verified is required when email is set",
+		},
 		// TODO: More tests for invalid data.
 	}
 	for _, test := range tests {
@@ -288,7 +360,7 @@ func TestResponseBodyForVerb(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			result, headers, err := ResponseForVerb(sch, tc.verb, HTTPResponse{Body: tc.body, Headers: tc.headers})
+			result, headers, _, err := ResponseForVerb(sch, tc.verb, HTTPResponse{Body: tc.body, Headers: tc.headers})
 			assert.NoError(t, err)
 			assert.Equal(t, tc.expectedBody, result)
 			if tc.expectedHeaders != nil {
@@ -298,6 +370,92 @@ func TestResponseBodyForVerb(t *testing.T) {
 	}
 }
 
+func TestResponseMapping(t *testing.T) {
+	sch, err := schema.ParseString("", `
+		module test {
+			data Item {
+				name String
+			}
+			data Envelope {
+				code Int?
+				requestId String
+				tags [String]
+				data test.Item?
+				message String +alias json "msg"
+			}
+			export verb mapped(HttpRequest<Unit>) HttpResponse<test.Envelope, String>
+				+ingress http GET /mapped
+				+response status code header "X-Request-Id" requestId header "X-Tags" tags header "Cache-Control" = "no-store" body data
+			export verb headers(HttpRequest<Unit>) HttpResponse<test.Envelope, String>
+				+ingress http GET /headers
+				+response header "X-Request-Id" requestId
+		}
+	`)
+	assert.NoError(t, err)
+	verb := func(name string) *schema.Verb {
+		verb := &schema.Verb{}
+		assert.NoError(t, sch.ResolveToType(&schema.Ref{Module: "test", Name: name}, verb))
+		return verb
+	}
+
+	tests := []struct {
+		name            string
+		verb            string
+		response        HTTPResponse
+		expectedStatus  int
+		expectedBody    string
+		expectedHeaders http.Header
+	}{
+		{
+			name:           "MapsStatusHeadersAndBody",
+			verb:           "mapped",
+			response:       HTTPResponse{Body: []byte(`{"code": 201, "requestId": "abc", "tags": ["a", "b"], "data": {"name": "foo"}, "message": "ignored"}`)},
+			expectedStatus: 201,
+			expectedBody:   `{"name":"foo"}`,
+			expectedHeaders: http.Header{
+				"Content-Type":  {"application/json; charset=utf-8"},
+				"X-Request-Id":  {"abc"},
+				"X-Tags":        {"a", "b"},
+				"Cache-Control": {"no-store"},
+			},
+		},
+		{
+			name:           "NullFieldsAreSkipped",
+			verb:           "mapped",
+			response:       HTTPResponse{Status: 202, Body: []byte(`{"code": null, "requestId": "abc", "tags": [], "data": null, "message": ""}`)},
+			expectedStatus: 202,
+			expectedBody:   ``,
+			expectedHeaders: http.Header{
+				"X-Request-Id":  {"abc"},
+				"Cache-Control": {"no-store"},
+			},
+		},
+		{
+			name:           "KeepsRemainingFields",
+			verb:           "headers",
+			response:       HTTPResponse{Body: []byte(`{"code": 201, "requestId": "abc", "tags": [], "message": "hello"}`)},
+			expectedStatus: 0,
+			expectedBody:   `{"code":201,"msg":"hello","tags":[]}`,
+			expectedHeaders: http.Header{
+				"Content-Type": {"application/json; charset=utf-8"},
+				"X-Request-Id": {"abc"},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			body, headers, status, err := ResponseForVerb(sch, verb(tc.verb), tc.response)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, status)
+			assert.Equal(t, tc.expectedBody, string(body))
+			assert.Equal(t, tc.expectedHeaders, headers)
+		})
+	}
+
+	_, _, _, err = ResponseForVerb(sch, verb("mapped"), HTTPResponse{Body: []byte(`{"code": 42, "requestId": "abc", "tags": []}`)})
+	assert.EqualError(t, err, `response status field "code" has invalid HTTP status 42`)
+}
+
 func TestValueForData(t *testing.T) {
 	tests := []struct {
 		typ    schema.Type
@@ -0,0 +1,83 @@
+package ingress
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/alecthomas/types/optional"
+)
+
+// AuthenticateRequest verifies the JWT bearer token of an ingress request, if
+// any, and returns its claims.
+//
+// Tokens must be signed with HS256 using "secret". If "secret" is empty,
+// tokens are not verified and no principal is returned.
+func AuthenticateRequest(r *http.Request, secret string) (optional.Option[json.RawMessage], error) {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if secret == "" || !ok {
+		return optional.None[json.RawMessage](), nil
+	}
+	claims, err := verifyJWT(token, []byte(secret), time.Now())
+	if err != nil {
+		return optional.None[json.RawMessage](), fmt.Errorf("invalid bearer token: %w", err)
+	}
+	return optional.Some(claims), nil
+}
+
+func verifyJWT(token string, secret []byte, now time.Time) (json.RawMessage, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed JWT")
+	}
+	var header struct {
+		Alg string `json:"alg"`
+	}
+	if err := decodeJWTSegment(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("invalid header: %w", err)
+	}
+	if header.Alg != "HS256" {
+		return nil, fmt.Errorf("unsupported algorithm %q", header.Alg)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature: %w", err)
+	}
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return nil, errors.New("signature mismatch")
+	}
+	var claims json.RawMessage
+	if err := decodeJWTSegment(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("invalid claims: %w", err)
+	}
+	var times struct {
+		Exp *float64 `json:"exp"`
+		Nbf *float64 `json:"nbf"`
+	}
+	if err := json.Unmarshal(claims, &times); err != nil {
+		return nil, fmt.Errorf("invalid claims: %w", err)
+	}
+	if times.Exp != nil && now.After(time.Unix(int64(*times.Exp), 0)) {
+		return nil, errors.New("token has expired")
+	}
+	if times.Nbf != nil && now.Before(time.Unix(int64(*times.Nbf), 0)) {
+		return nil, errors.New("token is not valid yet")
+	}
+	return claims, nil
+}
+
+func decodeJWTSegment(segment string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
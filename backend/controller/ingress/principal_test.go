@@ -0,0 +1,65 @@
+package ingress
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+)
+
+func signJWT(t *testing.T, header, claims, secret string) string {
+	t.Helper()
+	payload := base64.RawURLEncoding.EncodeToString([]byte(header)) + "." + base64.RawURLEncoding.EncodeToString([]byte(claims))
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+	return payload + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestVerifyJWT(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	hs256 := `{"alg":"HS256","typ":"JWT"}`
+	tests := []struct {
+		name  string
+		token string
+		err   string
+	}{
+		{name: "Valid", token: signJWT(t, hs256, `{"sub":"alice","exp":1700000100}`, "secret")},
+		{name: "WrongSecret", token: signJWT(t, hs256, `{"sub":"alice"}`, "other"), err: "signature mismatch"},
+		{name: "Expired", token: signJWT(t, hs256, `{"sub":"alice","exp":1699999999}`, "secret"), err: "token has expired"},
+		{name: "NotYetValid", token: signJWT(t, hs256, `{"sub":"alice","nbf":1700000100}`, "secret"), err: "token is not valid yet"},
+		{name: "UnsupportedAlgorithm", token: signJWT(t, `{"alg":"none"}`, `{"sub":"alice"}`, "secret"), err: `unsupported algorithm "none"`},
+		{name: "Malformed", token: "not-a-jwt", err: "malformed JWT"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			claims, err := verifyJWT(test.token, []byte("secret"), now)
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, `{"sub":"alice","exp":1700000100}`, string(claims))
+		})
+	}
+}
+
+func TestAuthenticateRequest(t *testing.T) {
+	r, err := http.NewRequest(http.MethodGet, "/", nil)
+	assert.NoError(t, err)
+	r.Header.Set("Authorization", "Bearer "+signJWT(t, `{"alg":"HS256"}`, `{"sub":"alice"}`, "secret"))
+
+	claims, err := AuthenticateRequest(r, "secret")
+	assert.NoError(t, err)
+	assert.Equal(t, `{"sub":"alice"}`, string(claims.MustGet()))
+
+	claims, err = AuthenticateRequest(r, "")
+	assert.NoError(t, err)
+	assert.False(t, claims.Ok())
+
+	_, err = AuthenticateRequest(r, "other")
+	assert.Error(t, err)
+}
@@ -255,9 +255,13 @@ func validateRequestMap(ref *schema.Ref, path path, request map[string]any, sch
 			err := validateValue(field.Type, fieldPath, value, sch)
 			if err != nil {
 				errs = append(errs, err)
+				continue
 			}
 		}
 
+		if err := validateConstraints(field, fieldPath, value, request); err != nil {
+			errs = append(errs, err)
+		}
 	}
 
 	return errors.Join(errs...)
@@ -269,7 +273,8 @@ func allowMissingField(field *schema.Field) bool {
 	case *schema.Optional, *schema.Any, *schema.Array, *schema.Map, *schema.Bytes, *schema.Unit:
 		return true
 
-	case *schema.Bool, *schema.Ref, *schema.Float, *schema.Int, *schema.String, *schema.Time:
+	case *schema.Bool, *schema.Ref, *schema.Float, *schema.Int, *schema.String, *schema.Time,
+		*schema.Timestamp, *schema.UUID, *schema.Decimal:
 	}
 	return false
 }
@@ -327,10 +332,10 @@ func parseQueryParams(values url.Values, data *schema.Data) (map[string]any, err
 
 func valueForField(typ schema.Type, value []string) (optional.Option[any], error) {
 	switch t := typ.(type) {
-	case *schema.Bytes, *schema.Map, *schema.Time,
+	case *schema.Bytes, *schema.Map, *schema.Time, *schema.Timestamp,
 		*schema.Unit, *schema.Ref, *schema.Any:
 
-	case *schema.Int, *schema.Float, *schema.String, *schema.Bool:
+	case *schema.Int, *schema.Float, *schema.String, *schema.Bool, *schema.UUID, *schema.Decimal:
 		if len(value) > 1 {
 			return optional.None[any](), fmt.Errorf("multiple values are not supported")
 		}
@@ -20,16 +20,19 @@ type HTTPResponse struct {
 	Error   json.RawMessage     `json:"error,omitempty"`
 }
 
-// ResponseForVerb returns the HTTP response for a given verb.
-func ResponseForVerb(sch *schema.Schema, verb *schema.Verb, response HTTPResponse) ([]byte, http.Header, error) {
+// ResponseForVerb returns the HTTP response body, headers and status for a
+// given verb.
+//
+// The status is zero if the verb did not set one.
+func ResponseForVerb(sch *schema.Schema, verb *schema.Verb, response HTTPResponse) ([]byte, http.Header, int, error) {
 	responseRef, ok := verb.Response.(*schema.Ref)
 	if !ok {
-		return nil, nil, nil
+		return nil, nil, 0, nil
 	}
 
 	bodyData, err := sch.ResolveMonomorphised(responseRef)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to resolve response data type: %w", err)
+		return nil, nil, 0, fmt.Errorf("failed to resolve response data type: %w", err)
 	}
 
 	haveBody := response.Body != nil && !bytes.Equal(response.Body, []byte("null"))
@@ -40,7 +43,7 @@ func ResponseForVerb(sch *schema.Schema, verb *schema.Verb, response HTTPRespons
 
 	switch {
 	case haveBody == haveError:
-		return nil, nil, fmt.Errorf("response must have either a body or an error")
+		return nil, nil, 0, fmt.Errorf("response must have either a body or an error")
 
 	case haveBody:
 		fieldType = bodyData.FieldByName("body").Type.(*schema.Optional).Type //nolint:forcetypeassert
@@ -59,6 +62,13 @@ func ResponseForVerb(sch *schema.Schema, verb *schema.Verb, response HTTPRespons
 	for k, v := range response.Headers {
 		headers[http.CanonicalHeaderKey(k)] = v
 	}
+	status := response.Status
+	if mapping, ok := verb.GetMetadataResponse().Get(); ok && haveBody {
+		fieldType, body, err = mapResponse(sch, mapping, fieldType, body, headers, &status)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+	}
 	// If the Content-Type header is not set, set it to the default value for the response or error type.
 	if _, ok := headers["Content-Type"]; !ok {
 		if contentType := getDefaultContentType(fieldType); contentType != "" {
@@ -67,7 +77,90 @@ func ResponseForVerb(sch *schema.Schema, verb *schema.Verb, response HTTPRespons
 	}
 
 	outBody, err := bodyForType(fieldType, sch, body)
-	return outBody, headers, err
+	return outBody, headers, status, err
+}
+
+// mapResponse applies a +response mapping to the body of a response, moving
+// mapped fields into the status and headers and optionally replacing the
+// body with one of its fields.
+//
+// It returns the type and encoding of the remaining body.
+func mapResponse(sch *schema.Schema, mapping *schema.MetadataResponse, bodyType schema.Type, body []byte, headers http.Header, status *int) (schema.Type, []byte, error) {
+	ref, ok := bodyType.(*schema.Ref)
+	if !ok {
+		return nil, nil, fmt.Errorf("response mapping requires a data structure body, not %s", bodyType)
+	}
+	data, err := sch.ResolveMonomorphised(ref)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to resolve response body type: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return nil, nil, fmt.Errorf("HTTP response body is not a JSON object: %w", err)
+	}
+	// take removes a field from the body, returning false if it is absent or null.
+	take := func(name string) (json.RawMessage, bool) {
+		value, ok := fields[name]
+		delete(fields, name)
+		return value, ok && !bytes.Equal(value, []byte("null"))
+	}
+
+	if mapping.Status != "" {
+		if value, ok := take(mapping.Status); ok {
+			if err := json.Unmarshal(value, status); err != nil {
+				return nil, nil, fmt.Errorf("response status field %q is not an int: %w", mapping.Status, err)
+			}
+			if *status < 100 || *status > 999 {
+				return nil, nil, fmt.Errorf("response status field %q has invalid HTTP status %d", mapping.Status, *status)
+			}
+		}
+	}
+
+	for _, header := range mapping.Headers {
+		if header.Field == "" {
+			headers.Set(header.Name, header.Value)
+			continue
+		}
+		value, ok := take(header.Field)
+		if !ok {
+			continue
+		}
+		// Strings and arrays of strings are decoded, ints and bools are used as is.
+		var values []string
+		if err := json.Unmarshal(value, &values); err != nil {
+			var str string
+			if err := json.Unmarshal(value, &str); err != nil {
+				str = string(value)
+			}
+			values = []string{str}
+		}
+		headers.Del(header.Name)
+		for _, v := range values {
+			headers.Add(header.Name, v)
+		}
+	}
+
+	if mapping.Body != "" {
+		field := data.FieldByName(mapping.Body)
+		if field == nil {
+			return nil, nil, fmt.Errorf("response body %s does not contain the field %q", data.Name, mapping.Body)
+		}
+		value, ok := take(mapping.Body)
+		if !ok {
+			return &schema.Unit{}, nil, nil
+		}
+		fieldType := field.Type
+		if opt, ok := fieldType.(*schema.Optional); ok {
+			fieldType = opt.Type
+		}
+		return fieldType, value, nil
+	}
+
+	body, err = json.Marshal(fields)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encode HTTP response body: %w", err)
+	}
+	return bodyType, body, nil
 }
 
 func bodyForType(typ schema.Type, sch *schema.Schema, data []byte) ([]byte, error) {
@@ -81,6 +174,9 @@ func bodyForType(typ schema.Type, sch *schema.Schema, data []byte) ([]byte, erro
 
 		err = transformAliasedFields(sch, t, response, func(obj map[string]any, field *schema.Field) string {
 			if jsonAlias, ok := field.Alias(schema.AliasKindJSON).Get(); ok && field.Name != jsonAlias {
+				if _, ok := obj[field.Name]; !ok {
+					return jsonAlias
+				}
 				obj[jsonAlias] = obj[field.Name]
 				delete(obj, field.Name)
 				return jsonAlias
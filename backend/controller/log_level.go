@@ -0,0 +1,49 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+// Log level overrides are intended for debugging, so they can't outlive a
+// working day and be forgotten about.
+const maxLogLevelOverride = time.Hour * 24
+
+func (s *Service) SetLogLevel(ctx context.Context, req *connect.Request[ftlv1.SetLogLevelRequest]) (*connect.Response[ftlv1.SetLogLevelResponse], error) {
+	if req.Msg.Module == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("module is required"))
+	}
+	if err := s.checkModuleScope(ctx, req.Msg.Module); err != nil {
+		return nil, err
+	}
+	level := log.Level(req.Msg.LogLevel)
+	if !level.IsALevel() {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid log level %d", req.Msg.LogLevel))
+	}
+	logger := log.FromContext(ctx)
+	if level == log.Default {
+		if err := s.dal.ClearModuleLogLevel(ctx, req.Msg.Module); err != nil {
+			return nil, fmt.Errorf("could not clear log level: %w", err)
+		}
+		logger.Infof("Cleared log level override for %s", req.Msg.Module)
+		return connect.NewResponse(&ftlv1.SetLogLevelResponse{}), nil
+	}
+	duration := req.Msg.Duration.AsDuration()
+	if duration <= 0 || duration > maxLogLevelOverride {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("log level override must last between 0 and %s, not %s", maxLogLevelOverride, duration))
+	}
+	expiresAt := time.Now().Add(duration)
+	if err := s.dal.SetModuleLogLevel(ctx, req.Msg.Module, level, expiresAt); err != nil {
+		return nil, fmt.Errorf("could not set log level: %w", err)
+	}
+	logger.Infof("Logging %s at %s for %s", req.Msg.Module, level, duration)
+	return connect.NewResponse(&ftlv1.SetLogLevelResponse{ExpiresAt: timestamppb.New(expiresAt)}), nil
+}
@@ -0,0 +1,79 @@
+package controller
+
+import (
+	"strings"
+
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+// moduleMetadata describes what a module does and who owns it.
+//
+// It is deployed with the runtime metadata of the module, and recorded in the
+// labels of its deployment.
+type moduleMetadata struct {
+	Owner  string
+	Readme string
+	Links  map[string]string
+}
+
+func moduleMetadataFromRuntime(runtime *schemapb.ModuleRuntime) moduleMetadata {
+	return moduleMetadata{Owner: runtime.GetOwner(), Readme: runtime.GetReadme(), Links: runtime.GetLinks()}
+}
+
+// moduleMetadataFromLabels returns the metadata recorded in the labels of a
+// deployment.
+func moduleMetadataFromLabels(labels model.Labels) moduleMetadata {
+	m := moduleMetadata{}
+	m.Owner, _ = labels[model.OwnerLabel].(string)
+	m.Readme, _ = labels[model.ReadmeLabel].(string)
+	if links, ok := labels[model.LinksLabel].(map[string]any); ok {
+		m.Links = map[string]string{}
+		for name, link := range links {
+			if link, ok := link.(string); ok {
+				m.Links[name] = link
+			}
+		}
+	}
+	return m
+}
+
+// addLabels records the metadata in the labels of a deployment.
+func (m moduleMetadata) addLabels(labels model.Labels) {
+	if m.Owner != "" {
+		labels[model.OwnerLabel] = m.Owner
+	}
+	if m.Readme != "" {
+		labels[model.ReadmeLabel] = m.Readme
+	}
+	if len(m.Links) > 0 {
+		links := map[string]any{}
+		for name, link := range m.Links {
+			links[name] = link
+		}
+		labels[model.LinksLabel] = links
+	}
+}
+
+// setRuntime sets the metadata in the runtime metadata of a module.
+func (m moduleMetadata) setRuntime(runtime *schemapb.ModuleRuntime) {
+	if m.Owner != "" {
+		runtime.Owner = &m.Owner
+	}
+	if m.Readme != "" {
+		runtime.Readme = &m.Readme
+	}
+	runtime.Links = m.Links
+}
+
+// description returns a one line description of what "module" does, from its
+// doc comments or, failing that, its README.
+func (m moduleMetadata) description(module *schema.Module) string {
+	for _, text := range []string{strings.Join(module.Comments, "\n"), m.Readme} {
+		if line, _, _ := strings.Cut(strings.TrimSpace(text), "\n"); line != "" {
+			return line
+		}
+	}
+	return ""
+}
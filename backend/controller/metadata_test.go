@@ -0,0 +1,41 @@
+package controller
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+func TestModuleMetadata(t *testing.T) {
+	owner, readme := "team-payments", "Charges customers.\nAnd issues refunds."
+	metadata := moduleMetadataFromRuntime(&schemapb.ModuleRuntime{
+		Owner:  &owner,
+		Readme: &readme,
+		Links:  map[string]string{"runbook": "https://example.com/runbook"},
+	})
+
+	// Labels are stored as JSON.
+	labels := model.Labels{model.ProjectLabel: "payments"}
+	metadata.addLabels(labels)
+	data, err := json.Marshal(labels)
+	assert.NoError(t, err)
+	labels = model.Labels{}
+	assert.NoError(t, json.Unmarshal(data, &labels))
+	assert.Equal(t, metadata, moduleMetadataFromLabels(labels))
+
+	runtime := &schemapb.ModuleRuntime{}
+	metadata.setRuntime(runtime)
+	assert.Equal(t, owner, runtime.GetOwner())
+	assert.Equal(t, readme, runtime.GetReadme())
+	assert.Equal(t, metadata.Links, runtime.Links)
+
+	assert.Equal(t, "Charges customers.", metadata.description(&schema.Module{Name: "payments"}))
+	assert.Equal(t, "Package payments takes money.", metadata.description(&schema.Module{Name: "payments", Comments: []string{"Package payments takes money.", "", "Details."}}))
+	assert.Equal(t, "", moduleMetadata{}.description(&schema.Module{Name: "payments"}))
+	assert.Equal(t, moduleMetadata{}, moduleMetadataFromLabels(model.Labels{}))
+}
@@ -0,0 +1,50 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/metrics"
+)
+
+// Timeout for scraping the metrics of each runner.
+const metricsScrapeTimeout = 5 * time.Second
+
+// aggregateMetrics scrapes the metrics of every runner with a deployment, and
+// merges them.
+//
+// Runners that can not be scraped are skipped.
+func (s *Service) aggregateMetrics(ctx context.Context) ([]metrics.Sample, error) {
+	logger := log.FromContext(ctx)
+	runners, err := s.dal.GetActiveRunners(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, metricsScrapeTimeout)
+	defer cancel()
+	var lock sync.Mutex
+	var wg sync.WaitGroup
+	snapshots := [][]metrics.Sample{}
+	for _, runner := range runners {
+		if !runner.Deployment.Ok() {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			samples, err := metrics.Scrape(ctx, http.DefaultClient, runner.Endpoint)
+			if err != nil {
+				logger.Warnf("Failed to scrape metrics from runner %s: %s", runner.Key, err)
+				return
+			}
+			lock.Lock()
+			defer lock.Unlock()
+			snapshots = append(snapshots, samples)
+		}()
+	}
+	wg.Wait()
+	return metrics.Merge(snapshots...)
+}
@@ -2,9 +2,13 @@ package controller
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/alecthomas/types/optional"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 
 	"github.com/TBD54566975/ftl/backend/controller/dal"
 	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
@@ -19,9 +23,37 @@ type Call struct {
 	startTime     time.Time
 	destVerb      *schema.Ref
 	callers       []*schema.Ref
+	deprecated    bool
 	request       *ftlv1.CallRequest
-	response      optional.Option[*ftlv1.CallResponse]
-	callError     optional.Option[error]
+	// The response is recorded alongside a call error if it was rejected,
+	// eg. for being too large.
+	response  optional.Option[*ftlv1.CallResponse]
+	callError optional.Option[error]
+}
+
+// callRequests counts verb calls, labelled with whether the verb is
+// deprecated so that migrations off deprecated verbs can be tracked.
+var callRequests = func() metric.Int64Counter {
+	counter, err := otel.GetMeterProvider().Meter("ftl.controller").Int64Counter("ftl.call.requests",
+		metric.WithDescription("number of verb calls"),
+		metric.WithUnit("{count}"))
+	if err != nil {
+		panic(err)
+	}
+	return counter
+}()
+
+// logDeprecatedCall warns that a deprecated verb was called.
+func logDeprecatedCall(ctx context.Context, verb *schema.Ref, callers []*schema.Ref, deprecated *schema.MetadataDeprecated) {
+	var msg strings.Builder
+	msg.WriteString("Call to deprecated verb " + verb.String())
+	if len(callers) > 0 {
+		msg.WriteString(" from " + callers[len(callers)-1].String())
+	}
+	if deprecated.Message != "" {
+		msg.WriteString(": " + deprecated.Message)
+	}
+	log.FromContext(ctx).Warnf("%s", msg.String())
 }
 
 func (s *Service) recordCall(ctx context.Context, call *Call) {
@@ -34,6 +66,12 @@ func (s *Service) recordCall(ctx context.Context, call *Call) {
 	var errorStr optional.Option[string]
 	var stack optional.Option[string]
 	var responseBody []byte
+	requestSize := int64(len(call.request.GetBody()))
+	var responseSize int64
+	if response, ok := call.response.Get(); ok {
+		responseSize = int64(len(response.GetBody()))
+	}
+	recordCallSizes(ctx, call.destVerb, requestSize, responseSize, call.response.Ok())
 
 	if callError, ok := call.callError.Get(); ok {
 		errorStr = optional.Some(callError.Error())
@@ -45,6 +83,16 @@ func (s *Service) recordCall(ctx context.Context, call *Call) {
 		}
 	}
 
+	attrs := []attribute.KeyValue{
+		attribute.String("ftl.verb.ref", call.destVerb.String()),
+		attribute.Bool("ftl.verb.deprecated", call.deprecated),
+		attribute.Bool("ftl.call.failed", errorStr.Ok()),
+	}
+	if len(call.callers) > 0 {
+		attrs = append(attrs, attribute.String("ftl.caller.verb.ref", call.callers[len(call.callers)-1].String()))
+	}
+	callRequests.Add(ctx, 1, metric.WithAttributes(attrs...))
+
 	err := s.dal.InsertCallEvent(ctx, &dal.CallEvent{
 		Time:          call.startTime,
 		DeploymentKey: call.deploymentKey,
@@ -54,6 +102,8 @@ func (s *Service) recordCall(ctx context.Context, call *Call) {
 		DestVerb:      *call.destVerb,
 		Request:       call.request.GetBody(),
 		Response:      responseBody,
+		RequestSize:   requestSize,
+		ResponseSize:  responseSize,
 		Error:         errorStr,
 		Stack:         stack,
 	})
@@ -0,0 +1,182 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	"github.com/TBD54566975/ftl/db/dalerrs"
+	"github.com/TBD54566975/ftl/internal/model"
+	"github.com/TBD54566975/ftl/internal/rpc"
+	"github.com/TBD54566975/ftl/internal/slices"
+)
+
+// Requests scoped to a project, via the Ftl-Project header set by
+// "ftl --project", only see and change the deployments of that project.
+// Unscoped requests operate on the whole cluster.
+
+// deploymentProject returns the project a deployment was deployed from, or ""
+// if it was not deployed from a project.
+func deploymentProject(labels model.Labels) string {
+	project, _ := labels[model.ProjectLabel].(string)
+	return project
+}
+
+// moduleProjects maps each module with an active deployment to its project.
+func moduleProjects(deployments []dal.Deployment) map[string]string {
+	projects := map[string]string{}
+	for _, deployment := range deployments {
+		if project := deploymentProject(deployment.Labels); project != "" {
+			projects[deployment.Module] = project
+		}
+	}
+	return projects
+}
+
+// inProjectScope returns true if a deployment with "labels" is visible to
+// requests made with "ctx".
+func inProjectScope(ctx context.Context, labels model.Labels) bool {
+	scope, ok := rpc.ProjectFromContext(ctx).Get()
+	return !ok || deploymentProject(labels) == scope
+}
+
+// checkProjectScope returns an error if requests made with "ctx" are scoped
+// to a project other than "project", which "what" belongs to.
+func checkProjectScope(ctx context.Context, what, project string) error {
+	scope, ok := rpc.ProjectFromContext(ctx).Get()
+	if !ok || project == scope {
+		return nil
+	}
+	if project == "" {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("%s does not belong to project %q", what, scope))
+	}
+	return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("%s belongs to project %q, not %q", what, project, scope))
+}
+
+// moduleScope returns a function that returns true if "module" is visible to
+// requests made with "ctx".
+//
+// Modules without active deployments are visible to every project.
+func (s *Service) moduleScope(ctx context.Context) (func(module string) bool, error) {
+	scope, ok := rpc.ProjectFromContext(ctx).Get()
+	if !ok {
+		return func(string) bool { return true }, nil
+	}
+	deployments, err := s.dal.GetActiveDeployments(ctx)
+	if err != nil {
+		return nil, err
+	}
+	projects := map[string]string{}
+	for _, deployment := range deployments {
+		projects[deployment.Module] = deploymentProject(deployment.Labels)
+	}
+	return func(module string) bool {
+		project, ok := projects[module]
+		return !ok || project == scope
+	}, nil
+}
+
+// checkModuleScope returns an error if "module" is not visible to requests
+// made with "ctx".
+func (s *Service) checkModuleScope(ctx context.Context, module string) error {
+	inScope, err := s.moduleScope(ctx)
+	if err != nil {
+		return err
+	}
+	if !inScope(module) {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("module %q does not belong to project %q", module, rpc.ProjectFromContext(ctx).Default("")))
+	}
+	return nil
+}
+
+// checkDeploymentScope returns the deployment "key", or an error if it is not
+// in the project requests made with "ctx" are scoped to.
+func (s *Service) checkDeploymentScope(ctx context.Context, key model.DeploymentKey) (*model.Deployment, error) {
+	deployment, err := s.dal.GetDeployment(ctx, key)
+	if errors.Is(err, dalerrs.ErrNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("deployment not found"))
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get deployment: %w", err)
+	}
+	if err := checkProjectScope(ctx, fmt.Sprintf("deployment %s", key), deploymentProject(deployment.Labels)); err != nil {
+		return nil, err
+	}
+	return deployment, nil
+}
+
+// checkModuleOwner returns an error if "module" has active deployments from a
+// project other than "project", so that one project can't replace the modules
+// of another. Modules that were not deployed from a project can be claimed by
+// any project.
+func checkModuleOwner(deployments []dal.Deployment, module, project string) error {
+	for _, deployment := range deployments {
+		if deployment.Module != module {
+			continue
+		}
+		if owner := deploymentProject(deployment.Labels); owner != "" && owner != project {
+			return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("module %q is deployed from project %q and can not be replaced from project %q", module, owner, project))
+		}
+	}
+	return nil
+}
+
+// checkProjectQuotas returns an error if running "minReplicas" replicas of
+// "module" would exceed the deployment or replica quotas of "project".
+func (s *Service) checkProjectQuotas(ctx context.Context, project, module string, minReplicas int) error {
+	if minReplicas == 0 {
+		return nil
+	}
+	deployments, err := s.dal.GetActiveDeployments(ctx)
+	if err != nil {
+		return err
+	}
+	modules, used, current := projectUsage(deployments, project, module)
+	if err := s.quotas.CheckDeployments(project, module, modules); err != nil {
+		return err
+	}
+	return s.quotas.CheckReplicas(project, module, used, current, minReplicas)
+}
+
+// projectUsage returns the modules with active deployments in "project", the
+// minimum replicas of those other than "module", and the replicas of "module".
+func projectUsage(deployments []dal.Deployment, project, module string) (modules []string, used, current int) {
+	for _, deployment := range deployments {
+		if deploymentProject(deployment.Labels) != project {
+			continue
+		}
+		modules = append(modules, deployment.Module)
+		if deployment.Module == module {
+			current += deployment.MinReplicas
+		} else {
+			used += deployment.MinReplicas
+		}
+	}
+	return modules, used, current
+}
+
+// scopeStatus removes the deployments, runners and routes of other projects
+// from "status", if requests made with "ctx" are scoped to a project.
+//
+// Idle runners are included if they are shared or dedicated to the project.
+func scopeStatus(ctx context.Context, status dal.Status) dal.Status {
+	if _, ok := rpc.ProjectFromContext(ctx).Get(); !ok {
+		return status
+	}
+	status.Deployments = slices.Filter(status.Deployments, func(d dal.Deployment) bool { return inProjectScope(ctx, d.Labels) })
+	inScope := map[string]bool{}
+	for _, deployment := range status.Deployments {
+		inScope[deployment.Key.String()] = true
+	}
+	status.Runners = slices.Filter(status.Runners, func(r dal.Runner) bool {
+		if deployment, ok := r.Deployment.Get(); ok {
+			return inScope[deployment.String()]
+		}
+		return deploymentProject(r.Labels) == "" || inProjectScope(ctx, r.Labels)
+	})
+	status.IngressRoutes = slices.Filter(status.IngressRoutes, func(r dal.IngressRouteEntry) bool { return inScope[r.Deployment.String()] })
+	status.Routes = slices.Filter(status.Routes, func(r dal.Route) bool { return inScope[r.Deployment.String()] })
+	return status
+}
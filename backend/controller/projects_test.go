@@ -0,0 +1,83 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	"github.com/TBD54566975/ftl/internal/model"
+	"github.com/TBD54566975/ftl/internal/rpc"
+)
+
+func deploymentInProject(module, project string, minReplicas int) dal.Deployment {
+	labels := model.Labels{}
+	if project != "" {
+		labels[model.ProjectLabel] = project
+	}
+	return dal.Deployment{Key: model.NewDeploymentKey(module), Module: module, MinReplicas: minReplicas, Labels: labels}
+}
+
+func TestProjectScope(t *testing.T) {
+	unscoped := context.Background()
+	billing := rpc.WithProject(unscoped, "billing")
+	invoices := deploymentInProject("invoices", "billing", 1)
+	users := deploymentInProject("users", "accounts", 1)
+	legacy := deploymentInProject("legacy", "", 1)
+
+	assert.True(t, inProjectScope(unscoped, users.Labels))
+	assert.True(t, inProjectScope(billing, invoices.Labels))
+	assert.False(t, inProjectScope(billing, users.Labels))
+	assert.False(t, inProjectScope(billing, legacy.Labels))
+
+	assert.NoError(t, checkProjectScope(unscoped, "module users", "accounts"))
+	assert.NoError(t, checkProjectScope(billing, "module invoices", "billing"))
+	err := checkProjectScope(billing, "module users", "accounts")
+	assert.EqualError(t, err, `permission_denied: module users belongs to project "accounts", not "billing"`)
+	err = checkProjectScope(billing, "module legacy", "")
+	assert.EqualError(t, err, `permission_denied: module legacy does not belong to project "billing"`)
+
+	status := scopeStatus(billing, dal.Status{
+		Deployments: []dal.Deployment{invoices, users},
+		Runners: []dal.Runner{
+			{Key: model.NewRunnerKey("localhost", "1"), Deployment: optional.Some(invoices.Key)},
+			{Key: model.NewRunnerKey("localhost", "2"), Deployment: optional.Some(users.Key)},
+			{Key: model.NewRunnerKey("localhost", "3"), Labels: model.Labels{}},
+			{Key: model.NewRunnerKey("localhost", "4"), Labels: model.Labels{model.ProjectLabel: "accounts"}},
+		},
+		Routes: []dal.Route{{Module: "invoices", Deployment: invoices.Key}, {Module: "users", Deployment: users.Key}},
+	})
+	assert.Equal(t, []dal.Deployment{invoices}, status.Deployments)
+	assert.Equal(t, []string{"invoices"}, []string{status.Routes[0].Module})
+	assert.Equal(t, 2, len(status.Runners), "should include the runner of invoices and the shared idle runner")
+}
+
+func TestCheckModuleOwner(t *testing.T) {
+	deployments := []dal.Deployment{
+		deploymentInProject("invoices", "billing", 1),
+		deploymentInProject("legacy", "", 1),
+	}
+	assert.NoError(t, checkModuleOwner(deployments, "invoices", "billing"))
+	assert.NoError(t, checkModuleOwner(deployments, "legacy", "billing"), "modules not deployed from a project can be claimed")
+	assert.NoError(t, checkModuleOwner(deployments, "users", "accounts"))
+	err := checkModuleOwner(deployments, "invoices", "accounts")
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+}
+
+func TestProjectUsage(t *testing.T) {
+	deployments := []dal.Deployment{
+		deploymentInProject("invoices", "billing", 2),
+		deploymentInProject("payments", "billing", 3),
+		deploymentInProject("users", "accounts", 5),
+	}
+	modules, used, current := projectUsage(deployments, "billing", "invoices")
+	assert.Equal(t, []string{"invoices", "payments"}, modules)
+	assert.Equal(t, 3, used)
+	assert.Equal(t, 2, current)
+	_, used, current = projectUsage(deployments, "billing", "refunds")
+	assert.Equal(t, 5, used)
+	assert.Equal(t, 0, current)
+}
@@ -0,0 +1,225 @@
+package provisioner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+// cloudSQLProvisioner creates a database and a user for each module database,
+// on a Cloud SQL instance, through the Cloud SQL Admin API.
+type cloudSQLProvisioner struct {
+	client   *http.Client
+	project  string
+	instance string
+	// Host modules connect to, defaulting to the private or public IP address
+	// of the instance.
+	host string
+	// Base URL of the Cloud SQL Admin API.
+	api string
+	// URL of the metadata server's access token endpoint.
+	tokenURL string
+	// Interval between checks for the completion of operations.
+	pollInterval time.Duration
+}
+
+var _ Provisioner = (*cloudSQLProvisioner)(nil)
+
+func newCloudSQLProvisioner(u *url.URL) (*cloudSQLProvisioner, error) {
+	instance := strings.Trim(u.Path, "/")
+	if u.Host == "" || instance == "" {
+		return nil, fmt.Errorf("database provisioner URL %q must be in the form cloudsql://PROJECT/INSTANCE", u.Redacted())
+	}
+	return &cloudSQLProvisioner{
+		client:       http.DefaultClient,
+		project:      u.Host,
+		instance:     instance,
+		host:         u.Query().Get("host"),
+		api:          "https://sqladmin.googleapis.com/v1",
+		tokenURL:     "http://metadata.google.internal/computeMetadata/v1/instance/service-accounts/default/token",
+		pollInterval: time.Second,
+	}, nil
+}
+
+func (c *cloudSQLProvisioner) Key() string { return "cloudsql" }
+
+func (c *cloudSQLProvisioner) Provision(ctx context.Context, module, name string) (Database, error) {
+	host, err := c.instanceHost(ctx)
+	if err != nil {
+		return Database{}, err
+	}
+	password, err := generatePassword()
+	if err != nil {
+		return Database{}, err
+	}
+	resource := resourceName(module, name)
+	if err := c.operation(ctx, http.MethodPost, c.instancePath("databases"), map[string]string{"name": resource}); err != nil {
+		return Database{}, fmt.Errorf("could not create database: %w", err)
+	}
+	if err := c.operation(ctx, http.MethodPost, c.instancePath("users"), map[string]string{"name": resource, "password": password}); err != nil {
+		// Delete the database rather than leaving it behind.
+		return Database{}, errors.Join(fmt.Errorf("could not create user: %w", err), c.deleteDatabase(ctx, resource))
+	}
+	dsn := &url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(resource, password),
+		Host:     host + ":5432",
+		Path:     "/" + resource,
+		RawQuery: "sslmode=require",
+	}
+	return Database{DSN: dsn.String(), Resource: resource}, nil
+}
+
+func (c *cloudSQLProvisioner) Deprovision(ctx context.Context, resource string) error {
+	if err := c.deleteDatabase(ctx, resource); err != nil {
+		return err
+	}
+	if err := c.operation(ctx, http.MethodDelete, c.instancePath("users?name="+url.QueryEscape(resource)), nil); err != nil {
+		return fmt.Errorf("could not delete user: %w", err)
+	}
+	return nil
+}
+
+func (c *cloudSQLProvisioner) deleteDatabase(ctx context.Context, resource string) error {
+	if err := c.operation(ctx, http.MethodDelete, c.instancePath("databases/"+url.PathEscape(resource)), nil); err != nil {
+		return fmt.Errorf("could not delete database: %w", err)
+	}
+	return nil
+}
+
+func (c *cloudSQLProvisioner) instancePath(path string) string {
+	return fmt.Sprintf("/projects/%s/instances/%s/%s", url.PathEscape(c.project), url.PathEscape(c.instance), path)
+}
+
+// instanceHost returns the host modules connect to, preferring the private IP
+// address of the instance.
+func (c *cloudSQLProvisioner) instanceHost(ctx context.Context) (string, error) {
+	if c.host != "" {
+		return c.host, nil
+	}
+	var instance struct {
+		IPAddresses []struct {
+			Type      string `json:"type"`
+			IPAddress string `json:"ipAddress"`
+		} `json:"ipAddresses"`
+	}
+	path := fmt.Sprintf("/projects/%s/instances/%s", url.PathEscape(c.project), url.PathEscape(c.instance))
+	if err := c.call(ctx, http.MethodGet, path, nil, &instance); err != nil {
+		return "", fmt.Errorf("could not get Cloud SQL instance: %w", err)
+	}
+	host := ""
+	for _, address := range instance.IPAddresses {
+		switch address.Type {
+		case "PRIVATE":
+			return address.IPAddress, nil
+		case "PRIMARY":
+			host = address.IPAddress
+		}
+	}
+	if host == "" {
+		return "", fmt.Errorf("instance %s has no IP address", c.instance)
+	}
+	return host, nil
+}
+
+type cloudSQLOperation struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Error  *struct {
+		Errors []struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		} `json:"errors"`
+	} `json:"error"`
+}
+
+// operation starts an operation on the instance and waits for it to complete.
+func (c *cloudSQLProvisioner) operation(ctx context.Context, method, path string, body any) error {
+	var op cloudSQLOperation
+	if err := c.call(ctx, method, path, body, &op); err != nil {
+		return err
+	}
+	for op.Status != "DONE" {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(c.pollInterval):
+		}
+		if err := c.call(ctx, http.MethodGet, fmt.Sprintf("/projects/%s/operations/%s", url.PathEscape(c.project), url.PathEscape(op.Name)), nil, &op); err != nil {
+			return err
+		}
+	}
+	if op.Error != nil && len(op.Error.Errors) > 0 {
+		return fmt.Errorf("%s: %s", op.Error.Errors[0].Code, op.Error.Errors[0].Message)
+	}
+	return nil
+}
+
+func (c *cloudSQLProvisioner) call(ctx context.Context, method, path string, body any, out any) error {
+	token, err := c.token(ctx)
+	if err != nil {
+		return err
+	}
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.api+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("%s %s: %s: %s", method, path, resp.Status, strings.TrimSpace(string(data)))
+	}
+	return json.Unmarshal(data, out)
+}
+
+// token returns an OAuth access token for the Cloud SQL Admin API.
+func (c *cloudSQLProvisioner) token(ctx context.Context) (string, error) {
+	if token, ok := os.LookupEnv("GOOGLE_OAUTH_ACCESS_TOKEN"); ok {
+		return token, nil
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.tokenURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Metadata-Flavor", "Google")
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("could not get access token from the metadata server: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("could not get access token from the metadata server: %s", resp.Status)
+	}
+	var token struct {
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", fmt.Errorf("could not decode access token: %w", err)
+	}
+	return token.AccessToken, nil
+}
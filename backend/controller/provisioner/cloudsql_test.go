@@ -0,0 +1,101 @@
+package provisioner
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+)
+
+func TestCloudSQLProvisioner(t *testing.T) {
+	t.Setenv("GOOGLE_OAUTH_ACCESS_TOKEN", "token")
+	var lock sync.Mutex
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		call := r.Method + " " + r.URL.RequestURI()
+		if r.Body != nil {
+			var body map[string]string
+			if json.NewDecoder(r.Body).Decode(&body) == nil {
+				call += " " + body["name"]
+			}
+		}
+		calls = append(calls, call)
+		switch {
+		case r.URL.Path == "/projects/project/instances/instance":
+			_, _ = w.Write([]byte(`{"ipAddresses": [{"type": "PRIMARY", "ipAddress": "1.2.3.4"}, {"type": "PRIVATE", "ipAddress": "10.0.0.1"}]}`)) //nolint:errcheck
+		case strings.HasPrefix(r.URL.Path, "/projects/project/operations/"):
+			_, _ = w.Write([]byte(`{"name": "op", "status": "DONE"}`)) //nolint:errcheck
+		case r.URL.Path == "/projects/project/instances/instance/users" && r.Method == http.MethodPost:
+			_, _ = w.Write([]byte(`{"name": "op", "status": "DONE"}`)) //nolint:errcheck
+		default:
+			_, _ = w.Write([]byte(`{"name": "op", "status": "PENDING"}`)) //nolint:errcheck
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	p, err := newCloudSQLProvisioner(&url.URL{Scheme: "cloudsql", Host: "project", Path: "/instance"})
+	assert.NoError(t, err)
+	p.api = server.URL
+	p.pollInterval = time.Millisecond
+
+	ctx := context.Background()
+	db, err := p.Provision(ctx, "shop", "orders")
+	assert.NoError(t, err)
+	assert.Equal(t, "ftl_shop_orders", db.Resource)
+	dsn, err := url.Parse(db.DSN)
+	assert.NoError(t, err)
+	assert.Equal(t, "10.0.0.1:5432", dsn.Host)
+	assert.Equal(t, "/ftl_shop_orders", dsn.Path)
+	assert.Equal(t, "ftl_shop_orders", dsn.User.Username())
+
+	assert.NoError(t, p.Deprovision(ctx, db.Resource))
+	assert.Equal(t, []string{
+		"GET /projects/project/instances/instance",
+		"POST /projects/project/instances/instance/databases ftl_shop_orders",
+		"GET /projects/project/operations/op",
+		"POST /projects/project/instances/instance/users ftl_shop_orders",
+		"DELETE /projects/project/instances/instance/databases/ftl_shop_orders",
+		"GET /projects/project/operations/op",
+		"DELETE /projects/project/instances/instance/users?name=ftl_shop_orders",
+		"GET /projects/project/operations/op",
+	}, calls)
+}
+
+func TestCloudSQLProvisionerDeletesDatabaseIfUserFails(t *testing.T) {
+	t.Setenv("GOOGLE_OAUTH_ACCESS_TOKEN", "token")
+	var lock sync.Mutex
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		calls = append(calls, r.Method+" "+r.URL.RequestURI())
+		if r.URL.Path == "/projects/project/instances/instance/users" {
+			http.Error(w, "quota exceeded", http.StatusForbidden)
+			return
+		}
+		_, _ = w.Write([]byte(`{"name": "op", "status": "DONE"}`)) //nolint:errcheck
+	}))
+	t.Cleanup(server.Close)
+
+	p, err := newCloudSQLProvisioner(&url.URL{Scheme: "cloudsql", Host: "project", Path: "/instance", RawQuery: "host=1.2.3.4"})
+	assert.NoError(t, err)
+	p.api = server.URL
+
+	_, err = p.Provision(context.Background(), "shop", "orders")
+	assert.Error(t, err)
+	assert.Equal(t, []string{
+		"POST /projects/project/instances/instance/databases",
+		"POST /projects/project/instances/instance/users",
+		"DELETE /projects/project/instances/instance/databases/ftl_shop_orders",
+	}, calls)
+}
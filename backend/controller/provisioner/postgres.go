@@ -0,0 +1,74 @@
+package provisioner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// postgresProvisioner creates a database and a role owning it for each module
+// database, on a Postgres server.
+type postgresProvisioner struct {
+	key string
+	// admin returns the URL to connect to the server with, as a role that can
+	// create databases and roles.
+	admin func(ctx context.Context) (*url.URL, error)
+}
+
+var _ Provisioner = (*postgresProvisioner)(nil)
+
+func (p *postgresProvisioner) Key() string { return p.key }
+
+func (p *postgresProvisioner) Provision(ctx context.Context, module, name string) (Database, error) {
+	admin, err := p.admin(ctx)
+	if err != nil {
+		return Database{}, err
+	}
+	password, err := generatePassword()
+	if err != nil {
+		return Database{}, err
+	}
+	resource := resourceName(module, name)
+	ident := pgx.Identifier{resource}.Sanitize()
+	if err := p.exec(ctx, admin, fmt.Sprintf("CREATE ROLE %s LOGIN PASSWORD '%s'", ident, password)); err != nil {
+		return Database{}, err
+	}
+	// CREATE DATABASE can't be executed within a transaction, so drop the role
+	// if it fails rather than leaving it behind.
+	if err := p.exec(ctx, admin, fmt.Sprintf("CREATE DATABASE %s OWNER %s", ident, ident)); err != nil {
+		return Database{}, errors.Join(err, p.exec(ctx, admin, fmt.Sprintf("DROP ROLE IF EXISTS %s", ident)))
+	}
+	dsn := *admin
+	dsn.User = url.UserPassword(resource, password)
+	dsn.Path = "/" + resource
+	return Database{DSN: dsn.String(), Resource: resource}, nil
+}
+
+func (p *postgresProvisioner) Deprovision(ctx context.Context, resource string) error {
+	admin, err := p.admin(ctx)
+	if err != nil {
+		return err
+	}
+	ident := pgx.Identifier{resource}.Sanitize()
+	return p.exec(ctx, admin,
+		fmt.Sprintf("DROP DATABASE IF EXISTS %s WITH (FORCE)", ident),
+		fmt.Sprintf("DROP ROLE IF EXISTS %s", ident),
+	)
+}
+
+func (p *postgresProvisioner) exec(ctx context.Context, admin *url.URL, statements ...string) error {
+	conn, err := pgx.Connect(ctx, admin.String())
+	if err != nil {
+		return fmt.Errorf("could not connect to %s: %w", admin.Redacted(), err)
+	}
+	defer conn.Close(ctx) //nolint:errcheck
+	for _, statement := range statements {
+		if _, err := conn.Exec(ctx, statement); err != nil {
+			return fmt.Errorf("could not provision database: %w", err)
+		}
+	}
+	return nil
+}
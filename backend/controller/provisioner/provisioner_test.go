@@ -0,0 +1,125 @@
+package provisioner
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	"github.com/TBD54566975/ftl/backend/schema"
+	cf "github.com/TBD54566975/ftl/common/configuration"
+	"github.com/TBD54566975/ftl/db/dalerrs"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+func TestProvisionModule(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	config := filepath.Join(t.TempDir(), "ftl-project.toml")
+	assert.NoError(t, os.WriteFile(config, nil, 0600))
+	sm, err := cf.New(ctx, cf.ProjectConfigResolver[cf.Secrets]{Config: config}, []cf.Provider[cf.Secrets]{cf.InlineProvider[cf.Secrets]{}})
+	assert.NoError(t, err)
+	ctx = cf.ContextWithSecrets(ctx, sm)
+
+	// "external" is managed outside FTL, so must not be provisioned.
+	err = sm.Set(ctx, "inline", cf.NewRef("shop", "FTL_DSN_SHOP_EXTERNAL"), "postgres://external")
+	assert.NoError(t, err)
+
+	provisioner := &fakeProvisioner{}
+	records := &fakeDAL{databases: map[string]dal.ProvisionedDatabase{}}
+	svc := New(records, provisioner, "inline")
+	module := &schema.Module{Name: "shop", Decls: []schema.Decl{
+		&schema.Database{Type: schema.PostgresDatabaseType, Name: "orders"},
+		&schema.Database{Type: schema.PostgresDatabaseType, Name: "external"},
+	}}
+
+	assert.NoError(t, svc.ProvisionModule(ctx, module))
+	assert.Equal(t, []string{"ftl_shop_orders"}, provisioner.provisioned)
+	assert.Equal(t, map[string]dal.ProvisionedDatabase{
+		"shop.orders": {Module: "shop", Name: "orders", Provisioner: "fake", Resource: "ftl_shop_orders"},
+	}, records.databases)
+	var dsn string
+	assert.NoError(t, sm.Get(ctx, cf.NewRef("shop", "FTL_DSN_SHOP_ORDERS"), &dsn))
+	assert.Equal(t, "postgres://fake/ftl_shop_orders", dsn)
+
+	// Redeploying doesn't provision the database again.
+	assert.NoError(t, svc.ProvisionModule(ctx, module))
+	assert.Equal(t, []string{"ftl_shop_orders"}, provisioner.provisioned)
+
+	assert.NoError(t, svc.Teardown(ctx, "shop", "orders"))
+	assert.Equal(t, []string{"ftl_shop_orders"}, provisioner.deprovisioned)
+	assert.Equal(t, map[string]dal.ProvisionedDatabase{}, records.databases)
+	assert.IsError(t, sm.Get(ctx, cf.NewRef("shop", "FTL_DSN_SHOP_ORDERS"), &dsn), cf.ErrNotFound)
+
+	err = svc.Teardown(ctx, "shop", "external")
+	assert.IsError(t, err, dalerrs.ErrNotFound)
+}
+
+func TestProvisionModuleCleansUpUnrecordedDatabases(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	config := filepath.Join(t.TempDir(), "ftl-project.toml")
+	assert.NoError(t, os.WriteFile(config, nil, 0600))
+	sm, err := cf.New(ctx, cf.ProjectConfigResolver[cf.Secrets]{Config: config}, []cf.Provider[cf.Secrets]{cf.InlineProvider[cf.Secrets]{}})
+	assert.NoError(t, err)
+	ctx = cf.ContextWithSecrets(ctx, sm)
+
+	provisioner := &fakeProvisioner{}
+	records := &fakeDAL{databases: map[string]dal.ProvisionedDatabase{}, createErr: errors.New("database is down")}
+	svc := New(records, provisioner, "inline")
+	module := &schema.Module{Name: "shop", Decls: []schema.Decl{
+		&schema.Database{Type: schema.PostgresDatabaseType, Name: "orders"},
+	}}
+
+	err = svc.ProvisionModule(ctx, module)
+	assert.EqualError(t, err, "could not record provisioned database shop.orders: database is down")
+	assert.Equal(t, []string{"ftl_shop_orders"}, provisioner.deprovisioned)
+	var dsn string
+	assert.IsError(t, sm.Get(ctx, cf.NewRef("shop", "FTL_DSN_SHOP_ORDERS"), &dsn), cf.ErrNotFound)
+}
+
+type fakeProvisioner struct {
+	provisioned   []string
+	deprovisioned []string
+}
+
+func (f *fakeProvisioner) Key() string { return "fake" }
+
+func (f *fakeProvisioner) Provision(ctx context.Context, module, name string) (Database, error) {
+	resource := resourceName(module, name)
+	f.provisioned = append(f.provisioned, resource)
+	return Database{DSN: "postgres://fake/" + resource, Resource: resource}, nil
+}
+
+func (f *fakeProvisioner) Deprovision(ctx context.Context, resource string) error {
+	f.deprovisioned = append(f.deprovisioned, resource)
+	return nil
+}
+
+type fakeDAL struct {
+	databases map[string]dal.ProvisionedDatabase
+	createErr error
+}
+
+func (f *fakeDAL) CreateProvisionedDatabase(ctx context.Context, db dal.ProvisionedDatabase) error {
+	if f.createErr != nil {
+		return f.createErr
+	}
+	f.databases[db.Module+"."+db.Name] = db
+	return nil
+}
+
+func (f *fakeDAL) GetProvisionedDatabase(ctx context.Context, module, name string) (dal.ProvisionedDatabase, error) {
+	db, ok := f.databases[module+"."+name]
+	if !ok {
+		return db, dalerrs.ErrNotFound
+	}
+	return db, nil
+}
+
+func (f *fakeDAL) DeleteProvisionedDatabase(ctx context.Context, module, name string) error {
+	delete(f.databases, module+"."+name)
+	return nil
+}
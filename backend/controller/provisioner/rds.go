@@ -0,0 +1,56 @@
+package provisioner
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+)
+
+// newRDSProvisioner creates a provisioner for the RDS instance at "u".
+//
+// The master user credentials are read from Secrets Manager each time they are
+// needed, because RDS rotates the secrets it manages.
+func newRDSProvisioner(ctx context.Context, u *url.URL) (*postgresProvisioner, error) {
+	if u.Host == "" {
+		return nil, fmt.Errorf("database provisioner URL %q has no host", u.Redacted())
+	}
+	secret := u.Query().Get("secret")
+	if secret == "" {
+		return nil, fmt.Errorf("database provisioner URL %q has no secret holding the master user credentials", u.Redacted())
+	}
+	var options []func(*config.LoadOptions) error
+	if region := u.Query().Get("region"); region != "" {
+		options = append(options, config.WithRegion(region))
+	}
+	cfg, err := config.LoadDefaultConfig(ctx, options...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS configuration: %w", err)
+	}
+	client := secretsmanager.NewFromConfig(cfg)
+	host := u.Host
+	return &postgresProvisioner{key: "rds", admin: func(ctx context.Context) (*url.URL, error) {
+		out, err := client.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{SecretId: aws.String(secret)})
+		if err != nil {
+			return nil, fmt.Errorf("could not get RDS master user credentials: %w", err)
+		}
+		var credentials struct {
+			Username string `json:"username"`
+			Password string `json:"password"`
+		}
+		if err := json.Unmarshal([]byte(aws.ToString(out.SecretString)), &credentials); err != nil {
+			return nil, fmt.Errorf("could not parse RDS master user credentials: %w", err)
+		}
+		return &url.URL{
+			Scheme:   "postgres",
+			User:     url.UserPassword(credentials.Username, credentials.Password),
+			Host:     host,
+			Path:     "/postgres",
+			RawQuery: "sslmode=require",
+		}, nil
+	}}, nil
+}
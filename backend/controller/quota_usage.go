@@ -0,0 +1,41 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"github.com/TBD54566975/ftl/backend/controller/quotas"
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/internal/rpc"
+	"github.com/TBD54566975/ftl/internal/slices"
+)
+
+func (s *Service) GetQuotaUsage(ctx context.Context, req *connect.Request[ftlv1.GetQuotaUsageRequest]) (*connect.Response[ftlv1.GetQuotaUsageResponse], error) {
+	project := rpc.ProjectFromContext(ctx).Default("")
+	if req.Msg.Project != nil {
+		if err := checkProjectScope(ctx, fmt.Sprintf("project %q", *req.Msg.Project), *req.Msg.Project); err != nil {
+			return nil, err
+		}
+		project = *req.Msg.Project
+	}
+	deployments, err := s.dal.GetActiveDeployments(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get active deployments: %w", err)
+	}
+	modules := map[string]quotas.ModuleUsage{}
+	for _, deployment := range deployments {
+		usage := modules[deployment.Module]
+		usage.Project = deploymentProject(deployment.Labels)
+		usage.Replicas += deployment.MinReplicas
+		modules[deployment.Module] = usage
+	}
+	usage := s.quotas.Usage(modules, project, time.Now())
+	return connect.NewResponse(&ftlv1.GetQuotaUsageResponse{
+		Usage: slices.Map(usage, func(u quotas.Usage) *ftlv1.GetQuotaUsageResponse_Usage {
+			return &ftlv1.GetQuotaUsageResponse_Usage{Scope: u.Scope, Quota: u.Quota, Used: int64(u.Used), Limit: int64(u.Limit)}
+		}),
+	}), nil
+}
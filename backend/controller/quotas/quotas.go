@@ -0,0 +1,270 @@
+// Package quotas limits the resources each project and module can use on a
+// shared cluster, so that one team can't starve the others.
+package quotas
+
+import (
+	"fmt"
+	"maps"
+	"sort"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/atomic"
+)
+
+// Default is the key of limits applying to every project or module that
+// doesn't have its own limit.
+const Default = "*"
+
+// Names of quotas.
+const (
+	DeploymentsQuota  = "deployments"
+	ReplicasQuota     = "replicas"
+	ArtefactSizeQuota = "artefact-size"
+	CallRateQuota     = "call-rate"
+)
+
+type Config struct {
+	Deployments  map[string]int `help:"Maximum number of modules each project can have deployed, eg. billing=5, or *=5 for every project." mapsep:"," env:"FTL_CONTROLLER_QUOTA_DEPLOYMENTS" placeholder:"PROJECT=N,…"`
+	Replicas     map[string]int `help:"Maximum total replicas of the deployments of each project." mapsep:"," env:"FTL_CONTROLLER_QUOTA_REPLICAS" placeholder:"PROJECT=N,…"`
+	ArtefactSize map[string]int `help:"Maximum size in bytes of each artefact uploaded for each project." mapsep:"," env:"FTL_CONTROLLER_QUOTA_ARTEFACT_SIZE" placeholder:"PROJECT=BYTES,…"`
+	CallRate     map[string]int `help:"Maximum calls per second to the verbs of each module, enforced by each controller." mapsep:"," env:"FTL_CONTROLLER_QUOTA_CALL_RATE" placeholder:"MODULE=N,…"`
+}
+
+// Usage of a quota by a project or module.
+type Usage struct {
+	// Project or module the quota applies to.
+	Scope string
+	Quota string
+	Used  int
+	Limit int
+}
+
+// Quotas enforces the limits in a [Config].
+type Quotas struct {
+	// The limits of the configuration with the overrides applied.
+	config atomic.Value[Config]
+
+	configLock sync.Mutex
+	base       Config
+	overrides  Config
+
+	lock sync.Mutex
+	// Calls to each module in the current one second window.
+	calls       map[string]int
+	windowStart time.Time
+}
+
+func New(config Config) *Quotas {
+	q := &Quotas{calls: map[string]int{}, base: config}
+	q.config.Store(config)
+	return q
+}
+
+// SetConfig replaces the limits enforced by the quotas.
+//
+// Calls already made in the current one second window count towards the new
+// call rate limits.
+func (q *Quotas) SetConfig(config Config) {
+	q.configLock.Lock()
+	defer q.configLock.Unlock()
+	q.base = config
+	q.config.Store(q.base.withOverrides(q.overrides))
+}
+
+// SetOverrides replaces the limits that override those of the [Config], eg.
+// those set with the admin API.
+//
+// An override replaces the limit of the configuration for the same project or
+// module, including the default limit of [Default].
+func (q *Quotas) SetOverrides(overrides Config) {
+	q.configLock.Lock()
+	defer q.configLock.Unlock()
+	q.overrides = overrides
+	q.config.Store(q.base.withOverrides(q.overrides))
+}
+
+// SetLimit sets the limit of "quota" for a project or module.
+func (c *Config) SetLimit(quota, scope string, limit int) error {
+	var limits *map[string]int
+	switch quota {
+	case DeploymentsQuota:
+		limits = &c.Deployments
+	case ReplicasQuota:
+		limits = &c.Replicas
+	case ArtefactSizeQuota:
+		limits = &c.ArtefactSize
+	case CallRateQuota:
+		limits = &c.CallRate
+	default:
+		return fmt.Errorf("unknown quota %q", quota)
+	}
+	if *limits == nil {
+		*limits = map[string]int{}
+	}
+	(*limits)[scope] = limit
+	return nil
+}
+
+func (c Config) withOverrides(overrides Config) Config {
+	merge := func(base, overrides map[string]int) map[string]int {
+		if len(overrides) == 0 {
+			return base
+		}
+		out := make(map[string]int, len(base)+len(overrides))
+		maps.Copy(out, base)
+		maps.Copy(out, overrides)
+		return out
+	}
+	return Config{
+		Deployments:  merge(c.Deployments, overrides.Deployments),
+		Replicas:     merge(c.Replicas, overrides.Replicas),
+		ArtefactSize: merge(c.ArtefactSize, overrides.ArtefactSize),
+		CallRate:     merge(c.CallRate, overrides.CallRate),
+	}
+}
+
+// limitFor returns the limit for "key" in "limits", or false if it is unlimited.
+func limitFor(limits map[string]int, key string) (int, bool) {
+	if limit, ok := limits[key]; ok {
+		return limit, true
+	}
+	limit, ok := limits[Default]
+	return limit, ok
+}
+
+func exceeded(format string, args ...any) error {
+	return connect.NewError(connect.CodeResourceExhausted, fmt.Errorf(format, args...))
+}
+
+// CheckDeployments returns an error if deploying "module" would exceed the
+// number of modules "project" can have deployed, given the modules it has
+// deployed already.
+func (q *Quotas) CheckDeployments(project, module string, deployed []string) error {
+	limit, ok := limitFor(q.config.Load().Deployments, project)
+	if !ok {
+		return nil
+	}
+	for _, existing := range deployed {
+		if existing == module {
+			return nil
+		}
+	}
+	if len(deployed) < limit {
+		return nil
+	}
+	return exceeded("project %q has %d of its quota of %d modules deployed and can not deploy module %q", project, len(deployed), limit, module)
+}
+
+// CheckReplicas returns an error if running "minReplicas" replicas of
+// "module" would exceed the replica quota of "project".
+//
+// "used" is the replicas of the project's other modules and "current" those of
+// "module", which are replaced. Reducing the replicas of a module is always
+// allowed.
+func (q *Quotas) CheckReplicas(project, module string, used, current, minReplicas int) error {
+	limit, ok := limitFor(q.config.Load().Replicas, project)
+	if !ok || minReplicas <= current || used+minReplicas <= limit {
+		return nil
+	}
+	return exceeded("project %q is using %d of its quota of %d replicas and can not run %d replicas of module %q", project, used, limit, minReplicas, module)
+}
+
+// CheckArtefactSize returns an error if an artefact of "size" bytes is too
+// large to upload for "project".
+func (q *Quotas) CheckArtefactSize(project string, size int) error {
+	limit, ok := limitFor(q.config.Load().ArtefactSize, project)
+	if !ok || size <= limit {
+		return nil
+	}
+	return exceeded("artefact of %d bytes exceeds the limit of %d bytes for project %q", size, limit, project)
+}
+
+// ArtefactSizeLimit returns the maximum size in bytes of artefacts uploaded
+// for "project", if it is limited.
+func (q *Quotas) ArtefactSizeLimit(project string) (int, bool) {
+	return limitFor(q.config.Load().ArtefactSize, project)
+}
+
+// AllowCall records a call to a verb of "module", returning an error if the
+// module has exceeded its call rate in the current second.
+func (q *Quotas) AllowCall(module string, now time.Time) error {
+	limit, ok := limitFor(q.config.Load().CallRate, module)
+	if !ok {
+		return nil
+	}
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	q.advanceWindow(now)
+	if q.calls[module] >= limit {
+		return exceeded("module %q has exceeded its quota of %d calls per second", module, limit)
+	}
+	q.calls[module]++
+	return nil
+}
+
+func (q *Quotas) advanceWindow(now time.Time) {
+	if now.Sub(q.windowStart) >= time.Second {
+		q.windowStart = now.Truncate(time.Second)
+		clear(q.calls)
+	}
+}
+
+// Usage returns the usage of each limited quota of "modules", which map each
+// deployed module to its project and replicas, ordered by scope and quota.
+//
+// If "project" is not empty only the quotas of that project and its modules
+// are reported.
+func (q *Quotas) Usage(modules map[string]ModuleUsage, project string, now time.Time) []Usage {
+	config := q.config.Load()
+	deployments := map[string]int{}
+	replicas := map[string]int{}
+	for _, usage := range modules {
+		deployments[usage.Project]++
+		replicas[usage.Project] += usage.Replicas
+	}
+	for _, limits := range []map[string]int{config.Deployments, config.Replicas} {
+		for scope := range limits {
+			if _, ok := deployments[scope]; !ok && scope != Default {
+				deployments[scope] = 0
+			}
+		}
+	}
+	var out []Usage
+	for scope := range deployments {
+		if project != "" && scope != project {
+			continue
+		}
+		if limit, ok := limitFor(config.Deployments, scope); ok {
+			out = append(out, Usage{Scope: scope, Quota: DeploymentsQuota, Used: deployments[scope], Limit: limit})
+		}
+		if limit, ok := limitFor(config.Replicas, scope); ok {
+			out = append(out, Usage{Scope: scope, Quota: ReplicasQuota, Used: replicas[scope], Limit: limit})
+		}
+	}
+	q.lock.Lock()
+	q.advanceWindow(now)
+	for module, usage := range modules {
+		if project != "" && usage.Project != project {
+			continue
+		}
+		if limit, ok := limitFor(config.CallRate, module); ok {
+			out = append(out, Usage{Scope: module, Quota: CallRateQuota, Used: q.calls[module], Limit: limit})
+		}
+	}
+	q.lock.Unlock()
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Scope != out[j].Scope {
+			return out[i].Scope < out[j].Scope
+		}
+		return out[i].Quota < out[j].Quota
+	})
+	return out
+}
+
+// ModuleUsage is the resources used by a deployed module.
+type ModuleUsage struct {
+	Project  string
+	Replicas int
+}
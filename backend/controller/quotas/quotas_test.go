@@ -0,0 +1,104 @@
+package quotas
+
+import (
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/assert/v2"
+)
+
+func TestCheckLimits(t *testing.T) {
+	q := New(Config{
+		Deployments:  map[string]int{"billing": 2, Default: 1},
+		Replicas:     map[string]int{"billing": 4},
+		ArtefactSize: map[string]int{Default: 100},
+	})
+
+	assert.NoError(t, q.CheckDeployments("billing", "payments", []string{"invoices"}))
+	assert.NoError(t, q.CheckDeployments("billing", "invoices", []string{"invoices", "payments"}), "replacing a module should not count against the quota")
+	err := q.CheckDeployments("billing", "refunds", []string{"invoices", "payments"})
+	assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+	assert.Error(t, q.CheckDeployments("accounts", "sessions", []string{"users"}), "the default limit should apply to other projects")
+
+	assert.NoError(t, q.CheckReplicas("billing", "invoices", 2, 1, 2))
+	assert.EqualError(t, q.CheckReplicas("billing", "invoices", 2, 1, 3),
+		`resource_exhausted: project "billing" is using 2 of its quota of 4 replicas and can not run 3 replicas of module "invoices"`)
+	assert.NoError(t, q.CheckReplicas("billing", "invoices", 5, 3, 2), "reducing replicas should always be allowed")
+	assert.NoError(t, q.CheckReplicas("accounts", "users", 100, 0, 100))
+
+	assert.NoError(t, q.CheckArtefactSize("billing", 100))
+	assert.Error(t, q.CheckArtefactSize("", 101))
+	limit, ok := q.ArtefactSizeLimit("billing")
+	assert.True(t, ok)
+	assert.Equal(t, 100, limit)
+	_, ok = New(Config{}).ArtefactSizeLimit("billing")
+	assert.False(t, ok)
+}
+
+func TestCallRate(t *testing.T) {
+	q := New(Config{CallRate: map[string]int{"echo": 2}})
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.NoError(t, q.AllowCall("echo", now))
+	assert.NoError(t, q.AllowCall("echo", now.Add(time.Millisecond*100)))
+	assert.Error(t, q.AllowCall("echo", now.Add(time.Millisecond*200)))
+	assert.NoError(t, q.AllowCall("time", now.Add(time.Millisecond*200)), "modules without a limit should not be rate limited")
+
+	usage := q.Usage(map[string]ModuleUsage{"echo": {Project: "demo", Replicas: 1}}, "", now.Add(time.Millisecond*300))
+	assert.Equal(t, []Usage{{Scope: "echo", Quota: CallRateQuota, Used: 2, Limit: 2}}, usage)
+
+	assert.NoError(t, q.AllowCall("echo", now.Add(time.Second)), "calls should be allowed again in the next window")
+}
+
+func TestSetConfig(t *testing.T) {
+	q := New(Config{CallRate: map[string]int{"echo": 1}})
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.NoError(t, q.AllowCall("echo", now))
+	assert.Error(t, q.AllowCall("echo", now))
+
+	q.SetConfig(Config{CallRate: map[string]int{"echo": 2}, Deployments: map[string]int{Default: 1}})
+	assert.NoError(t, q.AllowCall("echo", now), "calls already made in the window should count towards the new limit")
+	assert.Error(t, q.AllowCall("echo", now))
+	assert.Error(t, q.CheckDeployments("demo", "time", []string{"echo"}))
+}
+
+func TestUsage(t *testing.T) {
+	q := New(Config{
+		Deployments: map[string]int{"billing": 2, "accounts": 3},
+		Replicas:    map[string]int{"billing": 4},
+	})
+	modules := map[string]ModuleUsage{
+		"invoices": {Project: "billing", Replicas: 1},
+		"payments": {Project: "billing", Replicas: 2},
+	}
+	assert.Equal(t, []Usage{
+		{Scope: "accounts", Quota: DeploymentsQuota, Used: 0, Limit: 3},
+		{Scope: "billing", Quota: DeploymentsQuota, Used: 2, Limit: 2},
+		{Scope: "billing", Quota: ReplicasQuota, Used: 3, Limit: 4},
+	}, q.Usage(modules, "", time.Now()))
+	assert.Equal(t, []Usage{
+		{Scope: "billing", Quota: DeploymentsQuota, Used: 2, Limit: 2},
+		{Scope: "billing", Quota: ReplicasQuota, Used: 3, Limit: 4},
+	}, q.Usage(modules, "billing", time.Now()))
+}
+
+func TestOverrides(t *testing.T) {
+	q := New(Config{Replicas: map[string]int{"billing": 4, Default: 2}})
+	overrides := Config{}
+	assert.NoError(t, overrides.SetLimit(ReplicasQuota, "billing", 1))
+	assert.NoError(t, overrides.SetLimit(CallRateQuota, "echo", 1))
+	assert.Error(t, overrides.SetLimit("bogus", "echo", 1))
+	q.SetOverrides(overrides)
+
+	assert.Error(t, q.CheckReplicas("billing", "invoices", 0, 0, 2), "override should replace the configured limit")
+	assert.NoError(t, q.CheckReplicas("accounts", "users", 0, 0, 2), "configured limits without an override should apply")
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.NoError(t, q.AllowCall("echo", now))
+	assert.Error(t, q.AllowCall("echo", now))
+
+	// Overrides survive reloads of the configuration.
+	q.SetConfig(Config{Replicas: map[string]int{"billing": 8}})
+	assert.Error(t, q.CheckReplicas("billing", "invoices", 0, 0, 2))
+	q.SetOverrides(Config{})
+	assert.NoError(t, q.CheckReplicas("billing", "invoices", 0, 0, 2))
+}
@@ -0,0 +1,231 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/types/optional"
+	"github.com/jpillora/backoff"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+// ReconcileConfig configures how deployments that repeatedly fail to reconcile are retried.
+type ReconcileConfig struct {
+	BackoffMin    time.Duration `help:"Delay before retrying a deployment after its first reconciliation failure." default:"1s" env:"FTL_CONTROLLER_RECONCILE_BACKOFF_MIN"`
+	BackoffMax    time.Duration `help:"Maximum delay before retrying a deployment that keeps failing to reconcile." default:"1m" env:"FTL_CONTROLLER_RECONCILE_BACKOFF_MAX"`
+	BackoffJitter bool          `help:"Randomise reconciliation retry delays, so that deployments failing together don't retry together." default:"true" negatable:"" env:"FTL_CONTROLLER_RECONCILE_BACKOFF_JITTER"`
+
+	CrashLoopThreshold int           `help:"Number of consecutive crashes after which a deployment is reported as unhealthy." default:"3" env:"FTL_CONTROLLER_RECONCILE_CRASH_LOOP_THRESHOLD"`
+	CrashResetAfter    time.Duration `help:"How long a deployment must run without crashing for its earlier crashes to be forgotten." default:"10m" env:"FTL_CONTROLLER_RECONCILE_CRASH_RESET_AFTER"`
+}
+
+func (c ReconcileConfig) validate() error {
+	if c.BackoffMin <= 0 {
+		return fmt.Errorf("reconciliation backoff minimum must be positive, got %s", c.BackoffMin)
+	}
+	if c.BackoffMax < c.BackoffMin {
+		return fmt.Errorf("reconciliation backoff maximum %s is less than the minimum %s", c.BackoffMax, c.BackoffMin)
+	}
+	if c.CrashLoopThreshold <= 0 {
+		return fmt.Errorf("crash loop threshold must be positive, got %d", c.CrashLoopThreshold)
+	}
+	if c.CrashResetAfter <= 0 {
+		return fmt.Errorf("crash reset delay must be positive, got %s", c.CrashResetAfter)
+	}
+	return nil
+}
+
+// crashRestartDelay returns how long to wait before replacing the replicas of
+// a deployment that crashed "crashes" consecutive times.
+func (c ReconcileConfig) crashRestartDelay(crashes int) time.Duration {
+	if crashes <= 0 {
+		return 0
+	}
+	b := backoff.Backoff{Min: c.BackoffMin, Max: c.BackoffMax, Factor: 2}
+	return b.ForAttempt(float64(crashes - 1))
+}
+
+// crashRestartAt returns when the crashed replicas of a deployment can be
+// replaced, which is immediately if it hasn't crashed recently.
+func (c ReconcileConfig) crashRestartAt(crashes int, lastCrashedAt optional.Option[time.Time]) time.Time {
+	last, ok := lastCrashedAt.Get()
+	if !ok {
+		return time.Time{}
+	}
+	return last.Add(c.crashRestartDelay(crashes))
+}
+
+// unhealthy returns true if a deployment that crashed "crashes" consecutive
+// times, most recently at "lastCrashedAt", is crash looping at "now".
+func (c ReconcileConfig) unhealthy(crashes int, lastCrashedAt optional.Option[time.Time], now time.Time) bool {
+	last, ok := lastCrashedAt.Get()
+	return ok && crashes >= c.CrashLoopThreshold && now.Sub(last) < c.CrashResetAfter
+}
+
+// Number of consecutive failures to reconcile a deployment after which the
+// failures are logged as errors and webhooks are notified.
+const reconcileFailureThreshold = 5
+
+// reconcileBackoff tracks consecutive failures to reconcile each deployment,
+// so that deployments that keep failing are retried with exponential backoff
+// rather than on every pass of the reconciliation loop.
+type reconcileBackoff struct {
+	config   ReconcileConfig
+	lock     sync.Mutex
+	failures map[string]*reconcileFailures
+}
+
+type reconcileFailures struct {
+	module  string
+	count   int
+	retryAt time.Time
+}
+
+func newReconcileBackoff(config ReconcileConfig) *reconcileBackoff {
+	return &reconcileBackoff{config: config, failures: map[string]*reconcileFailures{}}
+}
+
+// setConfig replaces the backoff parameters of failures recorded from now on.
+func (r *reconcileBackoff) setConfig(config ReconcileConfig) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.config = config
+}
+
+// ready returns true if "deployment" is not backing off at "now".
+func (r *reconcileBackoff) ready(deployment model.DeploymentKey, now time.Time) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	failures, ok := r.failures[deployment.String()]
+	return !ok || !now.Before(failures.retryAt)
+}
+
+// failed records a failure to reconcile a deployment at "now", returning the
+// number of consecutive failures and how long to wait before retrying.
+func (r *reconcileBackoff) failed(ctx context.Context, reconcile dal.Reconciliation, now time.Time) (int, time.Duration) {
+	reconcileFailureCounter.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("ftl.deployment.key", reconcile.Deployment.String()),
+		attribute.String("ftl.module.name", reconcile.Module),
+	))
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	failures, ok := r.failures[reconcile.Deployment.String()]
+	if !ok {
+		failures = &reconcileFailures{module: reconcile.Module}
+		r.failures[reconcile.Deployment.String()] = failures
+	}
+	failures.count++
+	b := backoff.Backoff{Min: r.config.BackoffMin, Max: r.config.BackoffMax, Factor: 2, Jitter: r.config.BackoffJitter}
+	delay := b.ForAttempt(float64(failures.count - 1))
+	failures.retryAt = now.Add(delay)
+	return failures.count, delay
+}
+
+// succeeded clears the failures of "deployment".
+func (r *reconcileBackoff) succeeded(deployment model.DeploymentKey) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delete(r.failures, deployment.String())
+}
+
+// retain clears the failures of deployments other than "deployments", which
+// can happen if a deployment was removed before successfully reconciling.
+func (r *reconcileBackoff) retain(deployments []dal.Reconciliation) {
+	keep := map[string]bool{}
+	for _, reconcile := range deployments {
+		keep[reconcile.Deployment.String()] = true
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for key := range r.failures {
+		if !keep[key] {
+			delete(r.failures, key)
+		}
+	}
+}
+
+// reset clears the failures of every deployment of "module".
+func (r *reconcileBackoff) reset(module string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for key, failures := range r.failures {
+		if failures.module == module {
+			delete(r.failures, key)
+		}
+	}
+}
+
+func (s *Service) Reconcile(ctx context.Context, req *connect.Request[ftlv1.ReconcileRequest]) (*connect.Response[ftlv1.ReconcileResponse], error) {
+	if err := s.checkModuleScope(ctx, req.Msg.Module); err != nil {
+		return nil, err
+	}
+	reconciliation, err := s.dal.GetDeploymentsNeedingReconciliation(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deployments needing reconciliation: %w", err)
+	}
+	s.reconcileBackoff.reset(req.Msg.Module)
+	if err := s.dal.ResetDeploymentCrashes(ctx, req.Msg.Module); err != nil {
+		return nil, fmt.Errorf("failed to reset deployment crashes: %w", err)
+	}
+	out := &ftlv1.ReconcileResponse{}
+	for _, reconcile := range reconciliation {
+		if reconcile.Module != req.Msg.Module {
+			continue
+		}
+		deployment := &ftlv1.ReconcileResponse_Deployment{
+			DeploymentKey:    reconcile.Deployment.String(),
+			AssignedReplicas: int32(reconcile.AssignedReplicas),
+			RequiredReplicas: int32(reconcile.RequiredReplicas),
+		}
+		s.getDeploymentLogger(ctx, reconcile.Deployment).Infof("Manually reconciling %s", reconcile.Deployment)
+		if err := s.reconcileDeployment(ctx, reconcile); err != nil {
+			message := err.Error()
+			deployment.Error = &message
+		}
+		out.Deployments = append(out.Deployments, deployment)
+	}
+	return connect.NewResponse(out), nil
+}
+
+var reconcileFailureCounter = func() metric.Int64Counter {
+	counter, err := otel.GetMeterProvider().Meter("ftl.controller").Int64Counter("ftl.deployment.reconcile.failures",
+		metric.WithDescription("number of failed attempts to reconcile the replicas of a deployment"),
+		metric.WithUnit("{count}"))
+	if err != nil {
+		panic(err)
+	}
+	return counter
+}()
+
+// registerReconcileMetrics exposes the consecutive reconciliation failures of
+// each deployment that is backing off as a gauge.
+func (s *Service) registerReconcileMetrics() error {
+	meter := otel.GetMeterProvider().Meter("ftl.controller")
+	consecutiveFailures, err := meter.Int64ObservableGauge("ftl.deployment.reconcile.consecutive_failures",
+		metric.WithDescription("number of consecutive failures to reconcile the replicas of a deployment"),
+		metric.WithUnit("{count}"))
+	if err != nil {
+		return err
+	}
+	_, err = meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
+		s.reconcileBackoff.lock.Lock()
+		defer s.reconcileBackoff.lock.Unlock()
+		for key, failures := range s.reconcileBackoff.failures {
+			observer.ObserveInt64(consecutiveFailures, int64(failures.count), metric.WithAttributes(
+				attribute.String("ftl.deployment.key", key),
+				attribute.String("ftl.module.name", failures.module),
+			))
+		}
+		return nil
+	}, consecutiveFailures)
+	return err
+}
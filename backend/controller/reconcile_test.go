@@ -0,0 +1,63 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+func TestReconcileBackoff(t *testing.T) {
+	ctx := context.Background()
+	r := newReconcileBackoff(ReconcileConfig{BackoffMin: time.Second, BackoffMax: time.Second * 5})
+	echo := dal.Reconciliation{Deployment: model.NewDeploymentKey("echo"), Module: "echo"}
+	other := dal.Reconciliation{Deployment: model.NewDeploymentKey("other"), Module: "other"}
+	now := time.Now()
+
+	assert.True(t, r.ready(echo.Deployment, now))
+	delays := []time.Duration{}
+	for range 5 {
+		_, delay := r.failed(ctx, echo, now)
+		delays = append(delays, delay)
+	}
+	assert.Equal(t, []time.Duration{time.Second, time.Second * 2, time.Second * 4, time.Second * 5, time.Second * 5}, delays)
+	assert.False(t, r.ready(echo.Deployment, now.Add(time.Second*4)))
+	assert.True(t, r.ready(echo.Deployment, now.Add(time.Second*5)))
+
+	r.succeeded(echo.Deployment)
+	count, delay := r.failed(ctx, echo, now)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, time.Second, delay)
+
+	r.failed(ctx, other, now)
+	r.reset("echo")
+	assert.True(t, r.ready(echo.Deployment, now))
+	assert.False(t, r.ready(other.Deployment, now))
+
+	r.retain(nil)
+	assert.True(t, r.ready(other.Deployment, now))
+}
+
+func TestCrashRestartBackoff(t *testing.T) {
+	config := ReconcileConfig{BackoffMin: time.Second, BackoffMax: time.Second * 5, CrashLoopThreshold: 3, CrashResetAfter: time.Minute}
+	now := time.Now()
+
+	delays := []time.Duration{}
+	for crashes := range 5 {
+		delays = append(delays, config.crashRestartDelay(crashes))
+	}
+	assert.Equal(t, []time.Duration{0, time.Second, time.Second * 2, time.Second * 4, time.Second * 5}, delays)
+
+	assert.Equal(t, time.Time{}, config.crashRestartAt(0, optional.None[time.Time]()))
+	assert.Equal(t, now.Add(time.Second*2), config.crashRestartAt(2, optional.Some(now)))
+
+	assert.False(t, config.unhealthy(0, optional.None[time.Time](), now))
+	assert.False(t, config.unhealthy(2, optional.Some(now), now))
+	assert.True(t, config.unhealthy(3, optional.Some(now), now.Add(time.Second*59)))
+	assert.False(t, config.unhealthy(3, optional.Some(now), now.Add(time.Minute)))
+}
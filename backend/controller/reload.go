@@ -0,0 +1,82 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+// withReloadable returns "dst" with the fields of "src" that can be changed
+// while the controller is running.
+//
+// Everything else, such as the sockets the controller listens on and its
+// database, is only read when the controller starts.
+func withReloadable(dst, src Config) Config {
+	dst.IngressJWTSecret = src.IngressJWTSecret
+	dst.IngressAccessLog = src.IngressAccessLog
+	dst.CallPayload = src.CallPayload
+	dst.RunnerDrainTimeout = src.RunnerDrainTimeout
+	dst.DeploymentReservationTimeout = src.DeploymentReservationTimeout
+	dst.ModuleUpdateFrequency = src.ModuleUpdateFrequency
+	dst.ArtefactChunkSize = src.ArtefactChunkSize
+	dst.PrefetchWindow = src.PrefetchWindow
+	dst.SLO = src.SLO
+	dst.Reconcile = src.Reconcile
+	dst.Webhooks = src.Webhooks
+	dst.IdleRunners = src.IdleRunners
+	dst.Quota = src.Quota
+	return dst
+}
+
+// Reload applies the reloadable fields of "config" to the running controller,
+// without interrupting calls or the streams of runners.
+//
+// Changes to any other field are logged and ignored until the controller is
+// restarted. If "config" is invalid the controller is left unchanged.
+func (s *Service) Reload(ctx context.Context, config Config) error {
+	logger := log.FromContext(ctx)
+	config.SetDefaults()
+	overrideDevelDefaults(&config, s.runnerScaling)
+	if err := config.SLO.validate(); err != nil {
+		return err
+	}
+	if err := config.Reconcile.validate(); err != nil {
+		return err
+	}
+	if err := config.IngressAccessLog.Validate(); err != nil {
+		return err
+	}
+	current := s.config.Load()
+	if ignored := withReloadable(config, current); !reflect.DeepEqual(ignored, current) {
+		logger.Warnf("Some configuration changes will only take effect when the controller restarts")
+	}
+	next := withReloadable(current, config)
+	// The webhooks file is reloaded even if its path is unchanged.
+	if err := s.webhooks.Reload(ctx, next.Webhooks); err != nil {
+		return fmt.Errorf("failed to reload webhooks: %w", err)
+	}
+	s.crashLoops.SetConfig(next.Webhooks)
+	s.quotas.SetConfig(next.Quota)
+	s.reconcileBackoff.setConfig(next.Reconcile)
+	s.config.Store(next)
+	logger.Infof("Reloaded controller configuration")
+	return nil
+}
+
+// watchReloads applies each configuration received from "reloads" until the
+// context is cancelled.
+func (s *Service) watchReloads(ctx context.Context, reloads <-chan Config) {
+	logger := log.FromContext(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case config := <-reloads:
+			if err := s.Reload(ctx, config); err != nil {
+				logger.Errorf(err, "Failed to reload controller configuration, keeping the current configuration")
+			}
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package controller
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/backend/controller/quotas"
+	"github.com/TBD54566975/ftl/backend/controller/webhooks"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+func TestReload(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	config := Config{}
+	config.SetDefaults()
+	notifier, err := webhooks.New(ctx, config.Webhooks)
+	assert.NoError(t, err)
+	s := &Service{
+		reconcileBackoff: newReconcileBackoff(config.Reconcile),
+		quotas:           quotas.New(config.Quota),
+		webhooks:         notifier,
+		crashLoops:       webhooks.NewCrashLoopDetector(config.Webhooks),
+	}
+	s.config.Store(config)
+
+	next := Config{
+		Bind:          &url.URL{Scheme: "http", Host: "localhost:9999"},
+		RunnerTimeout: time.Minute,
+		CommonConfig: CommonConfig{
+			IdleRunners: 5,
+			Quota:       quotas.Config{CallRate: map[string]int{"echo": 1}},
+		},
+	}
+	next.SLO.Window = time.Minute
+	assert.NoError(t, s.Reload(ctx, next))
+	reloaded := s.config.Load()
+	assert.Equal(t, 5, reloaded.IdleRunners)
+	assert.Equal(t, time.Minute, reloaded.SLO.Window)
+	assert.Equal(t, config.Bind, reloaded.Bind, "the bind address can only change on restart")
+	assert.Equal(t, config.RunnerTimeout, reloaded.RunnerTimeout, "the runner timeout can only change on restart")
+	assert.NoError(t, s.quotas.AllowCall("echo", time.Now()))
+	assert.Error(t, s.quotas.AllowCall("echo", time.Now()))
+
+	invalid := next
+	invalid.IdleRunners = 1
+	invalid.SLO.Window = -time.Minute
+	assert.Error(t, s.Reload(ctx, invalid))
+	assert.Equal(t, 5, s.config.Load().IdleRunners, "an invalid configuration should not be applied")
+}
@@ -0,0 +1,126 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/db/dalerrs"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+func (s *Service) GetSaga(ctx context.Context, req *connect.Request[ftlv1.GetSagaRequest]) (*connect.Response[ftlv1.GetSagaResponse], error) {
+	fsm := &schema.FSM{}
+	if err := s.schema.Load().ResolveToType(schema.RefFromProto(req.Msg.Saga), fsm); err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("saga not found: %w", err))
+	}
+	saga, ok := fsm.GetMetadataSaga().Get()
+	if !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("fsm %s is not a saga", req.Msg.Saga))
+	}
+	instance, err := s.dal.GetFSMInstance(ctx, schema.RefFromProto(req.Msg.Saga).ToRefKey(), req.Msg.Instance)
+	if errors.Is(err, dalerrs.ErrNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("saga instance %q not found", req.Msg.Instance))
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get saga instance: %w", err)
+	}
+	return connect.NewResponse(&ftlv1.GetSagaResponse{
+		Status:         sagaStatus(saga, instance),
+		CurrentState:   sagaStateToProto(instance.CurrentState),
+		ExecutingState: sagaStateToProto(instance.DestinationState),
+	}), nil
+}
+
+func sagaStateToProto(state optional.Option[schema.RefKey]) *schemapb.Ref {
+	if key, ok := state.Get(); ok {
+		return key.ToProto()
+	}
+	return nil
+}
+
+// sagaStatus derives the progress of a saga from the state of its FSM instance.
+func sagaStatus(saga *schema.MetadataSaga, instance dal.FSMInstanceState) ftlv1.GetSagaResponse_Status {
+	isCompensation := func(state optional.Option[schema.RefKey]) bool {
+		key, ok := state.Get()
+		return ok && saga.CompensationIndex(key) >= 0
+	}
+	switch instance.Status {
+	case dal.FSMStatusFailed:
+		return ftlv1.GetSagaResponse_STATUS_FAILED
+	case dal.FSMStatusCompleted:
+		if isCompensation(instance.CurrentState) {
+			return ftlv1.GetSagaResponse_STATUS_COMPENSATED
+		}
+		return ftlv1.GetSagaResponse_STATUS_COMPLETED
+	case dal.FSMStatusRunning:
+		if isCompensation(instance.CurrentState) || isCompensation(instance.DestinationState) {
+			return ftlv1.GetSagaResponse_STATUS_COMPENSATING
+		}
+		return ftlv1.GetSagaResponse_STATUS_RUNNING
+	default:
+		return ftlv1.GetSagaResponse_STATUS_UNSPECIFIED
+	}
+}
+
+// onSagaCallCompletion progresses a saga once the call to one of its steps or
+// compensations has completed, and will not be retried.
+//
+// Each step and compensation is called with the request the saga was started
+// with.
+func (s *Service) onSagaCallCompletion(ctx context.Context, tx *dal.Tx, fsm *schema.FSM, saga *schema.MetadataSaga, instance *dal.FSMInstance, request json.RawMessage, failed bool) error {
+	logger := log.FromContext(ctx).Scope(instance.FSM.String())
+
+	destinationState, _ := instance.DestinationState.Get()
+	var next *schema.Ref
+	if failed {
+		compensation, ok := saga.Compensate(instance.CurrentState).Get()
+		if saga.CompensationIndex(destinationState) >= 0 || !ok {
+			logger.Warnf("Saga %s failed calling %s", instance.FSM, destinationState)
+			if err := tx.FailFSMInstance(ctx, instance.FSM, instance.Key); err != nil {
+				return fmt.Errorf("failed to fail saga instance: %w", err)
+			}
+			return nil
+		}
+		logger.Warnf("Saga %s failed calling %s, compensating with %s", instance.FSM, destinationState, compensation)
+		if err := tx.AbortFSMTransition(ctx, instance.FSM, instance.Key); err != nil {
+			return fmt.Errorf("failed to abort saga transition: %w", err)
+		}
+		next = compensation
+	} else {
+		step, ok := saga.Next(destinationState).Get()
+		if !ok {
+			logger.Debugf("Saga reached final state %s", destinationState)
+			if err := tx.SucceedFSMInstance(ctx, instance.FSM, instance.Key); err != nil {
+				return fmt.Errorf("failed to succeed saga instance: %w", err)
+			}
+			return nil
+		}
+		if err := tx.FinishFSMTransition(ctx, instance.FSM, instance.Key); err != nil {
+			return fmt.Errorf("failed to complete saga transition: %w", err)
+		}
+		next = step
+	}
+
+	verb := &schema.Verb{}
+	if err := s.schema.Load().ResolveToType(next, verb); err != nil {
+		return fmt.Errorf("saga: state %s not found: %w", next, err)
+	}
+	retryParams, err := schema.RetryParamsForFSMTransition(fsm, verb)
+	if err != nil {
+		return err
+	}
+	err = tx.StartFSMTransition(ctx, instance.FSM, instance.Key, next.ToRefKey(), request, retryParams, optional.None[model.RequestKey]())
+	if err != nil {
+		return fmt.Errorf("could not start saga transition: %w", err)
+	}
+	return nil
+}
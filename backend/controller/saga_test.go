@@ -0,0 +1,45 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+func TestSagaStatus(t *testing.T) {
+	ref := func(name string) *schema.Ref { return &schema.Ref{Module: "shop", Name: name} }
+	state := func(name string) optional.Option[schema.RefKey] { return optional.Some(ref(name).ToRefKey()) }
+	none := optional.None[schema.RefKey]()
+	saga := &schema.MetadataSaga{Steps: []*schema.SagaStep{
+		{Verb: ref("reserve"), Compensation: ref("release")},
+		{Verb: ref("charge"), Compensation: ref("refund")},
+		{Verb: ref("ship")},
+	}}
+
+	tests := []struct {
+		name        string
+		status      dal.FSMStatus
+		current     optional.Option[schema.RefKey]
+		destination optional.Option[schema.RefKey]
+		expected    ftlv1.GetSagaResponse_Status
+	}{
+		{"Starting", dal.FSMStatusRunning, none, state("reserve"), ftlv1.GetSagaResponse_STATUS_RUNNING},
+		{"Running", dal.FSMStatusRunning, state("reserve"), state("charge"), ftlv1.GetSagaResponse_STATUS_RUNNING},
+		{"Completed", dal.FSMStatusCompleted, state("ship"), none, ftlv1.GetSagaResponse_STATUS_COMPLETED},
+		{"StartCompensating", dal.FSMStatusRunning, state("charge"), state("refund"), ftlv1.GetSagaResponse_STATUS_COMPENSATING},
+		{"Compensating", dal.FSMStatusRunning, state("refund"), state("release"), ftlv1.GetSagaResponse_STATUS_COMPENSATING},
+		{"Compensated", dal.FSMStatusCompleted, state("release"), none, ftlv1.GetSagaResponse_STATUS_COMPENSATED},
+		{"Failed", dal.FSMStatusFailed, none, state("release"), ftlv1.GetSagaResponse_STATUS_FAILED},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := sagaStatus(saga, dal.FSMInstanceState{Status: test.status, CurrentState: test.current, DestinationState: test.destination})
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
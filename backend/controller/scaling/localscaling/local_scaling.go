@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/alecthomas/kong"
+	"github.com/jpillora/backoff"
 
 	"github.com/TBD54566975/ftl/backend/controller/scaling"
 	"github.com/TBD54566975/ftl/backend/runner"
@@ -21,6 +22,14 @@ import (
 
 var _ scaling.RunnerScaling = (*LocalScaling)(nil)
 
+const (
+	runnerRestartMin = time.Second
+	runnerRestartMax = time.Minute
+	// Number of consecutive failures after which a runner is reported as
+	// crash looping.
+	runnerCrashLoopThreshold = 3
+)
+
 type LocalScaling struct {
 	lock     sync.Mutex
 	cacheDir string
@@ -78,9 +87,14 @@ func (l *LocalScaling) SetReplicas(ctx context.Context, replicas int, idleRunner
 	for range replicasToAdd {
 		controllerEndpoint := l.controllerAddresses[len(l.runners)%len(l.controllerAddresses)]
 
-		bind := l.portAllocator.Next()
 		keySuffix := l.prevRunnerSuffix + 1
 		l.prevRunnerSuffix = keySuffix
+		simpleName := fmt.Sprintf("runner%d", keySuffix)
+
+		bind, err := l.portAllocator.NextFor(ctx, simpleName)
+		if err != nil {
+			return err
+		}
 
 		config := runner.Config{
 			Bind:               bind,
@@ -89,7 +103,6 @@ func (l *LocalScaling) SetReplicas(ctx context.Context, replicas int, idleRunner
 			Key:                model.NewLocalRunnerKey(keySuffix),
 		}
 
-		simpleName := fmt.Sprintf("runner%d", keySuffix)
 		if err := kong.ApplyDefaults(&config, kong.Vars{
 			"deploymentdir": filepath.Join(l.cacheDir, "ftl-runner", simpleName, "deployments"),
 			"language":      "go,kotlin",
@@ -104,13 +117,7 @@ func (l *LocalScaling) SetReplicas(ctx context.Context, replicas int, idleRunner
 		runnerCtx, cancel := context.WithCancel(runnerCtx)
 		l.runners[config.Key.String()] = cancel
 
-		go func() {
-			logger.Debugf("Starting runner: %s", config.Key)
-			err := runner.Start(runnerCtx, config)
-			if err != nil && !errors.Is(err, context.Canceled) {
-				logger.Errorf(err, "Runner failed: %s", err)
-			}
-		}()
+		go supervise(runnerCtx, config)
 	}
 
 	return nil
@@ -130,3 +137,36 @@ func (l *LocalScaling) remove(ctx context.Context, runner model.RunnerKey) error
 
 	return nil
 }
+
+// supervise runs a runner until "ctx" is cancelled, restarting it with
+// exponential backoff if it fails.
+func supervise(ctx context.Context, config runner.Config) {
+	logger := log.FromContext(ctx)
+	retry := backoff.Backoff{Min: runnerRestartMin, Max: runnerRestartMax, Factor: 2}
+	for {
+		logger.Debugf("Starting runner: %s", config.Key)
+		started := time.Now()
+		err := runner.Start(ctx, config)
+		if ctx.Err() != nil || errors.Is(err, context.Canceled) {
+			return
+		}
+		if err == nil {
+			err = errors.New("runner exited")
+		}
+		// Runners that ran for a while before failing aren't crash looping.
+		if time.Since(started) > runnerRestartMax {
+			retry.Reset()
+		}
+		delay := retry.Duration()
+		if int(retry.Attempt()) >= runnerCrashLoopThreshold {
+			logger.Errorf(err, "Runner %s is crash looping, restarting in %s", config.Key, delay)
+		} else {
+			logger.Warnf("Runner %s failed, restarting in %s: %s", config.Key, delay, err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+	}
+}
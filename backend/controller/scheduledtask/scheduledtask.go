@@ -14,6 +14,10 @@ import (
 	"github.com/alecthomas/types/optional"
 	clock "github.com/benbjohnson/clock"
 	"github.com/jpillora/backoff"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/TBD54566975/ftl/backend/controller/dal"
 	"github.com/TBD54566975/ftl/backend/controller/leases"
@@ -22,6 +26,8 @@ import (
 	"github.com/TBD54566975/ftl/internal/slices"
 )
 
+var tracer = otel.Tracer("ftl.scheduledtask")
+
 type descriptor struct {
 	next        time.Time
 	name        string
@@ -144,7 +150,11 @@ func (s *Scheduler) run(ctx context.Context) {
 				jobs[i] = nil // Zero out scheduled jobs.
 				logger.Scope(job.name).Tracef("Running scheduled task")
 				go func() {
+					ctx, span := tracer.Start(ctx, "scheduledtask."+job.name, trace.WithAttributes(attribute.Bool("ftl.scheduledtask.singleton", job.singlyHomed)))
+					defer span.End()
 					if delay, err := job.job(ctx); err != nil {
+						span.RecordError(err)
+						span.SetStatus(codes.Error, err.Error())
 						logger.Scope(job.name).Warnf("%s", err)
 						job.next = s.clock.Now().Add(job.retry.Duration())
 					} else {
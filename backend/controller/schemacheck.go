@@ -0,0 +1,65 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TBD54566975/ftl"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+// checkStoredSchemas validates the stored schemas of all active deployments.
+//
+// Deployments whose schemas can no longer be parsed by this version of FTL,
+// eg. after a change to the schema format, are reported and quarantined.
+// Otherwise every query returning active deployments would fail, breaking
+// schema sync for all clients.
+func (s *Service) checkStoredSchemas(ctx context.Context) error {
+	logger := log.FromContext(ctx)
+	stored, err := s.dal.GetActiveDeploymentRawSchemas(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load stored schemas: %w", err)
+	}
+	modules := make([]*schema.Module, 0, len(stored))
+	for _, deployment := range stored {
+		module, parseErr := parseStoredSchema(deployment.Module, deployment.Schema)
+		if parseErr == nil {
+			modules = append(modules, module)
+			continue
+		}
+		quarantined, err := s.dal.QuarantineDeployment(ctx, deployment.Key, deployment.Module, parseErr.Error())
+		if err != nil {
+			return fmt.Errorf("failed to quarantine deployment %s: %w", deployment.Key, err)
+		}
+		if quarantined {
+			logger.Errorf(parseErr, "Quarantined deployment %s of module %s, as its schema can not be parsed by FTL %s; redeploy the module to restore it", deployment.Key, deployment.Module, ftl.Version)
+		}
+	}
+	// Problems between modules can't be attributed to a single deployment, so
+	// are only reported.
+	if _, err := schema.ValidateSchema(&schema.Schema{Modules: modules}); err != nil {
+		logger.Warnf("Schemas of active deployments are inconsistent: %s", err)
+	}
+	logger.Debugf("Checked the stored schemas of %d active deployments", len(stored))
+	return nil
+}
+
+// parseStoredSchema parses and validates the stored schema of a deployment of
+// "module".
+func parseStoredSchema(module string, data []byte) (out *schema.Module, err error) {
+	// Conversion from protobuf panics on unknown types.
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("invalid schema: %v", r)
+		}
+	}()
+	out, err = schema.ModuleFromBytes(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schema: %w", err)
+	}
+	if out.Name != module {
+		return nil, fmt.Errorf("schema is for module %q", out.Name)
+	}
+	return out, nil
+}
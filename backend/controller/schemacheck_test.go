@@ -0,0 +1,33 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+	"google.golang.org/protobuf/proto"
+
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+func TestParseStoredSchema(t *testing.T) {
+	valid, err := schema.ModuleToBytes(&schema.Module{Name: "echo"})
+	assert.NoError(t, err)
+	module, err := parseStoredSchema("echo", valid)
+	assert.NoError(t, err)
+	assert.Equal(t, "echo", module.Name)
+
+	_, err = parseStoredSchema("time", valid)
+	assert.EqualError(t, err, `schema is for module "echo"`)
+
+	_, err = parseStoredSchema("echo", []byte("not a schema"))
+	assert.Error(t, err)
+
+	// A type unknown to this version of FTL.
+	unknown, err := proto.Marshal(&schemapb.Module{Name: "echo", Decls: []*schemapb.Decl{
+		{Value: &schemapb.Decl_Verb{Verb: &schemapb.Verb{Name: "echo", Request: &schemapb.Type{}, Response: &schemapb.Type{}}}},
+	}})
+	assert.NoError(t, err)
+	_, err = parseStoredSchema("echo", unknown)
+	assert.Error(t, err)
+}
@@ -0,0 +1,158 @@
+package simulation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	"github.com/TBD54566975/ftl/backend/controller/leases"
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+type fsmInstanceKey struct {
+	fsm schema.RefKey
+	key string
+}
+
+// SendFSMEvent sends an event to an instance of an FSM, transitioning it to
+// the first valid destination state that accepts "event".
+//
+// If the instance doesn't exist a new one is created.
+func (s *Simulation) SendFSMEvent(ctx context.Context, fsm *schema.Ref, instance string, event schema.Type, body json.RawMessage) error {
+	return s.startFSMTransition(ctx, fsm, instance, body, "type "+event.String(), func(_ *schema.Ref, verb *schema.Verb) bool {
+		return event.Equal(verb.Request)
+	})
+}
+
+// SendFSMTransition transitions an instance of an FSM to "state".
+func (s *Simulation) SendFSMTransition(ctx context.Context, fsm *schema.Ref, instance string, state *schema.Ref, body json.RawMessage) error {
+	if state.Module == "" {
+		state = &schema.Ref{Module: fsm.Module, Name: state.Name}
+	}
+	return s.startFSMTransition(ctx, fsm, instance, body, "state "+state.String(), func(ref *schema.Ref, _ *schema.Verb) bool {
+		return ref.ToRefKey() == state.ToRefKey()
+	})
+}
+
+// FSMInstance returns the state of an instance of an FSM.
+func (s *Simulation) FSMInstance(fsm schema.RefKey, key string) (dal.FSMInstanceState, bool) {
+	instance, ok := s.instances[fsmInstanceKey{fsm: fsm, key: key}]
+	if !ok {
+		return dal.FSMInstanceState{}, false
+	}
+	return *instance, true
+}
+
+// startFSMTransition mirrors the controller, enqueuing an async call to the
+// first destination state accepted by "match".
+func (s *Simulation) startFSMTransition(ctx context.Context, fsmRef *schema.Ref, instanceKey string, body json.RawMessage, target string, match func(ref *schema.Ref, verb *schema.Verb) bool) error {
+	fsm := &schema.FSM{}
+	if err := s.schema.ResolveToType(fsmRef, fsm); err != nil {
+		return fmt.Errorf("fsm not found: %w", err)
+	}
+	if _, ok := fsm.GetMetadataSaga().Get(); ok {
+		return fmt.Errorf("fsm %s is a saga, which simulations do not support", fsmRef)
+	}
+	fsmKey := fsmRef.ToRefKey()
+
+	lease, _, err := s.leaser.AcquireLease(ctx, leases.SystemKey("fsm_instance", fsmKey.String(), instanceKey), time.Second*5, optional.None[any]())
+	if err != nil {
+		return fmt.Errorf("could not acquire fsm instance: %w", err)
+	}
+	defer lease.Release() //nolint:errcheck
+
+	instance, ok := s.instances[fsmInstanceKey{fsm: fsmKey, key: instanceKey}]
+	if !ok {
+		instance = &dal.FSMInstanceState{FSM: fsmKey, Key: instanceKey, Status: dal.FSMStatusRunning}
+	}
+
+	var destinationRef *schema.Ref
+	var destinationVerb *schema.Verb
+	var candidates []string
+	consider := func(ref *schema.Ref) (bool, error) {
+		verb := &schema.Verb{}
+		if err := s.schema.ResolveToType(ref, verb); err != nil {
+			return false, fmt.Errorf("fsm: destination verb %s not found: %w", ref, err)
+		}
+		candidates = append(candidates, verb.Name)
+		if !match(ref, verb) {
+			return false, nil
+		}
+		destinationRef, destinationVerb = ref, verb
+		return true, nil
+	}
+	var destinations []*schema.Ref
+	if current, ok := instance.CurrentState.Get(); ok {
+		for _, transition := range fsm.Transitions {
+			if transition.From.ToRefKey() == current {
+				destinations = append(destinations, transition.To)
+			}
+		}
+	} else {
+		destinations = fsm.Start
+	}
+	for _, ref := range destinations {
+		if found, err := consider(ref); err != nil {
+			return err
+		} else if found {
+			break
+		}
+	}
+	if destinationRef == nil {
+		if len(candidates) > 0 {
+			return fmt.Errorf("no transition found from state %s for %s, candidates are %s", instance.CurrentState, target, strings.Join(candidates, ", "))
+		}
+		return fmt.Errorf("no transition found from state %s for %s", instance.CurrentState, target)
+	}
+	// As in the database, an instance can only execute one transition at a time.
+	if instance.DestinationState.Ok() {
+		return fmt.Errorf("transition already executing: %w", leases.ErrConflict)
+	}
+
+	retryParams, err := schema.RetryParamsForFSMTransition(fsm, destinationVerb)
+	if err != nil {
+		return err
+	}
+	s.EnqueueAsyncCall(AsyncCall{
+		Origin:            dal.AsyncOriginFSM{FSM: fsmKey, Key: instanceKey},
+		Verb:              destinationRef.ToRefKey(),
+		Request:           body,
+		RemainingAttempts: int32(retryParams.Count),
+		Backoff:           retryParams.MinBackoff,
+		MaxBackoff:        retryParams.MaxBackoff,
+	})
+	instance.DestinationState = optional.Some(destinationRef.ToRefKey())
+	s.instances[fsmInstanceKey{fsm: fsmKey, key: instanceKey}] = instance
+	return nil
+}
+
+// onFSMCallCompletion mirrors the controller, moving an FSM instance to the
+// destination state of its completed transition.
+func (s *Simulation) onFSMCallCompletion(origin dal.AsyncOriginFSM, failed bool) {
+	instance, ok := s.instances[fsmInstanceKey{fsm: origin.FSM, key: origin.Key}]
+	if !ok {
+		return
+	}
+	if failed {
+		instance.Status = dal.FSMStatusFailed
+		instance.CurrentState = optional.None[schema.RefKey]()
+		return
+	}
+	destination, _ := instance.DestinationState.Get()
+	instance.CurrentState = instance.DestinationState
+	instance.DestinationState = optional.None[schema.RefKey]()
+	fsm := &schema.FSM{}
+	if err := s.schema.ResolveToType(origin.FSM.ToRef(), fsm); err != nil {
+		return
+	}
+	for _, terminal := range fsm.TerminalStates() {
+		if terminal.ToRefKey() == destination {
+			instance.Status = dal.FSMStatusCompleted
+		}
+	}
+}
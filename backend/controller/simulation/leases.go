@@ -0,0 +1,100 @@
+package simulation
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/alecthomas/types/optional"
+	"github.com/benbjohnson/clock"
+
+	"github.com/TBD54566975/ftl/backend/controller/leases"
+)
+
+var _ leases.Leaser = (*Leaser)(nil)
+
+// Leaser is an in-memory [leases.Leaser] on the virtual clock of a simulation.
+//
+// As with real leases, held leases are renewed until they are released, so
+// their expiry is always a TTL from the current virtual time.
+type Leaser struct {
+	clock  clock.Clock
+	leases map[string]*Lease
+}
+
+func newLeaser(clock clock.Clock) *Leaser {
+	return &Leaser{clock: clock, leases: map[string]*Lease{}}
+}
+
+func (l *Leaser) AcquireLease(ctx context.Context, key leases.Key, ttl time.Duration, metadata optional.Option[any]) (leases.Lease, context.Context, error) {
+	if _, ok := l.leases[key.String()]; ok {
+		return nil, nil, leases.ErrConflict
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	lease := &Lease{leaser: l, key: key, ttl: ttl, metadata: metadata, cancel: cancel}
+	l.leases[key.String()] = lease
+	return lease, ctx, nil
+}
+
+func (l *Leaser) GetLeaseInfo(ctx context.Context, key leases.Key, metadata any) (expiry time.Time, err error) {
+	lease, ok := l.leases[key.String()]
+	if !ok {
+		return time.Time{}, fmt.Errorf("lease %s not found", key)
+	}
+	expiry = l.clock.Now().Add(lease.ttl)
+	md, ok := lease.metadata.Get()
+	if !ok || metadata == nil {
+		return expiry, nil
+	}
+	value := reflect.ValueOf(metadata)
+	if value.Kind() != reflect.Ptr || value.IsNil() {
+		return time.Time{}, fmt.Errorf("metadata must be a non-nil pointer")
+	}
+	mdValue := reflect.ValueOf(md)
+	if mdValue.Type() != value.Elem().Type() {
+		return time.Time{}, fmt.Errorf("lease metadata is a %s, not a %s", mdValue.Type(), value.Elem().Type())
+	}
+	value.Elem().Set(mdValue)
+	return expiry, nil
+}
+
+// Held returns the keys of the leases currently held, in order.
+func (l *Leaser) Held() []string {
+	keys := make([]string, 0, len(l.leases))
+	for key := range l.leases {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Expire a held lease as if its holder stopped renewing it, cancelling the
+// context returned when it was acquired.
+func (l *Leaser) Expire(key leases.Key) {
+	if lease, ok := l.leases[key.String()]; ok {
+		_ = lease.Release()
+	}
+}
+
+// Lease held in a simulation.
+type Lease struct {
+	leaser   *Leaser
+	key      leases.Key
+	ttl      time.Duration
+	metadata optional.Option[any]
+	cancel   context.CancelFunc
+}
+
+var _ leases.Lease = (*Lease)(nil)
+
+func (l *Lease) Release() error {
+	if held, ok := l.leaser.leases[l.key.String()]; ok && held == l {
+		delete(l.leaser.leases, l.key.String())
+	}
+	l.cancel()
+	return nil
+}
+
+func (l *Lease) String() string { return l.key.String() }
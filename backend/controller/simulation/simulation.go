@@ -0,0 +1,363 @@
+// Package simulation runs the async call scheduler, FSM executor and leases of
+// the controller in-process against a virtual clock.
+//
+// Nothing executes until the clock is advanced, and whatever falls due
+// executes in a deterministic order, so tests can fast-forward through
+// retries, backoff, timeouts and scheduled calls in milliseconds. The
+// semantics mirror those of the controller and [dal.DAL], without a database
+// or runners.
+//
+// Simulations are not safe for concurrent use, but verbs may call back into
+// the simulation that is executing them, eg. to send FSM events.
+package simulation
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/alecthomas/types/optional"
+	"github.com/benbjohnson/clock"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	"github.com/TBD54566975/ftl/backend/controller/leases"
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+// Epoch is the virtual time that simulations start at.
+var Epoch = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// asyncCallLeaseTTL matches the TTL of the leases that [dal.DAL] acquires
+// async calls with.
+const asyncCallLeaseTTL = time.Second * 5
+
+// maxStepsPerInstant bounds the calls executed without the clock advancing,
+// to catch verbs that schedule each other forever.
+const maxStepsPerInstant = 10000
+
+// Verb implements a verb that async calls are made to.
+//
+// Returning an error fails the call, as if the verb returned an error
+// response.
+type Verb func(ctx context.Context, request json.RawMessage) (json.RawMessage, error)
+
+type simulatedVerb struct {
+	fn      Verb
+	latency time.Duration
+}
+
+type AsyncCallState string
+
+const (
+	AsyncCallPending   AsyncCallState = "pending"
+	AsyncCallExecuting AsyncCallState = "executing"
+	AsyncCallSucceeded AsyncCallState = "success"
+	AsyncCallFailed    AsyncCallState = "error"
+)
+
+// AsyncCall is an async call in a simulation.
+type AsyncCall struct {
+	ID int64
+	// Origin of the call, or nil for calls enqueued directly.
+	Origin  dal.AsyncOrigin
+	Verb    schema.RefKey
+	Request json.RawMessage
+	// Time the call is scheduled to execute at. Zero schedules it immediately.
+	ScheduledAt time.Time
+
+	RemainingAttempts int32
+	Backoff           time.Duration
+	MaxBackoff        time.Duration
+	OrderingKey       optional.Option[string]
+
+	State AsyncCallState
+	// Number of times the call has been executed.
+	Attempts int
+	Response json.RawMessage
+	// Error of the most recent failed attempt.
+	Error string
+
+	lease    leases.Lease
+	finishAt time.Time
+	result   error
+}
+
+type Option func(s *Simulation)
+
+// CallTimeout fails attempts to call verbs that take longer than "timeout",
+// so that they are retried.
+func CallTimeout(timeout time.Duration) Option {
+	return func(s *Simulation) { s.timeout = timeout }
+}
+
+// A Simulation of the async subsystems of an FTL cluster.
+type Simulation struct {
+	clock     *clock.Mock
+	leaser    *Leaser
+	schema    *schema.Schema
+	timeout   time.Duration
+	verbs     map[schema.RefKey]simulatedVerb
+	calls     []*AsyncCall
+	instances map[fsmInstanceKey]*dal.FSMInstanceState
+}
+
+// New creates a simulation of the modules in "sch", starting at [Epoch].
+func New(sch *schema.Schema, options ...Option) *Simulation {
+	mock := clock.NewMock()
+	mock.Set(Epoch)
+	s := &Simulation{
+		clock:     mock,
+		leaser:    newLeaser(mock),
+		schema:    sch,
+		verbs:     map[schema.RefKey]simulatedVerb{},
+		instances: map[fsmInstanceKey]*dal.FSMInstanceState{},
+	}
+	for _, option := range options {
+		option(s)
+	}
+	return s
+}
+
+// Clock returns the virtual clock of the simulation.
+//
+// It can be passed to components that accept a [clock.Clock], but must only
+// be advanced through the simulation.
+func (s *Simulation) Clock() clock.Clock { return s.clock }
+
+// Now returns the current virtual time.
+func (s *Simulation) Now() time.Time { return s.clock.Now() }
+
+// Leaser returns the leases of the simulation.
+func (s *Simulation) Leaser() *Leaser { return s.leaser }
+
+// Verb implements "ref" with "fn", taking "latency" of virtual time to return.
+//
+// Calls to verbs without an implementation fail.
+func (s *Simulation) Verb(ref schema.RefKey, latency time.Duration, fn Verb) {
+	s.verbs[ref] = simulatedVerb{fn: fn, latency: latency}
+}
+
+// EnqueueAsyncCall enqueues "call" for execution, returning its ID.
+func (s *Simulation) EnqueueAsyncCall(call AsyncCall) int64 {
+	call.ID = int64(len(s.calls) + 1)
+	if call.ScheduledAt.IsZero() {
+		call.ScheduledAt = s.clock.Now()
+	}
+	call.State = AsyncCallPending
+	call.Attempts = 0
+	s.calls = append(s.calls, &call)
+	return call.ID
+}
+
+// AsyncCall returns a copy of the async call with "id".
+func (s *Simulation) AsyncCall(id int64) (AsyncCall, bool) {
+	if id < 1 || id > int64(len(s.calls)) {
+		return AsyncCall{}, false
+	}
+	return *s.calls[id-1], true
+}
+
+// AsyncCalls returns a copy of every async call, in the order they were
+// enqueued.
+func (s *Simulation) AsyncCalls() []AsyncCall {
+	out := make([]AsyncCall, len(s.calls))
+	for i, call := range s.calls {
+		out[i] = *call
+	}
+	return out
+}
+
+// Advance the virtual clock by "d", executing async calls as they fall due.
+func (s *Simulation) Advance(ctx context.Context, d time.Duration) error {
+	end := s.clock.Now().Add(d)
+	for {
+		next, ok := s.nextEvent()
+		if !ok || next.After(end) {
+			break
+		}
+		if next.After(s.clock.Now()) {
+			s.clock.Set(next)
+		}
+		if err := s.step(ctx); err != nil {
+			return err
+		}
+	}
+	if end.After(s.clock.Now()) {
+		s.clock.Set(end)
+	}
+	return nil
+}
+
+// RunUntilIdle advances the virtual clock until every async call has
+// completed, returning an error if they haven't within "limit".
+func (s *Simulation) RunUntilIdle(ctx context.Context, limit time.Duration) error {
+	end := s.clock.Now().Add(limit)
+	for {
+		next, ok := s.nextEvent()
+		if !ok {
+			return nil
+		}
+		if next.After(end) {
+			return fmt.Errorf("async calls still pending after %s", limit)
+		}
+		if err := s.Advance(ctx, next.Sub(s.clock.Now())); err != nil {
+			return err
+		}
+	}
+}
+
+// nextEvent returns the time of the next attempt to start or finish.
+func (s *Simulation) nextEvent() (time.Time, bool) {
+	var next time.Time
+	found := false
+	for _, call := range s.calls {
+		var at time.Time
+		switch {
+		case call.State == AsyncCallExecuting:
+			at = call.finishAt
+		case call.State == AsyncCallPending && s.acquirable(call):
+			at = call.ScheduledAt
+		default:
+			continue
+		}
+		if at.Before(s.clock.Now()) {
+			at = s.clock.Now()
+		}
+		if !found || at.Before(next) {
+			next, found = at, true
+		}
+	}
+	return next, found
+}
+
+// step finishes and starts every attempt due at the current virtual time.
+func (s *Simulation) step(ctx context.Context) error {
+	now := s.clock.Now()
+	for range maxStepsPerInstant {
+		progressed := false
+		for _, call := range s.calls {
+			if call.State == AsyncCallExecuting && !call.finishAt.After(now) {
+				s.finish(call)
+				progressed = true
+			}
+		}
+		if call, ok := s.due(now); ok {
+			if err := s.start(ctx, call); err != nil {
+				return err
+			}
+			progressed = true
+		}
+		if !progressed {
+			return nil
+		}
+	}
+	return fmt.Errorf("more than %d async calls executed at %s, verbs may be scheduling each other forever", maxStepsPerInstant, now)
+}
+
+// due returns the pending call that is due soonest, if any are due.
+func (s *Simulation) due(now time.Time) (*AsyncCall, bool) {
+	var due []*AsyncCall
+	for _, call := range s.calls {
+		if call.State == AsyncCallPending && !call.ScheduledAt.After(now) && s.acquirable(call) {
+			due = append(due, call)
+		}
+	}
+	if len(due) == 0 {
+		return nil, false
+	}
+	sort.SliceStable(due, func(i, j int) bool { return due[i].ScheduledAt.Before(due[j].ScheduledAt) })
+	return due[0], true
+}
+
+// acquirable returns true if "call" could be acquired once it is due.
+//
+// As in the database, calls sharing an ordering key are only acquired once
+// every earlier call with the key has completed.
+func (s *Simulation) acquirable(call *AsyncCall) bool {
+	key, ok := call.OrderingKey.Get()
+	if !ok {
+		return true
+	}
+	if _, held := s.leaser.leases[orderingKeyLease(key).String()]; held {
+		return false
+	}
+	for _, earlier := range s.calls {
+		if earlier.ID >= call.ID {
+			break
+		}
+		if earlier.State == AsyncCallPending && earlier.OrderingKey == call.OrderingKey {
+			return false
+		}
+	}
+	return true
+}
+
+func orderingKeyLease(key string) leases.Key {
+	sum := sha256.Sum256([]byte(key))
+	return leases.SystemKey("async_call_order", hex.EncodeToString(sum[:]))
+}
+
+// start an attempt to execute "call".
+func (s *Simulation) start(ctx context.Context, call *AsyncCall) error {
+	leaseKey := leases.SystemKey("async_call", fmt.Sprint(call.ID))
+	if key, ok := call.OrderingKey.Get(); ok {
+		leaseKey = orderingKeyLease(key)
+	}
+	lease, _, err := s.leaser.AcquireLease(ctx, leaseKey, asyncCallLeaseTTL, optional.None[any]())
+	if err != nil {
+		return fmt.Errorf("failed to acquire lease for async call %d: %w", call.ID, err)
+	}
+	call.lease = lease
+	call.State = AsyncCallExecuting
+	call.Attempts++
+
+	verb, ok := s.verbs[call.Verb]
+	if !ok {
+		call.finishAt = s.clock.Now()
+		call.result = fmt.Errorf("verb %s is not implemented by the simulation", call.Verb)
+		return nil
+	}
+	if s.timeout > 0 && verb.latency > s.timeout {
+		// The verb still executes, but its response is lost.
+		call.finishAt = s.clock.Now().Add(s.timeout)
+		_, _ = verb.fn(ctx, call.Request) //nolint:errcheck
+		call.result = fmt.Errorf("call to %s timed out after %s: %w", call.Verb, s.timeout, context.DeadlineExceeded)
+		return nil
+	}
+	call.finishAt = s.clock.Now().Add(verb.latency)
+	response, err := verb.fn(ctx, call.Request)
+	call.Response = response
+	call.result = err
+	return nil
+}
+
+// finish an attempt to execute "call", retrying it or completing its origin
+// as the controller does.
+func (s *Simulation) finish(call *AsyncCall) {
+	_ = call.lease.Release()
+	call.lease = nil
+	failed := call.result != nil
+	if !failed {
+		call.State = AsyncCallSucceeded
+		call.Error = ""
+	} else {
+		call.Response = nil
+		call.Error = call.result.Error()
+		if call.RemainingAttempts > 0 {
+			call.State = AsyncCallPending
+			call.ScheduledAt = s.clock.Now().Add(call.Backoff)
+			call.RemainingAttempts--
+			call.Backoff = min(call.Backoff*2, call.MaxBackoff)
+			// Will retry, do not propagate failure yet.
+			return
+		}
+		call.State = AsyncCallFailed
+	}
+	if origin, ok := call.Origin.(dal.AsyncOriginFSM); ok {
+		s.onFSMCallCompletion(origin, failed)
+	}
+}
@@ -0,0 +1,155 @@
+package simulation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	"github.com/TBD54566975/ftl/backend/controller/leases"
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+func newSimulation(t *testing.T, options ...Option) *Simulation {
+	t.Helper()
+	module, err := schema.ParseModuleString("", `
+		module payments {
+			data Created {}
+			data Paid {}
+
+			verb created(payments.Created) Unit
+				+retry 2 1s 3s
+
+			verb paid(payments.Paid) Unit
+
+			fsm payment {
+				start payments.created
+				transition payments.created to payments.paid
+			}
+		}
+	`)
+	assert.NoError(t, err)
+	return New(&schema.Schema{Modules: []*schema.Module{module}}, options...)
+}
+
+func TestRetriesWithBackoff(t *testing.T) {
+	ctx := context.Background()
+	sim := newSimulation(t)
+	var attempts []time.Duration
+	sim.Verb(schema.RefKey{Module: "payments", Name: "flaky"}, 0, func(ctx context.Context, request json.RawMessage) (json.RawMessage, error) {
+		attempts = append(attempts, sim.Now().Sub(Epoch))
+		if len(attempts) < 4 {
+			return nil, errors.New("unavailable")
+		}
+		return json.RawMessage(`{}`), nil
+	})
+	id := sim.EnqueueAsyncCall(AsyncCall{
+		Verb:              schema.RefKey{Module: "payments", Name: "flaky"},
+		RemainingAttempts: 5,
+		Backoff:           time.Second,
+		MaxBackoff:        3 * time.Second,
+	})
+
+	assert.NoError(t, sim.RunUntilIdle(ctx, time.Minute))
+	assert.Equal(t, []time.Duration{0, time.Second, 3 * time.Second, 6 * time.Second}, attempts)
+	call, ok := sim.AsyncCall(id)
+	assert.True(t, ok)
+	assert.Equal(t, AsyncCallSucceeded, call.State)
+	assert.Equal(t, int32(2), call.RemainingAttempts)
+	assert.Equal(t, 6*time.Second, sim.Now().Sub(Epoch))
+}
+
+func TestTimeoutsAndScheduledCalls(t *testing.T) {
+	ctx := context.Background()
+	sim := newSimulation(t, CallTimeout(10*time.Second))
+	sim.Verb(schema.RefKey{Module: "payments", Name: "slow"}, time.Minute, func(ctx context.Context, request json.RawMessage) (json.RawMessage, error) {
+		return json.RawMessage(`{}`), nil
+	})
+	id := sim.EnqueueAsyncCall(AsyncCall{
+		Verb:              schema.RefKey{Module: "payments", Name: "slow"},
+		ScheduledAt:       Epoch.Add(time.Hour),
+		RemainingAttempts: 1,
+		Backoff:           time.Second,
+		MaxBackoff:        time.Second,
+	})
+
+	assert.NoError(t, sim.Advance(ctx, 59*time.Minute))
+	call, _ := sim.AsyncCall(id)
+	assert.Equal(t, AsyncCallPending, call.State)
+	assert.Equal(t, 0, call.Attempts)
+
+	assert.NoError(t, sim.RunUntilIdle(ctx, time.Hour))
+	call, _ = sim.AsyncCall(id)
+	assert.Equal(t, AsyncCallFailed, call.State)
+	assert.Equal(t, 2, call.Attempts)
+	assert.Contains(t, call.Error, "timed out after 10s")
+	assert.Equal(t, time.Hour+21*time.Second, sim.Now().Sub(Epoch))
+}
+
+func TestOrderingKeys(t *testing.T) {
+	ctx := context.Background()
+	sim := newSimulation(t)
+	var order []string
+	sim.Verb(schema.RefKey{Module: "payments", Name: "record"}, time.Second, func(ctx context.Context, request json.RawMessage) (json.RawMessage, error) {
+		order = append(order, string(request))
+		return nil, nil
+	})
+	for _, request := range []string{`"a"`, `"b"`, `"c"`} {
+		sim.EnqueueAsyncCall(AsyncCall{
+			Verb:        schema.RefKey{Module: "payments", Name: "record"},
+			Request:     json.RawMessage(request),
+			OrderingKey: optional.Some("account"),
+		})
+	}
+
+	assert.NoError(t, sim.Advance(ctx, 0))
+	assert.Equal(t, []string{`"a"`}, order)
+	assert.Equal(t, []string{orderingKeyLease("account").String()}, sim.Leaser().Held())
+
+	assert.NoError(t, sim.RunUntilIdle(ctx, time.Minute))
+	assert.Equal(t, []string{`"a"`, `"b"`, `"c"`}, order)
+	assert.Equal(t, 3*time.Second, sim.Now().Sub(Epoch))
+	assert.Equal(t, []string{}, sim.Leaser().Held())
+}
+
+func TestFSM(t *testing.T) {
+	ctx := context.Background()
+	sim := newSimulation(t)
+	fsm := &schema.Ref{Module: "payments", Name: "payment"}
+	failures := 2
+	sim.Verb(schema.RefKey{Module: "payments", Name: "created"}, 0, func(ctx context.Context, request json.RawMessage) (json.RawMessage, error) {
+		if failures > 0 {
+			failures--
+			return nil, errors.New("declined")
+		}
+		return nil, nil
+	})
+	sim.Verb(schema.RefKey{Module: "payments", Name: "paid"}, 0, func(ctx context.Context, request json.RawMessage) (json.RawMessage, error) {
+		return nil, nil
+	})
+
+	assert.NoError(t, sim.SendFSMEvent(ctx, fsm, "1", &schema.Ref{Module: "payments", Name: "Created"}, json.RawMessage(`{}`)))
+	err := sim.SendFSMEvent(ctx, fsm, "1", &schema.Ref{Module: "payments", Name: "Created"}, json.RawMessage(`{}`))
+	assert.IsError(t, err, leases.ErrConflict)
+
+	// Attempts at 0s and 1s fail, and the retry at 3s succeeds.
+	assert.NoError(t, sim.Advance(ctx, 2*time.Second))
+	instance, ok := sim.FSMInstance(fsm.ToRefKey(), "1")
+	assert.True(t, ok)
+	assert.False(t, instance.CurrentState.Ok())
+	assert.NoError(t, sim.Advance(ctx, time.Second))
+	instance, _ = sim.FSMInstance(fsm.ToRefKey(), "1")
+	assert.Equal(t, dal.FSMStatusRunning, instance.Status)
+	assert.Equal(t, optional.Some(schema.RefKey{Module: "payments", Name: "created"}), instance.CurrentState)
+
+	assert.NoError(t, sim.SendFSMTransition(ctx, fsm, "1", &schema.Ref{Name: "paid"}, json.RawMessage(`{}`)))
+	assert.NoError(t, sim.RunUntilIdle(ctx, time.Minute))
+	instance, _ = sim.FSMInstance(fsm.ToRefKey(), "1")
+	assert.Equal(t, dal.FSMStatusCompleted, instance.Status)
+	assert.Equal(t, optional.Some(schema.RefKey{Module: "payments", Name: "paid"}), instance.CurrentState)
+}
@@ -0,0 +1,146 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+)
+
+// Period over which the rate the error budget is consumed at is measured.
+const sloBurnWindow = time.Hour
+
+// SLOConfig configures the service level objectives that calls to verbs are measured against.
+type SLOConfig struct {
+	Availability     float64                  `help:"Target fraction of calls to each verb that succeed." default:"0.999" env:"FTL_CONTROLLER_SLO_AVAILABILITY"`
+	Latency          time.Duration            `help:"Target p95 latency of calls to each verb." default:"1s" env:"FTL_CONTROLLER_SLO_LATENCY"`
+	Window           time.Duration            `help:"Period over which error budgets are measured." default:"24h" env:"FTL_CONTROLLER_SLO_WINDOW"`
+	VerbAvailability map[string]float64       `help:"Per-verb overrides of --slo-availability." mapsep:"," env:"FTL_CONTROLLER_SLO_VERB_AVAILABILITY" placeholder:"MODULE.VERB=TARGET,…"`
+	VerbLatency      map[string]time.Duration `help:"Per-verb overrides of --slo-latency." mapsep:"," env:"FTL_CONTROLLER_SLO_VERB_LATENCY" placeholder:"MODULE.VERB=DURATION,…"`
+}
+
+func (c SLOConfig) validate() error {
+	if c.Availability <= 0 || c.Availability >= 1 {
+		return fmt.Errorf("SLO availability target must be between 0 and 1, got %v", c.Availability)
+	}
+	for verb, target := range c.VerbAvailability {
+		if target <= 0 || target >= 1 {
+			return fmt.Errorf("SLO availability target for %s must be between 0 and 1, got %v", verb, target)
+		}
+	}
+	if c.Window <= 0 {
+		return fmt.Errorf("SLO window must be positive, got %s", c.Window)
+	}
+	return nil
+}
+
+func (s *Service) GetSLOStatus(ctx context.Context, req *connect.Request[ftlv1.GetSLOStatusRequest]) (*connect.Response[ftlv1.GetSLOStatusResponse], error) {
+	status, err := s.measureSLOs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&ftlv1.GetSLOStatusResponse{
+		Window: durationpb.New(s.config.Load().SLO.Window),
+		Verbs:  status,
+	}), nil
+}
+
+// refreshSLOStatus periodically measures the SLOs for the burn rate metrics.
+func (s *Service) refreshSLOStatus(ctx context.Context) (time.Duration, error) {
+	status, err := s.measureSLOs(ctx)
+	if err != nil {
+		return 0, err
+	}
+	s.sloStatus.Store(status)
+	return time.Minute, nil
+}
+
+func (s *Service) measureSLOs(ctx context.Context) ([]*ftlv1.VerbSLOStatus, error) {
+	now := time.Now()
+	window, err := s.dal.GetVerbCallStats(ctx, now.Add(-s.config.Load().SLO.Window))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get calls over the SLO window: %w", err)
+	}
+	recent, err := s.dal.GetVerbCallStats(ctx, now.Add(-min(sloBurnWindow, s.config.Load().SLO.Window)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recent calls: %w", err)
+	}
+	return sloStatus(s.config.Load().SLO, window, recent), nil
+}
+
+// registerSLOMetrics exposes the most recently measured SLO status of each verb as gauges.
+func (s *Service) registerSLOMetrics() error {
+	meter := otel.GetMeterProvider().Meter("ftl.controller")
+	burnRate, err := meter.Float64ObservableGauge("ftl.slo.burn_rate",
+		metric.WithDescription("rate the error budget of a verb was consumed at over the last hour, where 1 exhausts it at the end of the SLO window"))
+	if err != nil {
+		return err
+	}
+	budgetRemaining, err := meter.Float64ObservableGauge("ftl.slo.error_budget_remaining",
+		metric.WithDescription("fraction of the error budget of a verb over the SLO window that is left"))
+	if err != nil {
+		return err
+	}
+	_, err = meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
+		for _, status := range s.sloStatus.Load() {
+			attrs := metric.WithAttributes(attribute.String("ftl.verb.ref", status.Verb.Module+"."+status.Verb.Name))
+			observer.ObserveFloat64(burnRate, status.BurnRate, attrs)
+			observer.ObserveFloat64(budgetRemaining, status.ErrorBudgetRemaining, attrs)
+		}
+		return nil
+	}, burnRate, budgetRemaining)
+	return err
+}
+
+// sloStatus measures the calls to each verb over the SLO window and over the
+// last hour against the verb's SLO targets.
+func sloStatus(config SLOConfig, window, recent []dal.VerbCallStats) []*ftlv1.VerbSLOStatus {
+	recentCalls := map[string]dal.VerbCallStats{}
+	for _, stats := range recent {
+		recentCalls[stats.Verb.String()] = stats
+	}
+	out := make([]*ftlv1.VerbSLOStatus, 0, len(window))
+	for _, stats := range window {
+		ref := stats.Verb.String()
+		availabilityTarget := config.Availability
+		if target, ok := config.VerbAvailability[ref]; ok {
+			availabilityTarget = target
+		}
+		latencyTarget := config.Latency
+		if target, ok := config.VerbLatency[ref]; ok {
+			latencyTarget = target
+		}
+		budget := 1 - availabilityTarget
+		status := &ftlv1.VerbSLOStatus{
+			Verb:                 &schemapb.Ref{Module: stats.Verb.Module, Name: stats.Verb.Name},
+			AvailabilityTarget:   availabilityTarget,
+			LatencyTarget:        durationpb.New(latencyTarget),
+			Calls:                stats.Calls,
+			Errors:               stats.Errors,
+			Availability:         1,
+			P95Latency:           durationpb.New(stats.P95Duration),
+			ErrorBudgetRemaining: 1,
+			LatencyBreached:      stats.P95Duration > latencyTarget,
+		}
+		if stats.Calls > 0 {
+			errorRate := float64(stats.Errors) / float64(stats.Calls)
+			status.Availability = 1 - errorRate
+			status.ErrorBudgetRemaining = 1 - errorRate/budget
+		}
+		if recent, ok := recentCalls[ref]; ok && recent.Calls > 0 {
+			status.BurnRate = float64(recent.Errors) / float64(recent.Calls) / budget
+		}
+		status.Burning = status.BurnRate > 1
+		out = append(out, status)
+	}
+	return out
+}
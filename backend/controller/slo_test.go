@@ -0,0 +1,67 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+	"google.golang.org/protobuf/runtime/protoimpl"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+func TestSLOStatus(t *testing.T) {
+	config := SLOConfig{
+		Availability:     0.75,
+		Latency:          time.Second,
+		Window:           24 * time.Hour,
+		VerbAvailability: map[string]float64{"time.time": 0.5},
+		VerbLatency:      map[string]time.Duration{"time.time": 10 * time.Millisecond},
+	}
+	echo := schema.Ref{Module: "echo", Name: "echo"}
+	timeVerb := schema.Ref{Module: "time", Name: "time"}
+	window := []dal.VerbCallStats{
+		{Verb: echo, Calls: 1000, Errors: 125, P95Duration: 100 * time.Millisecond},
+		{Verb: timeVerb, Calls: 100, Errors: 75, P95Duration: 20 * time.Millisecond},
+	}
+	recent := []dal.VerbCallStats{
+		{Verb: echo, Calls: 100, Errors: 50, P95Duration: 100 * time.Millisecond},
+	}
+
+	assert.Equal(t, []*ftlv1.VerbSLOStatus{
+		{
+			Verb:                 &schemapb.Ref{Module: "echo", Name: "echo"},
+			AvailabilityTarget:   0.75,
+			LatencyTarget:        durationpb.New(time.Second),
+			Calls:                1000,
+			Errors:               125,
+			Availability:         0.875,
+			P95Latency:           durationpb.New(100 * time.Millisecond),
+			ErrorBudgetRemaining: 0.5,
+			BurnRate:             2,
+			Burning:              true,
+		},
+		{
+			Verb:                 &schemapb.Ref{Module: "time", Name: "time"},
+			AvailabilityTarget:   0.5,
+			LatencyTarget:        durationpb.New(10 * time.Millisecond),
+			Calls:                100,
+			Errors:               75,
+			Availability:         0.25,
+			P95Latency:           durationpb.New(20 * time.Millisecond),
+			ErrorBudgetRemaining: -0.5,
+			LatencyBreached:      true,
+		},
+	}, sloStatus(config, window, recent), assert.Exclude[protoimpl.MessageState]())
+}
+
+func TestSLOConfigValidate(t *testing.T) {
+	config := SLOConfig{Availability: 0.999, Window: time.Hour}
+	assert.NoError(t, config.validate())
+	config.VerbAvailability = map[string]float64{"echo.echo": 1}
+	assert.EqualError(t, config.validate(), "SLO availability target for echo.echo must be between 0 and 1, got 1")
+}
@@ -4,6 +4,7 @@ package sql_test
 
 import (
 	"testing"
+	"time"
 
 	in "github.com/TBD54566975/ftl/integration"
 )
@@ -17,6 +18,15 @@ func TestDatabase(t *testing.T) {
 		in.Call("database", "insert", in.Obj{"data": "hello"}, nil),
 		in.QueryRow("testdb", "SELECT data FROM requests", "hello"),
 
+		// "pooled_requests" is created by the module's migrations
+		in.Call("database", "insertPooled", in.Obj{"data": "pooled"}, nil),
+		in.QueryRow("testdb", "SELECT data FROM pooled_requests", "pooled"),
+
+		// events published in a transaction are delivered once it commits
+		in.Call("database", "insertTransactional", in.Obj{"data": "transactional"}, nil),
+		in.Sleep(time.Second*2),
+		in.QueryRow("testdb", "SELECT data FROM recorded_requests", "transactional"),
+
 		// run tests which should only affect "testdb_test"
 		in.CreateDBAction("database", "testdb", true),
 		in.ExecModuleTest("database"),
@@ -39,3 +39,26 @@ func Migrate(ctx context.Context, dsn string) error {
 	}
 	return nil
 }
+
+// PendingMigrations returns the versions of migrations that have not yet been applied to the database.
+func PendingMigrations(ctx context.Context, dsn string) ([]string, error) {
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("invalid DSN: %w", err)
+	}
+	db := dbmate.New(u)
+	db.FS = migrationSchema
+	db.Log = log.FromContext(ctx).Scope("migrate").WriterAt(log.Debug)
+	db.MigrationsDir = []string{"schema"}
+	migrations, err := db.FindMigrations()
+	if err != nil {
+		return nil, fmt.Errorf("failed to find migrations: %w", err)
+	}
+	pending := []string{}
+	for _, migration := range migrations {
+		if !migration.Applied {
+			pending = append(pending, migration.Version)
+		}
+	}
+	return pending, nil
+}
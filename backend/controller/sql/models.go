@@ -361,11 +361,37 @@ func (ns NullTopicSubscriptionState) Value() (driver.Value, error) {
 	return string(ns.TopicSubscriptionState), nil
 }
 
-type Artefact struct {
+type AdminResource struct {
 	ID        int64
 	CreatedAt time.Time
-	Digest    []byte
-	Content   []byte
+	UpdatedAt time.Time
+	Kind      string
+	Name      string
+	Spec      []byte
+	Version   int64
+}
+
+type Artefact struct {
+	ID          int64
+	CreatedAt   time.Time
+	Digest      []byte
+	Content     []byte
+	ColdAt      optional.Option[time.Time]
+	Compression optional.Option[string]
+}
+
+type ArtefactChunk struct {
+	ID          int64
+	CreatedAt   time.Time
+	Digest      []byte
+	Content     []byte
+	Compression optional.Option[string]
+}
+
+type ArtefactChunkRef struct {
+	ArtefactID int64
+	ChunkID    int64
+	Seq        int32
 }
 
 type AsyncCall struct {
@@ -382,6 +408,9 @@ type AsyncCall struct {
 	RemainingAttempts int32
 	Backoff           time.Duration
 	MaxBackoff        time.Duration
+	ParentRequestKey  optional.Option[string]
+	OrderingKey       optional.Option[string]
+	FirstAttemptID    optional.Option[int64]
 }
 
 type Controller struct {
@@ -403,16 +432,19 @@ type CronJob struct {
 	NextExecution time.Time
 	State         model.CronJobState
 	ModuleName    string
+	Paused        bool
 }
 
 type Deployment struct {
-	ID          int64
-	CreatedAt   time.Time
-	ModuleID    int64
-	Key         model.DeploymentKey
-	Schema      *schema.Module
-	Labels      []byte
-	MinReplicas int32
+	ID            int64
+	CreatedAt     time.Time
+	ModuleID      int64
+	Key           model.DeploymentKey
+	Schema        *schema.Module
+	Labels        []byte
+	MinReplicas   int32
+	CrashCount    int32
+	LastCrashedAt optional.Option[time.Time]
 }
 
 type DeploymentArtefact struct {
@@ -423,6 +455,14 @@ type DeploymentArtefact struct {
 	Path         string
 }
 
+type DeploymentQuarantine struct {
+	ID           int64
+	CreatedAt    time.Time
+	DeploymentID int64
+	Reason       string
+	Schema       []byte
+}
+
 type Event struct {
 	ID           int64
 	TimeStamp    time.Time
@@ -436,6 +476,18 @@ type Event struct {
 	Payload      json.RawMessage
 }
 
+type Fault struct {
+	ID        int64
+	CreatedAt time.Time
+	Kind      string
+	Module    string
+	Verb      optional.Option[string]
+	Percent   float64
+	LatencyMs int64
+	Message   string
+	ExpiresAt time.Time
+}
+
 type FsmInstance struct {
 	ID               int64
 	CreatedAt        time.Time
@@ -478,6 +530,32 @@ type ModuleConfiguration struct {
 	Value     []byte
 }
 
+type ModuleFeatureFlag struct {
+	ID        int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Module    string
+	Name      string
+	Rules     []byte
+}
+
+type ModuleLogLevel struct {
+	ID        int64
+	CreatedAt time.Time
+	Module    string
+	Level     string
+	ExpiresAt time.Time
+}
+
+type ProvisionedDatabase struct {
+	ID          int64
+	CreatedAt   time.Time
+	Module      string
+	Name        string
+	Provisioner string
+	Resource    string
+}
+
 type Request struct {
 	ID         int64
 	Origin     Origin
@@ -509,11 +587,13 @@ type Topic struct {
 }
 
 type TopicEvent struct {
-	ID        int64
-	CreatedAt time.Time
-	Key       model.TopicEventKey
-	TopicID   int64
-	Payload   []byte
+	ID          int64
+	CreatedAt   time.Time
+	Key         model.TopicEventKey
+	TopicID     int64
+	Payload     []byte
+	RequestKey  optional.Option[string]
+	OrderingKey optional.Option[string]
 }
 
 type TopicSubscriber struct {
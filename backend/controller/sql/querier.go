@@ -16,19 +16,37 @@ import (
 )
 
 type Querier interface {
+	// Abandon an FSM transition, leaving the instance in its current state.
+	AbortFSMTransition(ctx context.Context, fsm schema.RefKey, key string) (bool, error)
 	// Reserve a pending async call for execution, returning the associated lease
 	// reservation key.
+	//
+	// Calls with an ordering key are leased by their key rather than their ID, so
+	// that only one call per key executes at a time, and are only acquired once
+	// all earlier calls with the same key have completed.
 	AcquireAsyncCall(ctx context.Context, ttl time.Duration) (AcquireAsyncCallRow, error)
+	AssociateArtefactChunk(ctx context.Context, artefactID int64, chunkID int64, seq int32) error
 	AssociateArtefactWithDeployment(ctx context.Context, arg AssociateArtefactWithDeploymentParams) error
 	BeginConsumingTopicEvent(ctx context.Context, subscription model.SubscriptionKey, event model.TopicEventKey) error
 	CompleteEventForSubscription(ctx context.Context, name string, module string) error
+	CreateAdminResource(ctx context.Context, kind string, name string, spec []byte) (AdminResource, error)
 	// Create a new artefact and return the artefact ID.
+	//
+	// The content of chunked artefacts is NULL.
 	CreateArtefact(ctx context.Context, digest []byte, content []byte) (int64, error)
 	CreateAsyncCall(ctx context.Context, arg CreateAsyncCallParams) (int64, error)
 	CreateCronJob(ctx context.Context, arg CreateCronJobParams) error
-	CreateDeployment(ctx context.Context, moduleName string, schema []byte, key model.DeploymentKey) error
+	CreateDeployment(ctx context.Context, arg CreateDeploymentParams) error
+	CreateFault(ctx context.Context, arg CreateFaultParams) (int64, error)
 	CreateIngressRoute(ctx context.Context, arg CreateIngressRouteParams) error
+	CreateProvisionedDatabase(ctx context.Context, arg CreateProvisionedDatabaseParams) error
 	CreateRequest(ctx context.Context, origin Origin, key model.RequestKey, sourceAddr string) error
+	// Delete a resource if it is at "version", or any version if it is NULL.
+	DeleteAdminResource(ctx context.Context, kind string, name string, version optional.Option[int64]) (int64, error)
+	// Delete the fault with "id", or every fault if it is NULL.
+	DeleteFaults(ctx context.Context, id optional.Option[int64]) (int64, error)
+	DeleteModuleLogLevel(ctx context.Context, module string) error
+	DeleteProvisionedDatabase(ctx context.Context, module string, name string) error
 	DeleteSubscribers(ctx context.Context, deployment model.DeploymentKey) error
 	DeleteSubscriptions(ctx context.Context, deployment model.DeploymentKey) error
 	DeregisterRunner(ctx context.Context, key model.RunnerKey) (int64, error)
@@ -36,18 +54,39 @@ type Querier interface {
 	ExpireLeases(ctx context.Context) (int64, error)
 	ExpireRunnerReservations(ctx context.Context) (int64, error)
 	FailAsyncCall(ctx context.Context, error string, iD int64) (bool, error)
+	// Retries keep the creation time of the failed call, so that they are still
+	// ordered before later calls with the same ordering key.
 	FailAsyncCallWithRetry(ctx context.Context, arg FailAsyncCallWithRetryParams) (bool, error)
 	FailFSMInstance(ctx context.Context, fsm schema.RefKey, key string) (bool, error)
 	// Mark an FSM transition as completed, updating the current state and clearing the async call ID.
 	FinishFSMTransition(ctx context.Context, fsm schema.RefKey, key string) (bool, error)
 	GetActiveControllers(ctx context.Context) ([]Controller, error)
+	// The schemas of active deployments as stored, for checking that they can
+	// still be parsed.
+	GetActiveDeploymentRawSchemas(ctx context.Context) ([]GetActiveDeploymentRawSchemasRow, error)
 	GetActiveDeploymentSchemas(ctx context.Context) ([]GetActiveDeploymentSchemasRow, error)
 	GetActiveDeployments(ctx context.Context) ([]GetActiveDeploymentsRow, error)
+	// Get the faults that have not expired.
+	GetActiveFaults(ctx context.Context) ([]Fault, error)
 	GetActiveIngressRoutes(ctx context.Context) ([]GetActiveIngressRoutesRow, error)
 	GetActiveRunners(ctx context.Context) ([]GetActiveRunnersRow, error)
-	GetArtefactContentRange(ctx context.Context, start int32, count int32, iD int64) ([]byte, error)
+	GetAdminResource(ctx context.Context, kind string, name string) (AdminResource, error)
+	// Return the digests of the chunks that exist in the database.
+	GetArtefactChunkDigests(ctx context.Context, digests [][]byte) ([][]byte, error)
+	GetArtefactChunks(ctx context.Context, digests [][]byte) ([]GetArtefactChunksRow, error)
+	// Get the stored content of an artefact in order, along with its compression.
+	//
+	// The first row is the content of the artefact itself, which is NULL if it is
+	// chunked, followed by its chunks.
+	GetArtefactContent(ctx context.Context, digest []byte) ([]GetArtefactContentRow, error)
 	// Return the digests that exist in the database.
 	GetArtefactDigests(ctx context.Context, digests [][]byte) ([]GetArtefactDigestsRow, error)
+	// Get the async calls triggered by a request, such as FSM transitions and pubsub deliveries.
+	GetAsyncCallsForRequest(ctx context.Context, requestKey string) ([]GetAsyncCallsForRequestRow, error)
+	// Failed calls since a point in time, grouped by the fingerprint of their error.
+	GetCallErrorGroups(ctx context.Context, since time.Time) ([]GetCallErrorGroupsRow, error)
+	// Calls since a point in time, aggregated by source and destination verb.
+	GetCallGraphEdges(ctx context.Context, since time.Time) ([]GetCallGraphEdgesRow, error)
 	GetCronJobs(ctx context.Context) ([]GetCronJobsRow, error)
 	GetDeployment(ctx context.Context, key model.DeploymentKey) (GetDeploymentRow, error)
 	// Get all artefacts matching the given digests.
@@ -60,14 +99,32 @@ type Querier interface {
 	GetDeploymentsWithMinReplicas(ctx context.Context) ([]GetDeploymentsWithMinReplicasRow, error)
 	GetExistingDeploymentForModule(ctx context.Context, name string) (GetExistingDeploymentForModuleRow, error)
 	GetFSMInstance(ctx context.Context, fsm schema.RefKey, key string) (FsmInstance, error)
+	GetFSMInstances(ctx context.Context) ([]FsmInstance, error)
 	GetIdleRunners(ctx context.Context, labels []byte, limit int64) ([]Runner, error)
+	// Get the digests of artefacts stored in the database that are only used by
+	// deployments that have been inactive since "inactive_since".
+	//
+	// A deployment is active while it has replicas, and the time it was last active
+	// is the time of its most recent event.
+	GetInactiveArtefacts(ctx context.Context, inactiveSince time.Time, max int32) ([][]byte, error)
 	// Get the runner endpoints corresponding to the given ingress route.
 	GetIngressRoutes(ctx context.Context, method string) ([]GetIngressRoutesRow, error)
+	// Get the latest attempt of an async call, following its retries.
+	GetLatestAsyncCallAttempt(ctx context.Context, id int64) (GetLatestAsyncCallAttemptRow, error)
 	GetLeaseInfo(ctx context.Context, key leases.Key) (GetLeaseInfoRow, error)
+	// Get the log level override of a module, if it has not expired.
+	GetModuleLogLevel(ctx context.Context, module string) (GetModuleLogLevelRow, error)
 	GetModulesByID(ctx context.Context, ids []int64) ([]Module, error)
 	GetNextEventForSubscription(ctx context.Context, consumptionDelay time.Duration, topic model.TopicKey, cursor optional.Option[model.TopicEventKey]) (GetNextEventForSubscriptionRow, error)
+	// Get deployments likely to need runners soon: those with fewer runners than
+	// required, followed by the newest deployment of each module if it was created
+	// recently and has no runners yet.
+	GetPrefetchCandidates(ctx context.Context, languages []string, createdAfter time.Time, max int32) ([]model.DeploymentKey, error)
 	GetProcessList(ctx context.Context) ([]GetProcessListRow, error)
+	GetProvisionedDatabase(ctx context.Context, module string, name string) (ProvisionedDatabase, error)
+	GetProvisionedDatabases(ctx context.Context) ([]ProvisionedDatabase, error)
 	GetRandomSubscriber(ctx context.Context, key model.SubscriptionKey) (GetRandomSubscriberRow, error)
+	GetRequest(ctx context.Context, key string) (GetRequestRow, error)
 	// Retrieve routing information for a runner.
 	GetRouteForRunner(ctx context.Context, key model.RunnerKey) (GetRouteForRunnerRow, error)
 	GetRoutingTable(ctx context.Context, modules []string) ([]GetRoutingTableRow, error)
@@ -80,6 +137,8 @@ type Querier interface {
 	// Sorting ensures that brand new events (that may not be ready for consumption)
 	// don't prevent older events from being consumed
 	GetSubscriptionsNeedingUpdate(ctx context.Context) ([]GetSubscriptionsNeedingUpdateRow, error)
+	// Calls to each verb since a point in time.
+	GetVerbCallStats(ctx context.Context, since time.Time) ([]GetVerbCallStatsRow, error)
 	InsertCallEvent(ctx context.Context, arg InsertCallEventParams) error
 	InsertDeploymentCreatedEvent(ctx context.Context, arg InsertDeploymentCreatedEventParams) error
 	InsertDeploymentUpdatedEvent(ctx context.Context, arg InsertDeploymentUpdatedEventParams) error
@@ -89,15 +148,37 @@ type Querier interface {
 	// Mark any controller entries that haven't been updated recently as dead.
 	KillStaleControllers(ctx context.Context, timeout time.Duration) (int64, error)
 	KillStaleRunners(ctx context.Context, timeout time.Duration) (int64, error)
+	ListAdminResources(ctx context.Context, kind string) ([]AdminResource, error)
 	LoadAsyncCall(ctx context.Context, id int64) (AsyncCall, error)
+	// Remove the content of an artefact that has been copied to cold storage with
+	// the given compression, along with any of its chunks that no other artefact
+	// contains.
+	MoveArtefactToColdStorage(ctx context.Context, compression optional.Option[string], digest []byte) error
 	NewLease(ctx context.Context, key leases.Key, ttl time.Duration, metadata []byte) (uuid.UUID, error)
 	PublishEventForTopic(ctx context.Context, arg PublishEventForTopicParams) error
+	// Count a crash of a replica of a deployment, returning its number of
+	// consecutive crashes. Crashes are no longer consecutive if the deployment
+	// hasn't crashed for "reset_after".
+	// Scale down a deployment and replace its schema with "placeholder", keeping
+	// the original schema in deployment_quarantines. Returns false if the
+	// deployment was already quarantined.
+	QuarantineDeployment(ctx context.Context, reason string, key model.DeploymentKey, placeholder *schema.Module) (bool, error)
+	RecordDeploymentCrash(ctx context.Context, resetAfter time.Duration, key model.DeploymentKey) (int32, error)
 	ReleaseLease(ctx context.Context, idempotencyKey uuid.UUID, key leases.Key) (bool, error)
 	RenewLease(ctx context.Context, ttl time.Duration, idempotencyKey uuid.UUID, key leases.Key) (bool, error)
 	ReplaceDeployment(ctx context.Context, oldDeployment model.DeploymentKey, newDeployment model.DeploymentKey, minReplicas int32) (int64, error)
 	// Find an idle runner and reserve it for the given deployment.
+	//
+	// Runners dedicated to a project are only reserved for deployments from that
+	// project, while shared runners are reserved for any deployment. Deployments
+	// built for a platform are only reserved runners on that platform.
 	ReserveRunner(ctx context.Context, reservationTimeout time.Time, deploymentKey model.DeploymentKey, labels []byte) (Runner, error)
+	ResetDeploymentCrashes(ctx context.Context, module string) error
+	// Restore an FSM instance from a backup, unless it already exists.
+	RestoreFSMInstance(ctx context.Context, arg RestoreFSMInstanceParams) error
+	SetCronJobsPaused(ctx context.Context, paused bool, module string, verb optional.Option[string]) ([]model.CronJobKey, error)
 	SetDeploymentDesiredReplicas(ctx context.Context, key model.DeploymentKey, minReplicas int32) error
+	SetModuleLogLevel(ctx context.Context, module string, level string, expiresAt time.Time) error
 	StartCronJobs(ctx context.Context, keys []string) ([]StartCronJobsRow, error)
 	// Start a new FSM transition, populating the destination state and async call ID.
 	//
@@ -105,6 +186,11 @@ type Querier interface {
 	StartFSMTransition(ctx context.Context, arg StartFSMTransitionParams) (FsmInstance, error)
 	SucceedAsyncCall(ctx context.Context, response []byte, iD int64) (bool, error)
 	SucceedFSMInstance(ctx context.Context, fsm schema.RefKey, key string) (bool, error)
+	// Update the spec of a resource if it is at "version", or any version if it
+	// is NULL.
+	UpdateAdminResource(ctx context.Context, arg UpdateAdminResourceParams) (AdminResource, error)
+	// Create a chunk of artefact content if it doesn't exist, and return its ID.
+	UpsertArtefactChunk(ctx context.Context, digest []byte, content []byte, compression optional.Option[string]) (int64, error)
 	UpsertController(ctx context.Context, key model.ControllerKey, endpoint string) (int64, error)
 	UpsertModule(ctx context.Context, language string, name string) (int64, error)
 	// Upsert a runner and return the deployment ID that it is assigned to, if any.
@@ -15,8 +15,8 @@ FROM modules
 WHERE id = ANY (@ids::BIGINT[]);
 
 -- name: CreateDeployment :exec
-INSERT INTO deployments (module_id, "schema", "key")
-VALUES ((SELECT id FROM modules WHERE name = @module_name::TEXT LIMIT 1), @schema::BYTEA, @key::deployment_key);
+INSERT INTO deployments (module_id, "schema", "key", labels)
+VALUES ((SELECT id FROM modules WHERE name = @module_name::TEXT LIMIT 1), @schema::BYTEA, @key::deployment_key, @labels::JSONB);
 
 -- name: GetArtefactDigests :many
 -- Return the digests that exist in the database.
@@ -26,17 +26,42 @@ WHERE digest = ANY (@digests::bytea[]);
 
 -- name: GetDeploymentArtefacts :many
 -- Get all artefacts matching the given digests.
-SELECT da.created_at, artefact_id AS id, executable, path, digest, executable
+SELECT da.created_at, artefact_id AS id, executable, path, digest, executable, (artefacts.cold_at IS NOT NULL)::BOOLEAN AS cold,
+       artefacts.compression
 FROM deployment_artefacts da
          INNER JOIN artefacts ON artefacts.id = da.artefact_id
 WHERE deployment_id = $1;
 
 -- name: CreateArtefact :one
 -- Create a new artefact and return the artefact ID.
+--
+-- The content of chunked artefacts is NULL.
 INSERT INTO artefacts (digest, content)
 VALUES ($1, $2)
 RETURNING id;
 
+-- name: UpsertArtefactChunk :one
+-- Create a chunk of artefact content if it doesn't exist, and return its ID.
+INSERT INTO artefact_chunks (digest, content, compression)
+VALUES (@digest::BYTEA, @content::BYTEA, sqlc.narg('compression')::TEXT)
+ON CONFLICT (digest) DO UPDATE SET digest = EXCLUDED.digest
+RETURNING id;
+
+-- name: AssociateArtefactChunk :exec
+INSERT INTO artefact_chunk_refs (artefact_id, chunk_id, seq)
+VALUES (@artefact_id::BIGINT, @chunk_id::BIGINT, @seq::INT);
+
+-- name: GetArtefactChunkDigests :many
+-- Return the digests of the chunks that exist in the database.
+SELECT digest
+FROM artefact_chunks
+WHERE digest = ANY (@digests::bytea[]);
+
+-- name: GetArtefactChunks :many
+SELECT digest, content, compression
+FROM artefact_chunks
+WHERE digest = ANY (@digests::bytea[]);
+
 -- name: AssociateArtefactWithDeployment :exec
 INSERT INTO deployment_artefacts (deployment_id, artefact_id, executable, path)
 VALUES ((SELECT id FROM deployments WHERE key = @key::deployment_key), $2, $3, $4);
@@ -73,10 +98,70 @@ WHERE EXISTS (SELECT 1
               HAVING COUNT(*) = @count::BIGINT -- Number of unique digests provided
 );
 
--- name: GetArtefactContentRange :one
-SELECT SUBSTRING(a.content FROM @start FOR @count)::BYTEA AS content
+-- name: GetArtefactContent :many
+-- Get the stored content of an artefact in order, along with its compression.
+--
+-- The first row is the content of the artefact itself, which is NULL if it is
+-- chunked, followed by its chunks.
+SELECT parts.content, parts.compression
+FROM (SELECT -1 AS seq, a.content, a.compression
+      FROM artefacts a
+      WHERE a.digest = @digest::BYTEA
+      UNION ALL
+      SELECT r.seq, c.content, c.compression
+      FROM artefacts a
+               INNER JOIN artefact_chunk_refs r ON r.artefact_id = a.id
+               INNER JOIN artefact_chunks c ON c.id = r.chunk_id
+      WHERE a.digest = @digest::BYTEA) parts
+ORDER BY parts.seq;
+
+-- name: GetInactiveArtefacts :many
+-- Get the digests of artefacts stored in the database that are only used by
+-- deployments that have been inactive since "inactive_since".
+--
+-- A deployment is active while it has replicas, and the time it was last active
+-- is the time of its most recent event.
+SELECT a.digest
 FROM artefacts a
-WHERE a.id = @id;
+WHERE a.cold_at IS NULL
+  AND a.created_at < @inactive_since::TIMESTAMPTZ
+  AND NOT EXISTS (SELECT 1
+                  FROM deployment_artefacts da
+                           INNER JOIN deployments d ON d.id = da.deployment_id
+                  WHERE da.artefact_id = a.id
+                    AND (d.min_replicas > 0
+                      OR d.created_at >= @inactive_since::TIMESTAMPTZ
+                      OR EXISTS (SELECT 1
+                                 FROM events e
+                                 WHERE e.deployment_id = d.id
+                                   AND e.time_stamp >= @inactive_since::TIMESTAMPTZ)))
+ORDER BY a.id
+LIMIT @max::INT;
+
+-- name: MoveArtefactToColdStorage :exec
+-- Remove the content of an artefact that has been copied to cold storage with
+-- the given compression, along with any of its chunks that no other artefact
+-- contains.
+WITH moved AS (
+    UPDATE artefacts
+        SET content = NULL,
+            compression = sqlc.narg('compression')::TEXT,
+            cold_at = (NOW() AT TIME ZONE 'utc')
+        WHERE digest = @digest::BYTEA
+            AND cold_at IS NULL
+        RETURNING id),
+     refs AS (
+         DELETE FROM artefact_chunk_refs r
+             USING moved
+             WHERE r.artefact_id = moved.id
+             RETURNING r.artefact_id, r.chunk_id)
+DELETE
+FROM artefact_chunks c
+WHERE c.id IN (SELECT chunk_id FROM refs)
+  AND NOT EXISTS (SELECT 1
+                  FROM artefact_chunk_refs r
+                  WHERE r.chunk_id = c.id
+                    AND r.artefact_id NOT IN (SELECT artefact_id FROM refs));
 
 -- name: UpsertRunner :one
 -- Upsert a runner and return the deployment ID that it is assigned to, if any.
@@ -161,6 +246,33 @@ ORDER BY d.key;
 -- name: GetActiveDeploymentSchemas :many
 SELECT key, schema FROM deployments WHERE min_replicas > 0;
 
+-- name: GetActiveDeploymentRawSchemas :many
+-- The schemas of active deployments as stored, for checking that they can
+-- still be parsed.
+SELECT d.key, m.name AS module_name, d."schema"::BYTEA AS "schema"
+FROM deployments d
+  INNER JOIN modules m ON d.module_id = m.id
+WHERE d.min_replicas > 0;
+
+-- name: QuarantineDeployment :one
+-- Scale down a deployment and replace its schema with "placeholder", keeping
+-- the original schema in deployment_quarantines. Returns false if the
+-- deployment was already quarantined.
+WITH quarantined AS (
+  INSERT INTO deployment_quarantines (deployment_id, reason, "schema")
+  SELECT id, sqlc.arg('reason')::TEXT, "schema"::BYTEA
+  FROM deployments
+  WHERE key = sqlc.arg('key')::deployment_key
+  ON CONFLICT (deployment_id) DO NOTHING
+  RETURNING deployment_id
+), updated AS (
+  UPDATE deployments
+  SET min_replicas = 0, "schema" = sqlc.arg('placeholder')::module_schema_pb
+  WHERE id IN (SELECT deployment_id FROM quarantined)
+  RETURNING id
+)
+SELECT COUNT(*) > 0 AS quarantined FROM updated;
+
 -- name: GetSchemaForDeployment :one
 SELECT schema FROM deployments WHERE key = sqlc.arg('key')::deployment_key;
 
@@ -170,7 +282,9 @@ SELECT d.min_replicas,
        d.labels    deployment_labels,
        r.key    AS runner_key,
        r.endpoint,
-       r.labels AS runner_labels
+       r.labels AS runner_labels,
+       d.crash_count,
+       d.last_crashed_at
 FROM deployments d
          LEFT JOIN runners r on d.id = r.deployment_id AND r.state != 'dead'
 WHERE d.min_replicas > 0
@@ -203,16 +317,123 @@ SELECT d.key                 AS deployment_key,
        m.name                 AS module_name,
        m.language             AS language,
        COUNT(r.id)            AS assigned_runners_count,
-       d.min_replicas::BIGINT AS required_runners_count
+       d.min_replicas::BIGINT AS required_runners_count,
+       d.crash_count,
+       d.last_crashed_at
 FROM deployments d
          LEFT JOIN runners r ON d.id = r.deployment_id AND r.state <> 'dead'
          JOIN modules m ON d.module_id = m.id
-GROUP BY d.key, d.min_replicas, m.name, m.language
+GROUP BY d.key, d.min_replicas, d.crash_count, d.last_crashed_at, m.name, m.language
 HAVING COUNT(r.id) <> d.min_replicas;
 
+-- name: RecordDeploymentCrash :one
+-- Count a crash of a replica of a deployment, returning its number of
+-- consecutive crashes. Crashes are no longer consecutive if the deployment
+-- hasn't crashed for "reset_after".
+UPDATE deployments
+SET crash_count     = CASE
+                          WHEN last_crashed_at IS NULL
+                              OR last_crashed_at < (NOW() AT TIME ZONE 'utc') - sqlc.arg('reset_after')::INTERVAL
+                              THEN 1
+                          ELSE crash_count + 1 END,
+    last_crashed_at = (NOW() AT TIME ZONE 'utc')::TIMESTAMPTZ
+WHERE key = sqlc.arg('key')::deployment_key
+RETURNING crash_count;
+
+-- name: ResetDeploymentCrashes :exec
+UPDATE deployments d
+SET crash_count     = 0,
+    last_crashed_at = NULL
+FROM modules m
+WHERE d.module_id = m.id
+  AND m.name = sqlc.arg('module')::TEXT;
+
+
+-- name: GetPrefetchCandidates :many
+-- Get deployments likely to need runners soon: those with fewer runners than
+-- required, followed by the newest deployment of each module if it was created
+-- recently and has no runners yet.
+SELECT d.key AS deployment_key
+FROM deployments d
+         LEFT JOIN runners r ON d.id = r.deployment_id AND r.state <> 'dead'
+         JOIN modules m ON d.module_id = m.id
+WHERE m.language = ANY (sqlc.arg('languages')::TEXT[])
+  AND (d.min_replicas > 0
+    OR (d.created_at > sqlc.arg('created_after')::TIMESTAMPTZ
+        AND NOT EXISTS (SELECT 1
+                        FROM deployments newer
+                        WHERE newer.module_id = d.module_id
+                          AND newer.created_at > d.created_at)))
+GROUP BY d.id, d.key, d.min_replicas, d.created_at
+HAVING COUNT(r.id) < GREATEST(d.min_replicas, 1)
+ORDER BY d.min_replicas > 0 DESC, d.created_at DESC
+LIMIT sqlc.arg('max')::INT;
+
+-- name: SetModuleLogLevel :exec
+INSERT INTO module_log_levels (module, level, expires_at)
+VALUES (sqlc.arg('module')::TEXT, sqlc.arg('level')::TEXT, sqlc.arg('expires_at')::TIMESTAMPTZ)
+ON CONFLICT (module) DO UPDATE
+SET level = EXCLUDED.level,
+    expires_at = EXCLUDED.expires_at;
+
+-- name: DeleteModuleLogLevel :exec
+DELETE FROM module_log_levels
+WHERE module = sqlc.arg('module')::TEXT;
+
+-- name: GetModuleLogLevel :one
+-- Get the log level override of a module, if it has not expired.
+SELECT level, expires_at
+FROM module_log_levels
+WHERE module = sqlc.arg('module')::TEXT
+  AND expires_at > (NOW() AT TIME ZONE 'utc');
+
+-- name: CreateFault :one
+INSERT INTO faults (kind, module, verb, percent, latency_ms, message, expires_at)
+VALUES (sqlc.arg('kind')::TEXT, sqlc.arg('module')::TEXT, sqlc.narg('verb')::TEXT, sqlc.arg('percent')::DOUBLE PRECISION,
+        sqlc.arg('latency_ms')::BIGINT, sqlc.arg('message')::TEXT, sqlc.arg('expires_at')::TIMESTAMPTZ)
+RETURNING id;
+
+-- name: GetActiveFaults :many
+-- Get the faults that have not expired.
+SELECT *
+FROM faults
+WHERE expires_at > (NOW() AT TIME ZONE 'utc')
+ORDER BY id;
+
+-- name: DeleteFaults :one
+-- Delete the fault with "id", or every fault if it is NULL.
+WITH rows AS (
+    DELETE FROM faults
+        WHERE sqlc.narg('id')::BIGINT IS NULL
+            OR id = sqlc.narg('id')::BIGINT
+        RETURNING 1)
+SELECT COUNT(*)
+FROM rows;
+
+-- name: CreateProvisionedDatabase :exec
+INSERT INTO provisioned_databases (module, name, provisioner, resource)
+VALUES (sqlc.arg('module')::TEXT, sqlc.arg('name')::TEXT, sqlc.arg('provisioner')::TEXT, sqlc.arg('resource')::TEXT);
+
+-- name: GetProvisionedDatabase :one
+SELECT *
+FROM provisioned_databases
+WHERE module = sqlc.arg('module')::TEXT AND name = sqlc.arg('name')::TEXT;
+
+-- name: GetProvisionedDatabases :many
+SELECT *
+FROM provisioned_databases
+ORDER BY module, name;
+
+-- name: DeleteProvisionedDatabase :exec
+DELETE FROM provisioned_databases
+WHERE module = sqlc.arg('module')::TEXT AND name = sqlc.arg('name')::TEXT;
 
 -- name: ReserveRunner :one
 -- Find an idle runner and reserve it for the given deployment.
+--
+-- Runners dedicated to a project are only reserved for deployments from that
+-- project, while shared runners are reserved for any deployment. Deployments
+-- built for a platform are only reserved runners on that platform.
 UPDATE runners
 SET state               = 'reserved',
     reservation_timeout = sqlc.arg('reservation_timeout')::timestamptz,
@@ -226,6 +447,15 @@ WHERE id = (SELECT id
             FROM runners r
             WHERE r.state = 'idle'
               AND r.labels @> sqlc.arg('labels')::jsonb
+              AND COALESCE(r.labels ->> 'project', '') IN ('', COALESCE((SELECT d.labels ->> 'project'
+                                                                         FROM deployments d
+                                                                         WHERE d.key = sqlc.arg('deployment_key')::deployment_key
+                                                                         LIMIT 1), ''))
+              AND NOT EXISTS (SELECT 1
+                              FROM deployments d
+                              WHERE d.key = sqlc.arg('deployment_key')::deployment_key
+                                AND ((d.labels ->> 'os' IS NOT NULL AND r.labels ->> 'os' IS DISTINCT FROM d.labels ->> 'os')
+                                  OR (d.labels ->> 'arch' IS NOT NULL AND r.labels ->> 'arch' IS DISTINCT FROM d.labels ->> 'arch')))
             LIMIT 1 FOR UPDATE SKIP LOCKED)
 RETURNING runners.*;
 
@@ -283,7 +513,7 @@ SELECT COUNT(*)
 FROM rows;
 
 -- name: GetCronJobs :many
-SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.start_time, j.next_execution, j.state
+SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.start_time, j.next_execution, j.state, j.paused
 FROM cron_jobs j
   INNER JOIN deployments d on j.deployment_id = d.id
 WHERE d.min_replicas > 0;
@@ -313,6 +543,7 @@ SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j
   COALESCE(u.start_time, j.start_time) as start_time,
   COALESCE(u.next_execution, j.next_execution) as next_execution,
   COALESCE(u.state, j.state) as state,
+  j.paused,
   d.min_replicas > 0 as has_min_replicas,
   CASE WHEN u.key IS NULL THEN FALSE ELSE TRUE END as updated
 FROM cron_jobs j
@@ -330,18 +561,28 @@ UPDATE cron_jobs
     AND start_time = sqlc.arg('start_time')::TIMESTAMPTZ
   RETURNING *
 )
-SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.start_time, j.next_execution, j.state
+SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.start_time, j.next_execution, j.state, j.paused
   FROM j
   INNER JOIN deployments d on j.deployment_id = d.id
   LIMIT 1;
 
 -- name: GetStaleCronJobs :many
-SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.start_time, j.next_execution, j.state
+SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.start_time, j.next_execution, j.state, j.paused
 FROM cron_jobs j
   INNER JOIN deployments d on j.deployment_id = d.id
 WHERE state = 'executing'
   AND start_time < (NOW() AT TIME ZONE 'utc') - $1::INTERVAL;
 
+-- name: SetCronJobsPaused :many
+UPDATE cron_jobs j
+SET paused = sqlc.arg('paused')::BOOLEAN
+FROM deployments d
+WHERE j.deployment_id = d.id
+  AND d.min_replicas > 0
+  AND j.module_name = sqlc.arg('module')::TEXT
+  AND (sqlc.narg('verb')::TEXT IS NULL OR j.verb = sqlc.narg('verb')::TEXT)
+RETURNING j.key;
+
 -- name: InsertLogEvent :exec
 INSERT INTO events (deployment_id, request_id, time_stamp, custom_key_1, type, payload)
 VALUES ((SELECT id FROM deployments d WHERE d.key = sqlc.arg('deployment_key')::deployment_key LIMIT 1),
@@ -404,13 +645,70 @@ VALUES ((SELECT id FROM deployments WHERE deployments.key = sqlc.arg('deployment
                 'request', sqlc.arg('request')::JSONB,
                 'response', sqlc.arg('response')::JSONB,
                 'error', sqlc.narg('error')::TEXT,
-                'stack', sqlc.narg('stack')::TEXT
+                'stack', sqlc.narg('stack')::TEXT,
+                'fingerprint', sqlc.narg('fingerprint')::TEXT,
+                'request_size', sqlc.arg('request_size')::BIGINT,
+                'response_size', sqlc.arg('response_size')::BIGINT
             ));
 
+-- name: GetCallErrorGroups :many
+-- Failed calls since a point in time, grouped by the fingerprint of their error.
+SELECT (e.payload ->> 'fingerprint')::TEXT                                              AS fingerprint,
+       e.custom_key_3::TEXT                                                            AS dest_module,
+       e.custom_key_4::TEXT                                                            AS dest_verb,
+       COUNT(*)                                                                        AS count,
+       MIN(e.time_stamp)::TIMESTAMPTZ                                                  AS first_seen,
+       MAX(e.time_stamp)::TIMESTAMPTZ                                                  AS last_seen,
+       (ARRAY_AGG(e.payload ->> 'error' ORDER BY e.time_stamp DESC))[1]::TEXT          AS error,
+       COALESCE((ARRAY_AGG(e.payload ->> 'stack' ORDER BY e.time_stamp DESC))[1], '')::TEXT AS stack
+FROM events e
+WHERE e.type = 'call'
+  AND e.payload ->> 'fingerprint' IS NOT NULL
+  AND e.time_stamp >= sqlc.arg('since')::TIMESTAMPTZ
+GROUP BY e.payload ->> 'fingerprint', e.custom_key_3, e.custom_key_4
+ORDER BY count DESC, last_seen DESC;
+
+-- name: GetCallGraphEdges :many
+-- Calls since a point in time, aggregated by source and destination verb.
+SELECT COALESCE(e.custom_key_1, '')::TEXT                                          AS source_module,
+       COALESCE(e.custom_key_2, '')::TEXT                                          AS source_verb,
+       e.custom_key_3::TEXT                                                        AS dest_module,
+       e.custom_key_4::TEXT                                                        AS dest_verb,
+       COUNT(*)                                                                    AS calls,
+       COUNT(*) FILTER (WHERE e.payload ->> 'error' IS NOT NULL)                   AS errors,
+       AVG((e.payload ->> 'duration_ms')::BIGINT)::FLOAT8                          AS average_duration_ms,
+       PERCENTILE_CONT(0.95) WITHIN GROUP (
+           ORDER BY (e.payload ->> 'duration_ms')::BIGINT)::FLOAT8                 AS p95_duration_ms,
+       MAX((e.payload ->> 'duration_ms')::BIGINT)::BIGINT                          AS max_duration_ms
+FROM events e
+WHERE e.type = 'call'
+  AND e.time_stamp >= sqlc.arg('since')::TIMESTAMPTZ
+GROUP BY e.custom_key_1, e.custom_key_2, e.custom_key_3, e.custom_key_4
+ORDER BY dest_module, dest_verb, source_module, source_verb;
+
+-- name: GetVerbCallStats :many
+-- Calls to each verb since a point in time.
+SELECT e.custom_key_3::TEXT                                                        AS module,
+       e.custom_key_4::TEXT                                                        AS verb,
+       COUNT(*)                                                                    AS calls,
+       COUNT(*) FILTER (WHERE e.payload ->> 'error' IS NOT NULL)                   AS errors,
+       PERCENTILE_CONT(0.95) WITHIN GROUP (
+           ORDER BY (e.payload ->> 'duration_ms')::BIGINT)::FLOAT8                 AS p95_duration_ms
+FROM events e
+WHERE e.type = 'call'
+  AND e.time_stamp >= sqlc.arg('since')::TIMESTAMPTZ
+GROUP BY e.custom_key_3, e.custom_key_4
+ORDER BY module, verb;
+
 -- name: CreateRequest :exec
 INSERT INTO requests (origin, "key", source_addr)
 VALUES ($1, $2, $3);
 
+-- name: GetRequest :one
+SELECT origin, source_addr
+FROM requests
+WHERE "key" = @key::TEXT;
+
 -- name: UpsertController :one
 INSERT INTO controller (key, endpoint)
 VALUES ($1, $2)
@@ -501,22 +799,47 @@ FROM expired;
 SELECT expires_at, metadata FROM leases WHERE key = @key::lease_key;
 
 -- name: CreateAsyncCall :one
-INSERT INTO async_calls (verb, origin, request, remaining_attempts, backoff, max_backoff)
-VALUES (@verb, @origin, @request, @remaining_attempts, @backoff::interval, @max_backoff::interval)
+INSERT INTO async_calls (verb, origin, request, remaining_attempts, backoff, max_backoff, parent_request_key, ordering_key)
+VALUES (@verb, @origin, @request, @remaining_attempts, @backoff::interval, @max_backoff::interval, sqlc.narg('parent_request_key')::TEXT, sqlc.narg('ordering_key')::TEXT)
 RETURNING id;
 
 -- name: AcquireAsyncCall :one
 -- Reserve a pending async call for execution, returning the associated lease
 -- reservation key.
+--
+-- Calls with an ordering key are leased by their key rather than their ID, so
+-- that only one call per key executes at a time, and are only acquired once
+-- all earlier calls with the same key have completed.
 WITH async_call AS (
-  SELECT id
-  FROM async_calls
+  SELECT id,
+    CASE
+      WHEN ordering_key IS NULL THEN '/system/async_call/' || id
+      ELSE '/system/async_call_order/' || encode(sha256(convert_to(ordering_key, 'UTF8')), 'hex')
+    END AS lease_key
+  FROM async_calls AS calls
   WHERE state = 'pending' AND scheduled_at <= (NOW() AT TIME ZONE 'utc')
+    AND (
+      ordering_key IS NULL
+      OR (
+        NOT EXISTS (
+          SELECT 1
+          FROM leases
+          WHERE key = '/system/async_call_order/' || encode(sha256(convert_to(calls.ordering_key, 'UTF8')), 'hex')
+        )
+        AND NOT EXISTS (
+          SELECT 1
+          FROM async_calls AS earlier
+          WHERE earlier.ordering_key = calls.ordering_key
+            AND earlier.state = 'pending'
+            AND (earlier.created_at, earlier.id) < (calls.created_at, calls.id)
+        )
+      )
+    )
   LIMIT 1
   FOR UPDATE SKIP LOCKED
 ), lease AS (
   INSERT INTO leases (idempotency_key, key, expires_at)
-  VALUES (gen_random_uuid(), '/system/async_call/' || (SELECT id FROM async_call), (NOW() AT TIME ZONE 'utc') + @ttl::interval)
+  VALUES (gen_random_uuid(), (SELECT lease_key FROM async_call), (NOW() AT TIME ZONE 'utc') + @ttl::interval)
   RETURNING *
 )
 UPDATE async_calls
@@ -532,7 +855,8 @@ RETURNING
   scheduled_at,
   remaining_attempts,
   backoff,
-  max_backoff;
+  max_backoff,
+  ordering_key;
 
 -- name: SucceedAsyncCall :one
 UPDATE async_calls
@@ -551,6 +875,8 @@ WHERE id = @id
 RETURNING true;
 
 -- name: FailAsyncCallWithRetry :one
+-- Retries keep the creation time of the failed call, so that they are still
+-- ordered before later calls with the same ordering key.
 WITH updated AS (
   UPDATE async_calls
   SET state = 'error'::async_call_state,
@@ -558,11 +884,26 @@ WITH updated AS (
   WHERE id = @id::BIGINT
   RETURNING *
 )
-INSERT INTO async_calls (verb, origin, request, remaining_attempts, backoff, max_backoff, scheduled_at)
-SELECT updated.verb, updated.origin, updated.request, @remaining_attempts, @backoff::interval, @max_backoff::interval, @scheduled_at::TIMESTAMPTZ
+INSERT INTO async_calls (verb, origin, request, remaining_attempts, backoff, max_backoff, scheduled_at, parent_request_key, ordering_key, created_at, first_attempt_id)
+SELECT updated.verb, updated.origin, updated.request, @remaining_attempts, @backoff::interval, @max_backoff::interval, @scheduled_at::TIMESTAMPTZ, updated.parent_request_key, updated.ordering_key, updated.created_at, COALESCE(updated.first_attempt_id, updated.id)
   FROM updated
   RETURNING true;
 
+-- name: GetAsyncCallsForRequest :many
+-- Get the async calls triggered by a request, such as FSM transitions and pubsub deliveries.
+SELECT id, created_at, verb, state, origin, scheduled_at, error
+FROM async_calls
+WHERE parent_request_key = @request_key::TEXT
+ORDER BY created_at, id;
+
+-- name: GetLatestAsyncCallAttempt :one
+-- Get the latest attempt of an async call, following its retries.
+SELECT id, origin, state, response, error
+FROM async_calls
+WHERE id = @id::BIGINT OR first_attempt_id = @id::BIGINT
+ORDER BY id DESC
+LIMIT 1;
+
 -- name: LoadAsyncCall :one
 SELECT *
 FROM async_calls
@@ -608,6 +949,16 @@ WHERE
   fsm = @fsm::schema_ref AND key = @key::TEXT
 RETURNING true;
 
+-- name: AbortFSMTransition :one
+-- Abandon an FSM transition, leaving the instance in its current state.
+UPDATE fsm_instances
+SET
+  destination_state = NULL,
+  async_call_id = NULL
+WHERE
+  fsm = @fsm::schema_ref AND key = @key::TEXT
+RETURNING true;
+
 -- name: SucceedFSMInstance :one
 UPDATE fsm_instances
 SET
@@ -629,6 +980,26 @@ WHERE
   fsm = @fsm::schema_ref AND key = @key::TEXT
 RETURNING true;
 
+-- name: GetFSMInstances :many
+SELECT *
+FROM fsm_instances
+ORDER BY fsm, key;
+
+-- name: RestoreFSMInstance :exec
+-- Restore an FSM instance from a backup, unless it already exists.
+INSERT INTO fsm_instances (
+  fsm,
+  key,
+  status,
+  current_state
+) VALUES (
+  @fsm::schema_ref,
+  @key::TEXT,
+  @status::fsm_status,
+  sqlc.narg('current_state')::schema_ref
+)
+ON CONFLICT(fsm, key) DO NOTHING;
+
 -- name: UpsertTopic :exec
 INSERT INTO topics (key, module_id, name, type)
 VALUES (
@@ -714,7 +1085,9 @@ VALUES (
 INSERT INTO topic_events (
     "key",
     topic_id,
-    payload
+    payload,
+    request_key,
+    ordering_key
   )
 VALUES (
   sqlc.arg('key')::topic_event_key,
@@ -725,7 +1098,9 @@ VALUES (
     WHERE modules.name = sqlc.arg('module')::TEXT
       AND topics.name = sqlc.arg('topic')::TEXT
   ),
-  sqlc.arg('payload')
+  sqlc.arg('payload'),
+  sqlc.narg('request_key')::TEXT,
+  sqlc.narg('ordering_key')::TEXT
 );
 
 -- name: GetSubscriptionsNeedingUpdate :many
@@ -757,6 +1132,8 @@ WITH cursor AS (
 SELECT events."key" as event,
         events.payload,
         events.created_at,
+        events.request_key,
+        events.ordering_key,
         NOW() - events.created_at >= sqlc.arg('consumption_delay')::interval AS ready
 FROM topics
 LEFT JOIN topic_events as events ON events.topic_id = topics.id
@@ -798,3 +1175,39 @@ UPDATE topic_subscriptions
 SET state = 'idle'
 WHERE name = @name::TEXT
       AND module_id = (SELECT id FROM module);
+
+-- name: ListAdminResources :many
+SELECT *
+FROM admin_resources
+WHERE kind = sqlc.arg('kind')::TEXT
+ORDER BY name;
+
+-- name: GetAdminResource :one
+SELECT *
+FROM admin_resources
+WHERE kind = sqlc.arg('kind')::TEXT AND name = sqlc.arg('name')::TEXT;
+
+-- name: CreateAdminResource :one
+INSERT INTO admin_resources (kind, name, spec)
+VALUES (sqlc.arg('kind')::TEXT, sqlc.arg('name')::TEXT, sqlc.arg('spec')::JSONB)
+RETURNING *;
+
+-- name: UpdateAdminResource :one
+-- Update the spec of a resource if it is at "version", or any version if it
+-- is NULL.
+UPDATE admin_resources
+SET spec       = sqlc.arg('spec')::JSONB,
+    version    = version + 1,
+    updated_at = (NOW() AT TIME ZONE 'utc')
+WHERE kind = sqlc.arg('kind')::TEXT
+  AND name = sqlc.arg('name')::TEXT
+  AND (sqlc.narg('version')::BIGINT IS NULL OR version = sqlc.narg('version')::BIGINT)
+RETURNING *;
+
+-- name: DeleteAdminResource :one
+-- Delete a resource if it is at "version", or any version if it is NULL.
+DELETE FROM admin_resources
+WHERE kind = sqlc.arg('kind')::TEXT
+  AND name = sqlc.arg('name')::TEXT
+  AND (sqlc.narg('version')::BIGINT IS NULL OR version = sqlc.narg('version')::BIGINT)
+RETURNING id;
@@ -17,16 +17,55 @@ import (
 	"github.com/google/uuid"
 )
 
+const abortFSMTransition = `-- name: AbortFSMTransition :one
+UPDATE fsm_instances
+SET
+  destination_state = NULL,
+  async_call_id = NULL
+WHERE
+  fsm = $1::schema_ref AND key = $2::TEXT
+RETURNING true
+`
+
+// Abandon an FSM transition, leaving the instance in its current state.
+func (q *Queries) AbortFSMTransition(ctx context.Context, fsm schema.RefKey, key string) (bool, error) {
+	row := q.db.QueryRow(ctx, abortFSMTransition, fsm, key)
+	var column_1 bool
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
 const acquireAsyncCall = `-- name: AcquireAsyncCall :one
 WITH async_call AS (
-  SELECT id
-  FROM async_calls
+  SELECT id,
+    CASE
+      WHEN ordering_key IS NULL THEN '/system/async_call/' || id
+      ELSE '/system/async_call_order/' || encode(sha256(convert_to(ordering_key, 'UTF8')), 'hex')
+    END AS lease_key
+  FROM async_calls AS calls
   WHERE state = 'pending' AND scheduled_at <= (NOW() AT TIME ZONE 'utc')
+    AND (
+      ordering_key IS NULL
+      OR (
+        NOT EXISTS (
+          SELECT 1
+          FROM leases
+          WHERE key = '/system/async_call_order/' || encode(sha256(convert_to(calls.ordering_key, 'UTF8')), 'hex')
+        )
+        AND NOT EXISTS (
+          SELECT 1
+          FROM async_calls AS earlier
+          WHERE earlier.ordering_key = calls.ordering_key
+            AND earlier.state = 'pending'
+            AND (earlier.created_at, earlier.id) < (calls.created_at, calls.id)
+        )
+      )
+    )
   LIMIT 1
   FOR UPDATE SKIP LOCKED
 ), lease AS (
   INSERT INTO leases (idempotency_key, key, expires_at)
-  VALUES (gen_random_uuid(), '/system/async_call/' || (SELECT id FROM async_call), (NOW() AT TIME ZONE 'utc') + $1::interval)
+  VALUES (gen_random_uuid(), (SELECT lease_key FROM async_call), (NOW() AT TIME ZONE 'utc') + $1::interval)
   RETURNING id, idempotency_key, key, created_at, expires_at, metadata
 )
 UPDATE async_calls
@@ -42,7 +81,8 @@ RETURNING
   scheduled_at,
   remaining_attempts,
   backoff,
-  max_backoff
+  max_backoff,
+  ordering_key
 `
 
 type AcquireAsyncCallRow struct {
@@ -56,10 +96,15 @@ type AcquireAsyncCallRow struct {
 	RemainingAttempts   int32
 	Backoff             time.Duration
 	MaxBackoff          time.Duration
+	OrderingKey         optional.Option[string]
 }
 
 // Reserve a pending async call for execution, returning the associated lease
 // reservation key.
+//
+// Calls with an ordering key are leased by their key rather than their ID, so
+// that only one call per key executes at a time, and are only acquired once
+// all earlier calls with the same key have completed.
 func (q *Queries) AcquireAsyncCall(ctx context.Context, ttl time.Duration) (AcquireAsyncCallRow, error) {
 	row := q.db.QueryRow(ctx, acquireAsyncCall, ttl)
 	var i AcquireAsyncCallRow
@@ -74,10 +119,21 @@ func (q *Queries) AcquireAsyncCall(ctx context.Context, ttl time.Duration) (Acqu
 		&i.RemainingAttempts,
 		&i.Backoff,
 		&i.MaxBackoff,
+		&i.OrderingKey,
 	)
 	return i, err
 }
 
+const associateArtefactChunk = `-- name: AssociateArtefactChunk :exec
+INSERT INTO artefact_chunk_refs (artefact_id, chunk_id, seq)
+VALUES ($1::BIGINT, $2::BIGINT, $3::INT)
+`
+
+func (q *Queries) AssociateArtefactChunk(ctx context.Context, artefactID int64, chunkID int64, seq int32) error {
+	_, err := q.db.Exec(ctx, associateArtefactChunk, artefactID, chunkID, seq)
+	return err
+}
+
 const associateArtefactWithDeployment = `-- name: AssociateArtefactWithDeployment :exec
 INSERT INTO deployment_artefacts (deployment_id, artefact_id, executable, path)
 VALUES ((SELECT id FROM deployments WHERE key = $1::deployment_key), $2, $3, $4)
@@ -102,7 +158,7 @@ func (q *Queries) AssociateArtefactWithDeployment(ctx context.Context, arg Assoc
 
 const beginConsumingTopicEvent = `-- name: BeginConsumingTopicEvent :exec
 WITH event AS (
-  SELECT id, created_at, key, topic_id, payload
+  SELECT id, created_at, key, topic_id, payload, request_key, ordering_key
   FROM topic_events
   WHERE "key" = $2::topic_event_key
 )
@@ -134,6 +190,27 @@ func (q *Queries) CompleteEventForSubscription(ctx context.Context, name string,
 	return err
 }
 
+const createAdminResource = `-- name: CreateAdminResource :one
+INSERT INTO admin_resources (kind, name, spec)
+VALUES ($1::TEXT, $2::TEXT, $3::JSONB)
+RETURNING id, created_at, updated_at, kind, name, spec, version
+`
+
+func (q *Queries) CreateAdminResource(ctx context.Context, kind string, name string, spec []byte) (AdminResource, error) {
+	row := q.db.QueryRow(ctx, createAdminResource, kind, name, spec)
+	var i AdminResource
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Kind,
+		&i.Name,
+		&i.Spec,
+		&i.Version,
+	)
+	return i, err
+}
+
 const createArtefact = `-- name: CreateArtefact :one
 INSERT INTO artefacts (digest, content)
 VALUES ($1, $2)
@@ -141,6 +218,8 @@ RETURNING id
 `
 
 // Create a new artefact and return the artefact ID.
+//
+// The content of chunked artefacts is NULL.
 func (q *Queries) CreateArtefact(ctx context.Context, digest []byte, content []byte) (int64, error) {
 	row := q.db.QueryRow(ctx, createArtefact, digest, content)
 	var id int64
@@ -149,8 +228,8 @@ func (q *Queries) CreateArtefact(ctx context.Context, digest []byte, content []b
 }
 
 const createAsyncCall = `-- name: CreateAsyncCall :one
-INSERT INTO async_calls (verb, origin, request, remaining_attempts, backoff, max_backoff)
-VALUES ($1, $2, $3, $4, $5::interval, $6::interval)
+INSERT INTO async_calls (verb, origin, request, remaining_attempts, backoff, max_backoff, parent_request_key, ordering_key)
+VALUES ($1, $2, $3, $4, $5::interval, $6::interval, $7::TEXT, $8::TEXT)
 RETURNING id
 `
 
@@ -161,6 +240,8 @@ type CreateAsyncCallParams struct {
 	RemainingAttempts int32
 	Backoff           time.Duration
 	MaxBackoff        time.Duration
+	ParentRequestKey  optional.Option[string]
+	OrderingKey       optional.Option[string]
 }
 
 func (q *Queries) CreateAsyncCall(ctx context.Context, arg CreateAsyncCallParams) (int64, error) {
@@ -171,6 +252,8 @@ func (q *Queries) CreateAsyncCall(ctx context.Context, arg CreateAsyncCallParams
 		arg.RemainingAttempts,
 		arg.Backoff,
 		arg.MaxBackoff,
+		arg.ParentRequestKey,
+		arg.OrderingKey,
 	)
 	var id int64
 	err := row.Scan(&id)
@@ -213,15 +296,59 @@ func (q *Queries) CreateCronJob(ctx context.Context, arg CreateCronJobParams) er
 }
 
 const createDeployment = `-- name: CreateDeployment :exec
-INSERT INTO deployments (module_id, "schema", "key")
-VALUES ((SELECT id FROM modules WHERE name = $1::TEXT LIMIT 1), $2::BYTEA, $3::deployment_key)
+INSERT INTO deployments (module_id, "schema", "key", labels)
+VALUES ((SELECT id FROM modules WHERE name = $1::TEXT LIMIT 1), $2::BYTEA, $3::deployment_key, $4::JSONB)
 `
 
-func (q *Queries) CreateDeployment(ctx context.Context, moduleName string, schema []byte, key model.DeploymentKey) error {
-	_, err := q.db.Exec(ctx, createDeployment, moduleName, schema, key)
+type CreateDeploymentParams struct {
+	ModuleName string
+	Schema     []byte
+	Key        model.DeploymentKey
+	Labels     []byte
+}
+
+func (q *Queries) CreateDeployment(ctx context.Context, arg CreateDeploymentParams) error {
+	_, err := q.db.Exec(ctx, createDeployment,
+		arg.ModuleName,
+		arg.Schema,
+		arg.Key,
+		arg.Labels,
+	)
 	return err
 }
 
+const createFault = `-- name: CreateFault :one
+INSERT INTO faults (kind, module, verb, percent, latency_ms, message, expires_at)
+VALUES ($1::TEXT, $2::TEXT, $3::TEXT, $4::DOUBLE PRECISION,
+        $5::BIGINT, $6::TEXT, $7::TIMESTAMPTZ)
+RETURNING id
+`
+
+type CreateFaultParams struct {
+	Kind      string
+	Module    string
+	Verb      optional.Option[string]
+	Percent   float64
+	LatencyMs int64
+	Message   string
+	ExpiresAt time.Time
+}
+
+func (q *Queries) CreateFault(ctx context.Context, arg CreateFaultParams) (int64, error) {
+	row := q.db.QueryRow(ctx, createFault,
+		arg.Kind,
+		arg.Module,
+		arg.Verb,
+		arg.Percent,
+		arg.LatencyMs,
+		arg.Message,
+		arg.ExpiresAt,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
 const createIngressRoute = `-- name: CreateIngressRoute :exec
 INSERT INTO ingress_routes (deployment_id, module, verb, method, path)
 VALUES ((SELECT id FROM deployments WHERE key = $1::deployment_key LIMIT 1), $2, $3, $4, $5)
@@ -246,6 +373,28 @@ func (q *Queries) CreateIngressRoute(ctx context.Context, arg CreateIngressRoute
 	return err
 }
 
+const createProvisionedDatabase = `-- name: CreateProvisionedDatabase :exec
+INSERT INTO provisioned_databases (module, name, provisioner, resource)
+VALUES ($1::TEXT, $2::TEXT, $3::TEXT, $4::TEXT)
+`
+
+type CreateProvisionedDatabaseParams struct {
+	Module      string
+	Name        string
+	Provisioner string
+	Resource    string
+}
+
+func (q *Queries) CreateProvisionedDatabase(ctx context.Context, arg CreateProvisionedDatabaseParams) error {
+	_, err := q.db.Exec(ctx, createProvisionedDatabase,
+		arg.Module,
+		arg.Name,
+		arg.Provisioner,
+		arg.Resource,
+	)
+	return err
+}
+
 const createRequest = `-- name: CreateRequest :exec
 INSERT INTO requests (origin, "key", source_addr)
 VALUES ($1, $2, $3)
@@ -256,6 +405,60 @@ func (q *Queries) CreateRequest(ctx context.Context, origin Origin, key model.Re
 	return err
 }
 
+const deleteAdminResource = `-- name: DeleteAdminResource :one
+DELETE FROM admin_resources
+WHERE kind = $1::TEXT
+  AND name = $2::TEXT
+  AND ($3::BIGINT IS NULL OR version = $3::BIGINT)
+RETURNING id
+`
+
+// Delete a resource if it is at "version", or any version if it is NULL.
+func (q *Queries) DeleteAdminResource(ctx context.Context, kind string, name string, version optional.Option[int64]) (int64, error) {
+	row := q.db.QueryRow(ctx, deleteAdminResource, kind, name, version)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const deleteFaults = `-- name: DeleteFaults :one
+WITH rows AS (
+    DELETE FROM faults
+        WHERE $1::BIGINT IS NULL
+            OR id = $1::BIGINT
+        RETURNING 1)
+SELECT COUNT(*)
+FROM rows
+`
+
+// Delete the fault with "id", or every fault if it is NULL.
+func (q *Queries) DeleteFaults(ctx context.Context, id optional.Option[int64]) (int64, error) {
+	row := q.db.QueryRow(ctx, deleteFaults, id)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const deleteModuleLogLevel = `-- name: DeleteModuleLogLevel :exec
+DELETE FROM module_log_levels
+WHERE module = $1::TEXT
+`
+
+func (q *Queries) DeleteModuleLogLevel(ctx context.Context, module string) error {
+	_, err := q.db.Exec(ctx, deleteModuleLogLevel, module)
+	return err
+}
+
+const deleteProvisionedDatabase = `-- name: DeleteProvisionedDatabase :exec
+DELETE FROM provisioned_databases
+WHERE module = $1::TEXT AND name = $2::TEXT
+`
+
+func (q *Queries) DeleteProvisionedDatabase(ctx context.Context, module string, name string) error {
+	_, err := q.db.Exec(ctx, deleteProvisionedDatabase, module, name)
+	return err
+}
+
 const deleteSubscribers = `-- name: DeleteSubscribers :exec
 DELETE FROM topic_subscribers
 WHERE deployment_id IN (
@@ -310,9 +513,9 @@ UPDATE cron_jobs
   WHERE key = $2::cron_job_key
     AND state = 'executing'
     AND start_time = $3::TIMESTAMPTZ
-  RETURNING id, key, deployment_id, verb, schedule, start_time, next_execution, state, module_name
+  RETURNING id, key, deployment_id, verb, schedule, start_time, next_execution, state, module_name, paused
 )
-SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.start_time, j.next_execution, j.state
+SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.start_time, j.next_execution, j.state, j.paused
   FROM j
   INNER JOIN deployments d on j.deployment_id = d.id
   LIMIT 1
@@ -327,6 +530,7 @@ type EndCronJobRow struct {
 	StartTime     time.Time
 	NextExecution time.Time
 	State         model.CronJobState
+	Paused        bool
 }
 
 func (q *Queries) EndCronJob(ctx context.Context, nextExecution time.Time, key model.CronJobKey, startTime time.Time) (EndCronJobRow, error) {
@@ -341,6 +545,7 @@ func (q *Queries) EndCronJob(ctx context.Context, nextExecution time.Time, key m
 		&i.StartTime,
 		&i.NextExecution,
 		&i.State,
+		&i.Paused,
 	)
 	return i, err
 }
@@ -404,10 +609,10 @@ WITH updated AS (
   SET state = 'error'::async_call_state,
       error = $5::TEXT
   WHERE id = $6::BIGINT
-  RETURNING id, created_at, lease_id, verb, state, origin, scheduled_at, request, response, error, remaining_attempts, backoff, max_backoff
+  RETURNING id, created_at, lease_id, verb, state, origin, scheduled_at, request, response, error, remaining_attempts, backoff, max_backoff, parent_request_key, ordering_key, first_attempt_id
 )
-INSERT INTO async_calls (verb, origin, request, remaining_attempts, backoff, max_backoff, scheduled_at)
-SELECT updated.verb, updated.origin, updated.request, $1, $2::interval, $3::interval, $4::TIMESTAMPTZ
+INSERT INTO async_calls (verb, origin, request, remaining_attempts, backoff, max_backoff, scheduled_at, parent_request_key, ordering_key, created_at, first_attempt_id)
+SELECT updated.verb, updated.origin, updated.request, $1, $2::interval, $3::interval, $4::TIMESTAMPTZ, updated.parent_request_key, updated.ordering_key, updated.created_at, COALESCE(updated.first_attempt_id, updated.id)
   FROM updated
   RETURNING true
 `
@@ -421,6 +626,8 @@ type FailAsyncCallWithRetryParams struct {
 	ID                int64
 }
 
+// Retries keep the creation time of the failed call, so that they are still
+// ordered before later calls with the same ordering key.
 func (q *Queries) FailAsyncCallWithRetry(ctx context.Context, arg FailAsyncCallWithRetryParams) (bool, error) {
 	row := q.db.QueryRow(ctx, failAsyncCallWithRetry,
 		arg.RemainingAttempts,
@@ -506,6 +713,41 @@ func (q *Queries) GetActiveControllers(ctx context.Context) ([]Controller, error
 	return items, nil
 }
 
+const getActiveDeploymentRawSchemas = `-- name: GetActiveDeploymentRawSchemas :many
+SELECT d.key, m.name AS module_name, d."schema"::BYTEA AS "schema"
+FROM deployments d
+  INNER JOIN modules m ON d.module_id = m.id
+WHERE d.min_replicas > 0
+`
+
+type GetActiveDeploymentRawSchemasRow struct {
+	Key        model.DeploymentKey
+	ModuleName string
+	Schema     []byte
+}
+
+// The schemas of active deployments as stored, for checking that they can
+// still be parsed.
+func (q *Queries) GetActiveDeploymentRawSchemas(ctx context.Context) ([]GetActiveDeploymentRawSchemasRow, error) {
+	rows, err := q.db.Query(ctx, getActiveDeploymentRawSchemas)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetActiveDeploymentRawSchemasRow
+	for rows.Next() {
+		var i GetActiveDeploymentRawSchemasRow
+		if err := rows.Scan(&i.Key, &i.ModuleName, &i.Schema); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getActiveDeploymentSchemas = `-- name: GetActiveDeploymentSchemas :many
 SELECT key, schema FROM deployments WHERE min_replicas > 0
 `
@@ -583,6 +825,44 @@ func (q *Queries) GetActiveDeployments(ctx context.Context) ([]GetActiveDeployme
 	return items, nil
 }
 
+const getActiveFaults = `-- name: GetActiveFaults :many
+SELECT id, created_at, kind, module, verb, percent, latency_ms, message, expires_at
+FROM faults
+WHERE expires_at > (NOW() AT TIME ZONE 'utc')
+ORDER BY id
+`
+
+// Get the faults that have not expired.
+func (q *Queries) GetActiveFaults(ctx context.Context) ([]Fault, error) {
+	rows, err := q.db.Query(ctx, getActiveFaults)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Fault
+	for rows.Next() {
+		var i Fault
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.Kind,
+			&i.Module,
+			&i.Verb,
+			&i.Percent,
+			&i.LatencyMs,
+			&i.Message,
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getActiveIngressRoutes = `-- name: GetActiveIngressRoutes :many
 SELECT d.key AS deployment_key, ir.module, ir.verb, ir.method, ir.path
 FROM ingress_routes ir
@@ -678,17 +958,127 @@ func (q *Queries) GetActiveRunners(ctx context.Context) ([]GetActiveRunnersRow,
 	return items, nil
 }
 
-const getArtefactContentRange = `-- name: GetArtefactContentRange :one
-SELECT SUBSTRING(a.content FROM $1 FOR $2)::BYTEA AS content
-FROM artefacts a
-WHERE a.id = $3
+const getAdminResource = `-- name: GetAdminResource :one
+SELECT id, created_at, updated_at, kind, name, spec, version
+FROM admin_resources
+WHERE kind = $1::TEXT AND name = $2::TEXT
+`
+
+func (q *Queries) GetAdminResource(ctx context.Context, kind string, name string) (AdminResource, error) {
+	row := q.db.QueryRow(ctx, getAdminResource, kind, name)
+	var i AdminResource
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Kind,
+		&i.Name,
+		&i.Spec,
+		&i.Version,
+	)
+	return i, err
+}
+
+const getArtefactChunkDigests = `-- name: GetArtefactChunkDigests :many
+SELECT digest
+FROM artefact_chunks
+WHERE digest = ANY ($1::bytea[])
+`
+
+// Return the digests of the chunks that exist in the database.
+func (q *Queries) GetArtefactChunkDigests(ctx context.Context, digests [][]byte) ([][]byte, error) {
+	rows, err := q.db.Query(ctx, getArtefactChunkDigests, digests)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items [][]byte
+	for rows.Next() {
+		var digest []byte
+		if err := rows.Scan(&digest); err != nil {
+			return nil, err
+		}
+		items = append(items, digest)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getArtefactChunks = `-- name: GetArtefactChunks :many
+SELECT digest, content, compression
+FROM artefact_chunks
+WHERE digest = ANY ($1::bytea[])
+`
+
+type GetArtefactChunksRow struct {
+	Digest      []byte
+	Content     []byte
+	Compression optional.Option[string]
+}
+
+func (q *Queries) GetArtefactChunks(ctx context.Context, digests [][]byte) ([]GetArtefactChunksRow, error) {
+	rows, err := q.db.Query(ctx, getArtefactChunks, digests)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetArtefactChunksRow
+	for rows.Next() {
+		var i GetArtefactChunksRow
+		if err := rows.Scan(&i.Digest, &i.Content, &i.Compression); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getArtefactContent = `-- name: GetArtefactContent :many
+SELECT parts.content, parts.compression
+FROM (SELECT -1 AS seq, a.content, a.compression
+      FROM artefacts a
+      WHERE a.digest = $1::BYTEA
+      UNION ALL
+      SELECT r.seq, c.content, c.compression
+      FROM artefacts a
+               INNER JOIN artefact_chunk_refs r ON r.artefact_id = a.id
+               INNER JOIN artefact_chunks c ON c.id = r.chunk_id
+      WHERE a.digest = $1::BYTEA) parts
+ORDER BY parts.seq
 `
 
-func (q *Queries) GetArtefactContentRange(ctx context.Context, start int32, count int32, iD int64) ([]byte, error) {
-	row := q.db.QueryRow(ctx, getArtefactContentRange, start, count, iD)
-	var content []byte
-	err := row.Scan(&content)
-	return content, err
+type GetArtefactContentRow struct {
+	Content     []byte
+	Compression optional.Option[string]
+}
+
+// Get the stored content of an artefact in order, along with its compression.
+//
+// The first row is the content of the artefact itself, which is NULL if it is
+// chunked, followed by its chunks.
+func (q *Queries) GetArtefactContent(ctx context.Context, digest []byte) ([]GetArtefactContentRow, error) {
+	rows, err := q.db.Query(ctx, getArtefactContent, digest)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetArtefactContentRow
+	for rows.Next() {
+		var i GetArtefactContentRow
+		if err := rows.Scan(&i.Content, &i.Compression); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
 const getArtefactDigests = `-- name: GetArtefactDigests :many
@@ -709,10 +1099,175 @@ func (q *Queries) GetArtefactDigests(ctx context.Context, digests [][]byte) ([]G
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetArtefactDigestsRow
+	var items []GetArtefactDigestsRow
+	for rows.Next() {
+		var i GetArtefactDigestsRow
+		if err := rows.Scan(&i.ID, &i.Digest); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAsyncCallsForRequest = `-- name: GetAsyncCallsForRequest :many
+SELECT id, created_at, verb, state, origin, scheduled_at, error
+FROM async_calls
+WHERE parent_request_key = $1::TEXT
+ORDER BY created_at, id
+`
+
+type GetAsyncCallsForRequestRow struct {
+	ID          int64
+	CreatedAt   time.Time
+	Verb        schema.RefKey
+	State       AsyncCallState
+	Origin      string
+	ScheduledAt time.Time
+	Error       optional.Option[string]
+}
+
+// Get the async calls triggered by a request, such as FSM transitions and pubsub deliveries.
+func (q *Queries) GetAsyncCallsForRequest(ctx context.Context, requestKey string) ([]GetAsyncCallsForRequestRow, error) {
+	rows, err := q.db.Query(ctx, getAsyncCallsForRequest, requestKey)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetAsyncCallsForRequestRow
+	for rows.Next() {
+		var i GetAsyncCallsForRequestRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.Verb,
+			&i.State,
+			&i.Origin,
+			&i.ScheduledAt,
+			&i.Error,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCallErrorGroups = `-- name: GetCallErrorGroups :many
+SELECT (e.payload ->> 'fingerprint')::TEXT                                              AS fingerprint,
+       e.custom_key_3::TEXT                                                            AS dest_module,
+       e.custom_key_4::TEXT                                                            AS dest_verb,
+       COUNT(*)                                                                        AS count,
+       MIN(e.time_stamp)::TIMESTAMPTZ                                                  AS first_seen,
+       MAX(e.time_stamp)::TIMESTAMPTZ                                                  AS last_seen,
+       (ARRAY_AGG(e.payload ->> 'error' ORDER BY e.time_stamp DESC))[1]::TEXT          AS error,
+       COALESCE((ARRAY_AGG(e.payload ->> 'stack' ORDER BY e.time_stamp DESC))[1], '')::TEXT AS stack
+FROM events e
+WHERE e.type = 'call'
+  AND e.payload ->> 'fingerprint' IS NOT NULL
+  AND e.time_stamp >= $1::TIMESTAMPTZ
+GROUP BY e.payload ->> 'fingerprint', e.custom_key_3, e.custom_key_4
+ORDER BY count DESC, last_seen DESC
+`
+
+type GetCallErrorGroupsRow struct {
+	Fingerprint string
+	DestModule  string
+	DestVerb    string
+	Count       int64
+	FirstSeen   time.Time
+	LastSeen    time.Time
+	Error       string
+	Stack       string
+}
+
+// Failed calls since a point in time, grouped by the fingerprint of their error.
+func (q *Queries) GetCallErrorGroups(ctx context.Context, since time.Time) ([]GetCallErrorGroupsRow, error) {
+	rows, err := q.db.Query(ctx, getCallErrorGroups, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCallErrorGroupsRow
+	for rows.Next() {
+		var i GetCallErrorGroupsRow
+		if err := rows.Scan(
+			&i.Fingerprint,
+			&i.DestModule,
+			&i.DestVerb,
+			&i.Count,
+			&i.FirstSeen,
+			&i.LastSeen,
+			&i.Error,
+			&i.Stack,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCallGraphEdges = `-- name: GetCallGraphEdges :many
+SELECT COALESCE(e.custom_key_1, '')::TEXT                                          AS source_module,
+       COALESCE(e.custom_key_2, '')::TEXT                                          AS source_verb,
+       e.custom_key_3::TEXT                                                        AS dest_module,
+       e.custom_key_4::TEXT                                                        AS dest_verb,
+       COUNT(*)                                                                    AS calls,
+       COUNT(*) FILTER (WHERE e.payload ->> 'error' IS NOT NULL)                   AS errors,
+       AVG((e.payload ->> 'duration_ms')::BIGINT)::FLOAT8                          AS average_duration_ms,
+       PERCENTILE_CONT(0.95) WITHIN GROUP (
+           ORDER BY (e.payload ->> 'duration_ms')::BIGINT)::FLOAT8                 AS p95_duration_ms,
+       MAX((e.payload ->> 'duration_ms')::BIGINT)::BIGINT                          AS max_duration_ms
+FROM events e
+WHERE e.type = 'call'
+  AND e.time_stamp >= $1::TIMESTAMPTZ
+GROUP BY e.custom_key_1, e.custom_key_2, e.custom_key_3, e.custom_key_4
+ORDER BY dest_module, dest_verb, source_module, source_verb
+`
+
+type GetCallGraphEdgesRow struct {
+	SourceModule      string
+	SourceVerb        string
+	DestModule        string
+	DestVerb          string
+	Calls             int64
+	Errors            int64
+	AverageDurationMs float64
+	P95DurationMs     float64
+	MaxDurationMs     int64
+}
+
+// Calls since a point in time, aggregated by source and destination verb.
+func (q *Queries) GetCallGraphEdges(ctx context.Context, since time.Time) ([]GetCallGraphEdgesRow, error) {
+	rows, err := q.db.Query(ctx, getCallGraphEdges, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCallGraphEdgesRow
 	for rows.Next() {
-		var i GetArtefactDigestsRow
-		if err := rows.Scan(&i.ID, &i.Digest); err != nil {
+		var i GetCallGraphEdgesRow
+		if err := rows.Scan(
+			&i.SourceModule,
+			&i.SourceVerb,
+			&i.DestModule,
+			&i.DestVerb,
+			&i.Calls,
+			&i.Errors,
+			&i.AverageDurationMs,
+			&i.P95DurationMs,
+			&i.MaxDurationMs,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -724,7 +1279,7 @@ func (q *Queries) GetArtefactDigests(ctx context.Context, digests [][]byte) ([]G
 }
 
 const getCronJobs = `-- name: GetCronJobs :many
-SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.start_time, j.next_execution, j.state
+SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.start_time, j.next_execution, j.state, j.paused
 FROM cron_jobs j
   INNER JOIN deployments d on j.deployment_id = d.id
 WHERE d.min_replicas > 0
@@ -739,6 +1294,7 @@ type GetCronJobsRow struct {
 	StartTime     time.Time
 	NextExecution time.Time
 	State         model.CronJobState
+	Paused        bool
 }
 
 func (q *Queries) GetCronJobs(ctx context.Context) ([]GetCronJobsRow, error) {
@@ -759,6 +1315,7 @@ func (q *Queries) GetCronJobs(ctx context.Context) ([]GetCronJobsRow, error) {
 			&i.StartTime,
 			&i.NextExecution,
 			&i.State,
+			&i.Paused,
 		); err != nil {
 			return nil, err
 		}
@@ -803,7 +1360,8 @@ func (q *Queries) GetDeployment(ctx context.Context, key model.DeploymentKey) (G
 }
 
 const getDeploymentArtefacts = `-- name: GetDeploymentArtefacts :many
-SELECT da.created_at, artefact_id AS id, executable, path, digest, executable
+SELECT da.created_at, artefact_id AS id, executable, path, digest, executable, (artefacts.cold_at IS NOT NULL)::BOOLEAN AS cold,
+       artefacts.compression
 FROM deployment_artefacts da
          INNER JOIN artefacts ON artefacts.id = da.artefact_id
 WHERE deployment_id = $1
@@ -816,6 +1374,8 @@ type GetDeploymentArtefactsRow struct {
 	Path         string
 	Digest       []byte
 	Executable_2 bool
+	Cold         bool
+	Compression  optional.Option[string]
 }
 
 // Get all artefacts matching the given digests.
@@ -835,6 +1395,8 @@ func (q *Queries) GetDeploymentArtefacts(ctx context.Context, deploymentID int64
 			&i.Path,
 			&i.Digest,
 			&i.Executable_2,
+			&i.Cold,
+			&i.Compression,
 		); err != nil {
 			return nil, err
 		}
@@ -885,11 +1447,13 @@ SELECT d.key                 AS deployment_key,
        m.name                 AS module_name,
        m.language             AS language,
        COUNT(r.id)            AS assigned_runners_count,
-       d.min_replicas::BIGINT AS required_runners_count
+       d.min_replicas::BIGINT AS required_runners_count,
+       d.crash_count,
+       d.last_crashed_at
 FROM deployments d
          LEFT JOIN runners r ON d.id = r.deployment_id AND r.state <> 'dead'
          JOIN modules m ON d.module_id = m.id
-GROUP BY d.key, d.min_replicas, m.name, m.language
+GROUP BY d.key, d.min_replicas, d.crash_count, d.last_crashed_at, m.name, m.language
 HAVING COUNT(r.id) <> d.min_replicas
 `
 
@@ -899,6 +1463,8 @@ type GetDeploymentsNeedingReconciliationRow struct {
 	Language             string
 	AssignedRunnersCount int64
 	RequiredRunnersCount int64
+	CrashCount           int32
+	LastCrashedAt        optional.Option[time.Time]
 }
 
 // Get deployments that have a mismatch between the number of assigned and required replicas.
@@ -917,6 +1483,8 @@ func (q *Queries) GetDeploymentsNeedingReconciliation(ctx context.Context) ([]Ge
 			&i.Language,
 			&i.AssignedRunnersCount,
 			&i.RequiredRunnersCount,
+			&i.CrashCount,
+			&i.LastCrashedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -1083,6 +1651,41 @@ func (q *Queries) GetFSMInstance(ctx context.Context, fsm schema.RefKey, key str
 	return i, err
 }
 
+const getFSMInstances = `-- name: GetFSMInstances :many
+SELECT id, created_at, fsm, key, status, current_state, destination_state, async_call_id
+FROM fsm_instances
+ORDER BY fsm, key
+`
+
+func (q *Queries) GetFSMInstances(ctx context.Context) ([]FsmInstance, error) {
+	rows, err := q.db.Query(ctx, getFSMInstances)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FsmInstance
+	for rows.Next() {
+		var i FsmInstance
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.Fsm,
+			&i.Key,
+			&i.Status,
+			&i.CurrentState,
+			&i.DestinationState,
+			&i.AsyncCallID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getIdleRunners = `-- name: GetIdleRunners :many
 SELECT id, key, created, last_seen, reservation_timeout, state, endpoint, module_name, deployment_id, labels
 FROM runners
@@ -1122,6 +1725,50 @@ func (q *Queries) GetIdleRunners(ctx context.Context, labels []byte, limit int64
 	return items, nil
 }
 
+const getInactiveArtefacts = `-- name: GetInactiveArtefacts :many
+SELECT a.digest
+FROM artefacts a
+WHERE a.cold_at IS NULL
+  AND a.created_at < $1::TIMESTAMPTZ
+  AND NOT EXISTS (SELECT 1
+                  FROM deployment_artefacts da
+                           INNER JOIN deployments d ON d.id = da.deployment_id
+                  WHERE da.artefact_id = a.id
+                    AND (d.min_replicas > 0
+                      OR d.created_at >= $1::TIMESTAMPTZ
+                      OR EXISTS (SELECT 1
+                                 FROM events e
+                                 WHERE e.deployment_id = d.id
+                                   AND e.time_stamp >= $1::TIMESTAMPTZ)))
+ORDER BY a.id
+LIMIT $2::INT
+`
+
+// Get the digests of artefacts stored in the database that are only used by
+// deployments that have been inactive since "inactive_since".
+//
+// A deployment is active while it has replicas, and the time it was last active
+// is the time of its most recent event.
+func (q *Queries) GetInactiveArtefacts(ctx context.Context, inactiveSince time.Time, max int32) ([][]byte, error) {
+	rows, err := q.db.Query(ctx, getInactiveArtefacts, inactiveSince, max)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items [][]byte
+	for rows.Next() {
+		var digest []byte
+		if err := rows.Scan(&digest); err != nil {
+			return nil, err
+		}
+		items = append(items, digest)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getIngressRoutes = `-- name: GetIngressRoutes :many
 SELECT r.key AS runner_key, d.key AS deployment_key, endpoint, ir.path, ir.module, ir.verb
 FROM ingress_routes ir
@@ -1168,6 +1815,36 @@ func (q *Queries) GetIngressRoutes(ctx context.Context, method string) ([]GetIng
 	return items, nil
 }
 
+const getLatestAsyncCallAttempt = `-- name: GetLatestAsyncCallAttempt :one
+SELECT id, origin, state, response, error
+FROM async_calls
+WHERE id = $1::BIGINT OR first_attempt_id = $1::BIGINT
+ORDER BY id DESC
+LIMIT 1
+`
+
+type GetLatestAsyncCallAttemptRow struct {
+	ID       int64
+	Origin   string
+	State    AsyncCallState
+	Response []byte
+	Error    optional.Option[string]
+}
+
+// Get the latest attempt of an async call, following its retries.
+func (q *Queries) GetLatestAsyncCallAttempt(ctx context.Context, id int64) (GetLatestAsyncCallAttemptRow, error) {
+	row := q.db.QueryRow(ctx, getLatestAsyncCallAttempt, id)
+	var i GetLatestAsyncCallAttemptRow
+	err := row.Scan(
+		&i.ID,
+		&i.Origin,
+		&i.State,
+		&i.Response,
+		&i.Error,
+	)
+	return i, err
+}
+
 const getLeaseInfo = `-- name: GetLeaseInfo :one
 SELECT expires_at, metadata FROM leases WHERE key = $1::lease_key
 `
@@ -1184,6 +1861,26 @@ func (q *Queries) GetLeaseInfo(ctx context.Context, key leases.Key) (GetLeaseInf
 	return i, err
 }
 
+const getModuleLogLevel = `-- name: GetModuleLogLevel :one
+SELECT level, expires_at
+FROM module_log_levels
+WHERE module = $1::TEXT
+  AND expires_at > (NOW() AT TIME ZONE 'utc')
+`
+
+type GetModuleLogLevelRow struct {
+	Level     string
+	ExpiresAt time.Time
+}
+
+// Get the log level override of a module, if it has not expired.
+func (q *Queries) GetModuleLogLevel(ctx context.Context, module string) (GetModuleLogLevelRow, error) {
+	row := q.db.QueryRow(ctx, getModuleLogLevel, module)
+	var i GetModuleLogLevelRow
+	err := row.Scan(&i.Level, &i.ExpiresAt)
+	return i, err
+}
+
 const getModulesByID = `-- name: GetModulesByID :many
 SELECT id, language, name
 FROM modules
@@ -1221,6 +1918,8 @@ WITH cursor AS (
 SELECT events."key" as event,
         events.payload,
         events.created_at,
+        events.request_key,
+        events.ordering_key,
         NOW() - events.created_at >= $1::interval AS ready
 FROM topics
 LEFT JOIN topic_events as events ON events.topic_id = topics.id
@@ -1231,10 +1930,12 @@ LIMIT 1
 `
 
 type GetNextEventForSubscriptionRow struct {
-	Event     optional.Option[model.TopicEventKey]
-	Payload   []byte
-	CreatedAt optional.Option[time.Time]
-	Ready     bool
+	Event       optional.Option[model.TopicEventKey]
+	Payload     []byte
+	CreatedAt   optional.Option[time.Time]
+	RequestKey  optional.Option[string]
+	OrderingKey optional.Option[string]
+	Ready       bool
 }
 
 func (q *Queries) GetNextEventForSubscription(ctx context.Context, consumptionDelay time.Duration, topic model.TopicKey, cursor optional.Option[model.TopicEventKey]) (GetNextEventForSubscriptionRow, error) {
@@ -1244,18 +1945,63 @@ func (q *Queries) GetNextEventForSubscription(ctx context.Context, consumptionDe
 		&i.Event,
 		&i.Payload,
 		&i.CreatedAt,
+		&i.RequestKey,
+		&i.OrderingKey,
 		&i.Ready,
 	)
 	return i, err
 }
 
+const getPrefetchCandidates = `-- name: GetPrefetchCandidates :many
+SELECT d.key AS deployment_key
+FROM deployments d
+         LEFT JOIN runners r ON d.id = r.deployment_id AND r.state <> 'dead'
+         JOIN modules m ON d.module_id = m.id
+WHERE m.language = ANY ($1::TEXT[])
+  AND (d.min_replicas > 0
+    OR (d.created_at > $2::TIMESTAMPTZ
+        AND NOT EXISTS (SELECT 1
+                        FROM deployments newer
+                        WHERE newer.module_id = d.module_id
+                          AND newer.created_at > d.created_at)))
+GROUP BY d.id, d.key, d.min_replicas, d.created_at
+HAVING COUNT(r.id) < GREATEST(d.min_replicas, 1)
+ORDER BY d.min_replicas > 0 DESC, d.created_at DESC
+LIMIT $3::INT
+`
+
+// Get deployments likely to need runners soon: those with fewer runners than
+// required, followed by the newest deployment of each module if it was created
+// recently and has no runners yet.
+func (q *Queries) GetPrefetchCandidates(ctx context.Context, languages []string, createdAfter time.Time, max int32) ([]model.DeploymentKey, error) {
+	rows, err := q.db.Query(ctx, getPrefetchCandidates, languages, createdAfter, max)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []model.DeploymentKey
+	for rows.Next() {
+		var deployment_key model.DeploymentKey
+		if err := rows.Scan(&deployment_key); err != nil {
+			return nil, err
+		}
+		items = append(items, deployment_key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getProcessList = `-- name: GetProcessList :many
 SELECT d.min_replicas,
        d.key   AS deployment_key,
        d.labels    deployment_labels,
        r.key    AS runner_key,
        r.endpoint,
-       r.labels AS runner_labels
+       r.labels AS runner_labels,
+       d.crash_count,
+       d.last_crashed_at
 FROM deployments d
          LEFT JOIN runners r on d.id = r.deployment_id AND r.state != 'dead'
 WHERE d.min_replicas > 0
@@ -1269,6 +2015,8 @@ type GetProcessListRow struct {
 	RunnerKey        optional.Option[model.RunnerKey]
 	Endpoint         optional.Option[string]
 	RunnerLabels     []byte
+	CrashCount       int32
+	LastCrashedAt    optional.Option[time.Time]
 }
 
 func (q *Queries) GetProcessList(ctx context.Context) ([]GetProcessListRow, error) {
@@ -1287,6 +2035,61 @@ func (q *Queries) GetProcessList(ctx context.Context) ([]GetProcessListRow, erro
 			&i.RunnerKey,
 			&i.Endpoint,
 			&i.RunnerLabels,
+			&i.CrashCount,
+			&i.LastCrashedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getProvisionedDatabase = `-- name: GetProvisionedDatabase :one
+SELECT id, created_at, module, name, provisioner, resource
+FROM provisioned_databases
+WHERE module = $1::TEXT AND name = $2::TEXT
+`
+
+func (q *Queries) GetProvisionedDatabase(ctx context.Context, module string, name string) (ProvisionedDatabase, error) {
+	row := q.db.QueryRow(ctx, getProvisionedDatabase, module, name)
+	var i ProvisionedDatabase
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.Module,
+		&i.Name,
+		&i.Provisioner,
+		&i.Resource,
+	)
+	return i, err
+}
+
+const getProvisionedDatabases = `-- name: GetProvisionedDatabases :many
+SELECT id, created_at, module, name, provisioner, resource
+FROM provisioned_databases
+ORDER BY module, name
+`
+
+func (q *Queries) GetProvisionedDatabases(ctx context.Context) ([]ProvisionedDatabase, error) {
+	rows, err := q.db.Query(ctx, getProvisionedDatabases)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ProvisionedDatabase
+	for rows.Next() {
+		var i ProvisionedDatabase
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.Module,
+			&i.Name,
+			&i.Provisioner,
+			&i.Resource,
 		); err != nil {
 			return nil, err
 		}
@@ -1330,6 +2133,24 @@ func (q *Queries) GetRandomSubscriber(ctx context.Context, key model.Subscriptio
 	return i, err
 }
 
+const getRequest = `-- name: GetRequest :one
+SELECT origin, source_addr
+FROM requests
+WHERE "key" = $1::TEXT
+`
+
+type GetRequestRow struct {
+	Origin     Origin
+	SourceAddr string
+}
+
+func (q *Queries) GetRequest(ctx context.Context, key string) (GetRequestRow, error) {
+	row := q.db.QueryRow(ctx, getRequest, key)
+	var i GetRequestRow
+	err := row.Scan(&i.Origin, &i.SourceAddr)
+	return i, err
+}
+
 const getRouteForRunner = `-- name: GetRouteForRunner :one
 SELECT endpoint, r.key AS runner_key, r.module_name, d.key deployment_key, r.state
 FROM runners r
@@ -1531,7 +2352,7 @@ func (q *Queries) GetSchemaForDeployment(ctx context.Context, key model.Deployme
 }
 
 const getStaleCronJobs = `-- name: GetStaleCronJobs :many
-SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.start_time, j.next_execution, j.state
+SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j.schedule, j.start_time, j.next_execution, j.state, j.paused
 FROM cron_jobs j
   INNER JOIN deployments d on j.deployment_id = d.id
 WHERE state = 'executing'
@@ -1547,6 +2368,7 @@ type GetStaleCronJobsRow struct {
 	StartTime     time.Time
 	NextExecution time.Time
 	State         model.CronJobState
+	Paused        bool
 }
 
 func (q *Queries) GetStaleCronJobs(ctx context.Context, dollar_1 time.Duration) ([]GetStaleCronJobsRow, error) {
@@ -1567,6 +2389,7 @@ func (q *Queries) GetStaleCronJobs(ctx context.Context, dollar_1 time.Duration)
 			&i.StartTime,
 			&i.NextExecution,
 			&i.State,
+			&i.Paused,
 		); err != nil {
 			return nil, err
 		}
@@ -1629,6 +2452,55 @@ func (q *Queries) GetSubscriptionsNeedingUpdate(ctx context.Context) ([]GetSubsc
 	return items, nil
 }
 
+const getVerbCallStats = `-- name: GetVerbCallStats :many
+SELECT e.custom_key_3::TEXT                                                        AS module,
+       e.custom_key_4::TEXT                                                        AS verb,
+       COUNT(*)                                                                    AS calls,
+       COUNT(*) FILTER (WHERE e.payload ->> 'error' IS NOT NULL)                   AS errors,
+       PERCENTILE_CONT(0.95) WITHIN GROUP (
+           ORDER BY (e.payload ->> 'duration_ms')::BIGINT)::FLOAT8                 AS p95_duration_ms
+FROM events e
+WHERE e.type = 'call'
+  AND e.time_stamp >= $1::TIMESTAMPTZ
+GROUP BY e.custom_key_3, e.custom_key_4
+ORDER BY module, verb
+`
+
+type GetVerbCallStatsRow struct {
+	Module        string
+	Verb          string
+	Calls         int64
+	Errors        int64
+	P95DurationMs float64
+}
+
+// Calls to each verb since a point in time.
+func (q *Queries) GetVerbCallStats(ctx context.Context, since time.Time) ([]GetVerbCallStatsRow, error) {
+	rows, err := q.db.Query(ctx, getVerbCallStats, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetVerbCallStatsRow
+	for rows.Next() {
+		var i GetVerbCallStatsRow
+		if err := rows.Scan(
+			&i.Module,
+			&i.Verb,
+			&i.Calls,
+			&i.Errors,
+			&i.P95DurationMs,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const insertCallEvent = `-- name: InsertCallEvent :exec
 INSERT INTO events (deployment_id, request_id, time_stamp, type,
                     custom_key_1, custom_key_2, custom_key_3, custom_key_4, payload)
@@ -1648,7 +2520,10 @@ VALUES ((SELECT id FROM deployments WHERE deployments.key = $1::deployment_key),
                 'request', $9::JSONB,
                 'response', $10::JSONB,
                 'error', $11::TEXT,
-                'stack', $12::TEXT
+                'stack', $12::TEXT,
+                'fingerprint', $13::TEXT,
+                'request_size', $14::BIGINT,
+                'response_size', $15::BIGINT
             ))
 `
 
@@ -1665,6 +2540,9 @@ type InsertCallEventParams struct {
 	Response      []byte
 	Error         optional.Option[string]
 	Stack         optional.Option[string]
+	Fingerprint   optional.Option[string]
+	RequestSize   int64
+	ResponseSize  int64
 }
 
 func (q *Queries) InsertCallEvent(ctx context.Context, arg InsertCallEventParams) error {
@@ -1681,6 +2559,9 @@ func (q *Queries) InsertCallEvent(ctx context.Context, arg InsertCallEventParams
 		arg.Response,
 		arg.Error,
 		arg.Stack,
+		arg.Fingerprint,
+		arg.RequestSize,
+		arg.ResponseSize,
 	)
 	return err
 }
@@ -1915,8 +2796,43 @@ func (q *Queries) KillStaleRunners(ctx context.Context, timeout time.Duration) (
 	return count, err
 }
 
+const listAdminResources = `-- name: ListAdminResources :many
+SELECT id, created_at, updated_at, kind, name, spec, version
+FROM admin_resources
+WHERE kind = $1::TEXT
+ORDER BY name
+`
+
+func (q *Queries) ListAdminResources(ctx context.Context, kind string) ([]AdminResource, error) {
+	rows, err := q.db.Query(ctx, listAdminResources, kind)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AdminResource
+	for rows.Next() {
+		var i AdminResource
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Kind,
+			&i.Name,
+			&i.Spec,
+			&i.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const loadAsyncCall = `-- name: LoadAsyncCall :one
-SELECT id, created_at, lease_id, verb, state, origin, scheduled_at, request, response, error, remaining_attempts, backoff, max_backoff
+SELECT id, created_at, lease_id, verb, state, origin, scheduled_at, request, response, error, remaining_attempts, backoff, max_backoff, parent_request_key, ordering_key, first_attempt_id
 FROM async_calls
 WHERE id = $1
 `
@@ -1938,10 +2854,44 @@ func (q *Queries) LoadAsyncCall(ctx context.Context, id int64) (AsyncCall, error
 		&i.RemainingAttempts,
 		&i.Backoff,
 		&i.MaxBackoff,
+		&i.ParentRequestKey,
+		&i.OrderingKey,
+		&i.FirstAttemptID,
 	)
 	return i, err
 }
 
+const moveArtefactToColdStorage = `-- name: MoveArtefactToColdStorage :exec
+WITH moved AS (
+    UPDATE artefacts
+        SET content = NULL,
+            compression = $1::TEXT,
+            cold_at = (NOW() AT TIME ZONE 'utc')
+        WHERE digest = $2::BYTEA
+            AND cold_at IS NULL
+        RETURNING id),
+     refs AS (
+         DELETE FROM artefact_chunk_refs r
+             USING moved
+             WHERE r.artefact_id = moved.id
+             RETURNING r.artefact_id, r.chunk_id)
+DELETE
+FROM artefact_chunks c
+WHERE c.id IN (SELECT chunk_id FROM refs)
+  AND NOT EXISTS (SELECT 1
+                  FROM artefact_chunk_refs r
+                  WHERE r.chunk_id = c.id
+                    AND r.artefact_id NOT IN (SELECT artefact_id FROM refs))
+`
+
+// Remove the content of an artefact that has been copied to cold storage with
+// the given compression, along with any of its chunks that no other artefact
+// contains.
+func (q *Queries) MoveArtefactToColdStorage(ctx context.Context, compression optional.Option[string], digest []byte) error {
+	_, err := q.db.Exec(ctx, moveArtefactToColdStorage, compression, digest)
+	return err
+}
+
 const newLease = `-- name: NewLease :one
 INSERT INTO leases (
   idempotency_key,
@@ -1969,7 +2919,9 @@ const publishEventForTopic = `-- name: PublishEventForTopic :exec
 INSERT INTO topic_events (
     "key",
     topic_id,
-    payload
+    payload,
+    request_key,
+    ordering_key
   )
 VALUES (
   $1::topic_event_key,
@@ -1980,15 +2932,19 @@ VALUES (
     WHERE modules.name = $2::TEXT
       AND topics.name = $3::TEXT
   ),
-  $4
+  $4,
+  $5::TEXT,
+  $6::TEXT
 )
 `
 
 type PublishEventForTopicParams struct {
-	Key     model.TopicEventKey
-	Module  string
-	Topic   string
-	Payload []byte
+	Key         model.TopicEventKey
+	Module      string
+	Topic       string
+	Payload     []byte
+	RequestKey  optional.Option[string]
+	OrderingKey optional.Option[string]
 }
 
 func (q *Queries) PublishEventForTopic(ctx context.Context, arg PublishEventForTopicParams) error {
@@ -1997,10 +2953,61 @@ func (q *Queries) PublishEventForTopic(ctx context.Context, arg PublishEventForT
 		arg.Module,
 		arg.Topic,
 		arg.Payload,
+		arg.RequestKey,
+		arg.OrderingKey,
 	)
 	return err
 }
 
+const quarantineDeployment = `-- name: QuarantineDeployment :one
+WITH quarantined AS (
+  INSERT INTO deployment_quarantines (deployment_id, reason, "schema")
+  SELECT id, $1::TEXT, "schema"::BYTEA
+  FROM deployments
+  WHERE key = $2::deployment_key
+  ON CONFLICT (deployment_id) DO NOTHING
+  RETURNING deployment_id
+), updated AS (
+  UPDATE deployments
+  SET min_replicas = 0, "schema" = $3::module_schema_pb
+  WHERE id IN (SELECT deployment_id FROM quarantined)
+  RETURNING id
+)
+SELECT COUNT(*) > 0 AS quarantined FROM updated
+`
+
+// Scale down a deployment and replace its schema with "placeholder", keeping
+// the original schema in deployment_quarantines. Returns false if the
+// deployment was already quarantined.
+func (q *Queries) QuarantineDeployment(ctx context.Context, reason string, key model.DeploymentKey, placeholder *schema.Module) (bool, error) {
+	row := q.db.QueryRow(ctx, quarantineDeployment, reason, key, placeholder)
+	var quarantined bool
+	err := row.Scan(&quarantined)
+	return quarantined, err
+}
+
+const recordDeploymentCrash = `-- name: RecordDeploymentCrash :one
+UPDATE deployments
+SET crash_count     = CASE
+                          WHEN last_crashed_at IS NULL
+                              OR last_crashed_at < (NOW() AT TIME ZONE 'utc') - $1::INTERVAL
+                              THEN 1
+                          ELSE crash_count + 1 END,
+    last_crashed_at = (NOW() AT TIME ZONE 'utc')::TIMESTAMPTZ
+WHERE key = $2::deployment_key
+RETURNING crash_count
+`
+
+// Count a crash of a replica of a deployment, returning its number of
+// consecutive crashes. Crashes are no longer consecutive if the deployment
+// hasn't crashed for "reset_after".
+func (q *Queries) RecordDeploymentCrash(ctx context.Context, resetAfter time.Duration, key model.DeploymentKey) (int32, error) {
+	row := q.db.QueryRow(ctx, recordDeploymentCrash, resetAfter, key)
+	var crash_count int32
+	err := row.Scan(&crash_count)
+	return crash_count, err
+}
+
 const releaseLease = `-- name: ReleaseLease :one
 DELETE FROM leases
 WHERE idempotency_key = $1 AND key = $2::lease_key
@@ -2062,11 +3069,24 @@ WHERE id = (SELECT id
             FROM runners r
             WHERE r.state = 'idle'
               AND r.labels @> $3::jsonb
+              AND COALESCE(r.labels ->> 'project', '') IN ('', COALESCE((SELECT d.labels ->> 'project'
+                                                                         FROM deployments d
+                                                                         WHERE d.key = $2::deployment_key
+                                                                         LIMIT 1), ''))
+              AND NOT EXISTS (SELECT 1
+                              FROM deployments d
+                              WHERE d.key = $2::deployment_key
+                                AND ((d.labels ->> 'os' IS NOT NULL AND r.labels ->> 'os' IS DISTINCT FROM d.labels ->> 'os')
+                                  OR (d.labels ->> 'arch' IS NOT NULL AND r.labels ->> 'arch' IS DISTINCT FROM d.labels ->> 'arch')))
             LIMIT 1 FOR UPDATE SKIP LOCKED)
 RETURNING runners.id, runners.key, runners.created, runners.last_seen, runners.reservation_timeout, runners.state, runners.endpoint, runners.module_name, runners.deployment_id, runners.labels
 `
 
 // Find an idle runner and reserve it for the given deployment.
+//
+// Runners dedicated to a project are only reserved for deployments from that
+// project, while shared runners are reserved for any deployment. Deployments
+// built for a platform are only reserved runners on that platform.
 func (q *Queries) ReserveRunner(ctx context.Context, reservationTimeout time.Time, deploymentKey model.DeploymentKey, labels []byte) (Runner, error) {
 	row := q.db.QueryRow(ctx, reserveRunner, reservationTimeout, deploymentKey, labels)
 	var i Runner
@@ -2085,6 +3105,53 @@ func (q *Queries) ReserveRunner(ctx context.Context, reservationTimeout time.Tim
 	return i, err
 }
 
+const resetDeploymentCrashes = `-- name: ResetDeploymentCrashes :exec
+UPDATE deployments d
+SET crash_count     = 0,
+    last_crashed_at = NULL
+FROM modules m
+WHERE d.module_id = m.id
+  AND m.name = $1::TEXT
+`
+
+func (q *Queries) ResetDeploymentCrashes(ctx context.Context, module string) error {
+	_, err := q.db.Exec(ctx, resetDeploymentCrashes, module)
+	return err
+}
+
+const restoreFSMInstance = `-- name: RestoreFSMInstance :exec
+INSERT INTO fsm_instances (
+  fsm,
+  key,
+  status,
+  current_state
+) VALUES (
+  $1::schema_ref,
+  $2::TEXT,
+  $3::fsm_status,
+  $4::schema_ref
+)
+ON CONFLICT(fsm, key) DO NOTHING
+`
+
+type RestoreFSMInstanceParams struct {
+	Fsm          schema.RefKey
+	Key          string
+	Status       FsmStatus
+	CurrentState optional.Option[schema.RefKey]
+}
+
+// Restore an FSM instance from a backup, unless it already exists.
+func (q *Queries) RestoreFSMInstance(ctx context.Context, arg RestoreFSMInstanceParams) error {
+	_, err := q.db.Exec(ctx, restoreFSMInstance,
+		arg.Fsm,
+		arg.Key,
+		arg.Status,
+		arg.CurrentState,
+	)
+	return err
+}
+
 const setDeploymentDesiredReplicas = `-- name: SetDeploymentDesiredReplicas :exec
 UPDATE deployments
 SET min_replicas = $2
@@ -2097,6 +3164,50 @@ func (q *Queries) SetDeploymentDesiredReplicas(ctx context.Context, key model.De
 	return err
 }
 
+const setModuleLogLevel = `-- name: SetModuleLogLevel :exec
+INSERT INTO module_log_levels (module, level, expires_at)
+VALUES ($1::TEXT, $2::TEXT, $3::TIMESTAMPTZ)
+ON CONFLICT (module) DO UPDATE
+SET level = EXCLUDED.level,
+    expires_at = EXCLUDED.expires_at
+`
+
+func (q *Queries) SetModuleLogLevel(ctx context.Context, module string, level string, expiresAt time.Time) error {
+	_, err := q.db.Exec(ctx, setModuleLogLevel, module, level, expiresAt)
+	return err
+}
+
+const setCronJobsPaused = `-- name: SetCronJobsPaused :many
+UPDATE cron_jobs j
+SET paused = $1::BOOLEAN
+FROM deployments d
+WHERE j.deployment_id = d.id
+  AND d.min_replicas > 0
+  AND j.module_name = $2::TEXT
+  AND ($3::TEXT IS NULL OR j.verb = $3::TEXT)
+RETURNING j.key
+`
+
+func (q *Queries) SetCronJobsPaused(ctx context.Context, paused bool, module string, verb optional.Option[string]) ([]model.CronJobKey, error) {
+	rows, err := q.db.Query(ctx, setCronJobsPaused, paused, module, verb)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []model.CronJobKey
+	for rows.Next() {
+		var key model.CronJobKey
+		if err := rows.Scan(&key); err != nil {
+			return nil, err
+		}
+		items = append(items, key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const startCronJobs = `-- name: StartCronJobs :many
 WITH updates AS (
   UPDATE cron_jobs
@@ -2111,6 +3222,7 @@ SELECT j.key as key, d.key as deployment_key, j.module_name as module, j.verb, j
   COALESCE(u.start_time, j.start_time) as start_time,
   COALESCE(u.next_execution, j.next_execution) as next_execution,
   COALESCE(u.state, j.state) as state,
+  j.paused,
   d.min_replicas > 0 as has_min_replicas,
   CASE WHEN u.key IS NULL THEN FALSE ELSE TRUE END as updated
 FROM cron_jobs j
@@ -2128,6 +3240,7 @@ type StartCronJobsRow struct {
 	StartTime      time.Time
 	NextExecution  time.Time
 	State          model.CronJobState
+	Paused         bool
 	HasMinReplicas bool
 	Updated        bool
 }
@@ -2150,6 +3263,7 @@ func (q *Queries) StartCronJobs(ctx context.Context, keys []string) ([]StartCron
 			&i.StartTime,
 			&i.NextExecution,
 			&i.State,
+			&i.Paused,
 			&i.HasMinReplicas,
 			&i.Updated,
 		); err != nil {
@@ -2251,6 +3365,61 @@ func (q *Queries) SucceedFSMInstance(ctx context.Context, fsm schema.RefKey, key
 	return column_1, err
 }
 
+const updateAdminResource = `-- name: UpdateAdminResource :one
+UPDATE admin_resources
+SET spec       = $1::JSONB,
+    version    = version + 1,
+    updated_at = (NOW() AT TIME ZONE 'utc')
+WHERE kind = $2::TEXT
+  AND name = $3::TEXT
+  AND ($4::BIGINT IS NULL OR version = $4::BIGINT)
+RETURNING id, created_at, updated_at, kind, name, spec, version
+`
+
+type UpdateAdminResourceParams struct {
+	Spec    []byte
+	Kind    string
+	Name    string
+	Version optional.Option[int64]
+}
+
+// Update the spec of a resource if it is at "version", or any version if it
+// is NULL.
+func (q *Queries) UpdateAdminResource(ctx context.Context, arg UpdateAdminResourceParams) (AdminResource, error) {
+	row := q.db.QueryRow(ctx, updateAdminResource,
+		arg.Spec,
+		arg.Kind,
+		arg.Name,
+		arg.Version,
+	)
+	var i AdminResource
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Kind,
+		&i.Name,
+		&i.Spec,
+		&i.Version,
+	)
+	return i, err
+}
+
+const upsertArtefactChunk = `-- name: UpsertArtefactChunk :one
+INSERT INTO artefact_chunks (digest, content, compression)
+VALUES ($1::BYTEA, $2::BYTEA, $3::TEXT)
+ON CONFLICT (digest) DO UPDATE SET digest = EXCLUDED.digest
+RETURNING id
+`
+
+// Create a chunk of artefact content if it doesn't exist, and return its ID.
+func (q *Queries) UpsertArtefactChunk(ctx context.Context, digest []byte, content []byte, compression optional.Option[string]) (int64, error) {
+	row := q.db.QueryRow(ctx, upsertArtefactChunk, digest, content, compression)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
 const upsertController = `-- name: UpsertController :one
 INSERT INTO controller (key, endpoint)
 VALUES ($1, $2)
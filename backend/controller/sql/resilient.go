@@ -0,0 +1,276 @@
+package sql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/alecthomas/atomic"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jpillora/backoff"
+
+	"github.com/TBD54566975/ftl/db/dalerrs"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+// PoolI is a connection pool that can be health checked.
+type PoolI interface {
+	ConnI
+	Ping(ctx context.Context) error
+}
+
+// ResilienceConfig controls how a [ResilientConn] handles an unavailable database.
+type ResilienceConfig struct {
+	// Maximum number of attempts of each statement, including the first.
+	MaxAttempts int
+	// Delay between attempts.
+	Backoff backoff.Backoff
+	// Number of consecutive statements failing because the database is
+	// unavailable before the circuit breaker opens.
+	FailureThreshold int
+	// Period between health checks of the database while the circuit breaker
+	// is open.
+	HealthCheckInterval time.Duration
+}
+
+var DefaultResilienceConfig = ResilienceConfig{
+	MaxAttempts:         3,
+	Backoff:             backoff.Backoff{Min: time.Millisecond * 50, Max: time.Second, Factor: 2, Jitter: true},
+	FailureThreshold:    5,
+	HealthCheckInterval: time.Second,
+}
+
+// errInjectedUnavailable is returned by every statement while
+// [ResilientConn.InjectUnavailable] is in effect.
+var errInjectedUnavailable = fmt.Errorf("%w: injected fault", dalerrs.ErrDBUnavailable)
+
+// ResilientConn wraps a connection pool, retrying statements that fail with
+// transient errors.
+//
+// Once enough consecutive statements fail because the database is
+// unavailable, its circuit breaker opens and statements fail immediately with
+// [dalerrs.ErrDBUnavailable], rather than waiting on connection timeouts,
+// until a health check of the pool succeeds.
+//
+// Statements within transactions are not retried, as the transaction may not
+// be usable after a failure.
+type ResilientConn struct {
+	pool   PoolI
+	config ResilienceConfig
+
+	injected atomic.Value[bool]
+
+	lock     sync.Mutex
+	failures int
+	open     bool
+}
+
+var _ ConnI = (*ResilientConn)(nil)
+
+// NewResilientConn wraps "pool" and health checks it while its circuit
+// breaker is open, until the context is cancelled.
+func NewResilientConn(ctx context.Context, pool PoolI, config ResilienceConfig) *ResilientConn {
+	c := &ResilientConn{pool: pool, config: config}
+	go c.healthCheck(ctx)
+	return c
+}
+
+// InjectUnavailable makes the database appear unavailable until called with
+// false, without affecting the database itself.
+//
+// This is intended for testing how callers of the DAL handle outages.
+func (c *ResilientConn) InjectUnavailable(unavailable bool) {
+	c.injected.Store(unavailable)
+}
+
+// Available returns false while the circuit breaker is open.
+func (c *ResilientConn) Available() bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return !c.open
+}
+
+func (c *ResilientConn) Exec(ctx context.Context, sql string, args ...any) (tag pgconn.CommandTag, err error) {
+	err = c.retry(ctx, func() error {
+		tag, err = c.pool.Exec(ctx, sql, args...)
+		return err
+	})
+	return tag, err
+}
+
+func (c *ResilientConn) Query(ctx context.Context, sql string, args ...any) (rows pgx.Rows, err error) {
+	err = c.retry(ctx, func() error {
+		rows, err = c.pool.Query(ctx, sql, args...)
+		if err != nil {
+			return err
+		}
+		// Failures to execute the query are reported by the first call to
+		// Next, so it is peeked at to allow them to be retried. Failures
+		// after that are not, as rows may already have been consumed.
+		peeked := &peekedRows{Rows: rows, next: rows.Next()}
+		if !peeked.next {
+			if err := rows.Err(); err != nil {
+				return err
+			}
+		}
+		rows = peeked
+		return nil
+	})
+	return rows, err
+}
+
+func (c *ResilientConn) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	return &resilientRow{ctx: ctx, conn: c, sql: sql, args: args}
+}
+
+func (c *ResilientConn) Begin(ctx context.Context) (tx pgx.Tx, err error) {
+	err = c.retry(ctx, func() error {
+		tx, err = c.pool.Begin(ctx)
+		return err
+	})
+	return tx, err
+}
+
+// retry "statement" until it succeeds, fails with an error that isn't
+// retryable, or runs out of attempts.
+//
+// Errors caused by the database being unavailable wrap
+// [dalerrs.ErrDBUnavailable].
+func (c *ResilientConn) retry(ctx context.Context, statement func() error) error {
+	retry := c.config.Backoff
+	for attempt := 1; ; attempt++ {
+		if !c.Available() {
+			return fmt.Errorf("%w: circuit breaker is open", dalerrs.ErrDBUnavailable)
+		}
+		var err error
+		if c.injected.Load() {
+			err = errInjectedUnavailable
+		} else {
+			err = statement()
+		}
+		c.record(ctx, err)
+		if err == nil {
+			return nil
+		}
+		if !retryable(err) || attempt >= c.config.MaxAttempts || ctx.Err() != nil {
+			return wrapUnavailable(err)
+		}
+		delay := retry.Duration()
+		log.FromContext(ctx).Tracef("Database statement failed, retrying in %s: %s", delay, err)
+		select {
+		case <-ctx.Done():
+			return wrapUnavailable(err)
+		case <-time.After(delay):
+		}
+	}
+}
+
+// record the outcome of a statement, opening the circuit breaker if the
+// database appears to be unavailable.
+func (c *ResilientConn) record(ctx context.Context, err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if !dalerrs.IsUnavailable(err) {
+		c.failures = 0
+		return
+	}
+	c.failures++
+	if !c.open && c.failures >= c.config.FailureThreshold {
+		c.open = true
+		log.FromContext(ctx).Errorf(err, "Database is unavailable, failing statements until it recovers")
+	}
+}
+
+// healthCheck pings the pool while the circuit breaker is open, closing it
+// once the database is available again.
+func (c *ResilientConn) healthCheck(ctx context.Context) {
+	logger := log.FromContext(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(c.config.HealthCheckInterval):
+		}
+		if c.Available() {
+			continue
+		}
+		err := c.ping(ctx)
+		if err != nil {
+			logger.Debugf("Database health check failed: %s", err)
+			continue
+		}
+		c.lock.Lock()
+		c.open = false
+		c.failures = 0
+		c.lock.Unlock()
+		logger.Infof("Database is available again")
+	}
+}
+
+func (c *ResilientConn) ping(ctx context.Context) error {
+	if c.injected.Load() {
+		return errInjectedUnavailable
+	}
+	ctx, cancel := context.WithTimeout(ctx, c.config.HealthCheckInterval)
+	defer cancel()
+	return c.pool.Ping(ctx)
+}
+
+// resilientRow retries the query when it is scanned, as that is when
+// [pgx.Row] reports errors.
+type resilientRow struct {
+	ctx  context.Context
+	conn *ResilientConn
+	sql  string
+	args []any
+}
+
+func (r *resilientRow) Scan(dest ...any) error {
+	return r.conn.retry(r.ctx, func() error {
+		return r.conn.pool.QueryRow(r.ctx, r.sql, r.args...).Scan(dest...)
+	})
+}
+
+// peekedRows are rows whose first call to Next has already been made.
+type peekedRows struct {
+	pgx.Rows
+	peeked bool
+	next   bool
+}
+
+func (r *peekedRows) Next() bool {
+	if !r.peeked {
+		r.peeked = true
+		return r.next
+	}
+	return r.Rows.Next()
+}
+
+// wrapUnavailable wraps "err" with [dalerrs.ErrDBUnavailable] if it was caused
+// by the database being unavailable.
+func wrapUnavailable(err error) error {
+	if dalerrs.IsUnavailable(err) && !errors.Is(err, dalerrs.ErrDBUnavailable) {
+		return fmt.Errorf("%w: %w", dalerrs.ErrDBUnavailable, err)
+	}
+	return err
+}
+
+// retryable returns true if a statement that failed with "err" had no effect,
+// and is worth retrying.
+func retryable(err error) bool {
+	var connectErr *pgconn.ConnectError
+	var pgErr *pgconn.PgError
+	switch {
+	case errors.Is(err, errInjectedUnavailable), errors.As(err, &connectErr), pgconn.SafeToRetry(err):
+		return true
+	case errors.As(err, &pgErr):
+		// Statements outside transactions are rolled back when the server
+		// reports an error.
+		return dalerrs.IsTransient(err)
+	default:
+		return false
+	}
+}
@@ -0,0 +1,112 @@
+package sql
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jpillora/backoff"
+
+	"github.com/TBD54566975/ftl/db/dalerrs"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+// fakePool fails each statement with the next of its errors, then succeeds.
+type fakePool struct {
+	errs       []error
+	statements int
+	down       atomic.Bool
+}
+
+func (p *fakePool) Exec(context.Context, string, ...any) (pgconn.CommandTag, error) {
+	p.statements++
+	if len(p.errs) > 0 {
+		err := p.errs[0]
+		p.errs = p.errs[1:]
+		return pgconn.CommandTag{}, err
+	}
+	return pgconn.NewCommandTag("UPDATE 1"), nil
+}
+
+func (p *fakePool) Query(context.Context, string, ...any) (pgx.Rows, error) { panic("unused") }
+func (p *fakePool) QueryRow(context.Context, string, ...any) pgx.Row        { panic("unused") }
+func (p *fakePool) Begin(context.Context) (pgx.Tx, error)                   { panic("unused") }
+func (p *fakePool) Ping(context.Context) error {
+	if p.down.Load() {
+		return errors.New("down")
+	}
+	return nil
+}
+
+func TestResilientConn(t *testing.T) {
+	ctx, cancel := context.WithCancel(log.ContextWithNewDefaultLogger(context.Background()))
+	t.Cleanup(cancel)
+	config := ResilienceConfig{
+		MaxAttempts:         3,
+		Backoff:             backoff.Backoff{Min: time.Millisecond, Max: time.Millisecond},
+		FailureThreshold:    4,
+		HealthCheckInterval: time.Millisecond * 10,
+	}
+	unavailable := &pgconn.PgError{Code: pgerrcode.CannotConnectNow}
+	serialization := &pgconn.PgError{Code: pgerrcode.SerializationFailure}
+
+	t.Run("RetriesTransientErrors", func(t *testing.T) {
+		pool := &fakePool{errs: []error{unavailable, serialization}}
+		conn := NewResilientConn(ctx, pool, config)
+		tag, err := conn.Exec(ctx, "UPDATE")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), tag.RowsAffected())
+		assert.Equal(t, 3, pool.statements)
+	})
+
+	t.Run("DoesNotRetryOtherErrors", func(t *testing.T) {
+		constraint := &pgconn.PgError{Code: pgerrcode.UniqueViolation}
+		pool := &fakePool{errs: []error{constraint}}
+		conn := NewResilientConn(ctx, pool, config)
+		_, err := conn.Exec(ctx, "UPDATE")
+		assert.IsError(t, err, constraint)
+		assert.Equal(t, 1, pool.statements)
+	})
+
+	t.Run("WrapsUnavailableErrors", func(t *testing.T) {
+		pool := &fakePool{errs: []error{unavailable, unavailable, unavailable}}
+		conn := NewResilientConn(ctx, pool, config)
+		_, err := conn.Exec(ctx, "UPDATE")
+		assert.IsError(t, err, dalerrs.ErrDBUnavailable)
+		assert.Equal(t, 3, pool.statements)
+		assert.True(t, conn.Available(), "circuit breaker should not open below the threshold")
+	})
+
+	t.Run("CircuitBreaker", func(t *testing.T) {
+		pool := &fakePool{}
+		pool.down.Store(true)
+		conn := NewResilientConn(ctx, pool, config)
+		conn.InjectUnavailable(true)
+		_, err := conn.Exec(ctx, "UPDATE")
+		assert.IsError(t, err, dalerrs.ErrDBUnavailable)
+		_, err = conn.Exec(ctx, "UPDATE")
+		assert.IsError(t, err, dalerrs.ErrDBUnavailable)
+		assert.False(t, conn.Available())
+		assert.Equal(t, 0, pool.statements, "injected faults should not reach the pool")
+
+		// Health checks keep the breaker open until the pool can be pinged.
+		conn.InjectUnavailable(false)
+		time.Sleep(config.HealthCheckInterval * 5)
+		assert.False(t, conn.Available())
+		_, err = conn.Exec(ctx, "UPDATE")
+		assert.IsError(t, err, dalerrs.ErrDBUnavailable)
+		assert.Equal(t, 0, pool.statements, "statements should fail fast while the breaker is open")
+
+		pool.down.Store(false)
+		time.Sleep(config.HealthCheckInterval * 5)
+		assert.True(t, conn.Available())
+		_, err = conn.Exec(ctx, "UPDATE")
+		assert.NoError(t, err)
+	})
+}
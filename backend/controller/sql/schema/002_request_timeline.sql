@@ -0,0 +1,10 @@
+-- migrate:up
+-- Request that triggered the async call (eg. by sending an FSM event or publishing to a topic), if any.
+ALTER TABLE async_calls ADD COLUMN parent_request_key TEXT;
+
+CREATE INDEX async_calls_parent_request_key_idx ON async_calls (parent_request_key);
+
+-- Request that published the event, if any.
+ALTER TABLE topic_events ADD COLUMN request_key TEXT;
+
+-- migrate:down
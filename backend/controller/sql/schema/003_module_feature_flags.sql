@@ -0,0 +1,14 @@
+-- migrate:up
+CREATE TABLE module_feature_flags
+(
+    id         BIGINT      NOT NULL GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT (NOW() AT TIME ZONE 'utc'),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT (NOW() AT TIME ZONE 'utc'),
+    module     TEXT        NOT NULL,
+    name       TEXT        NOT NULL,
+    -- Rules for the flag, as JSON encoded featureflags.Flag.
+    rules      JSONB       NOT NULL,
+    UNIQUE (module, name)
+);
+
+-- migrate:down
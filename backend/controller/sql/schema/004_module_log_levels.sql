@@ -0,0 +1,12 @@
+-- migrate:up
+CREATE TABLE module_log_levels
+(
+    id         BIGINT      NOT NULL GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT (NOW() AT TIME ZONE 'utc'),
+    module     TEXT        NOT NULL UNIQUE,
+    -- Name of the log level, eg. "debug".
+    level      TEXT        NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+-- migrate:down
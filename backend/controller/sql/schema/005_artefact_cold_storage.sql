@@ -0,0 +1,8 @@
+-- migrate:up
+-- The content of artefacts moved to cold storage is removed from the
+-- database, and fetched from the cold store on demand.
+ALTER TABLE artefacts
+    ALTER COLUMN content DROP NOT NULL,
+    ADD COLUMN cold_at TIMESTAMPTZ;
+
+-- migrate:down
@@ -0,0 +1,12 @@
+-- migrate:up
+-- Async calls sharing an ordering key are executed one at a time, in the
+-- order they were created.
+ALTER TABLE async_calls ADD COLUMN ordering_key TEXT;
+
+CREATE INDEX async_calls_ordering_key_idx ON async_calls (ordering_key, created_at, id)
+    WHERE ordering_key IS NOT NULL AND state = 'pending';
+
+-- Ordering key of the async calls delivering the event to subscribers.
+ALTER TABLE topic_events ADD COLUMN ordering_key TEXT;
+
+-- migrate:down
@@ -0,0 +1,17 @@
+-- migrate:up
+-- Databases the controller provisioned for modules, so that they can be torn
+-- down once they are no longer needed.
+CREATE TABLE provisioned_databases
+(
+    id          BIGINT      NOT NULL GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT (NOW() AT TIME ZONE 'utc'),
+    module      TEXT        NOT NULL,
+    name        TEXT        NOT NULL,
+    -- Provisioner that created the database, eg. "postgres".
+    provisioner TEXT        NOT NULL,
+    -- Provisioner specific identifier of the resources created for the database.
+    resource    TEXT        NOT NULL,
+    UNIQUE (module, name)
+);
+
+-- migrate:down
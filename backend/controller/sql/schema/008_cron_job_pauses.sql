@@ -0,0 +1,6 @@
+-- migrate:up
+-- Paused cron jobs keep advancing through their schedule without being
+-- executed, until they are resumed.
+ALTER TABLE cron_jobs ADD COLUMN paused BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- migrate:down
@@ -0,0 +1,7 @@
+-- migrate:up
+-- Consecutive crashes of the replicas of a deployment, so that crash looping
+-- deployments are restarted with backoff and reported as unhealthy.
+ALTER TABLE deployments ADD COLUMN crash_count INT NOT NULL DEFAULT 0;
+ALTER TABLE deployments ADD COLUMN last_crashed_at TIMESTAMPTZ;
+
+-- migrate:down
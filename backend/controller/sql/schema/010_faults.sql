@@ -0,0 +1,19 @@
+-- migrate:up
+-- Faults injected into calls to verbs, for game days and integration tests.
+CREATE TABLE faults
+(
+    id         BIGINT           NOT NULL GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    created_at TIMESTAMPTZ      NOT NULL DEFAULT (NOW() AT TIME ZONE 'utc'),
+    -- One of "latency", "error", "drop_async" or "delay_scheduling".
+    kind       TEXT             NOT NULL,
+    module     TEXT             NOT NULL,
+    -- The fault applies to every verb of the module if NULL.
+    verb       TEXT,
+    -- Percentage of calls the fault is injected into.
+    percent    DOUBLE PRECISION NOT NULL,
+    latency_ms BIGINT           NOT NULL DEFAULT 0,
+    message    TEXT             NOT NULL DEFAULT '',
+    expires_at TIMESTAMPTZ      NOT NULL
+);
+
+-- migrate:down
@@ -0,0 +1,16 @@
+-- migrate:up
+-- Deployments whose stored schema could not be parsed by a controller, eg.
+-- after a change to the schema format. Quarantined deployments are scaled
+-- down, and their schema replaced with an empty module so that they don't
+-- break queries over every deployment.
+CREATE TABLE deployment_quarantines
+(
+    id            BIGINT      NOT NULL GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT (NOW() AT TIME ZONE 'utc'),
+    deployment_id BIGINT      NOT NULL UNIQUE REFERENCES deployments (id) ON DELETE CASCADE,
+    reason        TEXT        NOT NULL,
+    -- The schema of the deployment before it was quarantined.
+    "schema"      BYTEA       NOT NULL
+);
+
+-- migrate:down
@@ -0,0 +1,9 @@
+-- migrate:up
+-- Retries of an async call are inserted as new calls, which reference the
+-- first attempt so that the result of the call can be found from its ID.
+ALTER TABLE async_calls ADD COLUMN first_attempt_id BIGINT REFERENCES async_calls (id) ON DELETE CASCADE;
+
+CREATE INDEX async_calls_first_attempt_id_idx ON async_calls (first_attempt_id)
+    WHERE first_attempt_id IS NOT NULL;
+
+-- migrate:down
@@ -0,0 +1,19 @@
+-- migrate:up
+-- Resources managed with the admin resource API that are stored by the
+-- controller, eg. projects, quota overrides and ingress domains.
+CREATE TABLE admin_resources
+(
+    id         BIGINT      NOT NULL GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT (NOW() AT TIME ZONE 'utc'),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT (NOW() AT TIME ZONE 'utc'),
+    -- One of "project", "quota" or "ingress_domain".
+    kind       TEXT        NOT NULL,
+    name       TEXT        NOT NULL,
+    -- The protojson encoded spec of the resource.
+    spec       JSONB       NOT NULL,
+    -- Incremented on each update, for optimistic concurrency control.
+    version    BIGINT      NOT NULL DEFAULT 1,
+    UNIQUE (kind, name)
+);
+
+-- migrate:down
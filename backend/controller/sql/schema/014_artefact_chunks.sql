@@ -0,0 +1,25 @@
+-- migrate:up
+-- The content of artefacts is split into content-defined chunks, which are
+-- stored once and shared by every artefact that contains them. Artefacts
+-- created before chunking keep their content in the artefacts table.
+CREATE TABLE artefact_chunks
+(
+    id         BIGINT       NOT NULL GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    created_at TIMESTAMPTZ  NOT NULL DEFAULT (NOW() AT TIME ZONE 'utc'),
+    -- SHA256 digest of the content.
+    digest     BYTEA UNIQUE NOT NULL,
+    content    BYTEA        NOT NULL
+);
+
+CREATE TABLE artefact_chunk_refs
+(
+    artefact_id BIGINT NOT NULL REFERENCES artefacts (id) ON DELETE CASCADE,
+    chunk_id    BIGINT NOT NULL REFERENCES artefact_chunks (id),
+    -- Position of the chunk in the content of the artefact.
+    seq         INT    NOT NULL,
+    PRIMARY KEY (artefact_id, seq)
+);
+
+CREATE INDEX artefact_chunk_refs_chunk_id_idx ON artefact_chunk_refs (chunk_id);
+
+-- migrate:down
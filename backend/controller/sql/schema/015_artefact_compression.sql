@@ -0,0 +1,11 @@
+-- migrate:up
+-- The compression of stored artefact content, eg. "zstd", or NULL if it is
+-- not compressed. For artefacts this is the compression of their content,
+-- whether in the database or in cold storage.
+ALTER TABLE artefacts
+    ADD COLUMN compression TEXT;
+
+ALTER TABLE artefact_chunks
+    ADD COLUMN compression TEXT;
+
+-- migrate:down
@@ -3,6 +3,8 @@ package database
 import (
 	"context"
 
+	"github.com/jackc/pgx/v5"
+
 	"github.com/TBD54566975/ftl/go-runtime/ftl" // Import the FTL SDK.
 )
 
@@ -24,6 +26,43 @@ func Insert(ctx context.Context, req InsertRequest) (InsertResponse, error) {
 	return InsertResponse{}, nil
 }
 
+// InsertPooled inserts into a table created by the module's migrations.
+//
+//ftl:verb
+func InsertPooled(ctx context.Context, req InsertRequest) (InsertResponse, error) {
+	_, err := db.Pool(ctx).Exec(ctx, "INSERT INTO pooled_requests (data) VALUES ($1);", req.Data)
+	if err != nil {
+		return InsertResponse{}, err
+	}
+	return InsertResponse{}, nil
+}
+
+var requests = ftl.Topic[InsertRequest]("requests")
+var _ = ftl.Subscription(requests, "recordRequests")
+
+// InsertTransactional inserts and publishes an event in a single transaction.
+//
+//ftl:verb
+func InsertTransactional(ctx context.Context, req InsertRequest) (InsertResponse, error) {
+	err := ftl.WithTransaction(ctx, db, func(ctx context.Context, tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, "INSERT INTO pooled_requests (data) VALUES ($1);", req.Data); err != nil {
+			return err
+		}
+		return requests.Publish(ctx, req)
+	})
+	if err != nil {
+		return InsertResponse{}, err
+	}
+	return InsertResponse{}, nil
+}
+
+//ftl:verb
+//ftl:subscribe recordRequests
+func RecordRequest(ctx context.Context, req InsertRequest) error {
+	_, err := db.Pool(ctx).Exec(ctx, "INSERT INTO recorded_requests (data) VALUES ($1);", req.Data)
+	return err
+}
+
 func persistRequest(ctx context.Context, req InsertRequest) error {
 	_, err := db.Get(ctx).Exec(`CREATE TABLE IF NOT EXISTS requests
 	       (
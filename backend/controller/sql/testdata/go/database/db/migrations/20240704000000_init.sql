@@ -0,0 +1,7 @@
+-- migrate:up
+CREATE TABLE pooled_requests (
+  data TEXT NOT NULL
+);
+
+-- migrate:down
+DROP TABLE pooled_requests;
@@ -0,0 +1,7 @@
+-- migrate:up
+CREATE TABLE recorded_requests (
+  data TEXT NOT NULL
+);
+
+-- migrate:down
+DROP TABLE recorded_requests;
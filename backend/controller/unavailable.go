@@ -0,0 +1,44 @@
+package controller
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+
+	"github.com/TBD54566975/ftl/db/dalerrs"
+)
+
+// dbUnavailableInterceptor reports handler errors caused by the database being
+// unavailable as [connect.CodeUnavailable], which is served as a 503 and
+// retried by clients, rather than as an unknown error.
+type dbUnavailableInterceptor struct{}
+
+var _ connect.Interceptor = dbUnavailableInterceptor{}
+
+func (dbUnavailableInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		resp, err := next(ctx, req)
+		return resp, translateDBUnavailable(err)
+	}
+}
+
+func (dbUnavailableInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (dbUnavailableInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return translateDBUnavailable(next(ctx, conn))
+	}
+}
+
+func translateDBUnavailable(err error) error {
+	if err == nil || !errors.Is(err, dalerrs.ErrDBUnavailable) {
+		return err
+	}
+	if connectErr := new(connect.Error); errors.As(err, &connectErr) {
+		return err
+	}
+	return connect.NewError(connect.CodeUnavailable, err)
+}
@@ -0,0 +1,61 @@
+package webhooks
+
+import (
+	"sync"
+	"time"
+)
+
+// CrashLoopDetector detects deployments whose replicas keep terminating with
+// an error, from the crashes reported to this controller.
+type CrashLoopDetector struct {
+	threshold int
+	window    time.Duration
+
+	lock sync.Mutex
+	// Times of recent crashes of each deployment.
+	crashes map[string][]time.Time
+}
+
+func NewCrashLoopDetector(config Config) *CrashLoopDetector {
+	return &CrashLoopDetector{threshold: config.CrashLoopThreshold, window: config.CrashLoopWindow, crashes: map[string][]time.Time{}}
+}
+
+// SetConfig replaces the threshold and window of the detector, keeping the
+// crashes recorded so far.
+func (c *CrashLoopDetector) SetConfig(config Config) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.threshold = config.CrashLoopThreshold
+	c.window = config.CrashLoopWindow
+}
+
+// Crashed records a crash of "deployment" at "now", returning true if it has
+// crashed the threshold number of times within the window.
+//
+// The crashes of a deployment are cleared once it is reported as crash
+// looping, so that it is only reported again if it keeps crashing.
+func (c *CrashLoopDetector) Crashed(deployment string, now time.Time) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	// Forget crashes outside the window, including those of other deployments
+	// so that deployments that stopped crashing don't accumulate.
+	for key, times := range c.crashes {
+		recent := times[:0]
+		for _, t := range times {
+			if now.Sub(t) < c.window {
+				recent = append(recent, t)
+			}
+		}
+		if len(recent) == 0 {
+			delete(c.crashes, key)
+		} else {
+			c.crashes[key] = recent
+		}
+	}
+	c.crashes[deployment] = append(c.crashes[deployment], now)
+	if len(c.crashes[deployment]) < c.threshold {
+		return false
+	}
+	delete(c.crashes, deployment)
+	return true
+}
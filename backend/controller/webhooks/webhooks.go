@@ -0,0 +1,291 @@
+// Package webhooks notifies external services, such as Slack or Teams, of
+// deployment and FSM events through signed HTTP webhooks.
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/alecthomas/atomic"
+	"github.com/jpillora/backoff"
+
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+const (
+	// Maximum number of attempts to deliver an event to a webhook.
+	maxAttempts = 5
+	// Maximum number of events waiting to be delivered before new events are dropped.
+	queueSize = 1000
+	// Number of events delivered concurrently.
+	workers = 4
+)
+
+type Config struct {
+	File               string        `help:"TOML file configuring webhooks to notify of deployment and FSM events." env:"FTL_CONTROLLER_WEBHOOKS_FILE" type:"existingfile" placeholder:"FILE"`
+	CrashLoopThreshold int           `help:"Number of times a deployment must crash within --webhooks-crash-loop-window to be reported as crash looping." default:"3" env:"FTL_CONTROLLER_WEBHOOKS_CRASH_LOOP_THRESHOLD"`
+	CrashLoopWindow    time.Duration `help:"Window in which crashes of a deployment are counted towards a crash loop." default:"10m" env:"FTL_CONTROLLER_WEBHOOKS_CRASH_LOOP_WINDOW"`
+}
+
+type EventType string
+
+const (
+	// EventDeploymentSucceeded is sent when a deployment reaches its required
+	// number of replicas.
+	EventDeploymentSucceeded EventType = "deployment.succeeded"
+	// EventDeploymentFailed is sent when a deployment repeatedly fails to
+	// reach its required number of replicas.
+	EventDeploymentFailed EventType = "deployment.failed"
+	// EventDeploymentCrashLoop is sent when the replicas of a deployment
+	// repeatedly terminate with an error.
+	EventDeploymentCrashLoop EventType = "deployment.crash_loop"
+	// EventFSMFailed is sent when an instance of an FSM fails.
+	EventFSMFailed EventType = "fsm.failed"
+)
+
+var eventTypes = map[EventType]bool{
+	EventDeploymentSucceeded: true,
+	EventDeploymentFailed:    true,
+	EventDeploymentCrashLoop: true,
+	EventFSMFailed:           true,
+}
+
+// Event is the JSON body of webhook requests.
+type Event struct {
+	Type          EventType `json:"type"`
+	Time          time.Time `json:"time"`
+	Module        string    `json:"module,omitempty"`
+	DeploymentKey string    `json:"deployment_key,omitempty"`
+	FSM           string    `json:"fsm,omitempty"`
+	FSMKey        string    `json:"fsm_key,omitempty"`
+	Message       string    `json:"message"`
+	// Text summarises the event, and is what Slack and Teams incoming webhooks display.
+	Text string `json:"text"`
+}
+
+// Webhook is a URL notified of events, configured in the webhooks file as a
+// [[webhook]] table.
+type Webhook struct {
+	URL string `toml:"url"`
+	// Secret used to sign requests, if any.
+	Secret string `toml:"secret"`
+	// Types of events sent to the webhook, or all events if empty.
+	Events []EventType `toml:"events"`
+}
+
+func (w Webhook) wants(event EventType) bool {
+	if len(w.Events) == 0 {
+		return true
+	}
+	for _, wanted := range w.Events {
+		if wanted == event {
+			return true
+		}
+	}
+	return false
+}
+
+// LoadWebhooks loads the webhooks configured in a TOML file.
+func LoadWebhooks(path string) ([]Webhook, error) {
+	var file struct {
+		Webhook []Webhook `toml:"webhook"`
+	}
+	if _, err := toml.DecodeFile(path, &file); err != nil {
+		return nil, fmt.Errorf("failed to load webhooks from %s: %w", path, err)
+	}
+	for i, webhook := range file.Webhook {
+		u, err := url.Parse(webhook.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			return nil, fmt.Errorf("%s: webhook %d has invalid URL %q", path, i+1, webhook.URL)
+		}
+		for _, event := range webhook.Events {
+			if !eventTypes[event] {
+				return nil, fmt.Errorf("%s: webhook %d has unknown event type %q", path, i+1, event)
+			}
+		}
+	}
+	return file.Webhook, nil
+}
+
+// Sign returns the signature of a request sent at "timestamp", in the format
+// of the X-FTL-Signature header.
+//
+// The signature is the HMAC-SHA256 of the timestamp, a ".", and the body.
+// Receivers should recompute it, compare it in constant time, and reject
+// requests with old timestamps to prevent replays.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+type delivery struct {
+	webhook Webhook
+	body    []byte
+}
+
+// Notifier delivers events to webhooks in the background, retrying failed
+// deliveries with backoff.
+//
+// Events are dropped rather than blocking the caller if too many are waiting
+// to be delivered.
+type Notifier struct {
+	webhooks atomic.Value[[]Webhook]
+	client   *http.Client
+	queue    chan delivery
+	retry    backoff.Backoff
+	start    sync.Once
+}
+
+// New creates a [Notifier] for the webhooks configured in config.File, which
+// does nothing if no file is configured.
+func New(ctx context.Context, config Config) (*Notifier, error) {
+	n := &Notifier{
+		client: &http.Client{Timeout: time.Second * 10},
+		queue:  make(chan delivery, queueSize),
+		retry:  backoff.Backoff{Min: time.Second, Max: time.Minute, Factor: 2, Jitter: true},
+	}
+	if err := n.Reload(ctx, config); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// Reload replaces the webhooks notified of events with those configured in
+// config.File, starting to deliver events if there were no webhooks before.
+//
+// Events already waiting to be delivered are still delivered to the webhooks
+// they were queued for. If the file fails to load the webhooks are unchanged.
+func (n *Notifier) Reload(ctx context.Context, config Config) error {
+	var webhooks []Webhook
+	if config.File != "" {
+		var err error
+		webhooks, err = LoadWebhooks(config.File)
+		if err != nil {
+			return err
+		}
+	}
+	n.webhooks.Store(webhooks)
+	if len(webhooks) == 0 {
+		return nil
+	}
+	n.start.Do(func() {
+		for range workers {
+			go n.run(ctx)
+		}
+	})
+	log.FromContext(ctx).Debugf("Notifying %d webhooks of events", len(webhooks))
+	return nil
+}
+
+// Notify queues "event" for delivery to the webhooks subscribed to it.
+func (n *Notifier) Notify(ctx context.Context, event Event) {
+	webhooks := n.webhooks.Load()
+	if len(webhooks) == 0 {
+		return
+	}
+	if event.Time.IsZero() {
+		event.Time = time.Now().UTC()
+	}
+	event.Text = fmt.Sprintf("FTL %s: %s", event.Type, event.Message)
+	body, err := json.Marshal(event)
+	if err != nil {
+		log.FromContext(ctx).Errorf(err, "Failed to encode %s webhook", event.Type)
+		return
+	}
+	for _, webhook := range webhooks {
+		if !webhook.wants(event.Type) {
+			continue
+		}
+		select {
+		case n.queue <- delivery{webhook: webhook, body: body}:
+		default:
+			log.FromContext(ctx).Warnf("Dropped %s webhook to %s, too many webhooks are waiting to be delivered", event.Type, redact(webhook.URL))
+		}
+	}
+}
+
+func (n *Notifier) run(ctx context.Context) {
+	logger := log.FromContext(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case d := <-n.queue:
+			if err := n.deliver(ctx, d); err != nil {
+				logger.Warnf("Failed to deliver webhook to %s: %s", redact(d.webhook.URL), err)
+			}
+		}
+	}
+}
+
+// deliver sends a webhook request, retrying it until it succeeds, fails with
+// a client error, or runs out of attempts.
+func (n *Notifier) deliver(ctx context.Context, d delivery) error {
+	retry := n.retry
+	for attempt := 1; ; attempt++ {
+		retryable, err := n.send(ctx, d)
+		if err == nil {
+			return nil
+		}
+		if !retryable || attempt == maxAttempts {
+			return fmt.Errorf("attempt %d: %w", attempt, err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(retry.Duration()):
+		}
+	}
+}
+
+// send a webhook request, returning whether it can be retried if it fails.
+func (n *Notifier) send(ctx context.Context, d delivery) (retryable bool, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.webhook.URL, bytes.NewReader(d.body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if d.webhook.Secret != "" {
+		timestamp := time.Now().Unix()
+		req.Header.Set("X-FTL-Timestamp", strconv.FormatInt(timestamp, 10))
+		req.Header.Set("X-FTL-Signature", Sign(d.webhook.Secret, timestamp, d.body))
+	}
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1024)) //nolint:errcheck
+	switch {
+	case resp.StatusCode/100 == 2:
+		return false, nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode/100 == 5:
+		return true, fmt.Errorf("webhook returned %s", resp.Status)
+	default:
+		return false, fmt.Errorf("webhook returned %s", resp.Status)
+	}
+}
+
+// redact returns the origin of a webhook URL, as the rest of it is often a
+// credential.
+func redact(webhookURL string) string {
+	u, err := url.Parse(webhookURL)
+	if err != nil {
+		return "<invalid URL>"
+	}
+	return u.Scheme + "://" + u.Host
+}
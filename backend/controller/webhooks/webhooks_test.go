@@ -0,0 +1,161 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+	"github.com/jpillora/backoff"
+
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+type request struct {
+	event     Event
+	signature string
+	timestamp string
+	body      []byte
+}
+
+func TestNotify(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	ctx, cancel := context.WithCancel(ctx)
+	t.Cleanup(cancel)
+	requests := make(chan request, 16)
+	failures := 1
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		if failures > 0 {
+			failures--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		var event Event
+		assert.NoError(t, json.Unmarshal(body, &event))
+		requests <- request{event: event, signature: r.Header.Get("X-FTL-Signature"), timestamp: r.Header.Get("X-FTL-Timestamp"), body: body}
+	}))
+	t.Cleanup(server.Close)
+
+	path := filepath.Join(t.TempDir(), "webhooks.toml")
+	assert.NoError(t, os.WriteFile(path, []byte(`
+[[webhook]]
+url = "`+server.URL+`/failures"
+secret = "s3cret"
+events = ["deployment.failed", "fsm.failed"]
+`), 0600))
+	n, err := New(ctx, Config{File: path})
+	assert.NoError(t, err)
+	n.retry = backoff.Backoff{Min: time.Millisecond, Max: time.Millisecond}
+
+	n.Notify(ctx, Event{Type: EventDeploymentSucceeded, Module: "echo", Message: "Deployed"})
+	n.Notify(ctx, Event{Type: EventDeploymentFailed, Module: "echo", DeploymentKey: "dpl-echo-1", Message: "Failed to deploy"})
+
+	select {
+	case req := <-requests:
+		assert.Equal(t, EventDeploymentFailed, req.event.Type)
+		assert.Equal(t, "dpl-echo-1", req.event.DeploymentKey)
+		assert.Equal(t, "FTL deployment.failed: Failed to deploy", req.event.Text)
+		timestamp, err := strconv.ParseInt(req.timestamp, 10, 64)
+		assert.NoError(t, err)
+		assert.Equal(t, Sign("s3cret", timestamp, req.body), req.signature)
+	case <-time.After(time.Second * 5):
+		t.Fatal("webhook was not delivered")
+	}
+	select {
+	case req := <-requests:
+		t.Fatalf("unexpected webhook %s", req.event.Type)
+	case <-time.After(time.Millisecond * 100):
+	}
+}
+
+func TestReload(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	ctx, cancel := context.WithCancel(ctx)
+	t.Cleanup(cancel)
+	received := make(chan EventType, 16)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event Event
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+		received <- event.Type
+	}))
+	t.Cleanup(server.Close)
+
+	n, err := New(ctx, Config{})
+	assert.NoError(t, err)
+	n.Notify(ctx, Event{Type: EventFSMFailed, Message: "Failed"})
+
+	path := filepath.Join(t.TempDir(), "webhooks.toml")
+	assert.NoError(t, os.WriteFile(path, []byte(`
+[[webhook]]
+url = "`+server.URL+`"
+`), 0600))
+	assert.NoError(t, n.Reload(ctx, Config{File: path}))
+	assert.NoError(t, os.WriteFile(path, []byte(`[[webhook]]`+"\n"+`url = "ftp://example.com"`), 0600))
+	assert.Error(t, n.Reload(ctx, Config{File: path}))
+
+	n.Notify(ctx, Event{Type: EventDeploymentFailed, Message: "Failed"})
+	select {
+	case event := <-received:
+		assert.Equal(t, EventDeploymentFailed, event, "events sent before the reload should not be delivered")
+	case <-time.After(time.Second * 5):
+		t.Fatal("webhook was not delivered")
+	}
+}
+
+func TestLoadWebhooks(t *testing.T) {
+	write := func(content string) string {
+		path := filepath.Join(t.TempDir(), "webhooks.toml")
+		assert.NoError(t, os.WriteFile(path, []byte(content), 0600))
+		return path
+	}
+
+	webhooks, err := LoadWebhooks(write(`
+[[webhook]]
+url = "https://hooks.slack.com/services/T000/B000/XXXX"
+
+[[webhook]]
+url = "https://example.com/ftl"
+secret = "s3cret"
+events = ["deployment.crash_loop"]
+`))
+	assert.NoError(t, err)
+	assert.Equal(t, []Webhook{
+		{URL: "https://hooks.slack.com/services/T000/B000/XXXX"},
+		{URL: "https://example.com/ftl", Secret: "s3cret", Events: []EventType{EventDeploymentCrashLoop}},
+	}, webhooks)
+	assert.True(t, webhooks[0].wants(EventFSMFailed))
+	assert.False(t, webhooks[1].wants(EventFSMFailed))
+
+	path := write("[[webhook]]\nurl = \"ftp://example.com\"\n")
+	_, err = LoadWebhooks(path)
+	assert.EqualError(t, err, path+`: webhook 1 has invalid URL "ftp://example.com"`)
+
+	path = write("[[webhook]]\nurl = \"https://example.com\"\nevents = [\"deployment.exploded\"]\n")
+	_, err = LoadWebhooks(path)
+	assert.EqualError(t, err, path+`: webhook 1 has unknown event type "deployment.exploded"`)
+}
+
+func TestCrashLoopDetector(t *testing.T) {
+	d := NewCrashLoopDetector(Config{CrashLoopThreshold: 3, CrashLoopWindow: time.Minute})
+	now := time.Now()
+	assert.False(t, d.Crashed("a", now))
+	assert.False(t, d.Crashed("a", now.Add(time.Second)))
+	assert.False(t, d.Crashed("b", now.Add(time.Second)))
+	assert.True(t, d.Crashed("a", now.Add(time.Second*2)))
+	// Crashes are cleared once reported.
+	assert.False(t, d.Crashed("a", now.Add(time.Second*3)))
+
+	// Crashes outside the window are not counted.
+	assert.False(t, d.Crashed("b", now.Add(time.Minute*2)))
+	assert.False(t, d.Crashed("b", now.Add(time.Minute*2)))
+	assert.True(t, d.Crashed("b", now.Add(time.Minute*2)))
+}
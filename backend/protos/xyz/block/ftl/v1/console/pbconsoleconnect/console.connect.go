@@ -45,6 +45,15 @@ const (
 	// ConsoleServiceGetEventsProcedure is the fully-qualified name of the ConsoleService's GetEvents
 	// RPC.
 	ConsoleServiceGetEventsProcedure = "/xyz.block.ftl.v1.console.ConsoleService/GetEvents"
+	// ConsoleServiceGetErrorGroupsProcedure is the fully-qualified name of the ConsoleService's
+	// GetErrorGroups RPC.
+	ConsoleServiceGetErrorGroupsProcedure = "/xyz.block.ftl.v1.console.ConsoleService/GetErrorGroups"
+	// ConsoleServiceGetCallGraphProcedure is the fully-qualified name of the ConsoleService's
+	// GetCallGraph RPC.
+	ConsoleServiceGetCallGraphProcedure = "/xyz.block.ftl.v1.console.ConsoleService/GetCallGraph"
+	// ConsoleServiceGetRequestTimelineProcedure is the fully-qualified name of the ConsoleService's
+	// GetRequestTimeline RPC.
+	ConsoleServiceGetRequestTimelineProcedure = "/xyz.block.ftl.v1.console.ConsoleService/GetRequestTimeline"
 )
 
 // ConsoleServiceClient is a client for the xyz.block.ftl.v1.console.ConsoleService service.
@@ -54,6 +63,12 @@ type ConsoleServiceClient interface {
 	GetModules(context.Context, *connect.Request[console.GetModulesRequest]) (*connect.Response[console.GetModulesResponse], error)
 	StreamEvents(context.Context, *connect.Request[console.StreamEventsRequest]) (*connect.ServerStreamForClient[console.StreamEventsResponse], error)
 	GetEvents(context.Context, *connect.Request[console.EventsQuery]) (*connect.Response[console.GetEventsResponse], error)
+	// Failed calls grouped by the fingerprint of their error.
+	GetErrorGroups(context.Context, *connect.Request[console.GetErrorGroupsRequest]) (*connect.Response[console.GetErrorGroupsResponse], error)
+	// Call graph of the active modules, with call rates and latencies observed in the call log.
+	GetCallGraph(context.Context, *connect.Request[console.GetCallGraphRequest]) (*connect.Response[console.GetCallGraphResponse], error)
+	// Everything correlated with a single request, in the order it happened.
+	GetRequestTimeline(context.Context, *connect.Request[console.GetRequestTimelineRequest]) (*connect.Response[console.GetRequestTimelineResponse], error)
 }
 
 // NewConsoleServiceClient constructs a client for the xyz.block.ftl.v1.console.ConsoleService
@@ -75,7 +90,8 @@ func NewConsoleServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 		getModules: connect.NewClient[console.GetModulesRequest, console.GetModulesResponse](
 			httpClient,
 			baseURL+ConsoleServiceGetModulesProcedure,
-			opts...,
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
 		),
 		streamEvents: connect.NewClient[console.StreamEventsRequest, console.StreamEventsResponse](
 			httpClient,
@@ -85,17 +101,39 @@ func NewConsoleServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 		getEvents: connect.NewClient[console.EventsQuery, console.GetEventsResponse](
 			httpClient,
 			baseURL+ConsoleServiceGetEventsProcedure,
-			opts...,
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getErrorGroups: connect.NewClient[console.GetErrorGroupsRequest, console.GetErrorGroupsResponse](
+			httpClient,
+			baseURL+ConsoleServiceGetErrorGroupsProcedure,
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getCallGraph: connect.NewClient[console.GetCallGraphRequest, console.GetCallGraphResponse](
+			httpClient,
+			baseURL+ConsoleServiceGetCallGraphProcedure,
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getRequestTimeline: connect.NewClient[console.GetRequestTimelineRequest, console.GetRequestTimelineResponse](
+			httpClient,
+			baseURL+ConsoleServiceGetRequestTimelineProcedure,
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
 		),
 	}
 }
 
 // consoleServiceClient implements ConsoleServiceClient.
 type consoleServiceClient struct {
-	ping         *connect.Client[v1.PingRequest, v1.PingResponse]
-	getModules   *connect.Client[console.GetModulesRequest, console.GetModulesResponse]
-	streamEvents *connect.Client[console.StreamEventsRequest, console.StreamEventsResponse]
-	getEvents    *connect.Client[console.EventsQuery, console.GetEventsResponse]
+	ping               *connect.Client[v1.PingRequest, v1.PingResponse]
+	getModules         *connect.Client[console.GetModulesRequest, console.GetModulesResponse]
+	streamEvents       *connect.Client[console.StreamEventsRequest, console.StreamEventsResponse]
+	getEvents          *connect.Client[console.EventsQuery, console.GetEventsResponse]
+	getErrorGroups     *connect.Client[console.GetErrorGroupsRequest, console.GetErrorGroupsResponse]
+	getCallGraph       *connect.Client[console.GetCallGraphRequest, console.GetCallGraphResponse]
+	getRequestTimeline *connect.Client[console.GetRequestTimelineRequest, console.GetRequestTimelineResponse]
 }
 
 // Ping calls xyz.block.ftl.v1.console.ConsoleService.Ping.
@@ -118,6 +156,21 @@ func (c *consoleServiceClient) GetEvents(ctx context.Context, req *connect.Reque
 	return c.getEvents.CallUnary(ctx, req)
 }
 
+// GetErrorGroups calls xyz.block.ftl.v1.console.ConsoleService.GetErrorGroups.
+func (c *consoleServiceClient) GetErrorGroups(ctx context.Context, req *connect.Request[console.GetErrorGroupsRequest]) (*connect.Response[console.GetErrorGroupsResponse], error) {
+	return c.getErrorGroups.CallUnary(ctx, req)
+}
+
+// GetCallGraph calls xyz.block.ftl.v1.console.ConsoleService.GetCallGraph.
+func (c *consoleServiceClient) GetCallGraph(ctx context.Context, req *connect.Request[console.GetCallGraphRequest]) (*connect.Response[console.GetCallGraphResponse], error) {
+	return c.getCallGraph.CallUnary(ctx, req)
+}
+
+// GetRequestTimeline calls xyz.block.ftl.v1.console.ConsoleService.GetRequestTimeline.
+func (c *consoleServiceClient) GetRequestTimeline(ctx context.Context, req *connect.Request[console.GetRequestTimelineRequest]) (*connect.Response[console.GetRequestTimelineResponse], error) {
+	return c.getRequestTimeline.CallUnary(ctx, req)
+}
+
 // ConsoleServiceHandler is an implementation of the xyz.block.ftl.v1.console.ConsoleService
 // service.
 type ConsoleServiceHandler interface {
@@ -126,6 +179,12 @@ type ConsoleServiceHandler interface {
 	GetModules(context.Context, *connect.Request[console.GetModulesRequest]) (*connect.Response[console.GetModulesResponse], error)
 	StreamEvents(context.Context, *connect.Request[console.StreamEventsRequest], *connect.ServerStream[console.StreamEventsResponse]) error
 	GetEvents(context.Context, *connect.Request[console.EventsQuery]) (*connect.Response[console.GetEventsResponse], error)
+	// Failed calls grouped by the fingerprint of their error.
+	GetErrorGroups(context.Context, *connect.Request[console.GetErrorGroupsRequest]) (*connect.Response[console.GetErrorGroupsResponse], error)
+	// Call graph of the active modules, with call rates and latencies observed in the call log.
+	GetCallGraph(context.Context, *connect.Request[console.GetCallGraphRequest]) (*connect.Response[console.GetCallGraphResponse], error)
+	// Everything correlated with a single request, in the order it happened.
+	GetRequestTimeline(context.Context, *connect.Request[console.GetRequestTimelineRequest]) (*connect.Response[console.GetRequestTimelineResponse], error)
 }
 
 // NewConsoleServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -143,7 +202,8 @@ func NewConsoleServiceHandler(svc ConsoleServiceHandler, opts ...connect.Handler
 	consoleServiceGetModulesHandler := connect.NewUnaryHandler(
 		ConsoleServiceGetModulesProcedure,
 		svc.GetModules,
-		opts...,
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
 	)
 	consoleServiceStreamEventsHandler := connect.NewServerStreamHandler(
 		ConsoleServiceStreamEventsProcedure,
@@ -153,7 +213,26 @@ func NewConsoleServiceHandler(svc ConsoleServiceHandler, opts ...connect.Handler
 	consoleServiceGetEventsHandler := connect.NewUnaryHandler(
 		ConsoleServiceGetEventsProcedure,
 		svc.GetEvents,
-		opts...,
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	consoleServiceGetErrorGroupsHandler := connect.NewUnaryHandler(
+		ConsoleServiceGetErrorGroupsProcedure,
+		svc.GetErrorGroups,
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	consoleServiceGetCallGraphHandler := connect.NewUnaryHandler(
+		ConsoleServiceGetCallGraphProcedure,
+		svc.GetCallGraph,
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	consoleServiceGetRequestTimelineHandler := connect.NewUnaryHandler(
+		ConsoleServiceGetRequestTimelineProcedure,
+		svc.GetRequestTimeline,
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
 	)
 	return "/xyz.block.ftl.v1.console.ConsoleService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
@@ -165,6 +244,12 @@ func NewConsoleServiceHandler(svc ConsoleServiceHandler, opts ...connect.Handler
 			consoleServiceStreamEventsHandler.ServeHTTP(w, r)
 		case ConsoleServiceGetEventsProcedure:
 			consoleServiceGetEventsHandler.ServeHTTP(w, r)
+		case ConsoleServiceGetErrorGroupsProcedure:
+			consoleServiceGetErrorGroupsHandler.ServeHTTP(w, r)
+		case ConsoleServiceGetCallGraphProcedure:
+			consoleServiceGetCallGraphHandler.ServeHTTP(w, r)
+		case ConsoleServiceGetRequestTimelineProcedure:
+			consoleServiceGetRequestTimelineHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -189,3 +274,15 @@ func (UnimplementedConsoleServiceHandler) StreamEvents(context.Context, *connect
 func (UnimplementedConsoleServiceHandler) GetEvents(context.Context, *connect.Request[console.EventsQuery]) (*connect.Response[console.GetEventsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.console.ConsoleService.GetEvents is not implemented"))
 }
+
+func (UnimplementedConsoleServiceHandler) GetErrorGroups(context.Context, *connect.Request[console.GetErrorGroupsRequest]) (*connect.Response[console.GetErrorGroupsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.console.ConsoleService.GetErrorGroups is not implemented"))
+}
+
+func (UnimplementedConsoleServiceHandler) GetCallGraph(context.Context, *connect.Request[console.GetCallGraphRequest]) (*connect.Response[console.GetCallGraphResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.console.ConsoleService.GetCallGraph is not implemented"))
+}
+
+func (UnimplementedConsoleServiceHandler) GetRequestTimeline(context.Context, *connect.Request[console.GetRequestTimelineRequest]) (*connect.Response[console.GetRequestTimelineResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.console.ConsoleService.GetRequestTimeline is not implemented"))
+}
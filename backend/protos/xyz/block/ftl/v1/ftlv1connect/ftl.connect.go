@@ -53,8 +53,15 @@ const (
 	// VerbServicePublishEventProcedure is the fully-qualified name of the VerbService's PublishEvent
 	// RPC.
 	VerbServicePublishEventProcedure = "/xyz.block.ftl.v1.VerbService/PublishEvent"
+	// VerbServiceCallAsyncProcedure is the fully-qualified name of the VerbService's CallAsync RPC.
+	VerbServiceCallAsyncProcedure = "/xyz.block.ftl.v1.VerbService/CallAsync"
+	// VerbServiceGetAsyncCallResultProcedure is the fully-qualified name of the VerbService's
+	// GetAsyncCallResult RPC.
+	VerbServiceGetAsyncCallResultProcedure = "/xyz.block.ftl.v1.VerbService/GetAsyncCallResult"
 	// VerbServiceCallProcedure is the fully-qualified name of the VerbService's Call RPC.
 	VerbServiceCallProcedure = "/xyz.block.ftl.v1.VerbService/Call"
+	// VerbServiceCallStreamProcedure is the fully-qualified name of the VerbService's CallStream RPC.
+	VerbServiceCallStreamProcedure = "/xyz.block.ftl.v1.VerbService/CallStream"
 	// ControllerServicePingProcedure is the fully-qualified name of the ControllerService's Ping RPC.
 	ControllerServicePingProcedure = "/xyz.block.ftl.v1.ControllerService/Ping"
 	// ControllerServiceProcessListProcedure is the fully-qualified name of the ControllerService's
@@ -96,6 +103,63 @@ const (
 	// ControllerServicePullSchemaProcedure is the fully-qualified name of the ControllerService's
 	// PullSchema RPC.
 	ControllerServicePullSchemaProcedure = "/xyz.block.ftl.v1.ControllerService/PullSchema"
+	// ControllerServiceSendFSMTransitionProcedure is the fully-qualified name of the
+	// ControllerService's SendFSMTransition RPC.
+	ControllerServiceSendFSMTransitionProcedure = "/xyz.block.ftl.v1.ControllerService/SendFSMTransition"
+	// ControllerServiceGetSLOStatusProcedure is the fully-qualified name of the ControllerService's
+	// GetSLOStatus RPC.
+	ControllerServiceGetSLOStatusProcedure = "/xyz.block.ftl.v1.ControllerService/GetSLOStatus"
+	// ControllerServiceGetPrefetchCandidatesProcedure is the fully-qualified name of the
+	// ControllerService's GetPrefetchCandidates RPC.
+	ControllerServiceGetPrefetchCandidatesProcedure = "/xyz.block.ftl.v1.ControllerService/GetPrefetchCandidates"
+	// ControllerServiceReconcileProcedure is the fully-qualified name of the ControllerService's
+	// Reconcile RPC.
+	ControllerServiceReconcileProcedure = "/xyz.block.ftl.v1.ControllerService/Reconcile"
+	// ControllerServiceListFeatureFlagsProcedure is the fully-qualified name of the ControllerService's
+	// ListFeatureFlags RPC.
+	ControllerServiceListFeatureFlagsProcedure = "/xyz.block.ftl.v1.ControllerService/ListFeatureFlags"
+	// ControllerServiceSetFeatureFlagProcedure is the fully-qualified name of the ControllerService's
+	// SetFeatureFlag RPC.
+	ControllerServiceSetFeatureFlagProcedure = "/xyz.block.ftl.v1.ControllerService/SetFeatureFlag"
+	// ControllerServiceUnsetFeatureFlagProcedure is the fully-qualified name of the ControllerService's
+	// UnsetFeatureFlag RPC.
+	ControllerServiceUnsetFeatureFlagProcedure = "/xyz.block.ftl.v1.ControllerService/UnsetFeatureFlag"
+	// ControllerServiceSetLogLevelProcedure is the fully-qualified name of the ControllerService's
+	// SetLogLevel RPC.
+	ControllerServiceSetLogLevelProcedure = "/xyz.block.ftl.v1.ControllerService/SetLogLevel"
+	// ControllerServiceGetQuotaUsageProcedure is the fully-qualified name of the ControllerService's
+	// GetQuotaUsage RPC.
+	ControllerServiceGetQuotaUsageProcedure = "/xyz.block.ftl.v1.ControllerService/GetQuotaUsage"
+	// ControllerServiceGetSagaProcedure is the fully-qualified name of the ControllerService's GetSaga
+	// RPC.
+	ControllerServiceGetSagaProcedure = "/xyz.block.ftl.v1.ControllerService/GetSaga"
+	// ControllerServiceListProvisionedDatabasesProcedure is the fully-qualified name of the
+	// ControllerService's ListProvisionedDatabases RPC.
+	ControllerServiceListProvisionedDatabasesProcedure = "/xyz.block.ftl.v1.ControllerService/ListProvisionedDatabases"
+	// ControllerServiceTeardownDatabaseProcedure is the fully-qualified name of the ControllerService's
+	// TeardownDatabase RPC.
+	ControllerServiceTeardownDatabaseProcedure = "/xyz.block.ftl.v1.ControllerService/TeardownDatabase"
+	// ControllerServiceListCronJobsProcedure is the fully-qualified name of the ControllerService's
+	// ListCronJobs RPC.
+	ControllerServiceListCronJobsProcedure = "/xyz.block.ftl.v1.ControllerService/ListCronJobs"
+	// ControllerServicePauseCronJobsProcedure is the fully-qualified name of the ControllerService's
+	// PauseCronJobs RPC.
+	ControllerServicePauseCronJobsProcedure = "/xyz.block.ftl.v1.ControllerService/PauseCronJobs"
+	// ControllerServiceResumeCronJobsProcedure is the fully-qualified name of the ControllerService's
+	// ResumeCronJobs RPC.
+	ControllerServiceResumeCronJobsProcedure = "/xyz.block.ftl.v1.ControllerService/ResumeCronJobs"
+	// ControllerServiceTriggerCronJobProcedure is the fully-qualified name of the ControllerService's
+	// TriggerCronJob RPC.
+	ControllerServiceTriggerCronJobProcedure = "/xyz.block.ftl.v1.ControllerService/TriggerCronJob"
+	// ControllerServiceInjectFaultProcedure is the fully-qualified name of the ControllerService's
+	// InjectFault RPC.
+	ControllerServiceInjectFaultProcedure = "/xyz.block.ftl.v1.ControllerService/InjectFault"
+	// ControllerServiceListFaultsProcedure is the fully-qualified name of the ControllerService's
+	// ListFaults RPC.
+	ControllerServiceListFaultsProcedure = "/xyz.block.ftl.v1.ControllerService/ListFaults"
+	// ControllerServiceClearFaultsProcedure is the fully-qualified name of the ControllerService's
+	// ClearFaults RPC.
+	ControllerServiceClearFaultsProcedure = "/xyz.block.ftl.v1.ControllerService/ClearFaults"
 	// RunnerServicePingProcedure is the fully-qualified name of the RunnerService's Ping RPC.
 	RunnerServicePingProcedure = "/xyz.block.ftl.v1.RunnerService/Ping"
 	// RunnerServiceReserveProcedure is the fully-qualified name of the RunnerService's Reserve RPC.
@@ -125,6 +189,21 @@ const (
 	// AdminServiceSecretUnsetProcedure is the fully-qualified name of the AdminService's SecretUnset
 	// RPC.
 	AdminServiceSecretUnsetProcedure = "/xyz.block.ftl.v1.AdminService/SecretUnset"
+	// AdminServiceListAdminResourcesProcedure is the fully-qualified name of the AdminService's
+	// ListAdminResources RPC.
+	AdminServiceListAdminResourcesProcedure = "/xyz.block.ftl.v1.AdminService/ListAdminResources"
+	// AdminServiceGetAdminResourceProcedure is the fully-qualified name of the AdminService's
+	// GetAdminResource RPC.
+	AdminServiceGetAdminResourceProcedure = "/xyz.block.ftl.v1.AdminService/GetAdminResource"
+	// AdminServiceCreateAdminResourceProcedure is the fully-qualified name of the AdminService's
+	// CreateAdminResource RPC.
+	AdminServiceCreateAdminResourceProcedure = "/xyz.block.ftl.v1.AdminService/CreateAdminResource"
+	// AdminServiceUpdateAdminResourceProcedure is the fully-qualified name of the AdminService's
+	// UpdateAdminResource RPC.
+	AdminServiceUpdateAdminResourceProcedure = "/xyz.block.ftl.v1.AdminService/UpdateAdminResource"
+	// AdminServiceDeleteAdminResourceProcedure is the fully-qualified name of the AdminService's
+	// DeleteAdminResource RPC.
+	AdminServiceDeleteAdminResourceProcedure = "/xyz.block.ftl.v1.AdminService/DeleteAdminResource"
 )
 
 // VerbServiceClient is a client for the xyz.block.ftl.v1.VerbService service.
@@ -141,8 +220,17 @@ type VerbServiceClient interface {
 	SendFSMEvent(context.Context, *connect.Request[v1.SendFSMEventRequest]) (*connect.Response[v1.SendFSMEventResponse], error)
 	// Publish an event to a topic.
 	PublishEvent(context.Context, *connect.Request[v1.PublishEventRequest]) (*connect.Response[v1.PublishEventResponse], error)
+	// Enqueue a call to a Verb, to be executed asynchronously by the controller.
+	CallAsync(context.Context, *connect.Request[v1.CallAsyncRequest]) (*connect.Response[v1.CallAsyncResponse], error)
+	// Get the result of a call made with CallAsync.
+	GetAsyncCallResult(context.Context, *connect.Request[v1.GetAsyncCallResultRequest]) (*connect.Response[v1.GetAsyncCallResultResponse], error)
 	// Issue a synchronous call to a Verb.
 	Call(context.Context, *connect.Request[v1.CallRequest]) (*connect.Response[v1.CallResponse], error)
+	// Issue a call to a streaming Verb, returning each response as it is sent.
+	//
+	// The stream completes when the Verb returns, and errors are sent as a final
+	// response.
+	CallStream(context.Context, *connect.Request[v1.CallRequest]) (*connect.ServerStreamForClient[v1.CallResponse], error)
 }
 
 // NewVerbServiceClient constructs a client for the xyz.block.ftl.v1.VerbService service. By
@@ -181,22 +269,40 @@ func NewVerbServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			baseURL+VerbServicePublishEventProcedure,
 			opts...,
 		),
+		callAsync: connect.NewClient[v1.CallAsyncRequest, v1.CallAsyncResponse](
+			httpClient,
+			baseURL+VerbServiceCallAsyncProcedure,
+			opts...,
+		),
+		getAsyncCallResult: connect.NewClient[v1.GetAsyncCallResultRequest, v1.GetAsyncCallResultResponse](
+			httpClient,
+			baseURL+VerbServiceGetAsyncCallResultProcedure,
+			opts...,
+		),
 		call: connect.NewClient[v1.CallRequest, v1.CallResponse](
 			httpClient,
 			baseURL+VerbServiceCallProcedure,
 			opts...,
 		),
+		callStream: connect.NewClient[v1.CallRequest, v1.CallResponse](
+			httpClient,
+			baseURL+VerbServiceCallStreamProcedure,
+			opts...,
+		),
 	}
 }
 
 // verbServiceClient implements VerbServiceClient.
 type verbServiceClient struct {
-	ping             *connect.Client[v1.PingRequest, v1.PingResponse]
-	getModuleContext *connect.Client[v1.ModuleContextRequest, v1.ModuleContextResponse]
-	acquireLease     *connect.Client[v1.AcquireLeaseRequest, v1.AcquireLeaseResponse]
-	sendFSMEvent     *connect.Client[v1.SendFSMEventRequest, v1.SendFSMEventResponse]
-	publishEvent     *connect.Client[v1.PublishEventRequest, v1.PublishEventResponse]
-	call             *connect.Client[v1.CallRequest, v1.CallResponse]
+	ping               *connect.Client[v1.PingRequest, v1.PingResponse]
+	getModuleContext   *connect.Client[v1.ModuleContextRequest, v1.ModuleContextResponse]
+	acquireLease       *connect.Client[v1.AcquireLeaseRequest, v1.AcquireLeaseResponse]
+	sendFSMEvent       *connect.Client[v1.SendFSMEventRequest, v1.SendFSMEventResponse]
+	publishEvent       *connect.Client[v1.PublishEventRequest, v1.PublishEventResponse]
+	callAsync          *connect.Client[v1.CallAsyncRequest, v1.CallAsyncResponse]
+	getAsyncCallResult *connect.Client[v1.GetAsyncCallResultRequest, v1.GetAsyncCallResultResponse]
+	call               *connect.Client[v1.CallRequest, v1.CallResponse]
+	callStream         *connect.Client[v1.CallRequest, v1.CallResponse]
 }
 
 // Ping calls xyz.block.ftl.v1.VerbService.Ping.
@@ -224,11 +330,26 @@ func (c *verbServiceClient) PublishEvent(ctx context.Context, req *connect.Reque
 	return c.publishEvent.CallUnary(ctx, req)
 }
 
+// CallAsync calls xyz.block.ftl.v1.VerbService.CallAsync.
+func (c *verbServiceClient) CallAsync(ctx context.Context, req *connect.Request[v1.CallAsyncRequest]) (*connect.Response[v1.CallAsyncResponse], error) {
+	return c.callAsync.CallUnary(ctx, req)
+}
+
+// GetAsyncCallResult calls xyz.block.ftl.v1.VerbService.GetAsyncCallResult.
+func (c *verbServiceClient) GetAsyncCallResult(ctx context.Context, req *connect.Request[v1.GetAsyncCallResultRequest]) (*connect.Response[v1.GetAsyncCallResultResponse], error) {
+	return c.getAsyncCallResult.CallUnary(ctx, req)
+}
+
 // Call calls xyz.block.ftl.v1.VerbService.Call.
 func (c *verbServiceClient) Call(ctx context.Context, req *connect.Request[v1.CallRequest]) (*connect.Response[v1.CallResponse], error) {
 	return c.call.CallUnary(ctx, req)
 }
 
+// CallStream calls xyz.block.ftl.v1.VerbService.CallStream.
+func (c *verbServiceClient) CallStream(ctx context.Context, req *connect.Request[v1.CallRequest]) (*connect.ServerStreamForClient[v1.CallResponse], error) {
+	return c.callStream.CallServerStream(ctx, req)
+}
+
 // VerbServiceHandler is an implementation of the xyz.block.ftl.v1.VerbService service.
 type VerbServiceHandler interface {
 	// Ping service for readiness.
@@ -243,8 +364,17 @@ type VerbServiceHandler interface {
 	SendFSMEvent(context.Context, *connect.Request[v1.SendFSMEventRequest]) (*connect.Response[v1.SendFSMEventResponse], error)
 	// Publish an event to a topic.
 	PublishEvent(context.Context, *connect.Request[v1.PublishEventRequest]) (*connect.Response[v1.PublishEventResponse], error)
+	// Enqueue a call to a Verb, to be executed asynchronously by the controller.
+	CallAsync(context.Context, *connect.Request[v1.CallAsyncRequest]) (*connect.Response[v1.CallAsyncResponse], error)
+	// Get the result of a call made with CallAsync.
+	GetAsyncCallResult(context.Context, *connect.Request[v1.GetAsyncCallResultRequest]) (*connect.Response[v1.GetAsyncCallResultResponse], error)
 	// Issue a synchronous call to a Verb.
 	Call(context.Context, *connect.Request[v1.CallRequest]) (*connect.Response[v1.CallResponse], error)
+	// Issue a call to a streaming Verb, returning each response as it is sent.
+	//
+	// The stream completes when the Verb returns, and errors are sent as a final
+	// response.
+	CallStream(context.Context, *connect.Request[v1.CallRequest], *connect.ServerStream[v1.CallResponse]) error
 }
 
 // NewVerbServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -279,11 +409,26 @@ func NewVerbServiceHandler(svc VerbServiceHandler, opts ...connect.HandlerOption
 		svc.PublishEvent,
 		opts...,
 	)
+	verbServiceCallAsyncHandler := connect.NewUnaryHandler(
+		VerbServiceCallAsyncProcedure,
+		svc.CallAsync,
+		opts...,
+	)
+	verbServiceGetAsyncCallResultHandler := connect.NewUnaryHandler(
+		VerbServiceGetAsyncCallResultProcedure,
+		svc.GetAsyncCallResult,
+		opts...,
+	)
 	verbServiceCallHandler := connect.NewUnaryHandler(
 		VerbServiceCallProcedure,
 		svc.Call,
 		opts...,
 	)
+	verbServiceCallStreamHandler := connect.NewServerStreamHandler(
+		VerbServiceCallStreamProcedure,
+		svc.CallStream,
+		opts...,
+	)
 	return "/xyz.block.ftl.v1.VerbService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case VerbServicePingProcedure:
@@ -296,8 +441,14 @@ func NewVerbServiceHandler(svc VerbServiceHandler, opts ...connect.HandlerOption
 			verbServiceSendFSMEventHandler.ServeHTTP(w, r)
 		case VerbServicePublishEventProcedure:
 			verbServicePublishEventHandler.ServeHTTP(w, r)
+		case VerbServiceCallAsyncProcedure:
+			verbServiceCallAsyncHandler.ServeHTTP(w, r)
+		case VerbServiceGetAsyncCallResultProcedure:
+			verbServiceGetAsyncCallResultHandler.ServeHTTP(w, r)
 		case VerbServiceCallProcedure:
 			verbServiceCallHandler.ServeHTTP(w, r)
+		case VerbServiceCallStreamProcedure:
+			verbServiceCallStreamHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -327,10 +478,22 @@ func (UnimplementedVerbServiceHandler) PublishEvent(context.Context, *connect.Re
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.VerbService.PublishEvent is not implemented"))
 }
 
+func (UnimplementedVerbServiceHandler) CallAsync(context.Context, *connect.Request[v1.CallAsyncRequest]) (*connect.Response[v1.CallAsyncResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.VerbService.CallAsync is not implemented"))
+}
+
+func (UnimplementedVerbServiceHandler) GetAsyncCallResult(context.Context, *connect.Request[v1.GetAsyncCallResultRequest]) (*connect.Response[v1.GetAsyncCallResultResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.VerbService.GetAsyncCallResult is not implemented"))
+}
+
 func (UnimplementedVerbServiceHandler) Call(context.Context, *connect.Request[v1.CallRequest]) (*connect.Response[v1.CallResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.VerbService.Call is not implemented"))
 }
 
+func (UnimplementedVerbServiceHandler) CallStream(context.Context, *connect.Request[v1.CallRequest], *connect.ServerStream[v1.CallResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.VerbService.CallStream is not implemented"))
+}
+
 // ControllerServiceClient is a client for the xyz.block.ftl.v1.ControllerService service.
 type ControllerServiceClient interface {
 	// Ping service for readiness.
@@ -372,6 +535,54 @@ type ControllerServiceClient interface {
 	// Note that if there are no deployments this will block indefinitely, making it unsuitable for
 	// just retrieving the schema. Use GetSchema for that.
 	PullSchema(context.Context, *connect.Request[v1.PullSchemaRequest]) (*connect.ServerStreamForClient[v1.PullSchemaResponse], error)
+	// Start a transition of an FSM instance to the given state.
+	//
+	// Unlike VerbService.SendFSMEvent, the destination is selected by state
+	// rather than by the type of the event.
+	SendFSMTransition(context.Context, *connect.Request[v1.SendFSMTransitionRequest]) (*connect.Response[v1.SendFSMTransitionResponse], error)
+	// Success rates and latencies of calls to each verb, measured against their service level objectives.
+	GetSLOStatus(context.Context, *connect.Request[v1.GetSLOStatusRequest]) (*connect.Response[v1.GetSLOStatusResponse], error)
+	// Deployments idle runners should prefetch artefacts for, because they are
+	// likely to be scaled up.
+	GetPrefetchCandidates(context.Context, *connect.Request[v1.GetPrefetchCandidatesRequest]) (*connect.Response[v1.GetPrefetchCandidatesResponse], error)
+	// Immediately reconcile the deployments of a module, ignoring any backoff
+	// from previous failures.
+	Reconcile(context.Context, *connect.Request[v1.ReconcileRequest]) (*connect.Response[v1.ReconcileResponse], error)
+	// List feature flags, optionally filtered by module.
+	ListFeatureFlags(context.Context, *connect.Request[v1.ListFeatureFlagsRequest]) (*connect.Response[v1.ListFeatureFlagsResponse], error)
+	// Create or replace the rules of a feature flag.
+	SetFeatureFlag(context.Context, *connect.Request[v1.SetFeatureFlagRequest]) (*connect.Response[v1.SetFeatureFlagResponse], error)
+	// Delete a feature flag.
+	UnsetFeatureFlag(context.Context, *connect.Request[v1.UnsetFeatureFlagRequest]) (*connect.Response[v1.UnsetFeatureFlagResponse], error)
+	// Temporarily override the log level of the running deployments of a module.
+	SetLogLevel(context.Context, *connect.Request[v1.SetLogLevelRequest]) (*connect.Response[v1.SetLogLevelResponse], error)
+	// Report the usage of each configured quota.
+	GetQuotaUsage(context.Context, *connect.Request[v1.GetQuotaUsageRequest]) (*connect.Response[v1.GetQuotaUsageResponse], error)
+	// Report the progress of an instance of a saga.
+	GetSaga(context.Context, *connect.Request[v1.GetSagaRequest]) (*connect.Response[v1.GetSagaResponse], error)
+	// List the databases the controller provisioned for modules.
+	ListProvisionedDatabases(context.Context, *connect.Request[v1.ListProvisionedDatabasesRequest]) (*connect.Response[v1.ListProvisionedDatabasesResponse], error)
+	// Delete a database the controller provisioned for a module, along with its
+	// DSN secret. Fails if an active deployment of the module still declares it.
+	TeardownDatabase(context.Context, *connect.Request[v1.TeardownDatabaseRequest]) (*connect.Response[v1.TeardownDatabaseResponse], error)
+	// List the cron jobs of active deployments, optionally filtered by module.
+	ListCronJobs(context.Context, *connect.Request[v1.ListCronJobsRequest]) (*connect.Response[v1.ListCronJobsResponse], error)
+	// Pause cron jobs of the active deployment of a module until they are
+	// resumed. Paused jobs are skipped when they are due.
+	PauseCronJobs(context.Context, *connect.Request[v1.PauseCronJobsRequest]) (*connect.Response[v1.PauseCronJobsResponse], error)
+	// Resume paused cron jobs of the active deployment of a module.
+	ResumeCronJobs(context.Context, *connect.Request[v1.ResumeCronJobsRequest]) (*connect.Response[v1.ResumeCronJobsResponse], error)
+	// Execute a cron job immediately, whether or not it is paused, without
+	// affecting its schedule.
+	TriggerCronJob(context.Context, *connect.Request[v1.TriggerCronJobRequest]) (*connect.Response[v1.TriggerCronJobResponse], error)
+	// Inject a fault into calls to the verbs of a module, for game days and
+	// integration tests. Only available if the controller was started with
+	// fault injection enabled, and to requests not scoped to a project.
+	InjectFault(context.Context, *connect.Request[v1.InjectFaultRequest]) (*connect.Response[v1.InjectFaultResponse], error)
+	// List the injected faults that have not expired.
+	ListFaults(context.Context, *connect.Request[v1.ListFaultsRequest]) (*connect.Response[v1.ListFaultsResponse], error)
+	// Clear injected faults.
+	ClearFaults(context.Context, *connect.Request[v1.ClearFaultsRequest]) (*connect.Response[v1.ClearFaultsResponse], error)
 }
 
 // NewControllerServiceClient constructs a client for the xyz.block.ftl.v1.ControllerService
@@ -393,17 +604,20 @@ func NewControllerServiceClient(httpClient connect.HTTPClient, baseURL string, o
 		processList: connect.NewClient[v1.ProcessListRequest, v1.ProcessListResponse](
 			httpClient,
 			baseURL+ControllerServiceProcessListProcedure,
-			opts...,
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
 		),
 		status: connect.NewClient[v1.StatusRequest, v1.StatusResponse](
 			httpClient,
 			baseURL+ControllerServiceStatusProcedure,
-			opts...,
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
 		),
 		getArtefactDiffs: connect.NewClient[v1.GetArtefactDiffsRequest, v1.GetArtefactDiffsResponse](
 			httpClient,
 			baseURL+ControllerServiceGetArtefactDiffsProcedure,
-			opts...,
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
 		),
 		uploadArtefact: connect.NewClient[v1.UploadArtefactRequest, v1.UploadArtefactResponse](
 			httpClient,
@@ -418,7 +632,8 @@ func NewControllerServiceClient(httpClient connect.HTTPClient, baseURL string, o
 		getDeployment: connect.NewClient[v1.GetDeploymentRequest, v1.GetDeploymentResponse](
 			httpClient,
 			baseURL+ControllerServiceGetDeploymentProcedure,
-			opts...,
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
 		),
 		getDeploymentArtefacts: connect.NewClient[v1.GetDeploymentArtefactsRequest, v1.GetDeploymentArtefactsResponse](
 			httpClient,
@@ -448,32 +663,161 @@ func NewControllerServiceClient(httpClient connect.HTTPClient, baseURL string, o
 		getSchema: connect.NewClient[v1.GetSchemaRequest, v1.GetSchemaResponse](
 			httpClient,
 			baseURL+ControllerServiceGetSchemaProcedure,
-			opts...,
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
 		),
 		pullSchema: connect.NewClient[v1.PullSchemaRequest, v1.PullSchemaResponse](
 			httpClient,
 			baseURL+ControllerServicePullSchemaProcedure,
 			opts...,
 		),
+		sendFSMTransition: connect.NewClient[v1.SendFSMTransitionRequest, v1.SendFSMTransitionResponse](
+			httpClient,
+			baseURL+ControllerServiceSendFSMTransitionProcedure,
+			opts...,
+		),
+		getSLOStatus: connect.NewClient[v1.GetSLOStatusRequest, v1.GetSLOStatusResponse](
+			httpClient,
+			baseURL+ControllerServiceGetSLOStatusProcedure,
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getPrefetchCandidates: connect.NewClient[v1.GetPrefetchCandidatesRequest, v1.GetPrefetchCandidatesResponse](
+			httpClient,
+			baseURL+ControllerServiceGetPrefetchCandidatesProcedure,
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		reconcile: connect.NewClient[v1.ReconcileRequest, v1.ReconcileResponse](
+			httpClient,
+			baseURL+ControllerServiceReconcileProcedure,
+			opts...,
+		),
+		listFeatureFlags: connect.NewClient[v1.ListFeatureFlagsRequest, v1.ListFeatureFlagsResponse](
+			httpClient,
+			baseURL+ControllerServiceListFeatureFlagsProcedure,
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		setFeatureFlag: connect.NewClient[v1.SetFeatureFlagRequest, v1.SetFeatureFlagResponse](
+			httpClient,
+			baseURL+ControllerServiceSetFeatureFlagProcedure,
+			connect.WithIdempotency(connect.IdempotencyIdempotent),
+			connect.WithClientOptions(opts...),
+		),
+		unsetFeatureFlag: connect.NewClient[v1.UnsetFeatureFlagRequest, v1.UnsetFeatureFlagResponse](
+			httpClient,
+			baseURL+ControllerServiceUnsetFeatureFlagProcedure,
+			connect.WithIdempotency(connect.IdempotencyIdempotent),
+			connect.WithClientOptions(opts...),
+		),
+		setLogLevel: connect.NewClient[v1.SetLogLevelRequest, v1.SetLogLevelResponse](
+			httpClient,
+			baseURL+ControllerServiceSetLogLevelProcedure,
+			connect.WithIdempotency(connect.IdempotencyIdempotent),
+			connect.WithClientOptions(opts...),
+		),
+		getQuotaUsage: connect.NewClient[v1.GetQuotaUsageRequest, v1.GetQuotaUsageResponse](
+			httpClient,
+			baseURL+ControllerServiceGetQuotaUsageProcedure,
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getSaga: connect.NewClient[v1.GetSagaRequest, v1.GetSagaResponse](
+			httpClient,
+			baseURL+ControllerServiceGetSagaProcedure,
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		listProvisionedDatabases: connect.NewClient[v1.ListProvisionedDatabasesRequest, v1.ListProvisionedDatabasesResponse](
+			httpClient,
+			baseURL+ControllerServiceListProvisionedDatabasesProcedure,
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		teardownDatabase: connect.NewClient[v1.TeardownDatabaseRequest, v1.TeardownDatabaseResponse](
+			httpClient,
+			baseURL+ControllerServiceTeardownDatabaseProcedure,
+			opts...,
+		),
+		listCronJobs: connect.NewClient[v1.ListCronJobsRequest, v1.ListCronJobsResponse](
+			httpClient,
+			baseURL+ControllerServiceListCronJobsProcedure,
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		pauseCronJobs: connect.NewClient[v1.PauseCronJobsRequest, v1.PauseCronJobsResponse](
+			httpClient,
+			baseURL+ControllerServicePauseCronJobsProcedure,
+			connect.WithIdempotency(connect.IdempotencyIdempotent),
+			connect.WithClientOptions(opts...),
+		),
+		resumeCronJobs: connect.NewClient[v1.ResumeCronJobsRequest, v1.ResumeCronJobsResponse](
+			httpClient,
+			baseURL+ControllerServiceResumeCronJobsProcedure,
+			connect.WithIdempotency(connect.IdempotencyIdempotent),
+			connect.WithClientOptions(opts...),
+		),
+		triggerCronJob: connect.NewClient[v1.TriggerCronJobRequest, v1.TriggerCronJobResponse](
+			httpClient,
+			baseURL+ControllerServiceTriggerCronJobProcedure,
+			opts...,
+		),
+		injectFault: connect.NewClient[v1.InjectFaultRequest, v1.InjectFaultResponse](
+			httpClient,
+			baseURL+ControllerServiceInjectFaultProcedure,
+			opts...,
+		),
+		listFaults: connect.NewClient[v1.ListFaultsRequest, v1.ListFaultsResponse](
+			httpClient,
+			baseURL+ControllerServiceListFaultsProcedure,
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		clearFaults: connect.NewClient[v1.ClearFaultsRequest, v1.ClearFaultsResponse](
+			httpClient,
+			baseURL+ControllerServiceClearFaultsProcedure,
+			connect.WithIdempotency(connect.IdempotencyIdempotent),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // controllerServiceClient implements ControllerServiceClient.
 type controllerServiceClient struct {
-	ping                   *connect.Client[v1.PingRequest, v1.PingResponse]
-	processList            *connect.Client[v1.ProcessListRequest, v1.ProcessListResponse]
-	status                 *connect.Client[v1.StatusRequest, v1.StatusResponse]
-	getArtefactDiffs       *connect.Client[v1.GetArtefactDiffsRequest, v1.GetArtefactDiffsResponse]
-	uploadArtefact         *connect.Client[v1.UploadArtefactRequest, v1.UploadArtefactResponse]
-	createDeployment       *connect.Client[v1.CreateDeploymentRequest, v1.CreateDeploymentResponse]
-	getDeployment          *connect.Client[v1.GetDeploymentRequest, v1.GetDeploymentResponse]
-	getDeploymentArtefacts *connect.Client[v1.GetDeploymentArtefactsRequest, v1.GetDeploymentArtefactsResponse]
-	registerRunner         *connect.Client[v1.RegisterRunnerRequest, v1.RegisterRunnerResponse]
-	updateDeploy           *connect.Client[v1.UpdateDeployRequest, v1.UpdateDeployResponse]
-	replaceDeploy          *connect.Client[v1.ReplaceDeployRequest, v1.ReplaceDeployResponse]
-	streamDeploymentLogs   *connect.Client[v1.StreamDeploymentLogsRequest, v1.StreamDeploymentLogsResponse]
-	getSchema              *connect.Client[v1.GetSchemaRequest, v1.GetSchemaResponse]
-	pullSchema             *connect.Client[v1.PullSchemaRequest, v1.PullSchemaResponse]
+	ping                     *connect.Client[v1.PingRequest, v1.PingResponse]
+	processList              *connect.Client[v1.ProcessListRequest, v1.ProcessListResponse]
+	status                   *connect.Client[v1.StatusRequest, v1.StatusResponse]
+	getArtefactDiffs         *connect.Client[v1.GetArtefactDiffsRequest, v1.GetArtefactDiffsResponse]
+	uploadArtefact           *connect.Client[v1.UploadArtefactRequest, v1.UploadArtefactResponse]
+	createDeployment         *connect.Client[v1.CreateDeploymentRequest, v1.CreateDeploymentResponse]
+	getDeployment            *connect.Client[v1.GetDeploymentRequest, v1.GetDeploymentResponse]
+	getDeploymentArtefacts   *connect.Client[v1.GetDeploymentArtefactsRequest, v1.GetDeploymentArtefactsResponse]
+	registerRunner           *connect.Client[v1.RegisterRunnerRequest, v1.RegisterRunnerResponse]
+	updateDeploy             *connect.Client[v1.UpdateDeployRequest, v1.UpdateDeployResponse]
+	replaceDeploy            *connect.Client[v1.ReplaceDeployRequest, v1.ReplaceDeployResponse]
+	streamDeploymentLogs     *connect.Client[v1.StreamDeploymentLogsRequest, v1.StreamDeploymentLogsResponse]
+	getSchema                *connect.Client[v1.GetSchemaRequest, v1.GetSchemaResponse]
+	pullSchema               *connect.Client[v1.PullSchemaRequest, v1.PullSchemaResponse]
+	sendFSMTransition        *connect.Client[v1.SendFSMTransitionRequest, v1.SendFSMTransitionResponse]
+	getSLOStatus             *connect.Client[v1.GetSLOStatusRequest, v1.GetSLOStatusResponse]
+	getPrefetchCandidates    *connect.Client[v1.GetPrefetchCandidatesRequest, v1.GetPrefetchCandidatesResponse]
+	reconcile                *connect.Client[v1.ReconcileRequest, v1.ReconcileResponse]
+	listFeatureFlags         *connect.Client[v1.ListFeatureFlagsRequest, v1.ListFeatureFlagsResponse]
+	setFeatureFlag           *connect.Client[v1.SetFeatureFlagRequest, v1.SetFeatureFlagResponse]
+	unsetFeatureFlag         *connect.Client[v1.UnsetFeatureFlagRequest, v1.UnsetFeatureFlagResponse]
+	setLogLevel              *connect.Client[v1.SetLogLevelRequest, v1.SetLogLevelResponse]
+	getQuotaUsage            *connect.Client[v1.GetQuotaUsageRequest, v1.GetQuotaUsageResponse]
+	getSaga                  *connect.Client[v1.GetSagaRequest, v1.GetSagaResponse]
+	listProvisionedDatabases *connect.Client[v1.ListProvisionedDatabasesRequest, v1.ListProvisionedDatabasesResponse]
+	teardownDatabase         *connect.Client[v1.TeardownDatabaseRequest, v1.TeardownDatabaseResponse]
+	listCronJobs             *connect.Client[v1.ListCronJobsRequest, v1.ListCronJobsResponse]
+	pauseCronJobs            *connect.Client[v1.PauseCronJobsRequest, v1.PauseCronJobsResponse]
+	resumeCronJobs           *connect.Client[v1.ResumeCronJobsRequest, v1.ResumeCronJobsResponse]
+	triggerCronJob           *connect.Client[v1.TriggerCronJobRequest, v1.TriggerCronJobResponse]
+	injectFault              *connect.Client[v1.InjectFaultRequest, v1.InjectFaultResponse]
+	listFaults               *connect.Client[v1.ListFaultsRequest, v1.ListFaultsResponse]
+	clearFaults              *connect.Client[v1.ClearFaultsRequest, v1.ClearFaultsResponse]
 }
 
 // Ping calls xyz.block.ftl.v1.ControllerService.Ping.
@@ -546,6 +890,101 @@ func (c *controllerServiceClient) PullSchema(ctx context.Context, req *connect.R
 	return c.pullSchema.CallServerStream(ctx, req)
 }
 
+// SendFSMTransition calls xyz.block.ftl.v1.ControllerService.SendFSMTransition.
+func (c *controllerServiceClient) SendFSMTransition(ctx context.Context, req *connect.Request[v1.SendFSMTransitionRequest]) (*connect.Response[v1.SendFSMTransitionResponse], error) {
+	return c.sendFSMTransition.CallUnary(ctx, req)
+}
+
+// GetSLOStatus calls xyz.block.ftl.v1.ControllerService.GetSLOStatus.
+func (c *controllerServiceClient) GetSLOStatus(ctx context.Context, req *connect.Request[v1.GetSLOStatusRequest]) (*connect.Response[v1.GetSLOStatusResponse], error) {
+	return c.getSLOStatus.CallUnary(ctx, req)
+}
+
+// GetPrefetchCandidates calls xyz.block.ftl.v1.ControllerService.GetPrefetchCandidates.
+func (c *controllerServiceClient) GetPrefetchCandidates(ctx context.Context, req *connect.Request[v1.GetPrefetchCandidatesRequest]) (*connect.Response[v1.GetPrefetchCandidatesResponse], error) {
+	return c.getPrefetchCandidates.CallUnary(ctx, req)
+}
+
+// Reconcile calls xyz.block.ftl.v1.ControllerService.Reconcile.
+func (c *controllerServiceClient) Reconcile(ctx context.Context, req *connect.Request[v1.ReconcileRequest]) (*connect.Response[v1.ReconcileResponse], error) {
+	return c.reconcile.CallUnary(ctx, req)
+}
+
+// ListFeatureFlags calls xyz.block.ftl.v1.ControllerService.ListFeatureFlags.
+func (c *controllerServiceClient) ListFeatureFlags(ctx context.Context, req *connect.Request[v1.ListFeatureFlagsRequest]) (*connect.Response[v1.ListFeatureFlagsResponse], error) {
+	return c.listFeatureFlags.CallUnary(ctx, req)
+}
+
+// SetFeatureFlag calls xyz.block.ftl.v1.ControllerService.SetFeatureFlag.
+func (c *controllerServiceClient) SetFeatureFlag(ctx context.Context, req *connect.Request[v1.SetFeatureFlagRequest]) (*connect.Response[v1.SetFeatureFlagResponse], error) {
+	return c.setFeatureFlag.CallUnary(ctx, req)
+}
+
+// UnsetFeatureFlag calls xyz.block.ftl.v1.ControllerService.UnsetFeatureFlag.
+func (c *controllerServiceClient) UnsetFeatureFlag(ctx context.Context, req *connect.Request[v1.UnsetFeatureFlagRequest]) (*connect.Response[v1.UnsetFeatureFlagResponse], error) {
+	return c.unsetFeatureFlag.CallUnary(ctx, req)
+}
+
+// SetLogLevel calls xyz.block.ftl.v1.ControllerService.SetLogLevel.
+func (c *controllerServiceClient) SetLogLevel(ctx context.Context, req *connect.Request[v1.SetLogLevelRequest]) (*connect.Response[v1.SetLogLevelResponse], error) {
+	return c.setLogLevel.CallUnary(ctx, req)
+}
+
+// GetQuotaUsage calls xyz.block.ftl.v1.ControllerService.GetQuotaUsage.
+func (c *controllerServiceClient) GetQuotaUsage(ctx context.Context, req *connect.Request[v1.GetQuotaUsageRequest]) (*connect.Response[v1.GetQuotaUsageResponse], error) {
+	return c.getQuotaUsage.CallUnary(ctx, req)
+}
+
+// GetSaga calls xyz.block.ftl.v1.ControllerService.GetSaga.
+func (c *controllerServiceClient) GetSaga(ctx context.Context, req *connect.Request[v1.GetSagaRequest]) (*connect.Response[v1.GetSagaResponse], error) {
+	return c.getSaga.CallUnary(ctx, req)
+}
+
+// ListProvisionedDatabases calls xyz.block.ftl.v1.ControllerService.ListProvisionedDatabases.
+func (c *controllerServiceClient) ListProvisionedDatabases(ctx context.Context, req *connect.Request[v1.ListProvisionedDatabasesRequest]) (*connect.Response[v1.ListProvisionedDatabasesResponse], error) {
+	return c.listProvisionedDatabases.CallUnary(ctx, req)
+}
+
+// TeardownDatabase calls xyz.block.ftl.v1.ControllerService.TeardownDatabase.
+func (c *controllerServiceClient) TeardownDatabase(ctx context.Context, req *connect.Request[v1.TeardownDatabaseRequest]) (*connect.Response[v1.TeardownDatabaseResponse], error) {
+	return c.teardownDatabase.CallUnary(ctx, req)
+}
+
+// ListCronJobs calls xyz.block.ftl.v1.ControllerService.ListCronJobs.
+func (c *controllerServiceClient) ListCronJobs(ctx context.Context, req *connect.Request[v1.ListCronJobsRequest]) (*connect.Response[v1.ListCronJobsResponse], error) {
+	return c.listCronJobs.CallUnary(ctx, req)
+}
+
+// PauseCronJobs calls xyz.block.ftl.v1.ControllerService.PauseCronJobs.
+func (c *controllerServiceClient) PauseCronJobs(ctx context.Context, req *connect.Request[v1.PauseCronJobsRequest]) (*connect.Response[v1.PauseCronJobsResponse], error) {
+	return c.pauseCronJobs.CallUnary(ctx, req)
+}
+
+// ResumeCronJobs calls xyz.block.ftl.v1.ControllerService.ResumeCronJobs.
+func (c *controllerServiceClient) ResumeCronJobs(ctx context.Context, req *connect.Request[v1.ResumeCronJobsRequest]) (*connect.Response[v1.ResumeCronJobsResponse], error) {
+	return c.resumeCronJobs.CallUnary(ctx, req)
+}
+
+// TriggerCronJob calls xyz.block.ftl.v1.ControllerService.TriggerCronJob.
+func (c *controllerServiceClient) TriggerCronJob(ctx context.Context, req *connect.Request[v1.TriggerCronJobRequest]) (*connect.Response[v1.TriggerCronJobResponse], error) {
+	return c.triggerCronJob.CallUnary(ctx, req)
+}
+
+// InjectFault calls xyz.block.ftl.v1.ControllerService.InjectFault.
+func (c *controllerServiceClient) InjectFault(ctx context.Context, req *connect.Request[v1.InjectFaultRequest]) (*connect.Response[v1.InjectFaultResponse], error) {
+	return c.injectFault.CallUnary(ctx, req)
+}
+
+// ListFaults calls xyz.block.ftl.v1.ControllerService.ListFaults.
+func (c *controllerServiceClient) ListFaults(ctx context.Context, req *connect.Request[v1.ListFaultsRequest]) (*connect.Response[v1.ListFaultsResponse], error) {
+	return c.listFaults.CallUnary(ctx, req)
+}
+
+// ClearFaults calls xyz.block.ftl.v1.ControllerService.ClearFaults.
+func (c *controllerServiceClient) ClearFaults(ctx context.Context, req *connect.Request[v1.ClearFaultsRequest]) (*connect.Response[v1.ClearFaultsResponse], error) {
+	return c.clearFaults.CallUnary(ctx, req)
+}
+
 // ControllerServiceHandler is an implementation of the xyz.block.ftl.v1.ControllerService service.
 type ControllerServiceHandler interface {
 	// Ping service for readiness.
@@ -587,6 +1026,54 @@ type ControllerServiceHandler interface {
 	// Note that if there are no deployments this will block indefinitely, making it unsuitable for
 	// just retrieving the schema. Use GetSchema for that.
 	PullSchema(context.Context, *connect.Request[v1.PullSchemaRequest], *connect.ServerStream[v1.PullSchemaResponse]) error
+	// Start a transition of an FSM instance to the given state.
+	//
+	// Unlike VerbService.SendFSMEvent, the destination is selected by state
+	// rather than by the type of the event.
+	SendFSMTransition(context.Context, *connect.Request[v1.SendFSMTransitionRequest]) (*connect.Response[v1.SendFSMTransitionResponse], error)
+	// Success rates and latencies of calls to each verb, measured against their service level objectives.
+	GetSLOStatus(context.Context, *connect.Request[v1.GetSLOStatusRequest]) (*connect.Response[v1.GetSLOStatusResponse], error)
+	// Deployments idle runners should prefetch artefacts for, because they are
+	// likely to be scaled up.
+	GetPrefetchCandidates(context.Context, *connect.Request[v1.GetPrefetchCandidatesRequest]) (*connect.Response[v1.GetPrefetchCandidatesResponse], error)
+	// Immediately reconcile the deployments of a module, ignoring any backoff
+	// from previous failures.
+	Reconcile(context.Context, *connect.Request[v1.ReconcileRequest]) (*connect.Response[v1.ReconcileResponse], error)
+	// List feature flags, optionally filtered by module.
+	ListFeatureFlags(context.Context, *connect.Request[v1.ListFeatureFlagsRequest]) (*connect.Response[v1.ListFeatureFlagsResponse], error)
+	// Create or replace the rules of a feature flag.
+	SetFeatureFlag(context.Context, *connect.Request[v1.SetFeatureFlagRequest]) (*connect.Response[v1.SetFeatureFlagResponse], error)
+	// Delete a feature flag.
+	UnsetFeatureFlag(context.Context, *connect.Request[v1.UnsetFeatureFlagRequest]) (*connect.Response[v1.UnsetFeatureFlagResponse], error)
+	// Temporarily override the log level of the running deployments of a module.
+	SetLogLevel(context.Context, *connect.Request[v1.SetLogLevelRequest]) (*connect.Response[v1.SetLogLevelResponse], error)
+	// Report the usage of each configured quota.
+	GetQuotaUsage(context.Context, *connect.Request[v1.GetQuotaUsageRequest]) (*connect.Response[v1.GetQuotaUsageResponse], error)
+	// Report the progress of an instance of a saga.
+	GetSaga(context.Context, *connect.Request[v1.GetSagaRequest]) (*connect.Response[v1.GetSagaResponse], error)
+	// List the databases the controller provisioned for modules.
+	ListProvisionedDatabases(context.Context, *connect.Request[v1.ListProvisionedDatabasesRequest]) (*connect.Response[v1.ListProvisionedDatabasesResponse], error)
+	// Delete a database the controller provisioned for a module, along with its
+	// DSN secret. Fails if an active deployment of the module still declares it.
+	TeardownDatabase(context.Context, *connect.Request[v1.TeardownDatabaseRequest]) (*connect.Response[v1.TeardownDatabaseResponse], error)
+	// List the cron jobs of active deployments, optionally filtered by module.
+	ListCronJobs(context.Context, *connect.Request[v1.ListCronJobsRequest]) (*connect.Response[v1.ListCronJobsResponse], error)
+	// Pause cron jobs of the active deployment of a module until they are
+	// resumed. Paused jobs are skipped when they are due.
+	PauseCronJobs(context.Context, *connect.Request[v1.PauseCronJobsRequest]) (*connect.Response[v1.PauseCronJobsResponse], error)
+	// Resume paused cron jobs of the active deployment of a module.
+	ResumeCronJobs(context.Context, *connect.Request[v1.ResumeCronJobsRequest]) (*connect.Response[v1.ResumeCronJobsResponse], error)
+	// Execute a cron job immediately, whether or not it is paused, without
+	// affecting its schedule.
+	TriggerCronJob(context.Context, *connect.Request[v1.TriggerCronJobRequest]) (*connect.Response[v1.TriggerCronJobResponse], error)
+	// Inject a fault into calls to the verbs of a module, for game days and
+	// integration tests. Only available if the controller was started with
+	// fault injection enabled, and to requests not scoped to a project.
+	InjectFault(context.Context, *connect.Request[v1.InjectFaultRequest]) (*connect.Response[v1.InjectFaultResponse], error)
+	// List the injected faults that have not expired.
+	ListFaults(context.Context, *connect.Request[v1.ListFaultsRequest]) (*connect.Response[v1.ListFaultsResponse], error)
+	// Clear injected faults.
+	ClearFaults(context.Context, *connect.Request[v1.ClearFaultsRequest]) (*connect.Response[v1.ClearFaultsResponse], error)
 }
 
 // NewControllerServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -604,17 +1091,20 @@ func NewControllerServiceHandler(svc ControllerServiceHandler, opts ...connect.H
 	controllerServiceProcessListHandler := connect.NewUnaryHandler(
 		ControllerServiceProcessListProcedure,
 		svc.ProcessList,
-		opts...,
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
 	)
 	controllerServiceStatusHandler := connect.NewUnaryHandler(
 		ControllerServiceStatusProcedure,
 		svc.Status,
-		opts...,
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
 	)
 	controllerServiceGetArtefactDiffsHandler := connect.NewUnaryHandler(
 		ControllerServiceGetArtefactDiffsProcedure,
 		svc.GetArtefactDiffs,
-		opts...,
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
 	)
 	controllerServiceUploadArtefactHandler := connect.NewUnaryHandler(
 		ControllerServiceUploadArtefactProcedure,
@@ -629,7 +1119,8 @@ func NewControllerServiceHandler(svc ControllerServiceHandler, opts ...connect.H
 	controllerServiceGetDeploymentHandler := connect.NewUnaryHandler(
 		ControllerServiceGetDeploymentProcedure,
 		svc.GetDeployment,
-		opts...,
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
 	)
 	controllerServiceGetDeploymentArtefactsHandler := connect.NewServerStreamHandler(
 		ControllerServiceGetDeploymentArtefactsProcedure,
@@ -659,13 +1150,123 @@ func NewControllerServiceHandler(svc ControllerServiceHandler, opts ...connect.H
 	controllerServiceGetSchemaHandler := connect.NewUnaryHandler(
 		ControllerServiceGetSchemaProcedure,
 		svc.GetSchema,
-		opts...,
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
 	)
 	controllerServicePullSchemaHandler := connect.NewServerStreamHandler(
 		ControllerServicePullSchemaProcedure,
 		svc.PullSchema,
 		opts...,
 	)
+	controllerServiceSendFSMTransitionHandler := connect.NewUnaryHandler(
+		ControllerServiceSendFSMTransitionProcedure,
+		svc.SendFSMTransition,
+		opts...,
+	)
+	controllerServiceGetSLOStatusHandler := connect.NewUnaryHandler(
+		ControllerServiceGetSLOStatusProcedure,
+		svc.GetSLOStatus,
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServiceGetPrefetchCandidatesHandler := connect.NewUnaryHandler(
+		ControllerServiceGetPrefetchCandidatesProcedure,
+		svc.GetPrefetchCandidates,
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServiceReconcileHandler := connect.NewUnaryHandler(
+		ControllerServiceReconcileProcedure,
+		svc.Reconcile,
+		opts...,
+	)
+	controllerServiceListFeatureFlagsHandler := connect.NewUnaryHandler(
+		ControllerServiceListFeatureFlagsProcedure,
+		svc.ListFeatureFlags,
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServiceSetFeatureFlagHandler := connect.NewUnaryHandler(
+		ControllerServiceSetFeatureFlagProcedure,
+		svc.SetFeatureFlag,
+		connect.WithIdempotency(connect.IdempotencyIdempotent),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServiceUnsetFeatureFlagHandler := connect.NewUnaryHandler(
+		ControllerServiceUnsetFeatureFlagProcedure,
+		svc.UnsetFeatureFlag,
+		connect.WithIdempotency(connect.IdempotencyIdempotent),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServiceSetLogLevelHandler := connect.NewUnaryHandler(
+		ControllerServiceSetLogLevelProcedure,
+		svc.SetLogLevel,
+		connect.WithIdempotency(connect.IdempotencyIdempotent),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServiceGetQuotaUsageHandler := connect.NewUnaryHandler(
+		ControllerServiceGetQuotaUsageProcedure,
+		svc.GetQuotaUsage,
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServiceGetSagaHandler := connect.NewUnaryHandler(
+		ControllerServiceGetSagaProcedure,
+		svc.GetSaga,
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServiceListProvisionedDatabasesHandler := connect.NewUnaryHandler(
+		ControllerServiceListProvisionedDatabasesProcedure,
+		svc.ListProvisionedDatabases,
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServiceTeardownDatabaseHandler := connect.NewUnaryHandler(
+		ControllerServiceTeardownDatabaseProcedure,
+		svc.TeardownDatabase,
+		opts...,
+	)
+	controllerServiceListCronJobsHandler := connect.NewUnaryHandler(
+		ControllerServiceListCronJobsProcedure,
+		svc.ListCronJobs,
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServicePauseCronJobsHandler := connect.NewUnaryHandler(
+		ControllerServicePauseCronJobsProcedure,
+		svc.PauseCronJobs,
+		connect.WithIdempotency(connect.IdempotencyIdempotent),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServiceResumeCronJobsHandler := connect.NewUnaryHandler(
+		ControllerServiceResumeCronJobsProcedure,
+		svc.ResumeCronJobs,
+		connect.WithIdempotency(connect.IdempotencyIdempotent),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServiceTriggerCronJobHandler := connect.NewUnaryHandler(
+		ControllerServiceTriggerCronJobProcedure,
+		svc.TriggerCronJob,
+		opts...,
+	)
+	controllerServiceInjectFaultHandler := connect.NewUnaryHandler(
+		ControllerServiceInjectFaultProcedure,
+		svc.InjectFault,
+		opts...,
+	)
+	controllerServiceListFaultsHandler := connect.NewUnaryHandler(
+		ControllerServiceListFaultsProcedure,
+		svc.ListFaults,
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	controllerServiceClearFaultsHandler := connect.NewUnaryHandler(
+		ControllerServiceClearFaultsProcedure,
+		svc.ClearFaults,
+		connect.WithIdempotency(connect.IdempotencyIdempotent),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/xyz.block.ftl.v1.ControllerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ControllerServicePingProcedure:
@@ -696,6 +1297,44 @@ func NewControllerServiceHandler(svc ControllerServiceHandler, opts ...connect.H
 			controllerServiceGetSchemaHandler.ServeHTTP(w, r)
 		case ControllerServicePullSchemaProcedure:
 			controllerServicePullSchemaHandler.ServeHTTP(w, r)
+		case ControllerServiceSendFSMTransitionProcedure:
+			controllerServiceSendFSMTransitionHandler.ServeHTTP(w, r)
+		case ControllerServiceGetSLOStatusProcedure:
+			controllerServiceGetSLOStatusHandler.ServeHTTP(w, r)
+		case ControllerServiceGetPrefetchCandidatesProcedure:
+			controllerServiceGetPrefetchCandidatesHandler.ServeHTTP(w, r)
+		case ControllerServiceReconcileProcedure:
+			controllerServiceReconcileHandler.ServeHTTP(w, r)
+		case ControllerServiceListFeatureFlagsProcedure:
+			controllerServiceListFeatureFlagsHandler.ServeHTTP(w, r)
+		case ControllerServiceSetFeatureFlagProcedure:
+			controllerServiceSetFeatureFlagHandler.ServeHTTP(w, r)
+		case ControllerServiceUnsetFeatureFlagProcedure:
+			controllerServiceUnsetFeatureFlagHandler.ServeHTTP(w, r)
+		case ControllerServiceSetLogLevelProcedure:
+			controllerServiceSetLogLevelHandler.ServeHTTP(w, r)
+		case ControllerServiceGetQuotaUsageProcedure:
+			controllerServiceGetQuotaUsageHandler.ServeHTTP(w, r)
+		case ControllerServiceGetSagaProcedure:
+			controllerServiceGetSagaHandler.ServeHTTP(w, r)
+		case ControllerServiceListProvisionedDatabasesProcedure:
+			controllerServiceListProvisionedDatabasesHandler.ServeHTTP(w, r)
+		case ControllerServiceTeardownDatabaseProcedure:
+			controllerServiceTeardownDatabaseHandler.ServeHTTP(w, r)
+		case ControllerServiceListCronJobsProcedure:
+			controllerServiceListCronJobsHandler.ServeHTTP(w, r)
+		case ControllerServicePauseCronJobsProcedure:
+			controllerServicePauseCronJobsHandler.ServeHTTP(w, r)
+		case ControllerServiceResumeCronJobsProcedure:
+			controllerServiceResumeCronJobsHandler.ServeHTTP(w, r)
+		case ControllerServiceTriggerCronJobProcedure:
+			controllerServiceTriggerCronJobHandler.ServeHTTP(w, r)
+		case ControllerServiceInjectFaultProcedure:
+			controllerServiceInjectFaultHandler.ServeHTTP(w, r)
+		case ControllerServiceListFaultsProcedure:
+			controllerServiceListFaultsHandler.ServeHTTP(w, r)
+		case ControllerServiceClearFaultsProcedure:
+			controllerServiceClearFaultsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -761,6 +1400,82 @@ func (UnimplementedControllerServiceHandler) PullSchema(context.Context, *connec
 	return connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.PullSchema is not implemented"))
 }
 
+func (UnimplementedControllerServiceHandler) SendFSMTransition(context.Context, *connect.Request[v1.SendFSMTransitionRequest]) (*connect.Response[v1.SendFSMTransitionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.SendFSMTransition is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) GetSLOStatus(context.Context, *connect.Request[v1.GetSLOStatusRequest]) (*connect.Response[v1.GetSLOStatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.GetSLOStatus is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) GetPrefetchCandidates(context.Context, *connect.Request[v1.GetPrefetchCandidatesRequest]) (*connect.Response[v1.GetPrefetchCandidatesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.GetPrefetchCandidates is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) Reconcile(context.Context, *connect.Request[v1.ReconcileRequest]) (*connect.Response[v1.ReconcileResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.Reconcile is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) ListFeatureFlags(context.Context, *connect.Request[v1.ListFeatureFlagsRequest]) (*connect.Response[v1.ListFeatureFlagsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.ListFeatureFlags is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) SetFeatureFlag(context.Context, *connect.Request[v1.SetFeatureFlagRequest]) (*connect.Response[v1.SetFeatureFlagResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.SetFeatureFlag is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) UnsetFeatureFlag(context.Context, *connect.Request[v1.UnsetFeatureFlagRequest]) (*connect.Response[v1.UnsetFeatureFlagResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.UnsetFeatureFlag is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) SetLogLevel(context.Context, *connect.Request[v1.SetLogLevelRequest]) (*connect.Response[v1.SetLogLevelResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.SetLogLevel is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) GetQuotaUsage(context.Context, *connect.Request[v1.GetQuotaUsageRequest]) (*connect.Response[v1.GetQuotaUsageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.GetQuotaUsage is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) GetSaga(context.Context, *connect.Request[v1.GetSagaRequest]) (*connect.Response[v1.GetSagaResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.GetSaga is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) ListProvisionedDatabases(context.Context, *connect.Request[v1.ListProvisionedDatabasesRequest]) (*connect.Response[v1.ListProvisionedDatabasesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.ListProvisionedDatabases is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) TeardownDatabase(context.Context, *connect.Request[v1.TeardownDatabaseRequest]) (*connect.Response[v1.TeardownDatabaseResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.TeardownDatabase is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) ListCronJobs(context.Context, *connect.Request[v1.ListCronJobsRequest]) (*connect.Response[v1.ListCronJobsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.ListCronJobs is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) PauseCronJobs(context.Context, *connect.Request[v1.PauseCronJobsRequest]) (*connect.Response[v1.PauseCronJobsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.PauseCronJobs is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) ResumeCronJobs(context.Context, *connect.Request[v1.ResumeCronJobsRequest]) (*connect.Response[v1.ResumeCronJobsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.ResumeCronJobs is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) TriggerCronJob(context.Context, *connect.Request[v1.TriggerCronJobRequest]) (*connect.Response[v1.TriggerCronJobResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.TriggerCronJob is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) InjectFault(context.Context, *connect.Request[v1.InjectFaultRequest]) (*connect.Response[v1.InjectFaultResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.InjectFault is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) ListFaults(context.Context, *connect.Request[v1.ListFaultsRequest]) (*connect.Response[v1.ListFaultsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.ListFaults is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) ClearFaults(context.Context, *connect.Request[v1.ClearFaultsRequest]) (*connect.Response[v1.ClearFaultsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.ClearFaults is not implemented"))
+}
+
 // RunnerServiceClient is a client for the xyz.block.ftl.v1.RunnerService service.
 type RunnerServiceClient interface {
 	Ping(context.Context, *connect.Request[v1.PingRequest]) (*connect.Response[v1.PingResponse], error)
@@ -926,6 +1641,16 @@ type AdminServiceClient interface {
 	SecretSet(context.Context, *connect.Request[v1.SetSecretRequest]) (*connect.Response[v1.SetSecretResponse], error)
 	// Unset a secret.
 	SecretUnset(context.Context, *connect.Request[v1.UnsetSecretRequest]) (*connect.Response[v1.UnsetSecretResponse], error)
+	// List the resources of a kind.
+	ListAdminResources(context.Context, *connect.Request[v1.ListAdminResourcesRequest]) (*connect.Response[v1.ListAdminResourcesResponse], error)
+	// Get a resource.
+	GetAdminResource(context.Context, *connect.Request[v1.GetAdminResourceRequest]) (*connect.Response[v1.GetAdminResourceResponse], error)
+	// Create a resource, failing if it already exists.
+	CreateAdminResource(context.Context, *connect.Request[v1.CreateAdminResourceRequest]) (*connect.Response[v1.CreateAdminResourceResponse], error)
+	// Update an existing resource.
+	UpdateAdminResource(context.Context, *connect.Request[v1.UpdateAdminResourceRequest]) (*connect.Response[v1.UpdateAdminResourceResponse], error)
+	// Delete a resource.
+	DeleteAdminResource(context.Context, *connect.Request[v1.DeleteAdminResourceRequest]) (*connect.Response[v1.DeleteAdminResourceResponse], error)
 }
 
 // NewAdminServiceClient constructs a client for the xyz.block.ftl.v1.AdminService service. By
@@ -947,57 +1672,98 @@ func NewAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 		configList: connect.NewClient[v1.ListConfigRequest, v1.ListConfigResponse](
 			httpClient,
 			baseURL+AdminServiceConfigListProcedure,
-			opts...,
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
 		),
 		configGet: connect.NewClient[v1.GetConfigRequest, v1.GetConfigResponse](
 			httpClient,
 			baseURL+AdminServiceConfigGetProcedure,
-			opts...,
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
 		),
 		configSet: connect.NewClient[v1.SetConfigRequest, v1.SetConfigResponse](
 			httpClient,
 			baseURL+AdminServiceConfigSetProcedure,
-			opts...,
+			connect.WithIdempotency(connect.IdempotencyIdempotent),
+			connect.WithClientOptions(opts...),
 		),
 		configUnset: connect.NewClient[v1.UnsetConfigRequest, v1.UnsetConfigResponse](
 			httpClient,
 			baseURL+AdminServiceConfigUnsetProcedure,
-			opts...,
+			connect.WithIdempotency(connect.IdempotencyIdempotent),
+			connect.WithClientOptions(opts...),
 		),
 		secretsList: connect.NewClient[v1.ListSecretsRequest, v1.ListSecretsResponse](
 			httpClient,
 			baseURL+AdminServiceSecretsListProcedure,
-			opts...,
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
 		),
 		secretGet: connect.NewClient[v1.GetSecretRequest, v1.GetSecretResponse](
 			httpClient,
 			baseURL+AdminServiceSecretGetProcedure,
-			opts...,
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
 		),
 		secretSet: connect.NewClient[v1.SetSecretRequest, v1.SetSecretResponse](
 			httpClient,
 			baseURL+AdminServiceSecretSetProcedure,
-			opts...,
+			connect.WithIdempotency(connect.IdempotencyIdempotent),
+			connect.WithClientOptions(opts...),
 		),
 		secretUnset: connect.NewClient[v1.UnsetSecretRequest, v1.UnsetSecretResponse](
 			httpClient,
 			baseURL+AdminServiceSecretUnsetProcedure,
+			connect.WithIdempotency(connect.IdempotencyIdempotent),
+			connect.WithClientOptions(opts...),
+		),
+		listAdminResources: connect.NewClient[v1.ListAdminResourcesRequest, v1.ListAdminResourcesResponse](
+			httpClient,
+			baseURL+AdminServiceListAdminResourcesProcedure,
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getAdminResource: connect.NewClient[v1.GetAdminResourceRequest, v1.GetAdminResourceResponse](
+			httpClient,
+			baseURL+AdminServiceGetAdminResourceProcedure,
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		createAdminResource: connect.NewClient[v1.CreateAdminResourceRequest, v1.CreateAdminResourceResponse](
+			httpClient,
+			baseURL+AdminServiceCreateAdminResourceProcedure,
 			opts...,
 		),
+		updateAdminResource: connect.NewClient[v1.UpdateAdminResourceRequest, v1.UpdateAdminResourceResponse](
+			httpClient,
+			baseURL+AdminServiceUpdateAdminResourceProcedure,
+			opts...,
+		),
+		deleteAdminResource: connect.NewClient[v1.DeleteAdminResourceRequest, v1.DeleteAdminResourceResponse](
+			httpClient,
+			baseURL+AdminServiceDeleteAdminResourceProcedure,
+			connect.WithIdempotency(connect.IdempotencyIdempotent),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // adminServiceClient implements AdminServiceClient.
 type adminServiceClient struct {
-	ping        *connect.Client[v1.PingRequest, v1.PingResponse]
-	configList  *connect.Client[v1.ListConfigRequest, v1.ListConfigResponse]
-	configGet   *connect.Client[v1.GetConfigRequest, v1.GetConfigResponse]
-	configSet   *connect.Client[v1.SetConfigRequest, v1.SetConfigResponse]
-	configUnset *connect.Client[v1.UnsetConfigRequest, v1.UnsetConfigResponse]
-	secretsList *connect.Client[v1.ListSecretsRequest, v1.ListSecretsResponse]
-	secretGet   *connect.Client[v1.GetSecretRequest, v1.GetSecretResponse]
-	secretSet   *connect.Client[v1.SetSecretRequest, v1.SetSecretResponse]
-	secretUnset *connect.Client[v1.UnsetSecretRequest, v1.UnsetSecretResponse]
+	ping                *connect.Client[v1.PingRequest, v1.PingResponse]
+	configList          *connect.Client[v1.ListConfigRequest, v1.ListConfigResponse]
+	configGet           *connect.Client[v1.GetConfigRequest, v1.GetConfigResponse]
+	configSet           *connect.Client[v1.SetConfigRequest, v1.SetConfigResponse]
+	configUnset         *connect.Client[v1.UnsetConfigRequest, v1.UnsetConfigResponse]
+	secretsList         *connect.Client[v1.ListSecretsRequest, v1.ListSecretsResponse]
+	secretGet           *connect.Client[v1.GetSecretRequest, v1.GetSecretResponse]
+	secretSet           *connect.Client[v1.SetSecretRequest, v1.SetSecretResponse]
+	secretUnset         *connect.Client[v1.UnsetSecretRequest, v1.UnsetSecretResponse]
+	listAdminResources  *connect.Client[v1.ListAdminResourcesRequest, v1.ListAdminResourcesResponse]
+	getAdminResource    *connect.Client[v1.GetAdminResourceRequest, v1.GetAdminResourceResponse]
+	createAdminResource *connect.Client[v1.CreateAdminResourceRequest, v1.CreateAdminResourceResponse]
+	updateAdminResource *connect.Client[v1.UpdateAdminResourceRequest, v1.UpdateAdminResourceResponse]
+	deleteAdminResource *connect.Client[v1.DeleteAdminResourceRequest, v1.DeleteAdminResourceResponse]
 }
 
 // Ping calls xyz.block.ftl.v1.AdminService.Ping.
@@ -1045,6 +1811,31 @@ func (c *adminServiceClient) SecretUnset(ctx context.Context, req *connect.Reque
 	return c.secretUnset.CallUnary(ctx, req)
 }
 
+// ListAdminResources calls xyz.block.ftl.v1.AdminService.ListAdminResources.
+func (c *adminServiceClient) ListAdminResources(ctx context.Context, req *connect.Request[v1.ListAdminResourcesRequest]) (*connect.Response[v1.ListAdminResourcesResponse], error) {
+	return c.listAdminResources.CallUnary(ctx, req)
+}
+
+// GetAdminResource calls xyz.block.ftl.v1.AdminService.GetAdminResource.
+func (c *adminServiceClient) GetAdminResource(ctx context.Context, req *connect.Request[v1.GetAdminResourceRequest]) (*connect.Response[v1.GetAdminResourceResponse], error) {
+	return c.getAdminResource.CallUnary(ctx, req)
+}
+
+// CreateAdminResource calls xyz.block.ftl.v1.AdminService.CreateAdminResource.
+func (c *adminServiceClient) CreateAdminResource(ctx context.Context, req *connect.Request[v1.CreateAdminResourceRequest]) (*connect.Response[v1.CreateAdminResourceResponse], error) {
+	return c.createAdminResource.CallUnary(ctx, req)
+}
+
+// UpdateAdminResource calls xyz.block.ftl.v1.AdminService.UpdateAdminResource.
+func (c *adminServiceClient) UpdateAdminResource(ctx context.Context, req *connect.Request[v1.UpdateAdminResourceRequest]) (*connect.Response[v1.UpdateAdminResourceResponse], error) {
+	return c.updateAdminResource.CallUnary(ctx, req)
+}
+
+// DeleteAdminResource calls xyz.block.ftl.v1.AdminService.DeleteAdminResource.
+func (c *adminServiceClient) DeleteAdminResource(ctx context.Context, req *connect.Request[v1.DeleteAdminResourceRequest]) (*connect.Response[v1.DeleteAdminResourceResponse], error) {
+	return c.deleteAdminResource.CallUnary(ctx, req)
+}
+
 // AdminServiceHandler is an implementation of the xyz.block.ftl.v1.AdminService service.
 type AdminServiceHandler interface {
 	Ping(context.Context, *connect.Request[v1.PingRequest]) (*connect.Response[v1.PingResponse], error)
@@ -1064,6 +1855,16 @@ type AdminServiceHandler interface {
 	SecretSet(context.Context, *connect.Request[v1.SetSecretRequest]) (*connect.Response[v1.SetSecretResponse], error)
 	// Unset a secret.
 	SecretUnset(context.Context, *connect.Request[v1.UnsetSecretRequest]) (*connect.Response[v1.UnsetSecretResponse], error)
+	// List the resources of a kind.
+	ListAdminResources(context.Context, *connect.Request[v1.ListAdminResourcesRequest]) (*connect.Response[v1.ListAdminResourcesResponse], error)
+	// Get a resource.
+	GetAdminResource(context.Context, *connect.Request[v1.GetAdminResourceRequest]) (*connect.Response[v1.GetAdminResourceResponse], error)
+	// Create a resource, failing if it already exists.
+	CreateAdminResource(context.Context, *connect.Request[v1.CreateAdminResourceRequest]) (*connect.Response[v1.CreateAdminResourceResponse], error)
+	// Update an existing resource.
+	UpdateAdminResource(context.Context, *connect.Request[v1.UpdateAdminResourceRequest]) (*connect.Response[v1.UpdateAdminResourceResponse], error)
+	// Delete a resource.
+	DeleteAdminResource(context.Context, *connect.Request[v1.DeleteAdminResourceRequest]) (*connect.Response[v1.DeleteAdminResourceResponse], error)
 }
 
 // NewAdminServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -1081,43 +1882,79 @@ func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOpti
 	adminServiceConfigListHandler := connect.NewUnaryHandler(
 		AdminServiceConfigListProcedure,
 		svc.ConfigList,
-		opts...,
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
 	)
 	adminServiceConfigGetHandler := connect.NewUnaryHandler(
 		AdminServiceConfigGetProcedure,
 		svc.ConfigGet,
-		opts...,
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
 	)
 	adminServiceConfigSetHandler := connect.NewUnaryHandler(
 		AdminServiceConfigSetProcedure,
 		svc.ConfigSet,
-		opts...,
+		connect.WithIdempotency(connect.IdempotencyIdempotent),
+		connect.WithHandlerOptions(opts...),
 	)
 	adminServiceConfigUnsetHandler := connect.NewUnaryHandler(
 		AdminServiceConfigUnsetProcedure,
 		svc.ConfigUnset,
-		opts...,
+		connect.WithIdempotency(connect.IdempotencyIdempotent),
+		connect.WithHandlerOptions(opts...),
 	)
 	adminServiceSecretsListHandler := connect.NewUnaryHandler(
 		AdminServiceSecretsListProcedure,
 		svc.SecretsList,
-		opts...,
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
 	)
 	adminServiceSecretGetHandler := connect.NewUnaryHandler(
 		AdminServiceSecretGetProcedure,
 		svc.SecretGet,
-		opts...,
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
 	)
 	adminServiceSecretSetHandler := connect.NewUnaryHandler(
 		AdminServiceSecretSetProcedure,
 		svc.SecretSet,
-		opts...,
+		connect.WithIdempotency(connect.IdempotencyIdempotent),
+		connect.WithHandlerOptions(opts...),
 	)
 	adminServiceSecretUnsetHandler := connect.NewUnaryHandler(
 		AdminServiceSecretUnsetProcedure,
 		svc.SecretUnset,
+		connect.WithIdempotency(connect.IdempotencyIdempotent),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListAdminResourcesHandler := connect.NewUnaryHandler(
+		AdminServiceListAdminResourcesProcedure,
+		svc.ListAdminResources,
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceGetAdminResourceHandler := connect.NewUnaryHandler(
+		AdminServiceGetAdminResourceProcedure,
+		svc.GetAdminResource,
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceCreateAdminResourceHandler := connect.NewUnaryHandler(
+		AdminServiceCreateAdminResourceProcedure,
+		svc.CreateAdminResource,
+		opts...,
+	)
+	adminServiceUpdateAdminResourceHandler := connect.NewUnaryHandler(
+		AdminServiceUpdateAdminResourceProcedure,
+		svc.UpdateAdminResource,
 		opts...,
 	)
+	adminServiceDeleteAdminResourceHandler := connect.NewUnaryHandler(
+		AdminServiceDeleteAdminResourceProcedure,
+		svc.DeleteAdminResource,
+		connect.WithIdempotency(connect.IdempotencyIdempotent),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/xyz.block.ftl.v1.AdminService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AdminServicePingProcedure:
@@ -1138,6 +1975,16 @@ func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOpti
 			adminServiceSecretSetHandler.ServeHTTP(w, r)
 		case AdminServiceSecretUnsetProcedure:
 			adminServiceSecretUnsetHandler.ServeHTTP(w, r)
+		case AdminServiceListAdminResourcesProcedure:
+			adminServiceListAdminResourcesHandler.ServeHTTP(w, r)
+		case AdminServiceGetAdminResourceProcedure:
+			adminServiceGetAdminResourceHandler.ServeHTTP(w, r)
+		case AdminServiceCreateAdminResourceProcedure:
+			adminServiceCreateAdminResourceHandler.ServeHTTP(w, r)
+		case AdminServiceUpdateAdminResourceProcedure:
+			adminServiceUpdateAdminResourceHandler.ServeHTTP(w, r)
+		case AdminServiceDeleteAdminResourceProcedure:
+			adminServiceDeleteAdminResourceHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -1182,3 +2029,23 @@ func (UnimplementedAdminServiceHandler) SecretSet(context.Context, *connect.Requ
 func (UnimplementedAdminServiceHandler) SecretUnset(context.Context, *connect.Request[v1.UnsetSecretRequest]) (*connect.Response[v1.UnsetSecretResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.AdminService.SecretUnset is not implemented"))
 }
+
+func (UnimplementedAdminServiceHandler) ListAdminResources(context.Context, *connect.Request[v1.ListAdminResourcesRequest]) (*connect.Response[v1.ListAdminResourcesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.AdminService.ListAdminResources is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) GetAdminResource(context.Context, *connect.Request[v1.GetAdminResourceRequest]) (*connect.Response[v1.GetAdminResourceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.AdminService.GetAdminResource is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) CreateAdminResource(context.Context, *connect.Request[v1.CreateAdminResourceRequest]) (*connect.Response[v1.CreateAdminResourceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.AdminService.CreateAdminResource is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) UpdateAdminResource(context.Context, *connect.Request[v1.UpdateAdminResourceRequest]) (*connect.Response[v1.UpdateAdminResourceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.AdminService.UpdateAdminResource is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) DeleteAdminResource(context.Context, *connect.Request[v1.DeleteAdminResourceRequest]) (*connect.Response[v1.DeleteAdminResourceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.AdminService.DeleteAdminResource is not implemented"))
+}
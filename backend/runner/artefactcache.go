@@ -0,0 +1,198 @@
+package runner
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/internal/download"
+	"github.com/TBD54566975/ftl/internal/sha256"
+)
+
+// Directory within the deployment directory that cached artefacts are kept in.
+const artefactCacheDir = ".artefacts"
+
+var _ download.Cache = (*artefactCache)(nil)
+
+// artefactCache keeps downloaded artefacts on disk by digest, so that
+// deploying the same artefacts again doesn't download them.
+//
+// The cache persists across restarts of the runner. Once it grows beyond
+// "maxSize" bytes, the least recently used artefacts are evicted. Each
+// artefact's modification time records when it was last used.
+type artefactCache struct {
+	dir     string
+	maxSize int64
+
+	lock    sync.Mutex
+	entries map[sha256.SHA256]*cacheEntry
+	size    int64
+	stats   artefactCacheStats
+}
+
+type cacheEntry struct {
+	size int64
+	used time.Time
+}
+
+type artefactCacheStats struct {
+	hits      int64
+	misses    int64
+	evictions int64
+}
+
+func newArtefactCache(dir string, maxSize int64) (*artefactCache, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create artefact cache: %w", err)
+	}
+	c := &artefactCache{dir: dir, maxSize: maxSize, entries: map[sha256.SHA256]*cacheEntry{}}
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read artefact cache: %w", err)
+	}
+	for _, file := range files {
+		digest, err := sha256.ParseSHA256(file.Name())
+		if err != nil || !file.Type().IsRegular() {
+			// Left over from an interrupted write.
+			if err := os.RemoveAll(filepath.Join(dir, file.Name())); err != nil {
+				return nil, fmt.Errorf("failed to clean artefact cache: %w", err)
+			}
+			continue
+		}
+		info, err := file.Info()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read artefact cache: %w", err)
+		}
+		c.entries[digest] = &cacheEntry{size: info.Size(), used: info.ModTime()}
+		c.size += info.Size()
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if err := c.evict(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Get copies a cached artefact to "dest".
+func (c *artefactCache) Get(artefact *ftlv1.DeploymentArtefact, dest string) (bool, error) {
+	digest, err := sha256.ParseSHA256(artefact.Digest)
+	if err != nil {
+		return false, fmt.Errorf("invalid digest for %s: %w", artefact.Path, err)
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	entry, ok := c.entries[digest]
+	if !ok {
+		c.stats.misses++
+		return false, nil
+	}
+	path := filepath.Join(c.dir, digest.String())
+	var mode os.FileMode = 0600
+	if artefact.Executable {
+		mode = 0700
+	}
+	if err := copyFile(path, dest, mode); err != nil {
+		return false, fmt.Errorf("failed to copy cached artefact %s: %w", digest, err)
+	}
+	entry.used = time.Now()
+	// Errors are ignored, as this only affects the order of eviction after a
+	// restart.
+	_ = os.Chtimes(path, entry.used, entry.used)
+	c.stats.hits++
+	return true, nil
+}
+
+// Put adds a downloaded artefact at "path" to the cache, evicting the least
+// recently used artefacts if the cache is full.
+func (c *artefactCache) Put(artefact *ftlv1.DeploymentArtefact, path string) error {
+	digest, err := sha256.ParseSHA256(artefact.Digest)
+	if err != nil {
+		return fmt.Errorf("invalid digest for %s: %w", artefact.Path, err)
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if _, ok := c.entries[digest]; ok {
+		return nil
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if info.Size() > c.maxSize {
+		return nil
+	}
+	// Copy to a temporary file first so that partially written artefacts are
+	// never used.
+	tmp := filepath.Join(c.dir, digest.String()+".tmp")
+	if err := copyFile(path, tmp, 0600); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("failed to cache artefact %s: %w", digest, err)
+	}
+	used := time.Now()
+	_ = os.Chtimes(tmp, used, used)
+	if err := os.Rename(tmp, filepath.Join(c.dir, digest.String())); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("failed to cache artefact %s: %w", digest, err)
+	}
+	c.entries[digest] = &cacheEntry{size: info.Size(), used: used}
+	c.size += info.Size()
+	return c.evict()
+}
+
+// evict removes the least recently used artefacts until the cache fits in
+// its maximum size.
+//
+// Must be called with the lock held.
+func (c *artefactCache) evict() error {
+	for c.size > c.maxSize {
+		var oldest sha256.SHA256
+		var oldestEntry *cacheEntry
+		for digest, entry := range c.entries {
+			if oldestEntry == nil || entry.used.Before(oldestEntry.used) {
+				oldest, oldestEntry = digest, entry
+			}
+		}
+		if err := os.Remove(filepath.Join(c.dir, oldest.String())); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to evict cached artefact %s: %w", oldest, err)
+		}
+		delete(c.entries, oldest)
+		c.size -= oldestEntry.size
+		c.stats.evictions++
+	}
+	return nil
+}
+
+// proto returns the statistics of the cache reported in heartbeats.
+func (c *artefactCache) proto() *ftlv1.ArtefactCacheStats {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return &ftlv1.ArtefactCacheStats{
+		Hits:      c.stats.hits,
+		Misses:    c.stats.misses,
+		Evictions: c.stats.evictions,
+		SizeBytes: c.size,
+	}
+}
+
+func copyFile(src, dest string, mode os.FileMode) error {
+	r, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	w, err := os.OpenFile(dest, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(w, r); err != nil {
+		_ = w.Close()
+		return err
+	}
+	return w.Close()
+}
@@ -0,0 +1,65 @@
+package runner
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/internal/sha256"
+)
+
+func TestArtefactCache(t *testing.T) {
+	cacheDir := t.TempDir()
+	downloads := t.TempDir()
+	artefact := func(content string) *ftlv1.DeploymentArtefact {
+		path := filepath.Join(downloads, content)
+		assert.NoError(t, os.WriteFile(path, []byte(content), 0600))
+		return &ftlv1.DeploymentArtefact{Digest: sha256.Sum([]byte(content)).String(), Path: content, Executable: true}
+	}
+	a, b, c := artefact("aaaa"), artefact("bbbb"), artefact("cccc")
+
+	cache, err := newArtefactCache(cacheDir, 8)
+	assert.NoError(t, err)
+	dest := filepath.Join(t.TempDir(), "main")
+	ok, err := cache.Get(a, dest)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	assert.NoError(t, cache.Put(a, filepath.Join(downloads, a.Path)))
+	time.Sleep(time.Millisecond * 10)
+	assert.NoError(t, cache.Put(b, filepath.Join(downloads, b.Path)))
+	time.Sleep(time.Millisecond * 10)
+	ok, err = cache.Get(a, dest)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	content, err := os.ReadFile(dest)
+	assert.NoError(t, err)
+	assert.Equal(t, "aaaa", string(content))
+	info, err := os.Stat(dest)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0700), info.Mode().Perm())
+
+	// "b" is the least recently used, so it is evicted to make room for "c".
+	assert.NoError(t, cache.Put(c, filepath.Join(downloads, c.Path)))
+	ok, err = cache.Get(b, dest)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.Equal(t, &ftlv1.ArtefactCacheStats{Hits: 1, Misses: 2, Evictions: 1, SizeBytes: 8}, cache.proto())
+
+	// The cache persists when the runner restarts.
+	assert.NoError(t, os.WriteFile(filepath.Join(cacheDir, c.Digest+".tmp"), []byte("partial"), 0600))
+	cache, err = newArtefactCache(cacheDir, 4)
+	assert.NoError(t, err)
+	ok, err = cache.Get(c, dest)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = cache.Get(a, dest)
+	assert.NoError(t, err)
+	assert.False(t, ok, "the least recently used artefact should be evicted when the cache shrinks")
+	_, err = os.Stat(filepath.Join(cacheDir, c.Digest+".tmp"))
+	assert.True(t, os.IsNotExist(err), "partially written artefacts should be removed")
+}
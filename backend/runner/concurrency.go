@@ -0,0 +1,80 @@
+package runner
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/rpc/headers"
+)
+
+// concurrencyLimiter limits the number of concurrent calls to a verb declared
+// with "+concurrency".
+//
+// Calls beyond the limit wait for a free slot, and calls beyond the queue
+// length are rejected.
+type concurrencyLimiter struct {
+	// Calls that are executing or waiting to execute.
+	admitted chan struct{}
+	// Calls that are executing.
+	running chan struct{}
+}
+
+func newConcurrencyLimiter(limit *schema.MetadataConcurrency) *concurrencyLimiter {
+	return &concurrencyLimiter{
+		admitted: make(chan struct{}, limit.Max+limit.QueueLength()),
+		running:  make(chan struct{}, limit.Max),
+	}
+}
+
+// concurrencyLimiters returns a limiter for each verb in "module" with a concurrency limit, keyed by verb name.
+func concurrencyLimiters(module *schema.Module) map[string]*concurrencyLimiter {
+	out := map[string]*concurrencyLimiter{}
+	for _, decl := range module.Decls {
+		verb, ok := decl.(*schema.Verb)
+		if !ok {
+			continue
+		}
+		if limit, ok := verb.GetMetadataConcurrency().Get(); ok {
+			out[verb.Name] = newConcurrencyLimiter(limit)
+		}
+	}
+	return out
+}
+
+// acquire waits for a slot to execute a call to "verb", returning a function
+// that releases the slot once the call completes.
+//
+// Returns an error with [headers.OverloadedHeader] set if the queue is full.
+func (l *concurrencyLimiter) acquire(ctx context.Context, verb *schemapb.Ref) (release func(), err error) {
+	select {
+	case l.admitted <- struct{}{}:
+	default:
+		err := connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("%s.%s is overloaded: %d calls are executing and %d are queued", verb.Module, verb.Name, cap(l.running), cap(l.admitted)-cap(l.running)))
+		headers.SetOverloaded(err.Meta())
+		return nil, err
+	}
+	select {
+	case l.running <- struct{}{}:
+		return func() {
+			<-l.running
+			<-l.admitted
+		}, nil
+
+	case <-ctx.Done():
+		<-l.admitted
+		return nil, connect.NewError(connect.CodeCanceled, fmt.Errorf("%s.%s: cancelled while queued: %w", verb.Module, verb.Name, context.Cause(ctx)))
+	}
+}
+
+// acquire a slot to execute a call to "verb", if it has a concurrency limit.
+func (d *deployment) acquire(ctx context.Context, verb *schemapb.Ref) (release func(), err error) {
+	limiter, ok := d.limiters[verb.Name]
+	if !ok {
+		return func() {}, nil
+	}
+	return limiter.acquire(ctx, verb)
+}
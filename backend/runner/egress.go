@@ -0,0 +1,176 @@
+package runner
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+// EgressPolicy determines how HTTP requests from modules to origins that none
+// of their verbs declare with "+egress" are treated.
+type EgressPolicy string
+
+const (
+	// EgressEnforce rejects requests to undeclared origins.
+	EgressEnforce EgressPolicy = "enforce"
+	// EgressAudit logs requests to undeclared origins, but allows them.
+	EgressAudit EgressPolicy = "audit"
+	// EgressOff allows requests to any origin.
+	EgressOff EgressPolicy = "off"
+)
+
+// egressProxy is an HTTP proxy that only forwards the requests of a module to
+// the origins its verbs declare with "+egress".
+//
+// Modules are pointed at the proxy with the standard HTTP_PROXY and
+// HTTPS_PROXY environment variables. HTTPS requests are tunnelled, so only
+// their host and port can be checked.
+type egressProxy struct {
+	module   string
+	policy   EgressPolicy
+	origins  []schema.EgressOrigin
+	logger   *log.Logger
+	forward  *httputil.ReverseProxy
+	server   *http.Server
+	listener net.Listener
+}
+
+// startEgressProxy starts a proxy for the requests of "module" on a local
+// port, until it is closed.
+func startEgressProxy(logger *log.Logger, module *schema.Module, policy EgressPolicy) (*egressProxy, error) {
+	p := &egressProxy{
+		module: module.Name,
+		policy: policy,
+		logger: logger,
+		// The request is already in the absolute form sent to proxies.
+		forward: &httputil.ReverseProxy{Director: func(*http.Request) {}},
+	}
+	for origin := range module.Egress() {
+		parsed, err := schema.ParseEgressOrigin(origin)
+		if err != nil {
+			return nil, err
+		}
+		p.origins = append(p.origins, parsed)
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("failed to start egress proxy: %w", err)
+	}
+	p.listener = listener
+	p.server = &http.Server{Handler: p, ReadHeaderTimeout: 30 * time.Second}
+	go func() {
+		if err := p.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Errorf(err, "Egress proxy failed")
+		}
+	}()
+	return p, nil
+}
+
+// URL of the proxy.
+func (p *egressProxy) URL() *url.URL {
+	return &url.URL{Scheme: "http", Host: p.listener.Addr().String()}
+}
+
+// Envars returns the environment variables that direct the HTTP requests of a
+// module through the proxy, other than to FTL at "endpoint".
+func (p *egressProxy) Envars(endpoint *url.URL) []string {
+	proxy := p.URL().String()
+	noProxy := strings.Join([]string{"localhost", "127.0.0.1", "::1", endpoint.Hostname()}, ",")
+	return []string{
+		"HTTP_PROXY=" + proxy, "http_proxy=" + proxy,
+		"HTTPS_PROXY=" + proxy, "https_proxy=" + proxy,
+		"NO_PROXY=" + noProxy, "no_proxy=" + noProxy,
+	}
+}
+
+func (p *egressProxy) Close() error {
+	return p.server.Close()
+}
+
+func (p *egressProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodConnect {
+		host, port, err := net.SplitHostPort(r.Host)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid CONNECT target %q", r.Host), http.StatusBadRequest)
+			return
+		}
+		if p.check(w, "", host, port, r.Host) {
+			p.tunnel(w, r)
+		}
+		return
+	}
+	if !r.URL.IsAbs() {
+		http.Error(w, "this is an egress proxy", http.StatusBadRequest)
+		return
+	}
+	port := r.URL.Port()
+	if port == "" {
+		port = "80"
+		if r.URL.Scheme == "https" {
+			port = "443"
+		}
+	}
+	if p.check(w, r.URL.Scheme, r.URL.Hostname(), port, r.URL.Scheme+"://"+r.URL.Host) {
+		p.forward.ServeHTTP(w, r)
+	}
+}
+
+// check returns true if requests to "target" are allowed, otherwise
+// rejecting the request.
+func (p *egressProxy) check(w http.ResponseWriter, scheme, host, port, target string) bool {
+	for _, origin := range p.origins {
+		if origin.Matches(scheme, host, port) {
+			return true
+		}
+	}
+	if p.policy == EgressAudit {
+		p.logger.Warnf("Module %s made an HTTP request to %s, which none of its verbs declare with +egress", p.module, target)
+		return true
+	}
+	p.logger.Warnf("Rejected HTTP request from module %s to %s, which none of its verbs declare with +egress", p.module, target)
+	http.Error(w, fmt.Sprintf("egress to %s is not declared by module %s", target, p.module), http.StatusForbidden)
+	return false
+}
+
+// tunnel relays the connection of a CONNECT request to its target.
+func (p *egressProxy) tunnel(w http.ResponseWriter, r *http.Request) {
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		http.Error(w, "CONNECT is not supported", http.StatusInternalServerError)
+		return
+	}
+	upstream, err := net.DialTimeout("tcp", r.Host, 30*time.Second)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer upstream.Close()
+	conn, buffered, err := hijacker.Hijack()
+	if err != nil {
+		p.logger.Errorf(err, "Failed to tunnel to %s", r.Host)
+		return
+	}
+	defer conn.Close()
+	if _, err := conn.Write([]byte("HTTP/1.1 200 Connection established\r\n\r\n")); err != nil {
+		return
+	}
+	done := make(chan struct{}, 2)
+	go func() {
+		_, _ = io.Copy(upstream, buffered)
+		done <- struct{}{}
+	}()
+	go func() {
+		_, _ = io.Copy(conn, upstream)
+		done <- struct{}{}
+	}()
+	<-done
+}
@@ -0,0 +1,72 @@
+package runner
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+func TestEgressProxy(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	})
+	declared := httptest.NewServer(handler)
+	t.Cleanup(declared.Close)
+	declaredTLS := httptest.NewTLSServer(handler)
+	t.Cleanup(declaredTLS.Close)
+	undeclared := httptest.NewServer(handler)
+	t.Cleanup(undeclared.Close)
+	undeclaredTLS := httptest.NewTLSServer(handler)
+	t.Cleanup(undeclaredTLS.Close)
+
+	module := &schema.Module{Name: "payments", Decls: []schema.Decl{
+		&schema.Verb{Name: "charge", Request: &schema.Unit{}, Response: &schema.Unit{}, Metadata: []schema.Metadata{
+			&schema.MetadataEgress{URLs: []string{declared.URL, declaredTLS.URL}},
+		}},
+	}}
+	logger := log.FromContext(log.ContextWithNewDefaultLogger(context.Background()))
+
+	get := func(t *testing.T, proxy *egressProxy, url string) int {
+		t.Helper()
+		client := &http.Client{Transport: &http.Transport{
+			Proxy:           http.ProxyURL(proxy.URL()),
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, //nolint:gosec
+		}}
+		resp, err := client.Get(url)
+		if err != nil {
+			// Rejected CONNECT requests fail rather than returning the response.
+			assert.Contains(t, err.Error(), "Forbidden")
+			return http.StatusForbidden
+		}
+		defer resp.Body.Close()
+		_, err = io.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		return resp.StatusCode
+	}
+
+	t.Run("Enforce", func(t *testing.T) {
+		proxy, err := startEgressProxy(logger, module, EgressEnforce)
+		assert.NoError(t, err)
+		t.Cleanup(func() { _ = proxy.Close() })
+		assert.Equal(t, http.StatusOK, get(t, proxy, declared.URL))
+		assert.Equal(t, http.StatusOK, get(t, proxy, declaredTLS.URL))
+		assert.Equal(t, http.StatusForbidden, get(t, proxy, undeclared.URL))
+		assert.Equal(t, http.StatusForbidden, get(t, proxy, undeclaredTLS.URL))
+	})
+
+	t.Run("Audit", func(t *testing.T) {
+		proxy, err := startEgressProxy(logger, module, EgressAudit)
+		assert.NoError(t, err)
+		t.Cleanup(func() { _ = proxy.Close() })
+		assert.Equal(t, http.StatusOK, get(t, proxy, undeclared.URL))
+		assert.Equal(t, http.StatusOK, get(t, proxy, undeclaredTLS.URL))
+	})
+}
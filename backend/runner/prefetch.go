@@ -0,0 +1,235 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/otiai10/copy"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/internal/download"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/model"
+	"github.com/TBD54566975/ftl/internal/slices"
+)
+
+// prefetcher downloads the artefacts of deployments that are likely to be
+// scaled up while the runner is idle, so that deploying one of them doesn't
+// have to wait for its artefacts to download.
+//
+// Each prefetched deployment is a complete deployment directory in "dir",
+// which is moved into place by [prefetcher.take].
+type prefetcher struct {
+	dir         string
+	templateDir string
+	client      ftlv1connect.ControllerServiceClient
+	// Nil if artefacts are not cached.
+	cache download.Cache
+
+	lock sync.Mutex
+	// Set while the runner is deploying or assigned.
+	paused bool
+	// Prefetch in progress, if any.
+	current *prefetch
+}
+
+type prefetch struct {
+	key    model.DeploymentKey
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newPrefetcher(dir, templateDir string, client ftlv1connect.ControllerServiceClient, cache download.Cache) (*prefetcher, error) {
+	// Anything left over from a previous run may be incomplete.
+	if err := os.RemoveAll(dir); err != nil {
+		return nil, fmt.Errorf("failed to clear prefetched deployments: %w", err)
+	}
+	return &prefetcher{dir: dir, templateDir: templateDir, client: client, cache: cache}, nil
+}
+
+// sync prefetches the deployments in "keys", in order, and removes any other
+// prefetched deployments.
+func (p *prefetcher) sync(ctx context.Context, keys []model.DeploymentKey) error {
+	if err := p.prune(keys); err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := p.fetch(ctx, key); err != nil {
+			return fmt.Errorf("failed to prefetch %s: %w", key, err)
+		}
+	}
+	return nil
+}
+
+func (p *prefetcher) prune(keys []model.DeploymentKey) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.paused {
+		return nil
+	}
+	wanted := map[string]bool{}
+	for _, key := range keys {
+		wanted[key.String()] = true
+	}
+	entries, err := os.ReadDir(p.dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to read prefetched deployments: %w", err)
+	}
+	for _, entry := range entries {
+		if wanted[entry.Name()] {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(p.dir, entry.Name())); err != nil {
+			return fmt.Errorf("failed to remove prefetched deployment: %w", err)
+		}
+	}
+	return nil
+}
+
+func (p *prefetcher) fetch(ctx context.Context, key model.DeploymentKey) error {
+	prefetched := filepath.Join(p.dir, key.String())
+	if _, err := os.Stat(prefetched); err == nil {
+		return nil
+	}
+	p.lock.Lock()
+	if p.paused {
+		p.lock.Unlock()
+		return nil
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	current := &prefetch{key: key, cancel: cancel, done: make(chan struct{})}
+	p.current = current
+	p.lock.Unlock()
+	defer func() {
+		cancel()
+		p.lock.Lock()
+		p.current = nil
+		p.lock.Unlock()
+		close(current.done)
+	}()
+
+	log.FromContext(ctx).Debugf("Prefetching artefacts for %s", key)
+	partial := prefetched + ".partial"
+	if err := os.RemoveAll(partial); err != nil {
+		return err
+	}
+	if err := createDeploymentDir(p.templateDir, partial); err != nil {
+		return err
+	}
+	if err := download.CachedArtefacts(ctx, p.client, key, partial, p.cache); err != nil {
+		_ = os.RemoveAll(partial)
+		if ctx.Err() != nil {
+			// Cancelled in favour of deploying something else.
+			return nil
+		}
+		return err
+	}
+	return os.Rename(partial, prefetched)
+}
+
+// take moves the prefetched deployment directory for "key" to "dest",
+// returning false if it has not been prefetched.
+//
+// Prefetching is paused until [prefetcher.resume] is called. If "key" is
+// being prefetched take waits for it to complete, otherwise any prefetch in
+// progress is cancelled.
+func (p *prefetcher) take(key model.DeploymentKey, dest string) (bool, error) {
+	p.lock.Lock()
+	p.paused = true
+	current := p.current
+	p.lock.Unlock()
+	if current != nil {
+		if !current.key.Equal(key) {
+			current.cancel()
+		}
+		<-current.done
+	}
+
+	prefetched := filepath.Join(p.dir, key.String())
+	if _, err := os.Stat(prefetched); errors.Is(err, os.ErrNotExist) {
+		return false, p.clear()
+	} else if err != nil {
+		return false, err
+	}
+	if err := os.MkdirAll(filepath.Dir(dest), 0700); err != nil {
+		return false, err
+	}
+	if err := os.RemoveAll(dest); err != nil {
+		return false, err
+	}
+	if err := os.Rename(prefetched, dest); err != nil {
+		return false, err
+	}
+	return true, p.clear()
+}
+
+// clear removes all prefetched deployments.
+func (p *prefetcher) clear() error {
+	if err := os.RemoveAll(p.dir); err != nil {
+		return fmt.Errorf("failed to clear prefetched deployments: %w", err)
+	}
+	return nil
+}
+
+// resume prefetching once the runner is idle again.
+func (p *prefetcher) resume() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.paused = false
+}
+
+// prefetchLoop periodically prefetches artefacts for the deployments the
+// Controller expects to be scaled up, while the runner is idle.
+func (s *Service) prefetchLoop(ctx context.Context) {
+	logger := log.FromContext(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(s.config.PrefetchInterval):
+		}
+		if s.state.Load() != ftlv1.RunnerState_RUNNER_IDLE {
+			continue
+		}
+		resp, err := s.controllerClient.GetPrefetchCandidates(ctx, connect.NewRequest(&ftlv1.GetPrefetchCandidatesRequest{
+			Languages: s.config.Language,
+			Limit:     int32(s.config.PrefetchLimit),
+		}))
+		if err != nil {
+			logger.Debugf("Failed to get deployments to prefetch: %s", err)
+			continue
+		}
+		keys, err := slices.MapErr(resp.Msg.DeploymentKeys, model.ParseDeploymentKey)
+		if err != nil {
+			logger.Warnf("Invalid deployment to prefetch: %s", err)
+			continue
+		}
+		if err := s.prefetcher.sync(ctx, keys); err != nil {
+			logger.Warnf("%s", err)
+		}
+	}
+}
+
+// createDeploymentDir creates "dir", copying the template directory into it
+// if there is one.
+func createDeploymentDir(templateDir, dir string) error {
+	if templateDir != "" {
+		if err := copy.Copy(templateDir, dir); err != nil {
+			return fmt.Errorf("failed to copy template directory: %w", err)
+		}
+		return nil
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("failed to create deployment directory: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package runner
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/assert/v2"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/model"
+	"github.com/TBD54566975/ftl/internal/rpc"
+	"github.com/TBD54566975/ftl/internal/sha256"
+)
+
+type artefactController struct {
+	ftlv1connect.UnimplementedControllerServiceHandler
+	downloads map[string]int
+}
+
+func (a *artefactController) Ping(ctx context.Context, req *connect.Request[ftlv1.PingRequest]) (*connect.Response[ftlv1.PingResponse], error) {
+	return connect.NewResponse(&ftlv1.PingResponse{}), nil
+}
+
+func (a *artefactController) GetDeploymentArtefacts(ctx context.Context, req *connect.Request[ftlv1.GetDeploymentArtefactsRequest], resp *connect.ServerStream[ftlv1.GetDeploymentArtefactsResponse]) error {
+	a.downloads[req.Msg.DeploymentKey]++
+	return resp.Send(&ftlv1.GetDeploymentArtefactsResponse{
+		Artefact: &ftlv1.DeploymentArtefact{Digest: sha256.Sum([]byte(req.Msg.DeploymentKey)).String(), Path: "main", Executable: true},
+		Chunk:    []byte(req.Msg.DeploymentKey),
+	})
+}
+
+func TestPrefetcher(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	controller := &artefactController{downloads: map[string]int{}}
+	mux := http.NewServeMux()
+	mux.Handle(ftlv1connect.NewControllerServiceHandler(controller))
+	server := httptest.NewServer(h2c.NewHandler(mux, &http2.Server{}))
+	t.Cleanup(server.Close)
+	client := rpc.Dial(ftlv1connect.NewControllerServiceClient, server.URL, log.Error)
+
+	deploymentDir := t.TempDir()
+	p, err := newPrefetcher(filepath.Join(deploymentDir, prefetchDir), "", client, nil)
+	assert.NoError(t, err)
+
+	a := model.NewDeploymentKey("a")
+	b := model.NewDeploymentKey("b")
+	assert.NoError(t, p.sync(ctx, []model.DeploymentKey{a, b}))
+	assert.NoError(t, p.sync(ctx, []model.DeploymentKey{a}))
+	assert.Equal(t, map[string]int{a.String(): 1, b.String(): 1}, controller.downloads)
+	_, err = os.Stat(filepath.Join(deploymentDir, prefetchDir, b.String()))
+	assert.True(t, os.IsNotExist(err), "deployments that are no longer candidates should be removed")
+
+	dest := filepath.Join(deploymentDir, "a", a.String())
+	prefetched, err := p.take(a, dest)
+	assert.NoError(t, err)
+	assert.True(t, prefetched)
+	content, err := os.ReadFile(filepath.Join(dest, "main"))
+	assert.NoError(t, err)
+	assert.Equal(t, a.String(), string(content))
+
+	// Prefetching is paused until resumed.
+	assert.NoError(t, p.sync(ctx, []model.DeploymentKey{b}))
+	prefetched, err = p.take(b, filepath.Join(deploymentDir, "b", b.String()))
+	assert.NoError(t, err)
+	assert.False(t, prefetched)
+
+	p.resume()
+	assert.NoError(t, p.sync(ctx, []model.DeploymentKey{b}))
+	prefetched, err = p.take(b, filepath.Join(deploymentDir, "b", b.String()))
+	assert.NoError(t, err)
+	assert.True(t, prefetched)
+}
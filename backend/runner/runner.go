@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"net/http"
 	"net/url"
 	"os"
 	"path/filepath"
@@ -21,17 +22,23 @@ import (
 	"github.com/alecthomas/atomic"
 	"github.com/alecthomas/types/optional"
 	"github.com/jpillora/backoff"
-	"github.com/otiai10/copy"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
 	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
 	"github.com/TBD54566975/ftl/backend/schema"
 	"github.com/TBD54566975/ftl/common/plugin"
 	"github.com/TBD54566975/ftl/internal/download"
 	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/metrics"
 	"github.com/TBD54566975/ftl/internal/model"
+	"github.com/TBD54566975/ftl/internal/modulecontext"
 	"github.com/TBD54566975/ftl/internal/rpc"
 	"github.com/TBD54566975/ftl/internal/slices"
 	"github.com/TBD54566975/ftl/internal/unstoppable"
@@ -43,12 +50,18 @@ type Config struct {
 	Advertise             *url.URL        `help:"Endpoint the Runner should advertise (use --bind if omitted)." default:"" env:"FTL_RUNNER_ADVERTISE"`
 	Key                   model.RunnerKey `help:"Runner key (auto)."`
 	ControllerEndpoint    *url.URL        `name:"ftl-endpoint" help:"Controller endpoint." env:"FTL_ENDPOINT" default:"http://localhost:8892"`
+	FailoverEndpoints     []*url.URL      `name:"ftl-failover-endpoints" help:"Controller endpoints to fail over to if the controller endpoint is unavailable." env:"FTL_FAILOVER_ENDPOINTS" placeholder:"URL"`
 	TemplateDir           string          `help:"Template directory to copy into each deployment, if any." type:"existingdir"`
 	DeploymentDir         string          `help:"Directory to store deployments in." default:"${deploymentdir}"`
 	DeploymentKeepHistory int             `help:"Number of deployments to keep history for." default:"3"`
 	Language              []string        `short:"l" help:"Languages the runner supports." env:"FTL_LANGUAGE" default:"go,kotlin"`
 	HeartbeatPeriod       time.Duration   `help:"Minimum period between heartbeats." default:"3s"`
 	HeartbeatJitter       time.Duration   `help:"Jitter to add to heartbeat period." default:"2s"`
+	PrefetchLimit         int             `help:"Maximum number of deployments to prefetch artefacts for while idle (0 to disable)." default:"2" env:"FTL_RUNNER_PREFETCH_LIMIT"`
+	PrefetchInterval      time.Duration   `help:"Period between checks for deployments to prefetch artefacts for." default:"10s"`
+	ArtefactCacheSize     int64           `help:"Maximum size in bytes of the local cache of downloaded artefacts (0 to disable)." default:"1073741824" env:"FTL_RUNNER_ARTEFACT_CACHE_SIZE"`
+	Project               string          `help:"Only run deployments from this project, rather than from any project." env:"FTL_RUNNER_PROJECT"`
+	EgressPolicy          EgressPolicy    `help:"How to treat HTTP requests from modules to origins their verbs don't declare with +egress (${enum})." enum:"enforce,audit,off" default:"enforce" env:"FTL_RUNNER_EGRESS_POLICY"`
 }
 
 func Start(ctx context.Context, config Config) error {
@@ -61,7 +74,8 @@ func Start(ctx context.Context, config Config) error {
 	}
 	pid := os.Getpid()
 
-	client := rpc.Dial(ftlv1connect.NewVerbServiceClient, config.ControllerEndpoint.String(), log.Error)
+	controllerEndpoints := rpc.EndpointURLs(config.ControllerEndpoint, config.FailoverEndpoints)
+	client := rpc.DialFailover(ctx, ftlv1connect.NewVerbServiceClient, controllerEndpoints, log.Error)
 	ctx = rpc.ContextWithClient(ctx, client)
 
 	logger := log.FromContext(ctx).Attrs(map[string]string{"runner": config.Key.String()})
@@ -75,19 +89,23 @@ func Start(ctx context.Context, config Config) error {
 	logger.Debugf("Using FTL endpoint: %s", config.ControllerEndpoint)
 	logger.Debugf("Listening on %s", config.Bind)
 
-	controllerClient := rpc.Dial(ftlv1connect.NewControllerServiceClient, config.ControllerEndpoint.String(), log.Error)
+	controllerClient := rpc.DialFailover(ctx, ftlv1connect.NewControllerServiceClient, controllerEndpoints, log.Error)
 
 	key := config.Key
 	if key.IsZero() {
 		key = model.NewRunnerKey(config.Bind.Hostname(), config.Bind.Port())
 	}
-	labels, err := structpb.NewStruct(map[string]any{
+	labelMap := map[string]any{
 		"hostname":  hostname,
 		"pid":       pid,
 		"os":        runtime.GOOS,
 		"arch":      runtime.GOARCH,
 		"languages": slices.Map(config.Language, func(t string) any { return t }),
-	})
+	}
+	if config.Project != "" {
+		labelMap[model.ProjectLabel] = config.Project
+	}
+	labels, err := structpb.NewStruct(labelMap)
 	if err != nil {
 		return fmt.Errorf("failed to marshal labels: %w", err)
 	}
@@ -99,15 +117,31 @@ func Start(ctx context.Context, config Config) error {
 		forceUpdate:        make(chan struct{}, 16),
 		labels:             labels,
 		deploymentLogQueue: make(chan log.Entry, 10000),
+		moduleContexts:     modulecontext.NewModuleContextSupplier(client),
+		logLevel:           &log.LevelOverride{},
 	}
 	svc.state.Store(ftlv1.RunnerState_RUNNER_IDLE)
+	if config.ArtefactCacheSize > 0 {
+		svc.artefactCache, err = newArtefactCache(filepath.Join(config.DeploymentDir, artefactCacheDir), config.ArtefactCacheSize)
+		if err != nil {
+			return err
+		}
+	}
+	svc.prefetcher, err = newPrefetcher(filepath.Join(config.DeploymentDir, prefetchDir), config.TemplateDir, controllerClient, svc.downloadCache())
+	if err != nil {
+		return err
+	}
 
 	go rpc.RetryStreamingClientStream(ctx, backoff.Backoff{}, controllerClient.RegisterRunner, svc.registrationLoop)
 	go rpc.RetryStreamingClientStream(ctx, backoff.Backoff{}, controllerClient.StreamDeploymentLogs, svc.streamLogsLoop)
+	if config.PrefetchLimit > 0 {
+		go svc.prefetchLoop(ctx)
+	}
 
 	return rpc.Serve(ctx, config.Bind,
 		rpc.GRPC(ftlv1connect.NewVerbServiceHandler, svc),
 		rpc.GRPC(ftlv1connect.NewRunnerServiceHandler, svc),
+		rpc.HTTP(metrics.Path, metrics.JSONHandler(svc.scrapeMetrics)),
 	)
 }
 
@@ -126,7 +160,7 @@ func manageDeploymentDirectory(logger *log.Logger, config Config) error {
 	}
 
 	for _, module := range modules {
-		if !module.IsDir() {
+		if !module.IsDir() || module.Name() == prefetchDir || module.Name() == artefactCacheDir {
 			continue
 		}
 
@@ -166,14 +200,59 @@ func manageDeploymentDirectory(logger *log.Logger, config Config) error {
 	return nil
 }
 
+// Directory within the deployment directory that prefetched deployments are kept in.
+const prefetchDir = ".prefetch"
+
 var _ ftlv1connect.RunnerServiceHandler = (*Service)(nil)
 var _ ftlv1connect.VerbServiceHandler = (*Service)(nil)
 
+var tracer = otel.Tracer("ftl.runner")
+
 type deployment struct {
 	key    model.DeploymentKey
 	plugin *plugin.Plugin[ftlv1connect.VerbServiceClient]
 	// Cancelled when plugin terminates
 	ctx context.Context
+	// Limiters for verbs with a concurrency limit, keyed by verb name.
+	limiters map[string]*concurrencyLimiter
+
+	callsLock sync.Mutex
+	// Set when the deployment is being terminated, after which new calls are rejected.
+	draining bool
+	calls    sync.WaitGroup
+}
+
+// startCall records an in-flight call, returning a function to call when it completes.
+//
+// Returns an error if the deployment is draining.
+func (d *deployment) startCall() (done func(), err error) {
+	d.callsLock.Lock()
+	defer d.callsLock.Unlock()
+	if d.draining {
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("deployment %s is draining", d.key))
+	}
+	d.calls.Add(1)
+	return d.calls.Done, nil
+}
+
+// drain rejects new calls and waits up to "timeout" for in-flight calls to complete.
+//
+// Returns false if calls were still in flight after "timeout".
+func (d *deployment) drain(timeout time.Duration) bool {
+	d.callsLock.Lock()
+	d.draining = true
+	d.callsLock.Unlock()
+	drained := make(chan struct{})
+	go func() {
+		d.calls.Wait()
+		close(drained)
+	}()
+	select {
+	case <-drained:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
 }
 
 type Service struct {
@@ -189,6 +268,12 @@ type Service struct {
 	registrationFailure atomic.Value[optional.Option[error]]
 	labels              *structpb.Struct
 	deploymentLogQueue  chan log.Entry
+	prefetcher          *prefetcher
+	// Nil if the cache is disabled.
+	artefactCache  *artefactCache
+	moduleContexts modulecontext.ModuleContextSupplier
+	// Log level override of the current deployment.
+	logLevel *log.LevelOverride
 }
 
 func (s *Service) Call(ctx context.Context, req *connect.Request[ftlv1.CallRequest]) (*connect.Response[ftlv1.CallResponse], error) {
@@ -196,8 +281,76 @@ func (s *Service) Call(ctx context.Context, req *connect.Request[ftlv1.CallReque
 	if !ok {
 		return nil, connect.NewError(connect.CodeUnavailable, errors.New("no deployment"))
 	}
+	done, err := deployment.startCall()
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+	release, err := deployment.acquire(ctx, req.Msg.Verb)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	ctx, span := startVerbSpan(ctx, deployment, req.Msg.Verb)
+	defer span.End()
 	response, err := deployment.plugin.Client.Call(ctx, req)
-	return connect.NewResponse(response.Msg), err
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	if callError := response.Msg.GetError(); callError != nil {
+		span.SetStatus(codes.Error, callError.Message)
+	}
+	return connect.NewResponse(response.Msg), nil
+}
+
+// startVerbSpan starts a span covering the execution of a verb by the deployment.
+func startVerbSpan(ctx context.Context, deployment *deployment, verb *schemapb.Ref) (context.Context, trace.Span) {
+	ref := schema.RefFromProto(verb).String()
+	return tracer.Start(ctx, "verb "+ref, trace.WithAttributes( //nolint:spancheck // Ended by the caller.
+		attribute.String("ftl.verb.ref", ref),
+		attribute.String("ftl.deployment.key", deployment.key.String()),
+	))
+}
+
+// scrapeMetrics returns the metrics recorded by the deployment, if any.
+func (s *Service) scrapeMetrics(ctx context.Context) ([]metrics.Sample, error) {
+	deployment, ok := s.deployment.Load().Get()
+	if !ok {
+		return nil, nil
+	}
+	return metrics.Scrape(ctx, http.DefaultClient, deployment.plugin.Endpoint.String())
+}
+
+func (s *Service) CallStream(ctx context.Context, req *connect.Request[ftlv1.CallRequest], stream *connect.ServerStream[ftlv1.CallResponse]) error {
+	deployment, ok := s.deployment.Load().Get()
+	if !ok {
+		return connect.NewError(connect.CodeUnavailable, errors.New("no deployment"))
+	}
+	done, err := deployment.startCall()
+	if err != nil {
+		return err
+	}
+	defer done()
+	release, err := deployment.acquire(ctx, req.Msg.Verb)
+	if err != nil {
+		return err
+	}
+	defer release()
+	ctx, span := startVerbSpan(ctx, deployment, req.Msg.Verb)
+	defer span.End()
+	upstream, err := deployment.plugin.Client.CallStream(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer upstream.Close()
+	for upstream.Receive() {
+		if err := stream.Send(upstream.Msg()); err != nil {
+			return err
+		}
+	}
+	return upstream.Err()
 }
 
 func (s *Service) Reserve(ctx context.Context, c *connect.Request[ftlv1.ReserveRequest]) (*connect.Response[ftlv1.ReserveResponse], error) {
@@ -227,6 +380,14 @@ func (s *Service) PublishEvent(context.Context, *connect.Request[ftlv1.PublishEv
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("topic events must be sent to the controller"))
 }
 
+func (s *Service) CallAsync(context.Context, *connect.Request[ftlv1.CallAsyncRequest]) (*connect.Response[ftlv1.CallAsyncResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("async calls must be made through the controller"))
+}
+
+func (s *Service) GetAsyncCallResult(context.Context, *connect.Request[ftlv1.GetAsyncCallResultRequest]) (*connect.Response[ftlv1.GetAsyncCallResultResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("async call results must be retrieved from the controller"))
+}
+
 func (s *Service) Deploy(ctx context.Context, req *connect.Request[ftlv1.DeployRequest]) (response *connect.Response[ftlv1.DeployResponse], err error) {
 	if err, ok := s.registrationFailure.Load().Get(); ok {
 		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("failed to register runner: %w", err))
@@ -257,6 +418,7 @@ func (s *Service) Deploy(ctx context.Context, req *connect.Request[ftlv1.DeployR
 		if err != nil {
 			setState(ftlv1.RunnerState_RUNNER_IDLE)
 			s.deployment.Store(optional.None[*deployment]())
+			s.prefetcher.resume()
 		}
 	}()
 
@@ -269,20 +431,37 @@ func (s *Service) Deploy(ctx context.Context, req *connect.Request[ftlv1.DeployR
 		return nil, fmt.Errorf("invalid module: %w", err)
 	}
 	deploymentDir := filepath.Join(s.config.DeploymentDir, module.Name, key.String())
-	if s.config.TemplateDir != "" {
-		err = copy.Copy(s.config.TemplateDir, deploymentDir)
-		if err != nil {
-			return nil, fmt.Errorf("failed to copy template directory: %w", err)
-		}
+	prefetched, err := s.prefetcher.take(key, deploymentDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to use prefetched artefacts: %w", err)
+	}
+	if prefetched {
+		log.FromContext(ctx).Debugf("Using prefetched artefacts")
 	} else {
-		err = os.MkdirAll(deploymentDir, 0700)
+		if err := createDeploymentDir(s.config.TemplateDir, deploymentDir); err != nil {
+			return nil, err
+		}
+		err = download.CachedArtefacts(ctx, s.controllerClient, key, deploymentDir, s.downloadCache())
 		if err != nil {
-			return nil, fmt.Errorf("failed to create deployment directory: %w", err)
+			return nil, fmt.Errorf("failed to download artefacts: %w", err)
 		}
 	}
-	err = download.Artefacts(ctx, s.controllerClient, key, deploymentDir)
-	if err != nil {
-		return nil, fmt.Errorf("failed to download artefacts: %w", err)
+
+	envars := []string{
+		"FTL_ENDPOINT=" + s.config.ControllerEndpoint.String(),
+		"FTL_CONFIG=" + strings.Join(s.config.Config, ","),
+		"FTL_OBSERVABILITY_ENDPOINT=" + s.config.ControllerEndpoint.String(),
+	}
+	if len(s.config.FailoverEndpoints) > 0 {
+		envars = append(envars, "FTL_FAILOVER_ENDPOINTS="+strings.Join(slices.Map(s.config.FailoverEndpoints, (*url.URL).String), ","))
+	}
+	var egress *egressProxy
+	if s.config.EgressPolicy != EgressOff {
+		egress, err = startEgressProxy(deploymentLogger, module, s.config.EgressPolicy)
+		if err != nil {
+			return nil, err
+		}
+		envars = append(envars, egress.Envars(s.config.ControllerEndpoint)...)
 	}
 
 	verbCtx := log.ContextWithLogger(ctx, deploymentLogger.Attrs(map[string]string{"module": module.Name}))
@@ -293,17 +472,24 @@ func (s *Service) Deploy(ctx context.Context, req *connect.Request[ftlv1.DeployR
 		deploymentDir,
 		"./main",
 		ftlv1connect.NewVerbServiceClient,
-		plugin.WithEnvars(
-			"FTL_ENDPOINT="+s.config.ControllerEndpoint.String(),
-			"FTL_CONFIG="+strings.Join(s.config.Config, ","),
-			"FTL_OBSERVABILITY_ENDPOINT="+s.config.ControllerEndpoint.String(),
-		),
+		plugin.WithEnvars(envars...),
 	)
 	if err != nil {
+		if egress != nil {
+			_ = egress.Close()
+		}
 		return nil, fmt.Errorf("failed to spawn plugin: %w", err)
 	}
+	if egress != nil {
+		go func() {
+			<-cmdCtx.Done()
+			_ = egress.Close()
+		}()
+	}
+
+	s.followLogLevel(cmdCtx, module.Name)
 
-	dep := s.makeDeployment(cmdCtx, key, deployment)
+	dep := s.makeDeployment(cmdCtx, key, deployment, module)
 	s.deployment.Store(optional.Some(dep))
 
 	setState(ftlv1.RunnerState_RUNNER_ASSIGNED)
@@ -325,6 +511,14 @@ func (s *Service) Terminate(ctx context.Context, c *connect.Request[ftlv1.Termin
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("deployment key mismatch"))
 	}
 
+	if timeout := c.Msg.DrainTimeout; timeout != nil {
+		logger := s.getDeploymentLogger(ctx, depl.key)
+		logger.Debugf("Draining in-flight calls for up to %s", timeout.AsDuration())
+		if !depl.drain(timeout.AsDuration()) {
+			logger.Warnf("Terminating with calls still in flight after %s", timeout.AsDuration())
+		}
+	}
+
 	// Soft kill.
 	err = depl.plugin.Cmd.Kill(syscall.SIGTERM)
 	if err != nil {
@@ -342,6 +536,7 @@ func (s *Service) Terminate(ctx context.Context, c *connect.Request[ftlv1.Termin
 	}
 	s.deployment.Store(optional.None[*deployment]())
 	s.state.Store(ftlv1.RunnerState_RUNNER_IDLE)
+	s.prefetcher.resume()
 	return connect.NewResponse(&ftlv1.RegisterRunnerRequest{
 		Key:      s.key.String(),
 		Endpoint: s.config.Advertise.String(),
@@ -350,11 +545,21 @@ func (s *Service) Terminate(ctx context.Context, c *connect.Request[ftlv1.Termin
 	}), nil
 }
 
-func (s *Service) makeDeployment(ctx context.Context, key model.DeploymentKey, plugin *plugin.Plugin[ftlv1connect.VerbServiceClient]) *deployment {
+// downloadCache returns the artefact cache to download with, or nil if it is
+// disabled.
+func (s *Service) downloadCache() download.Cache {
+	if s.artefactCache == nil {
+		return nil
+	}
+	return s.artefactCache
+}
+
+func (s *Service) makeDeployment(ctx context.Context, key model.DeploymentKey, plugin *plugin.Plugin[ftlv1connect.VerbServiceClient], module *schema.Module) *deployment {
 	return &deployment{
-		ctx:    ctx,
-		key:    key,
-		plugin: plugin,
+		ctx:      ctx,
+		key:      key,
+		plugin:   plugin,
+		limiters: concurrencyLimiters(module),
 	}
 }
 
@@ -384,14 +589,20 @@ func (s *Service) registrationLoop(ctx context.Context, send func(request *ftlv1
 		s.state.Store(state)
 	}
 
+	var cacheStats *ftlv1.ArtefactCacheStats
+	if s.artefactCache != nil {
+		cacheStats = s.artefactCache.proto()
+	}
+
 	logger.Tracef("Registering with Controller as %s", state)
 	err := send(&ftlv1.RegisterRunnerRequest{
-		Key:        s.key.String(),
-		Endpoint:   s.config.Advertise.String(),
-		Labels:     s.labels,
-		Deployment: deploymentKey,
-		State:      state,
-		Error:      errPtr,
+		Key:           s.key.String(),
+		Endpoint:      s.config.Advertise.String(),
+		Labels:        s.labels,
+		Deployment:    deploymentKey,
+		State:         state,
+		Error:         errPtr,
+		ArtefactCache: cacheStats,
 	})
 	if err != nil {
 		s.registrationFailure.Store(optional.Some(err))
@@ -463,5 +674,17 @@ func (s *Service) getDeploymentLogger(ctx context.Context, deploymentKey model.D
 	}
 
 	sink := newDeploymentLogsSink(s.deploymentLogQueue)
-	return log.FromContext(ctx).AddSink(sink).Attrs(attrs)
+	return log.FromContext(ctx).LevelOverride(s.logLevel).AddSink(sink).Attrs(attrs)
+}
+
+// followLogLevel applies the log level override of "module" to the logs of
+// the deployment until "ctx" is cancelled, when the deployment exits.
+func (s *Service) followLogLevel(ctx context.Context, module string) {
+	s.moduleContexts.Subscribe(ctx, module, func(ctx context.Context, moduleContext modulecontext.ModuleContext) {
+		s.logLevel.Set(moduleContext.LogLevel())
+	})
+	go func() {
+		<-ctx.Done()
+		s.logLevel.Set(log.Default)
+	}()
 }
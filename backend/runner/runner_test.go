@@ -0,0 +1,43 @@
+package runner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+func TestDrain(t *testing.T) {
+	depl := &deployment{key: model.NewDeploymentKey("echo")}
+	done, err := depl.startCall()
+	assert.NoError(t, err)
+
+	// A call still in flight after the timeout fails the drain.
+	assert.False(t, depl.drain(10*time.Millisecond))
+
+	s := &Service{}
+	s.deployment.Store(optional.Some(depl))
+	_, err = s.Call(context.Background(), connect.NewRequest(&ftlv1.CallRequest{Verb: &schemapb.Ref{Module: "echo", Name: "echo"}}))
+	assert.Equal(t, connect.CodeUnavailable, connect.CodeOf(err))
+
+	done()
+	assert.True(t, depl.drain(time.Second))
+}
+
+func TestDrainWaitsForCalls(t *testing.T) {
+	depl := &deployment{key: model.NewDeploymentKey("echo")}
+	done, err := depl.startCall()
+	assert.NoError(t, err)
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		done()
+	}()
+	assert.True(t, depl.drain(time.Second))
+}
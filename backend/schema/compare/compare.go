@@ -0,0 +1,427 @@
+// Package compare computes the structural differences between two versions of
+// a schema, classifying each change as breaking or non-breaking for the
+// callers of a module's verbs and the consumers of its types.
+package compare
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"golang.org/x/exp/maps"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+// Kind of change.
+type Kind string
+
+const (
+	Added   Kind = "added"
+	Removed Kind = "removed"
+	Changed Kind = "changed"
+)
+
+// Change is a single difference between two schemas.
+type Change struct {
+	Kind Kind
+	// Path of the changed node, eg. "echo.EchoRequest.name".
+	Path string
+	// Description of the change, eg. "type changed from String to Int".
+	Description string
+	// Breaking is true if callers or consumers built against the previous
+	// schema may fail against the new schema.
+	Breaking bool
+}
+
+func (c Change) String() string {
+	symbol := map[Kind]string{Added: "+", Removed: "-", Changed: "~"}[c.Kind]
+	out := fmt.Sprintf("%s %s: %s", symbol, c.Path, c.Description)
+	if c.Breaking {
+		out += " (breaking)"
+	}
+	return out
+}
+
+// Diff is the list of changes between two schemas, in schema order.
+type Diff []Change
+
+// Breaking returns only the breaking changes.
+func (d Diff) Breaking() Diff {
+	out := Diff{}
+	for _, c := range d {
+		if c.Breaking {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// IsBreaking returns true if any change is breaking.
+func (d Diff) IsBreaking() bool {
+	return len(d.Breaking()) > 0
+}
+
+// String renders the diff with one change per line.
+func (d Diff) String() string {
+	w := &strings.Builder{}
+	for _, c := range d {
+		fmt.Fprintln(w, c)
+	}
+	return w.String()
+}
+
+// Schemas returns the differences between two schemas.
+func Schemas(before, after *schema.Schema) Diff {
+	beforeModules := map[string]*schema.Module{}
+	for _, m := range before.Modules {
+		beforeModules[m.Name] = m
+	}
+	afterModules := map[string]*schema.Module{}
+	for _, m := range after.Modules {
+		afterModules[m.Name] = m
+	}
+	d := &differ{}
+	for _, name := range sortedNames(beforeModules, afterModules) {
+		b, inBefore := beforeModules[name]
+		a, inAfter := afterModules[name]
+		switch {
+		case !inAfter:
+			d.add(Removed, name, true, "module removed")
+		case !inBefore:
+			d.add(Added, name, false, "module added")
+		default:
+			d.compareModules(b, a)
+		}
+	}
+	return d.changes
+}
+
+// Modules returns the differences between two versions of a module.
+func Modules(before, after *schema.Module) Diff {
+	d := &differ{}
+	d.compareModules(before, after)
+	return d.changes
+}
+
+type differ struct {
+	changes Diff
+}
+
+func (d *differ) add(kind Kind, path string, breaking bool, format string, args ...any) {
+	d.changes = append(d.changes, Change{
+		Kind:        kind,
+		Path:        path,
+		Description: fmt.Sprintf(format, args...),
+		Breaking:    breaking,
+	})
+}
+
+func (d *differ) compareModules(before, after *schema.Module) {
+	// Only changes to declarations visible outside the module can break
+	// other modules or external clients.
+	public := publicDecls(before)
+	beforeDecls := map[string]schema.Decl{}
+	for _, decl := range before.Decls {
+		beforeDecls[decl.GetName()] = decl
+	}
+	afterDecls := map[string]schema.Decl{}
+	for _, decl := range after.Decls {
+		afterDecls[decl.GetName()] = decl
+	}
+	for _, name := range sortedNames(beforeDecls, afterDecls) {
+		path := before.Name + "." + name
+		b, inBefore := beforeDecls[name]
+		a, inAfter := afterDecls[name]
+		switch {
+		case !inAfter:
+			d.add(Removed, path, public[name], "%s removed", typeName(b))
+		case !inBefore:
+			d.add(Added, path, false, "%s added", typeName(a))
+		default:
+			d.compareDecls(path, b, a, public[name])
+		}
+	}
+}
+
+func (d *differ) compareDecls(path string, before, after schema.Decl, public bool) {
+	if reflect.TypeOf(before) != reflect.TypeOf(after) {
+		d.add(Changed, path, public, "changed from %s to %s", typeName(before), typeName(after))
+		return
+	}
+	if before.IsExported() && !after.IsExported() {
+		d.add(Changed, path, true, "no longer exported")
+	} else if !before.IsExported() && after.IsExported() {
+		d.add(Changed, path, false, "now exported")
+	}
+	if isInternal(after) && !isInternal(before) && before.IsExported() {
+		d.add(Changed, path, true, "now internal to the project")
+	} else if isInternal(before) && !isInternal(after) && after.IsExported() {
+		d.add(Changed, path, false, "no longer internal to the project")
+	}
+	switch before := before.(type) {
+	case *schema.Data:
+		d.compareData(path, before, after.(*schema.Data), public) //nolint:forcetypeassert
+
+	case *schema.Verb:
+		d.compareVerbs(path, before, after.(*schema.Verb), public) //nolint:forcetypeassert
+
+	case *schema.Enum:
+		d.compareEnums(path, before, after.(*schema.Enum), public) //nolint:forcetypeassert
+
+	case *schema.TypeAlias:
+		d.compareTypes(path, "type", before.Type, after.(*schema.TypeAlias).Type, public) //nolint:forcetypeassert
+
+	case *schema.Topic:
+		d.compareTypes(path, "event", before.Event, after.(*schema.Topic).Event, public) //nolint:forcetypeassert
+
+	// Existing values of configuration and secrets may not decode as the new type.
+	case *schema.Config:
+		d.compareTypes(path, "type", before.Type, after.(*schema.Config).Type, true) //nolint:forcetypeassert
+
+	case *schema.Secret:
+		d.compareTypes(path, "type", before.Type, after.(*schema.Secret).Type, true) //nolint:forcetypeassert
+
+	default:
+		if before.String() != after.String() {
+			d.add(Changed, path, public, "%s changed", typeName(before))
+		}
+	}
+}
+
+func (d *differ) compareTypes(path, what string, before, after schema.Type, breaking bool) {
+	if before.String() != after.String() {
+		d.add(Changed, path, breaking, "%s changed from %s to %s", what, before, after)
+	}
+}
+
+func (d *differ) compareData(path string, before, after *schema.Data, public bool) {
+	beforeParams := typeParameters(before)
+	afterParams := typeParameters(after)
+	if beforeParams != afterParams {
+		d.add(Changed, path, public, "type parameters changed from %q to %q", beforeParams, afterParams)
+	}
+	if before.IsError() != after.IsError() {
+		if after.IsError() {
+			d.add(Changed, path, public, "now an error")
+		} else {
+			d.add(Changed, path, public, "no longer an error")
+		}
+	}
+	afterFields := map[string]*schema.Field{}
+	for _, field := range after.Fields {
+		afterFields[field.Name] = field
+	}
+	for _, b := range before.Fields {
+		fieldPath := path + "." + b.Name
+		a, ok := afterFields[b.Name]
+		if !ok {
+			d.add(Removed, fieldPath, public, "field removed")
+			continue
+		}
+		d.compareFields(fieldPath, b, a, public)
+	}
+	for _, a := range after.Fields {
+		if before.FieldByName(a.Name) != nil {
+			continue
+		}
+		// Callers built against the previous schema won't send a new
+		// required field.
+		if _, ok := a.Type.(*schema.Optional); ok {
+			d.add(Added, path+"."+a.Name, false, "optional field added")
+		} else {
+			d.add(Added, path+"."+a.Name, public, "required field added")
+		}
+	}
+}
+
+func (d *differ) compareFields(path string, before, after *schema.Field, public bool) {
+	d.compareTypes(path, "type", before.Type, after.Type, public)
+	beforeAlias := before.Alias(schema.AliasKindJSON).Default(before.Name)
+	afterAlias := after.Alias(schema.AliasKindJSON).Default(after.Name)
+	if beforeAlias != afterAlias {
+		d.add(Changed, path, public, "JSON alias changed from %q to %q", beforeAlias, afterAlias)
+	}
+	// Added constraints may reject values that were previously valid.
+	beforeConstraints := constraints(before)
+	afterConstraints := constraints(after)
+	for _, c := range afterConstraints {
+		if !slices.Contains(beforeConstraints, c) {
+			d.add(Added, path, public, "constraint %q added", c)
+		}
+	}
+	for _, c := range beforeConstraints {
+		if !slices.Contains(afterConstraints, c) {
+			d.add(Removed, path, false, "constraint %q removed", c)
+		}
+	}
+}
+
+func (d *differ) compareVerbs(path string, before, after *schema.Verb, public bool) {
+	d.compareTypes(path, "request", before.Request, after.Request, public)
+	d.compareTypes(path, "response", before.Response, after.Response, public)
+	if before.IsStream() != after.IsStream() {
+		if after.IsStream() {
+			d.add(Changed, path, public, "now streams responses")
+		} else {
+			d.add(Changed, path, public, "no longer streams responses")
+		}
+	}
+	// Metadata describes how a verb is deployed, which only affects callers
+	// when it is exposed over HTTP.
+	beforeMetadata := verbMetadata(before)
+	afterMetadata := verbMetadata(after)
+	for _, md := range beforeMetadata {
+		if !slices.Contains(afterMetadata, md) {
+			_, isIngress := findMetadata(before, md).(*schema.MetadataIngress)
+			d.add(Removed, path, isIngress, "metadata %q removed", md)
+		}
+	}
+	for _, md := range afterMetadata {
+		if !slices.Contains(beforeMetadata, md) {
+			d.add(Added, path, false, "metadata %q added", md)
+		}
+	}
+}
+
+func (d *differ) compareEnums(path string, before, after *schema.Enum, public bool) {
+	if before.Type != nil && after.Type != nil {
+		d.compareTypes(path, "type", before.Type, after.Type, public)
+	} else if (before.Type == nil) != (after.Type == nil) {
+		d.add(Changed, path, public, "changed from %s to %s", enumKind(before), enumKind(after))
+		return
+	}
+	afterVariants := map[string]*schema.EnumVariant{}
+	for _, v := range after.Variants {
+		afterVariants[v.Name] = v
+	}
+	beforeVariants := map[string]bool{}
+	for _, b := range before.Variants {
+		beforeVariants[b.Name] = true
+		variantPath := path + "." + b.Name
+		a, ok := afterVariants[b.Name]
+		if !ok {
+			d.add(Removed, variantPath, public, "variant removed")
+			continue
+		}
+		if b.Value.String() != a.Value.String() {
+			d.add(Changed, variantPath, public, "value changed from %s to %s", b.Value, a.Value)
+		}
+	}
+	for _, a := range after.Variants {
+		if !beforeVariants[a.Name] {
+			d.add(Added, path+"."+a.Name, false, "variant added")
+		}
+	}
+}
+
+// publicDecls returns the names of the declarations in a module that are
+// exported or exposed over HTTP, along with the declarations they reference.
+func publicDecls(module *schema.Module) map[string]bool {
+	public := map[string]bool{}
+	var mark func(decl schema.Decl)
+	mark = func(decl schema.Decl) {
+		if public[decl.GetName()] {
+			return
+		}
+		public[decl.GetName()] = true
+		_ = schema.Visit(decl, func(n schema.Node, next func() error) error { //nolint:errcheck
+			if ref, ok := n.(*schema.Ref); ok {
+				if resolved := module.Resolve(*ref); resolved != nil {
+					if decl, ok := resolved.Symbol.(schema.Decl); ok {
+						mark(decl)
+					}
+				}
+			}
+			return next()
+		})
+	}
+	for _, decl := range module.Decls {
+		if verb, ok := decl.(*schema.Verb); ok && verb.GetMetadataIngress().Ok() {
+			mark(decl)
+		} else if decl.IsExported() {
+			mark(decl)
+		}
+	}
+	return public
+}
+
+func typeParameters(data *schema.Data) string {
+	params := make([]string, len(data.TypeParameters))
+	for i, tp := range data.TypeParameters {
+		params[i] = tp.Name
+	}
+	return strings.Join(params, ", ")
+}
+
+func constraints(field *schema.Field) []string {
+	out := []string{}
+	for _, md := range field.Metadata {
+		if md, ok := md.(*schema.MetadataValidate); ok {
+			out = append(out, md.String())
+		}
+	}
+	return out
+}
+
+// verbMetadata returns the metadata of a verb, excluding "+stream" which is
+// compared separately.
+func verbMetadata(verb *schema.Verb) []string {
+	out := []string{}
+	for _, md := range verb.Metadata {
+		if _, ok := md.(*schema.MetadataStream); ok {
+			continue
+		}
+		out = append(out, strings.TrimSpace(md.String()))
+	}
+	return out
+}
+
+func findMetadata(verb *schema.Verb, str string) schema.Metadata {
+	for _, md := range verb.Metadata {
+		if strings.TrimSpace(md.String()) == str {
+			return md
+		}
+	}
+	return nil
+}
+
+func enumKind(enum *schema.Enum) string {
+	if enum.Type == nil {
+		return "type enum"
+	}
+	return "value enum"
+}
+
+// isInternal returns true if the declaration is only visible to modules in the
+// same project.
+func isInternal(decl schema.Decl) bool {
+	switch decl := decl.(type) {
+	case *schema.Verb:
+		return decl.Internal
+	case *schema.Data:
+		return decl.Internal
+	default:
+		return false
+	}
+}
+
+func typeName(decl schema.Decl) string {
+	if _, ok := decl.(*schema.TypeAlias); ok {
+		return "type alias"
+	}
+	return strings.ToLower(reflect.Indirect(reflect.ValueOf(decl)).Type().Name())
+}
+
+func sortedNames[T any](before, after map[string]T) []string {
+	names := maps.Keys(before)
+	for name := range after {
+		if _, ok := before[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,63 @@
+package compare
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+// Each directory in testdata contains a "before.schema" and an "after.schema",
+// and the expected diff between them in "diff.golden".
+func TestSchemas(t *testing.T) {
+	dirs, err := filepath.Glob("testdata/*")
+	assert.NoError(t, err)
+	for _, dir := range dirs {
+		t.Run(filepath.Base(dir), func(t *testing.T) {
+			before := parseSchema(t, filepath.Join(dir, "before.schema"))
+			after := parseSchema(t, filepath.Join(dir, "after.schema"))
+			actual := Schemas(before, after).String()
+			golden := filepath.Join(dir, "diff.golden")
+			if *update {
+				err := os.WriteFile(golden, []byte(actual), 0600)
+				assert.NoError(t, err)
+			}
+			expected, err := os.ReadFile(golden)
+			assert.NoError(t, err)
+			assert.Equal(t, string(expected), actual)
+		})
+	}
+}
+
+func TestSchemasUnchanged(t *testing.T) {
+	sch := parseSchema(t, "testdata/verbs/before.schema")
+	diff := Schemas(sch, sch)
+	assert.Equal(t, 0, len(diff))
+	assert.False(t, diff.IsBreaking())
+}
+
+func TestBreaking(t *testing.T) {
+	before := parseSchema(t, "testdata/data/before.schema")
+	after := parseSchema(t, "testdata/data/after.schema")
+	diff := Modules(before.Module("users").MustGet(), after.Module("users").MustGet())
+	assert.True(t, diff.IsBreaking())
+	for _, change := range diff.Breaking() {
+		assert.True(t, change.Breaking, "%s", change)
+	}
+	assert.True(t, len(diff.Breaking()) < len(diff))
+}
+
+func parseSchema(t *testing.T, path string) *schema.Schema {
+	t.Helper()
+	input, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	sch, err := schema.ParseString(path, string(input))
+	assert.NoError(t, err)
+	return sch
+}
@@ -8,6 +8,7 @@ import (
 
 	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
 	"github.com/TBD54566975/ftl/internal/reflect"
+	"github.com/TBD54566975/ftl/internal/slices"
 )
 
 // A Data structure.
@@ -16,6 +17,7 @@ type Data struct {
 
 	Comments       []string         `parser:"@Comment*" protobuf:"2"`
 	Export         bool             `parser:"@'export'?" protobuf:"3"`
+	Internal       bool             `parser:"@'internal'?" protobuf:"8"`
 	Name           string           `parser:"'data' @Ident" protobuf:"4"`
 	TypeParameters []*TypeParameter `parser:"( '<' @@ (',' @@)* '>' )?" protobuf:"5"`
 	Fields         []*Field         `parser:"'{' @@* '}'" protobuf:"6"`
@@ -121,9 +123,10 @@ func (d *Data) Monomorphise(ref *Ref) (*Data, error) {
 			IngressPathComponent, *IngressPathLiteral, *IngressPathParameter,
 			*Int, Metadata, *MetadataCalls, *MetadataDatabases, *MetadataRetry,
 			*MetadataIngress, *MetadataCronJob, *MetadataAlias, *Module,
-			*Schema, *String, *Time, Type, *TypeParameter, *Unit, *Verb, *Enum,
+			*Schema, *String, *Time, *Timestamp, *UUID, *Decimal, Type, *TypeParameter, *Unit, *Verb, *Enum,
 			*EnumVariant, Value, *IntValue, *StringValue, *TypeValue, Symbol,
-			Named, *FSM, *FSMTransition, *TypeAlias, *Topic, *Subscription, *MetadataSubscriber:
+			Named, *FSM, *FSMTransition, *TypeAlias, *Topic, *Subscription, *MetadataSubscriber,
+			*MetadataStream, *MetadataError, *MetadataConcurrency, *MetadataValidate, *MetadataDeprecated, *MetadataAllow, *MetadataCache, *MetadataSaga, *MetadataEgress, *MetadataResponse:
 		}
 		return next()
 	})
@@ -153,6 +156,12 @@ func (d *Data) schemaChildren() []Node {
 func (d *Data) GetName() string  { return d.Name }
 func (d *Data) IsExported() bool { return d.Export }
 
+// IsError returns true if the data structure is an error that can be returned by verbs.
+func (d *Data) IsError() bool {
+	_, ok := slices.FindVariant[*MetadataError](d.Metadata)
+	return ok
+}
+
 func (d *Data) String() string {
 	w := &strings.Builder{}
 	fmt.Fprint(w, EncodeComments(d.Comments))
@@ -170,6 +179,9 @@ func (d *Data) String() string {
 	if d.Export {
 		fmt.Fprint(w, "export ")
 	}
+	if d.Internal {
+		fmt.Fprint(w, "internal ")
+	}
 	fmt.Fprintf(w, "data %s%s {\n", d.Name, typeParameters)
 	for _, f := range d.Fields {
 		fmt.Fprintln(w, indent(f.String()))
@@ -185,6 +197,7 @@ func (d *Data) ToProto() proto.Message {
 
 		Name:           d.Name,
 		Export:         d.Export,
+		Internal:       d.Internal,
 		TypeParameters: nodeListToProto[*schemapb.TypeParameter](d.TypeParameters),
 		Fields:         nodeListToProto[*schemapb.Field](d.Fields),
 		Comments:       d.Comments,
@@ -197,6 +210,7 @@ func DataFromProto(s *schemapb.Data) *Data {
 
 		Name:           s.Name,
 		Export:         s.Export,
+		Internal:       s.Internal,
 		TypeParameters: typeParametersToSchema(s.TypeParameters),
 		Fields:         fieldListToSchema(s.Fields),
 		Comments:       s.Comments,
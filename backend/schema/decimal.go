@@ -0,0 +1,91 @@
+package schema
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+)
+
+const decimalPattern = `^[-+]?[0-9]+(\.[0-9]+)?$`
+
+var decimalRe = regexp.MustCompile(decimalPattern)
+
+// Decimal is an exact decimal number, encoded as a string to avoid loss of
+// precision, eg. "1234.56".
+//
+// A Decimal may optionally declare its precision, the maximum number of
+// significant digits, and its scale, the maximum number of digits after the
+// decimal point, eg. "Decimal(6, 2)". A precision of zero is unbounded.
+type Decimal struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	Decimal   bool `parser:"@'Decimal'" protobuf:"-"`
+	Precision int  `parser:"('(' @Number" protobuf:"2"`
+	Scale     int  `parser:"(',' @Number)? ')')?" protobuf:"3"`
+}
+
+var _ Type = (*Decimal)(nil)
+var _ Symbol = (*Decimal)(nil)
+
+func (d *Decimal) Equal(other Type) bool {
+	o, ok := other.(*Decimal)
+	return ok && d.Precision == o.Precision && d.Scale == o.Scale
+}
+func (d *Decimal) Position() Position   { return d.Pos }
+func (*Decimal) schemaChildren() []Node { return nil }
+func (*Decimal) schemaType()            {}
+func (*Decimal) schemaSymbol()          {}
+func (*Decimal) GetName() string        { return "Decimal" }
+func (d *Decimal) String() string {
+	switch {
+	case d.Precision == 0:
+		return "Decimal"
+	case d.Scale == 0:
+		return fmt.Sprintf("Decimal(%d)", d.Precision)
+	default:
+		return fmt.Sprintf("Decimal(%d, %d)", d.Precision, d.Scale)
+	}
+}
+
+func (d *Decimal) ToProto() proto.Message {
+	return &schemapb.Decimal{
+		Pos:       posToProto(d.Pos),
+		Precision: int64(d.Precision),
+		Scale:     int64(d.Scale),
+	}
+}
+
+// CheckValue returns an error if "value" is not a decimal number within the
+// precision and scale of the type.
+func (d *Decimal) CheckValue(value string) error {
+	precision, scale, err := DecimalDigits(value)
+	if err != nil {
+		return err
+	}
+	if d.Precision == 0 {
+		return nil
+	}
+	if scale > d.Scale {
+		return fmt.Errorf("%q has more than %d digits after the decimal point", value, d.Scale)
+	}
+	if precision-scale > d.Precision-d.Scale {
+		return fmt.Errorf("%q has more than %d digits before the decimal point", value, d.Precision-d.Scale)
+	}
+	return nil
+}
+
+// DecimalDigits returns the precision and scale of the decimal number
+// "value", excluding leading zeros, eg. "-012.340" has a precision of 5 and a
+// scale of 3.
+func DecimalDigits(value string) (precision, scale int, err error) {
+	if !decimalRe.MatchString(value) {
+		return 0, 0, fmt.Errorf("%q is not a decimal number", value)
+	}
+	integer, fraction, _ := strings.Cut(strings.TrimLeft(value, "+-"), ".")
+	integer = strings.TrimLeft(integer, "0")
+	return len(integer) + len(fraction), len(fraction), nil
+}
@@ -16,6 +16,19 @@ const (
 	ERROR
 )
 
+func (l ErrorLevel) String() string {
+	switch l {
+	case INFO:
+		return "info"
+	case WARN:
+		return "warn"
+	case ERROR:
+		return "error"
+	default:
+		return fmt.Sprintf("ErrorLevel(%d)", int(l))
+	}
+}
+
 type Error struct {
 	Msg       string     `json:"msg" protobuf:"1"`
 	Pos       Position   `json:"pos" protobuf:"2"`
@@ -116,6 +129,28 @@ func Wrapf(pos Position, endColumn int, err error, format string, args ...any) *
 	return makeError(ERROR, newPos, newEndColumn, format, args...)
 }
 
+// UnwrapErrors flattens errors joined with [errors.Join] into a list of
+// schema errors, so that each can be reported at its own position.
+//
+// Errors that are not schema errors are converted to errors without a position.
+func UnwrapErrors(err error) []*Error {
+	if err == nil {
+		return nil
+	}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok { //nolint:errorlint
+		var out []*Error
+		for _, e := range joined.Unwrap() {
+			out = append(out, UnwrapErrors(e)...)
+		}
+		return out
+	}
+	var perr *Error
+	if errors.As(err, &perr) {
+		return []*Error{perr}
+	}
+	return []*Error{Errorf(Position{}, 0, "%s", err)}
+}
+
 func SortErrorsByPosition(merr []*Error) {
 	if merr == nil {
 		return
@@ -0,0 +1,115 @@
+// Package export converts the data types of FTL modules into external
+// representations, for use with validators, schema registries and client
+// generators.
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+// Format of an exported module.
+type Format string
+
+const (
+	FormatJSONSchema Format = "jsonschema"
+	FormatProtobuf   Format = "proto"
+	FormatGo         Format = "go"
+	FormatKotlin     Format = "kotlin"
+)
+
+// Formats lists all supported export formats.
+var Formats = []Format{FormatJSONSchema, FormatProtobuf, FormatGo, FormatKotlin}
+
+// Extension returns the file extension conventionally used for the format.
+func (f Format) Extension() string {
+	switch f {
+	case FormatJSONSchema:
+		return ".json"
+	case FormatProtobuf:
+		return ".proto"
+	case FormatGo:
+		return ".go"
+	case FormatKotlin:
+		return ".kt"
+	default:
+		return ""
+	}
+}
+
+// Module exports the data types, enums and type aliases of a single module.
+//
+// References to types in other modules are expressed in the idiom of the
+// target format, eg. as imports of the other module's exported file.
+func Module(format Format, sch *schema.Schema, module string) ([]byte, error) {
+	m, ok := sch.Module(module).Get()
+	if !ok {
+		return nil, fmt.Errorf("unknown module %s", module)
+	}
+	switch format {
+	case FormatJSONSchema:
+		js, err := schema.ModuleToJSONSchema(sch, module)
+		if err != nil {
+			return nil, err
+		}
+		out, err := json.MarshalIndent(js, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return append(out, '\n'), nil
+	case FormatProtobuf:
+		return protobuf(sch, m)
+	case FormatGo:
+		return golang(m)
+	case FormatKotlin:
+		return kotlin(m)
+	default:
+		return nil, fmt.Errorf("unsupported export format %q", format)
+	}
+}
+
+// comment renders comments with the given line prefix.
+func comment(w *strings.Builder, indent, prefix string, comments []string) {
+	for _, c := range comments {
+		fmt.Fprintf(w, "%s%s %s\n", indent, prefix, c)
+	}
+}
+
+// sumTypeVariants maps local data types to the type enums they are a variant of.
+func sumTypeVariants(m *schema.Module) map[string][]string {
+	out := map[string][]string{}
+	for _, decl := range m.Decls {
+		enum, ok := decl.(*schema.Enum)
+		if !ok || enum.IsValueEnum() {
+			continue
+		}
+		for _, v := range enum.Variants {
+			if name, ok := localVariantType(m, v); ok {
+				out[name] = append(out[name], enum.Name)
+			}
+		}
+	}
+	return out
+}
+
+// localVariantType returns the name of the local data type a type enum
+// variant wraps, if it has the same name as the variant.
+func localVariantType(m *schema.Module, v *schema.EnumVariant) (string, bool) {
+	tv, ok := v.Value.(*schema.TypeValue)
+	if !ok {
+		return "", false
+	}
+	ref, ok := tv.Value.(*schema.Ref)
+	if !ok || len(ref.TypeParameters) > 0 || ref.Name != v.Name || (ref.Module != "" && ref.Module != m.Name) {
+		return "", false
+	}
+	for _, decl := range m.Decls {
+		if data, ok := decl.(*schema.Data); ok && data.Name == ref.Name {
+			return ref.Name, true
+		}
+	}
+	return "", false
+}
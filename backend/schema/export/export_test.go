@@ -0,0 +1,228 @@
+package export
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+var sample = &schema.Schema{
+	Modules: []*schema.Module{
+		{Name: "foo", Decls: []schema.Decl{
+			&schema.Data{
+				Name:     "Foo",
+				Comments: []string{"Data comment"},
+				Fields: []*schema.Field{
+					{Name: "name", Type: &schema.String{}, Comments: []string{"Field comment"}},
+					{Name: "optionalAge", Type: &schema.Optional{Type: &schema.Int{}}},
+					{Name: "created", Type: &schema.Time{}},
+					{Name: "tags", Type: &schema.Array{Element: &schema.String{}}},
+					{Name: "scores", Type: &schema.Map{Key: &schema.String{}, Value: &schema.Float{}}},
+					{Name: "bar", Type: &schema.Ref{Module: "bar", Name: "Bar"}},
+					{Name: "pair", Type: &schema.Ref{Module: "foo", Name: "Pair", TypeParameters: []schema.Type{&schema.String{}, &schema.Int{}}}},
+					{Name: "colour", Type: &schema.Ref{Module: "foo", Name: "Colour"}},
+					{Name: "shape", Type: &schema.Ref{Module: "foo", Name: "Shape"}},
+					{Name: "id", Type: &schema.Ref{Module: "foo", Name: "ID"}},
+				},
+			},
+			&schema.Data{
+				Name:           "Pair",
+				TypeParameters: []*schema.TypeParameter{{Name: "K"}, {Name: "V"}},
+				Fields: []*schema.Field{
+					{Name: "key", Type: &schema.Ref{Name: "K"}},
+					{Name: "value", Type: &schema.Ref{Name: "V"}},
+				},
+			},
+			&schema.Enum{
+				Name: "Colour",
+				Type: &schema.Int{},
+				Variants: []*schema.EnumVariant{
+					{Name: "Red", Value: &schema.IntValue{Value: 1}},
+					{Name: "Green", Value: &schema.IntValue{Value: 2}},
+				},
+			},
+			&schema.Enum{
+				Name: "Shape",
+				Variants: []*schema.EnumVariant{
+					{Name: "Circle", Value: &schema.TypeValue{Value: &schema.Ref{Module: "foo", Name: "Circle"}}},
+					{Name: "Label", Value: &schema.TypeValue{Value: &schema.String{}}},
+				},
+			},
+			&schema.Data{Name: "Circle", Fields: []*schema.Field{{Name: "radius", Type: &schema.Float{}}}},
+			&schema.TypeAlias{Name: "ID", Type: &schema.String{}},
+		}},
+		{Name: "bar", Decls: []schema.Decl{
+			&schema.Data{Name: "Bar", Fields: []*schema.Field{{Name: "enabled", Type: &schema.Bool{}}}},
+		}},
+	},
+}
+
+func TestExportProtobuf(t *testing.T) {
+	out, err := Module(FormatProtobuf, sample, "foo")
+	assert.NoError(t, err)
+	expected := `syntax = "proto3";
+
+// Code generated by FTL. DO NOT EDIT.
+package ftl.foo;
+
+import "bar.proto";
+import "google/protobuf/timestamp.proto";
+
+// Data comment
+message Foo {
+  // Field comment
+  string name = 1;
+  optional int64 optional_age = 2;
+  google.protobuf.Timestamp created = 3;
+  repeated string tags = 4;
+  map<string, double> scores = 5;
+  ftl.bar.Bar bar = 6;
+  PairStringInt pair = 7;
+  Colour colour = 8;
+  Shape shape = 9;
+  string id = 10;
+}
+
+enum Colour {
+  COLOUR_UNSPECIFIED = 0;
+  COLOUR_RED = 1;
+  COLOUR_GREEN = 2;
+}
+
+message Shape {
+  oneof value {
+    Circle circle = 1;
+    string label = 2;
+  }
+}
+
+message Circle {
+  double radius = 1;
+}
+
+message PairStringInt {
+  string key = 1;
+  int64 value = 2;
+}
+`
+	assert.Equal(t, expected, string(out))
+}
+
+func TestExportGo(t *testing.T) {
+	out, err := Module(FormatGo, sample, "foo")
+	assert.NoError(t, err)
+	expected := "// Code generated by FTL. DO NOT EDIT.\n\n" +
+		"package foo\n\n" +
+		"import (\n" +
+		"\tftlbar \"ftl/bar\"\n" +
+		"\t\"time\"\n" +
+		")\n\n" +
+		"// Data comment\n" +
+		"type Foo struct {\n" +
+		"\t// Field comment\n" +
+		"\tName        string             `json:\"name\"`\n" +
+		"\tOptionalAge *int               `json:\"optionalAge\"`\n" +
+		"\tCreated     time.Time          `json:\"created\"`\n" +
+		"\tTags        []string           `json:\"tags\"`\n" +
+		"\tScores      map[string]float64 `json:\"scores\"`\n" +
+		"\tBar         ftlbar.Bar         `json:\"bar\"`\n" +
+		"\tPair        Pair[string, int]  `json:\"pair\"`\n" +
+		"\tColour      Colour             `json:\"colour\"`\n" +
+		"\tShape       Shape              `json:\"shape\"`\n" +
+		"\tId          ID                 `json:\"id\"`\n" +
+		"}\n\n" +
+		"type Pair[K any, V any] struct {\n" +
+		"\tKey   K `json:\"key\"`\n" +
+		"\tValue V `json:\"value\"`\n" +
+		"}\n\n" +
+		"type Colour int\n\n" +
+		"const (\n" +
+		"\tRed   Colour = 1\n" +
+		"\tGreen Colour = 2\n" +
+		")\n\n" +
+		"type Shape interface{ isShape() }\n\n" +
+		"type Label string\n\n" +
+		"func (Label) isShape() {}\n\n" +
+		"type Circle struct {\n" +
+		"\tRadius float64 `json:\"radius\"`\n" +
+		"}\n\n" +
+		"func (Circle) isShape() {}\n\n" +
+		"type ID string\n"
+	assert.Equal(t, expected, string(out))
+}
+
+func TestExportKotlin(t *testing.T) {
+	out, err := Module(FormatKotlin, sample, "foo")
+	assert.NoError(t, err)
+	expected := `// Code generated by FTL. DO NOT EDIT.
+package ftl.foo
+
+import java.time.OffsetDateTime
+
+// Data comment
+data class Foo(
+  // Field comment
+  val name: String,
+  val optionalAge: Long? = null,
+  val created: OffsetDateTime,
+  val tags: List<String>,
+  val scores: Map<String, Double>,
+  val bar: ftl.bar.Bar,
+  val pair: Pair<String, Long>,
+  val colour: Colour,
+  val shape: Shape,
+  val id: ID,
+)
+
+data class Pair<K, V>(
+  val key: K,
+  val value: V,
+)
+
+enum class Colour(val value: Long) {
+  Red(1),
+  Green(2),
+}
+
+sealed interface Shape
+
+data class Label(val value: String) : Shape
+
+data class Circle(
+  val radius: Double,
+) : Shape
+
+typealias ID = String
+`
+	assert.Equal(t, expected, string(out))
+}
+
+func TestExportJSONSchema(t *testing.T) {
+	out, err := Module(FormatJSONSchema, sample, "foo")
+	assert.NoError(t, err)
+	var doc struct {
+		Title       string                     `json:"title"`
+		Definitions map[string]json.RawMessage `json:"definitions"`
+	}
+	assert.NoError(t, json.Unmarshal(out, &doc))
+	assert.Equal(t, "foo", doc.Title)
+	_, ok := doc.Definitions["foo.Pair[String, Int]"]
+	assert.True(t, ok, "expected monomorphised generic definition")
+	for _, name := range []string{"foo.Foo", "foo.Colour", "foo.Shape", "foo.Circle", "foo.ID", "bar.Bar"} {
+		_, ok := doc.Definitions[name]
+		assert.True(t, ok, "missing definition %s", name)
+	}
+}
+
+func TestExportProtobufUnsupported(t *testing.T) {
+	sch := &schema.Schema{Modules: []*schema.Module{{Name: "foo", Decls: []schema.Decl{
+		&schema.Data{Name: "Foo", Fields: []*schema.Field{
+			{Name: "matrix", Type: &schema.Array{Element: &schema.Array{Element: &schema.Int{}}}},
+		}},
+	}}}}
+	_, err := Module(FormatProtobuf, sch, "foo")
+	assert.EqualError(t, err, "foo.Foo: matrix: nested [Int] is not supported by protobuf")
+}
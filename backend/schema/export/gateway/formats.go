@@ -0,0 +1,272 @@
+package gateway
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// aws generates an OpenAPI document for AWS API Gateway REST APIs, with an
+// HTTP proxy integration forwarding each route to the FTL ingress.
+func aws(routes []Route, options Options) ([]byte, error) {
+	upstream := strings.TrimSuffix(options.Upstream.String(), "/")
+	paths := map[string]map[string]any{}
+	for _, route := range routes {
+		parameters := []map[string]any{}
+		requestParameters := map[string]string{}
+		for _, parameter := range route.Parameters {
+			parameters = append(parameters, map[string]any{
+				"name":     parameter,
+				"in":       "path",
+				"required": true,
+				"schema":   map[string]string{"type": "string"},
+			})
+			requestParameters["integration.request.path."+parameter] = "method.request.path." + parameter
+		}
+		if paths[route.Path] == nil {
+			paths[route.Path] = map[string]any{}
+		}
+		paths[route.Path][strings.ToLower(route.Method)] = map[string]any{
+			"operationId": route.Module + "." + route.Verb,
+			"parameters":  parameters,
+			"responses": map[string]any{
+				"default": map[string]string{"description": "Response of " + route.Module + "." + route.Verb},
+			},
+			"x-amazon-apigateway-integration": map[string]any{
+				"type":                "http_proxy",
+				"httpMethod":          route.Method,
+				"uri":                 upstream + route.Path,
+				"passthroughBehavior": "when_no_match",
+				"requestParameters":   requestParameters,
+			},
+		}
+	}
+	doc := map[string]any{
+		"openapi": "3.0.1",
+		"info":    map[string]string{"title": options.Name, "version": "1.0"},
+		"paths":   paths,
+	}
+	if options.Host != "" {
+		doc["servers"] = []map[string]string{{"url": "https://" + options.Host}}
+	}
+	out, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(out, '\n'), nil
+}
+
+type envoyRouteConfiguration struct {
+	Name         string             `yaml:"name"`
+	VirtualHosts []envoyVirtualHost `yaml:"virtual_hosts"`
+}
+
+type envoyVirtualHost struct {
+	Name    string       `yaml:"name"`
+	Domains []string     `yaml:"domains"`
+	Routes  []envoyRoute `yaml:"routes"`
+}
+
+type envoyRoute struct {
+	Name  string           `yaml:"name"`
+	Match envoyRouteMatch  `yaml:"match"`
+	Route envoyRouteAction `yaml:"route"`
+}
+
+type envoyRouteMatch struct {
+	Path      string               `yaml:"path,omitempty"`
+	SafeRegex *envoyRegexMatcher   `yaml:"safe_regex,omitempty"`
+	Headers   []envoyHeaderMatcher `yaml:"headers"`
+}
+
+type envoyRegexMatcher struct {
+	Regex string `yaml:"regex"`
+}
+
+type envoyHeaderMatcher struct {
+	Name        string             `yaml:"name"`
+	StringMatch envoyStringMatcher `yaml:"string_match"`
+}
+
+type envoyStringMatcher struct {
+	Exact string `yaml:"exact"`
+}
+
+type envoyRouteAction struct {
+	Cluster string `yaml:"cluster"`
+}
+
+// envoy generates an Envoy RouteConfiguration forwarding each route to a cluster.
+func envoy(routes []Route, options Options) ([]byte, error) {
+	host := envoyVirtualHost{Name: options.Name, Domains: []string{"*"}, Routes: []envoyRoute{}}
+	if options.Host != "" {
+		host.Domains = []string{options.Host}
+	}
+	for _, route := range routes {
+		r := envoyRoute{
+			Name: route.Method + " " + route.Module + "." + route.Verb,
+			Match: envoyRouteMatch{
+				Headers: []envoyHeaderMatcher{{Name: ":method", StringMatch: envoyStringMatcher{Exact: route.Method}}},
+			},
+			Route: envoyRouteAction{Cluster: options.Cluster},
+		}
+		if route.literal() {
+			r.Match.Path = route.Path
+		} else {
+			r.Match.SafeRegex = &envoyRegexMatcher{Regex: route.regex()}
+		}
+		host.Routes = append(host.Routes, r)
+	}
+	return marshalYAML(envoyRouteConfiguration{Name: options.Name, VirtualHosts: []envoyVirtualHost{host}})
+}
+
+type kubernetesMetadata struct {
+	Name      string `yaml:"name"`
+	Namespace string `yaml:"namespace,omitempty"`
+}
+
+type kubernetesResource[Spec any] struct {
+	APIVersion string             `yaml:"apiVersion"`
+	Kind       string             `yaml:"kind"`
+	Metadata   kubernetesMetadata `yaml:"metadata"`
+	Spec       Spec               `yaml:"spec"`
+}
+
+type kubernetesIngressSpec struct {
+	Rules []kubernetesIngressRule `yaml:"rules"`
+}
+
+type kubernetesIngressRule struct {
+	Host string                    `yaml:"host,omitempty"`
+	HTTP kubernetesIngressRuleHTTP `yaml:"http"`
+}
+
+type kubernetesIngressRuleHTTP struct {
+	Paths []kubernetesIngressPath `yaml:"paths"`
+}
+
+type kubernetesIngressPath struct {
+	Path     string                   `yaml:"path"`
+	PathType string                   `yaml:"pathType"`
+	Backend  kubernetesIngressBackend `yaml:"backend"`
+}
+
+type kubernetesIngressBackend struct {
+	Service kubernetesServiceBackend `yaml:"service"`
+}
+
+type kubernetesServiceBackend struct {
+	Name string                `yaml:"name"`
+	Port kubernetesServicePort `yaml:"port"`
+}
+
+type kubernetesServicePort struct {
+	Number int `yaml:"number"`
+}
+
+// kubernetesIngress generates a Kubernetes Ingress routing each path to the
+// FTL ingress service.
+//
+// Ingresses can't match methods or path parameters, so paths with parameters
+// are routed by the prefix before their first parameter.
+func kubernetesIngress(routes []Route, options Options) ([]byte, error) {
+	backend := kubernetesIngressBackend{Service: kubernetesServiceBackend{Name: options.Service, Port: kubernetesServicePort{Number: options.ServicePort}}}
+	var paths []kubernetesIngressPath
+	seen := map[string]bool{}
+	for _, route := range routes {
+		path := kubernetesIngressPath{Path: route.Path, PathType: "Exact", Backend: backend}
+		if !route.literal() {
+			path.Path = strings.TrimSuffix(route.prefix(), "/")
+			if path.Path == "" {
+				path.Path = "/"
+			}
+			path.PathType = "Prefix"
+		}
+		if seen[path.PathType+path.Path] {
+			continue
+		}
+		seen[path.PathType+path.Path] = true
+		paths = append(paths, path)
+	}
+	return marshalYAML(kubernetesResource[kubernetesIngressSpec]{
+		APIVersion: "networking.k8s.io/v1",
+		Kind:       "Ingress",
+		Metadata:   kubernetesMetadata{Name: options.Name, Namespace: options.Namespace},
+		Spec: kubernetesIngressSpec{Rules: []kubernetesIngressRule{
+			{Host: options.Host, HTTP: kubernetesIngressRuleHTTP{Paths: paths}},
+		}},
+	})
+}
+
+// Maximum number of matches in an HTTPRoute rule.
+const gatewayAPIMaxMatches = 8
+
+type httpRouteSpec struct {
+	ParentRefs []kubernetesMetadata `yaml:"parentRefs"`
+	Hostnames  []string             `yaml:"hostnames,omitempty"`
+	Rules      []httpRouteRule      `yaml:"rules"`
+}
+
+type httpRouteRule struct {
+	Matches     []httpRouteMatch      `yaml:"matches"`
+	BackendRefs []httpRouteBackendRef `yaml:"backendRefs"`
+}
+
+type httpRouteMatch struct {
+	Path   httpRoutePathMatch `yaml:"path"`
+	Method string             `yaml:"method"`
+}
+
+type httpRoutePathMatch struct {
+	Type  string `yaml:"type"`
+	Value string `yaml:"value"`
+}
+
+type httpRouteBackendRef struct {
+	Name string `yaml:"name"`
+	Port int    `yaml:"port"`
+}
+
+// gatewayAPI generates a Kubernetes Gateway API HTTPRoute routing each route
+// to the FTL ingress service.
+//
+// Matches are grouped into rules of up to 8, the most Gateway API allows.
+func gatewayAPI(routes []Route, options Options) ([]byte, error) {
+	var rules []httpRouteRule
+	for i, route := range routes {
+		if i%gatewayAPIMaxMatches == 0 {
+			rules = append(rules, httpRouteRule{BackendRefs: []httpRouteBackendRef{{Name: options.Service, Port: options.ServicePort}}})
+		}
+		match := httpRouteMatch{Method: route.Method, Path: httpRoutePathMatch{Type: "Exact", Value: route.Path}}
+		if !route.literal() {
+			match.Path = httpRoutePathMatch{Type: "RegularExpression", Value: route.regex()}
+		}
+		rule := &rules[len(rules)-1]
+		rule.Matches = append(rule.Matches, match)
+	}
+	spec := httpRouteSpec{ParentRefs: []kubernetesMetadata{{Name: options.Gateway}}, Rules: rules}
+	if options.Host != "" {
+		spec.Hostnames = []string{options.Host}
+	}
+	return marshalYAML(kubernetesResource[httpRouteSpec]{
+		APIVersion: "gateway.networking.k8s.io/v1",
+		Kind:       "HTTPRoute",
+		Metadata:   kubernetesMetadata{Name: options.Name, Namespace: options.Namespace},
+		Spec:       spec,
+	})
+}
+
+func marshalYAML(v any) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	enc := yaml.NewEncoder(buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,163 @@
+// Package gateway generates configuration for external API gateways from the
+// ingress routes of FTL modules, so that gateways in front of FTL's ingress
+// can be kept in sync with the routes it serves.
+package gateway
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+// Format of the generated gateway configuration.
+type Format string
+
+const (
+	// FormatAWS is an OpenAPI 3 document with AWS API Gateway integration
+	// extensions, proxying each route to the FTL ingress.
+	FormatAWS Format = "aws"
+	// FormatEnvoy is an Envoy route configuration.
+	FormatEnvoy Format = "envoy"
+	// FormatIngress is a Kubernetes Ingress.
+	FormatIngress Format = "ingress"
+	// FormatGatewayAPI is a Kubernetes Gateway API HTTPRoute.
+	FormatGatewayAPI Format = "gateway-api"
+)
+
+// Formats lists all supported gateway formats.
+var Formats = []Format{FormatAWS, FormatEnvoy, FormatIngress, FormatGatewayAPI}
+
+// Options for the generated configuration.
+type Options struct {
+	// Name of the generated route configuration or resource.
+	Name string
+	// Host that routes are served on, or empty for any host.
+	Host string
+	// URL of the FTL ingress, for gateways that proxy to a URL.
+	Upstream *url.URL
+	// Envoy cluster that routes are forwarded to.
+	Cluster string
+	// Kubernetes namespace of the generated resources.
+	Namespace string
+	// Kubernetes service and port of the FTL ingress.
+	Service     string
+	ServicePort int
+	// Kubernetes Gateway the HTTPRoute is attached to.
+	Gateway string
+}
+
+// Route is an HTTP ingress route of a verb.
+type Route struct {
+	Module string
+	Verb   string
+	Method string
+	// Path of the route, with parameters in braces, eg. "/users/{id}".
+	Path string
+	// Names of the path parameters, in order.
+	Parameters []string
+}
+
+// literal returns true if the route has no path parameters.
+func (r Route) literal() bool { return len(r.Parameters) == 0 }
+
+// prefix returns the path of the route up to its first parameter.
+func (r Route) prefix() string {
+	prefix, _, _ := strings.Cut(r.Path, "{")
+	return prefix
+}
+
+// regex returns a regular expression that matches the path of the route, as
+// the FTL ingress matches it.
+func (r Route) regex() string {
+	segments := strings.Split(strings.TrimPrefix(r.Path, "/"), "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, "{") {
+			segments[i] = "[^/]+"
+		} else {
+			segments[i] = regexp.QuoteMeta(segment)
+		}
+	}
+	return "^/" + strings.Join(segments, "/") + "/?$"
+}
+
+// Routes returns the HTTP ingress routes of the modules in "sch".
+//
+// Routes are ordered with paths without parameters first, so that gateways
+// that use the first matching route prefer them, then by path and method.
+func Routes(sch *schema.Schema) []Route {
+	var routes []Route
+	for _, module := range sch.Modules {
+		for _, decl := range module.Decls {
+			verb, ok := decl.(*schema.Verb)
+			if !ok {
+				continue
+			}
+			for _, metadata := range verb.Metadata {
+				ingress, ok := metadata.(*schema.MetadataIngress)
+				if !ok || ingress.Type != "http" {
+					continue
+				}
+				route := Route{Module: module.Name, Verb: verb.Name, Method: ingress.Method}
+				segments := make([]string, len(ingress.Path))
+				for i, component := range ingress.Path {
+					switch component := component.(type) {
+					case *schema.IngressPathLiteral:
+						segments[i] = component.Text
+					case *schema.IngressPathParameter:
+						segments[i] = "{" + component.Name + "}"
+						route.Parameters = append(route.Parameters, component.Name)
+					}
+				}
+				route.Path = "/" + strings.Join(segments, "/")
+				routes = append(routes, route)
+			}
+		}
+	}
+	sort.SliceStable(routes, func(i, j int) bool {
+		if routes[i].literal() != routes[j].literal() {
+			return routes[i].literal()
+		}
+		if routes[i].Path != routes[j].Path {
+			return routes[i].Path < routes[j].Path
+		}
+		return routes[i].Method < routes[j].Method
+	})
+	return routes
+}
+
+// Export generates gateway configuration for the ingress routes of "sch".
+func Export(format Format, sch *schema.Schema, options Options) ([]byte, error) {
+	routes := Routes(sch)
+	if options.Name == "" {
+		options.Name = "ftl"
+	}
+	switch format {
+	case FormatAWS:
+		if options.Upstream == nil {
+			return nil, fmt.Errorf("the %s format requires the URL of the FTL ingress", format)
+		}
+		return aws(routes, options)
+	case FormatEnvoy:
+		if options.Cluster == "" {
+			return nil, fmt.Errorf("the %s format requires an Envoy cluster", format)
+		}
+		return envoy(routes, options)
+	case FormatIngress, FormatGatewayAPI:
+		if options.Service == "" || options.ServicePort == 0 {
+			return nil, fmt.Errorf("the %s format requires the service and port of the FTL ingress", format)
+		}
+		if format == FormatIngress {
+			return kubernetesIngress(routes, options)
+		}
+		if options.Gateway == "" {
+			return nil, fmt.Errorf("the %s format requires the Gateway to attach routes to", format)
+		}
+		return gatewayAPI(routes, options)
+	default:
+		return nil, fmt.Errorf("unsupported gateway format %q", format)
+	}
+}
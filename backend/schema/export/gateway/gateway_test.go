@@ -0,0 +1,143 @@
+package gateway
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+func ingress(method string, path ...schema.IngressPathComponent) *schema.MetadataIngress {
+	return &schema.MetadataIngress{Type: "http", Method: method, Path: path}
+}
+
+func literal(text string) *schema.IngressPathLiteral { return &schema.IngressPathLiteral{Text: text} }
+
+func parameter(name string) *schema.IngressPathParameter {
+	return &schema.IngressPathParameter{Name: name}
+}
+
+var sample = &schema.Schema{
+	Modules: []*schema.Module{
+		{Name: "users", Decls: []schema.Decl{
+			&schema.Verb{Name: "get", Metadata: []schema.Metadata{ingress("GET", literal("users"), parameter("id"))}},
+			&schema.Verb{Name: "me", Metadata: []schema.Metadata{ingress("GET", literal("users"), literal("me"))}},
+			&schema.Verb{Name: "create", Metadata: []schema.Metadata{ingress("POST", literal("users"))}},
+			&schema.Verb{Name: "internal"},
+		}},
+	},
+}
+
+func TestRoutes(t *testing.T) {
+	assert.Equal(t, []Route{
+		{Module: "users", Verb: "create", Method: "POST", Path: "/users"},
+		{Module: "users", Verb: "me", Method: "GET", Path: "/users/me"},
+		{Module: "users", Verb: "get", Method: "GET", Path: "/users/{id}", Parameters: []string{"id"}},
+	}, Routes(sample))
+	assert.Equal(t, "^/users/[^/]+/?$", Routes(sample)[2].regex())
+}
+
+func TestExport(t *testing.T) {
+	upstream, err := url.Parse("http://ftl.internal:8891")
+	assert.NoError(t, err)
+	options := Options{Upstream: upstream, Cluster: "ftl", Service: "ftl-ingress", ServicePort: 8891, Gateway: "public", Host: "api.example.com"}
+
+	out, err := Export(FormatEnvoy, sample, options)
+	assert.NoError(t, err)
+	assert.Equal(t, `name: ftl
+virtual_hosts:
+  - name: ftl
+    domains:
+      - api.example.com
+    routes:
+      - name: POST users.create
+        match:
+          path: /users
+          headers:
+            - name: :method
+              string_match:
+                exact: POST
+        route:
+          cluster: ftl
+      - name: GET users.me
+        match:
+          path: /users/me
+          headers:
+            - name: :method
+              string_match:
+                exact: GET
+        route:
+          cluster: ftl
+      - name: GET users.get
+        match:
+          safe_regex:
+            regex: ^/users/[^/]+/?$
+          headers:
+            - name: :method
+              string_match:
+                exact: GET
+        route:
+          cluster: ftl
+`, string(out))
+
+	out, err = Export(FormatIngress, sample, options)
+	assert.NoError(t, err)
+	assert.Equal(t, `apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: ftl
+spec:
+  rules:
+    - host: api.example.com
+      http:
+        paths:
+          - path: /users
+            pathType: Exact
+            backend:
+              service:
+                name: ftl-ingress
+                port:
+                  number: 8891
+          - path: /users/me
+            pathType: Exact
+            backend:
+              service:
+                name: ftl-ingress
+                port:
+                  number: 8891
+          - path: /users
+            pathType: Prefix
+            backend:
+              service:
+                name: ftl-ingress
+                port:
+                  number: 8891
+`, string(out))
+
+	out, err = Export(FormatGatewayAPI, sample, options)
+	assert.NoError(t, err)
+	assert.Contains(t, string(out), `kind: HTTPRoute
+metadata:
+  name: ftl
+spec:
+  parentRefs:
+    - name: public
+  hostnames:
+    - api.example.com
+`)
+	assert.Contains(t, string(out), `        - path:
+            type: RegularExpression
+            value: ^/users/[^/]+/?$
+          method: GET
+`)
+
+	out, err = Export(FormatAWS, sample, options)
+	assert.NoError(t, err)
+	assert.Contains(t, string(out), `"uri": "http://ftl.internal:8891/users/{id}"`)
+	assert.Contains(t, string(out), `"integration.request.path.id": "method.request.path.id"`)
+
+	_, err = Export(FormatGatewayAPI, sample, Options{Service: "ftl-ingress", ServicePort: 8891})
+	assert.EqualError(t, err, "the gateway-api format requires the Gateway to attach routes to")
+}
@@ -0,0 +1,174 @@
+package export
+
+import (
+	"fmt"
+	"go/format"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/exp/maps"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/backend/schema/strcase"
+)
+
+type goGen struct {
+	module *schema.Module
+	// Import path to alias.
+	imports map[string]string
+}
+
+// golang exports a module as a Go package of plain types with JSON tags.
+//
+// Unlike the stubs generated for FTL modules, the exported types do not
+// depend on the FTL runtime: optional fields are pointers, and type enums are
+// interfaces implemented by each variant.
+func golang(m *schema.Module) ([]byte, error) {
+	g := &goGen{module: m, imports: map[string]string{}}
+	variants := sumTypeVariants(m)
+	body := &strings.Builder{}
+	for _, decl := range m.Decls {
+		switch decl := decl.(type) {
+		case *schema.Data:
+			body.WriteString("\n")
+			comment(body, "", "//", decl.Comments)
+			fmt.Fprintf(body, "type %s", decl.Name)
+			if len(decl.TypeParameters) > 0 {
+				params := make([]string, len(decl.TypeParameters))
+				for i, tp := range decl.TypeParameters {
+					params[i] = tp.Name + " any"
+				}
+				fmt.Fprintf(body, "[%s]", strings.Join(params, ", "))
+			}
+			body.WriteString(" struct {\n")
+			for _, field := range decl.Fields {
+				comment(body, "\t", "//", field.Comments)
+				fmt.Fprintf(body, "\t%s %s `json:\"%s\"`\n", strcase.ToUpperCamel(field.Name), g.typ(field.Type), field.Name)
+			}
+			body.WriteString("}\n")
+			for _, enum := range variants[decl.Name] {
+				fmt.Fprintf(body, "\nfunc (%s) is%s() {}\n", decl.Name, enum)
+			}
+
+		case *schema.Enum:
+			body.WriteString("\n")
+			comment(body, "", "//", decl.Comments)
+			if decl.IsValueEnum() {
+				fmt.Fprintf(body, "type %s %s\n\nconst (\n", decl.Name, g.typ(decl.Type))
+				for _, v := range decl.Variants {
+					comment(body, "\t", "//", v.Comments)
+					fmt.Fprintf(body, "\t%s %s = %s\n", v.Name, decl.Name, goValue(v.Value))
+				}
+				body.WriteString(")\n")
+				continue
+			}
+			fmt.Fprintf(body, "type %s interface{ is%s() }\n", decl.Name, decl.Name)
+			for _, v := range decl.Variants {
+				if _, ok := localVariantType(m, v); ok {
+					continue
+				}
+				body.WriteString("\n")
+				comment(body, "", "//", v.Comments)
+				fmt.Fprintf(body, "type %s %s\n\nfunc (%s) is%s() {}\n", v.Name, g.typ(v.Value.(*schema.TypeValue).Value), v.Name, decl.Name) //nolint:forcetypeassert
+			}
+
+		case *schema.TypeAlias:
+			body.WriteString("\n")
+			comment(body, "", "//", decl.Comments)
+			fmt.Fprintf(body, "type %s %s\n", decl.Name, g.typ(decl.Type))
+
+		default:
+		}
+	}
+
+	w := &strings.Builder{}
+	w.WriteString("// Code generated by FTL. DO NOT EDIT.\n\n")
+	comment(w, "", "//", m.Comments)
+	fmt.Fprintf(w, "package %s\n", m.Name)
+	if len(g.imports) > 0 {
+		w.WriteString("\nimport (\n")
+		imports := maps.Keys(g.imports)
+		sort.Strings(imports)
+		for _, imp := range imports {
+			if alias := g.imports[imp]; alias != "" {
+				fmt.Fprintf(w, "\t%s %q\n", alias, imp)
+			} else {
+				fmt.Fprintf(w, "\t%q\n", imp)
+			}
+		}
+		w.WriteString(")\n")
+	}
+	w.WriteString(body.String())
+	out, err := format.Source([]byte(w.String()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to format generated Go: %w", err)
+	}
+	return out, nil
+}
+
+func (g *goGen) typ(t schema.Type) string {
+	switch t := t.(type) {
+	case *schema.Ref:
+		desc := t.Name
+		if t.Module != "" && t.Module != g.module.Name {
+			g.imports["ftl/"+t.Module] = "ftl" + t.Module
+			desc = "ftl" + t.Module + "." + t.Name
+		}
+		if len(t.TypeParameters) > 0 {
+			params := make([]string, len(t.TypeParameters))
+			for i, tp := range t.TypeParameters {
+				params[i] = g.typ(tp)
+			}
+			desc += "[" + strings.Join(params, ", ") + "]"
+		}
+		return desc
+	case *schema.Int:
+		return "int"
+	case *schema.Float:
+		return "float64"
+	case *schema.String:
+		return "string"
+	case *schema.Bool:
+		return "bool"
+	case *schema.Bytes:
+		return "[]byte"
+	case *schema.Time:
+		g.imports["time"] = ""
+		return "time.Time"
+	case *schema.UUID:
+		g.imports["github.com/google/uuid"] = ""
+		return "uuid.UUID"
+	case *schema.Decimal, *schema.Timestamp:
+		// Encoded as strings, and not representable by the standard library.
+		return "string"
+	case *schema.Array:
+		return "[]" + g.typ(t.Element)
+	case *schema.Map:
+		return "map[" + g.typ(t.Key) + "]" + g.typ(t.Value)
+	case *schema.Optional:
+		switch t.Type.(type) {
+		case *schema.Array, *schema.Map, *schema.Any, *schema.Bytes:
+			// Already nilable.
+			return g.typ(t.Type)
+		default:
+			return "*" + g.typ(t.Type)
+		}
+	case *schema.Unit:
+		return "struct{}"
+	case *schema.Any:
+		return "any"
+	}
+	panic(fmt.Sprintf("unsupported type %T", t))
+}
+
+func goValue(v schema.Value) string {
+	switch v := v.(type) {
+	case *schema.StringValue:
+		return strconv.Quote(v.Value)
+	case *schema.IntValue:
+		return strconv.Itoa(v.Value)
+	default:
+		return fmt.Sprint(v.GetValue())
+	}
+}
@@ -0,0 +1,159 @@
+package export
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/exp/maps"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+type kotlinGen struct {
+	module  *schema.Module
+	imports map[string]bool
+}
+
+// kotlin exports a module as Kotlin data classes in the package "ftl.<module>".
+func kotlin(m *schema.Module) ([]byte, error) {
+	g := &kotlinGen{module: m, imports: map[string]bool{}}
+	variants := sumTypeVariants(m)
+	body := &strings.Builder{}
+	for _, decl := range m.Decls {
+		switch decl := decl.(type) {
+		case *schema.Data:
+			body.WriteString("\n")
+			comment(body, "", "//", decl.Comments)
+			supertypes := ""
+			if len(variants[decl.Name]) > 0 {
+				supertypes = " : " + strings.Join(variants[decl.Name], ", ")
+			}
+			if len(decl.Fields) == 0 {
+				fmt.Fprintf(body, "class %s%s\n", decl.Name, supertypes)
+				continue
+			}
+			fmt.Fprintf(body, "data class %s", decl.Name)
+			if len(decl.TypeParameters) > 0 {
+				params := make([]string, len(decl.TypeParameters))
+				for i, tp := range decl.TypeParameters {
+					params[i] = tp.Name
+				}
+				fmt.Fprintf(body, "<%s>", strings.Join(params, ", "))
+			}
+			body.WriteString("(\n")
+			for _, field := range decl.Fields {
+				comment(body, "  ", "//", field.Comments)
+				typ := g.typ(field.Type)
+				if _, ok := field.Type.(*schema.Optional); ok {
+					typ += " = null"
+				}
+				fmt.Fprintf(body, "  val %s: %s,\n", field.Name, typ)
+			}
+			fmt.Fprintf(body, ")%s\n", supertypes)
+
+		case *schema.Enum:
+			body.WriteString("\n")
+			comment(body, "", "//", decl.Comments)
+			if decl.IsValueEnum() {
+				fmt.Fprintf(body, "enum class %s(val value: %s) {\n", decl.Name, g.typ(decl.Type))
+				for _, v := range decl.Variants {
+					comment(body, "  ", "//", v.Comments)
+					fmt.Fprintf(body, "  %s(%s),\n", v.Name, kotlinValue(v.Value))
+				}
+				body.WriteString("}\n")
+				continue
+			}
+			fmt.Fprintf(body, "sealed interface %s\n", decl.Name)
+			for _, v := range decl.Variants {
+				if _, ok := localVariantType(m, v); ok {
+					continue
+				}
+				body.WriteString("\n")
+				comment(body, "", "//", v.Comments)
+				fmt.Fprintf(body, "data class %s(val value: %s) : %s\n", v.Name, g.typ(v.Value.(*schema.TypeValue).Value), decl.Name) //nolint:forcetypeassert
+			}
+
+		case *schema.TypeAlias:
+			body.WriteString("\n")
+			comment(body, "", "//", decl.Comments)
+			fmt.Fprintf(body, "typealias %s = %s\n", decl.Name, g.typ(decl.Type))
+
+		default:
+		}
+	}
+
+	w := &strings.Builder{}
+	w.WriteString("// Code generated by FTL. DO NOT EDIT.\n")
+	comment(w, "", "//", m.Comments)
+	fmt.Fprintf(w, "package ftl.%s\n", m.Name)
+	if len(g.imports) > 0 {
+		w.WriteString("\n")
+		imports := maps.Keys(g.imports)
+		sort.Strings(imports)
+		for _, imp := range imports {
+			fmt.Fprintf(w, "import %s\n", imp)
+		}
+	}
+	w.WriteString(body.String())
+	return []byte(w.String()), nil
+}
+
+func (g *kotlinGen) typ(t schema.Type) string {
+	switch t := t.(type) {
+	case *schema.Ref:
+		desc := t.Name
+		if t.Module != "" && t.Module != g.module.Name {
+			desc = "ftl." + t.Module + "." + t.Name
+		}
+		if len(t.TypeParameters) > 0 {
+			params := make([]string, len(t.TypeParameters))
+			for i, tp := range t.TypeParameters {
+				params[i] = g.typ(tp)
+			}
+			desc += "<" + strings.Join(params, ", ") + ">"
+		}
+		return desc
+	case *schema.Int:
+		return "Long"
+	case *schema.Float:
+		return "Double"
+	case *schema.Bool:
+		return "Boolean"
+	case *schema.Bytes:
+		return "ByteArray"
+	case *schema.Time:
+		g.imports["java.time.OffsetDateTime"] = true
+		return "OffsetDateTime"
+	case *schema.Timestamp:
+		g.imports["java.time.ZonedDateTime"] = true
+		return "ZonedDateTime"
+	case *schema.UUID:
+		g.imports["java.util.UUID"] = true
+		return "UUID"
+	case *schema.Decimal:
+		g.imports["java.math.BigDecimal"] = true
+		return "BigDecimal"
+	case *schema.Array:
+		return "List<" + g.typ(t.Element) + ">"
+	case *schema.Map:
+		return "Map<" + g.typ(t.Key) + ", " + g.typ(t.Value) + ">"
+	case *schema.Optional:
+		return g.typ(t.Type) + "?"
+	case *schema.String, *schema.Any, *schema.Unit:
+		return t.String()
+	}
+	panic(fmt.Sprintf("unsupported type %T", t))
+}
+
+func kotlinValue(v schema.Value) string {
+	switch v := v.(type) {
+	case *schema.StringValue:
+		return strconv.Quote(v.Value)
+	case *schema.IntValue:
+		return strconv.Itoa(v.Value)
+	default:
+		return fmt.Sprint(v.GetValue())
+	}
+}
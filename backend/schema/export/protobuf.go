@@ -0,0 +1,286 @@
+package export
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"golang.org/x/exp/maps"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/backend/schema/strcase"
+)
+
+type protoGen struct {
+	sch    *schema.Schema
+	module *schema.Module
+	// Imported files.
+	imports map[string]bool
+	// Instantiations of generic data types, keyed by message name.
+	instances map[string]*schema.Ref
+}
+
+// protobuf exports a module as a proto3 file in the package "ftl.<module>".
+//
+// Protobuf has no type parameters, so each instantiation of a generic data
+// type is emitted as a separate message. Type aliases are replaced by the
+// aliased type.
+func protobuf(sch *schema.Schema, m *schema.Module) ([]byte, error) {
+	g := &protoGen{sch: sch, module: m, imports: map[string]bool{}, instances: map[string]*schema.Ref{}}
+	body := &strings.Builder{}
+	for _, decl := range m.Decls {
+		var err error
+		switch decl := decl.(type) {
+		case *schema.Data:
+			if len(decl.TypeParameters) > 0 {
+				continue
+			}
+			err = g.message(body, decl.Name, decl)
+		case *schema.Enum:
+			err = g.enum(body, decl)
+		default:
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", m.Name, decl.GetName(), err)
+		}
+	}
+
+	// Instantiating a generic data type may in turn instantiate others.
+	emitted := map[string]bool{}
+	for len(emitted) < len(g.instances) {
+		names := maps.Keys(g.instances)
+		sort.Strings(names)
+		for _, name := range names {
+			if emitted[name] {
+				continue
+			}
+			emitted[name] = true
+			ref := g.instances[name]
+			data, err := sch.ResolveMonomorphised(ref)
+			if err != nil {
+				return nil, err
+			}
+			if err := g.message(body, name, data); err != nil {
+				return nil, fmt.Errorf("%s: %w", ref, err)
+			}
+		}
+	}
+
+	w := &strings.Builder{}
+	fmt.Fprintf(w, "syntax = \"proto3\";\n\n// Code generated by FTL. DO NOT EDIT.\n")
+	comment(w, "", "//", m.Comments)
+	fmt.Fprintf(w, "package ftl.%s;\n", m.Name)
+	if len(g.imports) > 0 {
+		w.WriteString("\n")
+		imports := maps.Keys(g.imports)
+		sort.Strings(imports)
+		for _, imp := range imports {
+			fmt.Fprintf(w, "import %q;\n", imp)
+		}
+	}
+	w.WriteString(body.String())
+	return []byte(w.String()), nil
+}
+
+func (g *protoGen) message(w *strings.Builder, name string, data *schema.Data) error {
+	w.WriteString("\n")
+	comment(w, "", "//", data.Comments)
+	if len(data.Fields) == 0 {
+		fmt.Fprintf(w, "message %s {}\n", name)
+		return nil
+	}
+	fmt.Fprintf(w, "message %s {\n", name)
+	for i, field := range data.Fields {
+		typ, err := g.field(field.Type)
+		if err != nil {
+			return fmt.Errorf("%s: %w", field.Name, err)
+		}
+		comment(w, "  ", "//", field.Comments)
+		fmt.Fprintf(w, "  %s %s = %d;\n", typ, strcase.ToLowerSnake(field.Name), i+1)
+	}
+	w.WriteString("}\n")
+	return nil
+}
+
+func (g *protoGen) enum(w *strings.Builder, enum *schema.Enum) error {
+	w.WriteString("\n")
+	comment(w, "", "//", enum.Comments)
+	if !enum.IsValueEnum() {
+		// Type enums are represented as a message with a oneof of the variants.
+		fmt.Fprintf(w, "message %s {\n  oneof value {\n", enum.Name)
+		for i, v := range enum.Variants {
+			typ, err := g.typ(v.Value.(*schema.TypeValue).Value) //nolint:forcetypeassert
+			if err != nil {
+				return fmt.Errorf("%s: %w", v.Name, err)
+			}
+			comment(w, "    ", "//", v.Comments)
+			fmt.Fprintf(w, "    %s %s = %d;\n", typ, strcase.ToLowerSnake(v.Name), i+1)
+		}
+		w.WriteString("  }\n}\n")
+		return nil
+	}
+	prefix := strcase.ToUpperSnake(enum.Name)
+	fmt.Fprintf(w, "enum %s {\n", enum.Name)
+	if _, ok := enum.Type.(*schema.Int); ok {
+		// The first value of a proto3 enum must be zero.
+		hasZero := false
+		for _, v := range enum.Variants {
+			if v.Value.GetValue() == 0 {
+				hasZero = true
+			}
+		}
+		if !hasZero {
+			fmt.Fprintf(w, "  %s_UNSPECIFIED = 0;\n", prefix)
+		}
+		for _, v := range enum.Variants {
+			comment(w, "  ", "//", v.Comments)
+			fmt.Fprintf(w, "  %s_%s = %d;\n", prefix, strcase.ToUpperSnake(v.Name), v.Value.GetValue())
+		}
+	} else {
+		for i, v := range enum.Variants {
+			comment(w, "  ", "//", v.Comments)
+			fmt.Fprintf(w, "  %s_%s = %d;\n", prefix, strcase.ToUpperSnake(v.Name), i)
+		}
+	}
+	w.WriteString("}\n")
+	return nil
+}
+
+// field returns the type of a field, including any label.
+func (g *protoGen) field(t schema.Type) (string, error) {
+	if alias, ok := g.alias(t); ok {
+		return g.field(alias.Type)
+	}
+	switch t := t.(type) {
+	case *schema.Optional:
+		inner := t.Type
+		if alias, ok := g.alias(inner); ok {
+			inner = alias.Type
+		}
+		switch inner.(type) {
+		case *schema.Array, *schema.Map:
+			// Repeated fields and maps can not be optional, but can be empty.
+			return g.field(inner)
+		default:
+		}
+		typ, err := g.typ(inner)
+		if err != nil {
+			return "", err
+		}
+		return "optional " + typ, nil
+
+	case *schema.Array:
+		typ, err := g.typ(stripOptional(t.Element))
+		if err != nil {
+			return "", err
+		}
+		return "repeated " + typ, nil
+
+	case *schema.Map:
+		switch t.Key.(type) {
+		case *schema.String, *schema.Int, *schema.Bool:
+		default:
+			return "", fmt.Errorf("map keys of type %s are not supported by protobuf", t.Key)
+		}
+		key, err := g.typ(t.Key)
+		if err != nil {
+			return "", err
+		}
+		value, err := g.typ(stripOptional(t.Value))
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("map<%s, %s>", key, value), nil
+
+	default:
+		return g.typ(t)
+	}
+}
+
+// typ returns the protobuf type of a scalar or message.
+func (g *protoGen) typ(t schema.Type) (string, error) {
+	switch t := t.(type) {
+	case *schema.Int:
+		return "int64", nil
+	case *schema.Float:
+		return "double", nil
+	case *schema.String, *schema.UUID, *schema.Decimal, *schema.Timestamp:
+		return "string", nil
+	case *schema.Bytes:
+		return "bytes", nil
+	case *schema.Bool:
+		return "bool", nil
+	case *schema.Time:
+		g.imports["google/protobuf/timestamp.proto"] = true
+		return "google.protobuf.Timestamp", nil
+	case *schema.Any:
+		g.imports["google/protobuf/struct.proto"] = true
+		return "google.protobuf.Value", nil
+	case *schema.Unit:
+		g.imports["google/protobuf/empty.proto"] = true
+		return "google.protobuf.Empty", nil
+	case *schema.Ref:
+		if alias, ok := g.alias(t); ok {
+			return g.typ(alias.Type)
+		}
+		if len(t.TypeParameters) > 0 {
+			name := instanceName(t)
+			g.instances[name] = t
+			return name, nil
+		}
+		if t.Module == "" || t.Module == g.module.Name {
+			return t.Name, nil
+		}
+		g.imports[t.Module+".proto"] = true
+		return "ftl." + t.Module + "." + t.Name, nil
+	case *schema.Array, *schema.Map, *schema.Optional:
+		return "", fmt.Errorf("nested %s is not supported by protobuf", t)
+	}
+	return "", fmt.Errorf("unsupported type %T", t)
+}
+
+// alias resolves a reference to a type alias.
+func (g *protoGen) alias(t schema.Type) (*schema.TypeAlias, bool) {
+	ref, ok := t.(*schema.Ref)
+	if !ok {
+		return nil, false
+	}
+	resolved := *ref
+	if resolved.Module == "" {
+		resolved.Module = g.module.Name
+	}
+	decl, ok := g.sch.Resolve(&resolved).Get()
+	if !ok {
+		return nil, false
+	}
+	alias, ok := decl.(*schema.TypeAlias)
+	return alias, ok
+}
+
+func stripOptional(t schema.Type) schema.Type {
+	if opt, ok := t.(*schema.Optional); ok {
+		return opt.Type
+	}
+	return t
+}
+
+// instanceName returns the message name for an instantiation of a generic
+// data type, eg. "Pair<String, foo.Item>" becomes "PairStringItem".
+func instanceName(t schema.Type) string {
+	switch t := t.(type) {
+	case *schema.Ref:
+		name := t.Name
+		for _, tp := range t.TypeParameters {
+			name += instanceName(tp)
+		}
+		return name
+	case *schema.Array:
+		return "List" + instanceName(t.Element)
+	case *schema.Map:
+		return "Map" + instanceName(t.Key) + instanceName(t.Value)
+	case *schema.Optional:
+		return "Optional" + instanceName(t.Type)
+	default:
+		return strcase.ToUpperCamel(t.String())
+	}
+}
@@ -60,6 +60,16 @@ func (f *Field) Alias(kind AliasKind) optional.Option[string] {
 	return optional.None[string]()
 }
 
+// Deprecated returns the deprecation metadata of the field, if any.
+func (f *Field) Deprecated() optional.Option[*MetadataDeprecated] {
+	for _, md := range f.Metadata {
+		if d, ok := md.(*MetadataDeprecated); ok {
+			return optional.Some(d)
+		}
+	}
+	return optional.None[*MetadataDeprecated]()
+}
+
 func fieldListToSchema(s []*schemapb.Field) []*Field {
 	var out []*Field
 	for _, n := range s {
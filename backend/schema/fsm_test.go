@@ -4,6 +4,9 @@ import (
 	"testing"
 
 	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/internal/slices"
 )
 
 func TestFSM(t *testing.T) {
@@ -28,3 +31,37 @@ func TestFSM(t *testing.T) {
 	}
 	assert.Equal(t, []*Ref{{Name: "end"}}, f.TerminalStates())
 }
+
+func TestSagaFSM(t *testing.T) {
+	ref := func(name string) *Ref { return &Ref{Module: "shop", Name: name} }
+	key := func(name string) RefKey { return ref(name).ToRefKey() }
+	steps := []*SagaStep{
+		{Verb: ref("reserve"), Compensation: ref("release")},
+		{Verb: ref("check")},
+		{Verb: ref("charge"), Compensation: ref("refund")},
+		{Verb: ref("ship")},
+	}
+	fsm := SagaFSM(Position{}, "checkout", steps)
+	assert.Equal(t, []*Ref{ref("reserve")}, fsm.Start)
+	assert.Equal(t, []string{
+		"shop.reserve to shop.check",
+		"shop.reserve to shop.release",
+		"shop.check to shop.charge",
+		"shop.check to shop.release",
+		"shop.charge to shop.ship",
+		"shop.charge to shop.refund",
+		"shop.refund to shop.release",
+	}, slices.Map(fsm.Transitions, (*FSMTransition).String))
+	assert.Equal(t, []*Ref{ref("release"), ref("ship")}, fsm.TerminalStates())
+
+	saga, ok := fsm.GetMetadataSaga().Get()
+	assert.True(t, ok)
+	assert.Equal(t, optional.Some(ref("check")), saga.Next(key("reserve")))
+	assert.Equal(t, optional.None[*Ref](), saga.Next(key("ship")))
+	assert.Equal(t, optional.Some(ref("release")), saga.Next(key("refund")))
+	assert.Equal(t, optional.None[*Ref](), saga.Next(key("release")))
+
+	assert.Equal(t, optional.None[*Ref](), saga.Compensate(optional.None[RefKey]()))
+	assert.Equal(t, optional.Some(ref("release")), saga.Compensate(optional.Some(key("check"))))
+	assert.Equal(t, optional.Some(ref("refund")), saga.Compensate(optional.Some(key("charge"))))
+}
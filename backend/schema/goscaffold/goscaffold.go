@@ -0,0 +1,235 @@
+// Package goscaffold generates the source of Go FTL modules from schemas, for
+// modules scaffolded from the descriptions of existing services.
+package goscaffold
+
+import (
+	"fmt"
+	"go/format"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/exp/maps"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/backend/schema/strcase"
+)
+
+// Options for [Generate].
+type Options struct {
+	// Header is inserted before the package clause, eg. a "Code generated"
+	// comment.
+	Header string
+	// Imports used by Preamble and VerbBody.
+	Imports []string
+	// Preamble is inserted before the declarations, eg. variables used by the
+	// bodies of verbs.
+	Preamble string
+	// VerbBody returns the statements of the function implementing "verb",
+	// whose request and response have the Go types "req" and "resp".
+	//
+	// Verbs whose request is Unit have no request parameter, and verbs whose
+	// response is Unit only return an error.
+	VerbBody func(verb *schema.Verb, req, resp string) string
+}
+
+// Generate generates the source of a Go FTL module declaring the types and
+// verbs of module "m".
+func Generate(m *schema.Module, options Options) ([]byte, error) {
+	g := &goGen{module: m, imports: map[string]bool{}}
+	for _, imp := range options.Imports {
+		g.imports[imp] = true
+	}
+	body := &strings.Builder{}
+	if options.Preamble != "" {
+		body.WriteString("\n" + options.Preamble)
+	}
+	for _, decl := range m.Decls {
+		body.WriteString("\n")
+		switch decl := decl.(type) {
+		case *schema.Data:
+			comment(body, "", decl.Comments)
+			fmt.Fprintf(body, "//%s\ntype %s struct {\n", directive("data", decl.Export), decl.Name)
+			for _, field := range decl.Fields {
+				comment(body, "\t", field.Comments)
+				fmt.Fprintf(body, "\t%s %s", strcase.ToUpperCamel(field.Name), g.typ(field.Type))
+				for _, md := range field.Metadata {
+					if alias, ok := md.(*schema.MetadataAlias); ok && alias.Kind == schema.AliasKindJSON {
+						fmt.Fprintf(body, " `json:%q`", alias.Alias)
+					}
+				}
+				body.WriteString("\n")
+			}
+			body.WriteString("}\n")
+
+		case *schema.Enum:
+			comment(body, "", decl.Comments)
+			fmt.Fprintf(body, "//%s\ntype %s %s\n\nconst (\n", directive("enum", decl.Export), decl.Name, g.typ(decl.Type))
+			for _, v := range decl.Variants {
+				comment(body, "\t", v.Comments)
+				fmt.Fprintf(body, "\t%s %s = %s\n", v.Name, decl.Name, goValue(v.Value))
+			}
+			body.WriteString(")\n")
+
+		case *schema.TypeAlias:
+			comment(body, "", decl.Comments)
+			fmt.Fprintf(body, "//%s\ntype %s %s\n", directive("typealias", decl.Export), decl.Name, g.typ(decl.Type))
+
+		case *schema.Verb:
+			g.verb(body, decl, options.VerbBody)
+
+		default:
+		}
+	}
+
+	w := &strings.Builder{}
+	if options.Header != "" {
+		w.WriteString(options.Header + "\n\n")
+	}
+	comment(w, "", m.Comments)
+	fmt.Fprintf(w, "package %s\n\nimport (\n", m.Name)
+	imports := maps.Keys(g.imports)
+	sort.Slice(imports, func(i, j int) bool {
+		// Standard library imports come first.
+		if std := isStd(imports[i]); std != isStd(imports[j]) {
+			return std
+		}
+		return imports[i] < imports[j]
+	})
+	for i, imp := range imports {
+		if i > 0 && isStd(imports[i-1]) && !isStd(imp) {
+			w.WriteString("\n")
+		}
+		if name, path, ok := strings.Cut(imp, " "); ok {
+			fmt.Fprintf(w, "\t%s %q\n", name, path)
+		} else {
+			fmt.Fprintf(w, "\t%q\n", imp)
+		}
+	}
+	w.WriteString(")\n")
+	w.WriteString(body.String())
+	out, err := format.Source([]byte(w.String()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to format generated Go: %w", err)
+	}
+	return out, nil
+}
+
+func (g *goGen) verb(w *strings.Builder, verb *schema.Verb, body func(verb *schema.Verb, req, resp string) string) {
+	comment(w, "", verb.Comments)
+	if len(verb.Comments) > 0 {
+		w.WriteString("//\n")
+	}
+	ingress := false
+	for _, md := range verb.Metadata {
+		if md, ok := md.(*schema.MetadataIngress); ok {
+			ingress = true
+			path := make([]string, len(md.Path))
+			for i, c := range md.Path {
+				if p, ok := c.(*schema.IngressPathParameter); ok {
+					path[i] = "{" + p.Name + "}"
+				} else {
+					path[i] = c.String()
+				}
+			}
+			fmt.Fprintf(w, "//ftl:ingress http %s /%s\n", md.Method, strings.Join(path, "/"))
+		}
+	}
+	if !ingress {
+		fmt.Fprintf(w, "//%s\n", directive("verb", verb.Export))
+	}
+	g.imports["context"] = true
+	req, resp := g.typ(verb.Request), g.typ(verb.Response)
+	fmt.Fprintf(w, "func %s(ctx context.Context", strcase.ToUpperCamel(verb.Name))
+	if _, ok := verb.Request.(*schema.Unit); !ok {
+		fmt.Fprintf(w, ", req %s", req)
+	}
+	if _, ok := verb.Response.(*schema.Unit); ok {
+		w.WriteString(") error {\n")
+	} else {
+		fmt.Fprintf(w, ") (%s, error) {\n", resp)
+	}
+	fmt.Fprintf(w, "%s\n}\n", body(verb, req, resp))
+}
+
+type goGen struct {
+	module  *schema.Module
+	imports map[string]bool
+}
+
+func (g *goGen) typ(t schema.Type) string {
+	switch t := t.(type) {
+	case *schema.Ref:
+		desc := t.Name
+		if t.Module != "" && t.Module != g.module.Name {
+			g.imports["ftl/"+t.Module] = true
+			desc = t.Module + "." + t.Name
+		}
+		if len(t.TypeParameters) > 0 {
+			params := make([]string, len(t.TypeParameters))
+			for i, tp := range t.TypeParameters {
+				params[i] = g.typ(tp)
+			}
+			desc += "[" + strings.Join(params, ", ") + "]"
+		}
+		return desc
+	case *schema.Int:
+		return "int"
+	case *schema.Float:
+		return "float64"
+	case *schema.String:
+		return "string"
+	case *schema.Bool:
+		return "bool"
+	case *schema.Bytes:
+		return "[]byte"
+	case *schema.Time:
+		g.imports["time"] = true
+		return "time.Time"
+	case *schema.Array:
+		return "[]" + g.typ(t.Element)
+	case *schema.Map:
+		return "map[" + g.typ(t.Key) + "]" + g.typ(t.Value)
+	case *schema.Optional:
+		g.imports["github.com/TBD54566975/ftl/go-runtime/ftl"] = true
+		return "ftl.Option[" + g.typ(t.Type) + "]"
+	case *schema.Unit:
+		g.imports["github.com/TBD54566975/ftl/go-runtime/ftl"] = true
+		return "ftl.Unit"
+	case *schema.Any:
+		return "any"
+	}
+	panic(fmt.Sprintf("unsupported type %T", t))
+}
+
+func isStd(imp string) bool {
+	_, path, ok := strings.Cut(imp, " ")
+	if !ok {
+		path = imp
+	}
+	return !strings.Contains(strings.Split(path, "/")[0], ".") && !strings.HasPrefix(path, "ftl/")
+}
+
+func directive(kind string, export bool) string {
+	if export {
+		return "ftl:" + kind + " export"
+	}
+	return "ftl:" + kind
+}
+
+func comment(w *strings.Builder, indent string, comments []string) {
+	for _, c := range comments {
+		fmt.Fprintf(w, "%s// %s\n", indent, c)
+	}
+}
+
+func goValue(v schema.Value) string {
+	switch v := v.(type) {
+	case *schema.StringValue:
+		return strconv.Quote(v.Value)
+	case *schema.IntValue:
+		return strconv.Itoa(v.Value)
+	default:
+		return fmt.Sprint(v.GetValue())
+	}
+}
@@ -0,0 +1,64 @@
+package grpc
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"golang.org/x/exp/maps"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/backend/schema/goscaffold"
+	"github.com/TBD54566975/ftl/backend/schema/strcase"
+)
+
+// descriptorsFile is the file the descriptors are embedded in the module from.
+const descriptorsFile = "descriptors.binpb"
+
+// Files returns the files of the Go module proxying to the services, by name
+// relative to the module directory.
+func (m *Module) Files() (map[string][]byte, error) {
+	// Variable of each service, by full name.
+	services := map[string]string{}
+	for _, method := range m.Methods {
+		services[method.Service] = ""
+	}
+	names := maps.Keys(services)
+	sort.Strings(names)
+	preamble := &strings.Builder{}
+	preamble.WriteString("// URL of the gRPC server, eg. https://api.example.com.\n")
+	preamble.WriteString("var endpoint = ftl.Config[string](\"endpoint\")\n\n")
+	fmt.Fprintf(preamble, "//go:embed %s\nvar descriptors []byte\n\n", descriptorsFile)
+	for j, name := range names {
+		services[name] = lowerCamel(strings.TrimSuffix(name[strings.LastIndex(name, ".")+1:], "Service")) + "Service"
+		if j > 0 && services[name] == services[names[j-1]] {
+			services[name] += fmt.Sprint(j + 1)
+		}
+		fmt.Fprintf(preamble, "var %s = grpcproxy.New(descriptors, %q, endpoint)\n", services[name], name)
+	}
+
+	source, err := goscaffold.Generate(m.Schema, goscaffold.Options{
+		Header:   "// Code generated by ftl import grpc. DO NOT EDIT.",
+		Imports:  []string{"_ embed", "github.com/TBD54566975/ftl/go-runtime/ftl", "github.com/TBD54566975/ftl/go-runtime/ftl/grpcproxy"},
+		Preamble: preamble.String(),
+		VerbBody: func(verb *schema.Verb, req, resp string) string {
+			method := m.Methods[verb.Name]
+			arg := "req"
+			if _, ok := verb.Request.(*schema.Unit); ok {
+				arg = "ftl.Unit{}"
+			}
+			call := fmt.Sprintf("grpcproxy.Call[%s, %s](ctx, %s, %q, %s)", req, resp, services[method.Service], method.Name, arg)
+			if _, ok := verb.Response.(*schema.Unit); ok {
+				return fmt.Sprintf("_, err := %s\nreturn err", call)
+			}
+			return "return " + call
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return map[string][]byte{
+		strings.ToLower(strcase.ToUpperCamel(m.Schema.Name)) + ".go": source,
+		descriptorsFile: m.Descriptors,
+	}, nil
+}
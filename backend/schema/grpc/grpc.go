@@ -0,0 +1,323 @@
+// Package grpc imports external gRPC services as FTL modules, whose verbs
+// proxy to the service through [grpcproxy].
+//
+// [grpcproxy]: https://pkg.go.dev/github.com/TBD54566975/ftl/go-runtime/ftl/grpcproxy
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/bufbuild/protocompile"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/backend/schema/strcase"
+)
+
+// Module is a set of gRPC services imported as an FTL module.
+type Module struct {
+	Schema *schema.Module
+	// Descriptors of the proto files defining the services and their
+	// dependencies, as a serialised FileDescriptorSet.
+	Descriptors []byte
+	// Methods proxied by each verb, by verb name.
+	Methods map[string]Method
+}
+
+// Method is a unary gRPC method.
+type Method struct {
+	// Full name of the service, eg. "helloworld.Greeter".
+	Service string
+	// Name of the method, eg. "SayHello".
+	Name string
+}
+
+// Import compiles proto "files", resolving their imports from "importPaths",
+// and imports the services they define as module "module".
+//
+// "module" defaults to the last component of the package of the first file.
+// Each unary method becomes an exported verb, and the messages and enums it
+// uses become data types and enums. Streaming methods are skipped.
+func Import(ctx context.Context, files []string, importPaths []string, module string) (*Module, error) {
+	names, err := relativeTo(files, importPaths)
+	if err != nil {
+		return nil, err
+	}
+	compiler := protocompile.Compiler{
+		Resolver:       protocompile.WithStandardImports(&protocompile.SourceResolver{ImportPaths: importPaths}),
+		SourceInfoMode: protocompile.SourceInfoStandard,
+	}
+	compiled, err := compiler.Compile(ctx, names...)
+	if err != nil {
+		return nil, fmt.Errorf("could not compile proto files: %w", err)
+	}
+	if module == "" {
+		module = moduleName(string(compiled[0].Package()))
+	}
+	i := &importer{
+		module: &Module{Schema: &schema.Module{Name: module}, Methods: map[string]Method{}},
+		names:  map[string]bool{},
+		decls:  map[protoreflect.FullName]string{},
+	}
+	// Declared by the generated module to proxy to the services.
+	i.uniqueName("descriptors")
+	i.module.Schema.Decls = append(i.module.Schema.Decls, &schema.Config{
+		Comments: []string{"URL of the gRPC server, eg. https://api.example.com."},
+		Name:     i.uniqueName("endpoint"),
+		Type:     &schema.String{},
+	})
+	set := &descriptorpb.FileDescriptorSet{}
+	seen := map[string]bool{}
+	for _, file := range compiled {
+		addFile(set, seen, file)
+		services := file.Services()
+		for j := range services.Len() {
+			i.service(services.Get(j))
+		}
+	}
+	if len(i.module.Methods) == 0 {
+		return nil, fmt.Errorf("%s define no unary gRPC methods", strings.Join(files, ", "))
+	}
+	i.module.Descriptors, err = proto.Marshal(set)
+	if err != nil {
+		return nil, err
+	}
+	return i.module, nil
+}
+
+var versionRe = regexp.MustCompile(`^v[0-9]+((alpha|beta)[0-9]*)?$`)
+
+// moduleName returns the default module name for proto package "pkg", its
+// last component other than a version, eg. "greeter" for "greeter.v1".
+func moduleName(pkg string) string {
+	parts := strings.Split(pkg, ".")
+	name := parts[len(parts)-1]
+	if len(parts) > 1 && versionRe.MatchString(name) {
+		name = parts[len(parts)-2]
+	}
+	return strings.ToLower(upperCamel(name))
+}
+
+// relativeTo returns the names of "files" relative to the import path that
+// contains them, as proto files are identified by their import path.
+func relativeTo(files, importPaths []string) ([]string, error) {
+	out := make([]string, len(files))
+	for j, file := range files {
+		out[j] = file
+		for _, importPath := range importPaths {
+			rel, err := filepath.Rel(importPath, file)
+			if err != nil {
+				return nil, err
+			}
+			if !strings.HasPrefix(rel, "..") {
+				out[j] = filepath.ToSlash(rel)
+				break
+			}
+		}
+	}
+	return out, nil
+}
+
+// addFile adds "file" and its dependencies to "set", dependencies first.
+func addFile(set *descriptorpb.FileDescriptorSet, seen map[string]bool, file protoreflect.FileDescriptor) {
+	if seen[file.Path()] {
+		return
+	}
+	seen[file.Path()] = true
+	imports := file.Imports()
+	for j := range imports.Len() {
+		addFile(set, seen, imports.Get(j).FileDescriptor)
+	}
+	set.File = append(set.File, protodesc.ToFileDescriptorProto(file))
+}
+
+type importer struct {
+	module *Module
+	// Lower-cased names of declarations, which must be unique in Go.
+	names map[string]bool
+	// Declaration names of imported messages and enums.
+	decls map[protoreflect.FullName]string
+}
+
+func (i *importer) service(sd protoreflect.ServiceDescriptor) {
+	methods := sd.Methods()
+	for j := range methods.Len() {
+		md := methods.Get(j)
+		if md.IsStreamingClient() || md.IsStreamingServer() {
+			continue
+		}
+		name := i.uniqueName(lowerCamel(string(md.Name())))
+		i.module.Methods[name] = Method{Service: string(sd.FullName()), Name: string(md.Name())}
+		i.module.Schema.Decls = append(i.module.Schema.Decls, &schema.Verb{
+			Comments: comments(md),
+			Export:   true,
+			Name:     name,
+			Request:  i.messageType(md.Input()),
+			Response: i.messageType(md.Output()),
+		})
+	}
+}
+
+// messageType returns the FTL type of messages of type "md".
+func (i *importer) messageType(md protoreflect.MessageDescriptor) schema.Type {
+	switch md.FullName() {
+	case "google.protobuf.Empty":
+		return &schema.Unit{}
+	case "google.protobuf.Timestamp":
+		return &schema.Time{}
+	case "google.protobuf.Duration", "google.protobuf.FieldMask":
+		return &schema.String{}
+	case "google.protobuf.Struct":
+		return &schema.Map{Key: &schema.String{}, Value: &schema.Any{}}
+	case "google.protobuf.ListValue":
+		return &schema.Array{Element: &schema.Any{}}
+	case "google.protobuf.Value", "google.protobuf.Any":
+		return &schema.Any{}
+	case "google.protobuf.DoubleValue", "google.protobuf.FloatValue":
+		return &schema.Optional{Type: &schema.Float{}}
+	case "google.protobuf.Int64Value", "google.protobuf.UInt64Value", "google.protobuf.Int32Value", "google.protobuf.UInt32Value":
+		return &schema.Optional{Type: &schema.Int{}}
+	case "google.protobuf.BoolValue":
+		return &schema.Optional{Type: &schema.Bool{}}
+	case "google.protobuf.StringValue":
+		return &schema.Optional{Type: &schema.String{}}
+	case "google.protobuf.BytesValue":
+		return &schema.Optional{Type: &schema.Bytes{}}
+	}
+	if name, ok := i.decls[md.FullName()]; ok {
+		return &schema.Ref{Module: i.module.Schema.Name, Name: name}
+	}
+	data := &schema.Data{Comments: comments(md), Export: true, Name: i.declName(md)}
+	// Declared before its fields, which may refer to it.
+	i.module.Schema.Decls = append(i.module.Schema.Decls, data)
+	fields := md.Fields()
+	for j := range fields.Len() {
+		fd := fields.Get(j)
+		data.Fields = append(data.Fields, &schema.Field{
+			Comments: comments(fd),
+			Name:     fieldName(fd),
+			Type:     i.fieldType(fd),
+		})
+	}
+	return &schema.Ref{Module: i.module.Schema.Name, Name: data.Name}
+}
+
+func (i *importer) fieldType(fd protoreflect.FieldDescriptor) schema.Type {
+	if fd.IsMap() {
+		var key schema.Type = &schema.String{}
+		if k := fd.MapKey().Kind(); k != protoreflect.StringKind && k != protoreflect.BoolKind {
+			key = &schema.Int{}
+		}
+		return &schema.Map{Key: key, Value: i.valueType(fd.MapValue())}
+	}
+	t := i.valueType(fd)
+	if fd.IsList() {
+		return &schema.Array{Element: t}
+	}
+	if _, ok := t.(*schema.Optional); !ok && fd.HasPresence() {
+		t = &schema.Optional{Type: t}
+	}
+	return t
+}
+
+func (i *importer) valueType(fd protoreflect.FieldDescriptor) schema.Type {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return &schema.Bool{}
+	case protoreflect.EnumKind:
+		return i.enumType(fd.Enum())
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return &schema.Float{}
+	case protoreflect.StringKind:
+		return &schema.String{}
+	case protoreflect.BytesKind:
+		return &schema.Bytes{}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return i.messageType(fd.Message())
+	default:
+		return &schema.Int{}
+	}
+}
+
+func (i *importer) enumType(ed protoreflect.EnumDescriptor) schema.Type {
+	if name, ok := i.decls[ed.FullName()]; ok {
+		return &schema.Ref{Module: i.module.Schema.Name, Name: name}
+	}
+	enum := &schema.Enum{Comments: comments(ed), Export: true, Name: i.declName(ed), Type: &schema.Int{}}
+	prefix := strcase.ToUpperSnake(string(ed.Name())) + "_"
+	values := ed.Values()
+	for j := range values.Len() {
+		vd := values.Get(j)
+		// Proto enum values are conventionally prefixed with the name of
+		// their enum, eg. STATUS_ACTIVE.
+		name := strings.TrimPrefix(string(vd.Name()), prefix)
+		enum.Variants = append(enum.Variants, &schema.EnumVariant{
+			Comments: comments(vd),
+			Name:     i.uniqueName(enum.Name + upperCamel(strings.ToLower(name))),
+			Value:    &schema.IntValue{Value: int(vd.Number())},
+		})
+	}
+	i.module.Schema.Decls = append(i.module.Schema.Decls, enum)
+	return &schema.Ref{Module: i.module.Schema.Name, Name: enum.Name}
+}
+
+// declName returns a unique declaration name for message or enum "d", named
+// after its nested name within its package, eg. "OuterInner".
+func (i *importer) declName(d protoreflect.Descriptor) string {
+	nested := strings.TrimPrefix(string(d.FullName()), string(d.ParentFile().Package())+".")
+	name := i.uniqueName(upperCamel(nested))
+	i.decls[d.FullName()] = name
+	return name
+}
+
+// uniqueName returns "name", suffixed with a number if it is already used.
+func (i *importer) uniqueName(name string) string {
+	unique := name
+	for n := 2; i.names[strings.ToLower(unique)]; n++ {
+		unique = fmt.Sprintf("%s%d", name, n)
+	}
+	i.names[strings.ToLower(unique)] = true
+	return unique
+}
+
+// fieldName returns the FTL field name of proto field "fd".
+//
+// This must match grpcproxy.FieldName.
+func fieldName(fd protoreflect.FieldDescriptor) string {
+	return strcase.ToLowerCamel(fd.JSONName())
+}
+
+func comments(d protoreflect.Descriptor) []string {
+	comment := strings.TrimSpace(d.ParentFile().SourceLocations().ByDescriptor(d).LeadingComments)
+	if comment == "" {
+		return nil
+	}
+	lines := strings.Split(comment, "\n")
+	for j, line := range lines {
+		lines[j] = strings.TrimSpace(line)
+	}
+	return lines
+}
+
+func upperCamel(s string) string {
+	return strings.ReplaceAll(strcase.ToUpperCamel(words(s)), " ", "")
+}
+
+func lowerCamel(s string) string {
+	return strings.ReplaceAll(strcase.ToLowerCamel(words(s)), " ", "")
+}
+
+// words splits "s" into space separated words at the characters that can't be
+// used in names.
+func words(s string) string {
+	return strings.Join(strings.FieldsFunc(s, func(r rune) bool {
+		return !((r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9'))
+	}), " ")
+}
@@ -0,0 +1,99 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+func TestImport(t *testing.T) {
+	actual, err := Import(context.Background(), []string{"testdata/greeter/v1/greeter.proto"}, []string{"testdata"}, "")
+	assert.NoError(t, err)
+	assert.NoError(t, schema.ValidateModule(actual.Schema))
+
+	expected, err := schema.ParseModuleString("", `
+module greeter {
+  // URL of the gRPC server, eg. https://api.example.com.
+  config endpoint String
+
+  export data SayHelloRequest {
+    // Name of the person to greet.
+    name String
+    language greeter.Language
+    times Int?
+    title String?
+    addresses {String: greeter.PersonAddress}
+  }
+
+  export enum Language: Int {
+    LanguageUnspecified = 0
+    LanguageEnglish = 1
+    LanguageBritishEnglish = 2
+  }
+
+  export data PersonAddress {
+    city String
+  }
+
+  export data SayHelloResponse {
+    greetings [String]
+    greetedAt Time?
+    person greeter.Person?
+  }
+
+  export data Person {
+    displayName String
+    addresses [greeter.PersonAddress]
+  }
+
+  // Greets a person.
+  export verb sayHello(greeter.SayHelloRequest) greeter.SayHelloResponse
+
+  // Forgets everyone greeted.
+  export verb reset(Unit) Unit
+}
+`)
+	assert.NoError(t, err)
+	assert.Equal(t, expected.String(), actual.Schema.String())
+	assert.Equal(t, map[string]Method{
+		"sayHello": {Service: "greeter.v1.GreeterService", Name: "SayHello"},
+		"reset":    {Service: "greeter.v1.GreeterService", Name: "Reset"},
+	}, actual.Methods)
+
+	set := &descriptorpb.FileDescriptorSet{}
+	assert.NoError(t, proto.Unmarshal(actual.Descriptors, set))
+	files := []string{}
+	for _, file := range set.File {
+		files = append(files, file.GetName())
+	}
+	assert.Equal(t, []string{
+		"google/protobuf/empty.proto",
+		"google/protobuf/timestamp.proto",
+		"google/protobuf/wrappers.proto",
+		"greeter/v1/greeter.proto",
+	}, files)
+}
+
+func TestFiles(t *testing.T) {
+	m, err := Import(context.Background(), []string{"testdata/greeter/v1/greeter.proto"}, []string{"testdata"}, "")
+	assert.NoError(t, err)
+	files, err := m.Files()
+	assert.NoError(t, err)
+	assert.Equal(t, m.Descriptors, files["descriptors.binpb"])
+	source := string(files["greeter.go"])
+	for _, expected := range []string{
+		"var greeterService = grpcproxy.New(descriptors, \"greeter.v1.GreeterService\", endpoint)\n",
+		"func SayHello(ctx context.Context, req SayHelloRequest) (SayHelloResponse, error) {\n" +
+			"\treturn grpcproxy.Call[SayHelloRequest, SayHelloResponse](ctx, greeterService, \"SayHello\", req)\n}\n",
+		"func Reset(ctx context.Context) error {\n" +
+			"\t_, err := grpcproxy.Call[ftl.Unit, ftl.Unit](ctx, greeterService, \"Reset\", ftl.Unit{})\n\treturn err\n}\n",
+	} {
+		assert.True(t, strings.Contains(source, expected), "expected generated source to contain:\n%s\ngot:\n%s", expected, source)
+	}
+}
@@ -28,32 +28,90 @@ func DataToJSONSchema(sch *Schema, ref Ref) (*jsonschema.Schema, error) {
 
 	// Resolve and encode all types reachable from the root.
 	root.Definitions = map[string]jsonschema.SchemaOrBool{}
-	for _, r := range refs {
-		decl, ok := sch.Resolve(r).Get()
-		if !ok {
-			return nil, fmt.Errorf("unknown ref %s", r)
-		}
-		switch n := decl.(type) {
+	if err := jsDefinitions(sch, refs, root.Definitions); err != nil {
+		return nil, err
+	}
+	return root, nil
+}
+
+// ModuleToJSONSchema converts all data types, enums and type aliases in a
+// module to a JSON Schema with one definition per type.
+//
+// Generic data types are only included once instantiated by a reference, as
+// JSON Schema has no equivalent of type parameters.
+func ModuleToJSONSchema(sch *Schema, module string) (*jsonschema.Schema, error) {
+	m, ok := sch.Module(module).Get()
+	if !ok {
+		return nil, fmt.Errorf("unknown module %s", module)
+	}
+	root := &jsonschema.Schema{
+		Description: jsComments(m.Comments),
+		Definitions: map[string]jsonschema.SchemaOrBool{},
+	}
+	root.WithTitle(module)
+	refs := map[RefKey]*Ref{}
+	for _, decl := range m.Decls {
+		switch decl := decl.(type) {
 		case *Data:
-			if len(r.TypeParameters) > 0 {
-				monomorphisedData, err := n.Monomorphise(r)
-				if err != nil {
-					return nil, err
-				}
+			if len(decl.TypeParameters) > 0 {
+				continue
+			}
+			refs[RefKey{Module: module, Name: decl.Name}] = &Ref{Module: module, Name: decl.Name}
+		case *Enum, *TypeAlias:
+			refs[RefKey{Module: module, Name: decl.GetName()}] = &Ref{Module: module, Name: decl.GetName()}
+		default:
+		}
+	}
+	if err := jsDefinitions(sch, refs, root.Definitions); err != nil {
+		return nil, err
+	}
+	return root, nil
+}
 
-				ref := fmt.Sprintf("%s.%s", r.Module, refName(r))
-				root.Definitions[ref] = jsonschema.SchemaOrBool{TypeObject: nodeToJSSchema(monomorphisedData, refs)}
-			} else {
-				root.Definitions[r.String()] = jsonschema.SchemaOrBool{TypeObject: nodeToJSSchema(n, refs)}
+// jsDefinitions encodes the declarations of refs into definitions, including
+// any further declarations reachable from them.
+func jsDefinitions(sch *Schema, refs map[RefKey]*Ref, definitions map[string]jsonschema.SchemaOrBool) error {
+	done := map[RefKey]bool{}
+	for len(done) < len(refs) {
+		pending := []*Ref{}
+		for key, r := range refs {
+			if !done[key] {
+				done[key] = true
+				pending = append(pending, r)
+			}
+		}
+		for _, r := range pending {
+			decl, ok := sch.Resolve(r).Get()
+			if !ok {
+				return fmt.Errorf("unknown ref %s", r)
 			}
-		case *Enum:
-			root.Definitions[r.String()] = jsonschema.SchemaOrBool{TypeObject: nodeToJSSchema(n, refs)}
+			switch n := decl.(type) {
+			case *Data:
+				if len(r.TypeParameters) > 0 {
+					monomorphisedData, err := n.Monomorphise(r)
+					if err != nil {
+						return err
+					}
 
-		case *Config, *Database, *Secret, *Verb, *FSM, *TypeAlias, *Topic, *Subscription:
-			return nil, fmt.Errorf("reference to unsupported node type %T", decl)
+					ref := fmt.Sprintf("%s.%s", r.Module, refName(r))
+					definitions[ref] = jsonschema.SchemaOrBool{TypeObject: nodeToJSSchema(monomorphisedData, refs)}
+				} else {
+					definitions[r.String()] = jsonschema.SchemaOrBool{TypeObject: nodeToJSSchema(n, refs)}
+				}
+			case *Enum:
+				definitions[r.String()] = jsonschema.SchemaOrBool{TypeObject: nodeToJSSchema(n, refs)}
+
+			case *TypeAlias:
+				alias := nodeToJSSchema(n.Type, refs)
+				alias.Description = jsComments(n.Comments)
+				definitions[r.String()] = jsonschema.SchemaOrBool{TypeObject: alias}
+
+			case *Config, *Database, *Secret, *Verb, *FSM, *Topic, *Subscription:
+				return fmt.Errorf("reference to unsupported node type %T", decl)
+			}
 		}
 	}
-	return root, nil
+	return nil
 }
 
 func nodeToJSSchema(node Node, refs map[RefKey]*Ref) *jsonschema.Schema {
@@ -79,6 +137,11 @@ func nodeToJSSchema(node Node, refs map[RefKey]*Ref) *jsonschema.Schema {
 			if _, ok := field.Type.(*Optional); !ok {
 				schema.Required = append(schema.Required, field.Name)
 			}
+			for _, md := range field.Metadata {
+				if md, ok := md.(*MetadataValidate); ok {
+					jsConstraint(schema, field, jsField, md)
+				}
+			}
 			schema.Properties[field.Name] = jsonschema.SchemaOrBool{TypeObject: jsField}
 		}
 		return schema
@@ -142,6 +205,21 @@ func nodeToJSSchema(node Node, refs map[RefKey]*Ref) *jsonschema.Schema {
 		dt := "date-time"
 		return &jsonschema.Schema{Type: &jsonschema.Type{SimpleTypes: &st}, Format: &dt}
 
+	case *Timestamp:
+		st := jsonschema.String
+		pattern := timestampPattern
+		return &jsonschema.Schema{Type: &jsonschema.Type{SimpleTypes: &st}, Pattern: &pattern}
+
+	case *UUID:
+		st := jsonschema.String
+		format := "uuid"
+		return &jsonschema.Schema{Type: &jsonschema.Type{SimpleTypes: &st}, Format: &format}
+
+	case *Decimal:
+		st := jsonschema.String
+		pattern := decimalPattern
+		return &jsonschema.Schema{Type: &jsonschema.Type{SimpleTypes: &st}, Pattern: &pattern}
+
 	case *Array:
 		st := jsonschema.Array
 		return &jsonschema.Schema{
@@ -187,7 +265,8 @@ func nodeToJSSchema(node Node, refs map[RefKey]*Ref) *jsonschema.Schema {
 		*MetadataAlias, IngressPathComponent, *IngressPathLiteral, *IngressPathParameter, *Module,
 		*Schema, Type, *Database, *Verb, *EnumVariant, *MetadataCronJob, Value,
 		*StringValue, *IntValue, *TypeValue, *Config, *Secret, Symbol, Named,
-		*FSM, *FSMTransition, *TypeAlias, *MetadataRetry, *Topic, *Subscription, *MetadataSubscriber:
+		*FSM, *FSMTransition, *TypeAlias, *MetadataRetry, *Topic, *Subscription, *MetadataSubscriber,
+		*MetadataStream, *MetadataError, *MetadataConcurrency, *MetadataValidate, *MetadataDeprecated, *MetadataAllow, *MetadataCache, *MetadataSaga, *MetadataEgress, *MetadataResponse:
 		panic(fmt.Sprintf("unsupported node type %T", node))
 
 	default:
@@ -195,6 +274,68 @@ func nodeToJSSchema(node Node, refs map[RefKey]*Ref) *jsonschema.Schema {
 	}
 }
 
+// jsConstraint adds a validation constraint on "field" to its JSON Schema.
+func jsConstraint(data *jsonschema.Schema, field *Field, jsField *jsonschema.Schema, md *MetadataValidate) {
+	// Constraints on optional fields apply to the non-null value.
+	target := jsField
+	fieldType := field.Type
+	if opt, ok := fieldType.(*Optional); ok {
+		target = jsField.AnyOf[0].TypeObject
+		fieldType = opt.Type
+	}
+	switch md.Kind {
+	case ConstraintMin:
+		if value, err := md.Number(); err == nil {
+			target.WithMinimum(value)
+		}
+
+	case ConstraintMax:
+		if value, err := md.Number(); err == nil {
+			target.WithMaximum(value)
+		}
+
+	case ConstraintMinLen, ConstraintMaxLen:
+		length, err := md.Length()
+		if err != nil {
+			return
+		}
+		minimum := md.Kind == ConstraintMinLen
+		switch fieldType.(type) {
+		case *Array:
+			if minimum {
+				target.WithMinItems(int64(length))
+			} else {
+				target.WithMaxItems(int64(length))
+			}
+		case *Map:
+			if minimum {
+				target.WithMinProperties(int64(length))
+			} else {
+				target.WithMaxProperties(int64(length))
+			}
+		case *Bytes:
+			// The length of base64 encoded bytes can't be constrained exactly.
+		default:
+			if minimum {
+				target.WithMinLength(int64(length))
+			} else {
+				target.WithMaxLength(int64(length))
+			}
+		}
+
+	case ConstraintPattern:
+		target.WithPattern(md.Value)
+
+	case ConstraintRequiredIf:
+		if data.Dependencies == nil {
+			data.Dependencies = map[string]jsonschema.DependenciesAdditionalProperties{}
+		}
+		dependency := data.Dependencies[md.Value]
+		dependency.StringArray = append(dependency.StringArray, field.Name)
+		data.Dependencies[md.Value] = dependency
+	}
+}
+
 func jsBool(ok bool) *jsonschema.SchemaOrBool {
 	return &jsonschema.SchemaOrBool{TypeBoolean: &ok}
 }
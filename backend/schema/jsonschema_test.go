@@ -369,3 +369,88 @@ func TestInvalidEnumValidation(t *testing.T) {
 	err = jsonschema.Validate(v)
 	assert.Contains(t, err.Error(), "value must be one of \"0\", \"1\"")
 }
+
+func TestConstraintsJSONSchemaValidation(t *testing.T) {
+	sch, err := ParseString("", `
+		module test {
+			data Signup {
+				age Int +validate min 18
+				name String +validate maxlen 8 +validate pattern "^[a-z]+$"
+				tags [String] +validate maxlen 2
+				email String?
+				verified Bool? +validate requiredif "email"
+			}
+		}
+	`)
+	assert.NoError(t, err)
+	schema, err := DataToJSONSchema(sch, Ref{Module: "test", Name: "Signup"})
+	assert.NoError(t, err)
+	schemaJSON, err := json.Marshal(schema)
+	assert.NoError(t, err)
+	compiled, err := jsonschema.CompileString("http://ftl.block.xyz/schema.json", string(schemaJSON))
+	assert.NoError(t, err)
+
+	tests := []struct {
+		input string
+		err   string
+	}{
+		{input: `{"age": 18, "name": "alice", "tags": ["a"], "email": "a@example.com", "verified": true}`},
+		{input: `{"age": 17, "name": "alice", "tags": []}`, err: "must be >= 18"},
+		{input: `{"age": 18, "name": "alice-smith", "tags": []}`, err: "length must be <= 8"},
+		{input: `{"age": 18, "name": "Alice", "tags": []}`, err: "does not match pattern"},
+		{input: `{"age": 18, "name": "alice", "tags": ["a", "b", "c"]}`, err: "maximum 2 items required"},
+		{input: `{"age": 18, "name": "alice", "tags": [], "email": "a@example.com"}`, err: "property 'verified' is required, if 'email' property exists"},
+	}
+	for _, test := range tests {
+		var v any
+		assert.NoError(t, json.Unmarshal([]byte(test.input), &v))
+		err := compiled.Validate(v)
+		if test.err == "" {
+			assert.NoError(t, err, test.input)
+		} else {
+			assert.Error(t, err, test.input)
+			assert.Contains(t, err.Error(), test.err, test.input)
+		}
+	}
+}
+
+func TestLeafTypesJSONSchemaValidation(t *testing.T) {
+	sch, err := ParseString("", `
+		module test {
+			data Payment {
+				id UUID
+				amount Decimal(10, 2)
+				at Timestamp
+			}
+		}
+	`)
+	assert.NoError(t, err)
+	schema, err := DataToJSONSchema(sch, Ref{Module: "test", Name: "Payment"})
+	assert.NoError(t, err)
+	schemaJSON, err := json.Marshal(schema)
+	assert.NoError(t, err)
+	compiled, err := jsonschema.CompileString("http://ftl.block.xyz/schema.json", string(schemaJSON))
+	assert.NoError(t, err)
+
+	tests := []struct {
+		input string
+		err   string
+	}{
+		{input: `{"id": "0f1e2d3c-4b5a-6978-8796-a5b4c3d2e1f0", "amount": "-12.50", "at": "2024-03-10T09:00:00-04:00[America/New_York]"}`},
+		{input: `{"id": "0f1e2d3c-4b5a-6978-8796-a5b4c3d2e1f0", "amount": "12.50", "at": "2024-03-10T13:00:00Z"}`},
+		{input: `{"id": "0f1e2d3c-4b5a-6978-8796-a5b4c3d2e1f0", "amount": 12.5, "at": "2024-03-10T13:00:00Z"}`, err: "expected string, but got number"},
+		{input: `{"id": "0f1e2d3c-4b5a-6978-8796-a5b4c3d2e1f0", "amount": "1e5", "at": "2024-03-10T13:00:00Z"}`, err: "does not match pattern"},
+		{input: `{"id": "0f1e2d3c-4b5a-6978-8796-a5b4c3d2e1f0", "amount": "12.50", "at": "2024-03-10 13:00"}`, err: "does not match pattern"},
+	}
+	for _, test := range tests {
+		var v any
+		assert.NoError(t, json.Unmarshal([]byte(test.input), &v))
+		err := compiled.Validate(v)
+		if test.err == "" {
+			assert.NoError(t, err, test.input)
+		} else {
+			assert.Error(t, err, test.input)
+			assert.Contains(t, err.Error(), test.err, test.input)
+		}
+	}
+}
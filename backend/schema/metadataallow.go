@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+)
+
+// MetadataAllow restricts the modules that can call an exported verb.
+type MetadataAllow struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	Modules []string `parser:"'+' 'allow' @Ident (',' @Ident)*" protobuf:"2"`
+}
+
+var _ Metadata = (*MetadataAllow)(nil)
+
+func (*MetadataAllow) schemaMetadata()          {}
+func (m *MetadataAllow) schemaChildren() []Node { return nil }
+func (m *MetadataAllow) Position() Position     { return m.Pos }
+func (m *MetadataAllow) String() string {
+	return "+allow " + strings.Join(m.Modules, ", ")
+}
+
+func (m *MetadataAllow) ToProto() proto.Message {
+	return &schemapb.MetadataAllow{
+		Pos:     posToProto(m.Pos),
+		Modules: m.Modules,
+	}
+}
+
+// Allows returns true if verbs of "module" can call the verb.
+func (m *MetadataAllow) Allows(module string) bool {
+	for _, allowed := range m.Modules {
+		if allowed == module {
+			return true
+		}
+	}
+	return false
+}
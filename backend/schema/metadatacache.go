@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+	"github.com/TBD54566975/ftl/internal/duration"
+)
+
+// MaxCacheTTL is the longest time a verb can declare its responses cacheable for.
+const MaxCacheTTL = 24 * time.Hour
+
+// MetadataCache declares that the responses of a verb can be cached by the
+// controller for TTL.
+//
+// Calls with the same request share a cached response, or if Vary is set,
+// calls with the same values of those request fields.
+type MetadataCache struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	TTL  string   `parser:"'+' 'cache' @(Number (?! Whitespace) Ident)" protobuf:"2"`
+	Vary []string `parser:"('vary' @Ident (',' @Ident)*)?" protobuf:"3"`
+}
+
+var _ Metadata = (*MetadataCache)(nil)
+
+func (*MetadataCache) schemaMetadata()          {}
+func (m *MetadataCache) schemaChildren() []Node { return nil }
+func (m *MetadataCache) Position() Position     { return m.Pos }
+func (m *MetadataCache) String() string {
+	if len(m.Vary) == 0 {
+		return "+cache " + m.TTL
+	}
+	return "+cache " + m.TTL + " vary " + strings.Join(m.Vary, ", ")
+}
+
+func (m *MetadataCache) ToProto() proto.Message {
+	return &schemapb.MetadataCache{
+		Pos:  posToProto(m.Pos),
+		Ttl:  m.TTL,
+		Vary: m.Vary,
+	}
+}
+
+// TTLDuration parses the TTL of the cached responses.
+func (m *MetadataCache) TTLDuration() (time.Duration, error) {
+	ttl, err := duration.Parse(m.TTL)
+	if err != nil {
+		return 0, fmt.Errorf("could not parse cache TTL: %w", err)
+	}
+	if ttl <= 0 {
+		return 0, fmt.Errorf("cache TTL must be positive")
+	}
+	if ttl > MaxCacheTTL {
+		return 0, fmt.Errorf("cache TTL can not be longer than 1d")
+	}
+	return ttl, nil
+}
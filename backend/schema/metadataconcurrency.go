@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+)
+
+// DefaultConcurrencyQueue is the number of calls that wait for a verb with a
+// concurrency limit when no queue length is declared.
+const DefaultConcurrencyQueue = 100
+
+// MetadataConcurrency limits the number of concurrent executions of a verb in each runner.
+//
+// Calls beyond the limit wait in a queue, and are rejected once the queue is full.
+type MetadataConcurrency struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	Max   int  `parser:"'+' 'concurrency' @Number" protobuf:"2"`
+	Queue *int `parser:"('queue' @Number)?" protobuf:"3,optional"`
+}
+
+var _ Metadata = (*MetadataConcurrency)(nil)
+
+func (*MetadataConcurrency) schemaMetadata()          {}
+func (m *MetadataConcurrency) schemaChildren() []Node { return nil }
+func (m *MetadataConcurrency) Position() Position     { return m.Pos }
+func (m *MetadataConcurrency) String() string {
+	components := []string{"+concurrency", strconv.Itoa(m.Max)}
+	if m.Queue != nil {
+		components = append(components, "queue", strconv.Itoa(*m.Queue))
+	}
+	return strings.Join(components, " ")
+}
+
+// QueueLength returns the declared queue length, or [DefaultConcurrencyQueue].
+func (m *MetadataConcurrency) QueueLength() int {
+	if m.Queue != nil {
+		return *m.Queue
+	}
+	return DefaultConcurrencyQueue
+}
+
+func (m *MetadataConcurrency) ToProto() proto.Message {
+	var queue *int64
+	if m.Queue != nil {
+		queue = proto.Int64(int64(*m.Queue))
+	}
+	return &schemapb.MetadataConcurrency{
+		Pos:   posToProto(m.Pos),
+		Max:   int64(m.Max),
+		Queue: queue,
+	}
+}
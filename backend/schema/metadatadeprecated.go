@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+)
+
+// MetadataDeprecated marks a verb or field as deprecated, with an optional
+// message describing what to use instead.
+type MetadataDeprecated struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	Deprecated bool   `parser:"'+' @'deprecated'" protobuf:"-"`
+	Message    string `parser:"@String?" protobuf:"2"`
+}
+
+var _ Metadata = (*MetadataDeprecated)(nil)
+
+func (*MetadataDeprecated) schemaMetadata()          {}
+func (m *MetadataDeprecated) schemaChildren() []Node { return nil }
+func (m *MetadataDeprecated) Position() Position     { return m.Pos }
+func (m *MetadataDeprecated) String() string {
+	if m.Message == "" {
+		return "+deprecated"
+	}
+	return fmt.Sprintf("+deprecated %q", m.Message)
+}
+
+func (m *MetadataDeprecated) ToProto() proto.Message {
+	return &schemapb.MetadataDeprecated{
+		Pos:     posToProto(m.Pos),
+		Message: m.Message,
+	}
+}
@@ -0,0 +1,100 @@
+package schema
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+)
+
+// MetadataEgress declares the external HTTP origins a verb calls, eg.
+// "https://api.stripe.com".
+//
+// Runners only allow modules to make HTTP requests to the origins declared by
+// their verbs.
+type MetadataEgress struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	URLs []string `parser:"'+' 'egress' @String (',' @String)*" protobuf:"2"`
+}
+
+var _ Metadata = (*MetadataEgress)(nil)
+
+func (*MetadataEgress) schemaMetadata()          {}
+func (m *MetadataEgress) schemaChildren() []Node { return nil }
+func (m *MetadataEgress) Position() Position     { return m.Pos }
+func (m *MetadataEgress) String() string {
+	urls := make([]string, len(m.URLs))
+	for i, u := range m.URLs {
+		urls[i] = strconv.Quote(u)
+	}
+	return "+egress " + strings.Join(urls, ", ")
+}
+
+func (m *MetadataEgress) ToProto() proto.Message {
+	return &schemapb.MetadataEgress{
+		Pos:  posToProto(m.Pos),
+		Urls: m.URLs,
+	}
+}
+
+// EgressOrigin is an external HTTP origin declared with +egress.
+type EgressOrigin struct {
+	Scheme string
+	// Host, or "*." followed by a domain to match any of its subdomains.
+	Host string
+	Port string
+}
+
+// ParseEgressOrigin parses an origin declared with +egress, eg.
+// "https://api.stripe.com" or "https://*.amazonaws.com:8443".
+func ParseEgressOrigin(origin string) (EgressOrigin, error) {
+	u, err := url.Parse(origin)
+	if err != nil {
+		return EgressOrigin{}, fmt.Errorf("invalid egress URL %q: %w", origin, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return EgressOrigin{}, fmt.Errorf("egress URL %q must be an http or https URL", origin)
+	}
+	if u.Hostname() == "" {
+		return EgressOrigin{}, fmt.Errorf("egress URL %q must have a host", origin)
+	}
+	if u.User != nil || (u.Path != "" && u.Path != "/") || u.RawQuery != "" || u.Fragment != "" {
+		return EgressOrigin{}, fmt.Errorf("egress URL %q must only have a scheme, host and port", origin)
+	}
+	host := strings.ToLower(u.Hostname())
+	if strings.Contains(strings.TrimPrefix(host, "*."), "*") {
+		return EgressOrigin{}, fmt.Errorf("egress URL %q can only have a wildcard as the first label of its host", origin)
+	}
+	port := u.Port()
+	if port == "" {
+		port = "443"
+		if u.Scheme == "http" {
+			port = "80"
+		}
+	}
+	return EgressOrigin{Scheme: u.Scheme, Host: host, Port: port}, nil
+}
+
+// Matches returns true if requests to "host" and "port" with "scheme" are to
+// the origin.
+//
+// An empty scheme matches any scheme, eg. for tunnelled requests.
+func (o EgressOrigin) Matches(scheme, host, port string) bool {
+	if (scheme != "" && scheme != o.Scheme) || port != o.Port {
+		return false
+	}
+	host = strings.ToLower(host)
+	if domain, ok := strings.CutPrefix(o.Host, "*."); ok {
+		return strings.HasSuffix(host, "."+domain)
+	}
+	return host == o.Host
+}
+
+func (o EgressOrigin) String() string {
+	return o.Scheme + "://" + o.Host + ":" + o.Port
+}
@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+)
+
+func TestEgressOriginMatches(t *testing.T) {
+	tests := []struct {
+		origin  string
+		scheme  string
+		host    string
+		port    string
+		matches bool
+	}{
+		{origin: "https://api.example.com", scheme: "https", host: "api.example.com", port: "443", matches: true},
+		{origin: "https://api.example.com", scheme: "", host: "API.example.com", port: "443", matches: true},
+		{origin: "https://api.example.com", scheme: "http", host: "api.example.com", port: "443", matches: false},
+		{origin: "https://api.example.com", scheme: "https", host: "api.example.com", port: "8443", matches: false},
+		{origin: "https://api.example.com", scheme: "https", host: "example.com", port: "443", matches: false},
+		{origin: "http://example.com:8080/", scheme: "http", host: "example.com", port: "8080", matches: true},
+		{origin: "https://*.example.com", scheme: "https", host: "a.b.example.com", port: "443", matches: true},
+		{origin: "https://*.example.com", scheme: "https", host: "example.com", port: "443", matches: false},
+		{origin: "https://*.example.com", scheme: "https", host: "badexample.com", port: "443", matches: false},
+	}
+	for _, test := range tests {
+		t.Run(test.origin+" "+test.host, func(t *testing.T) {
+			origin, err := ParseEgressOrigin(test.origin)
+			assert.NoError(t, err)
+			assert.Equal(t, test.matches, origin.Matches(test.scheme, test.host, test.port))
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package schema
+
+import (
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+	"google.golang.org/protobuf/proto"
+)
+
+// MetadataError marks a data structure as an error that can be returned by verbs.
+//
+// Errors are sent to callers with their type, so they can be decoded rather
+// than flattened to a message.
+type MetadataError struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	Error bool `parser:"'+' @'error'" protobuf:"-"`
+}
+
+var _ Metadata = (*MetadataError)(nil)
+
+func (*MetadataError) schemaMetadata()          {}
+func (m *MetadataError) schemaChildren() []Node { return nil }
+func (m *MetadataError) Position() Position     { return m.Pos }
+func (m *MetadataError) String() string         { return "+error" }
+
+func (m *MetadataError) ToProto() proto.Message {
+	return &schemapb.MetadataError{Pos: posToProto(m.Pos)}
+}
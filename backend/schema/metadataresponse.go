@@ -0,0 +1,88 @@
+package schema
+
+import (
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+	"github.com/TBD54566975/ftl/internal/slices"
+)
+
+// MetadataResponse maps the fields of the response body of an HTTP ingress
+// verb onto the HTTP response, so that verbs can return plain data.
+//
+// The status code can be taken from a field, headers can be set from fields
+// or static values, and the body can be replaced by one of its fields.
+type MetadataResponse struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	Status  string            `parser:"'+' 'response' ('status' @Ident)?" protobuf:"2"`
+	Headers []*ResponseHeader `parser:"@@*" protobuf:"3"`
+	Body    string            `parser:"('body' @Ident)?" protobuf:"4"`
+}
+
+var _ Metadata = (*MetadataResponse)(nil)
+
+func (*MetadataResponse) schemaMetadata()          {}
+func (m *MetadataResponse) schemaChildren() []Node { return nil }
+func (m *MetadataResponse) Position() Position     { return m.Pos }
+func (m *MetadataResponse) String() string {
+	parts := []string{"+response"}
+	if m.Status != "" {
+		parts = append(parts, "status "+m.Status)
+	}
+	for _, header := range m.Headers {
+		parts = append(parts, header.String())
+	}
+	if m.Body != "" {
+		parts = append(parts, "body "+m.Body)
+	}
+	return strings.Join(parts, " ")
+}
+
+func (m *MetadataResponse) ToProto() proto.Message {
+	return &schemapb.MetadataResponse{
+		Pos:     posToProto(m.Pos),
+		Status:  m.Status,
+		Headers: slices.Map(m.Headers, (*ResponseHeader).ToProto),
+		Body:    m.Body,
+	}
+}
+
+// ResponseHeader sets an HTTP response header from a field of the response
+// body, or to a static value.
+type ResponseHeader struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	Name  string `parser:"'header' @String" protobuf:"2"`
+	Field string `parser:"(@Ident" protobuf:"3"`
+	Value string `parser:"| '=' @String)" protobuf:"4"`
+}
+
+func (h *ResponseHeader) Position() Position { return h.Pos }
+func (h *ResponseHeader) String() string {
+	if h.Field != "" {
+		return "header " + strconv.Quote(h.Name) + " " + h.Field
+	}
+	return "header " + strconv.Quote(h.Name) + " = " + strconv.Quote(h.Value)
+}
+
+func (h *ResponseHeader) ToProto() *schemapb.ResponseHeader {
+	return &schemapb.ResponseHeader{
+		Pos:   posToProto(h.Pos),
+		Name:  h.Name,
+		Field: h.Field,
+		Value: h.Value,
+	}
+}
+
+func responseHeaderFromProto(pb *schemapb.ResponseHeader) *ResponseHeader {
+	return &ResponseHeader{
+		Pos:   posFromProto(pb.Pos),
+		Name:  pb.Name,
+		Field: pb.Field,
+		Value: pb.Value,
+	}
+}
@@ -0,0 +1,181 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/alecthomas/types/optional"
+	"google.golang.org/protobuf/proto"
+
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+	"github.com/TBD54566975/ftl/internal/slices"
+)
+
+// MetadataSaga declares that an FSM is a saga: an ordered list of steps, each
+// of which may have a compensation that undoes it.
+//
+// The controller executes the steps in order. If a step fails, the
+// compensations of the steps that completed before it are executed in reverse
+// order.
+//
+// The FSM's start state and transitions are derived from the steps, see
+// [SagaFSM].
+type MetadataSaga struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	Steps []*SagaStep `parser:"'+' 'saga' @@+" protobuf:"2"`
+}
+
+// SagaStep is a step of a saga.
+type SagaStep struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	Verb         *Ref `parser:"'step' @@" protobuf:"2"`
+	Compensation *Ref `parser:"('compensate' @@)?" protobuf:"3,optional"`
+}
+
+var _ Metadata = (*MetadataSaga)(nil)
+
+func (*MetadataSaga) schemaMetadata()          {}
+func (m *MetadataSaga) schemaChildren() []Node { return nil }
+func (m *MetadataSaga) Position() Position     { return m.Pos }
+func (m *MetadataSaga) String() string {
+	w := &strings.Builder{}
+	fmt.Fprint(w, "+saga")
+	for _, step := range m.Steps {
+		fmt.Fprintf(w, "\n  %s", step)
+	}
+	return w.String()
+}
+
+func (m *MetadataSaga) ToProto() proto.Message {
+	return &schemapb.MetadataSaga{
+		Pos:   posToProto(m.Pos),
+		Steps: slices.Map(m.Steps, (*SagaStep).ToProto),
+	}
+}
+
+func (s *SagaStep) Position() Position { return s.Pos }
+func (s *SagaStep) String() string {
+	if s.Compensation == nil {
+		return "step " + s.Verb.String()
+	}
+	return "step " + s.Verb.String() + " compensate " + s.Compensation.String()
+}
+
+func (s *SagaStep) ToProto() *schemapb.SagaStep {
+	out := &schemapb.SagaStep{
+		Pos:  posToProto(s.Pos),
+		Verb: s.Verb.ToProto().(*schemapb.Ref), //nolint: forcetypeassert
+	}
+	if s.Compensation != nil {
+		out.Compensation = s.Compensation.ToProto().(*schemapb.Ref) //nolint: forcetypeassert
+	}
+	return out
+}
+
+func sagaStepFromProto(pb *schemapb.SagaStep) *SagaStep {
+	step := &SagaStep{
+		Pos:  posFromProto(pb.Pos),
+		Verb: RefFromProto(pb.Verb),
+	}
+	if pb.Compensation != nil {
+		step.Compensation = RefFromProto(pb.Compensation)
+	}
+	return step
+}
+
+// SagaFSM returns the FSM that executes a saga.
+//
+// Each step transitions to the next. Each state also transitions to the
+// compensation of the latest step up to and including it, which is executed
+// if the step following it fails. Each compensation transitions to the
+// compensation of the previous step.
+func SagaFSM(pos Position, name string, steps []*SagaStep) *FSM {
+	saga := &MetadataSaga{Pos: pos, Steps: steps}
+	fsm := &FSM{Pos: pos, Name: name, Metadata: []Metadata{saga}}
+	if len(steps) == 0 {
+		return fsm
+	}
+	fsm.Start = []*Ref{steps[0].Verb}
+	for i, step := range steps {
+		if i+1 < len(steps) {
+			fsm.Transitions = append(fsm.Transitions, &FSMTransition{Pos: step.Pos, From: step.Verb, To: steps[i+1].Verb})
+			if compensation, ok := saga.compensationFrom(i).Get(); ok {
+				fsm.Transitions = append(fsm.Transitions, &FSMTransition{Pos: step.Pos, From: step.Verb, To: compensation})
+			}
+		}
+		if step.Compensation != nil {
+			if previous, ok := saga.compensationFrom(i - 1).Get(); ok {
+				fsm.Transitions = append(fsm.Transitions, &FSMTransition{Pos: step.Pos, From: step.Compensation, To: previous})
+			}
+		}
+	}
+	return fsm
+}
+
+// GetMetadataSaga returns the saga metadata of the FSM, if it is a saga.
+func (f *FSM) GetMetadataSaga() optional.Option[*MetadataSaga] {
+	if m, ok := slices.FindVariant[*MetadataSaga](f.Metadata); ok {
+		return optional.Some(m)
+	}
+	return optional.None[*MetadataSaga]()
+}
+
+// StepIndex returns the index of the step executing "verb", or -1.
+func (m *MetadataSaga) StepIndex(verb RefKey) int {
+	for i, step := range m.Steps {
+		if step.Verb.ToRefKey() == verb {
+			return i
+		}
+	}
+	return -1
+}
+
+// CompensationIndex returns the index of the step compensated by "verb", or -1.
+func (m *MetadataSaga) CompensationIndex(verb RefKey) int {
+	for i, step := range m.Steps {
+		if step.Compensation != nil && step.Compensation.ToRefKey() == verb {
+			return i
+		}
+	}
+	return -1
+}
+
+// Next returns the state to transition to once "state" has been reached, if any.
+//
+// This is the next step after a step, or the next compensation to execute
+// after a compensation.
+func (m *MetadataSaga) Next(state RefKey) optional.Option[*Ref] {
+	if i := m.StepIndex(state); i >= 0 {
+		if i+1 < len(m.Steps) {
+			return optional.Some(m.Steps[i+1].Verb)
+		}
+		return optional.None[*Ref]()
+	}
+	if i := m.CompensationIndex(state); i >= 0 {
+		return m.compensationFrom(i - 1)
+	}
+	return optional.None[*Ref]()
+}
+
+// Compensate returns the compensation to transition to when the step after "state" fails, if any.
+//
+// "state" is the last state the saga reached, or None if the first step failed.
+func (m *MetadataSaga) Compensate(state optional.Option[RefKey]) optional.Option[*Ref] {
+	current, ok := state.Get()
+	if !ok {
+		return optional.None[*Ref]()
+	}
+	return m.compensationFrom(m.StepIndex(current))
+}
+
+// compensationFrom returns the compensation of the latest step at or before "index".
+func (m *MetadataSaga) compensationFrom(index int) optional.Option[*Ref] {
+	for i := min(index, len(m.Steps)-1); i >= 0; i-- {
+		if m.Steps[i].Compensation != nil {
+			return optional.Some(m.Steps[i].Compensation)
+		}
+	}
+	return optional.None[*Ref]()
+}
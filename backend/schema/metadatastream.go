@@ -0,0 +1,26 @@
+package schema
+
+import (
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+	"google.golang.org/protobuf/proto"
+)
+
+// MetadataStream marks a verb as streaming its responses.
+//
+// A streaming verb sends zero or more responses to its caller before completing.
+type MetadataStream struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	Stream bool `parser:"'+' @'stream'" protobuf:"-"`
+}
+
+var _ Metadata = (*MetadataStream)(nil)
+
+func (*MetadataStream) schemaMetadata()          {}
+func (m *MetadataStream) schemaChildren() []Node { return nil }
+func (m *MetadataStream) Position() Position     { return m.Pos }
+func (m *MetadataStream) String() string         { return "+stream" }
+
+func (m *MetadataStream) ToProto() proto.Message {
+	return &schemapb.MetadataStream{Pos: posToProto(m.Pos)}
+}
@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"fmt"
+	"strconv"
+
+	"google.golang.org/protobuf/proto"
+
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+)
+
+// Kinds of validation constraints on fields.
+const (
+	// ConstraintMin is the minimum value of a number.
+	ConstraintMin = "min"
+	// ConstraintMax is the maximum value of a number.
+	ConstraintMax = "max"
+	// ConstraintMinLen is the minimum length of a string, array, map or bytes.
+	ConstraintMinLen = "minlen"
+	// ConstraintMaxLen is the maximum length of a string, array, map or bytes.
+	ConstraintMaxLen = "maxlen"
+	// ConstraintPattern is a regular expression a string must match.
+	ConstraintPattern = "pattern"
+	// ConstraintRequiredIf makes an optional field required when the named
+	// field of the same data structure is set.
+	ConstraintRequiredIf = "requiredif"
+)
+
+// MetadataValidate is a validation constraint on a field, enforced on
+// ingress requests and verb calls.
+type MetadataValidate struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	Kind  string `parser:"'+' 'validate' @('min' | 'max' | 'minlen' | 'maxlen' | 'pattern' | 'requiredif')" protobuf:"2"`
+	Value string `parser:"@('-'? Number | String)" protobuf:"3"`
+}
+
+var _ Metadata = (*MetadataValidate)(nil)
+
+func (*MetadataValidate) schemaMetadata()          {}
+func (m *MetadataValidate) schemaChildren() []Node { return nil }
+func (m *MetadataValidate) Position() Position     { return m.Pos }
+func (m *MetadataValidate) String() string {
+	switch m.Kind {
+	case ConstraintPattern, ConstraintRequiredIf:
+		return fmt.Sprintf("+validate %s %q", m.Kind, m.Value)
+	default:
+		return fmt.Sprintf("+validate %s %s", m.Kind, m.Value)
+	}
+}
+
+// Number returns the value of a min or max constraint.
+func (m *MetadataValidate) Number() (float64, error) {
+	value, err := strconv.ParseFloat(m.Value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s must be a number: %w", m.Kind, err)
+	}
+	return value, nil
+}
+
+// Length returns the value of a minlen or maxlen constraint.
+func (m *MetadataValidate) Length() (int, error) {
+	value, err := strconv.Atoi(m.Value)
+	if err != nil || value < 0 {
+		return 0, fmt.Errorf("%s must be a non-negative integer", m.Kind)
+	}
+	return value, nil
+}
+
+func (m *MetadataValidate) ToProto() proto.Message {
+	return &schemapb.MetadataValidate{
+		Pos:   posToProto(m.Pos),
+		Kind:  m.Kind,
+		Value: m.Value,
+	}
+}
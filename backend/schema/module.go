@@ -15,6 +15,7 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+	"github.com/TBD54566975/ftl/internal/compression"
 )
 
 type Module struct {
@@ -31,7 +32,8 @@ var _ Symbol = (*Module)(nil)
 var _ sql.Scanner = (*Module)(nil)
 var _ driver.Valuer = (*Module)(nil)
 
-func (m *Module) Value() (driver.Value, error) { return proto.Marshal(m.ToProto()) }
+// Value stores the module as a compressed protobuf message.
+func (m *Module) Value() (driver.Value, error) { return ModuleToCompressedBytes(m) }
 func (m *Module) Scan(src any) error {
 	switch src := src.(type) {
 	case []byte:
@@ -187,6 +189,20 @@ func (m *Module) Data() []*Data {
 	return data
 }
 
+// Egress returns the names of the verbs of the module declaring each external
+// HTTP origin with +egress, by origin.
+func (m *Module) Egress() map[string][]string {
+	egress := map[string][]string{}
+	for _, verb := range m.Verbs() {
+		if md, ok := verb.GetMetadataEgress().Get(); ok {
+			for _, origin := range md.URLs {
+				egress[origin] = append(egress[origin], verb.Name)
+			}
+		}
+	}
+	return egress
+}
+
 // Imports returns the modules imported by this module.
 func (m *Module) Imports() []string {
 	imports := map[string]bool{}
@@ -209,11 +225,12 @@ func (m *Module) Imports() []string {
 
 func (m *Module) ToProto() proto.Message {
 	return &schemapb.Module{
-		Pos:      posToProto(m.Pos),
-		Builtin:  m.Builtin,
-		Name:     m.Name,
-		Comments: m.Comments,
-		Decls:    declListToProto(m.Decls),
+		ProtoVersion: ProtoVersion,
+		Pos:          posToProto(m.Pos),
+		Builtin:      m.Builtin,
+		Name:         m.Name,
+		Comments:     m.Comments,
+		Decls:        declListToProto(m.Decls),
 	}
 }
 
@@ -230,7 +247,16 @@ func ModuleFromProtoFile(filename string) (*Module, error) {
 }
 
 // ModuleFromProto converts a protobuf Module to a Module and validates it.
+//
+// Modules encoded with older versions of the wire format are upgraded to
+// [ProtoVersion].
 func ModuleFromProto(s *schemapb.Module) (*Module, error) {
+	if s.ProtoVersion != ProtoVersion {
+		s = proto.Clone(s).(*schemapb.Module) //nolint:forcetypeassert
+		if err := UpgradeModuleProto(s); err != nil {
+			return nil, err
+		}
+	}
 	module := &Module{
 		Pos:      posFromProto(s.Pos),
 		Builtin:  s.Builtin,
@@ -241,7 +267,18 @@ func ModuleFromProto(s *schemapb.Module) (*Module, error) {
 	return module, ValidateModule(module)
 }
 
+// ModuleFromBytes decodes a module from a protobuf message, which may be
+// compressed.
 func ModuleFromBytes(b []byte) (*Module, error) {
+	// An encoded module never starts with a zstd frame, as the first byte of
+	// a frame is not the tag of any field of a module.
+	if compression.IsCompressed(b) {
+		var err error
+		b, err = compression.Decompress(b)
+		if err != nil {
+			return nil, err
+		}
+	}
 	s := &schemapb.Module{}
 	if err := proto.Unmarshal(b, s); err != nil {
 		return nil, err
@@ -253,6 +290,16 @@ func ModuleToBytes(m *Module) ([]byte, error) {
 	return proto.Marshal(m.ToProto())
 }
 
+// ModuleToCompressedBytes encodes a module as a compressed protobuf message,
+// as it is stored.
+func ModuleToCompressedBytes(m *Module) ([]byte, error) {
+	data, err := ModuleToBytes(m)
+	if err != nil {
+		return nil, err
+	}
+	return compression.Compress(data), nil
+}
+
 func moduleListToSchema(s []*schemapb.Module) ([]*Module, error) {
 	var out []*Module
 	for _, n := range s {
@@ -30,6 +30,15 @@ func Normalise[T Node](n T) T {
 		case *Time:
 			n.Time = false
 
+		case *Timestamp:
+			n.Timestamp = false
+
+		case *UUID:
+			n.UUID = false
+
+		case *Decimal:
+			n.Decimal = false
+
 		default: // Normally we don't default for sum types, but this is just for tests and will be immediately obvious.
 		}
 		return next()
@@ -0,0 +1,710 @@
+// Package openapi imports OpenAPI 3 specifications as FTL modules, so that
+// existing REST services can be moved onto FTL without rewriting their types
+// and routes by hand.
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"golang.org/x/exp/maps"
+	"gopkg.in/yaml.v3"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/backend/schema/goscaffold"
+	"github.com/TBD54566975/ftl/backend/schema/strcase"
+)
+
+type document struct {
+	OpenAPI string `json:"openapi"`
+	Swagger string `json:"swagger"`
+	Info    struct {
+		Title       string `json:"title"`
+		Description string `json:"description"`
+	} `json:"info"`
+	Paths      map[string]map[string]json.RawMessage `json:"paths"`
+	Components struct {
+		Schemas       map[string]*schemaObject `json:"schemas"`
+		Parameters    map[string]*parameter    `json:"parameters"`
+		RequestBodies map[string]*requestBody  `json:"requestBodies"`
+		Responses     map[string]*response     `json:"responses"`
+	} `json:"components"`
+}
+
+type schemaObject struct {
+	Ref                  string                   `json:"$ref"`
+	Type                 any                      `json:"type"`
+	Format               string                   `json:"format"`
+	Description          string                   `json:"description"`
+	Nullable             bool                     `json:"nullable"`
+	Enum                 []any                    `json:"enum"`
+	Items                *schemaObject            `json:"items"`
+	Properties           map[string]*schemaObject `json:"properties"`
+	Required             []string                 `json:"required"`
+	AdditionalProperties json.RawMessage          `json:"additionalProperties"`
+	AllOf                []*schemaObject          `json:"allOf"`
+	OneOf                []*schemaObject          `json:"oneOf"`
+	AnyOf                []*schemaObject          `json:"anyOf"`
+}
+
+type operation struct {
+	OperationID string               `json:"operationId"`
+	Summary     string               `json:"summary"`
+	Description string               `json:"description"`
+	Parameters  []*parameter         `json:"parameters"`
+	RequestBody *requestBody         `json:"requestBody"`
+	Responses   map[string]*response `json:"responses"`
+}
+
+type parameter struct {
+	Ref         string        `json:"$ref"`
+	Name        string        `json:"name"`
+	In          string        `json:"in"`
+	Description string        `json:"description"`
+	Required    bool          `json:"required"`
+	Schema      *schemaObject `json:"schema"`
+}
+
+type requestBody struct {
+	Ref     string               `json:"$ref"`
+	Content map[string]mediaType `json:"content"`
+}
+
+type response struct {
+	Ref     string               `json:"$ref"`
+	Content map[string]mediaType `json:"content"`
+}
+
+type mediaType struct {
+	Schema *schemaObject `json:"schema"`
+}
+
+// Methods FTL ingress supports, in the order operations on a path are imported.
+var methods = []string{"get", "put", "post", "delete"}
+
+type importer struct {
+	doc    *document
+	module *schema.Module
+	// Names of declarations, including those reserved for component schemas.
+	names map[string]bool
+	// Component schema name to declaration name.
+	components map[string]string
+	// Inline schemas already declared, which are revisited when the properties
+	// of a schema are merged into another.
+	declared map[*schemaObject]schema.Type
+}
+
+// Import converts an OpenAPI 3 specification, in JSON or YAML, to module "module".
+//
+// Each schema in the components of the spec becomes a data type, enum or type
+// alias, and each operation becomes an exported verb with an HTTP ingress.
+// Path and query parameters are merged with the fields of the request body,
+// the way FTL maps them onto ingress requests. Inline object schemas become
+// data types named after where they are used.
+//
+// Constructs with no FTL equivalent are imported as Any, eg. oneOf, and
+// operations with methods FTL ingress doesn't support are skipped.
+func Import(spec []byte, module string) (*schema.Module, error) {
+	var raw any
+	if err := yaml.Unmarshal(spec, &raw); err != nil {
+		return nil, fmt.Errorf("could not parse OpenAPI spec: %w", err)
+	}
+	data, err := json.Marshal(normalise(raw))
+	if err != nil {
+		return nil, fmt.Errorf("could not parse OpenAPI spec: %w", err)
+	}
+	doc := &document{}
+	if err := json.Unmarshal(data, doc); err != nil {
+		return nil, fmt.Errorf("could not parse OpenAPI spec: %w", err)
+	}
+	if doc.Swagger != "" || !strings.HasPrefix(doc.OpenAPI, "3.") {
+		return nil, fmt.Errorf("unsupported OpenAPI version %q, only OpenAPI 3 is supported", doc.OpenAPI+doc.Swagger)
+	}
+
+	i := &importer{
+		doc:        doc,
+		module:     &schema.Module{Name: module},
+		names:      map[string]bool{},
+		components: map[string]string{},
+		declared:   map[*schemaObject]schema.Type{},
+	}
+	if doc.Info.Description != "" {
+		i.module.Comments = lines(doc.Info.Description)
+	}
+	components := maps.Keys(doc.Components.Schemas)
+	sort.Strings(components)
+	// Reserve names first, so that references can be resolved in any order.
+	for _, name := range components {
+		i.components[name] = i.declName(name)
+	}
+	for _, name := range components {
+		if err := i.component(i.components[name], doc.Components.Schemas[name]); err != nil {
+			return nil, fmt.Errorf("schema %s: %w", name, err)
+		}
+	}
+
+	paths := maps.Keys(doc.Paths)
+	sort.Strings(paths)
+	for _, path := range paths {
+		item := doc.Paths[path]
+		var shared []*parameter
+		if params, ok := item["parameters"]; ok {
+			if err := json.Unmarshal(params, &shared); err != nil {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+		}
+		for _, method := range methods {
+			data, ok := item[method]
+			if !ok {
+				continue
+			}
+			op := &operation{}
+			if err := json.Unmarshal(data, op); err != nil {
+				return nil, fmt.Errorf("%s %s: %w", strings.ToUpper(method), path, err)
+			}
+			if err := i.operation(method, path, shared, op); err != nil {
+				return nil, fmt.Errorf("%s %s: %w", strings.ToUpper(method), path, err)
+			}
+		}
+	}
+	return i.module, nil
+}
+
+// normalise converts the maps decoded from YAML, whose keys may not be
+// strings (eg. response codes), to maps that can be encoded as JSON.
+func normalise(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			v[k] = normalise(e)
+		}
+		return v
+	case map[any]any:
+		out := make(map[string]any, len(v))
+		for k, e := range v {
+			out[fmt.Sprint(k)] = normalise(e)
+		}
+		return out
+	case []any:
+		for i, e := range v {
+			v[i] = normalise(e)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// component declares component schema "s" as "name".
+func (i *importer) component(name string, s *schemaObject) error {
+	if i.declarable(s) {
+		_, err := i.declare(name, s)
+		return err
+	}
+	t, err := i.typ(s, name)
+	if err != nil {
+		return err
+	}
+	i.module.Decls = append(i.module.Decls, &schema.TypeAlias{Comments: lines(s.Description), Export: true, Name: name, Type: t})
+	return nil
+}
+
+func (i *importer) operation(method, path string, shared []*parameter, op *operation) error {
+	name := op.OperationID
+	if name == "" {
+		name = method + " " + strings.NewReplacer("{", " by ", "}", " ", "/", " ").Replace(path)
+	}
+	name = i.uniqueName(lowerCamel(name))
+	typeName := strcase.ToUpperCamel(name)
+
+	params, err := i.parameters(shared, op.Parameters)
+	if err != nil {
+		return err
+	}
+	ingress := &schema.MetadataIngress{Type: "http", Method: strings.ToUpper(method)}
+	for _, segment := range strings.Split(strings.Trim(path, "/"), "/") {
+		if segment == "" {
+			continue
+		} else if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			ingress.Path = append(ingress.Path, &schema.IngressPathParameter{Name: fieldName(segment[1 : len(segment)-1])})
+		} else {
+			ingress.Path = append(ingress.Path, &schema.IngressPathLiteral{Text: segment})
+		}
+	}
+
+	var body *schemaObject
+	if op.RequestBody != nil {
+		rb, err := i.requestBody(op.RequestBody)
+		if err != nil {
+			return err
+		}
+		body = jsonSchema(rb.Content)
+	}
+	var request schema.Type = &schema.Unit{}
+	switch {
+	case len(params) > 0:
+		// Parameters and body fields are merged into one request type.
+		data := &schema.Data{Export: true, Name: i.uniqueName(typeName + "Request")}
+		i.module.Decls = append(i.module.Decls, data)
+		for _, p := range params {
+			t, err := i.typ(p.Schema, data.Name+upperCamel(p.Name))
+			if err != nil {
+				return fmt.Errorf("parameter %s: %w", p.Name, err)
+			}
+			data.Fields = append(data.Fields, i.field(p.Name, p.Description, t, p.Required || p.In == "path"))
+		}
+		if body != nil {
+			fields, err := i.fields(data.Name, body)
+			if err != nil {
+				return fmt.Errorf("request body: %w", err)
+			}
+			for _, f := range fields {
+				if !hasField(data, f.Name) {
+					data.Fields = append(data.Fields, f)
+				}
+			}
+		}
+		request = &schema.Ref{Module: i.module.Name, Name: data.Name}
+	case body != nil:
+		request, err = i.typ(body, typeName+"Request")
+		if err != nil {
+			return fmt.Errorf("request body: %w", err)
+		}
+	}
+
+	var success, failure schema.Type = &schema.Unit{}, &schema.Unit{}
+	codes := maps.Keys(op.Responses)
+	sort.Strings(codes)
+	foundSuccess, foundFailure := false, false
+	for _, code := range codes {
+		resp, err := i.response(op.Responses[code])
+		if err != nil {
+			return err
+		}
+		s := jsonSchema(resp.Content)
+		if s == nil {
+			continue
+		}
+		switch {
+		case strings.HasPrefix(code, "2") && !foundSuccess:
+			foundSuccess = true
+			success, err = i.typ(s, typeName+"Response")
+		case (strings.HasPrefix(code, "4") || strings.HasPrefix(code, "5") || code == "default") && !foundFailure:
+			foundFailure = true
+			failure, err = i.typ(s, typeName+"Error")
+		}
+		if err != nil {
+			return fmt.Errorf("response %s: %w", code, err)
+		}
+	}
+
+	i.module.Decls = append(i.module.Decls, &schema.Verb{
+		Comments: append(lines(op.Summary), lines(op.Description)...),
+		Export:   true,
+		Name:     name,
+		Request:  &schema.Ref{Module: "builtin", Name: "HttpRequest", TypeParameters: []schema.Type{i.unalias(request)}},
+		Response: &schema.Ref{Module: "builtin", Name: "HttpResponse", TypeParameters: []schema.Type{i.unalias(success), i.unalias(failure)}},
+		Metadata: []schema.Metadata{ingress},
+	})
+	return nil
+}
+
+// parameters returns the path and query parameters of an operation, with
+// those of the operation overriding those shared by its path.
+//
+// Headers and cookies are left out, as they are available from the request.
+func (i *importer) parameters(shared, own []*parameter) ([]*parameter, error) {
+	out := []*parameter{}
+	index := map[string]int{}
+	for _, p := range append(shared, own...) {
+		p, err := i.parameter(p)
+		if err != nil {
+			return nil, err
+		}
+		if p.In != "path" && p.In != "query" {
+			continue
+		}
+		key := p.In + ":" + p.Name
+		if j, ok := index[key]; ok {
+			out[j] = p
+			continue
+		}
+		index[key] = len(out)
+		out = append(out, p)
+	}
+	return out, nil
+}
+
+func (i *importer) parameter(p *parameter) (*parameter, error) {
+	if p.Ref == "" {
+		return p, nil
+	}
+	name := strings.TrimPrefix(p.Ref, "#/components/parameters/")
+	if resolved, ok := i.doc.Components.Parameters[name]; ok && name != p.Ref {
+		return resolved, nil
+	}
+	return nil, fmt.Errorf("unsupported parameter reference %q", p.Ref)
+}
+
+func (i *importer) requestBody(b *requestBody) (*requestBody, error) {
+	if b.Ref == "" {
+		return b, nil
+	}
+	name := strings.TrimPrefix(b.Ref, "#/components/requestBodies/")
+	if resolved, ok := i.doc.Components.RequestBodies[name]; ok && name != b.Ref {
+		return resolved, nil
+	}
+	return nil, fmt.Errorf("unsupported request body reference %q", b.Ref)
+}
+
+func (i *importer) response(r *response) (*response, error) {
+	if r.Ref == "" {
+		return r, nil
+	}
+	name := strings.TrimPrefix(r.Ref, "#/components/responses/")
+	if resolved, ok := i.doc.Components.Responses[name]; ok && name != r.Ref {
+		return resolved, nil
+	}
+	return nil, fmt.Errorf("unsupported response reference %q", r.Ref)
+}
+
+// jsonSchema returns the schema of the JSON content of a request or response.
+func jsonSchema(content map[string]mediaType) *schemaObject {
+	types := maps.Keys(content)
+	sort.Strings(types)
+	for _, t := range types {
+		if t == "application/json" || strings.HasSuffix(t, "+json") {
+			return content[t].Schema
+		}
+	}
+	return nil
+}
+
+// declarable returns true if "s" is imported as its own declaration rather
+// than as a type.
+func (i *importer) declarable(s *schemaObject) bool {
+	if s.Ref != "" {
+		return false
+	}
+	return len(s.Properties) > 0 || len(s.AllOf) > 1 || (len(s.Enum) > 0 && enumType(s) != nil)
+}
+
+// declare declares "s", which must be [importer.declarable], as "name".
+func (i *importer) declare(name string, s *schemaObject) (schema.Type, error) {
+	ref := &schema.Ref{Module: i.module.Name, Name: name}
+	if t := enumType(s); len(s.Enum) > 0 && t != nil {
+		enum := &schema.Enum{Comments: lines(s.Description), Export: true, Name: name, Type: t}
+		for _, v := range s.Enum {
+			variant := &schema.EnumVariant{Name: i.uniqueName(name + upperCamel(fmt.Sprint(v)))}
+			switch v := v.(type) {
+			case string:
+				variant.Value = &schema.StringValue{Value: v}
+			case float64:
+				variant.Value = &schema.IntValue{Value: int(v)}
+			}
+			enum.Variants = append(enum.Variants, variant)
+		}
+		i.module.Decls = append(i.module.Decls, enum)
+		return ref, nil
+	}
+	data := &schema.Data{Comments: lines(s.Description), Export: true, Name: name}
+	i.module.Decls = append(i.module.Decls, data)
+	fields, err := i.fields(name, s)
+	if err != nil {
+		return nil, err
+	}
+	data.Fields = fields
+	return ref, nil
+}
+
+// fields returns the fields of object schema "s", including those of the
+// schemas it is composed of with allOf, sorted by name.
+//
+// Inline types are named after "parent".
+func (i *importer) fields(parent string, s *schemaObject) ([]*schema.Field, error) {
+	properties := map[string]*schemaObject{}
+	required := map[string]bool{}
+	if err := i.properties(s, properties, required, map[string]bool{}); err != nil {
+		return nil, err
+	}
+	names := maps.Keys(properties)
+	sort.Strings(names)
+	out := make([]*schema.Field, 0, len(names))
+	for _, name := range names {
+		p := properties[name]
+		t, err := i.typ(p, parent+upperCamel(name))
+		if err != nil {
+			return nil, fmt.Errorf("property %s: %w", name, err)
+		}
+		out = append(out, i.field(name, p.Description, t, required[name]))
+	}
+	return out, nil
+}
+
+func (i *importer) properties(s *schemaObject, properties map[string]*schemaObject, required map[string]bool, seen map[string]bool) error {
+	if s.Ref != "" {
+		if seen[s.Ref] {
+			return nil
+		}
+		seen[s.Ref] = true
+		resolved, err := i.resolve(s.Ref)
+		if err != nil {
+			return err
+		}
+		return i.properties(resolved, properties, required, seen)
+	}
+	for _, part := range s.AllOf {
+		if err := i.properties(part, properties, required, seen); err != nil {
+			return err
+		}
+	}
+	for name, p := range s.Properties {
+		properties[name] = p
+	}
+	for _, name := range s.Required {
+		required[name] = true
+	}
+	return nil
+}
+
+func (i *importer) field(name, description string, t schema.Type, required bool) *schema.Field {
+	if _, ok := t.(*schema.Optional); !ok && !required {
+		t = &schema.Optional{Type: t}
+	}
+	field := &schema.Field{Comments: lines(description), Name: fieldName(name), Type: t}
+	if field.Name != name {
+		field.Metadata = []schema.Metadata{&schema.MetadataAlias{Kind: schema.AliasKindJSON, Alias: name}}
+	}
+	return field
+}
+
+// typ returns the FTL type of "s", declaring inline objects and enums as "name".
+func (i *importer) typ(s *schemaObject, name string) (schema.Type, error) {
+	if s == nil {
+		return &schema.Any{}, nil
+	}
+	t, err := i.nonNullableType(s, name)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := t.(*schema.Optional); !ok && nullable(s) {
+		t = &schema.Optional{Type: t}
+	}
+	return t, nil
+}
+
+func (i *importer) nonNullableType(s *schemaObject, name string) (schema.Type, error) {
+	if s.Ref != "" {
+		component := strings.TrimPrefix(s.Ref, "#/components/schemas/")
+		if decl, ok := i.components[component]; ok && component != s.Ref {
+			return &schema.Ref{Module: i.module.Name, Name: decl}, nil
+		}
+		return nil, fmt.Errorf("unsupported schema reference %q", s.Ref)
+	}
+	if t, ok := i.declared[s]; ok {
+		return t, nil
+	}
+	if i.declarable(s) {
+		t, err := i.declare(i.uniqueName(name), s)
+		if err != nil {
+			return nil, err
+		}
+		i.declared[s] = t
+		return t, nil
+	}
+	if len(s.AllOf) == 1 {
+		return i.typ(s.AllOf[0], name)
+	}
+	if len(s.OneOf) > 0 || len(s.AnyOf) > 0 {
+		return &schema.Any{}, nil
+	}
+	switch typeName(s) {
+	case "string":
+		switch s.Format {
+		case "date-time":
+			return &schema.Time{}, nil
+		case "byte", "binary":
+			return &schema.Bytes{}, nil
+		default:
+			return &schema.String{}, nil
+		}
+	case "integer":
+		return &schema.Int{}, nil
+	case "number":
+		return &schema.Float{}, nil
+	case "boolean":
+		return &schema.Bool{}, nil
+	case "array":
+		element, err := i.typ(s.Items, name+"Item")
+		if err != nil {
+			return nil, err
+		}
+		return &schema.Array{Element: element}, nil
+	case "object":
+		var value schema.Type = &schema.Any{}
+		additional := &schemaObject{}
+		if len(s.AdditionalProperties) > 0 && json.Unmarshal(s.AdditionalProperties, additional) == nil {
+			var err error
+			value, err = i.typ(additional, name+"Value")
+			if err != nil {
+				return nil, err
+			}
+		}
+		return &schema.Map{Key: &schema.String{}, Value: value}, nil
+	default:
+		return &schema.Any{}, nil
+	}
+}
+
+// unalias returns the type aliased by "t" if it is a type alias, as ingress
+// bodies can't be type aliases.
+func (i *importer) unalias(t schema.Type) schema.Type {
+	ref, ok := t.(*schema.Ref)
+	if !ok {
+		return t
+	}
+	for _, decl := range i.module.Decls {
+		if alias, ok := decl.(*schema.TypeAlias); ok && alias.Name == ref.Name {
+			return i.unalias(alias.Type)
+		}
+	}
+	return t
+}
+
+func (i *importer) resolve(ref string) (*schemaObject, error) {
+	name := strings.TrimPrefix(ref, "#/components/schemas/")
+	if s, ok := i.doc.Components.Schemas[name]; ok && name != ref {
+		return s, nil
+	}
+	return nil, fmt.Errorf("unsupported schema reference %q", ref)
+}
+
+// declName returns a unique declaration name for "name".
+func (i *importer) declName(name string) string {
+	return i.uniqueName(upperCamel(name))
+}
+
+// uniqueName returns "name", suffixed with a number if it is already used.
+func (i *importer) uniqueName(name string) string {
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "X" + name
+	}
+	unique := name
+	for n := 2; i.names[strings.ToLower(unique)]; n++ {
+		unique = fmt.Sprintf("%s%d", name, n)
+	}
+	i.names[strings.ToLower(unique)] = true
+	return unique
+}
+
+// typeName returns the type of "s", which may be a list of types in OpenAPI 3.1.
+func typeName(s *schemaObject) string {
+	switch t := s.Type.(type) {
+	case string:
+		return t
+	case []any:
+		for _, e := range t {
+			if e != "null" {
+				return fmt.Sprint(e)
+			}
+		}
+	}
+	if len(s.Properties) > 0 || len(s.AdditionalProperties) > 0 {
+		return "object"
+	}
+	return ""
+}
+
+func nullable(s *schemaObject) bool {
+	if s.Nullable {
+		return true
+	}
+	if t, ok := s.Type.([]any); ok {
+		for _, e := range t {
+			if e == "null" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// enumType returns the type of the values of an enum, or nil if they can't be
+// represented by an FTL enum.
+func enumType(s *schemaObject) schema.Type {
+	switch typeName(s) {
+	case "string":
+		for _, v := range s.Enum {
+			if _, ok := v.(string); !ok {
+				return nil
+			}
+		}
+		return &schema.String{}
+	case "integer":
+		for _, v := range s.Enum {
+			if f, ok := v.(float64); !ok || f != float64(int(f)) {
+				return nil
+			}
+		}
+		return &schema.Int{}
+	default:
+		return nil
+	}
+}
+
+func hasField(data *schema.Data, name string) bool {
+	for _, f := range data.Fields {
+		if f.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldName returns the FTL field name for property "name".
+func fieldName(name string) string {
+	name = lowerCamel(name)
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "x" + name
+	}
+	return name
+}
+
+func upperCamel(s string) string {
+	return strings.ReplaceAll(strcase.ToUpperCamel(words(s)), " ", "")
+}
+
+func lowerCamel(s string) string {
+	return strings.ReplaceAll(strcase.ToLowerCamel(words(s)), " ", "")
+}
+
+// words splits "s" into space separated words at the characters that can't be
+// used in names.
+func words(s string) string {
+	return strings.Join(strings.FieldsFunc(s, func(r rune) bool {
+		return !((r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9'))
+	}), " ")
+}
+
+func lines(s string) []string {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, "\n")
+}
+
+// GenerateGo generates the source of a Go FTL module implementing module "m",
+// as imported by [Import].
+//
+// Verbs are generated as stubs returning an error, to be implemented.
+func GenerateGo(m *schema.Module) ([]byte, error) {
+	return goscaffold.Generate(m, goscaffold.Options{
+		Imports: []string{"fmt"},
+		VerbBody: func(verb *schema.Verb, _, resp string) string {
+			return fmt.Sprintf("return %s{}, fmt.Errorf(%q)", resp, strcase.ToUpperCamel(verb.Name)+" is not implemented")
+		},
+	})
+}
@@ -0,0 +1,148 @@
+package openapi
+
+import (
+	"os"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+func TestImport(t *testing.T) {
+	spec, err := os.ReadFile("testdata/petstore.yaml")
+	assert.NoError(t, err)
+	actual, err := Import(spec, "petstore")
+	assert.NoError(t, err)
+	_, err = schema.ValidateSchema(&schema.Schema{Modules: []*schema.Module{schema.Builtins(), actual}})
+	assert.NoError(t, err)
+
+	expected, err := schema.ParseModuleString("", `
+// A store selling pets.
+module petstore {
+  export data Error {
+    code Int
+    message String
+  }
+
+  export data NewPet {
+    bornAt Time? +alias json "born_at"
+    // Name of the pet.
+    name String
+    owner petstore.NewPetOwner?
+    status petstore.Status?
+    tags [String]?
+  }
+
+  export data NewPetOwner {
+    name String?
+  }
+
+  export data Pet {
+    bornAt Time? +alias json "born_at"
+    id Int
+    // Name of the pet.
+    name String
+    owner petstore.NewPetOwner?
+    status petstore.Status?
+    tags [String]?
+  }
+
+  export typealias Pets [petstore.Pet]
+
+  export enum Status: String {
+    StatusAvailable = "available"
+    StatusSold = "sold"
+  }
+
+  export data ListPetsRequest {
+    limit Int?
+  }
+
+  // List all pets.
+  export verb listPets(builtin.HttpRequest<petstore.ListPetsRequest>) builtin.HttpResponse<[petstore.Pet], petstore.Error>
+    +ingress http GET /pets
+
+  export verb createPet(builtin.HttpRequest<petstore.NewPet>) builtin.HttpResponse<petstore.Pet, Unit>
+    +ingress http POST /pets
+
+  export data GetPetsByPetIdRequest {
+    petId Int +alias json "pet_id"
+  }
+
+  export verb getPetsByPetId(builtin.HttpRequest<petstore.GetPetsByPetIdRequest>) builtin.HttpResponse<petstore.Pet, petstore.Error>
+    +ingress http GET /pets/{petId}
+
+  export data UpdatePetRequest {
+    petId Int +alias json "pet_id"
+    bornAt Time? +alias json "born_at"
+    // Name of the pet.
+    name String
+    owner petstore.NewPetOwner?
+    status petstore.Status?
+    tags [String]?
+  }
+
+  export verb updatePet(builtin.HttpRequest<petstore.UpdatePetRequest>) builtin.HttpResponse<Unit, Unit>
+    +ingress http PUT /pets/{petId}
+}
+`)
+	assert.NoError(t, err)
+	assert.NoError(t, schema.ValidateModule(actual))
+	assert.Equal(t, expected.String(), actual.String())
+}
+
+func TestImportRejectsSwagger(t *testing.T) {
+	_, err := Import([]byte(`{"swagger": "2.0", "paths": {}}`), "petstore")
+	assert.EqualError(t, err, `unsupported OpenAPI version "2.0", only OpenAPI 3 is supported`)
+}
+
+func TestGenerateGo(t *testing.T) {
+	m, err := schema.ParseModuleString("", `
+module petstore {
+  export data Pet {
+    bornAt Time? +alias json "born_at"
+    name String
+  }
+
+  export enum Status: String {
+    StatusAvailable = "available"
+  }
+
+  export verb getPet(builtin.HttpRequest<Unit>) builtin.HttpResponse<petstore.Pet, Unit>
+    +ingress http GET /pets/latest
+}
+`)
+	assert.NoError(t, err)
+	actual, err := GenerateGo(m)
+	assert.NoError(t, err)
+	assert.Equal(t, `package petstore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"ftl/builtin"
+	"github.com/TBD54566975/ftl/go-runtime/ftl"
+)
+
+//ftl:enum export
+type Status string
+
+const (
+	StatusAvailable Status = "available"
+)
+
+//ftl:data export
+type Pet struct {
+	BornAt ftl.Option[time.Time] `+"`json:\"born_at\"`"+`
+	Name   string
+}
+
+//ftl:ingress http GET /pets/latest
+func GetPet(ctx context.Context, req builtin.HttpRequest[ftl.Unit]) (builtin.HttpResponse[Pet, ftl.Unit], error) {
+	return builtin.HttpResponse[Pet, ftl.Unit]{}, fmt.Errorf("GetPet is not implemented")
+}
+`, string(actual))
+}
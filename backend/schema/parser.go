@@ -5,6 +5,7 @@ import (
 	"io"
 	"reflect"
 	"strings"
+	"sync"
 
 	"github.com/alecthomas/participle/v2"
 	"github.com/alecthomas/participle/v2/lexer"
@@ -15,13 +16,19 @@ var (
 	declUnion            = []Decl{&Data{}, &Verb{}, &Database{}, &Enum{}, &TypeAlias{}, &Config{}, &Secret{}, &FSM{}, &Topic{}, &Subscription{}}
 	nonOptionalTypeUnion = []Type{
 		&Int{}, &Float{}, &String{}, &Bytes{}, &Bool{}, &Time{}, &Array{},
-		&Map{}, &Any{}, &Unit{},
+		&Map{}, &Any{}, &Unit{}, &UUID{}, &Decimal{}, &Timestamp{},
 		// Note: any types resolved by identifier (eg. "Any", "Unit", etc.) must
 		// be prior to Ref.
 		&Ref{},
 	}
-	typeUnion     = append(nonOptionalTypeUnion, &Optional{})
-	metadataUnion = []Metadata{&MetadataCalls{}, &MetadataIngress{}, &MetadataCronJob{}, &MetadataDatabases{}, &MetadataAlias{}, &MetadataRetry{}, &MetadataSubscriber{}}
+	// The order of this union determines protobuf field numbers, so new types
+	// must be appended.
+	typeUnion = []Type{
+		&Int{}, &Float{}, &String{}, &Bytes{}, &Bool{}, &Time{}, &Array{},
+		&Map{}, &Any{}, &Unit{}, &Ref{}, &Optional{}, &UUID{}, &Decimal{},
+		&Timestamp{},
+	}
+	metadataUnion = []Metadata{&MetadataCalls{}, &MetadataIngress{}, &MetadataCronJob{}, &MetadataDatabases{}, &MetadataAlias{}, &MetadataRetry{}, &MetadataSubscriber{}, &MetadataStream{}, &MetadataError{}, &MetadataConcurrency{}, &MetadataValidate{}, &MetadataDeprecated{}, &MetadataAllow{}, &MetadataCache{}, &MetadataSaga{}, &MetadataEgress{}, &MetadataResponse{}}
 	ingressUnion  = []IngressPathComponent{&IngressPathLiteral{}, &IngressPathParameter{}}
 	valueUnion    = []Value{&StringValue{}, &IntValue{}, &TypeValue{}}
 
@@ -65,7 +72,6 @@ var (
 
 	parser       = participle.MustBuild[Schema](parserOptions...)
 	moduleParser = participle.MustBuild[Module](parserOptions...)
-	typeParser   = participle.MustBuild[typeParserGrammar](append(commonParserOptions, participle.Union(nonOptionalTypeUnion...))...)
 	refParser    = participle.MustBuild[Ref](parserOptions...)
 )
 
@@ -153,13 +159,42 @@ type Decl interface {
 // We have a separate parser for types because Participle doesn't support left
 // recursion and "Type = Type ? | Int | String ..." is left recursive.
 type typeParserGrammar struct {
-	Type     Type `parser:"@@"`
-	Optional bool `parser:"@'?'?"`
+	Type     nonOptionalType `parser:"@@"`
+	Optional bool            `parser:"@'?'?"`
+}
+
+// nonOptionalType is the union of types parsed by the type parser before an
+// optional "?".
+//
+// It is distinct from [Type] so that types nested within arrays and maps, eg.
+// "[Int?]" or "{String: Int?}", recurse into the type parser and may
+// themselves be optional.
+type nonOptionalType interface{ Type }
+
+var (
+	typeParserOnce     sync.Once
+	typeParserInstance *participle.Parser[typeParserGrammar]
+)
+
+// typeParser returns the type parser, which is built lazily because it
+// recursively parses nested types with itself.
+func typeParser() *participle.Parser[typeParserGrammar] {
+	typeParserOnce.Do(func() {
+		members := make([]nonOptionalType, 0, len(nonOptionalTypeUnion))
+		for _, member := range nonOptionalTypeUnion {
+			members = append(members, member)
+		}
+		typeParserInstance = participle.MustBuild[typeParserGrammar](append(commonParserOptions,
+			participle.Union(members...),
+			participle.ParseTypeWith(parseType),
+		)...)
+	})
+	return typeParserInstance
 }
 
 // ParseType parses the string representation of a type.
 func ParseType(filename, input string) (Type, error) {
-	typ, err := typeParser.ParseString(filename, input)
+	typ, err := typeParser().ParseString(filename, input)
 	if err != nil {
 		return nil, err
 	}
@@ -170,7 +205,7 @@ func ParseType(filename, input string) (Type, error) {
 }
 
 func parseType(pl *lexer.PeekingLexer) (Type, error) {
-	typ, err := typeParser.ParseFromLexer(pl, participle.AllowTrailing(true))
+	typ, err := typeParser().ParseFromLexer(pl, participle.AllowTrailing(true))
 	if err != nil {
 		return nil, err
 	}
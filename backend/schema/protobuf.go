@@ -86,6 +86,10 @@ func generateStruct(t reflect.Type, messages map[string]string) {
 	if typesWithRuntime[t.Name()] {
 		fmt.Fprintf(w, "\n  optional %sRuntime runtime = 31634;\n", name)
 	}
+	if t == reflect.TypeOf(Module{}) {
+		fmt.Fprintf(w, "  // Version of the wire format the module was encoded with, see schema.ProtoVersion.\n")
+		fmt.Fprintf(w, "  int64 proto_version = 31635;\n")
+	}
 	generateWellKnownNestedEnums(t, w)
 	fields := reflect.VisibleFields(t)
 	// Sort by protobuf tag
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+	"github.com/TBD54566975/ftl/internal/slices"
 )
 
 func posFromProto(pos *schemapb.Position) Position {
@@ -72,6 +73,12 @@ func TypeFromProto(s *schemapb.Type) Type {
 		return &Bytes{Pos: posFromProto(s.Bytes.Pos)}
 	case *schemapb.Type_Time:
 		return &Time{Pos: posFromProto(s.Time.Pos)}
+	case *schemapb.Type_Timestamp:
+		return &Timestamp{Pos: posFromProto(s.Timestamp.Pos)}
+	case *schemapb.Type_Uuid:
+		return &UUID{Pos: posFromProto(s.Uuid.Pos)}
+	case *schemapb.Type_Decimal:
+		return &Decimal{Pos: posFromProto(s.Decimal.Pos), Precision: int(s.Decimal.Precision), Scale: int(s.Decimal.Scale)}
 	case *schemapb.Type_Bool:
 		return &Bool{Pos: posFromProto(s.Bool.Pos)}
 	case *schemapb.Type_Array:
@@ -171,6 +178,77 @@ func metadataToSchema(s *schemapb.Metadata) Metadata {
 			Name: s.Subscriber.Name,
 		}
 
+	case *schemapb.Metadata_Stream:
+		return &MetadataStream{
+			Pos:    posFromProto(s.Stream.Pos),
+			Stream: true,
+		}
+
+	case *schemapb.Metadata_Error:
+		return &MetadataError{
+			Pos:   posFromProto(s.Error.Pos),
+			Error: true,
+		}
+
+	case *schemapb.Metadata_Concurrency:
+		var queue *int
+		if s.Concurrency.Queue != nil {
+			queueValue := int(*s.Concurrency.Queue)
+			queue = &queueValue
+		}
+		return &MetadataConcurrency{
+			Pos:   posFromProto(s.Concurrency.Pos),
+			Max:   int(s.Concurrency.Max),
+			Queue: queue,
+		}
+
+	case *schemapb.Metadata_Validate:
+		return &MetadataValidate{
+			Pos:   posFromProto(s.Validate.Pos),
+			Kind:  s.Validate.Kind,
+			Value: s.Validate.Value,
+		}
+
+	case *schemapb.Metadata_Allow:
+		return &MetadataAllow{
+			Pos:     posFromProto(s.Allow.Pos),
+			Modules: s.Allow.Modules,
+		}
+
+	case *schemapb.Metadata_Cache:
+		return &MetadataCache{
+			Pos:  posFromProto(s.Cache.Pos),
+			TTL:  s.Cache.Ttl,
+			Vary: s.Cache.Vary,
+		}
+
+	case *schemapb.Metadata_Egress:
+		return &MetadataEgress{
+			Pos:  posFromProto(s.Egress.Pos),
+			URLs: s.Egress.Urls,
+		}
+
+	case *schemapb.Metadata_Response:
+		return &MetadataResponse{
+			Pos:     posFromProto(s.Response.Pos),
+			Status:  s.Response.Status,
+			Headers: slices.Map(s.Response.Headers, responseHeaderFromProto),
+			Body:    s.Response.Body,
+		}
+
+	case *schemapb.Metadata_Saga:
+		return &MetadataSaga{
+			Pos:   posFromProto(s.Saga.Pos),
+			Steps: slices.Map(s.Saga.Steps, sagaStepFromProto),
+		}
+
+	case *schemapb.Metadata_Deprecated:
+		return &MetadataDeprecated{
+			Pos:        posFromProto(s.Deprecated.Pos),
+			Deprecated: true,
+			Message:    s.Deprecated.Message,
+		}
+
 	default:
 		panic(fmt.Sprintf("unhandled metadata type: %T", s))
 	}
@@ -99,6 +99,36 @@ func metadataListToProto(nodes []Metadata) []*schemapb.Metadata {
 		case *MetadataSubscriber:
 			v = &schemapb.Metadata_Subscriber{Subscriber: n.ToProto().(*schemapb.MetadataSubscriber)}
 
+		case *MetadataStream:
+			v = &schemapb.Metadata_Stream{Stream: n.ToProto().(*schemapb.MetadataStream)}
+
+		case *MetadataError:
+			v = &schemapb.Metadata_Error{Error: n.ToProto().(*schemapb.MetadataError)}
+
+		case *MetadataConcurrency:
+			v = &schemapb.Metadata_Concurrency{Concurrency: n.ToProto().(*schemapb.MetadataConcurrency)}
+
+		case *MetadataValidate:
+			v = &schemapb.Metadata_Validate{Validate: n.ToProto().(*schemapb.MetadataValidate)}
+
+		case *MetadataDeprecated:
+			v = &schemapb.Metadata_Deprecated{Deprecated: n.ToProto().(*schemapb.MetadataDeprecated)}
+
+		case *MetadataAllow:
+			v = &schemapb.Metadata_Allow{Allow: n.ToProto().(*schemapb.MetadataAllow)}
+
+		case *MetadataCache:
+			v = &schemapb.Metadata_Cache{Cache: n.ToProto().(*schemapb.MetadataCache)}
+
+		case *MetadataEgress:
+			v = &schemapb.Metadata_Egress{Egress: n.ToProto().(*schemapb.MetadataEgress)}
+
+		case *MetadataResponse:
+			v = &schemapb.Metadata_Response{Response: n.ToProto().(*schemapb.MetadataResponse)}
+
+		case *MetadataSaga:
+			v = &schemapb.Metadata_Saga{Saga: n.ToProto().(*schemapb.MetadataSaga)}
+
 		default:
 			panic(fmt.Sprintf("unhandled metadata type %T", n))
 		}
@@ -151,6 +181,15 @@ func TypeToProto(t Type) *schemapb.Type {
 	case *Time:
 		return &schemapb.Type{Value: &schemapb.Type_Time{Time: t.ToProto().(*schemapb.Time)}}
 
+	case *Timestamp:
+		return &schemapb.Type{Value: &schemapb.Type_Timestamp{Timestamp: t.ToProto().(*schemapb.Timestamp)}}
+
+	case *UUID:
+		return &schemapb.Type{Value: &schemapb.Type_Uuid{Uuid: t.ToProto().(*schemapb.UUID)}}
+
+	case *Decimal:
+		return &schemapb.Type{Value: &schemapb.Type_Decimal{Decimal: t.ToProto().(*schemapb.Decimal)}}
+
 	case *Bool:
 		return &schemapb.Type{Value: &schemapb.Type_Bool{Bool: t.ToProto().(*schemapb.Bool)}}
 
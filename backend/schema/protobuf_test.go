@@ -6,6 +6,7 @@ import (
 	"github.com/alecthomas/assert/v2"
 
 	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+	"github.com/TBD54566975/ftl/internal/compression"
 )
 
 func TestProtoRoundtrip(t *testing.T) {
@@ -14,3 +15,21 @@ func TestProtoRoundtrip(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, Normalise(testSchema), Normalise(actual))
 }
+
+func TestModuleCompressedBytesRoundtrip(t *testing.T) {
+	for _, module := range MustValidate(testSchema).Modules {
+		compressed, err := ModuleToCompressedBytes(module)
+		assert.NoError(t, err)
+		uncompressed, err := ModuleToBytes(module)
+		assert.NoError(t, err)
+		assert.True(t, compression.IsCompressed(compressed))
+		assert.False(t, compression.IsCompressed(uncompressed))
+
+		// Modules stored before compression must still be readable.
+		expected, err := ModuleFromBytes(uncompressed)
+		assert.NoError(t, err)
+		actual, err := ModuleFromBytes(compressed)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+	}
+}
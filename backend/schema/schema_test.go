@@ -44,20 +44,28 @@ module todo {
   data DestroyResponse {
     name String
     when Time
+    id UUID
+    amount Decimal(10, 2)
+    at Timestamp
   }
 
   export verb create(todo.CreateRequest) todo.CreateResponse
     +calls todo.destroy
 	+database calls todo.testdb
+      +allow payments
+      +cache 5m vary name
+      +egress "https://api.example.com"
 
   export verb destroy(builtin.HttpRequest<todo.DestroyRequest>) builtin.HttpResponse<todo.DestroyResponse, String>
       +ingress http GET /todo/destroy/{name}
+      +response header "X-Name" name header "Cache-Control" = "no-store"
 
   verb mondays(Unit) Unit
       +cron Mon
 
   verb scheduled(Unit) Unit
       +cron */10 * * 1-10,11-31 * * *
+      +deprecated "use mondays"
 
   verb twiceADay(Unit) Unit
       +cron 12h
@@ -177,6 +185,12 @@ Module
       String
     Field
       Time
+    Field
+      UUID
+    Field
+      Decimal
+    Field
+      Timestamp
   Verb
     Ref
     Ref
@@ -184,6 +198,9 @@ Module
       Ref
     MetadataDatabases
       Ref
+    MetadataAllow
+    MetadataCache
+    MetadataEgress
   Verb
     Ref
       Ref
@@ -194,6 +211,7 @@ Module
       IngressPathLiteral
       IngressPathLiteral
       IngressPathParameter
+    MetadataResponse
   Verb
     Unit
     Unit
@@ -202,6 +220,7 @@ Module
     Unit
     Unit
     MetadataCronJob
+    MetadataDeprecated
   Verb
     Unit
     Unit
@@ -775,13 +794,18 @@ module todo {
   data DestroyResponse {
     name String
 	when Time
+	id UUID
+	amount Decimal(10, 2)
+	at Timestamp
   }
   export verb create(todo.CreateRequest) todo.CreateResponse
-  	+calls todo.destroy +database calls todo.testdb
+  	+calls todo.destroy +database calls todo.testdb +allow payments +cache 5m vary name +egress "https://api.example.com"
   export verb destroy(builtin.HttpRequest<todo.DestroyRequest>) builtin.HttpResponse<todo.DestroyResponse, String>
   	+ingress http GET /todo/destroy/{name}
+  	+response header "X-Name" name header "Cache-Control" = "no-store"
   verb scheduled(Unit) Unit
     +cron */10 * * 1-10,11-31 * * *
+    +deprecated "use mondays"
   verb twiceADay(Unit) Unit
     +cron 12h
   verb mondays(Unit) Unit
@@ -877,6 +901,9 @@ var testSchema = MustValidate(&Schema{
 					Fields: []*Field{
 						{Name: "name", Type: &String{}},
 						{Name: "when", Type: &Time{}},
+						{Name: "id", Type: &UUID{}},
+						{Name: "amount", Type: &Decimal{Precision: 10, Scale: 2}},
+						{Name: "at", Type: &Timestamp{}},
 					},
 				},
 				&Verb{Name: "create",
@@ -886,6 +913,9 @@ var testSchema = MustValidate(&Schema{
 					Metadata: []Metadata{
 						&MetadataCalls{Calls: []*Ref{{Module: "todo", Name: "destroy"}}},
 						&MetadataDatabases{Calls: []*Ref{{Module: "todo", Name: "testdb"}}},
+						&MetadataAllow{Modules: []string{"payments"}},
+						&MetadataCache{TTL: "5m", Vary: []string{"name"}},
+						&MetadataEgress{URLs: []string{"https://api.example.com"}},
 					}},
 				&Verb{Name: "destroy",
 					Export:   true,
@@ -901,6 +931,12 @@ var testSchema = MustValidate(&Schema{
 								&IngressPathParameter{Name: "name"},
 							},
 						},
+						&MetadataResponse{
+							Headers: []*ResponseHeader{
+								{Name: "X-Name", Field: "name"},
+								{Name: "Cache-Control", Value: "no-store"},
+							},
+						},
 					},
 				},
 				&Verb{Name: "scheduled",
@@ -910,6 +946,7 @@ var testSchema = MustValidate(&Schema{
 						&MetadataCronJob{
 							Cron: "*/10 * * 1-10,11-31 * * *",
 						},
+						&MetadataDeprecated{Deprecated: true, Message: "use mondays"},
 					},
 				},
 				&Verb{Name: "twiceADay",
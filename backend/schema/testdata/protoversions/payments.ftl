@@ -0,0 +1,43 @@
+// Payments taken from customers.
+module payments {
+  config currency String
+  secret apiKey String
+
+  database postgres ledger
+
+  export enum Status: String {
+    Pending = "pending"
+    Settled = "settled"
+  }
+
+  // A charge to a customer.
+  export data Charge {
+    id Int
+    amount Float
+    status payments.Status
+    tags {String: [String]}
+    settledAt Time?
+    payload Any
+  }
+
+  data ChargeRequest {
+    amount Float
+    idempotencyKey String
+  }
+
+  data Page<T> {
+    items [T]
+    cursor String?
+  }
+
+  export verb charge(payments.ChargeRequest) payments.Charge
+    +calls payments.notify
+
+  export verb list(builtin.HttpRequest<Unit>) builtin.HttpResponse<payments.Page<payments.Charge>, String>
+    +ingress http GET /charges
+
+  verb notify(payments.Charge) Unit
+
+  verb settle(Unit) Unit
+    +cron 0 * * * *
+}
@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+)
+
+const timestampPattern = `^[0-9]{4}-[0-9]{2}-[0-9]{2}T[0-9]{2}:[0-9]{2}:[0-9]{2}(\.[0-9]+)?(Z|[+-][0-9]{2}:[0-9]{2})(\[[^\]]+\])?$`
+
+// Timestamp is a point in time in a named time zone.
+//
+// Unlike Time, which only retains its UTC offset, a Timestamp is encoded as
+// an RFC 3339 string optionally followed by the IANA name of its time zone,
+// as per RFC 9557, eg. "2024-03-10T09:00:00-04:00[America/New_York]".
+type Timestamp struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	Timestamp bool `parser:"@'Timestamp'" protobuf:"-"`
+}
+
+var _ Type = (*Timestamp)(nil)
+var _ Symbol = (*Timestamp)(nil)
+
+func (t *Timestamp) Equal(other Type) bool  { _, ok := other.(*Timestamp); return ok }
+func (t *Timestamp) Position() Position     { return t.Pos }
+func (*Timestamp) schemaChildren() []Node   { return nil }
+func (*Timestamp) schemaType()              {}
+func (*Timestamp) schemaSymbol()            {}
+func (*Timestamp) String() string           { return "Timestamp" }
+func (t *Timestamp) ToProto() proto.Message { return &schemapb.Timestamp{Pos: posToProto(t.Pos)} }
+func (*Timestamp) GetName() string          { return "Timestamp" }
+
+// ParseTimestamp parses the encoded form of a Timestamp.
+//
+// The time is converted to the time zone named in brackets, if any.
+func ParseTimestamp(value string) (time.Time, error) {
+	value, zone, hasZone := strings.Cut(value, "[")
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid timestamp: %w", err)
+	}
+	if !hasZone {
+		return t, nil
+	}
+	zone, ok := strings.CutSuffix(zone, "]")
+	if !ok {
+		return time.Time{}, fmt.Errorf("invalid timestamp: unterminated time zone %q", zone)
+	}
+	location, err := time.LoadLocation(zone)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid timestamp: %w", err)
+	}
+	return t.In(location), nil
+}
+
+// FormatTimestamp returns the encoded form of a Timestamp, including the
+// name of the time zone of "t" unless it is UTC or the local time zone.
+func FormatTimestamp(t time.Time) string {
+	out := t.Format(time.RFC3339Nano)
+	switch zone := t.Location().String(); zone {
+	case "UTC", "Local", "":
+		return out
+	default:
+		return out + "[" + zone + "]"
+	}
+}
@@ -0,0 +1,27 @@
+package schema
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+)
+
+// UUID is a universally unique identifier, encoded as a string in its
+// canonical form, eg. "0f1e2d3c-4b5a-6978-8796-a5b4c3d2e1f0".
+type UUID struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	UUID bool `parser:"@'UUID'" protobuf:"-"`
+}
+
+var _ Type = (*UUID)(nil)
+var _ Symbol = (*UUID)(nil)
+
+func (u *UUID) Equal(other Type) bool  { _, ok := other.(*UUID); return ok }
+func (u *UUID) Position() Position     { return u.Pos }
+func (*UUID) schemaChildren() []Node   { return nil }
+func (*UUID) schemaType()              {}
+func (*UUID) schemaSymbol()            {}
+func (*UUID) String() string           { return "UUID" }
+func (u *UUID) ToProto() proto.Message { return &schemapb.UUID{Pos: posToProto(u.Pos)} }
+func (*UUID) GetName() string          { return "UUID" }
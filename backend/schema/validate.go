@@ -25,14 +25,17 @@ var (
 	// We don't need Array/Map/Ref here because there are no
 	// keywords associated with these types.
 	primitivesScope = Scope{
-		"Int":    ModuleDecl{Symbol: &Int{}},
-		"Float":  ModuleDecl{Symbol: &Float{}},
-		"String": ModuleDecl{Symbol: &String{}},
-		"Bytes":  ModuleDecl{Symbol: &Bytes{}},
-		"Bool":   ModuleDecl{Symbol: &Bool{}},
-		"Time":   ModuleDecl{Symbol: &Time{}},
-		"Unit":   ModuleDecl{Symbol: &Unit{}},
-		"Any":    ModuleDecl{Symbol: &Any{}},
+		"Int":       ModuleDecl{Symbol: &Int{}},
+		"Float":     ModuleDecl{Symbol: &Float{}},
+		"String":    ModuleDecl{Symbol: &String{}},
+		"Bytes":     ModuleDecl{Symbol: &Bytes{}},
+		"Bool":      ModuleDecl{Symbol: &Bool{}},
+		"Time":      ModuleDecl{Symbol: &Time{}},
+		"Unit":      ModuleDecl{Symbol: &Unit{}},
+		"Any":       ModuleDecl{Symbol: &Any{}},
+		"UUID":      ModuleDecl{Symbol: &UUID{}},
+		"Decimal":   ModuleDecl{Symbol: &Decimal{}},
+		"Timestamp": ModuleDecl{Symbol: &Timestamp{}},
 	}
 )
 
@@ -168,7 +171,7 @@ func ValidateModuleInSchema(schema *Schema, m optional.Option[*Module]) (*Schema
 						}
 						ingress[key] = n
 
-					case *MetadataCronJob, *MetadataRetry, *MetadataCalls, *MetadataDatabases, *MetadataAlias:
+					case *MetadataCronJob, *MetadataRetry, *MetadataCalls, *MetadataDatabases, *MetadataAlias, *MetadataStream, *MetadataError, *MetadataConcurrency, *MetadataValidate, *MetadataDeprecated, *MetadataAllow, *MetadataCache, *MetadataSaga, *MetadataEgress, *MetadataResponse:
 					}
 				}
 
@@ -203,6 +206,9 @@ func ValidateModuleInSchema(schema *Schema, m optional.Option[*Module]) (*Schema
 						merr = append(merr, errorf(start, "start state %q must be a sink but is %s", start, sym.Kind()))
 					}
 				}
+				if saga, ok := n.GetMetadataSaga().Get(); ok {
+					merr = append(merr, validateSaga(scopes, n, saga)...)
+				}
 
 			case *FSMTransition:
 				if sym, decl := ResolveAs[*Verb](scopes, *n.From); decl == nil {
@@ -222,9 +228,9 @@ func ValidateModuleInSchema(schema *Schema, m optional.Option[*Module]) (*Schema
 				IngressPathComponent, *IngressPathLiteral, *IngressPathParameter,
 				*Int, *Map, Metadata, *MetadataCalls, *MetadataDatabases, *MetadataCronJob,
 				*MetadataIngress, *MetadataAlias, *Module, *Optional, *Schema, *TypeAlias,
-				*String, *Time, Type, *Unit, *Any, *TypeParameter, *EnumVariant, *MetadataRetry,
-				Value, *IntValue, *StringValue, *TypeValue, *Config, *Secret, Symbol, Named,
-				*MetadataSubscriber, *Subscription, *Topic:
+				*String, *Time, *Timestamp, *UUID, *Decimal, Type, *Unit, *Any, *TypeParameter,
+				*EnumVariant, *MetadataRetry, Value, *IntValue, *StringValue, *TypeValue, *Config, *Secret, Symbol, Named,
+				*MetadataSubscriber, *MetadataStream, *MetadataError, *MetadataConcurrency, *MetadataValidate, *MetadataDeprecated, *MetadataAllow, *MetadataCache, *MetadataSaga, *MetadataEgress, *MetadataResponse, *Subscription, *Topic:
 			}
 			return next()
 		})
@@ -239,6 +245,9 @@ func ValidateModuleInSchema(schema *Schema, m optional.Option[*Module]) (*Schema
 
 var validNameRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
 
+// validHeaderNameRe matches the token characters of RFC 9110.
+var validHeaderNameRe = regexp.MustCompile("^[!#$%&'*+\\-.^_`|~0-9A-Za-z]+$")
+
 // ValidateName validates an FTL name.
 func ValidateName(name string) bool {
 	return validNameRe.MatchString(name)
@@ -323,18 +332,39 @@ func ValidateModule(module *Module) error {
 			}
 
 		case *Verb:
+			if n.Internal && !n.Export {
+				merr = append(merr, errorf(n, "verb %s: internal verbs must also be exported", n.Name))
+			}
 			merr = append(merr, validateVerbMetadata(scopes, module, n)...)
 
 		case *Data:
+			if n.Internal && !n.Export {
+				merr = append(merr, errorf(n, "data %s: internal data structures must also be exported", n.Name))
+			}
 			for _, md := range n.Metadata {
-				if md, ok := md.(*MetadataCalls); ok {
+				switch md := md.(type) {
+				case *MetadataCalls, *MetadataConcurrency, *MetadataValidate, *MetadataDeprecated, *MetadataAllow, *MetadataCache, *MetadataSaga, *MetadataEgress, *MetadataResponse:
 					merr = append(merr, errorf(md, "metadata %q is not valid on data structures", strings.TrimSpace(md.String())))
+				case *MetadataError:
+					if len(n.TypeParameters) > 0 {
+						merr = append(merr, errorf(md, "error data structure %s can not have type parameters", n.Name))
+					}
+				default:
+				}
+			}
+			for _, field := range n.Fields {
+				for _, md := range field.Metadata {
+					if md, ok := md.(*MetadataValidate); ok {
+						merr = append(merr, validateFieldConstraint(n, field, md)...)
+					}
 				}
 			}
 
 		case *Field:
 			for _, md := range n.Metadata {
-				if _, ok := md.(*MetadataAlias); !ok {
+				switch md.(type) {
+				case *MetadataAlias, *MetadataValidate, *MetadataDeprecated:
+				default:
 					merr = append(merr, errorf(md, "metadata %q is not valid on fields", strings.TrimSpace(md.String())))
 				}
 			}
@@ -352,12 +382,28 @@ func ValidateModule(module *Module) error {
 				merr = append(merr, errorf(n, "invalid name: must consist of only letters, numbers and underscores, and start with a letter."))
 			}
 
+		case *Optional:
+			if _, ok := n.Type.(*Optional); ok {
+				merr = append(merr, errorf(n, "optional type %s can not be optional", n.Type))
+			}
+
+		case *Map:
+			if _, ok := n.Key.(*Optional); ok {
+				merr = append(merr, errorf(n, "map key type %s can not be optional", n.Key))
+			}
+
+		case *Decimal:
+			if n.Scale > n.Precision {
+				merr = append(merr, errorf(n, "%s: scale must not be greater than precision", n))
+			}
+
 		case *Array, *Bool, *Database, *Float, *Int,
-			*Time, *Map, *Module, *Schema, *String, *Bytes,
+			*Time, *Timestamp, *UUID, *Module, *Schema, *String, *Bytes,
 			*MetadataCalls, *MetadataDatabases, *MetadataIngress, *MetadataCronJob, *MetadataAlias,
-			IngressPathComponent, *IngressPathLiteral, *IngressPathParameter, *Optional,
+			IngressPathComponent, *IngressPathLiteral, *IngressPathParameter,
 			*Unit, *Any, *TypeParameter, *Enum, *EnumVariant, *IntValue, *StringValue, *TypeValue,
-			*FSM, *Config, *FSMTransition, *Secret, *TypeAlias, *MetadataRetry, *MetadataSubscriber:
+			*FSM, *Config, *FSMTransition, *Secret, *TypeAlias, *MetadataRetry, *MetadataSubscriber,
+			*MetadataStream, *MetadataError, *MetadataConcurrency, *MetadataValidate, *MetadataDeprecated, *MetadataAllow, *MetadataCache, *MetadataSaga, *MetadataEgress, *MetadataResponse:
 
 		case Named, Symbol, Type, Metadata, Value, Decl: // Union types.
 		}
@@ -518,6 +564,9 @@ func validateVerbMetadata(scopes Scopes, module *Module, n *Verb) (merr []error)
 
 		switch md := md.(type) {
 		case *MetadataIngress:
+			if n.Internal {
+				merr = append(merr, errorf(md, "verb %s: internal verbs can not be ingress verbs", n.Name))
+			}
 			reqBodyType, reqBody, errs := validateIngressRequestOrResponse(scopes, module, n, "request", n.Request)
 			merr = append(merr, errs...)
 			_, _, errs = validateIngressRequestOrResponse(scopes, module, n, "response", n.Response)
@@ -589,12 +638,171 @@ func validateVerbMetadata(scopes Scopes, module *Module, n *Verb) (merr []error)
 		case *MetadataSubscriber:
 			subErrs := validateVerbSubscriptions(module, n, md, scopes, optional.None[*Schema]())
 			merr = append(merr, subErrs...)
-		case *MetadataCalls, *MetadataDatabases, *MetadataAlias:
+		case *MetadataStream:
+			if _, ok := n.Response.(*Unit); ok {
+				merr = append(merr, errorf(md, "verb %s: streaming verbs must have a response type", n.Name))
+			}
+			if _, ok := islices.FindVariant[*MetadataIngress](n.Metadata); ok {
+				merr = append(merr, errorf(md, "verb %s: streaming verbs can not be ingress verbs", n.Name))
+			}
+			if _, ok := islices.FindVariant[*MetadataSubscriber](n.Metadata); ok {
+				merr = append(merr, errorf(md, "verb %s: streaming verbs can not be subscribers", n.Name))
+			}
+		case *MetadataError:
+			merr = append(merr, errorf(md, "metadata %q is not valid on verbs", strings.TrimSpace(md.String())))
+		case *MetadataSaga:
+			merr = append(merr, errorf(md, "metadata %q is not valid on verbs", "+saga"))
+		case *MetadataValidate:
+			merr = append(merr, errorf(md, "metadata %q is not valid on verbs", strings.TrimSpace(md.String())))
+		case *MetadataConcurrency:
+			if md.Max < 1 {
+				merr = append(merr, errorf(md, "verb %s: concurrency limit must be at least 1", n.Name))
+			}
+			if md.Queue != nil && *md.Queue < 0 {
+				merr = append(merr, errorf(md, "verb %s: concurrency queue length can not be negative", n.Name))
+			}
+		case *MetadataAllow:
+			if !n.Export {
+				merr = append(merr, errorf(md, "verb %s: only exported verbs can restrict their callers", n.Name))
+			}
+			if slices.Contains(md.Modules, module.Name) {
+				merr = append(merr, errorf(md, "verb %s: verbs can always be called from their own module", n.Name))
+			}
+		case *MetadataCache:
+			if _, err := md.TTLDuration(); err != nil {
+				merr = append(merr, errorf(md, "verb %s: %v", n.Name, err))
+			}
+			if n.IsStream() {
+				merr = append(merr, errorf(md, "verb %s: responses of streaming verbs can not be cached", n.Name))
+			}
+			if len(md.Vary) > 0 {
+				request, _ := ResolveTypeAs[*Data](scopes, n.Request)
+				for _, field := range md.Vary {
+					if request == nil || request.FieldByName(field) == nil {
+						merr = append(merr, errorf(md, "verb %s: request type %s does not contain the field %q to vary the cache on", n.Name, n.Request, field))
+					}
+				}
+			}
+		case *MetadataEgress:
+			for _, origin := range md.URLs {
+				if _, err := ParseEgressOrigin(origin); err != nil {
+					merr = append(merr, errorf(md, "verb %s: %v", n.Name, err))
+				}
+			}
+		case *MetadataResponse:
+			merr = append(merr, validateResponseMapping(scopes, module, n, md)...)
+		case *MetadataCalls, *MetadataDatabases, *MetadataAlias, *MetadataDeprecated:
 		}
 	}
 	return
 }
 
+// validateResponseMapping checks that the fields mapped by a +response exist
+// on the response body of an HTTP ingress verb and have compatible types.
+func validateResponseMapping(scopes Scopes, module *Module, n *Verb, md *MetadataResponse) (merr []error) {
+	if ingress, ok := n.GetMetadataIngress().Get(); !ok || ingress.Type != "http" {
+		return []error{errorf(md, "verb %s: response mappings can only be added to HTTP ingress verbs", n.Name)}
+	}
+	if md.Status == "" && len(md.Headers) == 0 && md.Body == "" {
+		return []error{errorf(md, "verb %s: response mapping must map a status, header or body", n.Name)}
+	}
+	_, body, errs := validateIngressRequestOrResponse(scopes, module, n, "response", n.Response)
+	if len(errs) > 0 || body == nil {
+		// Invalid responses are reported by the ingress metadata, and bodies
+		// from other modules can not be checked.
+		return nil
+	}
+	data, ok := body.(*Data)
+	if !ok {
+		return []error{errorf(md, "verb %s: response mappings require a data structure body, not %s", n.Name, body)}
+	}
+	mapped := map[string]bool{}
+	mapField := func(pos interface{ Position() Position }, name string, what string, valid func(Type) bool) {
+		field := data.FieldByName(name)
+		switch {
+		case field == nil:
+			merr = append(merr, errorf(pos, "verb %s: response body %s does not contain the field %q to map the %s from", n.Name, data.Name, name, what))
+		case mapped[name]:
+			merr = append(merr, errorf(pos, "verb %s: field %q can only be mapped once", n.Name, name))
+		case valid != nil && !valid(field.Type):
+			merr = append(merr, errorf(pos, "verb %s: field %q of type %s can not be mapped to the %s", n.Name, name, field.Type, what))
+		}
+		mapped[name] = true
+	}
+	if md.Status != "" {
+		mapField(md, md.Status, "status", func(t Type) bool {
+			_, ok := stripOptional(t).(*Int)
+			return ok
+		})
+	}
+	for _, header := range md.Headers {
+		if !validHeaderNameRe.MatchString(header.Name) {
+			merr = append(merr, errorf(header, "verb %s: invalid response header name %q", n.Name, header.Name))
+		}
+		if header.Field == "" {
+			continue
+		}
+		mapField(header, header.Field, fmt.Sprintf("header %q", header.Name), func(t Type) bool {
+			switch t := stripOptional(t).(type) {
+			case *String, *Int, *Bool:
+				return true
+			case *Array:
+				_, ok := t.Element.(*String)
+				return ok
+			default:
+				return false
+			}
+		})
+	}
+	if md.Body != "" {
+		mapField(md, md.Body, "body", nil)
+	}
+	return
+}
+
+func stripOptional(t Type) Type {
+	if opt, ok := t.(*Optional); ok {
+		return opt.Type
+	}
+	return t
+}
+
+func validateSaga(scopes Scopes, fsm *FSM, saga *MetadataSaga) (merr []error) {
+	if len(saga.Steps) == 0 {
+		return []error{errorf(saga, "saga %s has no steps", fsm.Name)}
+	}
+	var request Type
+	seen := map[RefKey]bool{}
+	validateState := func(ref *Ref) {
+		if seen[ref.ToRefKey()] {
+			merr = append(merr, errorf(ref, "saga %s: %s can only be used once", fsm.Name, ref))
+		}
+		seen[ref.ToRefKey()] = true
+		verb, _ := ResolveAs[*Verb](scopes, *ref)
+		if verb == nil || verb.Kind() != VerbKindSink {
+			merr = append(merr, errorf(ref, "saga %s: %s must be a sink", fsm.Name, ref))
+			return
+		}
+		if request == nil {
+			request = verb.Request
+		} else if !request.Equal(verb.Request) {
+			merr = append(merr, errorf(ref, "saga %s: %s must accept %s like the other steps, not %s", fsm.Name, ref, request, verb.Request))
+		}
+	}
+	for _, step := range saga.Steps {
+		validateState(step.Verb)
+		if step.Compensation != nil {
+			validateState(step.Compensation)
+		}
+	}
+	expected := SagaFSM(fsm.Pos, fsm.Name, saga.Steps)
+	if !slices.EqualFunc(fsm.Start, expected.Start, func(a, b *Ref) bool { return a.String() == b.String() }) ||
+		!slices.EqualFunc(fsm.Transitions, expected.Transitions, func(a, b *FSMTransition) bool { return a.String() == b.String() }) {
+		merr = append(merr, errorf(fsm, "saga %s: the start state and transitions of the FSM must match its steps", fsm.Name))
+	}
+	return merr
+}
+
 func validateIngressRequestOrResponse(scopes Scopes, module *Module, n *Verb, reqOrResp string, r Type) (fieldType Type, body Symbol, merr []error) {
 	rref, _ := r.(*Ref)
 	resp, sym := ResolveTypeAs[*Data](scopes, r)
@@ -687,3 +895,55 @@ func validateVerbSubscriptions(module *Module, v *Verb, md *MetadataSubscriber,
 func typeName(v any) string {
 	return strings.ToLower(reflect.Indirect(reflect.ValueOf(v)).Type().Name())
 }
+
+// validateFieldConstraint validates that a constraint is valid for the type of a field.
+func validateFieldConstraint(data *Data, field *Field, md *MetadataValidate) (merr []error) {
+	fieldType := field.Type
+	if opt, ok := fieldType.(*Optional); ok {
+		fieldType = opt.Type
+	}
+	invalidType := func() error {
+		return errorf(md, "field %s: constraint %q is not valid on fields of type %s", field.Name, strings.TrimSpace(md.String()), field.Type)
+	}
+	switch md.Kind {
+	case ConstraintMin, ConstraintMax:
+		switch fieldType.(type) {
+		case *Int, *Float:
+		default:
+			merr = append(merr, invalidType())
+		}
+		if _, err := md.Number(); err != nil {
+			merr = append(merr, errorf(md, "field %s: %v", field.Name, err))
+		}
+
+	case ConstraintMinLen, ConstraintMaxLen:
+		switch fieldType.(type) {
+		case *String, *Array, *Map, *Bytes:
+		default:
+			merr = append(merr, invalidType())
+		}
+		if _, err := md.Length(); err != nil {
+			merr = append(merr, errorf(md, "field %s: %v", field.Name, err))
+		}
+
+	case ConstraintPattern:
+		if _, ok := fieldType.(*String); !ok {
+			merr = append(merr, invalidType())
+		}
+		if _, err := regexp.Compile(md.Value); err != nil {
+			merr = append(merr, errorf(md, "field %s: invalid pattern: %v", field.Name, err))
+		}
+
+	case ConstraintRequiredIf:
+		if _, ok := field.Type.(*Optional); !ok {
+			merr = append(merr, errorf(md, "field %s: %q is only valid on optional fields", field.Name, strings.TrimSpace(md.String())))
+		}
+		if md.Value == field.Name || data.FieldByName(md.Value) == nil {
+			merr = append(merr, errorf(md, "field %s: %q must refer to another field of %s", field.Name, strings.TrimSpace(md.String()), data.Name))
+		}
+
+	default:
+		merr = append(merr, errorf(md, "field %s: unknown constraint %q", field.Name, md.Kind))
+	}
+	return
+}
@@ -149,6 +149,48 @@ func TestValidate(t *testing.T) {
 				}
 			`,
 		},
+		{name: "NestedCompositions",
+			schema: `
+				module one {
+					enum Color: String {
+						Red = "red"
+					}
+					data Data {
+						optionalMapValues {String: Int?}
+						optionalArray [one.Data?]?
+						mapOfEnums {one.Color: [one.Color?]}
+						arrayOfMaps [{String: [Int?]?}]
+					}
+				}
+			`,
+		},
+		{name: "OptionalMapKey",
+			schema: `
+				module one {
+					data Data {
+						optionalKeys {String?: Int}
+					}
+				}
+			`,
+			errs: []string{
+				`4:20-20: map key type String? can not be optional`,
+			},
+		},
+		{name: "Decimals",
+			schema: `
+				module one {
+					data Data {
+						unbounded Decimal
+						integer Decimal(10)
+						money Decimal(12, 2)
+						invalid Decimal(2, 4)
+					}
+				}
+			`,
+			errs: []string{
+				`7:15-15: Decimal(2, 4): scale must not be greater than precision`,
+			},
+		},
 		{name: "DoubleCron",
 			schema: `
 				module one {
@@ -312,6 +354,212 @@ func TestValidate(t *testing.T) {
 				`6:7-7: verb B: retries can only be added to subscribers or FSM transitions`,
 			},
 		},
+		{name: "StreamingVerbs",
+			schema: `
+				module one {
+					verb A(Empty) Empty
+						+stream
+					verb B(Empty) Unit
+						+stream
+				}
+				`,
+			errs: []string{
+				`6:7-7: verb B: streaming verbs must have a response type`,
+			},
+		},
+		{name: "ConcurrencyLimits",
+			schema: `
+				module one {
+					verb A(Empty) Empty
+						+concurrency 4
+					verb B(Empty) Empty
+						+concurrency 1 queue 0
+					verb C(Empty) Empty
+						+concurrency 0
+					data D {
+					}
+						+concurrency 4
+				}
+				`,
+			errs: []string{
+				`11:7-7: metadata "+concurrency 4" is not valid on data structures`,
+				`8:7-7: verb C: concurrency limit must be at least 1`,
+			},
+		},
+		{name: "CacheDirectives",
+			schema: `
+				module one {
+					data Request {
+						id Int
+						region String
+					}
+					verb A(one.Request) Empty
+						+cache 5m
+					verb B(one.Request) Empty
+						+cache 1h vary id, region
+					verb C(one.Request) Empty
+						+cache 2d
+					verb D(one.Request) Empty
+						+cache 5m vary name
+					verb E(one.Request) Empty
+						+stream
+						+cache 5m
+					data F {
+					}
+						+cache 5m
+				}
+				`,
+			errs: []string{
+				`12:7-7: verb C: cache TTL can not be longer than 1d`,
+				`14:7-7: verb D: request type one.Request does not contain the field "name" to vary the cache on`,
+				`17:7-7: verb E: responses of streaming verbs can not be cached`,
+				`20:7-7: metadata "+cache 5m" is not valid on data structures`,
+			},
+		},
+		{name: "ResponseMapping",
+			schema: `
+				module one {
+					data Response {
+						code Int?
+						requestId String
+						tags [String]
+						nested one.Response?
+						data Any
+					}
+					verb A(HttpRequest<Empty>) HttpResponse<one.Response, Empty>
+						+ingress http GET /a
+						+response status code header "X-Request-Id" requestId header "X-Tags" tags header "Cache-Control" = "no-store" body data
+					verb B(HttpRequest<Empty>) HttpResponse<one.Response, Empty>
+						+ingress http GET /b
+						+response status requestId header "X Bad" nested header "X-Other" requestId body missing
+					verb C(HttpRequest<Empty>) HttpResponse<String, Empty>
+						+ingress http GET /c
+						+response body data
+					verb D(Empty) Empty
+						+response status code
+					verb E(HttpRequest<Empty>) HttpResponse<one.Response, Empty>
+						+ingress http GET /e
+						+response
+				}
+				`,
+			errs: []string{
+				`15:34-34: verb B: field "nested" of type one.Response? can not be mapped to the header "X Bad"`,
+				`15:34-34: verb B: invalid response header name "X Bad"`,
+				`15:56-56: verb B: field "requestId" can only be mapped once`,
+				`15:7-7: verb B: field "requestId" of type String can not be mapped to the status`,
+				`15:7-7: verb B: response body Response does not contain the field "missing" to map the body from`,
+				`18:7-7: verb C: response mappings require a data structure body, not String`,
+				`20:7-7: verb D: response mappings can only be added to HTTP ingress verbs`,
+				`23:7-7: verb E: response mapping must map a status, header or body`,
+			},
+		},
+		{name: "Deprecated",
+			schema: `
+				module one {
+					verb A(Empty) Empty
+						+deprecated "use B"
+					verb B(Empty) Empty
+					data C {
+						old String +deprecated
+						new String
+					}
+						+deprecated
+				}
+				`,
+			errs: []string{
+				`10:7-7: metadata "+deprecated" is not valid on data structures`,
+			},
+		},
+		{name: "Allow",
+			schema: `
+				module one {
+					export verb a(Empty) Empty
+						+allow two, three
+					verb b(Empty) Empty
+						+allow two
+					export verb c(Empty) Empty
+						+allow one
+				}
+				`,
+			errs: []string{
+				`6:7-7: verb b: only exported verbs can restrict their callers`,
+				`8:7-7: verb c: verbs can always be called from their own module`,
+			},
+		},
+		{name: "Egress",
+			schema: `
+				module one {
+					verb a(Empty) Empty
+						+egress "https://api.example.com", "http://*.example.com:8080/"
+					verb b(Empty) Empty
+						+egress "ftp://example.com", "https://example.com/v1", "https://api.*.com"
+				}
+				`,
+			errs: []string{
+				`6:7-7: verb b: egress URL "ftp://example.com" must be an http or https URL`,
+				`6:7-7: verb b: egress URL "https://api.*.com" can only have a wildcard as the first label of its host`,
+				`6:7-7: verb b: egress URL "https://example.com/v1" must only have a scheme, host and port`,
+			},
+		},
+		{name: "Internal",
+			schema: `
+				module one {
+					export internal verb a(Empty) Empty
+					internal verb b(Empty) Empty
+					export internal verb c(HttpRequest<Unit>) HttpResponse<Empty, Empty>
+						+ingress http GET /c
+					export internal data Req {
+					}
+					internal data Resp {
+					}
+				}
+				`,
+			errs: []string{
+				`4:6-6: verb b: internal verbs must also be exported`,
+				`6:7-7: verb c: internal verbs can not be ingress verbs`,
+				`9:6-6: data Resp: internal data structures must also be exported`,
+			},
+		},
+		{name: "FieldConstraints",
+			schema: `
+				module one {
+					data A {
+						age Int +validate min 0 +validate max 130
+						name String +validate minlen 1 +validate pattern "^[a-z]+$"
+						email String? +validate requiredif "name"
+						tags [String] +validate min 1
+						code String +validate pattern "("
+						note String +validate requiredif "missing"
+					}
+				}
+				`,
+			errs: []string{
+				`7:21-21: field tags: constraint "+validate min 1" is not valid on fields of type [String]`,
+				`8:19-19: field code: invalid pattern: error parsing regexp: missing closing ): ` + "`(`",
+				`9:19-19: field note: "+validate requiredif \"missing\"" is only valid on optional fields`,
+				`9:19-19: field note: "+validate requiredif \"missing\"" must refer to another field of A`,
+			},
+		},
+		{name: "ErrorMetadata",
+			schema: `
+				module one {
+					data NotFound {
+						id String
+					}
+						+error
+					data Invalid<T> {
+						value T
+					}
+						+error
+					verb A(Empty) Empty
+						+error
+				}
+				`,
+			errs: []string{
+				`10:7-7: error data structure Invalid can not have type parameters`,
+				`12:7-7: metadata "+error" is not valid on verbs`,
+			},
+		},
 		{name: "InvalidRetryDurations",
 			schema: `
 				module one {
@@ -379,6 +627,57 @@ func TestValidate(t *testing.T) {
 				`4:19: unexpected token "s"`,
 			},
 		},
+		{name: "ValidSaga",
+			schema: `
+				module one {
+					data Order {}
+
+					verb reserve(one.Order) Unit
+					verb release(one.Order) Unit
+					verb charge(one.Order) Unit
+					verb ship(one.Order) Unit
+
+					fsm checkout {
+						start one.reserve
+						transition one.reserve to one.charge
+						transition one.reserve to one.release
+						transition one.charge to one.ship
+						transition one.charge to one.release
+					}
+						+saga
+							step one.reserve compensate one.release
+							step one.charge
+							step one.ship
+				}
+				`,
+		},
+		{name: "InvalidSaga",
+			schema: `
+				module one {
+					data Order {}
+
+					verb reserve(one.Order) Unit
+					verb charge(one.Order) one.Order
+					verb ship(Empty) Unit
+
+					fsm checkout {
+						start one.reserve
+						transition one.reserve to one.charge
+					}
+						+saga
+							step one.reserve compensate one.reserve
+							step one.charge
+							step one.ship
+				}
+				`,
+			errs: []string{
+				`11:33-33: destination state "one.charge" must be a sink but is verb`,
+				`14:36-36: saga checkout: one.reserve can only be used once`,
+				`15:13-13: saga checkout: one.charge must be a sink`,
+				`16:13-13: saga checkout: one.ship must accept one.Order like the other steps, not builtin.Empty`,
+				`9:6-6: saga checkout: the start state and transitions of the FSM must match its steps`,
+			},
+		},
 		{name: "InvalidPubSub",
 			schema: `
 			module test {
@@ -16,6 +16,7 @@ type Verb struct {
 
 	Comments []string   `parser:"@Comment*" protobuf:"2"`
 	Export   bool       `parser:"@'export'?" protobuf:"3"`
+	Internal bool       `parser:"@'internal'?" protobuf:"8"`
 	Name     string     `parser:"'verb' @Ident" protobuf:"4"`
 	Request  Type       `parser:"'(' @@ ')'" protobuf:"5"`
 	Response Type       `parser:"@@" protobuf:"6"`
@@ -84,6 +85,9 @@ func (v *Verb) String() string {
 	if v.Export {
 		fmt.Fprint(w, "export ")
 	}
+	if v.Internal {
+		fmt.Fprint(w, "internal ")
+	}
 	fmt.Fprintf(w, "verb %s(%s) %s", v.Name, v.Request, v.Response)
 	fmt.Fprint(w, indent(encodeMetadata(v.Metadata)))
 	return w.String()
@@ -112,10 +116,59 @@ func (v *Verb) GetMetadataCronJob() optional.Option[*MetadataCronJob] {
 	return optional.None[*MetadataCronJob]()
 }
 
+func (v *Verb) GetMetadataConcurrency() optional.Option[*MetadataConcurrency] {
+	if m, ok := slices.FindVariant[*MetadataConcurrency](v.Metadata); ok {
+		return optional.Some(m)
+	}
+	return optional.None[*MetadataConcurrency]()
+}
+
+func (v *Verb) GetMetadataAllow() optional.Option[*MetadataAllow] {
+	if m, ok := slices.FindVariant[*MetadataAllow](v.Metadata); ok {
+		return optional.Some(m)
+	}
+	return optional.None[*MetadataAllow]()
+}
+
+func (v *Verb) GetMetadataCache() optional.Option[*MetadataCache] {
+	if m, ok := slices.FindVariant[*MetadataCache](v.Metadata); ok {
+		return optional.Some(m)
+	}
+	return optional.None[*MetadataCache]()
+}
+
+func (v *Verb) GetMetadataResponse() optional.Option[*MetadataResponse] {
+	if m, ok := slices.FindVariant[*MetadataResponse](v.Metadata); ok {
+		return optional.Some(m)
+	}
+	return optional.None[*MetadataResponse]()
+}
+
+func (v *Verb) GetMetadataEgress() optional.Option[*MetadataEgress] {
+	if m, ok := slices.FindVariant[*MetadataEgress](v.Metadata); ok {
+		return optional.Some(m)
+	}
+	return optional.None[*MetadataEgress]()
+}
+
+func (v *Verb) GetMetadataDeprecated() optional.Option[*MetadataDeprecated] {
+	if m, ok := slices.FindVariant[*MetadataDeprecated](v.Metadata); ok {
+		return optional.Some(m)
+	}
+	return optional.None[*MetadataDeprecated]()
+}
+
+// IsStream returns true if the Verb streams its responses.
+func (v *Verb) IsStream() bool {
+	_, ok := slices.FindVariant[*MetadataStream](v.Metadata)
+	return ok
+}
+
 func (v *Verb) ToProto() proto.Message {
 	return &schemapb.Verb{
 		Pos:      posToProto(v.Pos),
 		Export:   v.Export,
+		Internal: v.Internal,
 		Name:     v.Name,
 		Comments: v.Comments,
 		Request:  TypeToProto(v.Request),
@@ -128,6 +181,7 @@ func VerbFromProto(s *schemapb.Verb) *Verb {
 	return &Verb{
 		Pos:      posFromProto(s.Pos),
 		Export:   s.Export,
+		Internal: s.Internal,
 		Name:     s.Name,
 		Comments: s.Comments,
 		Request:  TypeFromProto(s.Request),
@@ -0,0 +1,91 @@
+package schema
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+)
+
+// ProtoVersion is the version of the protobuf wire format of modules written
+// by this version of FTL.
+//
+// Modules persisted by the controller, and exchanged with runners and the
+// build engine, may have been encoded by an older or newer version of FTL. When
+// a change to the schema changes how existing modules are encoded, increment
+// ProtoVersion, add a migration between the previous version and the new one
+// to protoMigrations, and add golden fixtures for the new version to
+// testdata/protoversions.
+//
+// Version 0 is the unversioned format written before versioning was
+// introduced, which is otherwise identical to version 1.
+const ProtoVersion = 1
+
+// protoMigration converts a module between a version of the wire format and
+// the next.
+type protoMigration struct {
+	// Upgrade a module encoded with the previous version.
+	upgrade func(m *schemapb.Module) error
+	// Downgrade a module to the previous version, for older clients.
+	downgrade func(m *schemapb.Module) error
+}
+
+// protoMigrations to each version of the wire format, from the previous
+// version.
+var protoMigrations = map[int64]protoMigration{
+	1: {},
+}
+
+// UnsupportedProtoVersionError is returned when a module was encoded with a
+// version of the wire format newer than this version of FTL supports.
+type UnsupportedProtoVersionError struct {
+	Module  string
+	Version int64
+}
+
+func (e UnsupportedProtoVersionError) Error() string {
+	return fmt.Sprintf("module %q was encoded with schema wire format version %d, but this version of FTL only supports up to version %d; upgrade FTL", e.Module, e.Version, ProtoVersion)
+}
+
+// UpgradeModuleProto migrates a module in place from the version of the wire
+// format it was encoded with to [ProtoVersion].
+func UpgradeModuleProto(m *schemapb.Module) error {
+	if m.ProtoVersion > ProtoVersion {
+		return UnsupportedProtoVersionError{Module: m.Name, Version: m.ProtoVersion}
+	}
+	if m.ProtoVersion < 0 {
+		return fmt.Errorf("module %q has invalid schema wire format version %d", m.Name, m.ProtoVersion)
+	}
+	for version := m.ProtoVersion + 1; version <= ProtoVersion; version++ {
+		if upgrade := protoMigrations[version].upgrade; upgrade != nil {
+			if err := upgrade(m); err != nil {
+				return fmt.Errorf("failed to upgrade module %q to schema wire format version %d: %w", m.Name, version, err)
+			}
+		}
+		m.ProtoVersion = version
+	}
+	return nil
+}
+
+// DowngradeModuleProto returns a copy of a module encoded with [ProtoVersion],
+// migrated to an older version of the wire format understood by a client.
+//
+// The module itself is returned if no migration is necessary.
+func DowngradeModuleProto(m *schemapb.Module, version int64) (*schemapb.Module, error) {
+	if version >= m.ProtoVersion {
+		return m, nil
+	}
+	if version < 0 {
+		return nil, fmt.Errorf("invalid schema wire format version %d", version)
+	}
+	out := proto.Clone(m).(*schemapb.Module) //nolint:forcetypeassert
+	for ; out.ProtoVersion > version; out.ProtoVersion-- {
+		if downgrade := protoMigrations[out.ProtoVersion].downgrade; downgrade != nil {
+			if err := downgrade(out); err != nil {
+				return nil, fmt.Errorf("failed to downgrade module %q to schema wire format version %d: %w", m.Name, out.ProtoVersion-1, err)
+			}
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+)
+
+// TestProtoVersionCompatibility decodes golden modules encoded with every
+// version of the wire format, in testdata/protoversions/v<version>/<module>.json,
+// and checks that they match the schema in testdata/protoversions/<module>.ftl.
+func TestProtoVersionCompatibility(t *testing.T) {
+	for version := int64(0); version <= ProtoVersion; version++ {
+		fixtures, err := filepath.Glob(fmt.Sprintf("testdata/protoversions/v%d/*.json", version))
+		assert.NoError(t, err)
+		assert.NotEqual(t, 0, len(fixtures), "no golden fixtures for schema wire format version %d", version)
+		for _, fixture := range fixtures {
+			name := strings.TrimSuffix(filepath.Base(fixture), ".json")
+			t.Run(fmt.Sprintf("v%d/%s", version, name), func(t *testing.T) {
+				data, err := os.ReadFile(fixture)
+				assert.NoError(t, err)
+				encoded := &schemapb.Module{}
+				assert.NoError(t, protojson.Unmarshal(data, encoded))
+				assert.Equal(t, version, encoded.ProtoVersion)
+
+				module, err := ModuleFromProto(encoded)
+				assert.NoError(t, err)
+				expected, err := os.ReadFile(filepath.Join("testdata/protoversions", name+".ftl"))
+				assert.NoError(t, err)
+				assert.Equal(t, normaliseString(string(expected)), normaliseString(module.String()))
+
+				// Modules sent to clients understanding this version decode
+				// to the same module.
+				downgraded, err := DowngradeModuleProto(module.ToProto().(*schemapb.Module), version) //nolint:forcetypeassert
+				assert.NoError(t, err)
+				assert.Equal(t, version, downgraded.ProtoVersion)
+				roundtripped, err := ModuleFromProto(downgraded)
+				assert.NoError(t, err)
+				assert.Equal(t, Normalise(module), Normalise(roundtripped))
+			})
+		}
+	}
+}
+
+func TestUnsupportedProtoVersion(t *testing.T) {
+	encoded := &schemapb.Module{Name: "echo", ProtoVersion: ProtoVersion + 1}
+	_, err := ModuleFromProto(encoded)
+	assert.IsError(t, err, UnsupportedProtoVersionError{Module: "echo", Version: ProtoVersion + 1})
+	assert.Equal(t, ProtoVersion+1, encoded.ProtoVersion, "the encoded module should not be modified")
+}
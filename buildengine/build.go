@@ -2,6 +2,7 @@ package buildengine
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -15,6 +16,7 @@ import (
 	"github.com/TBD54566975/ftl/internal/errors"
 	"github.com/TBD54566975/ftl/internal/flock"
 	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/model"
 )
 
 const BuildLockTimeout = time.Minute
@@ -23,10 +25,12 @@ const BuildLockTimeout = time.Minute
 //
 // A lock file is used to ensure that only one build is running at a time.
 func Build(ctx context.Context, sch *schema.Schema, module Module, filesTransaction ModifyFilesTransaction) error {
-	return buildModule(ctx, sch, module, filesTransaction)
+	return buildModule(ctx, model.Platform{}, sch, module, filesTransaction)
 }
 
-func buildModule(ctx context.Context, sch *schema.Schema, module Module, filesTransaction ModifyFilesTransaction) error {
+// buildModule builds a module for "target", or for the current platform if it
+// is zero.
+func buildModule(ctx context.Context, target model.Platform, sch *schema.Schema, module Module, filesTransaction ModifyFilesTransaction) error {
 	release, err := flock.Acquire(ctx, filepath.Join(module.Config.Dir, ".ftl.lock"), BuildLockTimeout)
 	if err != nil {
 		return err
@@ -41,10 +45,12 @@ func buildModule(ctx context.Context, sch *schema.Schema, module Module, filesTr
 	}
 
 	logger.Infof("Building module")
-	switch module.Config.Language {
-	case "go":
-		err = buildGoModule(ctx, sch, module, filesTransaction)
-	case "kotlin":
+	switch {
+	case module.Config.External.Build != "":
+		err = buildExternalModule(ctx, target, sch, module)
+	case module.Config.Language == "go":
+		err = buildGoModule(ctx, target, sch, module, filesTransaction)
+	case module.Config.Language == "kotlin":
 		err = buildKotlinModule(ctx, sch, module)
 	default:
 		return fmt.Errorf("unknown language %q", module.Config.Language)
@@ -63,6 +69,9 @@ func buildModule(ctx context.Context, sch *schema.Schema, module Module, filesTr
 	for _, e := range errorList.Errors {
 		errs = append(errs, e)
 	}
+	if err := writeDiagnostics(module.Config.Abs(), errorList); err != nil {
+		return fmt.Errorf("failed to write build diagnostics: %w", err)
+	}
 
 	if len(errs) > 0 {
 		return errors.Join(errs...)
@@ -87,3 +96,41 @@ func loadProtoErrors(config moduleconfig.AbsModuleConfig) (*schema.ErrorList, er
 	}
 	return schema.ErrorListFromProto(errorspb), nil
 }
+
+// diagnostics is the content of a module's diagnostics file, which contains
+// every schema error from the last build for use by editors and CI.
+type diagnostics struct {
+	Module      string       `json:"module"`
+	Diagnostics []diagnostic `json:"diagnostics"`
+}
+
+type diagnostic struct {
+	File      string `json:"file"`
+	Line      int    `json:"line"`
+	Column    int    `json:"column"`
+	EndColumn int    `json:"endColumn"`
+	Level     string `json:"level"`
+	Message   string `json:"message"`
+}
+
+func writeDiagnostics(config moduleconfig.AbsModuleConfig, errorList *schema.ErrorList) error {
+	out := diagnostics{Module: config.Module, Diagnostics: make([]diagnostic, 0, len(errorList.Errors))}
+	for _, e := range errorList.Errors {
+		out.Diagnostics = append(out.Diagnostics, diagnostic{
+			File:      e.Pos.Filename,
+			Line:      e.Pos.Line,
+			Column:    e.Pos.Column,
+			EndColumn: e.EndColumn,
+			Level:     e.Level.String(),
+			Message:   e.Msg,
+		})
+	}
+	content, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(config.Diagnostics), 0700); err != nil {
+		return err
+	}
+	return os.WriteFile(config.Diagnostics, append(content, '\n'), 0600)
+}
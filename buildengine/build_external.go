@@ -0,0 +1,78 @@
+package buildengine
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/exec"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/model"
+)
+
+// dependenciesSchemaFile is the file in the deploy directory that the schemas
+// of a module's dependencies are written to for external builds.
+const dependenciesSchemaFile = "dependencies.pb"
+
+// buildExternalModule builds a module with the command configured in its
+// [external] section, for modules built by another build system such as Bazel.
+//
+// The command is passed the locations it must write the module's schema,
+// errors and deploy files to, the schemas of its dependencies, and the target
+// platform if any, in environment variables.
+func buildExternalModule(ctx context.Context, target model.Platform, sch *schema.Schema, module Module) error {
+	logger := log.FromContext(ctx)
+	config := module.Config.Abs()
+	if err := os.MkdirAll(config.DeployDir, 0700); err != nil {
+		return fmt.Errorf("failed to create deploy directory: %w", err)
+	}
+	dependencies, err := proto.Marshal(sch.ToProto())
+	if err != nil {
+		return fmt.Errorf("failed to marshal dependency schemas: %w", err)
+	}
+	dependenciesFile := filepath.Join(config.DeployDir, dependenciesSchemaFile)
+	if err := os.WriteFile(dependenciesFile, dependencies, 0600); err != nil {
+		return fmt.Errorf("failed to write dependency schemas: %w", err)
+	}
+
+	logger.Debugf("Using external build command '%s'", config.External.Build)
+	cmd := exec.Command(ctx, log.Debug, config.Dir, "bash", "-c", config.External.Build)
+	cmd.Env = append(cmd.Env,
+		"FTL_MODULE="+config.Module,
+		"FTL_DEPLOY_DIR="+config.DeployDir,
+		"FTL_SCHEMA_FILE="+config.Schema,
+		"FTL_ERRORS_FILE="+config.Errors,
+		"FTL_DEPENDENCIES="+strings.Join(module.Dependencies, ","),
+		"FTL_DEPENDENCIES_SCHEMA_FILE="+dependenciesFile,
+	)
+	if !target.IsZero() {
+		cmd.Env = append(cmd.Env, "FTL_TARGET_OS="+target.OS, "FTL_TARGET_ARCH="+target.Arch)
+	}
+	if err := cmd.RunBuffered(ctx); err != nil {
+		return fmt.Errorf("failed to build module %q: %w", config.Module, err)
+	}
+
+	// Build errors are reported by the command writing them to the errors
+	// file, in which case the schema and deploy files aren't expected.
+	if _, err := os.Stat(config.Errors); err == nil {
+		return nil
+	}
+	moduleSchema, err := schema.ModuleFromProtoFile(config.Schema)
+	if err != nil {
+		return fmt.Errorf("external build of module %q did not produce a valid schema at %s: %w", config.Module, config.Schema, err)
+	}
+	if moduleSchema.Name != config.Module {
+		return fmt.Errorf("external build of module %q produced the schema of module %q", config.Module, moduleSchema.Name)
+	}
+	for _, file := range config.Deploy {
+		if _, err := os.Stat(file); err != nil {
+			return fmt.Errorf("external build of module %q did not produce deploy file %s: %w", config.Module, file, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package buildengine
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+	"google.golang.org/protobuf/proto"
+
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+func TestExternalBuild(t *testing.T) {
+	dir := t.TempDir()
+	module, err := proto.Marshal((&schema.Module{Name: "external"}).ToProto())
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "schema.pb"), module, 0600))
+	ctx := log.ContextWithLogger(context.Background(), log.Configure(os.Stderr, log.Config{}))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "ftl.toml"), []byte(`
+module = "external"
+language = "go"
+
+[external]
+build = "cp schema.pb \"$FTL_SCHEMA_FILE\" && touch \"$FTL_DEPLOY_DIR/main\" && echo \"$FTL_DEPENDENCIES\" > \"$FTL_DEPLOY_DIR/deps\""
+dependencies = ["alpha"]
+`), 0600))
+
+	m, err := LoadModule(dir)
+	assert.NoError(t, err)
+	m, err = UpdateDependencies(ctx, m)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"alpha", "builtin"}, m.Dependencies)
+	sch := &schema.Schema{Modules: []*schema.Module{schema.Builtins(), {Name: "alpha"}}}
+	err = Build(ctx, sch, m, &mockModifyFilesTransaction{})
+	assert.NoError(t, err)
+
+	deps, err := os.ReadFile(filepath.Join(dir, "_ftl", "deps"))
+	assert.NoError(t, err)
+	assert.Equal(t, "alpha,builtin\n", string(deps))
+	data, err := os.ReadFile(filepath.Join(dir, "_ftl", dependenciesSchemaFile))
+	assert.NoError(t, err)
+	dependencies := &schemapb.Schema{}
+	assert.NoError(t, proto.Unmarshal(data, dependencies))
+	assert.Equal(t, 2, len(dependencies.Modules))
+
+	// The deploy files must be produced by the command.
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "ftl.toml"), []byte(`
+module = "external"
+language = "go"
+
+[external]
+build = "cp schema.pb \"$FTL_SCHEMA_FILE\""
+dependencies = []
+`), 0600))
+	m, err = LoadModule(dir)
+	assert.NoError(t, err)
+	err = Build(ctx, sch, m, &mockModifyFilesTransaction{})
+	assert.Contains(t, err.Error(), "did not produce deploy file")
+}
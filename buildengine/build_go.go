@@ -6,10 +6,11 @@ import (
 
 	"github.com/TBD54566975/ftl/backend/schema"
 	"github.com/TBD54566975/ftl/go-runtime/compile"
+	"github.com/TBD54566975/ftl/internal/model"
 )
 
-func buildGoModule(ctx context.Context, sch *schema.Schema, module Module, transaction ModifyFilesTransaction) error {
-	if err := compile.Build(ctx, module.Config.Dir, sch, transaction); err != nil {
+func buildGoModule(ctx context.Context, target model.Platform, sch *schema.Schema, module Module, transaction ModifyFilesTransaction) error {
+	if err := compile.Build(ctx, module.Config.Dir, target, sch, transaction); err != nil {
 		return fmt.Errorf("failed to build module %q: %w", module.Config.Module, err)
 	}
 	return nil
@@ -1,9 +1,7 @@
 package buildengine
 
 import (
-	"fmt"
 	"os"
-	"runtime"
 	"testing"
 
 	"github.com/alecthomas/assert/v2"
@@ -49,6 +47,34 @@ func TestGenerateGoModule(t *testing.T) {
 						{Name: "B", Value: &schema.TypeValue{Value: &schema.String{}}},
 					},
 				},
+				&schema.TypeAlias{
+					Comments: []string{"This is a type alias."},
+					Name:     "CustomerID",
+					Export:   true,
+					Type:     &schema.String{},
+				},
+				&schema.TypeAlias{
+					Name:   "Ledger",
+					Export: true,
+					Type:   &schema.Map{Key: &schema.Ref{Name: "CustomerID"}, Value: &schema.Time{}},
+				},
+				&schema.Data{
+					Name:           "Page",
+					Export:         true,
+					TypeParameters: []*schema.TypeParameter{{Name: "T"}},
+					Fields: []*schema.Field{
+						{Name: "items", Type: &schema.Array{Element: &schema.Ref{Name: "T"}}},
+					},
+				},
+				&schema.Data{
+					Name:           "Index",
+					Export:         true,
+					TypeParameters: []*schema.TypeParameter{{Name: "K"}, {Name: "V"}},
+					Fields: []*schema.Field{
+						{Name: "entries", Type: &schema.Map{Key: &schema.Ref{Name: "K"}, Value: &schema.Ref{Name: "V"}}},
+						{Name: "pages", Type: &schema.Array{Element: &schema.Ref{Name: "Page", TypeParameters: []schema.Type{&schema.Ref{Name: "V"}}}}},
+					},
+				},
 				&schema.Data{Name: "EchoRequest", Export: true},
 				&schema.Data{
 					Comments: []string{"This is an echo data response."},
@@ -80,6 +106,11 @@ func TestGenerateGoModule(t *testing.T) {
 					Request:  &schema.Unit{},
 					Response: &schema.Unit{},
 				},
+				&schema.Data{
+					Name:     "NotFound",
+					Export:   true,
+					Metadata: []schema.Metadata{&schema.MetadataError{}},
+				},
 			}},
 			{Name: "test"},
 		},
@@ -90,6 +121,8 @@ package other
 
 import (
   "context"
+  "github.com/TBD54566975/ftl/go-runtime/ftl"
+  stdtime "time"
 
   "github.com/TBD54566975/ftl/go-runtime/ftl/reflection"
 )
@@ -129,6 +162,23 @@ type B string
 
 func (B) typeEnum() {}
 
+// This is a type alias.
+//
+//ftl:typealias
+type CustomerID string
+
+//ftl:typealias
+type Ledger map[CustomerID]stdtime.Time
+
+type Page[T any] struct {
+  Items []T ` + "`json:\"items\"`" + `
+}
+
+type Index[K comparable, V any] struct {
+  Entries map[K]V ` + "`json:\"entries\"`" + `
+  Pages []Page[V] ` + "`json:\"pages\"`" + `
+}
+
 type EchoRequest struct {
 }
 
@@ -167,12 +217,38 @@ func Nothing(context.Context) error {
   panic("Verb stubs should not be called directly, instead use github.com/TBD54566975/ftl/runtime-go/ftl.CallEmpty()")
 }
 
+type NotFound struct {
+}
+
+func (NotFound) Error() string { return "other.NotFound" }
+
+// OtherClient calls the exported verbs of the other module.
+//
+// Individual verbs can be replaced with fakes in tests.
+type OtherClient struct {
+  Echo ftl.VerbClient[EchoRequest, EchoResponse]
+  Sink ftl.SinkClient[SinkReq]
+  Source ftl.SourceClient[SourceResp]
+  Nothing ftl.EmptyClient
+}
+
+// NewOtherClient returns a client that calls the exported verbs of the other module through FTL.
+func NewOtherClient() OtherClient {
+  return OtherClient{
+    Echo: ftl.NewVerbClient(Echo),
+    Sink: ftl.NewSinkClient(Sink),
+    Source: ftl.NewSourceClient(Source),
+    Nothing: ftl.NewEmptyClient(Nothing),
+  }
+}
+
 func init() {
   reflection.Register(
     reflection.SumType[TypeEnum](
       *new(A),
       *new(B),
     ),
+    reflection.ErrorType[NotFound](),
   )
 }
 `
@@ -235,6 +311,7 @@ package test
 
 import (
   "context"
+  "github.com/TBD54566975/ftl/go-runtime/ftl"
 )
 
 var _ = context.Background
@@ -253,6 +330,115 @@ type Resp struct {
 func Call(context.Context, Req) (Resp, error) {
   panic("Verb stubs should not be called directly, instead use github.com/TBD54566975/ftl/runtime-go/ftl.Call()")
 }
+
+// TestClient calls the exported verbs of the test module.
+//
+// Individual verbs can be replaced with fakes in tests.
+type TestClient struct {
+  Call ftl.VerbClient[Req, Resp]
+}
+
+// NewTestClient returns a client that calls the exported verbs of the test module through FTL.
+func NewTestClient() TestClient {
+  return TestClient{
+    Call: ftl.NewVerbClient(Call),
+  }
+}
+`
+	bctx := buildContext{
+		moduleDir: "testdata/another",
+		buildDir:  "_ftl",
+		sch:       sch,
+	}
+	testBuild(t, bctx, "", []assertion{
+		assertGeneratedModule("go/modules/test/external_module.go", expected),
+	})
+}
+
+func TestGenerateDeprecated(t *testing.T) {
+	if testing.Short() {
+		t.SkipNow()
+	}
+	sch := &schema.Schema{
+		Modules: []*schema.Module{
+			schema.Builtins(),
+			{Name: "test", Decls: []schema.Decl{
+				&schema.Data{Name: "Req", Export: true, Fields: []*schema.Field{
+					{Name: "cursor", Type: &schema.String{}},
+					{Name: "offset", Type: &schema.Int{}, Metadata: []schema.Metadata{
+						&schema.MetadataDeprecated{Deprecated: true, Message: "use cursor"},
+					}},
+				}},
+				&schema.Verb{
+					Comments: []string{"This is a verb."},
+					Name:     "list",
+					Export:   true,
+					Request:  &schema.Ref{Name: "Req"},
+					Response: &schema.Unit{},
+					Metadata: []schema.Metadata{
+						&schema.MetadataDeprecated{Deprecated: true, Message: "use test.query"},
+					},
+				},
+				&schema.Verb{
+					Name:     "query",
+					Export:   true,
+					Request:  &schema.Ref{Name: "Req"},
+					Response: &schema.Unit{},
+					Metadata: []schema.Metadata{
+						&schema.MetadataDeprecated{Deprecated: true},
+					},
+				},
+			}},
+		},
+	}
+	expected := `// Code generated by FTL. DO NOT EDIT.
+
+package test
+
+import (
+  "context"
+  "github.com/TBD54566975/ftl/go-runtime/ftl"
+)
+
+var _ = context.Background
+
+type Req struct {
+  Cursor string ` + "`json:\"cursor\"`" + `
+  // Deprecated: use cursor
+  Offset int ` + "`json:\"offset\"`" + `
+}
+
+// This is a verb.
+//
+// Deprecated: use test.query
+//
+//ftl:verb
+func List(context.Context, Req) error {
+  panic("Verb stubs should not be called directly, instead use github.com/TBD54566975/ftl/runtime-go/ftl.CallSink()")
+}
+
+// Deprecated: this will be removed in a future version.
+//
+//ftl:verb
+func Query(context.Context, Req) error {
+  panic("Verb stubs should not be called directly, instead use github.com/TBD54566975/ftl/runtime-go/ftl.CallSink()")
+}
+
+// TestClient calls the exported verbs of the test module.
+//
+// Individual verbs can be replaced with fakes in tests.
+type TestClient struct {
+  List ftl.SinkClient[Req]
+  Query ftl.SinkClient[Req]
+}
+
+// NewTestClient returns a client that calls the exported verbs of the test module through FTL.
+func NewTestClient() TestClient {
+  return TestClient{
+    List: ftl.NewSinkClient(List),
+    Query: ftl.NewSinkClient(Query),
+  }
+}
 `
 	bctx := buildContext{
 		moduleDir: "testdata/another",
@@ -279,6 +465,11 @@ func TestExternalType(t *testing.T) {
 			"unsupported type \"time.Month\" for field \"Month\"",
 			"unsupported response type \"ftl/external.ExternalResponse\"",
 		),
+		assertBuildDiagnostics(
+			"unsupported external type \"time.Month\"",
+			"unsupported type \"time.Month\" for field \"Month\"",
+			"unsupported response type \"ftl/external.ExternalResponse\"",
+		),
 	})
 }
 
@@ -305,7 +496,7 @@ func TestGoModVersion(t *testing.T) {
 		buildDir:  "_ftl",
 		sch:       sch,
 	}
-	testBuild(t, bctx, fmt.Sprintf("go version %q is not recent enough for this module, needs minimum version \"9000.1.1\"", runtime.Version()[2:]), []assertion{})
+	testBuild(t, bctx, `is not recent enough for this module, needs minimum version "9000.1.1"`, []assertion{})
 }
 
 func TestGeneratedTypeRegistry(t *testing.T) {
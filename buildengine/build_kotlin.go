@@ -152,6 +152,10 @@ var scaffoldFuncs = scaffolder.FuncMap{
 		return sb.String()
 	},
 	"type": genType,
+	// aliasType is the type of a typealias, which unlike a field can't have a default value.
+	"aliasType": func(module *schema.Module, t schema.Type) string {
+		return strings.TrimSuffix(genType(module, t), " = null")
+	},
 	"is": func(kind string, t schema.Node) bool {
 		return reflect.Indirect(reflect.ValueOf(t)).Type().Name() == kind
 	},
@@ -171,6 +175,15 @@ var scaffoldFuncs = scaffolder.FuncMap{
 			case *schema.Time:
 				imports.Add("java.time.OffsetDateTime")
 
+			case *schema.Timestamp:
+				imports.Add("java.time.ZonedDateTime")
+
+			case *schema.UUID:
+				imports.Add("java.util.UUID")
+
+			case *schema.Decimal:
+				imports.Add("java.math.BigDecimal")
+
 			default:
 			}
 			return next()
@@ -215,6 +228,15 @@ func genType(module *schema.Module, t schema.Type) string {
 	case *schema.Time:
 		return "OffsetDateTime"
 
+	case *schema.Timestamp:
+		return "ZonedDateTime"
+
+	case *schema.UUID:
+		return "UUID"
+
+	case *schema.Decimal:
+		return "BigDecimal"
+
 	case *schema.Array:
 		return "List<" + genType(module, t.Element) + ">"
 
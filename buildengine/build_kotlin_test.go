@@ -72,6 +72,15 @@ func TestGenerateAllTypes(t *testing.T) {
 						},
 					},
 					&schema.Data{Name: "TestRequest", Fields: []*schema.Field{{Name: "field", Type: &schema.Int{}}}},
+					&schema.TypeAlias{
+						Name:     "CustomerId",
+						Comments: []string{"Alias comments"},
+						Type:     &schema.String{},
+					},
+					&schema.TypeAlias{
+						Name: "Ledger",
+						Type: &schema.Map{Key: &schema.Ref{Name: "CustomerId"}, Value: &schema.Optional{Type: &schema.Time{}}},
+					},
 					&schema.Data{
 						Name:     "TestResponse",
 						Comments: []string{"Response comments"},
@@ -138,6 +147,13 @@ data class TestRequest(
   val field: Long,
 )
 
+/**
+ * Alias comments
+ */
+typealias CustomerId = String
+
+typealias Ledger = Map<CustomerId, OffsetDateTime?>
+
 /**
  * Response comments
  */
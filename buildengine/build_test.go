@@ -2,6 +2,7 @@ package buildengine
 
 import (
 	"context"
+	"encoding/json"
 	"os"
 	"path/filepath"
 	"testing"
@@ -117,6 +118,30 @@ func assertGeneratedMain(expectedContent string) assertion {
 	}
 }
 
+func assertBuildDiagnostics(msgs ...string) assertion {
+	return func(t testing.TB, bctx buildContext) error {
+		t.Helper()
+		config, err := moduleconfig.LoadModuleConfig(bctx.moduleDir)
+		assert.NoError(t, err, "Error loading module config")
+		content, err := os.ReadFile(config.Abs().Diagnostics)
+		assert.NoError(t, err, "Error reading diagnostics")
+		actual := diagnostics{}
+		err = json.Unmarshal(content, &actual)
+		assert.NoError(t, err, "Error decoding diagnostics")
+
+		assert.Equal(t, config.Module, actual.Module)
+		actualMsgs := make([]string, 0, len(actual.Diagnostics))
+		for _, d := range actual.Diagnostics {
+			assert.NotEqual(t, "", d.File, "diagnostic %q has no file", d.Message)
+			assert.NotEqual(t, 0, d.Line, "diagnostic %q has no line", d.Message)
+			assert.Equal(t, "error", d.Level)
+			actualMsgs = append(actualMsgs, d.Message)
+		}
+		assert.Equal(t, msgs, actualMsgs)
+		return nil
+	}
+}
+
 func assertBuildProtoErrors(msgs ...string) assertion {
 	return func(t testing.TB, bctx buildContext) error {
 		t.Helper()
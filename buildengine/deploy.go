@@ -21,6 +21,10 @@ import (
 	"github.com/TBD54566975/ftl/internal/slices"
 )
 
+// terminationTimeout is the maximum time to wait for a terminated deployment's
+// runners to drain in-flight calls and stop.
+const terminationTimeout = 30 * time.Second
+
 type deploymentArtefact struct {
 	*ftlv1.DeploymentArtefact
 	localPath string
@@ -34,8 +38,11 @@ type DeployClient interface {
 	Status(ctx context.Context, req *connect.Request[ftlv1.StatusRequest]) (*connect.Response[ftlv1.StatusResponse], error)
 }
 
-// Deploy a module to the FTL controller with the given number of replicas. Optionally wait for the deployment to become ready.
-func Deploy(ctx context.Context, module Module, replicas int32, waitForDeployOnline bool, client DeployClient) error {
+// Deploy a module of "project" to the FTL controller with the given number of replicas. Optionally wait for the deployment to become ready.
+//
+// Missing artefacts are uploaded concurrently, reporting progress to
+// "progress" if it is not nil.
+func Deploy(ctx context.Context, project string, module Module, replicas int32, waitForDeployOnline bool, client DeployClient, progress UploadProgress) error {
 	logger := log.FromContext(ctx).Scope(module.Config.Module)
 	ctx = log.ContextWithLogger(ctx, logger)
 	logger.Infof("Deploying module")
@@ -57,26 +64,15 @@ func Deploy(ctx context.Context, module Module, replicas int32, waitForDeployOnl
 		return err
 	}
 
-	moduleSchema, err := loadProtoSchema(moduleConfig, replicas)
+	moduleSchema, err := loadProtoSchema(moduleConfig, project, replicas)
 	if err != nil {
 		return fmt.Errorf("failed to load protobuf schema from %q: %w", module.Config.Schema, err)
 	}
 
 	logger.Debugf("Uploading %d/%d files", len(gadResp.Msg.MissingDigests), len(files))
-	for _, missing := range gadResp.Msg.MissingDigests {
-		file := filesByHash[missing]
-		content, err := os.ReadFile(file.localPath)
-		if err != nil {
-			return err
-		}
-		logger.Tracef("Uploading %s", relToCWD(file.localPath))
-		resp, err := client.UploadArtefact(ctx, connect.NewRequest(&ftlv1.UploadArtefactRequest{
-			Content: content,
-		}))
-		if err != nil {
-			return err
-		}
-		logger.Debugf("Uploaded %s as %s:%s", relToCWD(file.localPath), sha256.FromBytes(resp.Msg.Digest), file.Path)
+	missing := slices.Map(gadResp.Msg.MissingDigests, func(digest string) string { return filesByHash[digest].localPath })
+	if err := uploadArtefacts(ctx, client, missing, progress); err != nil {
+		return err
 	}
 
 	resp, err := client.CreateDeployment(ctx, connect.NewRequest(&ftlv1.CreateDeploymentRequest{
@@ -127,10 +123,20 @@ func terminateModuleDeployment(ctx context.Context, client ftlv1connect.Controll
 
 	logger.Infof("Terminating deployment %s", key)
 	_, err = client.UpdateDeploy(ctx, connect.NewRequest(&ftlv1.UpdateDeployRequest{DeploymentKey: key}))
-	return err
+	if err != nil {
+		return err
+	}
+
+	// Runners drain in-flight calls before terminating, so wait for them to finish.
+	waitCtx, cancel := context.WithTimeout(ctx, terminationTimeout)
+	defer cancel()
+	if err := checkTermination(waitCtx, client, key); err != nil {
+		logger.Warnf("Deployment %s did not terminate within %s: %s", key, terminationTimeout, err)
+	}
+	return nil
 }
 
-func loadProtoSchema(config moduleconfig.AbsModuleConfig, replicas int32) (*schemapb.Module, error) {
+func loadProtoSchema(config moduleconfig.AbsModuleConfig, project string, replicas int32) (*schemapb.Module, error) {
 	content, err := os.ReadFile(config.Schema)
 	if err != nil {
 		return nil, err
@@ -151,6 +157,20 @@ func loadProtoSchema(config moduleconfig.AbsModuleConfig, replicas int32) (*sche
 	}
 	runtime.Language = config.Language
 	runtime.MinReplicas = replicas
+	if project != "" {
+		runtime.Project = &project
+	}
+	if config.Owner != "" {
+		runtime.Owner = &config.Owner
+	}
+	runtime.Links = config.Links
+	readme, err := readmeExcerpt(config.Readme)
+	if err != nil {
+		return nil, err
+	}
+	if readme != "" {
+		runtime.Readme = &readme
+	}
 	return module, nil
 }
 
@@ -234,6 +254,35 @@ func relToCWD(path string) string {
 	return rel
 }
 
+// checkTermination waits until no runners are assigned to a deployment.
+func checkTermination(ctx context.Context, client DeployClient, deploymentKey string) error {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			status, err := client.Status(ctx, connect.NewRequest(&ftlv1.StatusRequest{}))
+			if err != nil {
+				return err
+			}
+
+			terminated := true
+			for _, deployment := range status.Msg.Deployments {
+				if deployment.Key == deploymentKey && deployment.Replicas > 0 {
+					terminated = false
+				}
+			}
+			if terminated {
+				return nil
+			}
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
 func checkReadiness(ctx context.Context, client DeployClient, deploymentKey string, replicas int32) error {
 	ticker := time.NewTicker(time.Second)
 	defer ticker.Stop()
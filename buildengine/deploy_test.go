@@ -83,6 +83,6 @@ func TestDeploy(t *testing.T) {
 		DeploymentKey:  "test-deployment",
 	}
 
-	err = Deploy(ctx, module, int32(1), true, client)
+	err = Deploy(ctx, "", module, int32(1), true, client, nil)
 	assert.NoError(t, err)
 }
@@ -44,6 +44,9 @@ func UpdateDependencies(ctx context.Context, module Module) (Module, error) {
 }
 
 func extractDependencies(module Module) ([]string, error) {
+	if deps := module.Config.External.Dependencies; module.Config.External.Build != "" && deps != nil {
+		return deps, nil
+	}
 	switch module.Config.Language {
 	case "go":
 		return extractGoFTLImports(module.Config.Module, module.Config.Dir)
@@ -17,54 +17,62 @@ func TestDiscoverModules(t *testing.T) {
 	expected := []Module{
 		{
 			Config: moduleconfig.ModuleConfig{
-				Dir:       "testdata/alpha",
-				Language:  "go",
-				Realm:     "home",
-				Module:    "alpha",
-				Deploy:    []string{"main"},
-				DeployDir: "_ftl",
-				Schema:    "schema.pb",
-				Errors:    "errors.pb",
-				Watch:     []string{"**/*.go", "go.mod", "go.sum", "../../../go-runtime/ftl/**/*.go"},
+				Dir:         "testdata/alpha",
+				Language:    "go",
+				Realm:       "home",
+				Module:      "alpha",
+				Deploy:      []string{"main"},
+				DeployDir:   "_ftl",
+				Schema:      "schema.pb",
+				Errors:      "errors.pb",
+				Diagnostics: "diagnostics.json",
+				Readme:      "README.md",
+				Watch:       []string{"**/*.go", "go.mod", "go.sum", "db/migrations/**", "../../../go-runtime/ftl/**/*.go"},
 			},
 		},
 		{
 			Config: moduleconfig.ModuleConfig{
-				Dir:       "testdata/another",
-				Language:  "go",
-				Realm:     "home",
-				Module:    "another",
-				Deploy:    []string{"main"},
-				DeployDir: "_ftl",
-				Schema:    "schema.pb",
-				Errors:    "errors.pb",
-				Watch:     []string{"**/*.go", "go.mod", "go.sum", "../../../go-runtime/ftl/**/*.go"},
+				Dir:         "testdata/another",
+				Language:    "go",
+				Realm:       "home",
+				Module:      "another",
+				Deploy:      []string{"main"},
+				DeployDir:   "_ftl",
+				Schema:      "schema.pb",
+				Errors:      "errors.pb",
+				Diagnostics: "diagnostics.json",
+				Readme:      "README.md",
+				Watch:       []string{"**/*.go", "go.mod", "go.sum", "db/migrations/**", "../../../go-runtime/ftl/**/*.go"},
 			},
 		},
 		{
 			Config: moduleconfig.ModuleConfig{
-				Dir:       "testdata/depcycle1",
-				Language:  "go",
-				Realm:     "home",
-				Module:    "depcycle1",
-				Deploy:    []string{"main"},
-				DeployDir: "_ftl",
-				Schema:    "schema.pb",
-				Errors:    "errors.pb",
-				Watch:     []string{"**/*.go", "go.mod", "go.sum"},
+				Dir:         "testdata/depcycle1",
+				Language:    "go",
+				Realm:       "home",
+				Module:      "depcycle1",
+				Deploy:      []string{"main"},
+				DeployDir:   "_ftl",
+				Schema:      "schema.pb",
+				Errors:      "errors.pb",
+				Diagnostics: "diagnostics.json",
+				Readme:      "README.md",
+				Watch:       []string{"**/*.go", "go.mod", "go.sum", "db/migrations/**"},
 			},
 		},
 		{
 			Config: moduleconfig.ModuleConfig{
-				Dir:       "testdata/depcycle2",
-				Language:  "go",
-				Realm:     "home",
-				Module:    "depcycle2",
-				Deploy:    []string{"main"},
-				DeployDir: "_ftl",
-				Schema:    "schema.pb",
-				Errors:    "errors.pb",
-				Watch:     []string{"**/*.go", "go.mod", "go.sum"},
+				Dir:         "testdata/depcycle2",
+				Language:    "go",
+				Realm:       "home",
+				Module:      "depcycle2",
+				Deploy:      []string{"main"},
+				DeployDir:   "_ftl",
+				Schema:      "schema.pb",
+				Errors:      "errors.pb",
+				Diagnostics: "diagnostics.json",
+				Readme:      "README.md",
+				Watch:       []string{"**/*.go", "go.mod", "go.sum", "db/migrations/**"},
 			},
 		},
 		{
@@ -80,9 +88,11 @@ func TestDiscoverModules(t *testing.T) {
 					"dependency",
 					"classpath.txt",
 				},
-				DeployDir: "target",
-				Schema:    "schema.pb",
-				Errors:    "errors.pb",
+				DeployDir:   "target",
+				Schema:      "schema.pb",
+				Errors:      "errors.pb",
+				Diagnostics: "diagnostics.json",
+				Readme:      "README.md",
 				Watch: []string{
 					"pom.xml",
 					"src/**",
@@ -100,13 +110,16 @@ func TestDiscoverModules(t *testing.T) {
 				Deploy: []string{
 					"main",
 				},
-				DeployDir: "_ftl",
-				Schema:    "schema.pb",
-				Errors:    "errors.pb",
+				DeployDir:   "_ftl",
+				Schema:      "schema.pb",
+				Errors:      "errors.pb",
+				Diagnostics: "diagnostics.json",
+				Readme:      "README.md",
 				Watch: []string{
 					"**/*.go",
 					"go.mod",
 					"go.sum",
+					"db/migrations/**",
 				},
 			},
 		},
@@ -123,9 +136,11 @@ func TestDiscoverModules(t *testing.T) {
 					"dependency",
 					"classpath.txt",
 				},
-				DeployDir: "target",
-				Schema:    "schema.pb",
-				Errors:    "errors.pb",
+				DeployDir:   "target",
+				Schema:      "schema.pb",
+				Errors:      "errors.pb",
+				Diagnostics: "diagnostics.json",
+				Readme:      "README.md",
 				Watch: []string{
 					"pom.xml",
 					"src/**",
@@ -135,28 +150,32 @@ func TestDiscoverModules(t *testing.T) {
 		},
 		{
 			Config: moduleconfig.ModuleConfig{
-				Dir:       "testdata/highgoversion",
-				Language:  "go",
-				Realm:     "home",
-				Module:    "highgoversion",
-				Deploy:    []string{"main"},
-				DeployDir: "_ftl",
-				Schema:    "schema.pb",
-				Errors:    "errors.pb",
-				Watch:     []string{"**/*.go", "go.mod", "go.sum", "../../../go-runtime/ftl/**/*.go"},
+				Dir:         "testdata/highgoversion",
+				Language:    "go",
+				Realm:       "home",
+				Module:      "highgoversion",
+				Deploy:      []string{"main"},
+				DeployDir:   "_ftl",
+				Schema:      "schema.pb",
+				Errors:      "errors.pb",
+				Diagnostics: "diagnostics.json",
+				Readme:      "README.md",
+				Watch:       []string{"**/*.go", "go.mod", "go.sum", "db/migrations/**", "../../../go-runtime/ftl/**/*.go"},
 			},
 		},
 		{
 			Config: moduleconfig.ModuleConfig{
-				Dir:       "testdata/other",
-				Language:  "go",
-				Realm:     "home",
-				Module:    "other",
-				Deploy:    []string{"main"},
-				DeployDir: "_ftl",
-				Schema:    "schema.pb",
-				Errors:    "errors.pb",
-				Watch:     []string{"**/*.go", "go.mod", "go.sum", "../../../go-runtime/ftl/**/*.go"},
+				Dir:         "testdata/other",
+				Language:    "go",
+				Realm:       "home",
+				Module:      "other",
+				Deploy:      []string{"main"},
+				DeployDir:   "_ftl",
+				Schema:      "schema.pb",
+				Errors:      "errors.pb",
+				Diagnostics: "diagnostics.json",
+				Readme:      "README.md",
+				Watch:       []string{"**/*.go", "go.mod", "go.sum", "db/migrations/**", "../../../go-runtime/ftl/**/*.go"},
 			},
 		},
 	}
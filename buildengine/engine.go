@@ -8,10 +8,12 @@ import (
 	"fmt"
 	"path/filepath"
 	"runtime"
+	"sort"
 	"strings"
 	"time"
 
 	"connectrpc.com/connect"
+	"github.com/alecthomas/types/optional"
 	"github.com/alecthomas/types/pubsub"
 	"github.com/jpillora/backoff"
 	"github.com/puzpuzpuz/xsync/v3"
@@ -22,6 +24,7 @@ import (
 	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
 	"github.com/TBD54566975/ftl/backend/schema"
 	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/model"
 	"github.com/TBD54566975/ftl/internal/rpc"
 )
 
@@ -46,6 +49,14 @@ type Listener interface {
 	// OnBuildFailed is called for any build failures.
 	// OnBuildSuccess should not be called if this is called after a OnBuildStarted.
 	OnBuildFailed(err error)
+
+	// OnBuildDiagnostics is called when a build of a module completes, with
+	// the errors reported by the build. "errs" is empty if the build succeeded.
+	OnBuildDiagnostics(module Module, errs []*schema.Error)
+
+	// OnDeployProgress is called as the artefacts of a module are uploaded,
+	// with the number of bytes uploaded so far and the total to upload.
+	OnDeployProgress(module Module, uploaded, total int64)
 }
 
 // Engine for building a set of modules.
@@ -55,11 +66,15 @@ type Engine struct {
 	moduleDirs       []string
 	watcher          *Watcher
 	controllerSchema *xsync.MapOf[string, *schema.Module]
+	deployments      *xsync.MapOf[string, string]
 	schemaChanges    *pubsub.Topic[schemaChange]
 	cancel           func()
 	parallelism      int
-	listener         Listener
+	listeners        []Listener
 	modulesToBuild   *xsync.MapOf[string, bool]
+	buildRequests    chan []string
+	project          string
+	target           model.Platform
 }
 
 type Option func(o *Engine)
@@ -70,10 +85,25 @@ func Parallelism(n int) Option {
 	}
 }
 
-// WithListener sets the event listener for the Engine.
+// Project sets the name of the project that modules are deployed from.
+func Project(name string) Option {
+	return func(o *Engine) {
+		o.project = name
+	}
+}
+
+// Target sets the platform that modules are built for, eg. to build for
+// linux/amd64 runners on darwin/arm64.
+func Target(platform model.Platform) Option {
+	return func(o *Engine) {
+		o.target = platform
+	}
+}
+
+// WithListener adds an event listener to the Engine.
 func WithListener(listener Listener) Option {
 	return func(o *Engine) {
-		o.listener = listener
+		o.listeners = append(o.listeners, listener)
 	}
 }
 
@@ -92,9 +122,11 @@ func New(ctx context.Context, client ftlv1connect.ControllerServiceClient, modul
 		moduleMetas:      xsync.NewMapOf[string, moduleMeta](),
 		watcher:          NewWatcher(),
 		controllerSchema: xsync.NewMapOf[string, *schema.Module](),
+		deployments:      xsync.NewMapOf[string, string](),
 		schemaChanges:    pubsub.New[schemaChange](),
 		parallelism:      runtime.NumCPU(),
 		modulesToBuild:   xsync.NewMapOf[string, bool](),
+		buildRequests:    make(chan []string, 16),
 	}
 	for _, option := range options {
 		option(e)
@@ -151,10 +183,12 @@ func (e *Engine) startSchemaSync(ctx context.Context) func(ctx context.Context,
 				return err
 			}
 			e.controllerSchema.Store(sch.Name, sch)
+			e.deployments.Store(sch.Name, msg.DeploymentKey)
 			e.schemaChanges.Publish(schemaChange{ChangeType: msg.ChangeType, Module: sch})
 
 		case ftlv1.DeploymentChangeType_DEPLOYMENT_REMOVED:
 			e.controllerSchema.Delete(msg.ModuleName)
+			e.deployments.Delete(msg.ModuleName)
 			e.schemaChanges.Publish(schemaChange{ChangeType: msg.ChangeType, Module: nil})
 		}
 		return nil
@@ -209,6 +243,57 @@ func (e *Engine) buildGraph(moduleName string, out map[string][]string) error {
 	return nil
 }
 
+// ControllerDependencies returns the schemas of all modules in the dependency
+// graph that are not available locally, and are therefore sourced from the FTL
+// controller.
+func (e *Engine) ControllerDependencies() (map[string]*schema.Module, error) {
+	graph, err := e.Graph()
+	if err != nil {
+		return nil, err
+	}
+	out := map[string]*schema.Module{}
+	for name := range graph {
+		if _, ok := e.moduleMetas.Load(name); ok || name == "builtin" {
+			continue
+		}
+		if sch, ok := e.controllerSchema.Load(name); ok {
+			out[name] = sch
+		}
+	}
+	return out, nil
+}
+
+// Schema returns the merged schema of all modules synced from the FTL
+// controller or imported with [Engine.Import].
+func (e *Engine) Schema() *schema.Schema {
+	sch := &schema.Schema{}
+	e.controllerSchema.Range(func(_ string, module *schema.Module) bool {
+		sch.Modules = append(sch.Modules, module)
+		return true
+	})
+	sort.Slice(sch.Modules, func(i, j int) bool { return sch.Modules[i].Name < sch.Modules[j].Name })
+	return sch
+}
+
+// DeploymentKey returns the key of the active deployment of a module, if
+// its schema has been synced from the FTL controller.
+func (e *Engine) DeploymentKey(module string) optional.Option[string] {
+	return optional.From(e.deployments.Load(module))
+}
+
+// QueueBuild requests that [Engine.Dev] builds and deploys the given modules
+// together, in dependency order.
+//
+// This is used for changes that span modules, such as renaming a declaration,
+// so that dependent modules are built against the updated schema.
+func (e *Engine) QueueBuild(moduleNames ...string) {
+	select {
+	case e.buildRequests <- moduleNames:
+	default:
+		// The queue is full, so builds are already pending.
+	}
+}
+
 // Import manually imports a schema for a module as if it were retrieved from
 // the FTL controller.
 func (e *Engine) Import(ctx context.Context, schema *schema.Module) {
@@ -257,7 +342,7 @@ func (e *Engine) Deploy(ctx context.Context, replicas int32, waitForDeployOnline
 				if !ok {
 					return fmt.Errorf("module %q not found", moduleName)
 				}
-				return Deploy(ctx, module.module, replicas, waitForDeployOnline, e.client)
+				return Deploy(ctx, e.project, module.module, replicas, waitForDeployOnline, e.client, e.deployProgress(module.module))
 			})
 		}
 		if err := deployGroup.Wait(); err != nil {
@@ -284,14 +369,24 @@ func (e *Engine) Dev(ctx context.Context, period time.Duration) error {
 }
 
 func (e *Engine) reportBuildFailed(err error) {
-	if e.listener != nil {
-		e.listener.OnBuildFailed(err)
+	for _, listener := range e.listeners {
+		listener.OnBuildFailed(err)
 	}
 }
 
 func (e *Engine) reportSuccess() {
-	if e.listener != nil {
-		e.listener.OnBuildSuccess()
+	for _, listener := range e.listeners {
+		listener.OnBuildSuccess()
+	}
+}
+
+// deployProgress returns an [UploadProgress] that reports the progress of
+// uploading the artefacts of "module" to the listeners.
+func (e *Engine) deployProgress(module Module) UploadProgress {
+	return func(uploaded, total int64) {
+		for _, listener := range e.listeners {
+			listener.OnDeployProgress(module, uploaded, total)
+		}
 	}
 }
 
@@ -411,6 +506,16 @@ func (e *Engine) watchForModuleChanges(ctx context.Context, period time.Duration
 					didUpdateDeployments = true
 				}
 			}
+		case moduleNames := <-e.buildRequests:
+			didError = false
+			err := e.BuildAndDeploy(ctx, 1, true, moduleNames...)
+			if err != nil {
+				didError = true
+				e.reportBuildFailed(err)
+				logger.Errorf(err, "build and deploy failed for modules %s", strings.Join(moduleNames, ", "))
+			} else {
+				didUpdateDeployments = true
+			}
 		case change := <-schemaChanges:
 			if change.ChangeType != ftlv1.DeploymentChangeType_DEPLOYMENT_CHANGED {
 				continue
@@ -484,7 +589,7 @@ func (e *Engine) BuildAndDeploy(ctx context.Context, replicas int32, waitForDepl
 		return e.buildWithCallback(ctx, func(buildCtx context.Context, module Module) error {
 			buildGroup.Go(func() error {
 				e.modulesToBuild.Store(module.Config.Module, false)
-				return Deploy(buildCtx, module, replicas, waitForDeployOnline, e.client)
+				return Deploy(buildCtx, e.project, module, replicas, waitForDeployOnline, e.client, e.deployProgress(module))
 			})
 			return nil
 		}, moduleNames...)
@@ -642,10 +747,14 @@ func (e *Engine) build(ctx context.Context, moduleName string, builtModules map[
 	}
 	sch := &schema.Schema{Modules: maps.Values(combined)}
 
-	if e.listener != nil {
-		e.listener.OnBuildStarted(meta.module)
+	for _, listener := range e.listeners {
+		listener.OnBuildStarted(meta.module)
+	}
+	err := buildModule(ctx, e.target, sch, meta.module, e.watcher.GetTransaction(meta.module.Config.Dir))
+	errs := schema.UnwrapErrors(err)
+	for _, listener := range e.listeners {
+		listener.OnBuildDiagnostics(meta.module, errs)
 	}
-	err := Build(ctx, sch, meta.module, e.watcher.GetTransaction(meta.module.Config.Dir))
 	if err != nil {
 		return err
 	}
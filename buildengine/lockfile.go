@@ -0,0 +1,127 @@
+package buildengine
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/BurntSushi/toml"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/sha256"
+)
+
+// LockfileName is the name of the lockfile, which lives alongside the project
+// configuration file.
+const LockfileName = "ftl.lock"
+
+const lockfileHeader = "# This file is generated by FTL. Do not edit it manually, use \"ftl lock update\".\n\n"
+
+// Lockfile records the schema hashes of the dependencies that were sourced
+// from the FTL controller rather than built locally.
+type Lockfile struct {
+	// Path to the lockfile.
+	Path string `toml:"-"`
+
+	Modules map[string]sha256.SHA256 `toml:"modules"`
+}
+
+// LockfileDiff describes how a set of dependencies differs from a [Lockfile].
+type LockfileDiff struct {
+	// Added dependencies that are not in the lockfile.
+	Added []string
+	// Changed dependencies whose schema differs from the lockfile.
+	Changed []string
+	// Removed dependencies that are in the lockfile but no longer used.
+	Removed []string
+}
+
+// Empty returns true if there are no differences.
+func (d LockfileDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Changed) == 0 && len(d.Removed) == 0
+}
+
+func (d LockfileDiff) String() string {
+	w := &bytes.Buffer{}
+	for _, name := range d.Added {
+		fmt.Fprintf(w, "  %s: not locked\n", name)
+	}
+	for _, name := range d.Changed {
+		fmt.Fprintf(w, "  %s: schema has changed\n", name)
+	}
+	for _, name := range d.Removed {
+		fmt.Fprintf(w, "  %s: no longer used\n", name)
+	}
+	return w.String()
+}
+
+// LoadLockfile loads a lockfile, returning an empty lockfile if it does not exist.
+func LoadLockfile(path string) (*Lockfile, error) {
+	lock := &Lockfile{Path: path, Modules: map[string]sha256.SHA256{}}
+	if _, err := toml.DecodeFile(path, lock); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return lock, nil
+}
+
+// Save the lockfile to its path.
+func (l *Lockfile) Save() error {
+	w := &bytes.Buffer{}
+	w.WriteString(lockfileHeader)
+	if err := toml.NewEncoder(w).Encode(l); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(l.Path), filepath.Base(l.Path))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) //nolint:errcheck
+	defer tmp.Close()           //nolint:errcheck
+	if _, err := tmp.Write(w.Bytes()); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), l.Path)
+}
+
+// Diff compares the schemas of the given dependencies against the lockfile.
+func (l *Lockfile) Diff(dependencies map[string]*schema.Module) LockfileDiff {
+	diff := LockfileDiff{}
+	for name, module := range dependencies {
+		locked, ok := l.Modules[name]
+		switch {
+		case !ok:
+			diff.Added = append(diff.Added, name)
+		case locked != schemaHash(module):
+			diff.Changed = append(diff.Changed, name)
+		}
+	}
+	for name := range l.Modules {
+		if _, ok := dependencies[name]; !ok {
+			diff.Removed = append(diff.Removed, name)
+		}
+	}
+	sort.Strings(diff.Added)
+	sort.Strings(diff.Changed)
+	sort.Strings(diff.Removed)
+	return diff
+}
+
+// Lock records the schemas of the given dependencies, leaving other entries untouched.
+func (l *Lockfile) Lock(dependencies map[string]*schema.Module) {
+	for name, module := range dependencies {
+		l.Modules[name] = schemaHash(module)
+	}
+}
+
+// Update replaces the contents of the lockfile with the given dependencies.
+func (l *Lockfile) Update(dependencies map[string]*schema.Module) {
+	l.Modules = map[string]sha256.SHA256{}
+	l.Lock(dependencies)
+}
+
+func schemaHash(module *schema.Module) sha256.SHA256 {
+	return sha256.Sum([]byte(module.String()))
+}
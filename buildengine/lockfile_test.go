@@ -0,0 +1,63 @@
+package buildengine_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/buildengine"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+func TestControllerDependencies(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	engine, err := buildengine.New(ctx, nil, []string{"testdata/alpha", "testdata/another"})
+	assert.NoError(t, err)
+	defer engine.Close()
+
+	other := &schema.Module{Name: "other", Decls: []schema.Decl{
+		&schema.Data{Name: "EchoRequest"},
+		&schema.Data{Name: "EchoResponse"},
+	}}
+	engine.Import(ctx, other)
+
+	deps, err := engine.ControllerDependencies()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]*schema.Module{"other": other}, deps)
+}
+
+func TestLockfile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), buildengine.LockfileName)
+	lock, err := buildengine.LoadLockfile(path)
+	assert.NoError(t, err)
+
+	echo := &schema.Module{Name: "echo", Decls: []schema.Decl{&schema.Data{Name: "Echo"}}}
+	time := &schema.Module{Name: "time", Decls: []schema.Decl{&schema.Data{Name: "Time"}}}
+	deps := map[string]*schema.Module{"echo": echo, "time": time}
+
+	assert.Equal(t, buildengine.LockfileDiff{Added: []string{"echo", "time"}}, lock.Diff(deps))
+	lock.Lock(deps)
+	assert.True(t, lock.Diff(deps).Empty())
+	assert.NoError(t, lock.Save())
+
+	content, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(content), "# This file is generated"))
+
+	lock, err = buildengine.LoadLockfile(path)
+	assert.NoError(t, err)
+	assert.True(t, lock.Diff(deps).Empty())
+
+	changed := &schema.Module{Name: "echo", Decls: []schema.Decl{&schema.Data{Name: "Echo2"}}}
+	diff := lock.Diff(map[string]*schema.Module{"echo": changed})
+	assert.Equal(t, buildengine.LockfileDiff{Changed: []string{"echo"}, Removed: []string{"time"}}, diff)
+
+	lock.Update(map[string]*schema.Module{"echo": changed})
+	assert.Equal(t, 1, len(lock.Modules))
+	assert.True(t, lock.Diff(map[string]*schema.Module{"echo": changed}).Empty())
+}
@@ -0,0 +1,356 @@
+package buildengine
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"sort"
+
+	"connectrpc.com/connect"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/slices"
+)
+
+// ManifestClient is the subset of the controller API used to export and apply
+// a [Manifest].
+type ManifestClient interface {
+	DeployClient
+	GetDeployment(ctx context.Context, req *connect.Request[ftlv1.GetDeploymentRequest]) (*connect.Response[ftlv1.GetDeploymentResponse], error)
+	UpdateDeploy(ctx context.Context, req *connect.Request[ftlv1.UpdateDeployRequest]) (*connect.Response[ftlv1.UpdateDeployResponse], error)
+}
+
+// Manifest declares the desired deployments of a cluster.
+//
+// A Manifest is exported from a cluster with [ExportManifest], typically kept
+// under version control, and applied to a cluster with [ApplyManifest].
+type Manifest struct {
+	Deployments []*ManifestDeployment `yaml:"deployments"`
+}
+
+// ManifestDeployment is the desired deployment of a single module.
+type ManifestDeployment struct {
+	Module    string             `yaml:"module"`
+	Replicas  int32              `yaml:"replicas"`
+	Artefacts []ManifestArtefact `yaml:"artefacts"`
+	// Config and Secrets are the names of the configuration values and
+	// secrets the module requires.
+	Config  []string `yaml:"config,omitempty"`
+	Secrets []string `yaml:"secrets,omitempty"`
+	// Schema is the base64 encoded protobuf schema of the module.
+	Schema string `yaml:"schema"`
+}
+
+// ManifestArtefact is an artefact of a deployment, identified by its digest.
+type ManifestArtefact struct {
+	Path       string `yaml:"path"`
+	Digest     string `yaml:"digest"`
+	Executable bool   `yaml:"executable,omitempty"`
+}
+
+// LoadManifest loads a [Manifest] from a YAML file.
+func LoadManifest(path string) (*Manifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	manifest := &Manifest{}
+	if err := yaml.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf("%s: invalid manifest: %w", path, err)
+	}
+	seen := map[string]bool{}
+	for _, deployment := range manifest.Deployments {
+		if seen[deployment.Module] {
+			return nil, fmt.Errorf("%s: module %q is declared more than once", path, deployment.Module)
+		}
+		seen[deployment.Module] = true
+		if _, err := deployment.moduleSchema(); err != nil {
+			return nil, fmt.Errorf("%s: %s: %w", path, deployment.Module, err)
+		}
+	}
+	return manifest, nil
+}
+
+// Marshal the Manifest to YAML.
+func (m *Manifest) Marshal() ([]byte, error) {
+	return yaml.Marshal(m)
+}
+
+func (d *ManifestDeployment) moduleSchema() (*schemapb.Module, error) {
+	data, err := base64.StdEncoding.DecodeString(d.Schema)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schema: %w", err)
+	}
+	module := &schemapb.Module{}
+	if err := proto.Unmarshal(data, module); err != nil {
+		return nil, fmt.Errorf("invalid schema: %w", err)
+	}
+	if module.Name != d.Module {
+		return nil, fmt.Errorf("schema is for module %q", module.Name)
+	}
+	return module, nil
+}
+
+// ExportManifest returns a [Manifest] of the active deployments of the cluster.
+func ExportManifest(ctx context.Context, client ManifestClient) (*Manifest, error) {
+	status, err := client.Status(ctx, connect.NewRequest(&ftlv1.StatusRequest{}))
+	if err != nil {
+		return nil, err
+	}
+	manifest := &Manifest{}
+	for _, deployment := range status.Msg.Deployments {
+		resp, err := client.GetDeployment(ctx, connect.NewRequest(&ftlv1.GetDeploymentRequest{DeploymentKey: deployment.Key}))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", deployment.Name, err)
+		}
+		// The creation time and replicas of the deployment are not part of
+		// its desired state.
+		moduleSchema := proto.Clone(resp.Msg.Schema).(*schemapb.Module) //nolint:forcetypeassert
+		if moduleSchema.Runtime != nil {
+			moduleSchema.Runtime.CreateTime = nil
+			moduleSchema.Runtime.MinReplicas = 0
+		}
+		encoded, err := proto.MarshalOptions{Deterministic: true}.Marshal(moduleSchema)
+		if err != nil {
+			return nil, err
+		}
+		module, err := schema.ModuleFromProto(moduleSchema)
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid schema: %w", deployment.Name, err)
+		}
+		out := &ManifestDeployment{
+			Module:    deployment.Name,
+			Replicas:  deployment.MinReplicas,
+			Artefacts: manifestArtefacts(resp.Msg.Artefacts),
+			Schema:    base64.StdEncoding.EncodeToString(encoded),
+		}
+		for _, decl := range module.Decls {
+			switch decl := decl.(type) {
+			case *schema.Config:
+				out.Config = append(out.Config, decl.Name)
+			case *schema.Secret:
+				out.Secrets = append(out.Secrets, decl.Name)
+			}
+		}
+		sort.Strings(out.Config)
+		sort.Strings(out.Secrets)
+		manifest.Deployments = append(manifest.Deployments, out)
+	}
+	sort.Slice(manifest.Deployments, func(i, j int) bool { return manifest.Deployments[i].Module < manifest.Deployments[j].Module })
+	return manifest, nil
+}
+
+func manifestArtefacts(artefacts []*ftlv1.DeploymentArtefact) []ManifestArtefact {
+	out := slices.Map(artefacts, func(a *ftlv1.DeploymentArtefact) ManifestArtefact {
+		return ManifestArtefact{Path: a.Path, Digest: a.Digest, Executable: a.Executable}
+	})
+	sort.Slice(out, func(i, j int) bool { return out[i].Path < out[j].Path })
+	return out
+}
+
+// ManifestAction is the change [ApplyManifest] makes to a module.
+type ManifestAction string
+
+const (
+	// ManifestDeploy creates a new deployment of the module, replacing any
+	// existing deployment.
+	ManifestDeploy ManifestAction = "deploy"
+	// ManifestScale changes the replicas of the existing deployment.
+	ManifestScale ManifestAction = "scale"
+	// ManifestRemove scales down a deployment not declared in the manifest.
+	ManifestRemove ManifestAction = "remove"
+)
+
+// ManifestChange is a change to a module made by [ApplyManifest].
+type ManifestChange struct {
+	Module string
+	Action ManifestAction
+	// Deployment is the key of the existing deployment, if any.
+	Deployment       string
+	PreviousReplicas int32
+	Replicas         int32
+
+	manifest *ManifestDeployment
+}
+
+func (c ManifestChange) String() string {
+	switch c.Action {
+	case ManifestDeploy:
+		if c.Deployment == "" {
+			return fmt.Sprintf("%s: deploy with %d replicas", c.Module, c.Replicas)
+		}
+		return fmt.Sprintf("%s: deploy with %d replicas, replacing %s", c.Module, c.Replicas, c.Deployment)
+	case ManifestScale:
+		return fmt.Sprintf("%s: scale %s from %d to %d replicas", c.Module, c.Deployment, c.PreviousReplicas, c.Replicas)
+	case ManifestRemove:
+		return fmt.Sprintf("%s: remove %s", c.Module, c.Deployment)
+	default:
+		panic(fmt.Sprintf("unknown manifest action %q", c.Action))
+	}
+}
+
+// ManifestOptions control how [ApplyManifest] reconciles a cluster.
+type ManifestOptions struct {
+	// Remove active deployments of modules not declared in the manifest.
+	Prune bool
+	// Compute the changes without making them.
+	DryRun bool
+	// Wait for deployments to become ready.
+	Wait bool
+}
+
+// ApplyManifest reconciles the deployments of the cluster to a [Manifest],
+// returning the changes made.
+//
+// Modules whose active deployment has the artefacts and schema declared in
+// the manifest are only scaled, if necessary. Other modules are deployed from
+// the manifest, which requires their artefacts to have already been uploaded
+// to the cluster, eg. by "ftl deploy" in CI.
+func ApplyManifest(ctx context.Context, manifest *Manifest, options ManifestOptions, client ManifestClient) ([]ManifestChange, error) {
+	changes, err := planManifest(ctx, manifest, options.Prune, client)
+	if err != nil {
+		return nil, err
+	}
+	if options.DryRun || len(changes) == 0 {
+		return changes, nil
+	}
+	wg, ctx := errgroup.WithContext(ctx)
+	for _, change := range changes {
+		wg.Go(func() error {
+			if err := applyManifestChange(ctx, change, options.Wait, client); err != nil {
+				return fmt.Errorf("%s: %w", change.Module, err)
+			}
+			return nil
+		})
+	}
+	if err := wg.Wait(); err != nil {
+		return nil, fmt.Errorf("failed to apply manifest: %w", err)
+	}
+	return changes, nil
+}
+
+func planManifest(ctx context.Context, manifest *Manifest, prune bool, client ManifestClient) ([]ManifestChange, error) {
+	status, err := client.Status(ctx, connect.NewRequest(&ftlv1.StatusRequest{}))
+	if err != nil {
+		return nil, err
+	}
+	changes := []ManifestChange{}
+	declared := map[string]bool{}
+	for _, desired := range manifest.Deployments {
+		declared[desired.Module] = true
+		change := ManifestChange{Module: desired.Module, Action: ManifestDeploy, Replicas: desired.Replicas, manifest: desired}
+		if current, ok := findDeployment(status.Msg, desired.Module); ok {
+			change.Deployment = current.Key
+			change.PreviousReplicas = current.MinReplicas
+			same, err := sameDeployment(ctx, desired, current, client)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", desired.Module, err)
+			}
+			if same {
+				if current.MinReplicas == desired.Replicas {
+					continue
+				}
+				change.Action = ManifestScale
+			}
+		}
+		if change.Action == ManifestDeploy {
+			missing, err := client.GetArtefactDiffs(ctx, connect.NewRequest(&ftlv1.GetArtefactDiffsRequest{
+				ClientDigests: slices.Map(desired.Artefacts, func(a ManifestArtefact) string { return a.Digest }),
+			}))
+			if err != nil {
+				return nil, err
+			}
+			if len(missing.Msg.MissingDigests) > 0 {
+				return nil, fmt.Errorf("%s: the cluster is missing artefacts %v, which must be uploaded before the manifest is applied", desired.Module, missing.Msg.MissingDigests)
+			}
+		}
+		changes = append(changes, change)
+	}
+	if prune {
+		for _, current := range status.Msg.Deployments {
+			if !declared[current.Name] {
+				changes = append(changes, ManifestChange{Module: current.Name, Action: ManifestRemove, Deployment: current.Key, PreviousReplicas: current.MinReplicas})
+			}
+		}
+	}
+	sort.SliceStable(changes, func(i, j int) bool { return changes[i].Module < changes[j].Module })
+	return changes, nil
+}
+
+// sameDeployment returns true if "current" has the artefacts and schema of "desired".
+func sameDeployment(ctx context.Context, desired *ManifestDeployment, current *ftlv1.StatusResponse_Deployment, client ManifestClient) (bool, error) {
+	resp, err := client.GetDeployment(ctx, connect.NewRequest(&ftlv1.GetDeploymentRequest{DeploymentKey: current.Key}))
+	if err != nil {
+		return false, err
+	}
+	artefacts := manifestArtefacts(resp.Msg.Artefacts)
+	desiredArtefacts := append([]ManifestArtefact{}, desired.Artefacts...)
+	sort.Slice(desiredArtefacts, func(i, j int) bool { return desiredArtefacts[i].Path < desiredArtefacts[j].Path })
+	if len(artefacts) != len(desiredArtefacts) {
+		return false, nil
+	}
+	for i := range artefacts {
+		if artefacts[i] != desiredArtefacts[i] {
+			return false, nil
+		}
+	}
+	desiredSchema, err := desired.moduleSchema()
+	if err != nil {
+		return false, err
+	}
+	diff, err := schemaDiff(desired.Module, resp.Msg.Schema, desiredSchema)
+	if err != nil {
+		return false, err
+	}
+	return diff == "", nil
+}
+
+func applyManifestChange(ctx context.Context, change ManifestChange, wait bool, client ManifestClient) error {
+	logger := log.FromContext(ctx).Scope(change.Module)
+	ctx = log.ContextWithLogger(ctx, logger)
+	logger.Infof("Applying manifest: %s", change)
+	switch change.Action {
+	case ManifestScale, ManifestRemove:
+		_, err := client.UpdateDeploy(ctx, connect.NewRequest(&ftlv1.UpdateDeployRequest{DeploymentKey: change.Deployment, MinReplicas: change.Replicas}))
+		if err != nil {
+			return err
+		}
+		if wait && change.Action == ManifestScale {
+			return checkReadiness(ctx, client, change.Deployment, change.Replicas)
+		}
+		return nil
+
+	case ManifestDeploy:
+		moduleSchema, err := change.manifest.moduleSchema()
+		if err != nil {
+			return err
+		}
+		resp, err := client.CreateDeployment(ctx, connect.NewRequest(&ftlv1.CreateDeploymentRequest{
+			Schema: moduleSchema,
+			Artefacts: slices.Map(change.manifest.Artefacts, func(a ManifestArtefact) *ftlv1.DeploymentArtefact {
+				return &ftlv1.DeploymentArtefact{Digest: a.Digest, Path: a.Path, Executable: a.Executable}
+			}),
+		}))
+		if err != nil {
+			return err
+		}
+		_, err = client.ReplaceDeploy(ctx, connect.NewRequest(&ftlv1.ReplaceDeployRequest{DeploymentKey: resp.Msg.DeploymentKey, MinReplicas: change.Replicas}))
+		if err != nil {
+			return err
+		}
+		if wait {
+			return checkReadiness(ctx, client, resp.Msg.DeploymentKey, change.Replicas)
+		}
+		return nil
+
+	default:
+		panic(fmt.Sprintf("unknown manifest action %q", change.Action))
+	}
+}
@@ -0,0 +1,168 @@
+package buildengine
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/assert/v2"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+type mockManifestDeployment struct {
+	key       string
+	replicas  int32
+	schema    *schemapb.Module
+	artefacts []*ftlv1.DeploymentArtefact
+}
+
+type mockManifestClient struct {
+	mockDeployClient
+	lock        sync.Mutex
+	deployments map[string]*mockManifestDeployment
+	created     []string
+}
+
+func (m *mockManifestClient) Status(context.Context, *connect.Request[ftlv1.StatusRequest]) (*connect.Response[ftlv1.StatusResponse], error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	resp := &ftlv1.StatusResponse{}
+	for name, deployment := range m.deployments {
+		resp.Deployments = append(resp.Deployments, &ftlv1.StatusResponse_Deployment{
+			Key:         deployment.key,
+			Name:        name,
+			MinReplicas: deployment.replicas,
+			Replicas:    deployment.replicas,
+		})
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (m *mockManifestClient) GetDeployment(_ context.Context, req *connect.Request[ftlv1.GetDeploymentRequest]) (*connect.Response[ftlv1.GetDeploymentResponse], error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for _, deployment := range m.deployments {
+		if deployment.key == req.Msg.DeploymentKey {
+			return connect.NewResponse(&ftlv1.GetDeploymentResponse{Schema: deployment.schema, Artefacts: deployment.artefacts}), nil
+		}
+	}
+	return nil, connect.NewError(connect.CodeNotFound, nil)
+}
+
+func (m *mockManifestClient) CreateDeployment(_ context.Context, req *connect.Request[ftlv1.CreateDeploymentRequest]) (*connect.Response[ftlv1.CreateDeploymentResponse], error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	key := "dpl-" + req.Msg.Schema.Name + "-new"
+	m.created = append(m.created, key)
+	m.deployments[req.Msg.Schema.Name] = &mockManifestDeployment{key: key, schema: req.Msg.Schema, artefacts: req.Msg.Artefacts}
+	return connect.NewResponse(&ftlv1.CreateDeploymentResponse{DeploymentKey: key}), nil
+}
+
+func (m *mockManifestClient) ReplaceDeploy(_ context.Context, req *connect.Request[ftlv1.ReplaceDeployRequest]) (*connect.Response[ftlv1.ReplaceDeployResponse], error) {
+	return nil, m.scale(req.Msg.DeploymentKey, req.Msg.MinReplicas)
+}
+
+func (m *mockManifestClient) UpdateDeploy(_ context.Context, req *connect.Request[ftlv1.UpdateDeployRequest]) (*connect.Response[ftlv1.UpdateDeployResponse], error) {
+	return nil, m.scale(req.Msg.DeploymentKey, req.Msg.MinReplicas)
+}
+
+func (m *mockManifestClient) scale(key string, replicas int32) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for name, deployment := range m.deployments {
+		if deployment.key == key {
+			if replicas == 0 {
+				delete(m.deployments, name)
+			} else {
+				deployment.replicas = replicas
+			}
+			return nil
+		}
+	}
+	return connect.NewError(connect.CodeNotFound, nil)
+}
+
+func TestExportAndApplyManifest(t *testing.T) {
+	ctx := log.ContextWithLogger(context.Background(), log.Configure(os.Stderr, log.Config{}))
+	echo := &schema.Module{Name: "echo", Decls: []schema.Decl{
+		&schema.Config{Name: "greeting", Type: &schema.String{}},
+		&schema.Secret{Name: "apiKey", Type: &schema.String{}},
+		&schema.Data{Name: "EchoRequest"},
+	}}
+	time := &schema.Module{Name: "time", Decls: []schema.Decl{
+		&schema.Data{Name: "TimeRequest"},
+	}}
+	artefacts := []*ftlv1.DeploymentArtefact{{Path: "main", Digest: "abc", Executable: true}}
+	client := &mockManifestClient{deployments: map[string]*mockManifestDeployment{
+		"echo": {key: "dpl-echo", replicas: 2, schema: echo.ToProto().(*schemapb.Module), artefacts: artefacts}, //nolint:forcetypeassert
+		"time": {key: "dpl-time", replicas: 1, schema: time.ToProto().(*schemapb.Module), artefacts: artefacts}, //nolint:forcetypeassert
+	}}
+
+	manifest, err := ExportManifest(ctx, client)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(manifest.Deployments))
+	assert.Equal(t, "echo", manifest.Deployments[0].Module)
+	assert.Equal(t, int32(2), manifest.Deployments[0].Replicas)
+	assert.Equal(t, []string{"greeting"}, manifest.Deployments[0].Config)
+	assert.Equal(t, []string{"apiKey"}, manifest.Deployments[0].Secrets)
+	assert.Equal(t, []ManifestArtefact{{Path: "main", Digest: "abc", Executable: true}}, manifest.Deployments[0].Artefacts)
+
+	data, err := manifest.Marshal()
+	assert.NoError(t, err)
+	manifestFile := filepath.Join(t.TempDir(), "manifest.yaml")
+	assert.NoError(t, os.WriteFile(manifestFile, data, 0600))
+	manifest, err = LoadManifest(manifestFile)
+	assert.NoError(t, err)
+
+	// Applying the exported manifest is a no-op.
+	changes, err := ApplyManifest(ctx, manifest, ManifestOptions{}, client)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(changes))
+
+	// Changing replicas scales the existing deployment.
+	manifest.Deployments[1].Replicas = 3
+	changes, err = ApplyManifest(ctx, manifest, ManifestOptions{DryRun: true}, client)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"time: scale dpl-time from 1 to 3 replicas"}, changeStrings(changes))
+	assert.Equal(t, int32(1), client.deployments["time"].replicas)
+	_, err = ApplyManifest(ctx, manifest, ManifestOptions{}, client)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), client.deployments["time"].replicas)
+	assert.Equal(t, 0, len(client.created))
+
+	// Changing artefacts creates a new deployment, and pruning removes
+	// undeclared modules.
+	manifest.Deployments[0].Artefacts[0].Digest = "def"
+	manifest.Deployments = manifest.Deployments[:1]
+	changes, err = ApplyManifest(ctx, manifest, ManifestOptions{Prune: true}, client)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"echo: deploy with 2 replicas, replacing dpl-echo",
+		"time: remove dpl-time",
+	}, changeStrings(changes))
+	assert.Equal(t, []string{"dpl-echo-new"}, client.created)
+	assert.Equal(t, "def", client.deployments["echo"].artefacts[0].Digest)
+	_, ok := client.deployments["time"]
+	assert.False(t, ok)
+
+	// Artefacts must have been uploaded before they can be deployed.
+	client.MissingDigests = []string{"ghi"}
+	manifest.Deployments[0].Artefacts[0].Digest = "ghi"
+	_, err = ApplyManifest(ctx, manifest, ManifestOptions{}, client)
+	assert.EqualError(t, err, "echo: the cluster is missing artefacts [ghi], which must be uploaded before the manifest is applied")
+}
+
+func changeStrings(changes []ManifestChange) []string {
+	out := make([]string, 0, len(changes))
+	for _, change := range changes {
+		out = append(out, change.String())
+	}
+	return out
+}
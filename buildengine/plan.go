@@ -0,0 +1,337 @@
+package buildengine
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/hexops/gotextdiff"
+	"github.com/hexops/gotextdiff/myers"
+	"github.com/hexops/gotextdiff/span"
+	"golang.org/x/exp/maps"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/sha256"
+	"github.com/TBD54566975/ftl/internal/slices"
+)
+
+// Plan is a reviewable description of the changes a deployment will make.
+//
+// A Plan is created with [Engine.Plan], can be saved and reviewed, and is
+// later executed with [ApplyPlan].
+type Plan struct {
+	Modules []*ModulePlan `json:"modules"`
+	// Groups are the names of the modules in topological order. Each group is
+	// only deployed once the modules of the groups before it are deployed.
+	Groups [][]string `json:"groups"`
+}
+
+// ModulePlan describes the planned deployment of a single module.
+type ModulePlan struct {
+	Module string `json:"module"`
+	// Replaces is the key of the deployment that will be replaced, if any.
+	Replaces         string `json:"replaces,omitempty"`
+	PreviousReplicas int32  `json:"previousReplicas,omitempty"`
+	Replicas         int32  `json:"replicas"`
+	// SchemaDiff is a unified diff between the deployed and planned schemas.
+	SchemaDiff string `json:"schemaDiff,omitempty"`
+	// Schema is the protobuf encoded schema to deploy.
+	Schema         []byte            `json:"schema"`
+	Artefacts      []PlannedArtefact `json:"artefacts"`
+	MissingDigests []string          `json:"missingDigests,omitempty"`
+}
+
+// PlannedArtefact is an artefact that will be included in a planned deployment.
+type PlannedArtefact struct {
+	Digest     string `json:"digest"`
+	Path       string `json:"path"`
+	Executable bool   `json:"executable,omitempty"`
+	LocalPath  string `json:"localPath"`
+}
+
+// IsNew returns true if the module has no existing deployment.
+func (m *ModulePlan) IsNew() bool { return m.Replaces == "" }
+
+// LoadPlan loads a previously saved [Plan] from a file.
+func LoadPlan(path string) (*Plan, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	plan := &Plan{}
+	if err := json.Unmarshal(data, plan); err != nil {
+		return nil, fmt.Errorf("%s: invalid deployment plan: %w", path, err)
+	}
+	return plan, nil
+}
+
+// Save the Plan to a file.
+func (p *Plan) Save(path string) error {
+	data, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0600)
+}
+
+// Plan builds all local modules and computes the changes that deploying them would make.
+func (e *Engine) Plan(ctx context.Context, replicas int32) (*Plan, error) {
+	if err := e.Build(ctx); err != nil {
+		return nil, err
+	}
+	graph, err := e.Graph(e.Modules()...)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := TopologicalSort(graph)
+	if err != nil {
+		return nil, fmt.Errorf("topological sort failed: %w", err)
+	}
+	plan := &Plan{}
+	for _, group := range groups {
+		sort.Strings(group)
+		var planned []string
+		for _, moduleName := range group {
+			meta, ok := e.moduleMetas.Load(moduleName)
+			if !ok {
+				continue
+			}
+			modulePlan, err := PlanDeploy(ctx, e.project, meta.module, replicas, e.client)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", moduleName, err)
+			}
+			plan.Modules = append(plan.Modules, modulePlan)
+			planned = append(planned, moduleName)
+		}
+		if len(planned) > 0 {
+			plan.Groups = append(plan.Groups, planned)
+		}
+	}
+	return plan, nil
+}
+
+// PlanDeploy computes the changes that deploying an (already compiled) module of "project" would make.
+func PlanDeploy(ctx context.Context, project string, module Module, replicas int32, client DeployClient) (*ModulePlan, error) {
+	moduleConfig := module.Config.Abs()
+	files, err := FindFilesToDeploy(moduleConfig)
+	if err != nil {
+		return nil, err
+	}
+	filesByHash, err := hashFiles(moduleConfig.DeployDir, files)
+	if err != nil {
+		return nil, err
+	}
+	moduleSchema, err := loadProtoSchema(moduleConfig, project, replicas)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load protobuf schema from %q: %w", module.Config.Schema, err)
+	}
+	gadResp, err := client.GetArtefactDiffs(ctx, connect.NewRequest(&ftlv1.GetArtefactDiffsRequest{ClientDigests: maps.Keys(filesByHash)}))
+	if err != nil {
+		return nil, err
+	}
+	status, err := client.Status(ctx, connect.NewRequest(&ftlv1.StatusRequest{}))
+	if err != nil {
+		return nil, err
+	}
+
+	encodedSchema, err := proto.Marshal(moduleSchema)
+	if err != nil {
+		return nil, err
+	}
+	artefacts := slices.Map(maps.Values(filesByHash), func(a deploymentArtefact) PlannedArtefact {
+		return PlannedArtefact{Digest: a.Digest, Path: a.Path, Executable: a.Executable, LocalPath: a.localPath}
+	})
+	sort.Slice(artefacts, func(i, j int) bool { return artefacts[i].Path < artefacts[j].Path })
+	missing := gadResp.Msg.MissingDigests
+	sort.Strings(missing)
+	out := &ModulePlan{
+		Module:         module.Config.Module,
+		Replicas:       replicas,
+		Schema:         encodedSchema,
+		Artefacts:      artefacts,
+		MissingDigests: missing,
+	}
+
+	var existing *schemapb.Module
+	if deployment, ok := findDeployment(status.Msg, module.Config.Module); ok {
+		out.Replaces = deployment.Key
+		out.PreviousReplicas = deployment.MinReplicas
+		existing = deployment.Schema
+	}
+	out.SchemaDiff, err = schemaDiff(module.Config.Module, existing, moduleSchema)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ApplyPlan executes a previously created [Plan].
+//
+// The plan is rejected if the deployed state or any local artefacts have
+// changed since it was created.
+//
+// Modules are deployed group by group, so that modules are only deployed once
+// the modules they depend on are.
+//
+// If waitForDeployOnline is true, this function will block until all deployments are online.
+func ApplyPlan(ctx context.Context, plan *Plan, waitForDeployOnline bool, client DeployClient) error {
+	groups, err := plan.groups()
+	if err != nil {
+		return err
+	}
+	status, err := client.Status(ctx, connect.NewRequest(&ftlv1.StatusRequest{}))
+	if err != nil {
+		return err
+	}
+	for _, module := range plan.Modules {
+		current := ""
+		if deployment, ok := findDeployment(status.Msg, module.Module); ok {
+			current = deployment.Key
+		}
+		if current != module.Replaces {
+			return fmt.Errorf("%s: plan is stale: deployment changed from %q to %q since the plan was created", module.Module, module.Replaces, current)
+		}
+		for _, artefact := range module.Artefacts {
+			sum, err := sha256.SumFile(artefact.LocalPath)
+			if err != nil {
+				return fmt.Errorf("%s: %w", module.Module, err)
+			}
+			if sum.String() != artefact.Digest {
+				return fmt.Errorf("%s: plan is stale: %s has changed since the plan was created", module.Module, relToCWD(artefact.LocalPath))
+			}
+		}
+	}
+
+	for _, group := range groups {
+		deployGroup, ctx := errgroup.WithContext(ctx)
+		for _, module := range group {
+			deployGroup.Go(func() error {
+				return applyModulePlan(ctx, module, waitForDeployOnline, client)
+			})
+		}
+		if err := deployGroup.Wait(); err != nil {
+			return fmt.Errorf("deploy failed: %w", err)
+		}
+	}
+	log.FromContext(ctx).Infof("All modules deployed")
+	return nil
+}
+
+// groups returns the module plans in the order they must be deployed.
+//
+// Plans without groups deploy one module at a time, in order.
+func (p *Plan) groups() ([][]*ModulePlan, error) {
+	if len(p.Groups) == 0 {
+		return slices.Map(p.Modules, func(m *ModulePlan) []*ModulePlan { return []*ModulePlan{m} }), nil
+	}
+	modules := map[string]*ModulePlan{}
+	for _, module := range p.Modules {
+		modules[module.Module] = module
+	}
+	groups := make([][]*ModulePlan, 0, len(p.Groups))
+	for _, group := range p.Groups {
+		var modulePlans []*ModulePlan
+		for _, name := range group {
+			module, ok := modules[name]
+			if !ok {
+				return nil, fmt.Errorf("invalid deployment plan: unknown module %q", name)
+			}
+			delete(modules, name)
+			modulePlans = append(modulePlans, module)
+		}
+		groups = append(groups, modulePlans)
+	}
+	if len(modules) > 0 {
+		names := maps.Keys(modules)
+		sort.Strings(names)
+		return nil, fmt.Errorf("invalid deployment plan: modules %s are not in any group", strings.Join(names, ", "))
+	}
+	return groups, nil
+}
+
+func applyModulePlan(ctx context.Context, plan *ModulePlan, waitForDeployOnline bool, client DeployClient) error {
+	logger := log.FromContext(ctx).Scope(plan.Module)
+	ctx = log.ContextWithLogger(ctx, logger)
+	logger.Infof("Deploying module")
+
+	moduleSchema := &schemapb.Module{}
+	if err := proto.Unmarshal(plan.Schema, moduleSchema); err != nil {
+		return fmt.Errorf("invalid schema in plan: %w", err)
+	}
+
+	gadResp, err := client.GetArtefactDiffs(ctx, connect.NewRequest(&ftlv1.GetArtefactDiffsRequest{
+		ClientDigests: slices.Map(plan.Artefacts, func(a PlannedArtefact) string { return a.Digest }),
+	}))
+	if err != nil {
+		return err
+	}
+	localPaths := map[string]string{}
+	for _, artefact := range plan.Artefacts {
+		localPaths[artefact.Digest] = artefact.LocalPath
+	}
+	logger.Debugf("Uploading %d/%d files", len(gadResp.Msg.MissingDigests), len(plan.Artefacts))
+	missing := slices.Map(gadResp.Msg.MissingDigests, func(digest string) string { return localPaths[digest] })
+	if err := uploadArtefacts(ctx, client, missing, nil); err != nil {
+		return err
+	}
+
+	resp, err := client.CreateDeployment(ctx, connect.NewRequest(&ftlv1.CreateDeploymentRequest{
+		Schema: moduleSchema,
+		Artefacts: slices.Map(plan.Artefacts, func(a PlannedArtefact) *ftlv1.DeploymentArtefact {
+			return &ftlv1.DeploymentArtefact{Digest: a.Digest, Path: a.Path, Executable: a.Executable}
+		}),
+	}))
+	if err != nil {
+		return err
+	}
+
+	_, err = client.ReplaceDeploy(ctx, connect.NewRequest(&ftlv1.ReplaceDeployRequest{DeploymentKey: resp.Msg.GetDeploymentKey(), MinReplicas: plan.Replicas}))
+	if err != nil {
+		return err
+	}
+
+	if waitForDeployOnline {
+		logger.Debugf("Waiting for deployment %s to become ready", resp.Msg.DeploymentKey)
+		return checkReadiness(ctx, client, resp.Msg.DeploymentKey, plan.Replicas)
+	}
+	return nil
+}
+
+func findDeployment(status *ftlv1.StatusResponse, module string) (*ftlv1.StatusResponse_Deployment, bool) {
+	for _, deployment := range status.Deployments {
+		if deployment.Name == module {
+			return deployment, true
+		}
+	}
+	return nil, false
+}
+
+func schemaDiff(module string, from, to *schemapb.Module) (string, error) {
+	before := ""
+	if from != nil {
+		sch, err := schema.ModuleFromProto(from)
+		if err != nil {
+			return "", fmt.Errorf("invalid deployed schema: %w", err)
+		}
+		before = sch.String() + "\n"
+	}
+	sch, err := schema.ModuleFromProto(to)
+	if err != nil {
+		return "", fmt.Errorf("invalid schema: %w", err)
+	}
+	after := sch.String() + "\n"
+	if before == after {
+		return "", nil
+	}
+	edits := myers.ComputeEdits(span.URIFromPath(module), before, after)
+	return fmt.Sprint(gotextdiff.ToUnified("deployed/"+module, "planned/"+module, before, edits)), nil
+}
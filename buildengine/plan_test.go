@@ -0,0 +1,105 @@
+package buildengine
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/assert/v2"
+	"google.golang.org/protobuf/proto"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/common/moduleconfig"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+func TestPlanAndApply(t *testing.T) {
+	ctx := log.ContextWithLogger(context.Background(), log.Configure(os.Stderr, log.Config{}))
+	dir := t.TempDir()
+	deployDir := filepath.Join(dir, "_ftl")
+	assert.NoError(t, os.MkdirAll(deployDir, 0700))
+	assert.NoError(t, os.WriteFile(filepath.Join(deployDir, "main"), []byte("binary"), 0700)) //nolint:gosec
+	module := &schema.Module{Name: "echo", Decls: []schema.Decl{
+		&schema.Data{Name: "EchoRequest"},
+	}}
+	data, err := proto.Marshal(module.ToProto())
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(filepath.Join(deployDir, "schema.pb"), data, 0600))
+
+	m := Module{Config: moduleconfig.ModuleConfig{
+		Dir:       dir,
+		Language:  "go",
+		Module:    "echo",
+		Deploy:    []string{"main"},
+		DeployDir: "_ftl",
+		Schema:    "schema.pb",
+	}}
+	client := &mockDeployClient{DeploymentKey: "test-deployment"}
+	modulePlan, err := PlanDeploy(ctx, "", m, 2, client)
+	assert.NoError(t, err)
+	assert.True(t, modulePlan.IsNew())
+	assert.Equal(t, 1, len(modulePlan.Artefacts))
+	assert.Contains(t, modulePlan.SchemaDiff, "+  data EchoRequest {")
+
+	planFile := filepath.Join(dir, "plan.json")
+	plan := &Plan{Modules: []*ModulePlan{modulePlan}}
+	assert.NoError(t, plan.Save(planFile))
+	plan, err = LoadPlan(planFile)
+	assert.NoError(t, err)
+
+	err = ApplyPlan(ctx, plan, false, client)
+	assert.NoError(t, err)
+
+	// Modifying an artefact invalidates the plan.
+	assert.NoError(t, os.WriteFile(filepath.Join(deployDir, "main"), []byte("changed"), 0700)) //nolint:gosec
+	err = ApplyPlan(ctx, plan, false, client)
+	assert.EqualError(t, err, "echo: plan is stale: "+relToCWD(filepath.Join(deployDir, "main"))+" has changed since the plan was created")
+}
+
+// orderingDeployClient records the order modules are deployed in.
+type orderingDeployClient struct {
+	mockDeployClient
+	lock     sync.Mutex
+	deployed []string
+}
+
+func (c *orderingDeployClient) CreateDeployment(ctx context.Context, req *connect.Request[ftlv1.CreateDeploymentRequest]) (*connect.Response[ftlv1.CreateDeploymentResponse], error) {
+	if req.Msg.Schema.Name != "gamma" {
+		// Give modules deployed out of order a chance to go first.
+		time.Sleep(20 * time.Millisecond)
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.deployed = append(c.deployed, req.Msg.Schema.Name)
+	return c.mockDeployClient.CreateDeployment(ctx, req)
+}
+
+func TestApplyPlanInGroups(t *testing.T) {
+	ctx := log.ContextWithLogger(context.Background(), log.Configure(os.Stderr, log.Config{}))
+	modulePlan := func(name string) *ModulePlan {
+		data, err := proto.Marshal((&schema.Module{Name: name}).ToProto())
+		assert.NoError(t, err)
+		return &ModulePlan{Module: name, Replicas: 1, Schema: data}
+	}
+	plan := &Plan{
+		Modules: []*ModulePlan{modulePlan("alpha"), modulePlan("beta"), modulePlan("gamma")},
+		Groups:  [][]string{{"alpha", "beta"}, {"gamma"}},
+	}
+	client := &orderingDeployClient{}
+	assert.NoError(t, ApplyPlan(ctx, plan, false, client))
+	assert.Equal(t, 3, len(client.deployed))
+	assert.Equal(t, "gamma", client.deployed[2])
+
+	plan.Groups = [][]string{{"alpha"}, {"gamma"}}
+	err := ApplyPlan(ctx, plan, false, client)
+	assert.EqualError(t, err, "invalid deployment plan: modules beta are not in any group")
+
+	plan.Groups = [][]string{{"alpha", "beta"}, {"delta"}}
+	err = ApplyPlan(ctx, plan, false, client)
+	assert.EqualError(t, err, `invalid deployment plan: unknown module "delta"`)
+}
@@ -0,0 +1,44 @@
+package buildengine
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxReadmeExcerpt is the maximum size in bytes of the README excerpt deployed
+// with a module.
+const maxReadmeExcerpt = 1024
+
+// readmeExcerpt returns the first paragraph of the README at "path", skipping
+// any headings and badges before it, or "" if there is no README.
+func readmeExcerpt(path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+	var paragraph []string
+	for _, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimSpace(line)
+		if len(paragraph) == 0 {
+			if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "![") || strings.HasPrefix(line, "[![") {
+				continue
+			}
+		} else if line == "" || strings.HasPrefix(line, "#") {
+			break
+		}
+		paragraph = append(paragraph, line)
+	}
+	excerpt := strings.Join(paragraph, "\n")
+	if len(excerpt) > maxReadmeExcerpt {
+		excerpt = excerpt[:maxReadmeExcerpt]
+		for !utf8.ValidString(excerpt) {
+			excerpt = excerpt[:len(excerpt)-1]
+		}
+		excerpt += "…"
+	}
+	return excerpt, nil
+}
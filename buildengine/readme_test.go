@@ -0,0 +1,39 @@
+package buildengine
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+)
+
+func TestReadmeExcerpt(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "README.md")
+	excerpt, err := readmeExcerpt(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "", excerpt)
+
+	err = os.WriteFile(path, []byte(`# Payments
+
+[![CI](https://example.com/badge.svg)](https://example.com)
+
+Charges customers and issues refunds,
+on behalf of every other module.
+
+## Usage
+
+Call payments.charge.
+`), 0600)
+	assert.NoError(t, err)
+	excerpt, err = readmeExcerpt(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "Charges customers and issues refunds,\non behalf of every other module.", excerpt)
+
+	err = os.WriteFile(path, []byte(strings.Repeat("é", maxReadmeExcerpt)), 0600)
+	assert.NoError(t, err)
+	excerpt, err = readmeExcerpt(path)
+	assert.NoError(t, err)
+	assert.Equal(t, strings.Repeat("é", maxReadmeExcerpt/2)+"…", excerpt)
+}
@@ -0,0 +1,248 @@
+package buildengine
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+// RenameModule renames the module "from" to "to", rewriting its ftl.toml and
+// sources, and all references to it in the other modules found under
+// moduleDirs.
+//
+// Module directories themselves are not moved. Returns the files that were
+// modified or moved.
+func RenameModule(ctx context.Context, moduleDirs []string, from, to string) ([]string, error) {
+	logger := log.FromContext(ctx)
+	if !schema.ValidateName(to) {
+		return nil, fmt.Errorf("invalid module name %q", to)
+	}
+	modules, err := DiscoverModules(ctx, moduleDirs)
+	if err != nil {
+		return nil, err
+	}
+	var target *Module
+	for i, module := range modules {
+		switch module.Config.Module {
+		case from:
+			target = &modules[i]
+		case to:
+			return nil, fmt.Errorf("module %q already exists in %s", to, module.Config.Dir)
+		}
+	}
+	if target == nil {
+		return nil, fmt.Errorf("module %q not found", from)
+	}
+
+	r := &renamer{from: from, to: to}
+	if err := r.renameModule(*target); err != nil {
+		return nil, fmt.Errorf("%s: %w", from, err)
+	}
+	for _, module := range modules {
+		if module.Config.Module == from {
+			continue
+		}
+		dependencies, err := extractDependencies(module)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(dependencies, from) {
+			continue
+		}
+		logger.Debugf("Rewriting references to %q in %s", from, module.Config.Module)
+		if err := r.rewriteReferences(module); err != nil {
+			return nil, fmt.Errorf("%s: %w", module.Config.Module, err)
+		}
+	}
+	sort.Strings(r.changed)
+	return r.changed, nil
+}
+
+type renamer struct {
+	from, to string
+	changed  []string
+}
+
+// renameModule rewrites the module's own configuration and sources.
+func (r *renamer) renameModule(module Module) error {
+	dir := module.Config.Dir
+	moduleRe := regexp.MustCompile(`(?m)^(\s*module\s*=\s*)"` + regexp.QuoteMeta(r.from) + `"`)
+	if err := r.rewriteFile(filepath.Join(dir, "ftl.toml"), func(content string) string {
+		return moduleRe.ReplaceAllString(content, `${1}"`+r.to+`"`)
+	}); err != nil {
+		return err
+	}
+	switch module.Config.Language {
+	case "go":
+		goModRe := regexp.MustCompile(`(?m)^module\s+ftl/` + regexp.QuoteMeta(r.from) + `\s*$`)
+		if err := r.rewriteFile(filepath.Join(dir, "go.mod"), func(content string) string {
+			return goModRe.ReplaceAllString(content, "module ftl/"+r.to)
+		}); err != nil {
+			return err
+		}
+		return r.rewriteGoFiles(dir, true)
+
+	case "kotlin":
+		artifactRe := regexp.MustCompile(`<artifactId>` + regexp.QuoteMeta(r.from) + `</artifactId>`)
+		if err := r.rewriteFile(filepath.Join(dir, "pom.xml"), func(content string) string {
+			return artifactRe.ReplaceAllString(content, "<artifactId>"+r.to+"</artifactId>")
+		}); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		if err := r.rewriteKotlinFiles(dir); err != nil {
+			return err
+		}
+		// Move sources to match the new package name.
+		oldDir := filepath.Join(dir, "src/main/kotlin/ftl", r.from)
+		newDir := filepath.Join(dir, "src/main/kotlin/ftl", r.to)
+		if _, err := os.Stat(oldDir); err == nil {
+			if err := os.Rename(oldDir, newDir); err != nil {
+				return err
+			}
+			r.changed = append(r.changed, newDir)
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unsupported language: %s", module.Config.Language)
+	}
+}
+
+// rewriteReferences rewrites references to the renamed module in a dependent module.
+func (r *renamer) rewriteReferences(module Module) error {
+	switch module.Config.Language {
+	case "go":
+		return r.rewriteGoFiles(module.Config.Dir, false)
+	case "kotlin":
+		return r.rewriteKotlinFiles(module.Config.Dir)
+	default:
+		return fmt.Errorf("unsupported language: %s", module.Config.Language)
+	}
+}
+
+type edit struct {
+	offset int
+	length int
+	text   string
+}
+
+// rewriteGoFiles rewrites "ftl/<module>" imports and unaliased references to
+// the imported package. If self is true, package clauses are renamed too.
+func (r *renamer) rewriteGoFiles(dir string, self bool) error {
+	fset := token.NewFileSet()
+	importPath := "ftl/" + r.from
+	return WalkDir(dir, func(path string, d fs.DirEntry) error {
+		if d.IsDir() {
+			if strings.HasPrefix(d.Name(), "_") || d.Name() == "testdata" {
+				return ErrSkip
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") {
+			return nil
+		}
+		source, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		file, err := parser.ParseFile(fset, path, source, parser.ParseComments)
+		if err != nil {
+			return err
+		}
+		tfile := fset.File(file.Pos())
+		edits := []edit{}
+		if self && file.Name.Name == r.from {
+			edits = append(edits, edit{tfile.Offset(file.Name.Pos()), len(r.from), r.to})
+		}
+		renameIdent := false
+		for _, imp := range file.Imports {
+			path, err := strconv.Unquote(imp.Path.Value)
+			if err != nil || (path != importPath && !strings.HasPrefix(path, importPath+"/")) {
+				continue
+			}
+			newPath := "ftl/" + r.to + strings.TrimPrefix(path, importPath)
+			edits = append(edits, edit{tfile.Offset(imp.Path.Pos()), len(imp.Path.Value), strconv.Quote(newPath)})
+			if imp.Name == nil && path == importPath {
+				renameIdent = true
+			}
+		}
+		if renameIdent {
+			ast.Inspect(file, func(n ast.Node) bool {
+				sel, ok := n.(*ast.SelectorExpr)
+				if !ok {
+					return true
+				}
+				// A nil Obj means the identifier is not a local declaration, ie. it refers to the import.
+				if ident, ok := sel.X.(*ast.Ident); ok && ident.Name == r.from && ident.Obj == nil {
+					edits = append(edits, edit{tfile.Offset(ident.Pos()), len(r.from), r.to})
+				}
+				return true
+			})
+		}
+		if len(edits) == 0 {
+			return nil
+		}
+		sort.Slice(edits, func(i, j int) bool { return edits[i].offset > edits[j].offset })
+		for _, e := range edits {
+			source = slices.Concat(source[:e.offset], []byte(e.text), source[e.offset+e.length:])
+		}
+		return r.writeFile(path, source)
+	})
+}
+
+// rewriteKotlinFiles rewrites references to the "ftl.<module>" package.
+func (r *renamer) rewriteKotlinFiles(dir string) error {
+	packageRe := regexp.MustCompile(`\bftl\.` + regexp.QuoteMeta(r.from) + `\b`)
+	root := filepath.Join(dir, "src/main/kotlin")
+	if _, err := os.Stat(root); os.IsNotExist(err) {
+		return nil
+	}
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !(strings.HasSuffix(path, ".kt") || strings.HasSuffix(path, ".kts")) {
+			return nil
+		}
+		return r.rewriteFile(path, func(content string) string {
+			return packageRe.ReplaceAllString(content, "ftl."+r.to)
+		})
+	})
+}
+
+func (r *renamer) rewriteFile(path string, rewrite func(content string) string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	updated := rewrite(string(content))
+	if updated == string(content) {
+		return nil
+	}
+	return r.writeFile(path, []byte(updated))
+}
+
+func (r *renamer) writeFile(path string, content []byte) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, content, info.Mode().Perm()); err != nil {
+		return err
+	}
+	r.changed = append(r.changed, path)
+	return nil
+}
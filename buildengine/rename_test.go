@@ -0,0 +1,48 @@
+package buildengine
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+	"github.com/otiai10/copy"
+
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+func TestRenameModule(t *testing.T) {
+	ctx := log.ContextWithLogger(context.Background(), log.Configure(os.Stderr, log.Config{}))
+	dir := t.TempDir()
+	for _, module := range []string{"alpha", "other", "another"} {
+		assert.NoError(t, copy.Copy(filepath.Join("testdata", module), filepath.Join(dir, module)))
+	}
+
+	changed, err := RenameModule(ctx, []string{dir}, "other", "renamed")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(dir, "alpha", "alpha.go"),
+		filepath.Join(dir, "other", "ftl.toml"),
+		filepath.Join(dir, "other", "go.mod"),
+		filepath.Join(dir, "other", "other.go"),
+	}, changed)
+
+	alpha := readFile(t, filepath.Join(dir, "alpha", "alpha.go"))
+	assert.Contains(t, alpha, `"ftl/renamed"`)
+	assert.Contains(t, alpha, "ftl.Call(ctx, renamed.Echo, renamed.EchoRequest{})")
+	assert.NotContains(t, alpha, "other.")
+	assert.Contains(t, readFile(t, filepath.Join(dir, "other", "ftl.toml")), `module = "renamed"`)
+	assert.Contains(t, readFile(t, filepath.Join(dir, "other", "go.mod")), "module ftl/renamed\n")
+	assert.Contains(t, readFile(t, filepath.Join(dir, "other", "other.go")), "package renamed\n")
+
+	_, err = RenameModule(ctx, []string{dir}, "alpha", "another")
+	assert.EqualError(t, err, `module "another" already exists in `+filepath.Join(dir, "another"))
+}
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	return string(data)
+}
@@ -38,5 +38,5 @@ func main() {
 	verbConstructor := server.NewUserVerbServer("other",
 		server.HandleCall(other.Echo),
 	)
-	plugin.Start(context.Background(), "other", verbConstructor, ftlv1connect.VerbServiceName, ftlv1connect.NewVerbServiceHandler)
+	plugin.Start(context.Background(), "other", verbConstructor, ftlv1connect.VerbServiceName, ftlv1connect.NewVerbServiceHandler, server.ServeMetrics())
 }
@@ -0,0 +1,179 @@
+package buildengine
+
+import (
+	"context"
+	"os"
+	"sync"
+
+	"connectrpc.com/connect"
+	"golang.org/x/sync/errgroup"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/internal/cdc"
+	"github.com/TBD54566975/ftl/internal/compression"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/rpc/headers"
+	"github.com/TBD54566975/ftl/internal/sha256"
+)
+
+// maxConcurrentUploads is the maximum number of artefacts of a module that are
+// uploaded at once.
+const maxConcurrentUploads = 4
+
+// UploadProgress is called as the artefacts of a module are uploaded, with the
+// number of bytes of content uploaded so far and the total to upload.
+type UploadProgress func(uploaded, total int64)
+
+// artefactUpload is the upload of a single artefact.
+type artefactUpload struct {
+	path    string
+	content []byte
+	req     *ftlv1.UploadArtefactRequest
+	// Size of the content in the request, before compression.
+	size int64
+	// Indexes of the uploads that carry the content of chunks this upload
+	// references without their content, which must complete first.
+	after []int
+}
+
+// uploadArtefacts uploads the files at "paths" to the controller, up to
+// [maxConcurrentUploads] at a time, reporting progress to "progress" if it is
+// not nil.
+//
+// If the controller accepts artefacts uploaded in chunks, each file is split
+// into content-defined chunks and only the chunks the controller doesn't
+// already have are uploaded, so that content shared with other artefacts is
+// not uploaded again.
+//
+// Content is compressed if the controller accepts compressed uploads.
+func uploadArtefacts(ctx context.Context, client DeployClient, paths []string, progress UploadProgress) error {
+	logger := log.FromContext(ctx)
+	if len(paths) == 0 {
+		return nil
+	}
+	contents := make([][]byte, len(paths))
+	chunks := make([][][]byte, len(paths))
+	var chunkDigests []string
+	for i, path := range paths {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		contents[i] = content
+		chunks[i] = cdc.Split(content)
+		for _, chunk := range chunks[i] {
+			chunkDigests = append(chunkDigests, sha256.Sum(chunk).String())
+		}
+	}
+	diffs, err := client.GetArtefactDiffs(ctx, connect.NewRequest(&ftlv1.GetArtefactDiffsRequest{ClientChunkDigests: chunkDigests}))
+	if err != nil {
+		return err
+	}
+	compress := headers.AcceptsCompression(diffs.Header(), compression.Zstd)
+
+	// Chunks are only uploaded once, even if they're in more than one file,
+	// with the first file that contains them.
+	chunkUploads := map[string]int{}
+	for _, digest := range diffs.Msg.MissingChunkDigests {
+		chunkUploads[digest] = -1
+	}
+	uploads := make([]*artefactUpload, len(paths))
+	var total int64
+	for i, path := range paths {
+		upload := &artefactUpload{path: path, content: contents[i], req: &ftlv1.UploadArtefactRequest{}}
+		if diffs.Msg.AcceptsChunks {
+			after := map[int]bool{}
+			for _, chunk := range chunks[i] {
+				digest := sha256.Sum(chunk).String()
+				out := &ftlv1.ArtefactChunk{Digest: digest}
+				owner, missing := chunkUploads[digest]
+				if missing && owner == -1 {
+					out.Content = chunk
+					upload.size += int64(len(chunk))
+					chunkUploads[digest] = i
+				} else if missing && owner != i {
+					after[owner] = true
+				}
+				upload.req.Chunks = append(upload.req.Chunks, out)
+			}
+			for owner := range after {
+				upload.after = append(upload.after, owner)
+			}
+		} else {
+			upload.req.Content = contents[i]
+			upload.size = int64(len(contents[i]))
+		}
+		total += upload.size
+		uploads[i] = upload
+	}
+
+	var lock sync.Mutex
+	var uploaded int64
+	report := func(size int64) {
+		lock.Lock()
+		defer lock.Unlock()
+		uploaded += size
+		if progress != nil {
+			progress(uploaded, total)
+		}
+	}
+	report(0)
+
+	done := make([]chan struct{}, len(uploads))
+	for i := range done {
+		done[i] = make(chan struct{})
+	}
+	// Uploads only wait for earlier uploads, which are started first, so
+	// bounding the number of concurrent uploads can't deadlock.
+	group, ctx := errgroup.WithContext(ctx)
+	group.SetLimit(maxConcurrentUploads)
+	for i, upload := range uploads {
+		group.Go(func() error {
+			for _, j := range upload.after {
+				select {
+				case <-done[j]:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+			if err := uploadArtefact(ctx, client, upload, compress); err != nil {
+				return err
+			}
+			close(done[i])
+			report(upload.size)
+			return nil
+		})
+	}
+	if err := group.Wait(); err != nil {
+		return err
+	}
+	logger.Debugf("Uploaded %d bytes of %d files", total, len(paths))
+	return nil
+}
+
+func uploadArtefact(ctx context.Context, client DeployClient, upload *artefactUpload, compress bool) error {
+	logger := log.FromContext(ctx)
+	req := connect.NewRequest(upload.req)
+	if compress {
+		headers.SetCompression(req.Header(), compression.Zstd)
+		if len(req.Msg.Chunks) == 0 {
+			req.Msg.Content = compression.Compress(req.Msg.Content)
+		}
+		for _, chunk := range req.Msg.Chunks {
+			if len(chunk.Content) > 0 {
+				chunk.Content = compression.Compress(chunk.Content)
+			}
+		}
+	}
+	sent := len(req.Msg.Content)
+	for _, chunk := range req.Msg.Chunks {
+		sent += len(chunk.Content)
+	}
+	logger.Tracef("Uploading %s", relToCWD(upload.path))
+	resp, err := client.UploadArtefact(ctx, req)
+	if err != nil {
+		return err
+	}
+	logger.Debugf("Uploaded %s as %s (%d/%d bytes)", relToCWD(upload.path), sha256.FromBytes(resp.Msg.Digest), sent, len(upload.content))
+	return nil
+}
@@ -0,0 +1,145 @@
+package buildengine
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/assert/v2"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/internal/compression"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/rpc/headers"
+	"github.com/TBD54566975/ftl/internal/sha256"
+)
+
+// chunkingDeployClient stores the chunks of uploaded artefacts.
+type chunkingDeployClient struct {
+	mockDeployClient
+	lock      sync.Mutex
+	chunks    map[string][]byte
+	artefacts map[string][]byte
+	uploaded  int
+	// Accept compressed uploads.
+	compress bool
+}
+
+func (c *chunkingDeployClient) GetArtefactDiffs(ctx context.Context, req *connect.Request[ftlv1.GetArtefactDiffsRequest]) (*connect.Response[ftlv1.GetArtefactDiffsResponse], error) {
+	resp := &ftlv1.GetArtefactDiffsResponse{AcceptsChunks: true}
+	for _, digest := range req.Msg.ClientChunkDigests {
+		if _, ok := c.chunks[digest]; !ok {
+			resp.MissingChunkDigests = append(resp.MissingChunkDigests, digest)
+		}
+	}
+	out := connect.NewResponse(resp)
+	if c.compress {
+		headers.SetAcceptCompression(out.Header(), compression.Zstd)
+	}
+	return out, nil
+}
+
+func (c *chunkingDeployClient) UploadArtefact(ctx context.Context, req *connect.Request[ftlv1.UploadArtefactRequest]) (*connect.Response[ftlv1.UploadArtefactResponse], error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, compressed := headers.GetCompression(req.Header()).Get()
+	var content []byte
+	for _, chunk := range req.Msg.Chunks {
+		if len(chunk.Content) > 0 {
+			c.uploaded += len(chunk.Content)
+			if compressed {
+				decompressed, err := compression.Decompress(chunk.Content)
+				if err != nil {
+					return nil, err
+				}
+				chunk.Content = decompressed
+			}
+			c.chunks[chunk.Digest] = chunk.Content
+		}
+		stored, ok := c.chunks[chunk.Digest]
+		if !ok {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, nil)
+		}
+		content = append(content, stored...)
+	}
+	digest := sha256.Sum(content)
+	c.artefacts[digest.String()] = content
+	return connect.NewResponse(&ftlv1.UploadArtefactResponse{Digest: digest[:]}), nil
+}
+
+func TestUploadArtefactChunks(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	dir := t.TempDir()
+	dependency := make([]byte, 4*1024*1024)
+	rand.New(rand.NewSource(1)).Read(dependency) //nolint:errcheck
+	first := append([]byte("first module"), dependency...)
+	second := append([]byte("second module, which is different"), dependency...)
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "first"), first, 0600))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "second"), second, 0600))
+
+	client := &chunkingDeployClient{chunks: map[string][]byte{}, artefacts: map[string][]byte{}}
+	err := uploadArtefacts(ctx, client, []string{filepath.Join(dir, "first")}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, len(first), client.uploaded)
+
+	err = uploadArtefacts(ctx, client, []string{filepath.Join(dir, "second")}, nil)
+	assert.NoError(t, err)
+	assert.True(t, client.uploaded-len(first) < len(second)/2, "uploaded %d bytes of %d", client.uploaded-len(first), len(second))
+	assert.True(t, bytes.Equal(second, client.artefacts[sha256.Sum(second).String()]))
+}
+
+func TestUploadCompressedArtefacts(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	dir := t.TempDir()
+	content := bytes.Repeat([]byte("compressible "), 100_000)
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "main"), content, 0600))
+
+	client := &chunkingDeployClient{chunks: map[string][]byte{}, artefacts: map[string][]byte{}, compress: true}
+	err := uploadArtefacts(ctx, client, []string{filepath.Join(dir, "main")}, nil)
+	assert.NoError(t, err)
+	assert.True(t, client.uploaded < len(content)/10, "uploaded %d bytes of %d", client.uploaded, len(content))
+	assert.True(t, bytes.Equal(content, client.artefacts[sha256.Sum(content).String()]))
+}
+
+func TestUploadArtefactsConcurrently(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	dir := t.TempDir()
+	dependency := make([]byte, 2*1024*1024)
+	rand.New(rand.NewSource(1)).Read(dependency) //nolint:errcheck
+	var paths []string
+	var contents [][]byte
+	for i := range 10 {
+		// Every file shares the dependency, whose chunks are only uploaded
+		// with the first.
+		content := append([]byte(fmt.Sprintf("module %d", i)), dependency...)
+		path := filepath.Join(dir, fmt.Sprintf("module%d", i))
+		assert.NoError(t, os.WriteFile(path, content, 0600))
+		paths = append(paths, path)
+		contents = append(contents, content)
+	}
+
+	client := &chunkingDeployClient{chunks: map[string][]byte{}, artefacts: map[string][]byte{}}
+	var reports [][2]int64
+	err := uploadArtefacts(ctx, client, paths, func(uploaded, total int64) {
+		reports = append(reports, [2]int64{uploaded, total})
+	})
+	assert.NoError(t, err)
+	for _, content := range contents {
+		assert.True(t, bytes.Equal(content, client.artefacts[sha256.Sum(content).String()]))
+	}
+	assert.True(t, client.uploaded < 2*len(dependency), "uploaded %d bytes", client.uploaded)
+
+	assert.Equal(t, len(paths)+1, len(reports))
+	total := reports[0][1]
+	assert.Equal(t, [2]int64{0, total}, reports[0])
+	assert.Equal(t, [2]int64{total, total}, reports[len(reports)-1])
+	for i := 1; i < len(reports); i++ {
+		assert.True(t, reports[i][0] >= reports[i-1][0], "progress should not go backwards")
+	}
+}
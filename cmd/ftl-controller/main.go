@@ -4,13 +4,17 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"os/signal"
 	"strconv"
+	"syscall"
 	"time"
 
 	"github.com/alecthomas/kong"
+	kongtoml "github.com/alecthomas/kong-toml"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"golang.org/x/exp/maps"
 
 	"github.com/TBD54566975/ftl"
 	"github.com/TBD54566975/ftl/backend/controller"
@@ -23,30 +27,39 @@ import (
 	"github.com/TBD54566975/ftl/internal/observability"
 )
 
-var cli struct {
-	Version             kong.VersionFlag     `help:"Show version."`
-	ObservabilityConfig observability.Config `embed:"" prefix:"o11y-"`
-	LogConfig           log.Config           `embed:"" prefix:"log-"`
-	ControllerConfig    controller.Config    `embed:""`
-	ConfigFlag          string               `name:"config" short:"C" help:"Path to FTL project configuration file." env:"FTL_CONFIG" placeholder:"FILE"`
+type CLI struct {
+	Version              kong.VersionFlag     `help:"Show version."`
+	ObservabilityConfig  observability.Config `embed:"" prefix:"o11y-"`
+	LogConfig            log.Config           `embed:"" prefix:"log-"`
+	ControllerConfig     controller.Config    `embed:""`
+	ConfigFlag           string               `name:"config" short:"C" help:"Path to FTL project configuration file." env:"FTL_CONFIG" placeholder:"FILE"`
+	ControllerConfigFile kong.ConfigFlag      `name:"controller-config" help:"TOML file of controller flags, reloaded on SIGHUP or when it changes." placeholder:"FILE"`
 }
 
+var cli CLI
+
 func main() {
 	t, err := strconv.ParseInt(ftl.Timestamp, 10, 64)
 	if err != nil {
 		panic(fmt.Sprintf("invalid timestamp %q: %s", ftl.Timestamp, err))
 	}
-	kctx := kong.Parse(&cli,
+	options := []kong.Option{
 		kong.Description(`FTL - Towards a 𝝺-calculus for large-scale systems`),
 		kong.UsageOnError(),
+		kong.Configuration(kongtoml.Loader),
 		kong.Vars{"version": ftl.Version, "timestamp": time.Unix(t, 0).Format(time.RFC3339)},
-	)
-	ctx := log.ContextWithLogger(context.Background(), log.Configure(os.Stderr, cli.LogConfig))
+	}
+	kctx := kong.Parse(&cli, options...)
+	logLevel := &log.LevelOverride{}
+	ctx := log.ContextWithLogger(context.Background(), log.Configure(os.Stderr, cli.LogConfig).LevelOverride(logLevel))
 	err = observability.Init(ctx, "ftl-controller", ftl.Version, cli.ObservabilityConfig)
 	kctx.FatalIfErrorf(err, "failed to initialize observability")
 
 	// The FTL controller currently only supports DB as a configuration provider/resolver.
-	conn, err := pgxpool.New(ctx, cli.ControllerConfig.DSN)
+	poolConfig, err := pgxpool.ParseConfig(cli.ControllerConfig.DSN)
+	kctx.FatalIfErrorf(err)
+	poolConfig.ConnConfig.Tracer = observability.NewQueryTracer()
+	conn, err := pgxpool.NewWithConfig(ctx, poolConfig)
 	kctx.FatalIfErrorf(err)
 	dal, err := dal.New(ctx, conn)
 	kctx.FatalIfErrorf(err)
@@ -69,6 +82,78 @@ func main() {
 	kctx.FatalIfErrorf(err)
 	ctx = cf.ContextWithSecrets(ctx, sm)
 
-	err = controller.Start(ctx, cli.ControllerConfig, scaling.NewK8sScaling(), dal)
+	reloads := make(chan controller.Config)
+	go watchConfig(ctx, cli, options, logLevel, reloads)
+
+	err = controller.Start(ctx, cli.ControllerConfig, scaling.NewK8sScaling(), dal, configDal, reloads)
 	kctx.FatalIfErrorf(err)
 }
+
+// configPollInterval is how often the controller configuration file and
+// webhooks file are checked for changes.
+const configPollInterval = time.Second * 5
+
+// watchConfig re-parses the command line, environment and controller
+// configuration file whenever the process receives SIGHUP or one of the files
+// configuring the controller changes, applying the log level and sending the
+// controller configuration to "reloads".
+func watchConfig(ctx context.Context, current CLI, options []kong.Option, logLevel *log.LevelOverride, reloads chan<- controller.Config) {
+	logger := log.FromContext(ctx)
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+	ticker := time.NewTicker(configPollInterval)
+	defer ticker.Stop()
+
+	modified := configFilesModified(current)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-hup:
+			logger.Infof("Received SIGHUP, reloading configuration")
+
+		case <-ticker.C:
+			latest := configFilesModified(current)
+			if maps.Equal(latest, modified) {
+				continue
+			}
+			logger.Infof("Configuration files changed, reloading configuration")
+		}
+		// Files that fail to parse are only retried once they change again.
+		modified = configFilesModified(current)
+		next := CLI{}
+		parser, err := kong.New(&next, options...)
+		if err == nil {
+			_, err = parser.Parse(os.Args[1:])
+		}
+		if err != nil {
+			logger.Errorf(err, "Failed to reload configuration, keeping the current configuration")
+			continue
+		}
+		current = next
+		modified = configFilesModified(current)
+		logLevel.Set(current.LogConfig.Level)
+		select {
+		case reloads <- current.ControllerConfig:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// configFilesModified returns the modification time of each file configuring
+// the controller.
+func configFilesModified(config CLI) map[string]time.Time {
+	out := map[string]time.Time{}
+	for _, path := range []string{string(config.ControllerConfigFile), config.ControllerConfig.Webhooks.File} {
+		if path == "" {
+			continue
+		}
+		if info, err := os.Stat(path); err == nil {
+			out[path] = info.ModTime()
+		}
+	}
+	return out
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/TBD54566975/ftl/backend/controller/backup"
+	"github.com/TBD54566975/ftl/backend/controller/coldstorage"
+	"github.com/TBD54566975/ftl/backend/controller/dal"
+	cfdal "github.com/TBD54566975/ftl/common/configuration/dal"
+)
+
+type adminCmd struct {
+	Backup  adminBackupCmd  `cmd:"" help:"Back up the deployments, configuration, feature flags and FSM state of the cluster to an archive."`
+	Restore adminRestoreCmd `cmd:"" help:"Restore a backup archive into the cluster."`
+}
+
+func (a *adminCmd) Help() string {
+	return `
+These commands connect directly to the controller's database, so they can be
+used to recover a cluster whose controllers are not running. Secrets are not
+included in backups.
+`
+}
+
+type adminDatabaseFlags struct {
+	DSN            string   `help:"DSN of the controller database." default:"postgres://localhost:15432/ftl?sslmode=disable&user=postgres&password=secret" env:"FTL_CONTROLLER_DSN"`
+	ColdStorageURL *url.URL `help:"Cold storage the content of artefacts of inactive deployments was moved to, if any." env:"FTL_CONTROLLER_COLD_STORAGE_URL" placeholder:"URL"`
+}
+
+func (a *adminDatabaseFlags) open(ctx context.Context) (*dal.DAL, *cfdal.DAL, error) {
+	conn, err := pgxpool.New(ctx, a.DSN)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to connect to the database: %w", err)
+	}
+	d, err := dal.New(ctx, conn)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create DAL: %w", err)
+	}
+	if a.ColdStorageURL != nil {
+		store, err := coldstorage.NewStore(ctx, a.ColdStorageURL)
+		if err != nil {
+			return nil, nil, err
+		}
+		d.SetColdStore(store)
+	}
+	configDAL, err := cfdal.New(ctx, conn)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create configuration DAL: %w", err)
+	}
+	return d, configDAL, nil
+}
+
+type adminBackupCmd struct {
+	adminDatabaseFlags
+	Output string `arg:"" help:"File to write the backup archive to." type:"path"`
+}
+
+func (a *adminBackupCmd) Run(ctx context.Context) error {
+	d, configDAL, err := a.open(ctx)
+	if err != nil {
+		return err
+	}
+	w, err := os.Create(a.Output)
+	if err != nil {
+		return fmt.Errorf("failed to create backup archive: %w", err)
+	}
+	manifest, err := backup.Export(ctx, d, configDAL, w)
+	if err == nil {
+		err = w.Close()
+	} else {
+		_ = w.Close()
+	}
+	if err != nil {
+		_ = os.Remove(a.Output)
+		return fmt.Errorf("failed to back up: %w", err)
+	}
+	fmt.Printf("Backed up %s to %s\n", describeBackup(manifest), a.Output)
+	return nil
+}
+
+type adminRestoreCmd struct {
+	adminDatabaseFlags
+	Input string `arg:"" help:"Backup archive created by \"ftl admin backup\"." type:"existingfile"`
+}
+
+func (a *adminRestoreCmd) Run(ctx context.Context) error {
+	d, configDAL, err := a.open(ctx)
+	if err != nil {
+		return err
+	}
+	r, err := os.Open(a.Input)
+	if err != nil {
+		return fmt.Errorf("failed to open backup archive: %w", err)
+	}
+	defer r.Close()
+	manifest, err := backup.Restore(ctx, d, configDAL, r)
+	if err != nil {
+		return fmt.Errorf("failed to restore: %w", err)
+	}
+	fmt.Printf("Restored %s from the backup of %s\n", describeBackup(manifest), manifest.Created.Local().Format("2006-01-02 15:04:05"))
+	return nil
+}
+
+func describeBackup(manifest backup.Manifest) string {
+	return fmt.Sprintf("%d deployments, %d configuration values, %d feature flags and %d FSM instances",
+		len(manifest.Deployments), len(manifest.Configuration), len(manifest.FeatureFlags), len(manifest.FSMInstances))
+}
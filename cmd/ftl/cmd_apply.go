@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	"github.com/TBD54566975/ftl/backend/controller/admin"
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/buildengine"
+)
+
+type applyCmd struct {
+	Manifest string `arg:"" help:"Manifest to apply, as created by \"ftl export manifest\"." type:"existingfile" placeholder:"FILE"`
+	DryRun   bool   `help:"Show the changes that applying the manifest would make, without making them."`
+	Prune    bool   `help:"Remove deployments of modules that are not declared in the manifest."`
+	NoWait   bool   `help:"Do not wait for deployments to become ready."`
+}
+
+func (a *applyCmd) Help() string {
+	return `
+Reconciles the deployments of the cluster to a manifest created with "ftl export manifest".
+
+The artefacts of new deployments must already have been uploaded to the cluster, and the configuration and secrets
+they require must be set.
+`
+}
+
+func (a *applyCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient, adminClient admin.Client) error {
+	manifest, err := buildengine.LoadManifest(a.Manifest)
+	if err != nil {
+		return err
+	}
+	if err := checkManifestRefs(ctx, manifest, adminClient); err != nil {
+		return err
+	}
+	changes, err := buildengine.ApplyManifest(ctx, manifest, buildengine.ManifestOptions{
+		Prune:  a.Prune,
+		DryRun: a.DryRun,
+		Wait:   !a.NoWait,
+	}, client)
+	if err != nil {
+		return err
+	}
+	if len(changes) == 0 {
+		fmt.Println("Cluster is up to date")
+		return nil
+	}
+	for _, change := range changes {
+		fmt.Println(change)
+	}
+	return nil
+}
+
+// checkManifestRefs returns an error if any configuration or secrets required
+// by the manifest are not set, either for the module or globally.
+func checkManifestRefs(ctx context.Context, manifest *buildengine.Manifest, adminClient admin.Client) error {
+	// An empty module includes global values.
+	module := ""
+	includeValues := false
+	configs, err := adminClient.ConfigList(ctx, connect.NewRequest(&ftlv1.ListConfigRequest{Module: &module, IncludeValues: &includeValues}))
+	if err != nil {
+		return fmt.Errorf("failed to list configuration: %w", err)
+	}
+	secrets, err := adminClient.SecretsList(ctx, connect.NewRequest(&ftlv1.ListSecretsRequest{Module: &module, IncludeValues: &includeValues}))
+	if err != nil {
+		return fmt.Errorf("failed to list secrets: %w", err)
+	}
+	setConfig := map[string]bool{}
+	for _, config := range configs.Msg.Configs {
+		setConfig[config.RefPath] = true
+	}
+	setSecrets := map[string]bool{}
+	for _, secret := range secrets.Msg.Secrets {
+		setSecrets[secret.RefPath] = true
+	}
+	missing := []string{}
+	for _, deployment := range manifest.Deployments {
+		for _, name := range deployment.Config {
+			if !setConfig[deployment.Module+"."+name] && !setConfig[name] {
+				missing = append(missing, "config "+deployment.Module+"."+name)
+			}
+		}
+		for _, name := range deployment.Secrets {
+			if !setSecrets[deployment.Module+"."+name] && !setSecrets[name] {
+				missing = append(missing, "secret "+deployment.Module+"."+name)
+			}
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("manifest requires values that are not set: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
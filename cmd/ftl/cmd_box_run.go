@@ -16,7 +16,9 @@ import (
 	"github.com/TBD54566975/ftl/backend/controller/sql/databasetesting"
 	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
 	"github.com/TBD54566975/ftl/buildengine"
+	cfdal "github.com/TBD54566975/ftl/common/configuration/dal"
 	"github.com/TBD54566975/ftl/internal/bind"
+	"github.com/TBD54566975/ftl/internal/log"
 	"github.com/TBD54566975/ftl/internal/model"
 	"github.com/TBD54566975/ftl/internal/rpc"
 )
@@ -40,6 +42,10 @@ func (b *boxRunCmd) Run(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("failed to create DAL: %w", err)
 	}
+	configDAL, err := cfdal.New(ctx, conn)
+	if err != nil {
+		return fmt.Errorf("failed to create configuration DAL: %w", err)
+	}
 	config := controller.Config{
 		Bind:        b.Bind,
 		IngressBind: b.IngressBind,
@@ -61,11 +67,11 @@ func (b *boxRunCmd) Run(ctx context.Context) error {
 	}
 	wg := errgroup.Group{}
 	wg.Go(func() error {
-		return controller.Start(ctx, config, runnerScaling, dal)
+		return controller.Start(ctx, config, runnerScaling, dal, configDAL, nil)
 	})
 
 	// Wait for the controller to come up.
-	client := ftlv1connect.NewControllerServiceClient(rpc.GetHTTPClient(b.Bind.String()), b.Bind.String())
+	client := rpc.Dial(ftlv1connect.NewControllerServiceClient, b.Bind.String(), log.Error)
 	waitCtx, cancel := context.WithTimeout(ctx, b.ControllerTimeout)
 	defer cancel()
 	if err := rpc.Wait(waitCtx, backoff.Backoff{}, client); err != nil {
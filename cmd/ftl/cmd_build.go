@@ -8,11 +8,14 @@ import (
 	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
 	"github.com/TBD54566975/ftl/buildengine"
 	"github.com/TBD54566975/ftl/common/projectconfig"
+	"github.com/TBD54566975/ftl/internal/model"
 )
 
 type buildCmd struct {
-	Parallelism int      `short:"j" help:"Number of modules to build in parallel." default:"${numcpu}"`
-	Dirs        []string `arg:"" help:"Base directories containing modules (defaults to modules in project config)." type:"existingdir" optional:""`
+	Parallelism int            `short:"j" help:"Number of modules to build in parallel." default:"${numcpu}"`
+	Dirs        []string       `arg:"" help:"Base directories containing modules (defaults to modules in project config)." type:"existingdir" optional:""`
+	Frozen      bool           `help:"Fail if the schemas of dependencies sourced from the FTL cluster differ from ftl.lock."`
+	Target      model.Platform `help:"Platform to build modules for, eg. linux/amd64 (defaults to the current platform)." placeholder:"OS/ARCH" env:"FTL_TARGET"`
 }
 
 func (b *buildCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient, projConfig projectconfig.Config) error {
@@ -22,10 +25,13 @@ func (b *buildCmd) Run(ctx context.Context, client ftlv1connect.ControllerServic
 	if len(b.Dirs) == 0 {
 		return errors.New("no directories specified")
 	}
-	engine, err := buildengine.New(ctx, client, b.Dirs, buildengine.Parallelism(b.Parallelism))
+	engine, err := buildengine.New(ctx, client, b.Dirs, buildengine.Parallelism(b.Parallelism), buildengine.Target(b.Target))
 	if err != nil {
 		return err
 	}
+	if err := checkLockfile(ctx, projConfig, engine, b.Frozen); err != nil {
+		return err
+	}
 	if err := engine.Build(ctx); err != nil {
 		return fmt.Errorf("build failed: %w", err)
 	}
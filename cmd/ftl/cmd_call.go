@@ -19,12 +19,14 @@ import (
 	"github.com/TBD54566975/ftl/go-runtime/ftl/reflection"
 	"github.com/TBD54566975/ftl/internal/log"
 	"github.com/TBD54566975/ftl/internal/rpc"
+	"github.com/TBD54566975/ftl/internal/rpc/headers"
 )
 
 type callCmd struct {
 	Wait    time.Duration  `short:"w" help:"Wait up to this elapsed time for the FTL cluster to become available." default:"1m"`
 	Verb    reflection.Ref `arg:"" required:"" help:"Full path of Verb to call."`
 	Request string         `arg:"" optional:"" help:"JSON5 request payload." default:"{}"`
+	NoCache bool           `help:"Call the verb even if it has a cached response."`
 }
 
 func (c *callCmd) Run(ctx context.Context, client ftlv1connect.VerbServiceClient, ctlCli ftlv1connect.ControllerServiceClient) error {
@@ -48,10 +50,14 @@ func (c *callCmd) Run(ctx context.Context, client ftlv1connect.VerbServiceClient
 	logger.Debugf("Calling %s", c.Verb)
 
 	// otherwise, we have a match so call the verb
-	resp, err := client.Call(ctx, connect.NewRequest(&ftlv1.CallRequest{
+	req := connect.NewRequest(&ftlv1.CallRequest{
 		Verb: c.Verb.ToProto(),
 		Body: requestJSON,
-	}))
+	})
+	if c.NoCache {
+		headers.SetCacheBypass(req.Header())
+	}
+	resp, err := client.Call(ctx, req)
 
 	if cerr := new(connect.Error); errors.As(err, &cerr) && cerr.Code() == connect.CodeNotFound {
 		suggestions, err := c.findSuggestions(ctx, ctlCli)
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/TBD54566975/ftl/backend/controller/contract"
+)
+
+type contractCmd struct {
+	Verify contractVerifyCmd `cmd:"" help:"Replay recorded ingress fixtures and report responses that changed."`
+}
+
+func (c *contractCmd) Help() string {
+	return `
+Fixtures are recorded by running the controller (eg. "ftl serve") with
+--contract-dir=DIR against a known good build, then replayed against a new
+build with "ftl contract verify DIR" before it is deployed.
+`
+}
+
+type contractVerifyCmd struct {
+	Dir     string            `arg:"" help:"Directory of recorded fixtures." type:"existingdir"`
+	Ingress *url.URL          `help:"Ingress endpoint to replay requests against." default:"http://localhost:8891"`
+	Module  []string          `help:"Only verify the routes of these modules." placeholder:"MODULE"`
+	Header  map[string]string `help:"Headers to add to each request, eg. credentials that were not recorded." mapsep:"," placeholder:"NAME=VALUE"`
+	Timeout time.Duration     `help:"Timeout for each request." default:"30s"`
+}
+
+func (c *contractVerifyCmd) Run(ctx context.Context) error {
+	fixtures, err := contract.LoadFixtures(c.Dir)
+	if err != nil {
+		return err
+	}
+	headers := http.Header{}
+	for name, value := range c.Header {
+		headers.Set(name, value)
+	}
+	client := &http.Client{Timeout: c.Timeout}
+	verified, failed := 0, 0
+	for _, fixture := range fixtures {
+		if len(c.Module) > 0 && !slices.Contains(c.Module, fixture.Module) {
+			continue
+		}
+		mismatches, err := contract.Verify(ctx, client, c.Ingress, headers, fixture)
+		if err != nil {
+			return fmt.Errorf("%s: %w", fixture.Route, err)
+		}
+		verified += len(fixture.Exchanges)
+		failed += len(mismatches)
+		if len(mismatches) == 0 {
+			fmt.Printf("PASS %s %s %s (%d requests)\n", fixture.Route, fixture.Method, fixture.Path, len(fixture.Exchanges))
+			continue
+		}
+		fmt.Printf("FAIL %s %s %s (%d of %d requests)\n", fixture.Route, fixture.Method, fixture.Path, len(mismatches), len(fixture.Exchanges))
+		for _, mismatch := range mismatches {
+			u := url.URL{Path: mismatch.Request.Path, RawQuery: mismatch.Request.Query.Encode()}
+			fmt.Printf("  %s %s\n", mismatch.Request.Method, u.String())
+			for _, difference := range mismatch.Differences {
+				fmt.Printf("    %s\n", difference)
+			}
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d recorded requests had different responses", failed, verified)
+	}
+	return nil
+}
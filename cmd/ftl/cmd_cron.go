@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"connectrpc.com/connect"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/go-runtime/ftl/reflection"
+)
+
+type cronCmd struct {
+	List   cronListCmd   `cmd:"" help:"List the cron jobs of active deployments."`
+	Pause  cronPauseCmd  `cmd:"" help:"Pause the cron jobs of a module until they are resumed."`
+	Resume cronResumeCmd `cmd:"" help:"Resume the paused cron jobs of a module."`
+	Run    cronRunCmd    `cmd:"" help:"Execute a cron job immediately, whether or not it is paused."`
+}
+
+type cronListCmd struct {
+	Module string `arg:"" optional:"" help:"Only list the cron jobs of this module."`
+}
+
+func (c *cronListCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	req := &ftlv1.ListCronJobsRequest{}
+	if c.Module != "" {
+		req.Module = &c.Module
+	}
+	resp, err := client.ListCronJobs(ctx, connect.NewRequest(req))
+	if err != nil {
+		return err
+	}
+	if len(resp.Msg.Jobs) == 0 {
+		fmt.Println("No cron jobs found.")
+		return nil
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "JOB\tSCHEDULE\tNEXT\tSTATE")
+	for _, job := range resp.Msg.Jobs {
+		state := "idle"
+		switch {
+		case job.Executing:
+			state = "executing"
+		case job.Paused:
+			state = "paused"
+		}
+		fmt.Fprintf(w, "%s.%s\t%s\t%s\t%s\n", job.Verb.Module, job.Verb.Name, job.Schedule, job.NextExecution.AsTime().Local().Format(time.DateTime), state)
+	}
+	return w.Flush()
+}
+
+type cronPauseCmd struct {
+	Job string `arg:"" help:"Module to pause the cron jobs of, or a single job in the form <module>.<job>."`
+}
+
+func (c *cronPauseCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	module, verb := splitModuleVerb(c.Job)
+	_, err := client.PauseCronJobs(ctx, connect.NewRequest(&ftlv1.PauseCronJobsRequest{Module: module, Verb: verb}))
+	if err != nil {
+		return fmt.Errorf("failed to pause cron jobs: %w", err)
+	}
+	return nil
+}
+
+type cronResumeCmd struct {
+	Job string `arg:"" help:"Module to resume the cron jobs of, or a single job in the form <module>.<job>."`
+}
+
+func (c *cronResumeCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	module, verb := splitModuleVerb(c.Job)
+	_, err := client.ResumeCronJobs(ctx, connect.NewRequest(&ftlv1.ResumeCronJobsRequest{Module: module, Verb: verb}))
+	if err != nil {
+		return fmt.Errorf("failed to resume cron jobs: %w", err)
+	}
+	return nil
+}
+
+// splitModuleVerb splits "<module>[.<verb>]" into its module and optional verb.
+func splitModuleVerb(job string) (string, *string) {
+	module, verb, ok := strings.Cut(job, ".")
+	if !ok {
+		return module, nil
+	}
+	return module, &verb
+}
+
+type cronRunCmd struct {
+	Job reflection.Ref `arg:"" help:"Cron job to execute, in the form <module>.<job>."`
+}
+
+func (c *cronRunCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	_, err := client.TriggerCronJob(ctx, connect.NewRequest(&ftlv1.TriggerCronJobRequest{Verb: c.Job.ToProto()}))
+	if err != nil {
+		return fmt.Errorf("failed to run cron job: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"connectrpc.com/connect"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/go-runtime/ftl/reflection"
+)
+
+type databaseCmd struct {
+	List     databaseListCmd     `cmd:"" help:"List the databases provisioned by the controller."`
+	Teardown databaseTeardownCmd `cmd:"" help:"Delete a database provisioned by the controller, along with its DSN secret."`
+}
+
+type databaseListCmd struct{}
+
+func (d *databaseListCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	resp, err := client.ListProvisionedDatabases(ctx, connect.NewRequest(&ftlv1.ListProvisionedDatabasesRequest{}))
+	if err != nil {
+		return err
+	}
+	if len(resp.Msg.Databases) == 0 {
+		fmt.Println("No databases have been provisioned.")
+		return nil
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "DATABASE\tPROVISIONER\tCREATED")
+	for _, db := range resp.Msg.Databases {
+		fmt.Fprintf(w, "%s.%s\t%s\t%s\n", db.Module, db.Name, db.Provisioner, db.CreatedAt.AsTime().Format(time.RFC3339))
+	}
+	return w.Flush()
+}
+
+type databaseTeardownCmd struct {
+	Database reflection.Ref `arg:"" help:"Database to delete, in the form <module>.<database>."`
+}
+
+func (d *databaseTeardownCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	_, err := client.TeardownDatabase(ctx, connect.NewRequest(&ftlv1.TeardownDatabaseRequest{
+		Module: d.Database.Module,
+		Name:   d.Database.Name,
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to tear down database: %w", err)
+	}
+	return nil
+}
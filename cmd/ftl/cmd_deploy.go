@@ -2,24 +2,136 @@ package main
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/mattn/go-isatty"
+	"golang.org/x/exp/maps"
 
 	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/backend/schema"
 	"github.com/TBD54566975/ftl/buildengine"
+	"github.com/TBD54566975/ftl/common/projectconfig"
+	"github.com/TBD54566975/ftl/dashboard"
+	"github.com/TBD54566975/ftl/internal/model"
 	"github.com/TBD54566975/ftl/internal/rpc"
 )
 
 type deployCmd struct {
-	Parallelism int      `short:"j" help:"Number of modules to build in parallel." default:"${numcpu}"`
-	Replicas    int32    `short:"n" help:"Number of replicas to deploy." default:"1"`
-	Dirs        []string `arg:"" help:"Base directories containing modules." type:"existingdir" required:""`
-	NoWait      bool     `help:"Do not wait for deployment to complete." default:"false"`
+	Parallelism int            `short:"j" help:"Number of modules to build in parallel." default:"${numcpu}"`
+	Replicas    int32          `short:"n" help:"Number of replicas to deploy." default:"1"`
+	Dirs        []string       `arg:"" help:"Base directories containing modules." type:"existingdir" optional:""`
+	NoWait      bool           `help:"Do not wait for deployment to complete." default:"false"`
+	Plan        string         `help:"Build modules and write a deployment plan to FILE without deploying." placeholder:"FILE" xor:"plan"`
+	Apply       string         `help:"Apply a deployment plan previously created with --plan." placeholder:"FILE" xor:"plan" type:"existingfile"`
+	Target      model.Platform `help:"Platform of the runners to build modules for, eg. linux/amd64 (defaults to the current platform)." placeholder:"OS/ARCH" env:"FTL_TARGET"`
 }
 
-func (d *deployCmd) Run(ctx context.Context) error {
+func (d *deployCmd) Run(ctx context.Context, projConfig projectconfig.Config) error {
 	client := rpc.ClientFromContext[ftlv1connect.ControllerServiceClient](ctx)
-	engine, err := buildengine.New(ctx, client, d.Dirs, buildengine.Parallelism(d.Parallelism))
+	if d.Apply != "" {
+		if len(d.Dirs) > 0 {
+			return errors.New("module directories can not be specified with --apply")
+		}
+		plan, err := buildengine.LoadPlan(d.Apply)
+		if err != nil {
+			return err
+		}
+		return buildengine.ApplyPlan(ctx, plan, !d.NoWait, client)
+	}
+	if len(d.Dirs) == 0 {
+		return errors.New("expected one or more module directories")
+	}
+	opts := []buildengine.Option{buildengine.Parallelism(d.Parallelism), buildengine.Project(projectName(projConfig)), buildengine.Target(d.Target)}
+	if isatty.IsTerminal(os.Stderr.Fd()) {
+		opts = append(opts, buildengine.WithListener(newDeployProgress(os.Stderr)))
+	}
+	engine, err := buildengine.New(ctx, client, d.Dirs, opts...)
 	if err != nil {
 		return err
 	}
+	if d.Plan != "" {
+		plan, err := engine.Plan(ctx, d.Replicas)
+		if err != nil {
+			return err
+		}
+		printPlan(plan)
+		if err := plan.Save(d.Plan); err != nil {
+			return err
+		}
+		fmt.Printf("\nPlan saved to %s, apply with: ftl deploy --apply=%s\n", d.Plan, d.Plan)
+		return nil
+	}
 	return engine.BuildAndDeploy(ctx, d.Replicas, !d.NoWait)
 }
+
+func printPlan(plan *buildengine.Plan) {
+	for _, module := range plan.Modules {
+		if module.IsNew() {
+			fmt.Printf("+ %s: new deployment with %d replica(s)\n", module.Module, module.Replicas)
+		} else {
+			fmt.Printf("~ %s: replaces %s\n", module.Module, module.Replaces)
+			if module.PreviousReplicas != module.Replicas {
+				fmt.Printf("    replicas: %d -> %d\n", module.PreviousReplicas, module.Replicas)
+			}
+		}
+		fmt.Printf("    artefacts: %d (%d to upload)\n", len(module.Artefacts), len(module.MissingDigests))
+		if module.SchemaDiff == "" {
+			fmt.Printf("    schema: unchanged\n")
+			continue
+		}
+		fmt.Printf("    schema:\n")
+		for _, line := range strings.Split(strings.TrimSuffix(module.SchemaDiff, "\n"), "\n") {
+			fmt.Printf("      %s\n", line)
+		}
+	}
+}
+
+var _ buildengine.Listener = (*deployProgress)(nil)
+
+// deployProgress draws a progress bar for the artefacts of each module being
+// uploaded on a single line of a terminal.
+type deployProgress struct {
+	out     io.Writer
+	lock    sync.Mutex
+	modules map[string]moduleUpload
+}
+
+type moduleUpload struct{ uploaded, total int64 }
+
+func newDeployProgress(out io.Writer) *deployProgress {
+	return &deployProgress{out: out, modules: map[string]moduleUpload{}}
+}
+
+func (d *deployProgress) OnBuildStarted(module buildengine.Module) {}
+
+func (d *deployProgress) OnBuildSuccess() {}
+
+func (d *deployProgress) OnBuildFailed(err error) {}
+
+func (d *deployProgress) OnBuildDiagnostics(module buildengine.Module, errs []*schema.Error) {}
+
+// OnDeployProgress redraws the progress of the modules being uploaded, clearing
+// the line once they have all finished.
+func (d *deployProgress) OnDeployProgress(module buildengine.Module, uploaded, total int64) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if uploaded >= total {
+		delete(d.modules, module.Config.Module)
+	} else {
+		d.modules[module.Config.Module] = moduleUpload{uploaded, total}
+	}
+	names := maps.Keys(d.modules)
+	sort.Strings(names)
+	bars := make([]string, len(names))
+	for i, name := range names {
+		upload := d.modules[name]
+		bars[i] = name + " " + dashboard.ProgressBar(upload.uploaded, upload.total, 20)
+	}
+	fmt.Fprintf(d.out, "\r\x1b[K%s", strings.Join(bars, "  "))
+}
@@ -4,13 +4,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"time"
 
 	"golang.org/x/sync/errgroup"
 
+	"github.com/TBD54566975/ftl/backend/controller/admin"
 	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
 	"github.com/TBD54566975/ftl/buildengine"
 	"github.com/TBD54566975/ftl/common/projectconfig"
+	"github.com/TBD54566975/ftl/dashboard"
+	"github.com/TBD54566975/ftl/internal/daemon"
 	"github.com/TBD54566975/ftl/internal/log"
 	"github.com/TBD54566975/ftl/internal/rpc"
 	"github.com/TBD54566975/ftl/lsp"
@@ -22,12 +26,17 @@ type devCmd struct {
 	Watch          time.Duration `help:"Watch template directory at this frequency and regenerate on change." default:"500ms"`
 	NoServe        bool          `help:"Do not start the FTL server." default:"false"`
 	Lsp            bool          `help:"Run the language server." default:"false"`
+	TUI            bool          `name:"tui" help:"Show a live dashboard instead of scrolling logs." default:"false" xor:"devmode"`
+	Detach         bool          `help:"Run in the background. Use --attach to follow its logs, and --stop to stop it." default:"false" xor:"devmode"`
+	Attach         bool          `help:"Attach to the logs of an FTL dev instance running in the background." default:"false" xor:"devmode"`
+	Daemon         bool          `help:"Run as a background daemon (internal)." hidden:"" default:"false"`
 	ServeCmd       serveCmd      `embed:""`
 	InitDB         bool          `help:"Initialize the database and exit." default:"false"`
+	Scenario       string        `help:"Scenario from the project configuration to seed data and call verbs for after the initial deploy." env:"FTL_DEV_SCENARIO" placeholder:"NAME"`
 	languageServer *lsp.Server
 }
 
-func (d *devCmd) Run(ctx context.Context, projConfig projectconfig.Config) error {
+func (d *devCmd) Run(ctx context.Context, projConfig projectconfig.Config, adminClient admin.Client) error {
 	if len(d.Dirs) == 0 {
 		d.Dirs = projConfig.AbsModuleDirs()
 	}
@@ -37,6 +46,52 @@ func (d *devCmd) Run(ctx context.Context, projConfig projectconfig.Config) error
 
 	client := rpc.ClientFromContext[ftlv1connect.ControllerServiceClient](ctx)
 
+	var scenario *devScenario
+	if d.Scenario != "" && !d.Attach {
+		var err error
+		scenario, err = newDevScenario(projConfig, d.Scenario, adminClient, rpc.ClientFromContext[ftlv1connect.VerbServiceClient](ctx))
+		if err != nil {
+			return err
+		}
+	}
+
+	socket, err := devSocketPath()
+	if err != nil {
+		return err
+	}
+	if d.Attach {
+		return daemon.Attach(ctx, socket, log.FromContext(ctx))
+	}
+	if d.ServeCmd.Stop && daemon.Running(socket) {
+		if err := stopDevDaemon(ctx, socket); err != nil {
+			return err
+		}
+		if !d.Detach {
+			return nil
+		}
+		// The daemon ran the FTL server in-process, so it has already been stopped.
+		d.ServeCmd.Stop = false
+	}
+	if d.Detach {
+		return detachDev(ctx, socket, d.ServeCmd.StartupTimeout)
+	}
+	if d.Daemon {
+		var stop func()
+		ctx, stop, err = startDevDaemon(ctx, socket)
+		if err != nil {
+			return err
+		}
+		defer stop()
+	}
+
+	var dash *dashboard.Dashboard
+	if d.TUI {
+		ctx, dash, err = startDashboard(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
 	g, ctx := errgroup.WithContext(ctx)
 
 	if d.NoServe && d.ServeCmd.Stop {
@@ -74,7 +129,17 @@ func (d *devCmd) Run(ctx context.Context, projConfig projectconfig.Config) error
 			return err
 		}
 
-		opts := []buildengine.Option{buildengine.Parallelism(d.Parallelism)}
+		opts := []buildengine.Option{buildengine.Parallelism(d.Parallelism), buildengine.Project(projectName(projConfig))}
+		if scenario != nil {
+			if err := scenario.Start(ctx); err != nil {
+				return err
+			}
+			opts = append(opts, buildengine.WithListener(scenario))
+		}
+		if dash != nil {
+			opts = append(opts, buildengine.WithListener(dash))
+			g.Go(func() error { return dash.Run(ctx, os.Stdout, client, time.Second) })
+		}
 		if d.Lsp {
 			d.languageServer = lsp.NewServer(ctx)
 			opts = append(opts, buildengine.WithListener(d.languageServer))
@@ -88,8 +153,16 @@ func (d *devCmd) Run(ctx context.Context, projConfig projectconfig.Config) error
 		if err != nil {
 			return err
 		}
+		if d.languageServer != nil {
+			d.languageServer.SetEngine(engine)
+		}
 		return engine.Dev(ctx, d.Watch)
 	})
 
-	return g.Wait()
+	err = g.Wait()
+	if d.Daemon && errors.Is(err, context.Canceled) {
+		// Stopped via the control socket.
+		return nil
+	}
+	return err
 }
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	osExec "os/exec" //nolint:depguard
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/TBD54566975/ftl/internal/daemon"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+func devSocketPath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(homeDir, ".ftl", "ftl-dev.sock"), nil
+}
+
+// detachDev re-executes "ftl dev" as a background daemon and waits for its control socket to become available.
+func detachDev(ctx context.Context, socket string, startupTimeout time.Duration) error {
+	logger := log.FromContext(ctx)
+	if daemon.Running(socket) {
+		return errors.New("`ftl dev` is already running in the background, use --attach to follow its logs or --stop to stop it")
+	}
+	if err := os.MkdirAll(filepath.Dir(socket), 0750); err != nil {
+		return fmt.Errorf("failed to create directory for control socket: %w", err)
+	}
+
+	args := []string{}
+	for _, arg := range os.Args[1:] {
+		if arg == "--detach" || arg == "--stop" {
+			continue
+		}
+		args = append(args, arg)
+	}
+	args = append(args, "--daemon")
+
+	// Output from the daemon before its control socket is available, eg. startup failures, is kept here.
+	logPath := filepath.Join(filepath.Dir(socket), "ftl-dev.log")
+	logFile, err := os.Create(logPath)
+	if err != nil {
+		return fmt.Errorf("failed to create daemon log file: %w", err)
+	}
+	defer logFile.Close() //nolint:errcheck
+
+	cmd := osExec.Command(os.Args[0], args...)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = nil, logFile, logFile
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true}
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to start background process: %w", err)
+	}
+	exited := make(chan error, 1)
+	go func() { exited <- cmd.Wait() }()
+
+	ctx, cancel := context.WithTimeout(ctx, startupTimeout)
+	defer cancel()
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for !daemon.Running(socket) {
+		select {
+		case err := <-exited:
+			return fmt.Errorf("`ftl dev` exited during startup, see %s: %w", logPath, err)
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for `ftl dev` to start, see %s: %w", logPath, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+	logger.Infof("`ftl dev` running in background with pid %d, use --attach to follow its logs and --stop to stop it", cmd.Process.Pid)
+	return nil
+}
+
+// startDevDaemon starts serving the control socket, and returns a context
+// that is cancelled when a client requests that the daemon stop.
+//
+// Everything logged to the returned context is streamed to attached clients.
+// The returned function stops the daemon and closes the socket, and must be
+// called once everything has shut down.
+func startDevDaemon(ctx context.Context, socket string) (context.Context, func(), error) {
+	socketCtx, closeSocket := context.WithCancel(ctx)
+	ctx, cancel := context.WithCancel(ctx)
+	server, err := daemon.Listen(socket, cancel)
+	if err != nil {
+		cancel()
+		closeSocket()
+		return nil, nil, err
+	}
+	ctx = log.ContextWithLogger(ctx, log.FromContext(ctx).AddSink(server))
+	go func() {
+		if err := server.Serve(socketCtx); err != nil {
+			log.FromContext(ctx).Errorf(err, "Control socket failed")
+			cancel()
+		}
+	}()
+	return ctx, func() {
+		cancel()
+		closeSocket()
+	}, nil
+}
+
+// stopDevDaemon stops the daemon and waits for it to exit.
+func stopDevDaemon(ctx context.Context, socket string) error {
+	logger := log.FromContext(ctx)
+	if err := daemon.Stop(ctx, socket); err != nil {
+		return err
+	}
+	for daemon.Running(socket) {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+	logger.Infof("`ftl dev` stopped")
+	return nil
+}
@@ -0,0 +1,188 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/titanous/json5"
+	"golang.org/x/exp/maps"
+
+	"github.com/TBD54566975/ftl/backend/controller/admin"
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/buildengine"
+	cf "github.com/TBD54566975/ftl/common/configuration"
+	"github.com/TBD54566975/ftl/common/projectconfig"
+	"github.com/TBD54566975/ftl/go-runtime/ftl/reflection"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/modulecontext"
+)
+
+// devScenario brings a project to the state of one of the scenarios in its
+// configuration, once "ftl dev" has deployed it.
+type devScenario struct {
+	ctx      context.Context
+	name     string
+	root     string
+	scenario projectconfig.Scenario
+	admin    admin.Client
+	verbs    ftlv1connect.VerbServiceClient
+	once     sync.Once
+}
+
+var _ buildengine.Listener = (*devScenario)(nil)
+
+func newDevScenario(projConfig projectconfig.Config, name string, adminClient admin.Client, verbs ftlv1connect.VerbServiceClient) (*devScenario, error) {
+	scenario, ok := projConfig.Scenarios[name]
+	if !ok {
+		names := maps.Keys(projConfig.Scenarios)
+		sort.Strings(names)
+		if len(names) == 0 {
+			return nil, fmt.Errorf("scenario %q not found, the project configuration has no scenarios", name)
+		}
+		return nil, fmt.Errorf("scenario %q not found, available scenarios are %s", name, strings.Join(names, ", "))
+	}
+	return &devScenario{
+		name:     name,
+		root:     projConfig.Root(),
+		scenario: scenario,
+		admin:    adminClient,
+		verbs:    verbs,
+	}, nil
+}
+
+// Start sets the configuration values of the scenario, so that they are in
+// place when modules are first deployed. The rest of the scenario is applied
+// with "ctx" once the engine reports that the initial deploy succeeded.
+func (d *devScenario) Start(ctx context.Context) error {
+	d.ctx = ctx
+	keys := maps.Keys(d.scenario.Config)
+	sort.Strings(keys)
+	for _, key := range keys {
+		ref, err := cf.ParseRef(key)
+		if err != nil {
+			return fmt.Errorf("scenario %s: invalid config key %q: %w", d.name, key, err)
+		}
+		value := []byte(d.scenario.Config[key])
+		if !json.Valid(value) {
+			return fmt.Errorf("scenario %s: config %s is not valid JSON", d.name, key)
+		}
+		_, err = d.admin.ConfigSet(ctx, connect.NewRequest(&ftlv1.SetConfigRequest{
+			Ref:   configRefFromRef(ref),
+			Value: value,
+		}))
+		if err != nil {
+			return fmt.Errorf("scenario %s: failed to set config %s: %w", d.name, key, err)
+		}
+	}
+	return nil
+}
+
+func (d *devScenario) OnBuildStarted(module buildengine.Module) {}
+
+// OnBuildSuccess seeds the databases and calls the verbs of the scenario once
+// the initial deploy succeeds. Later deploys leave the data alone.
+func (d *devScenario) OnBuildSuccess() {
+	d.once.Do(func() {
+		go func() {
+			logger := log.FromContext(d.ctx)
+			if err := d.seed(d.ctx); err != nil {
+				logger.Errorf(err, "Scenario %s failed", d.name)
+				return
+			}
+			logger.Infof("Scenario %s applied", d.name)
+		}()
+	})
+}
+
+func (d *devScenario) OnBuildFailed(err error) {}
+
+func (d *devScenario) OnBuildDiagnostics(module buildengine.Module, errs []*schema.Error) {}
+
+func (d *devScenario) OnDeployProgress(module buildengine.Module, uploaded, total int64) {}
+
+func (d *devScenario) seed(ctx context.Context) error {
+	databases := maps.Keys(d.scenario.SQL)
+	sort.Strings(databases)
+	for _, database := range databases {
+		for _, file := range d.scenario.SQL[database] {
+			if err := d.execSQL(ctx, database, file); err != nil {
+				return err
+			}
+		}
+	}
+	for i, call := range d.scenario.Calls {
+		if err := d.call(ctx, call); err != nil {
+			return fmt.Errorf("call %d to %s: %w", i+1, call.Verb, err)
+		}
+	}
+	return nil
+}
+
+// execSQL executes the SQL in "file" against "database", in the form
+// <module>.<database>.
+func (d *devScenario) execSQL(ctx context.Context, database, file string) error {
+	module, name, ok := strings.Cut(database, ".")
+	if !ok {
+		return fmt.Errorf("invalid database %q, expected <module>.<database>", database)
+	}
+	sql, err := os.ReadFile(filepath.Join(d.root, file))
+	if err != nil {
+		return fmt.Errorf("failed to read SQL seed: %w", err)
+	}
+	resp, err := d.admin.SecretGet(ctx, connect.NewRequest(&ftlv1.GetSecretRequest{
+		Ref: &ftlv1.ConfigRef{Module: &module, Name: modulecontext.DSNSecretKey(module, name)},
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to get the DSN of database %s: %w", database, err)
+	}
+	var dsn string
+	if err := json.Unmarshal(resp.Msg.Value, &dsn); err != nil {
+		return fmt.Errorf("invalid DSN for database %s: %w", database, err)
+	}
+	conn, err := pgx.Connect(ctx, dsn)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database %s: %w", database, err)
+	}
+	defer conn.Close(ctx)
+	log.FromContext(ctx).Debugf("Scenario %s: executing %s against %s", d.name, file, database)
+	if _, err := conn.Exec(ctx, string(sql)); err != nil {
+		return fmt.Errorf("%s: %w", file, err)
+	}
+	return nil
+}
+
+func (d *devScenario) call(ctx context.Context, call projectconfig.ScenarioCall) error {
+	ref, err := reflection.ParseRef(call.Verb)
+	if err != nil {
+		return err
+	}
+	request := map[string]any{}
+	if call.Request != "" {
+		if err := json5.Unmarshal([]byte(call.Request), &request); err != nil {
+			return fmt.Errorf("invalid request: %w", err)
+		}
+	}
+	body, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("invalid request: %w", err)
+	}
+	log.FromContext(ctx).Debugf("Scenario %s: calling %s", d.name, ref)
+	resp, err := d.verbs.Call(ctx, connect.NewRequest(&ftlv1.CallRequest{Verb: ref.ToProto(), Body: body}))
+	if err != nil {
+		return err
+	}
+	if verr := resp.Msg.GetError(); verr != nil {
+		return fmt.Errorf("verb error: %s", verr.Message)
+	}
+	return nil
+}
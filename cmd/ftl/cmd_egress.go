@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"connectrpc.com/connect"
+	"golang.org/x/exp/maps"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+type egressCmd struct {
+	Modules []string `arg:"" optional:"" help:"Only show the external dependencies of these modules."`
+}
+
+func (e *egressCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	resp, err := client.GetSchema(ctx, connect.NewRequest(&ftlv1.GetSchemaRequest{}))
+	if err != nil {
+		return fmt.Errorf("failed to get schema: %w", err)
+	}
+	sch, err := schema.FromProto(resp.Msg.Schema)
+	if err != nil {
+		return fmt.Errorf("invalid schema: %w", err)
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "MODULE\tURL\tVERBS")
+	for _, module := range sch.Modules {
+		if len(e.Modules) > 0 && !slices.Contains(e.Modules, module.Name) {
+			continue
+		}
+		egress := module.Egress()
+		urls := maps.Keys(egress)
+		sort.Strings(urls)
+		for _, url := range urls {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", module.Name, url, strings.Join(egress[url], ", "))
+		}
+	}
+	return w.Flush()
+}
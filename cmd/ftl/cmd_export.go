@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+
+	"connectrpc.com/connect"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/backend/schema/export/gateway"
+	"github.com/TBD54566975/ftl/buildengine"
+)
+
+type exportCmd struct {
+	Gateway  exportGatewayCmd  `cmd:"" help:"Export configuration for an API gateway routing the cluster's ingress routes to FTL."`
+	Manifest exportManifestCmd `cmd:"" help:"Export a manifest of the cluster's deployments, to be applied with \"ftl apply\"."`
+}
+
+type exportGatewayCmd struct {
+	Format      gateway.Format `help:"Gateway configuration format (${enum})." enum:"aws,envoy,ingress,gateway-api" required:""`
+	Name        string         `help:"Name of the generated API, route configuration or resource." default:"ftl"`
+	Host        string         `help:"Host the routes are served on. Defaults to any host."`
+	Upstream    *url.URL       `help:"URL of the FTL ingress, for the aws format." default:"http://localhost:8891"`
+	Cluster     string         `help:"Envoy cluster of the FTL ingress, for the envoy format." default:"ftl-ingress"`
+	Namespace   string         `help:"Kubernetes namespace of the generated resource."`
+	Service     string         `help:"Kubernetes service of the FTL ingress, for the ingress and gateway-api formats." default:"ftl-ingress"`
+	ServicePort int            `help:"Port of the Kubernetes service of the FTL ingress." default:"8891"`
+	Gateway     string         `help:"Kubernetes Gateway to attach the HTTPRoute to, for the gateway-api format."`
+	Output      string         `short:"o" help:"File to write the configuration to. Defaults to stdout." type:"path" placeholder:"FILE"`
+}
+
+func (e *exportGatewayCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	resp, err := client.GetSchema(ctx, connect.NewRequest(&ftlv1.GetSchemaRequest{}))
+	if err != nil {
+		return fmt.Errorf("failed to get schema: %w", err)
+	}
+	sch, err := schema.FromProto(resp.Msg.Schema)
+	if err != nil {
+		return fmt.Errorf("invalid schema: %w", err)
+	}
+	out, err := gateway.Export(e.Format, sch, gateway.Options{
+		Name:        e.Name,
+		Host:        e.Host,
+		Upstream:    e.Upstream,
+		Cluster:     e.Cluster,
+		Namespace:   e.Namespace,
+		Service:     e.Service,
+		ServicePort: e.ServicePort,
+		Gateway:     e.Gateway,
+	})
+	if err != nil {
+		return err
+	}
+	if e.Output == "" {
+		fmt.Print(string(out))
+		return nil
+	}
+	return os.WriteFile(e.Output, out, 0600)
+}
+
+type exportManifestCmd struct {
+	Output string `short:"o" help:"File to write the manifest to. Defaults to stdout." type:"path" placeholder:"FILE"`
+}
+
+func (e *exportManifestCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	manifest, err := buildengine.ExportManifest(ctx, client)
+	if err != nil {
+		return fmt.Errorf("failed to export manifest: %w", err)
+	}
+	out, err := manifest.Marshal()
+	if err != nil {
+		return err
+	}
+	if e.Output == "" {
+		fmt.Print(string(out))
+		return nil
+	}
+	return os.WriteFile(e.Output, out, 0600)
+}
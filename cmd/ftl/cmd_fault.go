@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+)
+
+type faultCmd struct {
+	Inject faultInjectCmd `cmd:"" help:"Inject a fault into calls to the verbs of a module."`
+	List   faultListCmd   `cmd:"" help:"List injected faults."`
+	Clear  faultClearCmd  `cmd:"" help:"Clear an injected fault, or every fault."`
+}
+
+var faultKinds = map[string]ftlv1.Fault_Kind{
+	"latency":          ftlv1.Fault_KIND_LATENCY,
+	"error":            ftlv1.Fault_KIND_ERROR,
+	"drop-async":       ftlv1.Fault_KIND_DROP_ASYNC,
+	"delay-scheduling": ftlv1.Fault_KIND_DELAY_SCHEDULING,
+}
+
+type faultInjectCmd struct {
+	Kind     string        `arg:"" enum:"latency,error,drop-async,delay-scheduling" help:"Kind of fault to inject (${enum})."`
+	Verb     string        `arg:"" help:"Module to inject the fault into, or a single verb in the form <module>.<verb>."`
+	Percent  float64       `short:"p" help:"Percentage of calls to inject the fault into." default:"100"`
+	Latency  time.Duration `short:"l" help:"Latency to add to calls, for latency and delay-scheduling faults."`
+	Message  string        `short:"m" help:"Error message of failed calls, for error and drop-async faults."`
+	Duration time.Duration `short:"d" help:"How long to inject the fault for." default:"10m"`
+}
+
+func (c *faultInjectCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	module, verb := splitModuleVerb(c.Verb)
+	fault := &ftlv1.Fault{
+		Kind:    faultKinds[c.Kind],
+		Module:  module,
+		Verb:    verb,
+		Percent: c.Percent,
+	}
+	if c.Latency > 0 {
+		fault.Latency = durationpb.New(c.Latency)
+	}
+	if c.Message != "" {
+		fault.Message = &c.Message
+	}
+	resp, err := client.InjectFault(ctx, connect.NewRequest(&ftlv1.InjectFaultRequest{
+		Fault:    fault,
+		Duration: durationpb.New(c.Duration),
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to inject fault: %w", err)
+	}
+	fmt.Printf("Injected fault %d until %s\n", resp.Msg.Id, resp.Msg.ExpiresAt.AsTime().Local().Format(time.DateTime))
+	return nil
+}
+
+type faultListCmd struct{}
+
+func (c *faultListCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	resp, err := client.ListFaults(ctx, connect.NewRequest(&ftlv1.ListFaultsRequest{}))
+	if err != nil {
+		return err
+	}
+	if len(resp.Msg.Faults) == 0 {
+		fmt.Println("No faults injected.")
+		return nil
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tKIND\tTARGET\tPERCENT\tLATENCY\tEXPIRES")
+	for _, fault := range resp.Msg.Faults {
+		kind := "unknown"
+		for name, k := range faultKinds {
+			if k == fault.Kind {
+				kind = name
+			}
+		}
+		target := fault.Module
+		if fault.Verb != nil {
+			target += "." + *fault.Verb
+		}
+		latency := "-"
+		if fault.Latency != nil {
+			latency = fault.Latency.AsDuration().String()
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%g%%\t%s\t%s\n", fault.Id, kind, target, fault.Percent, latency, fault.ExpiresAt.AsTime().Local().Format(time.DateTime))
+	}
+	return w.Flush()
+}
+
+type faultClearCmd struct {
+	ID  *int64 `arg:"" optional:"" help:"ID of the fault to clear."`
+	All bool   `help:"Clear every injected fault."`
+}
+
+func (c *faultClearCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	if c.ID == nil && !c.All {
+		return errors.New("specify a fault ID or --all")
+	} else if c.ID != nil && c.All {
+		return errors.New("a fault ID can't be specified with --all")
+	}
+	resp, err := client.ClearFaults(ctx, connect.NewRequest(&ftlv1.ClearFaultsRequest{Id: c.ID}))
+	if err != nil {
+		return fmt.Errorf("failed to clear faults: %w", err)
+	}
+	fmt.Printf("Cleared %d faults\n", resp.Msg.Cleared)
+	return nil
+}
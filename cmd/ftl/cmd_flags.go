@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/types/optional"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	cf "github.com/TBD54566975/ftl/common/configuration"
+	"github.com/TBD54566975/ftl/internal/featureflags"
+)
+
+type flagsCmd struct {
+	List  flagsListCmd  `cmd:"" help:"List feature flags."`
+	Set   flagsSetCmd   `cmd:"" help:"Create or replace the rules of a feature flag."`
+	Unset flagsUnsetCmd `cmd:"" help:"Delete a feature flag."`
+}
+
+func (f *flagsCmd) Help() string {
+	return `
+Feature flags are defined per module and evaluated by modules with
+ftl.Flag("<name>").Enabled(ctx). Changes are pushed to running modules.
+`
+}
+
+type flagsListCmd struct {
+	Module string `optional:"" arg:"" placeholder:"MODULE" help:"List flags only in this module."`
+}
+
+func (f *flagsListCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	req := &ftlv1.ListFeatureFlagsRequest{}
+	if f.Module != "" {
+		req.Module = &f.Module
+	}
+	resp, err := client.ListFeatureFlags(ctx, connect.NewRequest(req))
+	if err != nil {
+		return err
+	}
+	for _, flag := range resp.Msg.Flags {
+		var rules featureflags.Flag
+		if err := json.Unmarshal(flag.Rules, &rules); err != nil {
+			fmt.Printf("%s.%s: invalid rules: %s\n", flag.Module, flag.Name, err)
+			continue
+		}
+		fmt.Printf("%s.%s: %s\n", flag.Module, flag.Name, rules)
+	}
+	return nil
+}
+
+type flagsSetCmd struct {
+	Ref        cf.Ref   `arg:"" help:"Flag reference in the form <module>.<name>."`
+	Off        bool     `help:"Turn the flag off for everyone."`
+	Percentage *int     `help:"Turn the flag on for this percentage of keys."`
+	Target     []string `help:"Turn the flag on for these keys." placeholder:"KEY"`
+}
+
+func (f *flagsSetCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	module, err := flagModule(f.Ref)
+	if err != nil {
+		return err
+	}
+	rules := featureflags.Flag{
+		Enabled:    !f.Off,
+		Percentage: optional.Ptr(f.Percentage),
+		Targets:    f.Target,
+	}
+	if err := rules.Validate(); err != nil {
+		return err
+	}
+	data, err := json.Marshal(rules)
+	if err != nil {
+		return err
+	}
+	_, err = client.SetFeatureFlag(ctx, connect.NewRequest(&ftlv1.SetFeatureFlagRequest{
+		Flag: &ftlv1.FeatureFlag{Module: module, Name: f.Ref.Name, Rules: data},
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to set flag: %w", err)
+	}
+	return nil
+}
+
+type flagsUnsetCmd struct {
+	Ref cf.Ref `arg:"" help:"Flag reference in the form <module>.<name>."`
+}
+
+func (f *flagsUnsetCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	module, err := flagModule(f.Ref)
+	if err != nil {
+		return err
+	}
+	_, err = client.UnsetFeatureFlag(ctx, connect.NewRequest(&ftlv1.UnsetFeatureFlagRequest{Module: module, Name: f.Ref.Name}))
+	if err != nil {
+		return fmt.Errorf("failed to unset flag: %w", err)
+	}
+	return nil
+}
+
+// flagModule returns the module of a flag reference, which must be set.
+func flagModule(ref cf.Ref) (string, error) {
+	module, ok := ref.Module.Get()
+	if !ok {
+		return "", errors.New("flag reference must be in the form <module>.<name>")
+	}
+	return module, nil
+}
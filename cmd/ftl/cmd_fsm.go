@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/titanous/json5"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/go-runtime/ftl/reflection"
+)
+
+type fsmCmd struct {
+	Send fsmSendCmd `cmd:"" help:"Transition an FSM instance to a new state."`
+}
+
+type fsmSendCmd struct {
+	FSM      reflection.Ref `arg:"" help:"FSM to send the event to, in the form <module>.<fsm>."`
+	Instance string         `arg:"" help:"Key of the FSM instance."`
+	State    string         `arg:"" help:"State to transition to. Verbs in other modules must be qualified with the module name."`
+	Body     string         `help:"JSON5 event payload, or @<file> to read it from a file." default:"{}"`
+}
+
+func (f *fsmSendCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	state := reflection.Ref{Name: f.State}
+	if module, name, ok := strings.Cut(f.State, "."); ok {
+		state = reflection.Ref{Module: module, Name: name}
+	}
+
+	body := []byte(f.Body)
+	if path, ok := strings.CutPrefix(f.Body, "@"); ok {
+		var err error
+		body, err = os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read event: %w", err)
+		}
+	}
+	event := map[string]any{}
+	if err := json5.Unmarshal(body, &event); err != nil {
+		return fmt.Errorf("invalid event: %w", err)
+	}
+	eventJSON, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("invalid event: %w", err)
+	}
+
+	_, err = client.SendFSMTransition(ctx, connect.NewRequest(&ftlv1.SendFSMTransitionRequest{
+		Fsm:      f.FSM.ToProto(),
+		Instance: f.Instance,
+		State:    state.ToProto(),
+		Body:     eventJSON,
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to send event: %w", err)
+	}
+	return nil
+}
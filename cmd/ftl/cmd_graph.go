@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	jsonpb "google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pbconsole "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/console"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/console/pbconsoleconnect"
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+)
+
+type graphCmd struct {
+	Live  bool          `help:"Include the call rates and latencies observed in the call log."`
+	Since time.Duration `help:"Period to observe calls over." default:"1h"`
+	JSON  bool          `help:"Output JSON."`
+}
+
+func (g *graphCmd) Run(ctx context.Context, client pbconsoleconnect.ConsoleServiceClient) error {
+	resp, err := client.GetCallGraph(ctx, connect.NewRequest(&pbconsole.GetCallGraphRequest{
+		Since: timestamppb.New(time.Now().Add(-g.Since)),
+	}))
+	if err != nil {
+		return err
+	}
+	if g.JSON {
+		data, err := jsonpb.MarshalOptions{Indent: "  "}.Marshal(resp.Msg)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s\n", data)
+		return nil
+	}
+	printCallGraph(os.Stdout, resp.Msg, g.Live)
+	return nil
+}
+
+// printCallGraph prints each module and its verbs, followed by the calls made by each verb.
+//
+// Calls that were observed but not declared are only included if "live" is set.
+func printCallGraph(w io.Writer, graph *pbconsole.GetCallGraphResponse, live bool) {
+	edges := map[string][]*pbconsole.CallGraphEdge{}
+	var external []*pbconsole.CallGraphEdge
+	for _, edge := range graph.Edges {
+		if !edge.Declared && !live {
+			continue
+		}
+		if edge.Source == nil {
+			external = append(external, edge)
+			continue
+		}
+		source := refString(edge.Source)
+		edges[source] = append(edges[source], edge)
+	}
+	for _, module := range graph.Modules {
+		fmt.Fprintf(w, "%s (%s)\n", module.Name, module.DeploymentKey)
+		for _, verb := range module.Verbs {
+			fmt.Fprintf(w, "  %s\n", verb)
+			for _, edge := range edges[module.Name+"."+verb] {
+				printCallGraphEdge(w, edge, live)
+			}
+		}
+	}
+	if len(external) > 0 {
+		fmt.Fprintf(w, "external\n")
+		for _, edge := range external {
+			printCallGraphEdge(w, edge, live)
+		}
+	}
+}
+
+func printCallGraphEdge(w io.Writer, edge *pbconsole.CallGraphEdge, live bool) {
+	line := "    -> " + refString(edge.Destination)
+	if !live {
+		fmt.Fprintln(w, line)
+		return
+	}
+	stats := []string{
+		fmt.Sprintf("%d calls", edge.Calls),
+		fmt.Sprintf("%.2f/s", edge.Rate),
+		fmt.Sprintf("%d errors", edge.Errors),
+	}
+	if edge.Calls > 0 {
+		stats = append(stats,
+			"avg "+edge.AverageLatency.AsDuration().String(),
+			"p95 "+edge.P95Latency.AsDuration().String(),
+			"max "+edge.MaxLatency.AsDuration().String(),
+		)
+	}
+	if !edge.Declared {
+		stats = append(stats, "undeclared")
+	}
+	fmt.Fprintf(w, "%s [%s]\n", line, strings.Join(stats, ", "))
+}
+
+func refString(ref *schemapb.Ref) string {
+	return ref.Module + "." + ref.Name
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TBD54566975/ftl/backend/schema/grpc"
+	"github.com/TBD54566975/ftl/common/projectconfig"
+)
+
+type importCmd struct {
+	GRPC importGRPCCmd `cmd:"" name:"grpc" help:"Import gRPC services as a Go module whose verbs proxy to them."`
+}
+
+type importGRPCCmd struct {
+	ImportPaths []string `short:"I" name:"import-path" help:"Directories to resolve proto imports from." type:"existingdir" default:"."`
+	Name        string   `help:"Name of the module. Defaults to the last component of the proto package."`
+	Dir         string   `help:"Directory to create the module in." default:"."`
+	Files       []string `arg:"" help:"Proto files defining the services." type:"existingfile"`
+}
+
+func (i importGRPCCmd) Run(ctx context.Context) error {
+	config, err := projectconfig.Load(ctx, "")
+	if err != nil {
+		return fmt.Errorf("failed to load project config: %w", err)
+	}
+	module, err := grpc.Import(ctx, i.Files, i.ImportPaths, i.Name)
+	if err != nil {
+		return err
+	}
+	return newGoCmd{Dir: i.Dir, Name: module.Schema.Name}.create(ctx, config, func(string) (map[string][]byte, error) {
+		return module.Files()
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"path/filepath"
 	"strings"
 
 	"github.com/TBD54566975/ftl"
@@ -17,11 +18,13 @@ import (
 )
 
 type initCmd struct {
-	Hermit     bool     `help:"Include Hermit language-specific toolchain binaries." negatable:""`
-	Dir        string   `arg:"" help:"Directory to initialize the project in."`
-	ModuleDirs []string `help:"Child directories of existing modules."`
-	NoGit      bool     `help:"Don't add files to the git repository."`
-	Startup    string   `help:"Command to run on startup."`
+	Hermit      bool     `help:"Include Hermit language-specific toolchain binaries." negatable:""`
+	Dir         string   `arg:"" help:"Directory to initialize the project in."`
+	ModuleDirs  []string `help:"Child directories of existing modules."`
+	NoGit       bool     `help:"Don't add files to the git repository."`
+	Startup     string   `help:"Command to run on startup."`
+	FromOpenAPI string   `name:"from-openapi" help:"Scaffold a Go module with the data types, ingress routes and verb stubs of an OpenAPI 3 spec." type:"existingfile" placeholder:"SPEC"`
+	Module      string   `help:"Name of the module scaffolded from the OpenAPI spec, defaulting to the name of the spec file."`
 }
 
 func (i initCmd) Run(ctx context.Context) error {
@@ -64,6 +67,15 @@ func (i initCmd) Run(ctx context.Context) error {
 			return err
 		}
 	}
+
+	if i.FromOpenAPI != "" {
+		module := i.Module
+		if module == "" {
+			module = strings.TrimSuffix(filepath.Base(i.FromOpenAPI), filepath.Ext(i.FromOpenAPI))
+		}
+		logger.Debugf("Scaffolding module %q from %s", module, i.FromOpenAPI)
+		return newGoCmd{Dir: i.Dir, Name: module}.create(ctx, config, fromOpenAPI(i.FromOpenAPI))
+	}
 	return nil
 }
 
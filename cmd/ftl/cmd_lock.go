@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/buildengine"
+	"github.com/TBD54566975/ftl/common/projectconfig"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+type lockCmd struct {
+	Update lockUpdateCmd `cmd:"" help:"Update ftl.lock with the current schemas of dependencies sourced from the FTL cluster."`
+}
+
+type lockUpdateCmd struct {
+	Dirs []string `arg:"" help:"Base directories containing modules (defaults to modules in project config)." type:"existingdir" optional:""`
+}
+
+func (l *lockUpdateCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient, projConfig projectconfig.Config) error {
+	if len(l.Dirs) == 0 {
+		l.Dirs = projConfig.AbsModuleDirs()
+	}
+	if len(l.Dirs) == 0 {
+		return errors.New("no directories specified")
+	}
+	engine, err := buildengine.New(ctx, client, l.Dirs)
+	if err != nil {
+		return err
+	}
+	defer engine.Close()
+	deps, err := engine.ControllerDependencies()
+	if err != nil {
+		return err
+	}
+	lock, err := buildengine.LoadLockfile(lockfilePath(projConfig))
+	if err != nil {
+		return err
+	}
+	diff := lock.Diff(deps)
+	if diff.Empty() {
+		return nil
+	}
+	fmt.Print(diff)
+	lock.Update(deps)
+	return lock.Save()
+}
+
+func lockfilePath(projConfig projectconfig.Config) string {
+	return filepath.Join(projConfig.Root(), buildengine.LockfileName)
+}
+
+// checkLockfile compares the schemas of dependencies sourced from the FTL
+// cluster against ftl.lock.
+//
+// If frozen is true any difference is an error, otherwise new dependencies are
+// added to the lockfile and changes are reported as warnings.
+func checkLockfile(ctx context.Context, projConfig projectconfig.Config, engine *buildengine.Engine, frozen bool) error {
+	logger := log.FromContext(ctx)
+	deps, err := engine.ControllerDependencies()
+	if err != nil {
+		return err
+	}
+	lock, err := buildengine.LoadLockfile(lockfilePath(projConfig))
+	if err != nil {
+		return err
+	}
+	diff := lock.Diff(deps)
+	if diff.Empty() {
+		return nil
+	}
+	if frozen {
+		return fmt.Errorf("dependencies differ from %s, run \"ftl lock update\":\n%s", lock.Path, diff)
+	}
+	if len(diff.Changed) > 0 {
+		logger.Warnf("Schemas of %v have changed since %s was updated, run \"ftl lock update\" to accept them", diff.Changed, lock.Path)
+	}
+	if len(diff.Added) == 0 {
+		return nil
+	}
+	added := map[string]*schema.Module{}
+	for _, name := range diff.Added {
+		added[name] = deps[name]
+	}
+	lock.Lock(added)
+	return lock.Save()
+}
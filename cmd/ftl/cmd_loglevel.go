@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+type logLevelCmd struct {
+	Module string        `arg:"" help:"Module to change the log level of."`
+	Level  log.Level     `arg:"" help:"Level to log at (trace, debug, info, warn, error), or \"default\" to remove the override."`
+	For    time.Duration `help:"How long to log at the level for." default:"10m"`
+}
+
+func (l *logLevelCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	resp, err := client.SetLogLevel(ctx, connect.NewRequest(&ftlv1.SetLogLevelRequest{
+		Module:   l.Module,
+		LogLevel: int32(l.Level),
+		Duration: durationpb.New(l.For),
+	}))
+	if err != nil {
+		return err
+	}
+	if resp.Msg.ExpiresAt == nil {
+		fmt.Printf("%s is logging at its default level\n", l.Module)
+		return nil
+	}
+	fmt.Printf("%s is logging at %s until %s\n", l.Module, l.Level, resp.Msg.ExpiresAt.AsTime().Local().Format(time.DateTime))
+	return nil
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"connectrpc.com/connect"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/buildengine"
+	"github.com/TBD54566975/ftl/common/projectconfig"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+type migrateCmd struct {
+	Parallelism int      `short:"j" help:"Number of modules to build in parallel." default:"${numcpu}"`
+	From        string   `arg:"" help:"Current name of the module."`
+	To          string   `arg:"" help:"New name of the module."`
+	Dirs        []string `help:"Base directories containing modules. Defaults to the project module directories." type:"existingdir"`
+	Replace     bool     `help:"Deploy the renamed module and its dependents, then scale the deployment of the old module down to zero."`
+}
+
+func (m *migrateCmd) Run(ctx context.Context, projConfig projectconfig.Config, client ftlv1connect.ControllerServiceClient) error {
+	logger := log.FromContext(ctx)
+	if len(m.Dirs) == 0 {
+		m.Dirs = projConfig.AbsModuleDirs()
+	}
+	if len(m.Dirs) == 0 {
+		return errors.New("no directories specified")
+	}
+
+	changed, err := buildengine.RenameModule(ctx, m.Dirs, m.From, m.To)
+	if err != nil {
+		return err
+	}
+	for _, path := range changed {
+		fmt.Printf("Updated %s\n", path)
+	}
+
+	// Carry over any module-specific configuration and secrets.
+	if section, ok := projConfig.Modules[m.From]; ok && projConfig.Path != "" {
+		projConfig.Modules[m.To] = section
+		delete(projConfig.Modules, m.From)
+		if err := projectconfig.Save(projConfig); err != nil {
+			return fmt.Errorf("failed to update project configuration: %w", err)
+		}
+		fmt.Printf("Updated %s\n", projConfig.Path)
+	}
+
+	if !m.Replace {
+		return nil
+	}
+	status, err := client.Status(ctx, connect.NewRequest(&ftlv1.StatusRequest{}))
+	if err != nil {
+		return err
+	}
+	engine, err := buildengine.New(ctx, client, m.Dirs, buildengine.Parallelism(m.Parallelism))
+	if err != nil {
+		return err
+	}
+	toDeploy := []string{m.To}
+	err = engine.Each(func(module buildengine.Module) error {
+		if slices.Contains(module.Dependencies, m.To) {
+			toDeploy = append(toDeploy, module.Config.Module)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if err := engine.BuildAndDeploy(ctx, 1, true, toDeploy...); err != nil {
+		return err
+	}
+	for _, deployment := range status.Msg.Deployments {
+		if deployment.Name != m.From {
+			continue
+		}
+		logger.Infof("Scaling down %s", deployment.Key)
+		_, err := client.UpdateDeploy(ctx, connect.NewRequest(&ftlv1.UpdateDeployRequest{DeploymentKey: deployment.Key}))
+		if err != nil {
+			return fmt.Errorf("failed to scale down %s: %w", deployment.Key, err)
+		}
+	}
+	return nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/TBD54566975/scaffolder"
 
 	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/backend/schema/openapi"
 	"github.com/TBD54566975/ftl/backend/schema/strcase"
 	"github.com/TBD54566975/ftl/buildengine"
 	"github.com/TBD54566975/ftl/common/projectconfig"
@@ -30,9 +31,10 @@ type newCmd struct {
 }
 
 type newGoCmd struct {
-	Replace map[string]string `short:"r" help:"Replace a module import path with a local path in the initialised FTL module." placeholder:"OLD=NEW,..." env:"FTL_INIT_GO_REPLACE"`
-	Dir     string            `arg:"" help:"Directory to initialize the module in."`
-	Name    string            `arg:"" help:"Name of the FTL module to create underneath the base directory."`
+	Replace     map[string]string `short:"r" help:"Replace a module import path with a local path in the initialised FTL module." placeholder:"OLD=NEW,..." env:"FTL_INIT_GO_REPLACE"`
+	FromOpenAPI string            `name:"from-openapi" help:"Scaffold the data types, ingress routes and verb stubs of the module from an OpenAPI 3 spec." type:"existingfile" placeholder:"SPEC"`
+	Dir         string            `arg:"" help:"Directory to initialize the module in."`
+	Name        string            `arg:"" help:"Name of the FTL module to create underneath the base directory."`
 }
 
 type newKotlinCmd struct {
@@ -41,6 +43,42 @@ type newKotlinCmd struct {
 }
 
 func (i newGoCmd) Run(ctx context.Context) error {
+	config, err := projectconfig.Load(ctx, "")
+	if err != nil {
+		return fmt.Errorf("failed to load project config: %w", err)
+	}
+	var generate func(name string) (map[string][]byte, error)
+	if i.FromOpenAPI != "" {
+		generate = fromOpenAPI(i.FromOpenAPI)
+	}
+	return i.create(ctx, config, generate)
+}
+
+// fromOpenAPI returns a generator of the source of modules scaffolded from
+// OpenAPI spec "spec".
+func fromOpenAPI(spec string) func(name string) (map[string][]byte, error) {
+	return func(name string) (map[string][]byte, error) {
+		data, err := os.ReadFile(spec)
+		if err != nil {
+			return nil, err
+		}
+		module, err := openapi.Import(data, name)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", spec, err)
+		}
+		source, err := openapi.GenerateGo(module)
+		if err != nil {
+			return nil, err
+		}
+		return map[string][]byte{strings.ToLower(strcase.ToUpperCamel(name)) + ".go": source}, nil
+	}
+}
+
+// create creates the module in the project configured by "config".
+//
+// If "generate" is non-nil, the files it returns for the module name are
+// written to the module, by name relative to the module directory.
+func (i newGoCmd) create(ctx context.Context, config projectconfig.Config, generate func(name string) (map[string][]byte, error)) error {
 	name, path, err := validateModule(i.Dir, i.Name)
 	if err != nil {
 		return err
@@ -51,9 +89,12 @@ func (i newGoCmd) Run(ctx context.Context) error {
 		return fmt.Errorf("module name %q must be a valid Go module name and not a reserved keyword", name)
 	}
 
-	config, err := projectconfig.Load(ctx, "")
-	if err != nil {
-		return fmt.Errorf("failed to load project config: %w", err)
+	var files map[string][]byte
+	if generate != nil {
+		files, err = generate(name)
+		if err != nil {
+			return err
+		}
 	}
 
 	logger := log.FromContext(ctx)
@@ -67,6 +108,15 @@ func (i newGoCmd) Run(ctx context.Context) error {
 		return err
 	}
 
+	// Written after tidying, as the imports of generated modules are only
+	// resolvable once FTL has generated their external module stubs.
+	for file, data := range files {
+		logger.Debugf("Writing %s", file)
+		if err := os.WriteFile(filepath.Join(path, file), data, 0600); err != nil {
+			return err
+		}
+	}
+
 	_, ok := internal.GitRoot(i.Dir).Get()
 	if !config.NoGit && ok {
 		logger.Debugf("Adding files to git")
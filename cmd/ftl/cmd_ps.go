@@ -3,6 +3,8 @@ package main
 import (
 	"context"
 	"fmt"
+	"io"
+	"os"
 	"sort"
 	"strings"
 
@@ -70,7 +72,7 @@ func (s *psCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceCl
 					}
 					runnerLabels = string(labels)
 				}
-				args := []any{key, fmt.Sprintf("%d/%d", i+1, first.MinReplicas), "live", runnerKey, endpoint}
+				args := []any{key, fmt.Sprintf("%d/%d", i+1, first.MinReplicas), processState(process), runnerKey, endpoint}
 				if s.Verbose > 1 {
 					labels, err := jsonpb.Marshal(first.Labels)
 					if err != nil {
@@ -89,12 +91,68 @@ func (s *psCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceCl
 			}
 		}
 	} else {
-		// Format: deployment, N/M replicas running
-		format := "%-30s %d/%d\n"
-		fmt.Printf("%-30s %s\n", "DEPLOYMENT", "REPLICAS")
+		// Format: deployment, N/M replicas running, owner, description
+		format := "%-30s %-10s %-20s %s\n"
+		fmt.Printf(format, "DEPLOYMENT", "REPLICAS", "OWNER", "DESCRIPTION")
 		for _, key := range keys {
-			fmt.Printf(format, key, len(groups[key]), groups[key][0].MinReplicas)
+			first := groups[key][0]
+			replicas := len(groups[key])
+			if first.Runner == nil {
+				replicas = 0
+			}
+			owner := first.GetOwner()
+			if owner == "" {
+				owner = "-"
+			}
+			description := truncateDescription(first.GetDescription(), 60)
+			if first.Unhealthy {
+				description = fmt.Sprintf("unhealthy, crashed %d times in a row", first.Crashes)
+			}
+			fmt.Printf(format, key, fmt.Sprintf("%d/%d", replicas, first.MinReplicas), owner, description)
 		}
 	}
+
+	// Older controllers don't measure SLOs, so this is best effort.
+	if slos, err := client.GetSLOStatus(ctx, connect.NewRequest(&ftlv1.GetSLOStatusRequest{})); err == nil {
+		warnBurningErrorBudgets(os.Stderr, slos.Msg)
+	}
 	return nil
 }
+
+// processState returns the state of a replica of a deployment.
+func processState(process *ftlv1.ProcessListResponse_Process) string {
+	if process.Unhealthy {
+		return "unhealthy"
+	}
+	return "live"
+}
+
+// truncateDescription truncates a description to at most "width" runes.
+func truncateDescription(description string, width int) string {
+	runes := []rune(description)
+	if len(runes) <= width {
+		return description
+	}
+	return string(runes[:width-1]) + "…"
+}
+
+// warnBurningErrorBudgets warns about each module with verbs that are
+// consuming their error budget faster than it can be sustained.
+func warnBurningErrorBudgets(w io.Writer, slos *ftlv1.GetSLOStatusResponse) {
+	burning := map[string][]*ftlv1.VerbSLOStatus{}
+	for _, verb := range slos.Verbs {
+		if verb.Burning {
+			burning[verb.Verb.Module] = append(burning[verb.Verb.Module], verb)
+		}
+	}
+	modules := maps.Keys(burning)
+	sort.Strings(modules)
+	for _, module := range modules {
+		fmt.Fprintf(w, "warning: module %s is burning its error budget\n", module)
+		for _, verb := range burning[module] {
+			fmt.Fprintf(w, "  %s.%s: burn rate %.1fx over the last hour, %.0f%% of the %s budget left (target %.2f%%, actual %.2f%%)\n",
+				verb.Verb.Module, verb.Verb.Name, verb.BurnRate, verb.ErrorBudgetRemaining*100, slos.Window.AsDuration(),
+				verb.AvailabilityTarget*100, verb.Availability*100)
+		}
+	}
+}
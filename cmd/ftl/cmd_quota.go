@@ -0,0 +1,42 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"connectrpc.com/connect"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+)
+
+type quotaCmd struct {
+	Project string `arg:"" optional:"" help:"Only show the quotas of this project and its modules, defaulting to --project."`
+}
+
+func (q *quotaCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	req := &ftlv1.GetQuotaUsageRequest{}
+	if q.Project != "" {
+		req.Project = &q.Project
+	}
+	resp, err := client.GetQuotaUsage(ctx, connect.NewRequest(req))
+	if err != nil {
+		return err
+	}
+	if len(resp.Msg.Usage) == 0 {
+		fmt.Println("No quotas are configured.")
+		return nil
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "SCOPE\tQUOTA\tUSED\tLIMIT")
+	for _, usage := range resp.Msg.Usage {
+		scope := usage.Scope
+		if scope == "" {
+			scope = "(no project)"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\n", scope, usage.Quota, usage.Used, usage.Limit)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+)
+
+type reconcileCmd struct {
+	Module string `arg:"" help:"Module to reconcile."`
+}
+
+func (r *reconcileCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	resp, err := client.Reconcile(ctx, connect.NewRequest(&ftlv1.ReconcileRequest{Module: r.Module}))
+	if err != nil {
+		return err
+	}
+	if len(resp.Msg.Deployments) == 0 {
+		fmt.Printf("%s does not need reconciliation\n", r.Module)
+		return nil
+	}
+	failed := 0
+	for _, deployment := range resp.Msg.Deployments {
+		if deployment.Error != nil {
+			failed++
+			fmt.Printf("%s: %d/%d replicas: %s\n", deployment.DeploymentKey, deployment.AssignedReplicas, deployment.RequiredReplicas, *deployment.Error)
+		} else {
+			fmt.Printf("%s: %d/%d replicas: reconciled\n", deployment.DeploymentKey, deployment.AssignedReplicas, deployment.RequiredReplicas)
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to reconcile %d deployment(s) of %s", failed, r.Module)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+	"github.com/TBD54566975/ftl/go-runtime/ftl/reflection"
+)
+
+type sagaCmd struct {
+	Status sagaStatusCmd `cmd:"" help:"Show the progress of a saga instance."`
+}
+
+type sagaStatusCmd struct {
+	Saga     reflection.Ref `arg:"" help:"Saga to show, in the form <module>.<saga>."`
+	Instance string         `arg:"" help:"Key of the saga instance."`
+}
+
+func (s *sagaStatusCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	resp, err := client.GetSaga(ctx, connect.NewRequest(&ftlv1.GetSagaRequest{
+		Saga:     s.Saga.ToProto(),
+		Instance: s.Instance,
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to get saga: %w", err)
+	}
+	status := strings.ToLower(strings.TrimPrefix(resp.Msg.Status.String(), "STATUS_"))
+	fmt.Printf("Status:    %s\n", status)
+	fmt.Printf("Completed: %s\n", sagaStateString(resp.Msg.CurrentState))
+	fmt.Printf("Executing: %s\n", sagaStateString(resp.Msg.ExecutingState))
+	return nil
+}
+
+func sagaStateString(state *schemapb.Ref) string {
+	if state == nil {
+		return "-"
+	}
+	return state.Module + "." + state.Name
+}
@@ -5,4 +5,5 @@ type schemaCmd struct {
 	Protobuf schemaProtobufCmd `cmd:"" help:"Generate protobuf schema mirroring the FTL schema structure."`
 	Generate schemaGenerateCmd `cmd:"" help:"Stream the schema from the cluster and generate files from the template."`
 	Import   schemaImportCmd   `cmd:"" help:"Import messages to the FTL schema."`
+	Export   schemaExportCmd   `cmd:"" help:"Export module data types as JSON Schema, protobuf, Go or Kotlin."`
 }
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"connectrpc.com/connect"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/backend/schema/export"
+)
+
+type schemaExportCmd struct {
+	Format  export.Format `help:"Output format (${enum})." enum:"jsonschema,proto,go,kotlin" default:"jsonschema"`
+	Output  string        `short:"o" help:"Directory to write one file per module to. Defaults to stdout." type:"path" placeholder:"DIR"`
+	Modules []string      `arg:"" help:"Modules to export. Defaults to all modules other than builtin." optional:""`
+}
+
+func (s *schemaExportCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	resp, err := client.GetSchema(ctx, connect.NewRequest(&ftlv1.GetSchemaRequest{}))
+	if err != nil {
+		return fmt.Errorf("failed to get schema: %w", err)
+	}
+	sch, err := schema.FromProto(resp.Msg.Schema)
+	if err != nil {
+		return fmt.Errorf("invalid schema: %w", err)
+	}
+	modules := s.Modules
+	if len(modules) == 0 {
+		for _, module := range sch.Modules {
+			if !module.Builtin {
+				modules = append(modules, module.Name)
+			}
+		}
+	}
+	if s.Output != "" {
+		if err := os.MkdirAll(s.Output, 0750); err != nil {
+			return err
+		}
+	}
+	for _, module := range modules {
+		out, err := export.Module(s.Format, sch, module)
+		if err != nil {
+			return err
+		}
+		if s.Output == "" {
+			fmt.Print(string(out))
+			continue
+		}
+		path := filepath.Join(s.Output, module+s.Format.Extension())
+		if err := os.WriteFile(path, out, 0600); err != nil {
+			return err
+		}
+	}
+	return nil
+}
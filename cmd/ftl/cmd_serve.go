@@ -16,14 +16,17 @@ import (
 	"connectrpc.com/connect"
 	"github.com/alecthomas/kong"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jpillora/backoff"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/TBD54566975/ftl/backend/controller"
 	"github.com/TBD54566975/ftl/backend/controller/dal"
 	"github.com/TBD54566975/ftl/backend/controller/scaling/localscaling"
+	"github.com/TBD54566975/ftl/backend/controller/sql"
 	"github.com/TBD54566975/ftl/backend/controller/sql/databasetesting"
 	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
 	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	cfdal "github.com/TBD54566975/ftl/common/configuration/dal"
 	"github.com/TBD54566975/ftl/common/projectconfig"
 	"github.com/TBD54566975/ftl/internal/bind"
 	"github.com/TBD54566975/ftl/internal/container"
@@ -34,13 +37,16 @@ import (
 )
 
 type serveCmd struct {
-	Bind           *url.URL      `help:"Starting endpoint to bind to and advertise to. Each controller, ingress and runner will increment the port by 1" default:"http://localhost:8891"`
-	DBPort         int           `help:"Port to use for the database." default:"15432"`
-	Recreate       bool          `help:"Recreate the database even if it already exists." default:"false"`
-	Controllers    int           `short:"c" help:"Number of controllers to start." default:"1"`
-	Background     bool          `help:"Run in the background." default:"false"`
-	Stop           bool          `help:"Stop the running FTL instance. Can be used with --background to restart the server" default:"false"`
-	StartupTimeout time.Duration `help:"Timeout for the server to start up." default:"1m"`
+	Bind           *url.URL       `help:"Starting endpoint to bind to and advertise to. Each controller, ingress and runner will increment the port by 1" default:"http://localhost:8891"`
+	DBPort         int            `help:"Port to use for the database." default:"15432"`
+	Recreate       bool           `help:"Recreate the database even if it already exists." default:"false"`
+	Controllers    int            `short:"c" help:"Number of controllers to start." default:"1"`
+	Background     bool           `help:"Run in the background." default:"false"`
+	Stop           bool           `help:"Stop the running FTL instance. Can be used with --background to restart the server" default:"false"`
+	StartupTimeout time.Duration  `help:"Timeout for the server to start up." default:"1m"`
+	DSN            string         `help:"Use this Postgres database, which also stores deployment artefacts, rather than starting a local container."`
+	Ports          map[string]int `help:"Fixed ports for processes started by serve, eg. controller0, ingress0 and runner0, overriding the ports persisted in the project's .ftl directory." mapsep:"," placeholder:"NAME=PORT"`
+	Gateway        gatewayFlags   `embed:""`
 	controller.CommonConfig
 }
 
@@ -84,34 +90,71 @@ func (s *serveCmd) Run(ctx context.Context, projConfig projectconfig.Config) err
 	if err != nil {
 		return err
 	}
-	conn, err := pgxpool.New(ctx, dsn)
+
+	// Persist ports so that they remain stable across restarts.
+	portsPath := ""
+	if stateDir, ok := projConfig.StateDir().Get(); ok {
+		portsPath = filepath.Join(stateDir, "ports.json")
+	}
+	bindAllocator, err := bind.NewPersistentBindAllocator(s.Bind, portsPath, s.Ports)
 	if err != nil {
 		return err
 	}
-	dal, err := dal.New(ctx, conn)
+	ingressAddresses, controllerAddresses, err := allocateControllerAddresses(ctx, bindAllocator, s.Controllers)
 	if err != nil {
 		return err
 	}
+	return s.serve(ctx, projConfig, dsn, bindAllocator, ingressAddresses, controllerAddresses)
+}
 
-	wg, ctx := errgroup.WithContext(ctx)
+// allocateControllerAddresses allocates ingress and controller bind addresses for n controllers.
+func allocateControllerAddresses(ctx context.Context, bindAllocator *bind.BindAllocator, n int) (ingressAddresses, controllerAddresses []*url.URL, err error) {
+	controllerAddresses = make([]*url.URL, 0, n)
+	ingressAddresses = make([]*url.URL, 0, n)
+	for i := range n {
+		ingress, err := bindAllocator.NextFor(ctx, fmt.Sprintf("ingress%d", i))
+		if err != nil {
+			return nil, nil, err
+		}
+		controller, err := bindAllocator.NextFor(ctx, fmt.Sprintf("controller%d", i))
+		if err != nil {
+			return nil, nil, err
+		}
+		ingressAddresses = append(ingressAddresses, ingress)
+		controllerAddresses = append(controllerAddresses, controller)
+	}
+	return ingressAddresses, controllerAddresses, nil
+}
 
-	bindAllocator, err := bind.NewBindAllocator(s.Bind)
+// serve starts the controllers against an already initialised database and blocks until they exit.
+func (s *serveCmd) serve(ctx context.Context, projConfig projectconfig.Config, dsn string, bindAllocator *bind.BindAllocator, ingressAddresses, controllerAddresses []*url.URL) error {
+	logger := log.FromContext(ctx)
+	// The cluster is shared by every project, so its own requests are unscoped.
+	ctx = rpc.WithProject(ctx, "")
+	conn, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		return err
+	}
+	dal, err := dal.New(ctx, conn)
+	if err != nil {
+		return err
+	}
+	configDAL, err := cfdal.New(ctx, conn)
 	if err != nil {
 		return err
 	}
 
-	controllerAddresses := make([]*url.URL, 0, s.Controllers)
-	ingressAddresses := make([]*url.URL, 0, s.Controllers)
-	for range s.Controllers {
-		ingressAddresses = append(ingressAddresses, bindAllocator.Next())
-		controllerAddresses = append(controllerAddresses, bindAllocator.Next())
+	wg, ctx := errgroup.WithContext(ctx)
+
+	if err := s.Gateway.start(ctx, wg, controllerAddresses[0], ingressAddresses[0]); err != nil {
+		return err
 	}
 
 	runnerScaling, err := localscaling.NewLocalScaling(bindAllocator, controllerAddresses)
 	if err != nil {
 		return err
 	}
-	for i := range s.Controllers {
+	for i := range controllerAddresses {
 		config := controller.Config{
 			CommonConfig: s.CommonConfig,
 			Bind:         controllerAddresses[i],
@@ -128,7 +171,7 @@ func (s *serveCmd) Run(ctx context.Context, projConfig projectconfig.Config) err
 		controllerCtx := log.ContextWithLogger(ctx, logger.Scope(scope))
 
 		wg.Go(func() error {
-			if err := controller.Start(controllerCtx, config, runnerScaling, dal); err != nil {
+			if err := controller.Start(controllerCtx, config, runnerScaling, dal, configDAL, nil); err != nil {
 				return fmt.Errorf("controller%d failed: %w", i, err)
 			}
 			return nil
@@ -268,49 +311,66 @@ func isServeRunning(logger *log.Logger) (bool, error) {
 }
 
 func (s *serveCmd) setupDB(ctx context.Context) (string, error) {
+	if s.DSN != "" {
+		if err := sql.Migrate(ctx, s.DSN); err != nil {
+			return "", fmt.Errorf("failed to migrate database: %w", err)
+		}
+		return s.DSN, nil
+	}
+	return setupDB(ctx, ftlContainerName, s.DBPort, s.Recreate)
+}
+
+// setupDB starts (creating if necessary) a Postgres container and returns the DSN of the initialised FTL database.
+func setupDB(ctx context.Context, containerName string, dbPort int, recreate bool) (string, error) {
 	logger := log.FromContext(ctx)
 
-	recreate := s.Recreate
-	port := s.DBPort
+	port := dbPort
 
-	exists, err := container.DoesExist(ctx, ftlContainerName)
+	exists, err := container.DoesExist(ctx, containerName)
 	if err != nil {
 		return "", err
 	}
 
 	if !exists {
-		logger.Debugf("Creating docker container '%s' for postgres db", ftlContainerName)
+		logger.Debugf("Creating docker container '%s' for postgres db", containerName)
 
-		// check if port s.DBPort is already in use
-		if l, err := net.Listen("tcp", fmt.Sprintf(":%d", s.DBPort)); err != nil {
-			return "", fmt.Errorf("port %d is already in use", s.DBPort)
+		// check if port dbPort is already in use
+		if l, err := net.Listen("tcp", fmt.Sprintf(":%d", dbPort)); err != nil {
+			return "", fmt.Errorf("port %d is already in use", dbPort)
 		} else if err = l.Close(); err != nil {
 			return "", fmt.Errorf("failed to close listener: %w", err)
 		}
 
-		err = container.RunDB(ctx, ftlContainerName, s.DBPort)
+		err = container.RunDB(ctx, containerName, dbPort)
 		if err != nil {
 			return "", err
 		}
+		if dbPort == 0 {
+			// Docker picked a free port.
+			port, err = container.GetContainerPort(ctx, containerName, 5432)
+			if err != nil {
+				return "", err
+			}
+		}
 
 		recreate = true
 	} else {
 		// Start the existing container
-		err = container.Start(ctx, ftlContainerName)
+		err = container.Start(ctx, containerName)
 		if err != nil {
 			return "", err
 		}
 
 		// Grab the port from the existing container
-		port, err = container.GetContainerPort(ctx, ftlContainerName, 5432)
+		port, err = container.GetContainerPort(ctx, containerName, 5432)
 		if err != nil {
 			return "", err
 		}
 
-		logger.Debugf("Reusing existing docker container %s on port %d for postgres db", ftlContainerName, port)
+		logger.Debugf("Reusing existing docker container %s on port %d for postgres db", containerName, port)
 	}
 
-	err = container.PollContainerHealth(ctx, ftlContainerName, 10*time.Second)
+	err = container.PollContainerHealth(ctx, containerName, 10*time.Second)
 	if err != nil {
 		return "", fmt.Errorf("db container failed to be healthy: %w", err)
 	}
@@ -333,27 +393,20 @@ func waitForControllerOnline(ctx context.Context, startupTimeout time.Duration,
 	ctx, cancel := context.WithTimeout(ctx, startupTimeout)
 	defer cancel()
 
-	ticker := time.NewTicker(time.Second)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ticker.C:
-			_, err := client.Status(ctx, connect.NewRequest(&ftlv1.StatusRequest{}))
-			if err != nil {
-				logger.Tracef("Error getting status, retrying...: %v", err)
-				continue // retry
-			}
-
-			return nil
-
-		case <-ctx.Done():
-			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
-				logger.Errorf(ctx.Err(), "Timeout reached while polling for controller status")
-			}
+	ctx = rpc.WithRetryPolicy(ctx, rpc.RetryPolicy{
+		Backoff: backoff.Backoff{Min: time.Millisecond * 100, Max: time.Second},
+		// Any error while the controller is starting up is transient.
+		Codes: []connect.Code{connect.CodeUnavailable, connect.CodeUnknown, connect.CodeInternal},
+	})
+	_, err := client.Status(ctx, connect.NewRequest(&ftlv1.StatusRequest{}))
+	if err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			logger.Errorf(ctx.Err(), "Timeout reached while polling for controller status")
 			return ctx.Err()
 		}
+		return err
 	}
+	return nil
 }
 
 func (s *serveCmd) isRunning(ctx context.Context, client ftlv1connect.ControllerServiceClient) bool {
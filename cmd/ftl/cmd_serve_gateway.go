@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/TBD54566975/ftl/internal/gateway"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+// gatewayFlags configure public, authenticated endpoints for a shared
+// development environment.
+type gatewayFlags struct {
+	Provision     bool     `help:"Expose the controller and ingress on public endpoints with TLS and token authentication, for use as a shared development environment." group:"Provisioning:"`
+	PublicBind    *url.URL `help:"Public endpoint for the controller." default:"https://0.0.0.0:8443" group:"Provisioning:"`
+	PublicIngress *url.URL `help:"Public endpoint for ingress." default:"https://0.0.0.0:8444" group:"Provisioning:"`
+	TLSCert       string   `help:"TLS certificate file." type:"existingfile" group:"Provisioning:"`
+	TLSKey        string   `help:"TLS private key file." type:"existingfile" group:"Provisioning:"`
+	ACMEDomains   []string `name:"acme-domain" help:"Obtain TLS certificates for these domains from Let's Encrypt. The public endpoints must be reachable on port 443." group:"Provisioning:"`
+	ACMECache     string   `help:"Directory to cache ACME certificates in." default:"~/.ftl/acme" type:"path" group:"Provisioning:"`
+	AuthToken     string   `help:"Bearer token required by the public controller endpoint. Generated if not provided." env:"FTL_AUTH_TOKEN" group:"Provisioning:"`
+}
+
+// start the public gateways in front of the local controller and ingress, if provisioning is enabled.
+func (g *gatewayFlags) start(ctx context.Context, wg *errgroup.Group, controller, ingress *url.URL) error {
+	if !g.Provision {
+		return nil
+	}
+	logger := log.FromContext(ctx).Scope("gateway")
+	if g.PublicBind.Scheme != "https" || g.PublicIngress.Scheme != "https" {
+		return errors.New("public endpoints must use https")
+	}
+	tlsConfig, err := gateway.TLSConfig(g.TLSCert, g.TLSKey, g.ACMEDomains, g.ACMECache)
+	if err != nil {
+		return err
+	}
+	token := g.AuthToken
+	if token == "" {
+		token, err = gateway.GenerateToken()
+		if err != nil {
+			return fmt.Errorf("failed to generate auth token: %w", err)
+		}
+		logger.Infof("Generated auth token: %s", token)
+	}
+	logger.Infof("Controller available at %s, ingress at %s", g.PublicBind, g.PublicIngress)
+	logger.Infof("Clients must authenticate with an authenticator that outputs \"Authorization: Bearer <token>\"")
+
+	ctx = log.ContextWithLogger(ctx, logger)
+	wg.Go(func() error {
+		if err := gateway.Serve(ctx, g.PublicBind, tlsConfig, gateway.Handler(controller, token)); err != nil {
+			return fmt.Errorf("controller gateway failed: %w", err)
+		}
+		return nil
+	})
+	wg.Go(func() error {
+		if err := gateway.Serve(ctx, g.PublicIngress, tlsConfig, gateway.Handler(ingress, "")); err != nil {
+			return fmt.Errorf("ingress gateway failed: %w", err)
+		}
+		return nil
+	})
+	return nil
+}
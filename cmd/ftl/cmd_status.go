@@ -2,13 +2,19 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"time"
 
 	"connectrpc.com/connect"
+	"github.com/mattn/go-isatty"
 	jsonpb "google.golang.org/protobuf/encoding/protojson"
 
 	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
 	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/dashboard"
+	"github.com/TBD54566975/ftl/internal/log"
 )
 
 type statusCmd struct {
@@ -17,9 +23,17 @@ type statusCmd struct {
 	AllRunners       bool `help:"Show all runners, even those that are not running."`
 	AllIngressRoutes bool `help:"Show all ingress routes, even those that are not running."`
 	Schema           bool `help:"Show schema."`
+	Watch            bool `help:"Show a live dashboard of cluster status." short:"w"`
 }
 
 func (s *statusCmd) Run(ctx context.Context, client ftlv1connect.ControllerServiceClient) error {
+	if s.Watch {
+		ctx, dash, err := startDashboard(ctx)
+		if err != nil {
+			return err
+		}
+		return dash.Run(ctx, os.Stdout, client, time.Second)
+	}
 	status, err := client.Status(ctx, connect.NewRequest(&ftlv1.StatusRequest{}))
 	if err != nil {
 		return err
@@ -38,3 +52,13 @@ func (s *statusCmd) Run(ctx context.Context, client ftlv1connect.ControllerServi
 	fmt.Printf("%s\n", data)
 	return nil
 }
+
+// startDashboard creates a dashboard and returns a context whose logger writes into it.
+func startDashboard(ctx context.Context) (context.Context, *dashboard.Dashboard, error) {
+	if !isatty.IsTerminal(os.Stdout.Fd()) {
+		return nil, nil, errors.New("the dashboard requires a terminal")
+	}
+	dash := dashboard.New()
+	logger := log.FromContext(ctx)
+	return log.ContextWithLogger(ctx, log.New(logger.GetLevel(), dash)), dash, nil
+}
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/alecthomas/kong"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/buildengine"
+	"github.com/TBD54566975/ftl/common/projectconfig"
+	"github.com/TBD54566975/ftl/internal/bind"
+	"github.com/TBD54566975/ftl/internal/container"
+	"github.com/TBD54566975/ftl/internal/exec"
+	"github.com/TBD54566975/ftl/internal/junit"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/rpc"
+)
+
+type testCmd struct {
+	Parallelism    int           `short:"j" help:"Number of modules to build in parallel." default:"${numcpu}"`
+	Modules        []string      `arg:"" help:"Modules to test. Defaults to all modules in the project." optional:""`
+	Dirs           []string      `help:"Base directories containing modules. Defaults to the project module directories." type:"existingdir"`
+	Bind           *url.URL      `help:"Starting endpoint for the ephemeral FTL cluster." default:"http://localhost:8991"`
+	DBPort         int           `help:"Port to use for the ephemeral database, or 0 for any free port." default:"0"`
+	JUnit          string        `help:"Write JUnit XML test results to FILE." placeholder:"FILE"`
+	StartupTimeout time.Duration `help:"Timeout for the ephemeral cluster to start up." default:"1m"`
+}
+
+func (t *testCmd) Run(ctx context.Context, projConfig projectconfig.Config) error {
+	logger := log.FromContext(ctx)
+	if len(t.Dirs) == 0 {
+		t.Dirs = projConfig.AbsModuleDirs()
+	}
+	if len(t.Dirs) == 0 {
+		return errors.New("no directories specified")
+	}
+
+	logger.Infof("Starting ephemeral FTL cluster")
+	// Name the database container after this process so that concurrent runs
+	// don't share it.
+	containerName := fmt.Sprintf("ftl-test-db-%d", os.Getpid())
+	dsn, err := setupDB(ctx, containerName, t.DBPort, true)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// Use a fresh context so that teardown happens even if we were cancelled.
+		if err := container.Remove(context.WithoutCancel(ctx), containerName); err != nil {
+			logger.Warnf("Failed to remove test database: %s", err)
+		}
+	}()
+
+	bindAllocator, err := bind.NewBindAllocator(t.Bind)
+	if err != nil {
+		return err
+	}
+	ingressAddresses, controllerAddresses, err := allocateControllerAddresses(ctx, bindAllocator, 1)
+	if err != nil {
+		return err
+	}
+	serve := &serveCmd{Bind: t.Bind, Controllers: 1, StartupTimeout: t.StartupTimeout}
+	if err := kong.ApplyDefaults(&serve.CommonConfig); err != nil {
+		return err
+	}
+
+	clusterCtx, stopCluster := context.WithCancel(ctx)
+	cluster, clusterCtx := errgroup.WithContext(clusterCtx)
+	cluster.Go(func() error {
+		return serve.serve(clusterCtx, projConfig, dsn, bindAllocator, ingressAddresses, controllerAddresses)
+	})
+	defer func() {
+		stopCluster()
+		_ = cluster.Wait() //nolint:errcheck // the cluster always exits with a cancellation error
+	}()
+
+	endpoint := controllerAddresses[0]
+	client := rpc.Dial(ftlv1connect.NewControllerServiceClient, endpoint.String(), log.Error)
+	if err := waitForControllerOnline(clusterCtx, t.StartupTimeout, client); err != nil {
+		return fmt.Errorf("ephemeral cluster failed to start: %w", err)
+	}
+
+	engine, err := buildengine.New(clusterCtx, client, t.Dirs, buildengine.Parallelism(t.Parallelism), buildengine.Project(projConfig.ProjectName()))
+	if err != nil {
+		return err
+	}
+	if len(t.Modules) == 0 {
+		t.Modules = engine.Modules()
+	}
+
+	// Deploy the modules under test along with all of their local dependencies.
+	graph, err := engine.Graph(t.Modules...)
+	if err != nil {
+		return err
+	}
+	local := map[string]buildengine.Module{}
+	err = engine.Each(func(m buildengine.Module) error {
+		local[m.Config.Module] = m
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	toDeploy := []string{}
+	for name := range graph {
+		if _, ok := local[name]; ok {
+			toDeploy = append(toDeploy, name)
+		}
+	}
+	if err := engine.BuildAndDeploy(clusterCtx, 1, true, toDeploy...); err != nil {
+		return err
+	}
+
+	report := &junit.TestSuites{}
+	for _, name := range t.Modules {
+		module := local[name]
+		if module.Config.Language != "go" {
+			logger.Warnf("Skipping tests for %s: %s modules are not supported", name, module.Config.Language)
+			continue
+		}
+		moduleReport, err := t.runGoTests(clusterCtx, module, endpoint)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		report.Merge(moduleReport)
+	}
+
+	if t.JUnit != "" {
+		w, err := os.Create(t.JUnit)
+		if err != nil {
+			return err
+		}
+		defer w.Close() //nolint:errcheck
+		if err := report.Write(w); err != nil {
+			return fmt.Errorf("failed to write JUnit report: %w", err)
+		}
+	}
+	if report.Failed() {
+		return fmt.Errorf("%d of %d tests failed", report.Failures, report.Tests)
+	}
+	logger.Infof("All %d tests passed", report.Tests)
+	return nil
+}
+
+func (t *testCmd) runGoTests(ctx context.Context, module buildengine.Module, endpoint *url.URL) (*junit.TestSuites, error) {
+	logger := log.FromContext(ctx).Scope(module.Config.Module)
+	cmd := exec.Command(ctx, log.Debug, module.Config.Dir, "go", "test", "-json", "./...")
+	// Read by ftltest.Context to make calls through the cluster.
+	cmd.Env = append(cmd.Env, "FTL_TEST_ENDPOINT="+endpoint.String())
+	cmd.Stdout = nil
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	report, parseErr := junit.FromGoTestJSON(stdout, logger.WriterAt(log.Info))
+	// Drain any remaining output so that the process can exit.
+	_, _ = io.Copy(io.Discard, stdout) //nolint:errcheck
+	waitErr := cmd.Wait()
+	if parseErr != nil {
+		return nil, parseErr
+	}
+	// "go test" exits non-zero when tests fail, which is reflected in the
+	// report. Otherwise it failed without running any tests, eg. because of a
+	// toolchain error.
+	if waitErr != nil && !report.Failed() {
+		return nil, fmt.Errorf("go test failed: %w", waitErr)
+	}
+	return report, nil
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"connectrpc.com/connect"
+	jsonpb "google.golang.org/protobuf/encoding/protojson"
+
+	pbconsole "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/console"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/console/pbconsoleconnect"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+type traceCmd struct {
+	Key  string `arg:"" help:"Request key to trace, eg. from the \"request\" attribute of a log line."`
+	JSON bool   `help:"Output JSON."`
+}
+
+func (t *traceCmd) Run(ctx context.Context, client pbconsoleconnect.ConsoleServiceClient) error {
+	resp, err := client.GetRequestTimeline(ctx, connect.NewRequest(&pbconsole.GetRequestTimelineRequest{RequestKey: t.Key}))
+	if err != nil {
+		return err
+	}
+	if t.JSON {
+		data, err := jsonpb.MarshalOptions{Indent: "  "}.Marshal(resp.Msg)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s\n", data)
+		return nil
+	}
+	printRequestTimeline(os.Stdout, resp.Msg)
+	return nil
+}
+
+// printRequestTimeline prints each entry of a request timeline, prefixed by
+// its offset from the start of the request.
+func printRequestTimeline(w io.Writer, timeline *pbconsole.GetRequestTimelineResponse) {
+	fmt.Fprintln(w, timeline.RequestKey)
+	var start time.Time
+	if len(timeline.Entries) > 0 && timeline.Entries[0].TimeStamp != nil {
+		start = timeline.Entries[0].TimeStamp.AsTime()
+	}
+	for _, entry := range timeline.Entries {
+		offset := "?"
+		if entry.TimeStamp != nil {
+			offset = "+" + entry.TimeStamp.AsTime().Sub(start).String()
+		}
+		fmt.Fprintf(w, "  %10s  %s\n", offset, describeTimelineEntry(entry))
+	}
+}
+
+func describeTimelineEntry(entry *pbconsole.RequestTimelineEntry) string {
+	switch entry := entry.Entry.(type) {
+	case *pbconsole.RequestTimelineEntry_Ingress:
+		out := fmt.Sprintf("%s %s", entry.Ingress.Origin, entry.Ingress.Description)
+		if entry.Ingress.SourceAddr != "" {
+			out += " from " + entry.Ingress.SourceAddr
+		}
+		return out
+
+	case *pbconsole.RequestTimelineEntry_Call:
+		call := entry.Call
+		source := "external"
+		if call.SourceVerbRef != nil {
+			source = refString(call.SourceVerbRef)
+		}
+		out := fmt.Sprintf("call %s -> %s (%s)", source, refString(call.DestinationVerbRef), call.Duration.AsDuration())
+		if call.Error != nil {
+			out += ": error: " + *call.Error
+		}
+		return out
+
+	case *pbconsole.RequestTimelineEntry_Log:
+		logEntry := entry.Log
+		out := fmt.Sprintf("log %s %s", log.Level(logEntry.LogLevel), logEntry.Message)
+		if logEntry.Error != nil {
+			out += ": " + *logEntry.Error
+		}
+		return out
+
+	case *pbconsole.RequestTimelineEntry_FsmTransition:
+		transition := entry.FsmTransition
+		out := fmt.Sprintf("fsm %s[%s] -> %s (%s)", refString(transition.Fsm), transition.InstanceKey, refString(transition.DestinationState), transition.State)
+		if transition.Error != nil {
+			out += ": " + *transition.Error
+		}
+		return out
+
+	case *pbconsole.RequestTimelineEntry_AsyncCall:
+		call := entry.AsyncCall
+		out := fmt.Sprintf("async %s -> %s (%s)", call.Origin, refString(call.Verb), call.State)
+		if call.Error != nil {
+			out += ": " + *call.Error
+		}
+		return out
+
+	default:
+		return fmt.Sprintf("unknown entry %T", entry)
+	}
+}
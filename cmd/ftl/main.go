@@ -15,6 +15,7 @@ import (
 
 	"github.com/TBD54566975/ftl"
 	"github.com/TBD54566975/ftl/backend/controller/admin"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/console/pbconsoleconnect"
 	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
 	cf "github.com/TBD54566975/ftl/common/configuration"
 	"github.com/TBD54566975/ftl/common/projectconfig"
@@ -24,32 +25,55 @@ import (
 )
 
 type CLI struct {
-	Version    kong.VersionFlag `help:"Show version."`
-	LogConfig  log.Config       `embed:"" prefix:"log-" group:"Logging:"`
-	Endpoint   *url.URL         `default:"http://127.0.0.1:8892" help:"FTL endpoint to bind/connect to." env:"FTL_ENDPOINT"`
-	ConfigFlag string           `name:"config" short:"C" help:"Path to FTL project configuration file." env:"FTL_CONFIG" placeholder:"FILE"`
+	Version           kong.VersionFlag `help:"Show version."`
+	LogConfig         log.Config       `embed:"" prefix:"log-" group:"Logging:"`
+	Endpoint          *url.URL         `default:"http://127.0.0.1:8892" help:"FTL endpoint to bind/connect to." env:"FTL_ENDPOINT"`
+	FailoverEndpoints []*url.URL       `help:"FTL endpoints to fail over to if --endpoint is unavailable." env:"FTL_FAILOVER_ENDPOINTS" placeholder:"URL"`
+	ConfigFlag        string           `name:"config" short:"C" help:"Path to FTL project configuration file." env:"FTL_CONFIG" placeholder:"FILE"`
+	Project           string           `help:"Project to operate on, scoping the deployments that are listed, changed and deployed. Defaults to the whole cluster, deploying to the project named in the project configuration." env:"FTL_PROJECT"`
 
 	Authenticators map[string]string `help:"Authenticators to use for FTL endpoints." mapsep:"," env:"FTL_AUTHENTICATORS" placeholder:"HOST=EXE,…"`
 	Insecure       bool              `help:"Skip TLS certificate verification. Caution: susceptible to machine-in-the-middle attacks."`
 
-	Ping     pingCmd     `cmd:"" help:"Ping the FTL cluster."`
-	Status   statusCmd   `cmd:"" help:"Show FTL status."`
-	Init     initCmd     `cmd:"" help:"Initialize a new FTL project."`
-	New      newCmd      `cmd:"" help:"Create a new FTL module."`
-	Dev      devCmd      `cmd:"" help:"Develop FTL modules. Will start the FTL cluster, build and deploy all modules found in the specified directories, and watch for changes."`
-	PS       psCmd       `cmd:"" help:"List deployments."`
-	Serve    serveCmd    `cmd:"" help:"Start the FTL server."`
-	Call     callCmd     `cmd:"" help:"Call an FTL function."`
-	Update   updateCmd   `cmd:"" help:"Update a deployment."`
-	Kill     killCmd     `cmd:"" help:"Kill a deployment."`
-	Schema   schemaCmd   `cmd:"" help:"FTL schema commands."`
-	Build    buildCmd    `cmd:"" help:"Build all modules found in the specified directories."`
-	Box      boxCmd      `cmd:"" help:"Build a self-contained Docker container for running a set of module."`
-	BoxRun   boxRunCmd   `cmd:"" hidden:"" help:"Run FTL inside an ftl-in-a-box container"`
-	Deploy   deployCmd   `cmd:"" help:"Build and deploy all modules found in the specified directories."`
-	Download downloadCmd `cmd:"" help:"Download a deployment."`
-	Secret   secretCmd   `cmd:"" help:"Manage secrets."`
-	Config   configCmd   `cmd:"" help:"Manage configuration."`
+	Ping      pingCmd      `cmd:"" help:"Ping the FTL cluster."`
+	Status    statusCmd    `cmd:"" help:"Show FTL status."`
+	Init      initCmd      `cmd:"" help:"Initialize a new FTL project."`
+	New       newCmd       `cmd:"" help:"Create a new FTL module."`
+	Dev       devCmd       `cmd:"" help:"Develop FTL modules. Will start the FTL cluster, build and deploy all modules found in the specified directories, and watch for changes."`
+	PS        psCmd        `cmd:"" help:"List deployments."`
+	Graph     graphCmd     `cmd:"" help:"Show the call graph of deployed modules."`
+	Trace     traceCmd     `cmd:"" help:"Show the calls, logs and async calls correlated with a request."`
+	Serve     serveCmd     `cmd:"" help:"Start the FTL server."`
+	Call      callCmd      `cmd:"" help:"Call an FTL function."`
+	Update    updateCmd    `cmd:"" help:"Update a deployment."`
+	Kill      killCmd      `cmd:"" help:"Kill a deployment."`
+	Reconcile reconcileCmd `cmd:"" help:"Reconcile the deployments of a module immediately, ignoring any backoff after failures."`
+	LogLevel  logLevelCmd  `cmd:"" name:"loglevel" help:"Temporarily change the log level of the running deployments of a module."`
+	Cron      cronCmd      `cmd:"" help:"Manage the cron jobs of active deployments."`
+	Fault     faultCmd     `cmd:"" help:"Inject faults into calls, if the controller enables fault injection."`
+	Schema    schemaCmd    `cmd:"" help:"FTL schema commands."`
+	FSM       fsmCmd       `cmd:"" help:"FTL FSM commands."`
+	Saga      sagaCmd      `cmd:"" help:"FTL saga commands."`
+	Build     buildCmd     `cmd:"" help:"Build all modules found in the specified directories."`
+	Lock      lockCmd      `cmd:"" help:"Manage the ftl.lock dependency lockfile."`
+	Box       boxCmd       `cmd:"" help:"Build a self-contained Docker container for running a set of module."`
+	BoxRun    boxRunCmd    `cmd:"" hidden:"" help:"Run FTL inside an ftl-in-a-box container"`
+	Doctor    doctorCmd    `cmd:"" help:"Diagnose problems with the local FTL environment."`
+	Deploy    deployCmd    `cmd:"" help:"Build and deploy all modules found in the specified directories."`
+	Apply     applyCmd     `cmd:"" help:"Reconcile the cluster's deployments to a manifest."`
+	Test      testCmd      `cmd:"" help:"Run module tests against an ephemeral FTL cluster."`
+	Migrate   migrateCmd   `cmd:"" name:"migrate-module" help:"Rename a module and rewrite all references to it."`
+	Download  downloadCmd  `cmd:"" help:"Download a deployment."`
+	Secret    secretCmd    `cmd:"" help:"Manage secrets."`
+	Config    configCmd    `cmd:"" help:"Manage configuration."`
+	Flags     flagsCmd     `cmd:"" help:"Manage feature flags."`
+	Contract  contractCmd  `cmd:"" help:"Record and verify ingress contract fixtures."`
+	Quota     quotaCmd     `cmd:"" help:"Show the usage of the cluster's quotas."`
+	Database  databaseCmd  `cmd:"" help:"Manage the databases provisioned for modules."`
+	Admin     adminCmd     `cmd:"" help:"Back up and restore the FTL cluster."`
+	Export    exportCmd    `cmd:"" help:"Export configuration for external systems."`
+	Egress    egressCmd    `cmd:"" help:"List the external HTTP origins declared by the verbs of deployed modules."`
+	Import    importCmd    `cmd:"" help:"Import external services as modules."`
 
 	// Specify the 1Password vault to access secrets from.
 	Vault string `name:"opvault" help:"1Password vault to be used for secrets. The name of the 1Password item will be the <ref> and the secret will be stored in the password field." placeholder:"VAULT"`
@@ -91,6 +115,9 @@ func main() {
 	if cli.Insecure {
 		logger.Warnf("--insecure skips TLS certificate verification")
 	}
+	if cli.Project != "" {
+		ctx = rpc.WithProject(ctx, cli.Project)
+	}
 
 	configPath := cli.ConfigFlag
 	if configPath == "" {
@@ -139,23 +166,36 @@ func main() {
 		os.Exit(0)
 	}()
 
-	adminServiceClient := rpc.Dial(ftlv1connect.NewAdminServiceClient, cli.Endpoint.String(), log.Error)
+	endpoints := rpc.EndpointURLs(cli.Endpoint, cli.FailoverEndpoints)
+	adminServiceClient := rpc.DialFailover(ctx, ftlv1connect.NewAdminServiceClient, endpoints, log.Error)
 	ctx = rpc.ContextWithClient(ctx, adminServiceClient)
 	adminClient, err := admin.NewClient(ctx, adminServiceClient, cli.Endpoint)
 	kctx.FatalIfErrorf(err)
 	kctx.BindTo(adminClient, (*admin.Client)(nil))
 
-	controllerServiceClient := rpc.Dial(ftlv1connect.NewControllerServiceClient, cli.Endpoint.String(), log.Error)
+	controllerServiceClient := rpc.DialFailover(ctx, ftlv1connect.NewControllerServiceClient, endpoints, log.Error)
 	ctx = rpc.ContextWithClient(ctx, controllerServiceClient)
 	kctx.BindTo(controllerServiceClient, (*ftlv1connect.ControllerServiceClient)(nil))
 
-	verbServiceClient := rpc.Dial(ftlv1connect.NewVerbServiceClient, cli.Endpoint.String(), log.Error)
+	verbServiceClient := rpc.DialFailover(ctx, ftlv1connect.NewVerbServiceClient, endpoints, log.Error)
 	ctx = rpc.ContextWithClient(ctx, verbServiceClient)
 	kctx.BindTo(verbServiceClient, (*ftlv1connect.VerbServiceClient)(nil))
 
+	consoleServiceClient := rpc.DialFailover(ctx, pbconsoleconnect.NewConsoleServiceClient, endpoints, log.Error)
+	kctx.BindTo(consoleServiceClient, (*pbconsoleconnect.ConsoleServiceClient)(nil))
+
 	kctx.Bind(cli.Endpoint)
 	kctx.BindTo(ctx, (*context.Context)(nil))
 
 	err = kctx.Run(ctx)
 	kctx.FatalIfErrorf(err)
 }
+
+// projectName returns the project modules are deployed to, either selected
+// with --project or named in the project configuration.
+func projectName(config projectconfig.Config) string {
+	if cli.Project != "" {
+		return cli.Project
+	}
+	return config.ProjectName()
+}
@@ -45,3 +45,26 @@ func (d *DAL) ListModuleConfiguration(ctx context.Context) ([]sql.ModuleConfigur
 	}
 	return l, nil
 }
+
+// ListModuleFeatureFlags returns the feature flags of "module", or of every
+// module if it is not set.
+func (d *DAL) ListModuleFeatureFlags(ctx context.Context, module optional.Option[string]) ([]sql.ModuleFeatureFlag, error) {
+	l, err := d.db.ListModuleFeatureFlags(ctx, module)
+	if err != nil {
+		return nil, dalerrs.TranslatePGError(err)
+	}
+	return l, nil
+}
+
+// SetModuleFeatureFlag creates or replaces the rules of a feature flag.
+func (d *DAL) SetModuleFeatureFlag(ctx context.Context, module, name string, rules []byte) error {
+	err := d.db.SetModuleFeatureFlag(ctx, module, name, rules)
+	return dalerrs.TranslatePGError(err)
+}
+
+// UnsetModuleFeatureFlag deletes a feature flag, returning
+// [dalerrs.ErrNotFound] if it doesn't exist.
+func (d *DAL) UnsetModuleFeatureFlag(ctx context.Context, module, name string) error {
+	_, err := d.db.UnsetModuleFeatureFlag(ctx, module, name)
+	return dalerrs.TranslatePGError(err)
+}
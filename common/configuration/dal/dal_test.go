@@ -9,6 +9,7 @@ import (
 
 	"github.com/TBD54566975/ftl/backend/controller/sql"
 	"github.com/TBD54566975/ftl/backend/controller/sql/sqltest"
+	"github.com/TBD54566975/ftl/db/dalerrs"
 	"github.com/TBD54566975/ftl/internal/log"
 )
 
@@ -101,3 +102,28 @@ func TestModuleConfiguration(t *testing.T) {
 		}
 	})
 }
+
+func TestModuleFeatureFlags(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	conn := sqltest.OpenForTesting(ctx, t)
+	dal, err := New(ctx, conn)
+	assert.NoError(t, err)
+
+	assert.NoError(t, dal.SetModuleFeatureFlag(ctx, "echo", "new-greeting", []byte(`{"enabled":false}`)))
+	assert.NoError(t, dal.SetModuleFeatureFlag(ctx, "echo", "new-greeting", []byte(`{"enabled":true}`)))
+	assert.NoError(t, dal.SetModuleFeatureFlag(ctx, "time", "utc", []byte(`{"enabled":true}`)))
+
+	flags, err := dal.ListModuleFeatureFlags(ctx, optional.Some("echo"))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(flags))
+	assert.Equal(t, "new-greeting", flags[0].Name)
+	assert.Equal(t, `{"enabled": true}`, string(flags[0].Rules))
+
+	flags, err = dal.ListModuleFeatureFlags(ctx, optional.None[string]())
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(flags))
+
+	assert.NoError(t, dal.UnsetModuleFeatureFlag(ctx, "echo", "new-greeting"))
+	err = dal.UnsetModuleFeatureFlag(ctx, "echo", "new-greeting")
+	assert.IsError(t, err, dalerrs.ErrNotFound)
+}
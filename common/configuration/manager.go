@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
 	"os"
 	"strings"
 
@@ -183,6 +184,21 @@ func (m *Manager[R]) Unset(ctx context.Context, pkey string, ref Ref) error {
 	return m.resolver.Unset(ctx, ref)
 }
 
+// SetKey points a configuration value at an existing "key" in its provider,
+// without storing a value.
+func (m *Manager[R]) SetKey(ctx context.Context, ref Ref, key *url.URL) error {
+	if _, ok := m.providers[key.Scheme]; !ok {
+		return fmt.Errorf("no provider for scheme %q", key.Scheme)
+	}
+	return m.resolver.Set(ctx, ref, key)
+}
+
+// UnsetKey removes a configuration value without deleting it from its
+// provider.
+func (m *Manager[R]) UnsetKey(ctx context.Context, ref Ref) error {
+	return m.resolver.Unset(ctx, ref)
+}
+
 func (m *Manager[R]) List(ctx context.Context) ([]Entry, error) {
 	return m.resolver.List(ctx)
 }
@@ -366,6 +366,7 @@ type Artefact struct {
 	CreatedAt time.Time
 	Digest    []byte
 	Content   []byte
+	ColdAt    optional.Option[time.Time]
 }
 
 type AsyncCall struct {
@@ -478,6 +479,23 @@ type ModuleConfiguration struct {
 	Value     []byte
 }
 
+type ModuleFeatureFlag struct {
+	ID        int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Module    string
+	Name      string
+	Rules     []byte
+}
+
+type ModuleLogLevel struct {
+	ID        int64
+	CreatedAt time.Time
+	Module    string
+	Level     string
+	ExpiresAt time.Time
+}
+
 type Request struct {
 	ID         int64
 	Origin     Origin
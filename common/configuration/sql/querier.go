@@ -13,8 +13,11 @@ import (
 type Querier interface {
 	GetModuleConfiguration(ctx context.Context, module optional.Option[string], name string) ([]byte, error)
 	ListModuleConfiguration(ctx context.Context) ([]ModuleConfiguration, error)
+	ListModuleFeatureFlags(ctx context.Context, module optional.Option[string]) ([]ModuleFeatureFlag, error)
 	SetModuleConfiguration(ctx context.Context, module optional.Option[string], name string, value []byte) error
+	SetModuleFeatureFlag(ctx context.Context, module string, name string, rules []byte) error
 	UnsetModuleConfiguration(ctx context.Context, module optional.Option[string], name string) error
+	UnsetModuleFeatureFlag(ctx context.Context, module string, name string) (int64, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -19,3 +19,19 @@ VALUES ($1, $2, $3);
 -- name: UnsetModuleConfiguration :exec
 DELETE FROM module_configuration
 WHERE module = @module AND name = @name;
+
+-- name: ListModuleFeatureFlags :many
+SELECT *
+FROM module_feature_flags
+WHERE (sqlc.narg('module')::TEXT IS NULL OR module = sqlc.narg('module')::TEXT)
+ORDER BY module, name;
+
+-- name: SetModuleFeatureFlag :exec
+INSERT INTO module_feature_flags (module, name, rules)
+VALUES ($1, $2, $3)
+ON CONFLICT (module, name) DO UPDATE SET rules = EXCLUDED.rules, updated_at = (NOW() AT TIME ZONE 'utc');
+
+-- name: UnsetModuleFeatureFlag :one
+DELETE FROM module_feature_flags
+WHERE module = @module AND name = @name
+RETURNING id;
@@ -60,6 +60,40 @@ func (q *Queries) ListModuleConfiguration(ctx context.Context) ([]ModuleConfigur
 	return items, nil
 }
 
+const listModuleFeatureFlags = `-- name: ListModuleFeatureFlags :many
+SELECT id, created_at, updated_at, module, name, rules
+FROM module_feature_flags
+WHERE ($1::TEXT IS NULL OR module = $1::TEXT)
+ORDER BY module, name
+`
+
+func (q *Queries) ListModuleFeatureFlags(ctx context.Context, module optional.Option[string]) ([]ModuleFeatureFlag, error) {
+	rows, err := q.db.Query(ctx, listModuleFeatureFlags, module)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ModuleFeatureFlag
+	for rows.Next() {
+		var i ModuleFeatureFlag
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Module,
+			&i.Name,
+			&i.Rules,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const setModuleConfiguration = `-- name: SetModuleConfiguration :exec
 INSERT INTO module_configuration (module, name, value)
 VALUES ($1, $2, $3)
@@ -70,6 +104,17 @@ func (q *Queries) SetModuleConfiguration(ctx context.Context, module optional.Op
 	return err
 }
 
+const setModuleFeatureFlag = `-- name: SetModuleFeatureFlag :exec
+INSERT INTO module_feature_flags (module, name, rules)
+VALUES ($1, $2, $3)
+ON CONFLICT (module, name) DO UPDATE SET rules = EXCLUDED.rules, updated_at = (NOW() AT TIME ZONE 'utc')
+`
+
+func (q *Queries) SetModuleFeatureFlag(ctx context.Context, module string, name string, rules []byte) error {
+	_, err := q.db.Exec(ctx, setModuleFeatureFlag, module, name, rules)
+	return err
+}
+
 const unsetModuleConfiguration = `-- name: UnsetModuleConfiguration :exec
 DELETE FROM module_configuration
 WHERE module = $1 AND name = $2
@@ -79,3 +124,16 @@ func (q *Queries) UnsetModuleConfiguration(ctx context.Context, module optional.
 	_, err := q.db.Exec(ctx, unsetModuleConfiguration, module, name)
 	return err
 }
+
+const unsetModuleFeatureFlag = `-- name: UnsetModuleFeatureFlag :one
+DELETE FROM module_feature_flags
+WHERE module = $1 AND name = $2
+RETURNING id
+`
+
+func (q *Queries) UnsetModuleFeatureFlag(ctx context.Context, module string, name string) (int64, error) {
+	row := q.db.QueryRow(ctx, unsetModuleFeatureFlag, module, name)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
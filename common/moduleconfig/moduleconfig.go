@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"go/parser"
 	"go/token"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
@@ -20,6 +21,17 @@ type ModuleGoConfig struct{}
 // ModuleKotlinConfig is language-specific configuration for Kotlin modules.
 type ModuleKotlinConfig struct{}
 
+// ModuleExternalConfig configures a build system outside of FTL, such as
+// Bazel, to build a module.
+type ModuleExternalConfig struct {
+	// Build is the command that builds the module, writing its schema, errors
+	// and deploy files to the DeployDir.
+	Build string `toml:"build"`
+	// Dependencies are the modules the module depends on, which are otherwise
+	// extracted from its source.
+	Dependencies []string `toml:"dependencies"`
+}
+
 // ModuleConfig is the configuration for an FTL module.
 //
 // Module config files are currently TOML.
@@ -40,11 +52,23 @@ type ModuleConfig struct {
 	Schema string `toml:"schema"`
 	// Errors is the name of the error file relative to the DeployDir.
 	Errors string `toml:"errors"`
+	// Diagnostics is the name of the JSON file that build errors are written to
+	// for editors and CI, relative to the DeployDir.
+	Diagnostics string `toml:"diagnostics"`
 	// Watch is the list of files to watch for changes.
 	Watch []string `toml:"watch"`
+	// Owner is the team or person that owns the module.
+	Owner string `toml:"owner"`
+	// Readme is the README of the module relative to the module directory, an
+	// excerpt of which is shown in the console.
+	Readme string `toml:"readme"`
+	// Links to the documentation, dashboards, runbooks etc. of the module, by name.
+	Links map[string]string `toml:"links"`
 
 	Go     ModuleGoConfig     `toml:"go,optional"`
 	Kotlin ModuleKotlinConfig `toml:"kotlin,optional"`
+	// External replaces the language-specific build of the module.
+	External ModuleExternalConfig `toml:"external,optional"`
 }
 
 // AbsModuleConfig is a ModuleConfig with all paths made absolute.
@@ -91,6 +115,16 @@ func (c ModuleConfig) Abs() AbsModuleConfig {
 	if !strings.HasPrefix(clone.Errors, clone.DeployDir) {
 		panic(fmt.Sprintf("errors %q is not beneath deploy directory %q", clone.Errors, clone.DeployDir))
 	}
+	clone.Diagnostics = filepath.Clean(filepath.Join(clone.DeployDir, clone.Diagnostics))
+	if !strings.HasPrefix(clone.Diagnostics, clone.DeployDir) {
+		panic(fmt.Sprintf("diagnostics %q is not beneath deploy directory %q", clone.Diagnostics, clone.DeployDir))
+	}
+	if clone.Readme != "" {
+		clone.Readme = filepath.Clean(filepath.Join(clone.Dir, clone.Readme))
+		if !strings.HasPrefix(clone.Readme, clone.Dir) {
+			panic(fmt.Sprintf("readme %q is not beneath module directory %q", clone.Readme, clone.Dir))
+		}
+	}
 	clone.Deploy = slices.Map(clone.Deploy, func(p string) string {
 		out := filepath.Clean(filepath.Join(clone.DeployDir, p))
 		if !strings.HasPrefix(out, clone.DeployDir) {
@@ -115,6 +149,12 @@ func setConfigDefaults(moduleDir string, config *ModuleConfig) error {
 	if config.Errors == "" {
 		config.Errors = "errors.pb"
 	}
+	if config.Diagnostics == "" {
+		config.Diagnostics = "diagnostics.json"
+	}
+	if config.Readme == "" {
+		config.Readme = "README.md"
+	}
 	switch config.Language {
 	case "kotlin":
 		if config.Build == "" {
@@ -138,12 +178,15 @@ func setConfigDefaults(moduleDir string, config *ModuleConfig) error {
 			config.Deploy = []string{"main"}
 		}
 		if len(config.Watch) == 0 {
-			config.Watch = []string{"**/*.go", "go.mod", "go.sum"}
-			watches, err := replacementWatches(moduleDir, config.DeployDir)
-			if err != nil {
-				return err
+			config.Watch = []string{"**/*.go", "go.mod", "go.sum", "db/migrations/**"}
+			// Externally built modules may not have a go.mod.
+			if config.External.Build == "" {
+				watches, err := replacementWatches(moduleDir, config.DeployDir)
+				if err != nil {
+					return err
+				}
+				config.Watch = append(config.Watch, watches...)
 			}
-			config.Watch = append(config.Watch, watches...)
 		}
 	}
 
@@ -156,6 +199,14 @@ func setConfigDefaults(moduleDir string, config *ModuleConfig) error {
 			return fmt.Errorf("deploy files must be relative to the module directory")
 		}
 	}
+	if !isBeneath(moduleDir, config.Readme) {
+		return fmt.Errorf("readme must be relative to the module directory")
+	}
+	for name, link := range config.Links {
+		if u, err := url.Parse(link); err != nil || u.Scheme == "" {
+			return fmt.Errorf("link %q must be an absolute URL", name)
+		}
+	}
 
 	return nil
 }
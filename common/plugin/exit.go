@@ -0,0 +1,98 @@
+package plugin
+
+import (
+	"bytes"
+	"errors"
+	"os/exec"
+	"strings"
+	"sync"
+	"syscall"
+)
+
+const (
+	// Number of lines of stderr output kept to report when a plugin crashes.
+	stderrTailLines = 50
+	// Longest line of stderr output kept.
+	stderrMaxLine = 64 * 1024
+)
+
+// ExitError is the cause of the cancellation of the context of a plugin whose
+// process exited with an error.
+type ExitError struct {
+	Err error
+	// The last lines the process wrote to stderr that weren't JSON log
+	// entries, eg. a panic and its stack trace.
+	Stderr string
+	// Signal that killed the process, if any.
+	Signal syscall.Signal
+	// Whether the process dumped core.
+	CoreDumped bool
+}
+
+func newExitError(err error, stderr string) *ExitError {
+	exitErr := &ExitError{Err: err, Stderr: stderr}
+	var execErr *exec.ExitError
+	if errors.As(err, &execErr) {
+		if status, ok := execErr.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+			exitErr.Signal = status.Signal()
+			exitErr.CoreDumped = status.CoreDump()
+		}
+	}
+	return exitErr
+}
+
+func (e *ExitError) Error() string {
+	msg := e.Err.Error()
+	if e.CoreDumped {
+		msg += " (core dumped)"
+	}
+	if e.Stderr != "" {
+		msg += "\n" + e.Stderr
+	}
+	return msg
+}
+
+func (e *ExitError) Unwrap() error { return e.Err }
+
+// stderrTail keeps the last lines of stderr output that aren't JSON log
+// entries, which are logged as they are written.
+type stderrTail struct {
+	lock    sync.Mutex
+	partial []byte
+	lines   []string
+}
+
+func (s *stderrTail) Write(p []byte) (int, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.partial = append(s.partial, p...)
+	for {
+		i := bytes.IndexByte(s.partial, '\n')
+		if i < 0 {
+			break
+		}
+		line := strings.TrimRight(string(s.partial[:i]), "\r")
+		s.partial = s.partial[i+1:]
+		if line == "" || strings.HasPrefix(line, "{") {
+			continue
+		}
+		s.lines = append(s.lines, line)
+		if len(s.lines) > stderrTailLines {
+			s.lines = s.lines[len(s.lines)-stderrTailLines:]
+		}
+	}
+	if len(s.partial) > stderrMaxLine {
+		s.partial = s.partial[:stderrMaxLine]
+	}
+	return len(p), nil
+}
+
+func (s *stderrTail) String() string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	lines := s.lines
+	if len(s.partial) > 0 && s.partial[0] != '{' {
+		lines = append(lines[:len(lines):len(lines)], string(s.partial))
+	}
+	return strings.Join(lines, "\n")
+}
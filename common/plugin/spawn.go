@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/url"
 	"os"
 	"path/filepath"
@@ -124,12 +125,13 @@ func Spawn[Client PingableClient](
 	logger.Tracef("Spawning plugin on %s", pluginEndpoint)
 	cmd := exec.Command(ctx, defaultLevel, dir, exe)
 
-	// Send the plugin's stderr to the logger.
-	cmd.Stderr = nil
-	pipe, err := cmd.StderrPipe()
-	if err != nil {
-		return nil, nil, err
-	}
+	// Send the plugin's stderr to the logger, keeping its tail to report if
+	// the plugin crashes.
+	pipe, stderrWriter := io.Pipe()
+	stderr := &stderrTail{}
+	cmd.Stderr = io.MultiWriter(stderrWriter, stderr)
+	// Don't wait indefinitely for subprocesses of the plugin to close stderr.
+	cmd.WaitDelay = time.Second
 	cmd.Env = append(cmd.Env, "FTL_BIND="+pluginEndpoint.String())
 	cmd.Env = append(cmd.Env, "FTL_WORKING_DIR="+workingDir)
 	cmd.Env = append(cmd.Env, opts.envars...)
@@ -139,13 +141,22 @@ func Spawn[Client PingableClient](
 	// Cancel the context if the command exits - this will terminate the Dial immediately.
 	var cancelWithCause context.CancelCauseFunc
 	cmdCtx, cancelWithCause = context.WithCancelCause(ctx)
-	go func() { cancelWithCause(cmd.Wait()) }()
+	go func() {
+		err := cmd.Wait()
+		_ = stderrWriter.Close()
+		if err != nil {
+			err = newExitError(err, stderr.String())
+		}
+		cancelWithCause(err)
+	}()
 
 	go func() {
 		err := log.JSONStreamer(pipe, logger, log.Error)
 		if err != nil {
 			logger.Errorf(err, "Error streaming plugin logs.")
 		}
+		// Keep draining stderr so that the plugin doesn't block writing to it.
+		_, _ = io.Copy(io.Discard, pipe)
 	}()
 
 	defer func() {
@@ -20,6 +20,26 @@ type Commands struct {
 	Startup []string `toml:"startup"`
 }
 
+// Scenario is a state that "ftl dev --scenario" brings a project to, so that
+// every developer starts from the same data.
+type Scenario struct {
+	// Configuration values to set before the initial deploy, keyed by
+	// [<module>.]<name>. Values are JSON.
+	Config map[string]string `toml:"config"`
+	// SQL files to execute against module databases after the initial
+	// deploy, keyed by <module>.<database>. Paths are relative to the project
+	// root.
+	SQL map[string][]string `toml:"sql"`
+	// Verbs to call, in order, once the SQL has been executed.
+	Calls []ScenarioCall `toml:"calls"`
+}
+
+type ScenarioCall struct {
+	Verb string `toml:"verb"`
+	// JSON5 request, defaulting to an empty object.
+	Request string `toml:"request"`
+}
+
 type ConfigAndSecrets struct {
 	Config  map[string]*URL `toml:"configuration"`
 	Secrets map[string]*URL `toml:"secrets"`
@@ -29,10 +49,13 @@ type Config struct {
 	// Path to the config file.
 	Path string `toml:"-"`
 
+	// Name of the project, defaulting to the name of the project root directory.
+	Name          string                      `toml:"name,omitempty"`
 	Global        ConfigAndSecrets            `toml:"global"`
 	Modules       map[string]ConfigAndSecrets `toml:"modules"`
 	ModuleDirs    []string                    `toml:"module-dirs"`
 	Commands      Commands                    `toml:"commands"`
+	Scenarios     map[string]Scenario         `toml:"scenarios"`
 	FTLMinVersion string                      `toml:"ftl-min-version"`
 	Hermit        bool                        `toml:"hermit"`
 	NoGit         bool                        `toml:"no-git"`
@@ -46,6 +69,27 @@ func (c Config) Root() string {
 	return filepath.Dir(c.Path)
 }
 
+// StateDir returns the directory local FTL state for the project, such as
+// allocated ports, is kept in, if the project has a config file.
+func (c Config) StateDir() optional.Option[string] {
+	if c.Path == "" {
+		return optional.None[string]()
+	}
+	return optional.Some(filepath.Join(c.Root(), ".ftl"))
+}
+
+// ProjectName returns the name of the project, or "" if the project has no
+// config file.
+func (c Config) ProjectName() string {
+	if c.Name != "" {
+		return c.Name
+	}
+	if c.Path == "" {
+		return ""
+	}
+	return filepath.Base(c.Root())
+}
+
 // AbsModuleDirs returns the absolute path for the module-dirs field from the ftl-project.toml, unless
 // that is not defined, in which case it defaults to the root directory.
 func (c Config) AbsModuleDirs() []string {
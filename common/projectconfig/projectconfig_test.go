@@ -30,11 +30,24 @@ func TestProjectConfig(t *testing.T) {
 		Commands: Commands{
 			Startup: []string{"echo 'Executing global pre-build command'"},
 		},
+		Scenarios: map[string]Scenario{
+			"demo": {
+				Config: map[string]string{"module.currency": `"USD"`},
+				SQL:    map[string][]string{"module.payments": {"seeds/payments.sql"}},
+				Calls:  []ScenarioCall{{Verb: "module.charge", Request: "{amount: 100}"}},
+			},
+		},
 	}
 
 	assert.Equal(t, expected, actual)
 }
 
+func TestProjectName(t *testing.T) {
+	assert.Equal(t, "", Config{}.ProjectName())
+	assert.Equal(t, "payments", Config{Path: "/src/payments/ftl-project.toml"}.ProjectName())
+	assert.Equal(t, "billing", Config{Path: "/src/payments/ftl-project.toml", Name: "billing"}.ProjectName())
+}
+
 func TestProjectLoadConfig(t *testing.T) {
 	tests := []struct {
 		name  string
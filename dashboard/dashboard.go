@@ -0,0 +1,348 @@
+// Package dashboard renders a live terminal dashboard of build state,
+// deployment health, recent errors and logs.
+package dashboard
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"connectrpc.com/connect"
+	"golang.org/x/term"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/buildengine"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+const (
+	maxErrors   = 5
+	maxLogLines = 500
+)
+
+type buildState int
+
+const (
+	buildStateBuilding buildState = iota
+	buildStateSuccess
+	buildStateFailed
+)
+
+type moduleBuild struct {
+	state   buildState
+	changed time.Time
+	// Bytes of artefacts uploaded and to upload, while deploying.
+	uploaded int64
+	total    int64
+}
+
+type logLine struct {
+	time    time.Time
+	level   log.Level
+	message string
+}
+
+var _ buildengine.Listener = (*Dashboard)(nil)
+var _ log.Sink = (*Dashboard)(nil)
+
+// Dashboard is a [buildengine.Listener] and [log.Sink] that collects state
+// for display in a terminal.
+type Dashboard struct {
+	lock      sync.Mutex
+	builds    map[string]*moduleBuild
+	status    *ftlv1.StatusResponse
+	statusErr error
+	errors    []logLine
+	logs      []logLine
+}
+
+// New creates a new, empty Dashboard.
+func New() *Dashboard {
+	return &Dashboard{builds: map[string]*moduleBuild{}}
+}
+
+// OnBuildStarted implements [buildengine.Listener].
+func (d *Dashboard) OnBuildStarted(module buildengine.Module) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.builds[module.Config.Module] = &moduleBuild{state: buildStateBuilding, changed: time.Now()}
+}
+
+// OnBuildSuccess implements [buildengine.Listener].
+func (d *Dashboard) OnBuildSuccess() {
+	d.finishBuilds(buildStateSuccess)
+}
+
+// OnBuildFailed implements [buildengine.Listener].
+func (d *Dashboard) OnBuildFailed(err error) {
+	d.finishBuilds(buildStateFailed)
+	d.addError(time.Now(), "build failed: "+err.Error())
+}
+
+// OnBuildDiagnostics implements [buildengine.Listener].
+//
+// Build errors are displayed when the build fails, so diagnostics are ignored.
+func (d *Dashboard) OnBuildDiagnostics(module buildengine.Module, errs []*schema.Error) {}
+
+// OnDeployProgress implements [buildengine.Listener].
+func (d *Dashboard) OnDeployProgress(module buildengine.Module, uploaded, total int64) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	build, ok := d.builds[module.Config.Module]
+	if !ok {
+		build = &moduleBuild{state: buildStateBuilding, changed: time.Now()}
+		d.builds[module.Config.Module] = build
+	}
+	build.uploaded = uploaded
+	build.total = total
+}
+
+func (d *Dashboard) finishBuilds(state buildState) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	for _, build := range d.builds {
+		if build.state == buildStateBuilding {
+			build.state = state
+			build.changed = time.Now()
+		}
+	}
+}
+
+// Log implements [log.Sink].
+func (d *Dashboard) Log(entry log.Entry) error {
+	message := entry.Message
+	if scope, ok := entry.Attributes["scope"]; ok {
+		message = scope + ": " + message
+	}
+	// Multi-line messages, eg. from build output, are split so the log pane scrolls correctly.
+	lines := strings.Split(strings.TrimRight(message, "\n"), "\n")
+	d.lock.Lock()
+	for _, line := range lines {
+		d.logs = append(d.logs, logLine{time: entry.Time, level: entry.Level, message: line})
+	}
+	if len(d.logs) > maxLogLines {
+		d.logs = d.logs[len(d.logs)-maxLogLines:]
+	}
+	d.lock.Unlock()
+	if entry.Level >= log.Error {
+		d.addError(entry.Time, message)
+	}
+	return nil
+}
+
+func (d *Dashboard) addError(t time.Time, message string) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.errors = append(d.errors, logLine{time: t, level: log.Error, message: message})
+	if len(d.errors) > maxErrors {
+		d.errors = d.errors[len(d.errors)-maxErrors:]
+	}
+}
+
+// UpdateStatus records the latest controller status, or the error retrieving it.
+func (d *Dashboard) UpdateStatus(status *ftlv1.StatusResponse, err error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if err != nil {
+		d.statusErr = err
+		return
+	}
+	d.status = status
+	d.statusErr = nil
+}
+
+// Run polls the controller for status and redraws the dashboard on out,
+// which must be a terminal, until the context is cancelled.
+//
+// The dashboard is drawn over the main screen rather than an alternate
+// screen, so the last frame remains visible after FTL exits.
+func (d *Dashboard) Run(ctx context.Context, out *os.File, client ftlv1connect.ControllerServiceClient, refresh time.Duration) error {
+	if _, err := io.WriteString(out, "\x1b[H\x1b[2J"); err != nil {
+		return err
+	}
+	ticker := time.NewTicker(refresh)
+	defer ticker.Stop()
+	for {
+		statusCtx, cancel := context.WithTimeout(ctx, refresh)
+		resp, err := client.Status(statusCtx, connect.NewRequest(&ftlv1.StatusRequest{}))
+		cancel()
+		if err != nil {
+			d.UpdateStatus(nil, err)
+		} else {
+			d.UpdateStatus(resp.Msg, nil)
+		}
+
+		width, height, err := term.GetSize(int(out.Fd()))
+		if err != nil {
+			width, height = 80, 24
+		}
+		frame := &strings.Builder{}
+		frame.WriteString("\x1b[H")
+		for i, line := range d.Render(time.Now(), width, height) {
+			if i > 0 {
+				frame.WriteString("\r\n")
+			}
+			frame.WriteString(line)
+			frame.WriteString("\x1b[K")
+		}
+		frame.WriteString("\x1b[J")
+		if _, err := io.WriteString(out, frame.String()); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Render the dashboard as at most height lines of at most width characters.
+func (d *Dashboard) Render(now time.Time, width, height int) []string {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	lines := []string{header(fmt.Sprintf("FTL %s", now.Format(time.TimeOnly)), width)}
+	if d.statusErr != nil {
+		lines = append(lines, "  controller unreachable: "+d.statusErr.Error())
+	} else if d.status != nil {
+		idle := 0
+		for _, runner := range d.status.Runners {
+			if runner.State == ftlv1.RunnerState_RUNNER_IDLE {
+				idle++
+			}
+		}
+		lines = append(lines, fmt.Sprintf("  %d controller(s), %d runner(s), %d idle", len(d.status.Controllers), len(d.status.Runners), idle))
+	}
+
+	if len(d.builds) > 0 {
+		lines = append(lines, header("Builds", width))
+		names := make([]string, 0, len(d.builds))
+		for name := range d.builds {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			build := d.builds[name]
+			var state string
+			switch build.state {
+			case buildStateBuilding:
+				state = "building"
+			case buildStateSuccess:
+				state = "built"
+			case buildStateFailed:
+				state = "failed"
+			}
+			if build.state == buildStateBuilding && build.uploaded < build.total {
+				lines = append(lines, fmt.Sprintf("  %-20s %-9s %s", name, "uploading", ProgressBar(build.uploaded, build.total, 20)))
+				continue
+			}
+			lines = append(lines, fmt.Sprintf("  %-20s %-9s %s ago", name, state, now.Sub(build.changed).Round(time.Second)))
+		}
+	}
+
+	lines = append(lines, header("Deployments", width))
+	if d.status == nil || len(d.status.Deployments) == 0 {
+		lines = append(lines, "  none")
+	} else {
+		runners := map[string]int{}
+		for _, runner := range d.status.Runners {
+			if runner.Deployment != nil {
+				runners[*runner.Deployment]++
+			}
+		}
+		deployments := append([]*ftlv1.StatusResponse_Deployment{}, d.status.Deployments...)
+		sort.Slice(deployments, func(i, j int) bool { return deployments[i].Name < deployments[j].Name })
+		for _, deployment := range deployments {
+			health := "healthy"
+			switch {
+			case deployment.MinReplicas == 0:
+				health = "stopped"
+			case runners[deployment.Key] < int(deployment.MinReplicas):
+				health = "degraded"
+			}
+			lines = append(lines, fmt.Sprintf("  %-20s %d/%d  %-9s %s", deployment.Name, runners[deployment.Key], deployment.MinReplicas, health, deployment.Key))
+		}
+	}
+
+	lines = append(lines, header("Errors", width))
+	if len(d.errors) == 0 {
+		lines = append(lines, "  none")
+	}
+	for _, e := range d.errors {
+		lines = append(lines, fmt.Sprintf("  %s %s", e.time.Format(time.TimeOnly), firstLine(e.message)))
+	}
+
+	lines = append(lines, header("Logs", width))
+	// The log pane takes up whatever space remains.
+	remaining := height - len(lines)
+	logs := d.logs
+	if remaining < len(logs) {
+		logs = logs[len(logs)-max(remaining, 0):]
+	}
+	for _, line := range logs {
+		lines = append(lines, fmt.Sprintf("  %s %s: %s", line.time.Format(time.TimeOnly), line.level, line.message))
+	}
+
+	if len(lines) > height {
+		lines = lines[:height]
+	}
+	for i, line := range lines {
+		lines[i] = truncate(line, width)
+	}
+	return lines
+}
+
+// ProgressBar renders the progress of an upload of "total" bytes as a bar of
+// "width" characters followed by the percentage and sizes uploaded.
+func ProgressBar(uploaded, total int64, width int) string {
+	fraction := 1.0
+	if total > 0 {
+		fraction = min(float64(uploaded)/float64(total), 1)
+	}
+	filled := int(fraction * float64(width))
+	return fmt.Sprintf("[%s%s] %3d%% %s/%s", strings.Repeat("█", filled), strings.Repeat("░", width-filled),
+		int(fraction*100), formatBytes(uploaded), formatBytes(total))
+}
+
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%dB", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+func header(title string, width int) string {
+	h := "── " + title + " "
+	return h + strings.Repeat("─", max(width-utf8.RuneCountInString(h), 0))
+}
+
+func firstLine(s string) string {
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		return s[:i] + " …"
+	}
+	return s
+}
+
+func truncate(s string, width int) string {
+	if utf8.RuneCountInString(s) <= width {
+		return s
+	}
+	runes := []rune(s)
+	return string(runes[:max(width-1, 0)]) + "…"
+}
@@ -0,0 +1,83 @@
+package dashboard
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/buildengine"
+	"github.com/TBD54566975/ftl/common/moduleconfig"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+func TestRender(t *testing.T) {
+	d := New()
+	d.OnBuildStarted(buildengine.Module{Config: moduleconfig.ModuleConfig{Module: "echo"}})
+	d.OnBuildStarted(buildengine.Module{Config: moduleconfig.ModuleConfig{Module: "time"}})
+	d.OnBuildFailed(errors.New("compile error\nmore detail"))
+
+	deployment := "dpl-echo-1"
+	d.UpdateStatus(&ftlv1.StatusResponse{
+		Controllers: []*ftlv1.StatusResponse_Controller{{Key: "ctr-1"}},
+		Runners: []*ftlv1.StatusResponse_Runner{
+			{Key: "run-1", State: ftlv1.RunnerState_RUNNER_ASSIGNED, Deployment: &deployment},
+			{Key: "run-2", State: ftlv1.RunnerState_RUNNER_IDLE},
+		},
+		Deployments: []*ftlv1.StatusResponse_Deployment{
+			{Key: "dpl-time-1", Name: "time", MinReplicas: 1},
+			{Key: deployment, Name: "echo", MinReplicas: 1},
+		},
+	}, nil)
+
+	now := time.Now()
+	for i := range 20 {
+		assert.NoError(t, d.Log(log.Entry{Time: now, Level: log.Info, Message: strings.Repeat("x", i)}))
+	}
+
+	lines := d.Render(now, 60, 20)
+	assert.Equal(t, 20, len(lines))
+	output := strings.Join(lines, "\n")
+	assert.Contains(t, output, "  1 controller(s), 2 runner(s), 1 idle")
+	assert.Contains(t, output, "  echo                 failed")
+	assert.Contains(t, output, "  echo                 1/1  healthy   dpl-echo-1")
+	assert.Contains(t, output, "  time                 0/1  degraded  dpl-time-1")
+	assert.Contains(t, output, "build failed: compile error …")
+	// Only the most recent logs fit.
+	assert.Contains(t, output, strings.Repeat("x", 19))
+	assert.NotContains(t, output, "info: \n")
+	for _, line := range lines {
+		assert.True(t, len([]rune(line)) <= 60, "line too long: %q", line)
+	}
+}
+
+func TestRenderUnreachable(t *testing.T) {
+	d := New()
+	d.UpdateStatus(nil, errors.New("connection refused"))
+	output := strings.Join(d.Render(time.Now(), 80, 24), "\n")
+	assert.Contains(t, output, "controller unreachable: connection refused")
+	assert.Contains(t, output, "── Deployments")
+	assert.NotContains(t, output, "── Builds")
+}
+
+func TestRenderDeployProgress(t *testing.T) {
+	d := New()
+	module := buildengine.Module{Config: moduleconfig.ModuleConfig{Module: "echo"}}
+	d.OnBuildStarted(module)
+	d.OnDeployProgress(module, 3*1024*1024, 12*1024*1024)
+	output := strings.Join(d.Render(time.Now(), 100, 24), "\n")
+	assert.Contains(t, output, "  echo                 uploading [█████░░░░░░░░░░░░░░░]  25% 3.0MiB/12.0MiB")
+
+	d.OnDeployProgress(module, 12*1024*1024, 12*1024*1024)
+	output = strings.Join(d.Render(time.Now(), 100, 24), "\n")
+	assert.Contains(t, output, "  echo                 building")
+}
+
+func TestProgressBar(t *testing.T) {
+	assert.Equal(t, "[░░░░]   0% 0B/512B", ProgressBar(0, 512, 4))
+	assert.Equal(t, "[██░░]  50% 1.0KiB/2.0KiB", ProgressBar(1024, 2048, 4))
+	assert.Equal(t, "[████] 100% 0B/0B", ProgressBar(0, 0, 4))
+}
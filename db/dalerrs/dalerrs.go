@@ -4,9 +4,12 @@
 package dalerrs
 
 import (
+	"context"
 	stdsql "database/sql"
 	"errors"
 	"fmt"
+	"io"
+	"net"
 	"strings"
 
 	"github.com/jackc/pgerrcode"
@@ -23,8 +26,60 @@ var (
 	ErrNotFound = errors.New("not found")
 	// ErrConstraint is returned by select methods in the DAL when a constraint is violated.
 	ErrConstraint = errors.New("constraint violation")
+	// ErrDBUnavailable is returned by the DAL when the database can't be
+	// reached, or is refusing connections.
+	//
+	// RPC handlers should report it as unavailable rather than as an internal
+	// error, so that clients retry.
+	ErrDBUnavailable = errors.New("database unavailable")
 )
 
+// IsUnavailable returns true if "err" was caused by the database being
+// unreachable, or refusing connections.
+func IsUnavailable(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, ErrDBUnavailable) {
+		return true
+	}
+	// Statements cancelled by their caller say nothing about the database.
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case pgerrcode.AdminShutdown,
+			pgerrcode.CrashShutdown,
+			pgerrcode.CannotConnectNow,
+			pgerrcode.TooManyConnections:
+			return true
+		}
+		return pgerrcode.IsConnectionException(pgErr.Code)
+	}
+	var connectErr *pgconn.ConnectError
+	var netErr net.Error
+	return errors.As(err, &connectErr) ||
+		errors.As(err, &netErr) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// IsTransient returns true if a statement that failed with "err" can be
+// retried outside of a transaction, because the database is unavailable or
+// the statement conflicted with a concurrent transaction.
+func IsTransient(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case pgerrcode.SerializationFailure, pgerrcode.DeadlockDetected:
+			return true
+		}
+	}
+	return IsUnavailable(err)
+}
+
 func IsNotFound(err error) bool {
 	return errors.Is(err, stdsql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows)
 }
@@ -51,5 +106,8 @@ func TranslatePGError(err error) error {
 	} else if IsNotFound(err) {
 		return ErrNotFound
 	}
+	if IsUnavailable(err) && !errors.Is(err, ErrDBUnavailable) {
+		return fmt.Errorf("%w: %w", ErrDBUnavailable, err)
+	}
 	return err
 }
@@ -6,6 +6,7 @@ import {
   BoltIcon,
   CodeBracketIcon,
   Cog6ToothIcon,
+  InformationCircleIcon,
   LockClosedIcon,
 } from '@heroicons/react/24/outline'
 import { Module } from '../../../protos/xyz/block/ftl/v1/console/console_pb'
@@ -22,6 +23,30 @@ export const modulePanels = (
 ): ExpandablePanelProps[] => {
   const panels = []
 
+  const links = Object.entries(module.links).sort(([a], [b]) => a.localeCompare(b))
+  if (module.comments.length > 0 || module.readme || module.owner || links.length > 0) {
+    panels.push({
+      icon: InformationCircleIcon,
+      title: 'About',
+      expanded: true,
+      children: (
+        <div className='flex flex-col space-y-2'>
+          {module.comments.length > 0 && <p className='text-sm whitespace-pre-wrap'>{module.comments.join('\n')}</p>}
+          {module.readme && <p className='text-xs text-gray-500 dark:text-gray-400 whitespace-pre-wrap'>{module.readme}</p>}
+          {module.owner && <RightPanelAttribute name='Owner' value={module.owner} />}
+          {links.map(([name, url]) => (
+            <div key={name} className='flex justify-between items-center'>
+              <span className='text-sm truncate'>{name}</span>
+              <a href={url} target='_blank' rel='noreferrer' className='flex items-center hover:text-indigo-400 py-1 px-2 rounded'>
+                <ArrowTopRightOnSquareIcon className='size-4' />
+              </a>
+            </div>
+          ))}
+        </div>
+      ),
+    })
+  }
+
   if (module.verbs && module.verbs.length > 0) {
     panels.push({
       icon: BoltIcon,
@@ -100,6 +100,12 @@ export const TimelineCallDetails = ({ timestamp, call }: { timestamp: Timestamp;
         <li>
           <AttributeBadge name='Duration' value={formatDuration(selectedCall.duration)} />
         </li>
+        <li>
+          <AttributeBadge name='Request size' value={`${selectedCall.requestSize} bytes`} />
+        </li>
+        <li>
+          <AttributeBadge name='Response size' value={`${selectedCall.responseSize} bytes`} />
+        </li>
         {selectedCall.destinationVerbRef && (
           <li>
             <AttributeBadge name='Destination' value={verbRefString(selectedCall.destinationVerbRef)} />
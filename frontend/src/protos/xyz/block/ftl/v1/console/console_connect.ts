@@ -5,7 +5,7 @@
 
 import { PingRequest, PingResponse } from "../ftl_pb.js";
 import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
-import { EventsQuery, GetEventsResponse, GetModulesRequest, GetModulesResponse, StreamEventsRequest, StreamEventsResponse } from "./console_pb.js";
+import { EventsQuery, GetCallGraphRequest, GetCallGraphResponse, GetErrorGroupsRequest, GetErrorGroupsResponse, GetEventsResponse, GetModulesRequest, GetModulesResponse, GetRequestTimelineRequest, GetRequestTimelineResponse, StreamEventsRequest, StreamEventsResponse } from "./console_pb.js";
 
 /**
  * @generated from service xyz.block.ftl.v1.console.ConsoleService
@@ -33,6 +33,7 @@ export const ConsoleService = {
       I: GetModulesRequest,
       O: GetModulesResponse,
       kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
     },
     /**
      * @generated from rpc xyz.block.ftl.v1.console.ConsoleService.StreamEvents
@@ -51,6 +52,43 @@ export const ConsoleService = {
       I: EventsQuery,
       O: GetEventsResponse,
       kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Failed calls grouped by the fingerprint of their error.
+     *
+     * @generated from rpc xyz.block.ftl.v1.console.ConsoleService.GetErrorGroups
+     */
+    getErrorGroups: {
+      name: "GetErrorGroups",
+      I: GetErrorGroupsRequest,
+      O: GetErrorGroupsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Call graph of the active modules, with call rates and latencies observed in the call log.
+     *
+     * @generated from rpc xyz.block.ftl.v1.console.ConsoleService.GetCallGraph
+     */
+    getCallGraph: {
+      name: "GetCallGraph",
+      I: GetCallGraphRequest,
+      O: GetCallGraphResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Everything correlated with a single request, in the order it happened.
+     *
+     * @generated from rpc xyz.block.ftl.v1.console.ConsoleService.GetRequestTimeline
+     */
+    getRequestTimeline: {
+      name: "GetRequestTimeline",
+      I: GetRequestTimelineRequest,
+      O: GetRequestTimelineResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
     },
   }
 } as const;
@@ -222,6 +222,16 @@ export class CallEvent extends Message<CallEvent> {
    */
   stack?: string;
 
+  /**
+   * @generated from field: int64 request_size = 13;
+   */
+  requestSize = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 response_size = 14;
+   */
+  responseSize = protoInt64.zero;
+
   constructor(data?: PartialMessage<CallEvent>) {
     super();
     proto3.util.initPartial(data, this);
@@ -240,6 +250,8 @@ export class CallEvent extends Message<CallEvent> {
     { no: 8, name: "response", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 9, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 10, name: "stack", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 13, name: "request_size", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 14, name: "response_size", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CallEvent {
@@ -535,6 +547,53 @@ export class Config extends Message<Config> {
   }
 }
 
+/**
+ * An external HTTP origin a module declares with +egress.
+ *
+ * @generated from message xyz.block.ftl.v1.console.Egress
+ */
+export class Egress extends Message<Egress> {
+  /**
+   * @generated from field: string url = 1;
+   */
+  url = "";
+
+  /**
+   * Verbs declaring the origin.
+   *
+   * @generated from field: repeated string verbs = 2;
+   */
+  verbs: string[] = [];
+
+  constructor(data?: PartialMessage<Egress>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.console.Egress";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "verbs", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Egress {
+    return new Egress().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Egress {
+    return new Egress().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Egress {
+    return new Egress().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Egress | PlainMessage<Egress> | undefined, b: Egress | PlainMessage<Egress> | undefined): boolean {
+    return proto3.util.equals(Egress, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.v1.console.Module
  */
@@ -579,6 +638,35 @@ export class Module extends Message<Module> {
    */
   configs: Config[] = [];
 
+  /**
+   * @generated from field: repeated xyz.block.ftl.v1.console.Egress egress = 9;
+   */
+  egress: Egress[] = [];
+
+  /**
+   * Doc comments on the module.
+   *
+   * @generated from field: repeated string comments = 10;
+   */
+  comments: string[] = [];
+
+  /**
+   * @generated from field: optional string owner = 11;
+   */
+  owner?: string;
+
+  /**
+   * Excerpt from the README of the module.
+   *
+   * @generated from field: optional string readme = 12;
+   */
+  readme?: string;
+
+  /**
+   * @generated from field: map<string, string> links = 13;
+   */
+  links: { [key: string]: string } = {};
+
   constructor(data?: PartialMessage<Module>) {
     super();
     proto3.util.initPartial(data, this);
@@ -595,6 +683,11 @@ export class Module extends Message<Module> {
     { no: 6, name: "data", kind: "message", T: Data, repeated: true },
     { no: 7, name: "secrets", kind: "message", T: Secret, repeated: true },
     { no: 8, name: "configs", kind: "message", T: Config, repeated: true },
+    { no: 9, name: "egress", kind: "message", T: Egress, repeated: true },
+    { no: 10, name: "comments", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 11, name: "owner", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 12, name: "readme", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 13, name: "links", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Module {
@@ -1470,3 +1563,758 @@ export class GetEventsResponse extends Message<GetEventsResponse> {
   }
 }
 
+/**
+ * @generated from message xyz.block.ftl.v1.console.GetErrorGroupsRequest
+ */
+export class GetErrorGroupsRequest extends Message<GetErrorGroupsRequest> {
+  /**
+   * Only include calls that failed since this time.
+   *
+   * @generated from field: google.protobuf.Timestamp since = 1;
+   */
+  since?: Timestamp;
+
+  constructor(data?: PartialMessage<GetErrorGroupsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.console.GetErrorGroupsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "since", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetErrorGroupsRequest {
+    return new GetErrorGroupsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetErrorGroupsRequest {
+    return new GetErrorGroupsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetErrorGroupsRequest {
+    return new GetErrorGroupsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetErrorGroupsRequest | PlainMessage<GetErrorGroupsRequest> | undefined, b: GetErrorGroupsRequest | PlainMessage<GetErrorGroupsRequest> | undefined): boolean {
+    return proto3.util.equals(GetErrorGroupsRequest, a, b);
+  }
+}
+
+/**
+ * A group of failed calls to a verb with the same error fingerprint.
+ *
+ * @generated from message xyz.block.ftl.v1.console.ErrorGroup
+ */
+export class ErrorGroup extends Message<ErrorGroup> {
+  /**
+   * @generated from field: string fingerprint = 1;
+   */
+  fingerprint = "";
+
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Ref verb = 2;
+   */
+  verb?: Ref;
+
+  /**
+   * @generated from field: int64 count = 3;
+   */
+  count = protoInt64.zero;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp first_seen = 4;
+   */
+  firstSeen?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_seen = 5;
+   */
+  lastSeen?: Timestamp;
+
+  /**
+   * Error and stack trace of the most recent failed call in the group.
+   *
+   * @generated from field: string error = 6;
+   */
+  error = "";
+
+  /**
+   * @generated from field: optional string stack = 7;
+   */
+  stack?: string;
+
+  constructor(data?: PartialMessage<ErrorGroup>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.console.ErrorGroup";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "fingerprint", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "verb", kind: "message", T: Ref },
+    { no: 3, name: "count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "first_seen", kind: "message", T: Timestamp },
+    { no: 5, name: "last_seen", kind: "message", T: Timestamp },
+    { no: 6, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "stack", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ErrorGroup {
+    return new ErrorGroup().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ErrorGroup {
+    return new ErrorGroup().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ErrorGroup {
+    return new ErrorGroup().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ErrorGroup | PlainMessage<ErrorGroup> | undefined, b: ErrorGroup | PlainMessage<ErrorGroup> | undefined): boolean {
+    return proto3.util.equals(ErrorGroup, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.console.GetErrorGroupsResponse
+ */
+export class GetErrorGroupsResponse extends Message<GetErrorGroupsResponse> {
+  /**
+   * Most frequent first.
+   *
+   * @generated from field: repeated xyz.block.ftl.v1.console.ErrorGroup groups = 1;
+   */
+  groups: ErrorGroup[] = [];
+
+  constructor(data?: PartialMessage<GetErrorGroupsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.console.GetErrorGroupsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "groups", kind: "message", T: ErrorGroup, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetErrorGroupsResponse {
+    return new GetErrorGroupsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetErrorGroupsResponse {
+    return new GetErrorGroupsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetErrorGroupsResponse {
+    return new GetErrorGroupsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetErrorGroupsResponse | PlainMessage<GetErrorGroupsResponse> | undefined, b: GetErrorGroupsResponse | PlainMessage<GetErrorGroupsResponse> | undefined): boolean {
+    return proto3.util.equals(GetErrorGroupsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.console.GetCallGraphRequest
+ */
+export class GetCallGraphRequest extends Message<GetCallGraphRequest> {
+  /**
+   * Only include calls observed since this time. Defaults to the last hour.
+   *
+   * @generated from field: optional google.protobuf.Timestamp since = 1;
+   */
+  since?: Timestamp;
+
+  constructor(data?: PartialMessage<GetCallGraphRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.console.GetCallGraphRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "since", kind: "message", T: Timestamp, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetCallGraphRequest {
+    return new GetCallGraphRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetCallGraphRequest {
+    return new GetCallGraphRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetCallGraphRequest {
+    return new GetCallGraphRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetCallGraphRequest | PlainMessage<GetCallGraphRequest> | undefined, b: GetCallGraphRequest | PlainMessage<GetCallGraphRequest> | undefined): boolean {
+    return proto3.util.equals(GetCallGraphRequest, a, b);
+  }
+}
+
+/**
+ * A module in the call graph, and its verbs.
+ *
+ * @generated from message xyz.block.ftl.v1.console.CallGraphModule
+ */
+export class CallGraphModule extends Message<CallGraphModule> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string deployment_key = 2;
+   */
+  deploymentKey = "";
+
+  /**
+   * @generated from field: repeated string verbs = 3;
+   */
+  verbs: string[] = [];
+
+  constructor(data?: PartialMessage<CallGraphModule>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.console.CallGraphModule";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "deployment_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "verbs", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CallGraphModule {
+    return new CallGraphModule().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CallGraphModule {
+    return new CallGraphModule().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CallGraphModule {
+    return new CallGraphModule().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CallGraphModule | PlainMessage<CallGraphModule> | undefined, b: CallGraphModule | PlainMessage<CallGraphModule> | undefined): boolean {
+    return proto3.util.equals(CallGraphModule, a, b);
+  }
+}
+
+/**
+ * Calls from one verb to another.
+ *
+ * @generated from message xyz.block.ftl.v1.console.CallGraphEdge
+ */
+export class CallGraphEdge extends Message<CallGraphEdge> {
+  /**
+   * Absent for calls that did not originate from a verb, such as ingress.
+   *
+   * @generated from field: optional xyz.block.ftl.v1.schema.Ref source = 1;
+   */
+  source?: Ref;
+
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Ref destination = 2;
+   */
+  destination?: Ref;
+
+  /**
+   * True if the source declares the call with "+calls".
+   *
+   * @generated from field: bool declared = 3;
+   */
+  declared = false;
+
+  /**
+   * Calls observed in the call log since the start of the graph.
+   *
+   * @generated from field: int64 calls = 4;
+   */
+  calls = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 errors = 5;
+   */
+  errors = protoInt64.zero;
+
+  /**
+   * Observed calls per second.
+   *
+   * @generated from field: double rate = 6;
+   */
+  rate = 0;
+
+  /**
+   * @generated from field: google.protobuf.Duration average_latency = 7;
+   */
+  averageLatency?: Duration;
+
+  /**
+   * @generated from field: google.protobuf.Duration p95_latency = 8;
+   */
+  p95Latency?: Duration;
+
+  /**
+   * @generated from field: google.protobuf.Duration max_latency = 9;
+   */
+  maxLatency?: Duration;
+
+  constructor(data?: PartialMessage<CallGraphEdge>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.console.CallGraphEdge";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "source", kind: "message", T: Ref, opt: true },
+    { no: 2, name: "destination", kind: "message", T: Ref },
+    { no: 3, name: "declared", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "calls", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "errors", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "rate", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 7, name: "average_latency", kind: "message", T: Duration },
+    { no: 8, name: "p95_latency", kind: "message", T: Duration },
+    { no: 9, name: "max_latency", kind: "message", T: Duration },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CallGraphEdge {
+    return new CallGraphEdge().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CallGraphEdge {
+    return new CallGraphEdge().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CallGraphEdge {
+    return new CallGraphEdge().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CallGraphEdge | PlainMessage<CallGraphEdge> | undefined, b: CallGraphEdge | PlainMessage<CallGraphEdge> | undefined): boolean {
+    return proto3.util.equals(CallGraphEdge, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.console.GetCallGraphResponse
+ */
+export class GetCallGraphResponse extends Message<GetCallGraphResponse> {
+  /**
+   * @generated from field: repeated xyz.block.ftl.v1.console.CallGraphModule modules = 1;
+   */
+  modules: CallGraphModule[] = [];
+
+  /**
+   * @generated from field: repeated xyz.block.ftl.v1.console.CallGraphEdge edges = 2;
+   */
+  edges: CallGraphEdge[] = [];
+
+  /**
+   * Start of the period that calls were observed over.
+   *
+   * @generated from field: google.protobuf.Timestamp since = 3;
+   */
+  since?: Timestamp;
+
+  constructor(data?: PartialMessage<GetCallGraphResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.console.GetCallGraphResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "modules", kind: "message", T: CallGraphModule, repeated: true },
+    { no: 2, name: "edges", kind: "message", T: CallGraphEdge, repeated: true },
+    { no: 3, name: "since", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetCallGraphResponse {
+    return new GetCallGraphResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetCallGraphResponse {
+    return new GetCallGraphResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetCallGraphResponse {
+    return new GetCallGraphResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetCallGraphResponse | PlainMessage<GetCallGraphResponse> | undefined, b: GetCallGraphResponse | PlainMessage<GetCallGraphResponse> | undefined): boolean {
+    return proto3.util.equals(GetCallGraphResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.console.GetRequestTimelineRequest
+ */
+export class GetRequestTimelineRequest extends Message<GetRequestTimelineRequest> {
+  /**
+   * @generated from field: string request_key = 1;
+   */
+  requestKey = "";
+
+  constructor(data?: PartialMessage<GetRequestTimelineRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.console.GetRequestTimelineRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "request_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetRequestTimelineRequest {
+    return new GetRequestTimelineRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetRequestTimelineRequest {
+    return new GetRequestTimelineRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetRequestTimelineRequest {
+    return new GetRequestTimelineRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetRequestTimelineRequest | PlainMessage<GetRequestTimelineRequest> | undefined, b: GetRequestTimelineRequest | PlainMessage<GetRequestTimelineRequest> | undefined): boolean {
+    return proto3.util.equals(GetRequestTimelineRequest, a, b);
+  }
+}
+
+/**
+ * The request entering the cluster.
+ *
+ * @generated from message xyz.block.ftl.v1.console.RequestIngress
+ */
+export class RequestIngress extends Message<RequestIngress> {
+  /**
+   * One of "ingress", "cron" or "pubsub".
+   *
+   * @generated from field: string origin = 1;
+   */
+  origin = "";
+
+  /**
+   * Description of the request from its key, eg. "GET-foo-bar".
+   *
+   * @generated from field: string description = 2;
+   */
+  description = "";
+
+  /**
+   * @generated from field: string source_addr = 3;
+   */
+  sourceAddr = "";
+
+  constructor(data?: PartialMessage<RequestIngress>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.console.RequestIngress";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "origin", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "source_addr", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RequestIngress {
+    return new RequestIngress().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RequestIngress {
+    return new RequestIngress().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RequestIngress {
+    return new RequestIngress().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RequestIngress | PlainMessage<RequestIngress> | undefined, b: RequestIngress | PlainMessage<RequestIngress> | undefined): boolean {
+    return proto3.util.equals(RequestIngress, a, b);
+  }
+}
+
+/**
+ * An async call started by the request, such as a delivery to a subscriber.
+ *
+ * @generated from message xyz.block.ftl.v1.console.RequestAsyncCall
+ */
+export class RequestAsyncCall extends Message<RequestAsyncCall> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Ref verb = 2;
+   */
+  verb?: Ref;
+
+  /**
+   * Originator of the async call, eg. "sub:echo.events".
+   *
+   * @generated from field: string origin = 3;
+   */
+  origin = "";
+
+  /**
+   * One of "pending", "executing", "success" or "error".
+   *
+   * @generated from field: string state = 4;
+   */
+  state = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp scheduled_at = 5;
+   */
+  scheduledAt?: Timestamp;
+
+  /**
+   * @generated from field: optional string error = 6;
+   */
+  error?: string;
+
+  constructor(data?: PartialMessage<RequestAsyncCall>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.console.RequestAsyncCall";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "verb", kind: "message", T: Ref },
+    { no: 3, name: "origin", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "state", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "scheduled_at", kind: "message", T: Timestamp },
+    { no: 6, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RequestAsyncCall {
+    return new RequestAsyncCall().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RequestAsyncCall {
+    return new RequestAsyncCall().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RequestAsyncCall {
+    return new RequestAsyncCall().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RequestAsyncCall | PlainMessage<RequestAsyncCall> | undefined, b: RequestAsyncCall | PlainMessage<RequestAsyncCall> | undefined): boolean {
+    return proto3.util.equals(RequestAsyncCall, a, b);
+  }
+}
+
+/**
+ * An FSM transition started by the request.
+ *
+ * @generated from message xyz.block.ftl.v1.console.RequestFSMTransition
+ */
+export class RequestFSMTransition extends Message<RequestFSMTransition> {
+  /**
+   * @generated from field: int64 async_call_id = 1;
+   */
+  asyncCallId = protoInt64.zero;
+
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Ref fsm = 2;
+   */
+  fsm?: Ref;
+
+  /**
+   * @generated from field: string instance_key = 3;
+   */
+  instanceKey = "";
+
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Ref destination_state = 4;
+   */
+  destinationState?: Ref;
+
+  /**
+   * State of the async call executing the transition.
+   *
+   * @generated from field: string state = 5;
+   */
+  state = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp scheduled_at = 6;
+   */
+  scheduledAt?: Timestamp;
+
+  /**
+   * @generated from field: optional string error = 7;
+   */
+  error?: string;
+
+  constructor(data?: PartialMessage<RequestFSMTransition>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.console.RequestFSMTransition";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "async_call_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "fsm", kind: "message", T: Ref },
+    { no: 3, name: "instance_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "destination_state", kind: "message", T: Ref },
+    { no: 5, name: "state", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "scheduled_at", kind: "message", T: Timestamp },
+    { no: 7, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RequestFSMTransition {
+    return new RequestFSMTransition().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RequestFSMTransition {
+    return new RequestFSMTransition().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RequestFSMTransition {
+    return new RequestFSMTransition().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RequestFSMTransition | PlainMessage<RequestFSMTransition> | undefined, b: RequestFSMTransition | PlainMessage<RequestFSMTransition> | undefined): boolean {
+    return proto3.util.equals(RequestFSMTransition, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.console.RequestTimelineEntry
+ */
+export class RequestTimelineEntry extends Message<RequestTimelineEntry> {
+  /**
+   * @generated from field: google.protobuf.Timestamp time_stamp = 1;
+   */
+  timeStamp?: Timestamp;
+
+  /**
+   * @generated from oneof xyz.block.ftl.v1.console.RequestTimelineEntry.entry
+   */
+  entry: {
+    /**
+     * @generated from field: xyz.block.ftl.v1.console.RequestIngress ingress = 2;
+     */
+    value: RequestIngress;
+    case: "ingress";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.console.CallEvent call = 3;
+     */
+    value: CallEvent;
+    case: "call";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.console.LogEvent log = 4;
+     */
+    value: LogEvent;
+    case: "log";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.console.RequestAsyncCall async_call = 5;
+     */
+    value: RequestAsyncCall;
+    case: "asyncCall";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.console.RequestFSMTransition fsm_transition = 6;
+     */
+    value: RequestFSMTransition;
+    case: "fsmTransition";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  constructor(data?: PartialMessage<RequestTimelineEntry>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.console.RequestTimelineEntry";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "time_stamp", kind: "message", T: Timestamp },
+    { no: 2, name: "ingress", kind: "message", T: RequestIngress, oneof: "entry" },
+    { no: 3, name: "call", kind: "message", T: CallEvent, oneof: "entry" },
+    { no: 4, name: "log", kind: "message", T: LogEvent, oneof: "entry" },
+    { no: 5, name: "async_call", kind: "message", T: RequestAsyncCall, oneof: "entry" },
+    { no: 6, name: "fsm_transition", kind: "message", T: RequestFSMTransition, oneof: "entry" },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RequestTimelineEntry {
+    return new RequestTimelineEntry().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RequestTimelineEntry {
+    return new RequestTimelineEntry().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RequestTimelineEntry {
+    return new RequestTimelineEntry().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RequestTimelineEntry | PlainMessage<RequestTimelineEntry> | undefined, b: RequestTimelineEntry | PlainMessage<RequestTimelineEntry> | undefined): boolean {
+    return proto3.util.equals(RequestTimelineEntry, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.console.GetRequestTimelineResponse
+ */
+export class GetRequestTimelineResponse extends Message<GetRequestTimelineResponse> {
+  /**
+   * @generated from field: string request_key = 1;
+   */
+  requestKey = "";
+
+  /**
+   * Oldest first.
+   *
+   * @generated from field: repeated xyz.block.ftl.v1.console.RequestTimelineEntry entries = 2;
+   */
+  entries: RequestTimelineEntry[] = [];
+
+  constructor(data?: PartialMessage<GetRequestTimelineResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.console.GetRequestTimelineResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "request_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "entries", kind: "message", T: RequestTimelineEntry, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetRequestTimelineResponse {
+    return new GetRequestTimelineResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetRequestTimelineResponse {
+    return new GetRequestTimelineResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetRequestTimelineResponse {
+    return new GetRequestTimelineResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetRequestTimelineResponse | PlainMessage<GetRequestTimelineResponse> | undefined, b: GetRequestTimelineResponse | PlainMessage<GetRequestTimelineResponse> | undefined): boolean {
+    return proto3.util.equals(GetRequestTimelineResponse, a, b);
+  }
+}
+
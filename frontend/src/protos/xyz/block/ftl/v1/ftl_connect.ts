@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { AcquireLeaseRequest, AcquireLeaseResponse, CallRequest, CallResponse, CreateDeploymentRequest, CreateDeploymentResponse, DeployRequest, DeployResponse, GetArtefactDiffsRequest, GetArtefactDiffsResponse, GetConfigRequest, GetConfigResponse, GetDeploymentArtefactsRequest, GetDeploymentArtefactsResponse, GetDeploymentRequest, GetDeploymentResponse, GetSchemaRequest, GetSchemaResponse, GetSecretRequest, GetSecretResponse, ListConfigRequest, ListConfigResponse, ListSecretsRequest, ListSecretsResponse, ModuleContextRequest, ModuleContextResponse, PingRequest, PingResponse, ProcessListRequest, ProcessListResponse, PublishEventRequest, PublishEventResponse, PullSchemaRequest, PullSchemaResponse, RegisterRunnerRequest, RegisterRunnerResponse, ReplaceDeployRequest, ReplaceDeployResponse, ReserveRequest, ReserveResponse, SendFSMEventRequest, SendFSMEventResponse, SetConfigRequest, SetConfigResponse, SetSecretRequest, SetSecretResponse, StatusRequest, StatusResponse, StreamDeploymentLogsRequest, StreamDeploymentLogsResponse, TerminateRequest, UnsetConfigRequest, UnsetConfigResponse, UnsetSecretRequest, UnsetSecretResponse, UpdateDeployRequest, UpdateDeployResponse, UploadArtefactRequest, UploadArtefactResponse } from "./ftl_pb.js";
+import { AcquireLeaseRequest, AcquireLeaseResponse, CallAsyncRequest, CallAsyncResponse, CallRequest, CallResponse, ClearFaultsRequest, ClearFaultsResponse, CreateAdminResourceRequest, CreateAdminResourceResponse, CreateDeploymentRequest, CreateDeploymentResponse, DeleteAdminResourceRequest, DeleteAdminResourceResponse, DeployRequest, DeployResponse, GetAdminResourceRequest, GetAdminResourceResponse, GetArtefactDiffsRequest, GetArtefactDiffsResponse, GetAsyncCallResultRequest, GetAsyncCallResultResponse, GetConfigRequest, GetConfigResponse, GetDeploymentArtefactsRequest, GetDeploymentArtefactsResponse, GetDeploymentRequest, GetDeploymentResponse, GetPrefetchCandidatesRequest, GetPrefetchCandidatesResponse, GetQuotaUsageRequest, GetQuotaUsageResponse, GetSagaRequest, GetSagaResponse, GetSchemaRequest, GetSchemaResponse, GetSecretRequest, GetSecretResponse, GetSLOStatusRequest, GetSLOStatusResponse, InjectFaultRequest, InjectFaultResponse, ListAdminResourcesRequest, ListAdminResourcesResponse, ListConfigRequest, ListConfigResponse, ListCronJobsRequest, ListCronJobsResponse, ListFaultsRequest, ListFaultsResponse, ListFeatureFlagsRequest, ListFeatureFlagsResponse, ListProvisionedDatabasesRequest, ListProvisionedDatabasesResponse, ListSecretsRequest, ListSecretsResponse, ModuleContextRequest, ModuleContextResponse, PauseCronJobsRequest, PauseCronJobsResponse, PingRequest, PingResponse, ProcessListRequest, ProcessListResponse, PublishEventRequest, PublishEventResponse, PullSchemaRequest, PullSchemaResponse, ReconcileRequest, ReconcileResponse, RegisterRunnerRequest, RegisterRunnerResponse, ReplaceDeployRequest, ReplaceDeployResponse, ReserveRequest, ReserveResponse, ResumeCronJobsRequest, ResumeCronJobsResponse, SendFSMEventRequest, SendFSMEventResponse, SendFSMTransitionRequest, SendFSMTransitionResponse, SetConfigRequest, SetConfigResponse, SetFeatureFlagRequest, SetFeatureFlagResponse, SetLogLevelRequest, SetLogLevelResponse, SetSecretRequest, SetSecretResponse, StatusRequest, StatusResponse, StreamDeploymentLogsRequest, StreamDeploymentLogsResponse, TeardownDatabaseRequest, TeardownDatabaseResponse, TerminateRequest, TriggerCronJobRequest, TriggerCronJobResponse, UnsetConfigRequest, UnsetConfigResponse, UnsetFeatureFlagRequest, UnsetFeatureFlagResponse, UnsetSecretRequest, UnsetSecretResponse, UpdateAdminResourceRequest, UpdateAdminResourceResponse, UpdateDeployRequest, UpdateDeployResponse, UploadArtefactRequest, UploadArtefactResponse } from "./ftl_pb.js";
 import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -72,6 +72,28 @@ export const VerbService = {
       O: PublishEventResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Enqueue a call to a Verb, to be executed asynchronously by the controller.
+     *
+     * @generated from rpc xyz.block.ftl.v1.VerbService.CallAsync
+     */
+    callAsync: {
+      name: "CallAsync",
+      I: CallAsyncRequest,
+      O: CallAsyncResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Get the result of a call made with CallAsync.
+     *
+     * @generated from rpc xyz.block.ftl.v1.VerbService.GetAsyncCallResult
+     */
+    getAsyncCallResult: {
+      name: "GetAsyncCallResult",
+      I: GetAsyncCallResultRequest,
+      O: GetAsyncCallResultResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * Issue a synchronous call to a Verb.
      *
@@ -83,6 +105,20 @@ export const VerbService = {
       O: CallResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Issue a call to a streaming Verb, returning each response as it is sent.
+     *
+     * The stream completes when the Verb returns, and errors are sent as a final
+     * response.
+     *
+     * @generated from rpc xyz.block.ftl.v1.VerbService.CallStream
+     */
+    callStream: {
+      name: "CallStream",
+      I: CallRequest,
+      O: CallResponse,
+      kind: MethodKind.ServerStreaming,
+    },
   }
 } as const;
 
@@ -114,6 +150,7 @@ export const ControllerService = {
       I: ProcessListRequest,
       O: ProcessListResponse,
       kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
     },
     /**
      * @generated from rpc xyz.block.ftl.v1.ControllerService.Status
@@ -123,6 +160,7 @@ export const ControllerService = {
       I: StatusRequest,
       O: StatusResponse,
       kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
     },
     /**
      * Get list of artefacts that differ between the server and client.
@@ -134,6 +172,7 @@ export const ControllerService = {
       I: GetArtefactDiffsRequest,
       O: GetArtefactDiffsResponse,
       kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
     },
     /**
      * Upload an artefact to the server.
@@ -167,6 +206,7 @@ export const ControllerService = {
       I: GetDeploymentRequest,
       O: GetDeploymentResponse,
       kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
     },
     /**
      * Stream deployment artefacts from the server.
@@ -242,6 +282,7 @@ export const ControllerService = {
       I: GetSchemaRequest,
       O: GetSchemaResponse,
       kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
     },
     /**
      * Pull schema changes from the Controller.
@@ -257,6 +298,239 @@ export const ControllerService = {
       O: PullSchemaResponse,
       kind: MethodKind.ServerStreaming,
     },
+    /**
+     * Start a transition of an FSM instance to the given state.
+     *
+     * Unlike VerbService.SendFSMEvent, the destination is selected by state
+     * rather than by the type of the event.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.SendFSMTransition
+     */
+    sendFSMTransition: {
+      name: "SendFSMTransition",
+      I: SendFSMTransitionRequest,
+      O: SendFSMTransitionResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Success rates and latencies of calls to each verb, measured against their service level objectives.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.GetSLOStatus
+     */
+    getSLOStatus: {
+      name: "GetSLOStatus",
+      I: GetSLOStatusRequest,
+      O: GetSLOStatusResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Deployments idle runners should prefetch artefacts for, because they are
+     * likely to be scaled up.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.GetPrefetchCandidates
+     */
+    getPrefetchCandidates: {
+      name: "GetPrefetchCandidates",
+      I: GetPrefetchCandidatesRequest,
+      O: GetPrefetchCandidatesResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Immediately reconcile the deployments of a module, ignoring any backoff
+     * from previous failures.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.Reconcile
+     */
+    reconcile: {
+      name: "Reconcile",
+      I: ReconcileRequest,
+      O: ReconcileResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * List feature flags, optionally filtered by module.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.ListFeatureFlags
+     */
+    listFeatureFlags: {
+      name: "ListFeatureFlags",
+      I: ListFeatureFlagsRequest,
+      O: ListFeatureFlagsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Create or replace the rules of a feature flag.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.SetFeatureFlag
+     */
+    setFeatureFlag: {
+      name: "SetFeatureFlag",
+      I: SetFeatureFlagRequest,
+      O: SetFeatureFlagResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.Idempotent,
+    },
+    /**
+     * Delete a feature flag.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.UnsetFeatureFlag
+     */
+    unsetFeatureFlag: {
+      name: "UnsetFeatureFlag",
+      I: UnsetFeatureFlagRequest,
+      O: UnsetFeatureFlagResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.Idempotent,
+    },
+    /**
+     * Temporarily override the log level of the running deployments of a module.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.SetLogLevel
+     */
+    setLogLevel: {
+      name: "SetLogLevel",
+      I: SetLogLevelRequest,
+      O: SetLogLevelResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.Idempotent,
+    },
+    /**
+     * Report the usage of each configured quota.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.GetQuotaUsage
+     */
+    getQuotaUsage: {
+      name: "GetQuotaUsage",
+      I: GetQuotaUsageRequest,
+      O: GetQuotaUsageResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Report the progress of an instance of a saga.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.GetSaga
+     */
+    getSaga: {
+      name: "GetSaga",
+      I: GetSagaRequest,
+      O: GetSagaResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * List the databases the controller provisioned for modules.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.ListProvisionedDatabases
+     */
+    listProvisionedDatabases: {
+      name: "ListProvisionedDatabases",
+      I: ListProvisionedDatabasesRequest,
+      O: ListProvisionedDatabasesResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Delete a database the controller provisioned for a module, along with its
+     * DSN secret. Fails if an active deployment of the module still declares it.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.TeardownDatabase
+     */
+    teardownDatabase: {
+      name: "TeardownDatabase",
+      I: TeardownDatabaseRequest,
+      O: TeardownDatabaseResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * List the cron jobs of active deployments, optionally filtered by module.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.ListCronJobs
+     */
+    listCronJobs: {
+      name: "ListCronJobs",
+      I: ListCronJobsRequest,
+      O: ListCronJobsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Pause cron jobs of the active deployment of a module until they are
+     * resumed. Paused jobs are skipped when they are due.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.PauseCronJobs
+     */
+    pauseCronJobs: {
+      name: "PauseCronJobs",
+      I: PauseCronJobsRequest,
+      O: PauseCronJobsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.Idempotent,
+    },
+    /**
+     * Resume paused cron jobs of the active deployment of a module.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.ResumeCronJobs
+     */
+    resumeCronJobs: {
+      name: "ResumeCronJobs",
+      I: ResumeCronJobsRequest,
+      O: ResumeCronJobsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.Idempotent,
+    },
+    /**
+     * Execute a cron job immediately, whether or not it is paused, without
+     * affecting its schedule.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.TriggerCronJob
+     */
+    triggerCronJob: {
+      name: "TriggerCronJob",
+      I: TriggerCronJobRequest,
+      O: TriggerCronJobResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Inject a fault into calls to the verbs of a module, for game days and
+     * integration tests. Only available if the controller was started with
+     * fault injection enabled, and to requests not scoped to a project.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.InjectFault
+     */
+    injectFault: {
+      name: "InjectFault",
+      I: InjectFaultRequest,
+      O: InjectFaultResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * List the injected faults that have not expired.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.ListFaults
+     */
+    listFaults: {
+      name: "ListFaults",
+      I: ListFaultsRequest,
+      O: ListFaultsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Clear injected faults.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.ClearFaults
+     */
+    clearFaults: {
+      name: "ClearFaults",
+      I: ClearFaultsRequest,
+      O: ClearFaultsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.Idempotent,
+    },
   }
 } as const;
 
@@ -347,6 +621,7 @@ export const AdminService = {
       I: ListConfigRequest,
       O: ListConfigResponse,
       kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
     },
     /**
      * Get a config value.
@@ -358,6 +633,7 @@ export const AdminService = {
       I: GetConfigRequest,
       O: GetConfigResponse,
       kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
     },
     /**
      * Set a config value.
@@ -369,6 +645,7 @@ export const AdminService = {
       I: SetConfigRequest,
       O: SetConfigResponse,
       kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.Idempotent,
     },
     /**
      * Unset a config value.
@@ -380,6 +657,7 @@ export const AdminService = {
       I: UnsetConfigRequest,
       O: UnsetConfigResponse,
       kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.Idempotent,
     },
     /**
      * List secrets.
@@ -391,6 +669,7 @@ export const AdminService = {
       I: ListSecretsRequest,
       O: ListSecretsResponse,
       kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
     },
     /**
      * Get a secret.
@@ -402,6 +681,7 @@ export const AdminService = {
       I: GetSecretRequest,
       O: GetSecretResponse,
       kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
     },
     /**
      * Set a secret.
@@ -413,6 +693,7 @@ export const AdminService = {
       I: SetSecretRequest,
       O: SetSecretResponse,
       kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.Idempotent,
     },
     /**
      * Unset a secret.
@@ -424,6 +705,65 @@ export const AdminService = {
       I: UnsetSecretRequest,
       O: UnsetSecretResponse,
       kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.Idempotent,
+    },
+    /**
+     * List the resources of a kind.
+     *
+     * @generated from rpc xyz.block.ftl.v1.AdminService.ListAdminResources
+     */
+    listAdminResources: {
+      name: "ListAdminResources",
+      I: ListAdminResourcesRequest,
+      O: ListAdminResourcesResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Get a resource.
+     *
+     * @generated from rpc xyz.block.ftl.v1.AdminService.GetAdminResource
+     */
+    getAdminResource: {
+      name: "GetAdminResource",
+      I: GetAdminResourceRequest,
+      O: GetAdminResourceResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Create a resource, failing if it already exists.
+     *
+     * @generated from rpc xyz.block.ftl.v1.AdminService.CreateAdminResource
+     */
+    createAdminResource: {
+      name: "CreateAdminResource",
+      I: CreateAdminResourceRequest,
+      O: CreateAdminResourceResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Update an existing resource.
+     *
+     * @generated from rpc xyz.block.ftl.v1.AdminService.UpdateAdminResource
+     */
+    updateAdminResource: {
+      name: "UpdateAdminResource",
+      I: UpdateAdminResourceRequest,
+      O: UpdateAdminResourceResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Delete a resource.
+     *
+     * @generated from rpc xyz.block.ftl.v1.AdminService.DeleteAdminResource
+     */
+    deleteAdminResource: {
+      name: "DeleteAdminResource",
+      I: DeleteAdminResourceRequest,
+      O: DeleteAdminResourceResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.Idempotent,
     },
   }
 } as const;
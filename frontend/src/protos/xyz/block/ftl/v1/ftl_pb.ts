@@ -4,7 +4,7 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Duration, Message, proto3, Struct, Timestamp } from "@bufbuild/protobuf";
+import { Duration, Message, proto3, protoInt64, Struct, Timestamp } from "@bufbuild/protobuf";
 import { Module, Ref, Schema, Type } from "./schema/schema_pb.js";
 
 /**
@@ -153,6 +153,64 @@ proto3.util.setEnumType(SecretProvider, "xyz.block.ftl.v1.SecretProvider", [
   { no: 4, name: "SECRET_ASM" },
 ]);
 
+/**
+ * Kinds of resources managed with the admin resource API.
+ *
+ * @generated from enum xyz.block.ftl.v1.AdminResourceKind
+ */
+export enum AdminResourceKind {
+  /**
+   * @generated from enum value: ADMIN_RESOURCE_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * A project, named by its name.
+   *
+   * @generated from enum value: ADMIN_RESOURCE_KIND_PROJECT = 1;
+   */
+  PROJECT = 1,
+
+  /**
+   * A configuration value, named by its ref, eg. "echo.greeting" or "greeting".
+   *
+   * @generated from enum value: ADMIN_RESOURCE_KIND_CONFIG = 2;
+   */
+  CONFIG = 2,
+
+  /**
+   * The location of a secret, named by its ref.
+   *
+   * @generated from enum value: ADMIN_RESOURCE_KIND_SECRET_REF = 3;
+   */
+  SECRET_REF = 3,
+
+  /**
+   * A quota limit overriding that of the controller's flags, named
+   * "<quota>/<scope>", eg. "replicas/billing" or "call-rate/*".
+   *
+   * @generated from enum value: ADMIN_RESOURCE_KIND_QUOTA = 4;
+   */
+  QUOTA = 4,
+
+  /**
+   * A domain whose ingress requests are routed to the modules of a project,
+   * named by the domain.
+   *
+   * @generated from enum value: ADMIN_RESOURCE_KIND_INGRESS_DOMAIN = 5;
+   */
+  INGRESS_DOMAIN = 5,
+}
+// Retrieve enum metadata with: proto3.getEnumType(AdminResourceKind)
+proto3.util.setEnumType(AdminResourceKind, "xyz.block.ftl.v1.AdminResourceKind", [
+  { no: 0, name: "ADMIN_RESOURCE_KIND_UNSPECIFIED" },
+  { no: 1, name: "ADMIN_RESOURCE_KIND_PROJECT" },
+  { no: 2, name: "ADMIN_RESOURCE_KIND_CONFIG" },
+  { no: 3, name: "ADMIN_RESOURCE_KIND_SECRET_REF" },
+  { no: 4, name: "ADMIN_RESOURCE_KIND_QUOTA" },
+  { no: 5, name: "ADMIN_RESOURCE_KIND_INGRESS_DOMAIN" },
+]);
+
 /**
  * @generated from message xyz.block.ftl.v1.PingRequest
  */
@@ -285,6 +343,20 @@ export class ModuleContextResponse extends Message<ModuleContextResponse> {
    */
   databases: ModuleContextResponse_DSN[] = [];
 
+  /**
+   * Feature flags of the module, as JSON encoded rules.
+   *
+   * @generated from field: map<string, bytes> flags = 5;
+   */
+  flags: { [key: string]: Uint8Array } = {};
+
+  /**
+   * Temporary log level override for the module, or zero if there is none.
+   *
+   * @generated from field: int32 log_level = 6;
+   */
+  logLevel = 0;
+
   constructor(data?: PartialMessage<ModuleContextResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -297,6 +369,8 @@ export class ModuleContextResponse extends Message<ModuleContextResponse> {
     { no: 2, name: "configs", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 12 /* ScalarType.BYTES */} },
     { no: 3, name: "secrets", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 12 /* ScalarType.BYTES */} },
     { no: 4, name: "databases", kind: "message", T: ModuleContextResponse_DSN, repeated: true },
+    { no: 5, name: "flags", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 12 /* ScalarType.BYTES */} },
+    { no: 6, name: "log_level", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ModuleContextResponse {
@@ -611,12 +685,24 @@ export class CallResponse_Error extends Message<CallResponse_Error> {
   message = "";
 
   /**
-   * TODO: Richer error type.
-   *
    * @generated from field: optional string stack = 2;
    */
   stack?: string;
 
+  /**
+   * Type of the error, if it is a data structure declared in the schema.
+   *
+   * @generated from field: optional xyz.block.ftl.v1.schema.Ref type = 3;
+   */
+  type?: Ref;
+
+  /**
+   * Encoded error, if it has a type.
+   *
+   * @generated from field: optional bytes body = 4;
+   */
+  body?: Uint8Array;
+
   constructor(data?: PartialMessage<CallResponse_Error>) {
     super();
     proto3.util.initPartial(data, this);
@@ -627,6 +713,8 @@ export class CallResponse_Error extends Message<CallResponse_Error> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "stack", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "type", kind: "message", T: Ref, opt: true },
+    { no: 4, name: "body", kind: "scalar", T: 12 /* ScalarType.BYTES */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CallResponse_Error {
@@ -812,6 +900,94 @@ export class SendFSMEventResponse extends Message<SendFSMEventResponse> {
   }
 }
 
+/**
+ * @generated from message xyz.block.ftl.v1.SendFSMTransitionRequest
+ */
+export class SendFSMTransitionRequest extends Message<SendFSMTransitionRequest> {
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Ref fsm = 1;
+   */
+  fsm?: Ref;
+
+  /**
+   * @generated from field: string instance = 2;
+   */
+  instance = "";
+
+  /**
+   * The destination state of the transition.
+   *
+   * @generated from field: xyz.block.ftl.v1.schema.Ref state = 3;
+   */
+  state?: Ref;
+
+  /**
+   * @generated from field: bytes body = 4;
+   */
+  body = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<SendFSMTransitionRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.SendFSMTransitionRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "fsm", kind: "message", T: Ref },
+    { no: 2, name: "instance", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "state", kind: "message", T: Ref },
+    { no: 4, name: "body", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SendFSMTransitionRequest {
+    return new SendFSMTransitionRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SendFSMTransitionRequest {
+    return new SendFSMTransitionRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SendFSMTransitionRequest {
+    return new SendFSMTransitionRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SendFSMTransitionRequest | PlainMessage<SendFSMTransitionRequest> | undefined, b: SendFSMTransitionRequest | PlainMessage<SendFSMTransitionRequest> | undefined): boolean {
+    return proto3.util.equals(SendFSMTransitionRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.SendFSMTransitionResponse
+ */
+export class SendFSMTransitionResponse extends Message<SendFSMTransitionResponse> {
+  constructor(data?: PartialMessage<SendFSMTransitionResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.SendFSMTransitionResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SendFSMTransitionResponse {
+    return new SendFSMTransitionResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SendFSMTransitionResponse {
+    return new SendFSMTransitionResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SendFSMTransitionResponse {
+    return new SendFSMTransitionResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SendFSMTransitionResponse | PlainMessage<SendFSMTransitionResponse> | undefined, b: SendFSMTransitionResponse | PlainMessage<SendFSMTransitionResponse> | undefined): boolean {
+    return proto3.util.equals(SendFSMTransitionResponse, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.v1.PublishEventRequest
  */
@@ -826,6 +1002,14 @@ export class PublishEventRequest extends Message<PublishEventRequest> {
    */
   body = new Uint8Array(0);
 
+  /**
+   * Events sharing an ordering key are processed one at a time, across all
+   * subscriptions.
+   *
+   * @generated from field: optional string ordering_key = 3;
+   */
+  orderingKey?: string;
+
   constructor(data?: PartialMessage<PublishEventRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -836,6 +1020,7 @@ export class PublishEventRequest extends Message<PublishEventRequest> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "topic", kind: "message", T: Ref },
     { no: 2, name: "body", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 3, name: "ordering_key", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PublishEventRequest {
@@ -886,6 +1071,232 @@ export class PublishEventResponse extends Message<PublishEventResponse> {
   }
 }
 
+/**
+ * @generated from message xyz.block.ftl.v1.CallAsyncRequest
+ */
+export class CallAsyncRequest extends Message<CallAsyncRequest> {
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Ref verb = 1;
+   */
+  verb?: Ref;
+
+  /**
+   * @generated from field: bytes body = 2;
+   */
+  body = new Uint8Array(0);
+
+  /**
+   * Retry policy for the call. If absent, the policy of the Verb is used.
+   *
+   * @generated from field: optional xyz.block.ftl.v1.CallAsyncRequest.Retry retry = 3;
+   */
+  retry?: CallAsyncRequest_Retry;
+
+  constructor(data?: PartialMessage<CallAsyncRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.CallAsyncRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "verb", kind: "message", T: Ref },
+    { no: 2, name: "body", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 3, name: "retry", kind: "message", T: CallAsyncRequest_Retry, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CallAsyncRequest {
+    return new CallAsyncRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CallAsyncRequest {
+    return new CallAsyncRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CallAsyncRequest {
+    return new CallAsyncRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CallAsyncRequest | PlainMessage<CallAsyncRequest> | undefined, b: CallAsyncRequest | PlainMessage<CallAsyncRequest> | undefined): boolean {
+    return proto3.util.equals(CallAsyncRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.CallAsyncRequest.Retry
+ */
+export class CallAsyncRequest_Retry extends Message<CallAsyncRequest_Retry> {
+  /**
+   * @generated from field: int32 count = 1;
+   */
+  count = 0;
+
+  /**
+   * @generated from field: google.protobuf.Duration min_backoff = 2;
+   */
+  minBackoff?: Duration;
+
+  constructor(data?: PartialMessage<CallAsyncRequest_Retry>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.CallAsyncRequest.Retry";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "min_backoff", kind: "message", T: Duration },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CallAsyncRequest_Retry {
+    return new CallAsyncRequest_Retry().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CallAsyncRequest_Retry {
+    return new CallAsyncRequest_Retry().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CallAsyncRequest_Retry {
+    return new CallAsyncRequest_Retry().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CallAsyncRequest_Retry | PlainMessage<CallAsyncRequest_Retry> | undefined, b: CallAsyncRequest_Retry | PlainMessage<CallAsyncRequest_Retry> | undefined): boolean {
+    return proto3.util.equals(CallAsyncRequest_Retry, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.CallAsyncResponse
+ */
+export class CallAsyncResponse extends Message<CallAsyncResponse> {
+  /**
+   * Identifies the call, to retrieve its result.
+   *
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  constructor(data?: PartialMessage<CallAsyncResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.CallAsyncResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CallAsyncResponse {
+    return new CallAsyncResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CallAsyncResponse {
+    return new CallAsyncResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CallAsyncResponse {
+    return new CallAsyncResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CallAsyncResponse | PlainMessage<CallAsyncResponse> | undefined, b: CallAsyncResponse | PlainMessage<CallAsyncResponse> | undefined): boolean {
+    return proto3.util.equals(CallAsyncResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.GetAsyncCallResultRequest
+ */
+export class GetAsyncCallResultRequest extends Message<GetAsyncCallResultRequest> {
+  /**
+   * ID of the call, as returned by CallAsync.
+   *
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  constructor(data?: PartialMessage<GetAsyncCallResultRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.GetAsyncCallResultRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAsyncCallResultRequest {
+    return new GetAsyncCallResultRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAsyncCallResultRequest {
+    return new GetAsyncCallResultRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAsyncCallResultRequest {
+    return new GetAsyncCallResultRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetAsyncCallResultRequest | PlainMessage<GetAsyncCallResultRequest> | undefined, b: GetAsyncCallResultRequest | PlainMessage<GetAsyncCallResultRequest> | undefined): boolean {
+    return proto3.util.equals(GetAsyncCallResultRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.GetAsyncCallResultResponse
+ */
+export class GetAsyncCallResultResponse extends Message<GetAsyncCallResultResponse> {
+  /**
+   * Absent until the call, including any retries, has completed.
+   *
+   * @generated from oneof xyz.block.ftl.v1.GetAsyncCallResultResponse.result
+   */
+  result: {
+    /**
+     * @generated from field: bytes body = 1;
+     */
+    value: Uint8Array;
+    case: "body";
+  } | {
+    /**
+     * Failure message of the last attempt.
+     *
+     * @generated from field: string error = 2;
+     */
+    value: string;
+    case: "error";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  constructor(data?: PartialMessage<GetAsyncCallResultResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.GetAsyncCallResultResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "body", kind: "scalar", T: 12 /* ScalarType.BYTES */, oneof: "result" },
+    { no: 2, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "result" },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAsyncCallResultResponse {
+    return new GetAsyncCallResultResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAsyncCallResultResponse {
+    return new GetAsyncCallResultResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAsyncCallResultResponse {
+    return new GetAsyncCallResultResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetAsyncCallResultResponse | PlainMessage<GetAsyncCallResultResponse> | undefined, b: GetAsyncCallResultResponse | PlainMessage<GetAsyncCallResultResponse> | undefined): boolean {
+    return proto3.util.equals(GetAsyncCallResultResponse, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.v1.GetSchemaRequest
  */
@@ -1060,6 +1471,13 @@ export class GetArtefactDiffsRequest extends Message<GetArtefactDiffsRequest> {
    */
   clientDigests: string[] = [];
 
+  /**
+   * Digests of the chunks of artefacts to be uploaded in chunks.
+   *
+   * @generated from field: repeated string client_chunk_digests = 2;
+   */
+  clientChunkDigests: string[] = [];
+
   constructor(data?: PartialMessage<GetArtefactDiffsRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1069,6 +1487,7 @@ export class GetArtefactDiffsRequest extends Message<GetArtefactDiffsRequest> {
   static readonly typeName = "xyz.block.ftl.v1.GetArtefactDiffsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "client_digests", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "client_chunk_digests", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetArtefactDiffsRequest {
@@ -1104,16 +1523,32 @@ export class GetArtefactDiffsResponse extends Message<GetArtefactDiffsResponse>
    */
   clientArtefacts: DeploymentArtefact[] = [];
 
-  constructor(data?: PartialMessage<GetArtefactDiffsResponse>) {
-    super();
-    proto3.util.initPartial(data, this);
-  }
-
-  static readonly runtime: typeof proto3 = proto3;
+  /**
+   * Chunks in client_chunk_digests that the controller does not have.
+   *
+   * @generated from field: repeated string missing_chunk_digests = 3;
+   */
+  missingChunkDigests: string[] = [];
+
+  /**
+   * Whether the controller accepts artefacts uploaded in chunks.
+   *
+   * @generated from field: bool accepts_chunks = 4;
+   */
+  acceptsChunks = false;
+
+  constructor(data?: PartialMessage<GetArtefactDiffsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
   static readonly typeName = "xyz.block.ftl.v1.GetArtefactDiffsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "missing_digests", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
     { no: 2, name: "client_artefacts", kind: "message", T: DeploymentArtefact, repeated: true },
+    { no: 3, name: "missing_chunk_digests", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "accepts_chunks", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetArtefactDiffsResponse {
@@ -1133,6 +1568,53 @@ export class GetArtefactDiffsResponse extends Message<GetArtefactDiffsResponse>
   }
 }
 
+/**
+ * A content-defined chunk of an artefact.
+ *
+ * @generated from message xyz.block.ftl.v1.ArtefactChunk
+ */
+export class ArtefactChunk extends Message<ArtefactChunk> {
+  /**
+   * @generated from field: string digest = 1;
+   */
+  digest = "";
+
+  /**
+   * Omitted if the controller already has the chunk.
+   *
+   * @generated from field: bytes content = 2;
+   */
+  content = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<ArtefactChunk>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ArtefactChunk";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "digest", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "content", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ArtefactChunk {
+    return new ArtefactChunk().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ArtefactChunk {
+    return new ArtefactChunk().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ArtefactChunk {
+    return new ArtefactChunk().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ArtefactChunk | PlainMessage<ArtefactChunk> | undefined, b: ArtefactChunk | PlainMessage<ArtefactChunk> | undefined): boolean {
+    return proto3.util.equals(ArtefactChunk, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.v1.UploadArtefactRequest
  */
@@ -1142,6 +1624,14 @@ export class UploadArtefactRequest extends Message<UploadArtefactRequest> {
    */
   content = new Uint8Array(0);
 
+  /**
+   * The content of the artefact split into chunks, as an alternative to
+   * uploading all of it.
+   *
+   * @generated from field: repeated xyz.block.ftl.v1.ArtefactChunk chunks = 2;
+   */
+  chunks: ArtefactChunk[] = [];
+
   constructor(data?: PartialMessage<UploadArtefactRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1151,6 +1641,7 @@ export class UploadArtefactRequest extends Message<UploadArtefactRequest> {
   static readonly typeName = "xyz.block.ftl.v1.UploadArtefactRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "content", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "chunks", kind: "message", T: ArtefactChunk, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UploadArtefactRequest {
@@ -1554,6 +2045,13 @@ export class RegisterRunnerRequest extends Message<RegisterRunnerRequest> {
    */
   error?: string;
 
+  /**
+   * Statistics of the Runner's local artefact cache, if it has one.
+   *
+   * @generated from field: optional xyz.block.ftl.v1.ArtefactCacheStats artefact_cache = 8;
+   */
+  artefactCache?: ArtefactCacheStats;
+
   constructor(data?: PartialMessage<RegisterRunnerRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1568,6 +2066,7 @@ export class RegisterRunnerRequest extends Message<RegisterRunnerRequest> {
     { no: 4, name: "state", kind: "enum", T: proto3.getEnumType(RunnerState) },
     { no: 5, name: "labels", kind: "message", T: Struct },
     { no: 7, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 8, name: "artefact_cache", kind: "message", T: ArtefactCacheStats, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RegisterRunnerRequest {
@@ -1587,6 +2086,67 @@ export class RegisterRunnerRequest extends Message<RegisterRunnerRequest> {
   }
 }
 
+/**
+ * @generated from message xyz.block.ftl.v1.ArtefactCacheStats
+ */
+export class ArtefactCacheStats extends Message<ArtefactCacheStats> {
+  /**
+   * Number of artefacts used from the cache rather than downloaded.
+   *
+   * @generated from field: int64 hits = 1;
+   */
+  hits = protoInt64.zero;
+
+  /**
+   * Number of artefacts that were not in the cache and were downloaded.
+   *
+   * @generated from field: int64 misses = 2;
+   */
+  misses = protoInt64.zero;
+
+  /**
+   * Number of artefacts evicted from the cache to keep it within its size.
+   *
+   * @generated from field: int64 evictions = 3;
+   */
+  evictions = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 size_bytes = 4;
+   */
+  sizeBytes = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ArtefactCacheStats>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ArtefactCacheStats";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "hits", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "misses", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "evictions", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "size_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ArtefactCacheStats {
+    return new ArtefactCacheStats().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ArtefactCacheStats {
+    return new ArtefactCacheStats().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ArtefactCacheStats {
+    return new ArtefactCacheStats().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ArtefactCacheStats | PlainMessage<ArtefactCacheStats> | undefined, b: ArtefactCacheStats | PlainMessage<ArtefactCacheStats> | undefined): boolean {
+    return proto3.util.equals(ArtefactCacheStats, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.v1.RegisterRunnerResponse
  */
@@ -2402,6 +2962,34 @@ export class ProcessListResponse_Process extends Message<ProcessListResponse_Pro
    */
   runner?: ProcessListResponse_ProcessRunner;
 
+  /**
+   * Consecutive crashes of the replicas of the deployment.
+   *
+   * @generated from field: int32 crashes = 5;
+   */
+  crashes = 0;
+
+  /**
+   * Whether the deployment is crash looping.
+   *
+   * @generated from field: bool unhealthy = 6;
+   */
+  unhealthy = false;
+
+  /**
+   * Owner of the module, from its ftl.toml.
+   *
+   * @generated from field: optional string owner = 7;
+   */
+  owner?: string;
+
+  /**
+   * What the module does, from its doc comments or README.
+   *
+   * @generated from field: optional string description = 8;
+   */
+  description?: string;
+
   constructor(data?: PartialMessage<ProcessListResponse_Process>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2414,6 +3002,10 @@ export class ProcessListResponse_Process extends Message<ProcessListResponse_Pro
     { no: 2, name: "min_replicas", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
     { no: 3, name: "labels", kind: "message", T: Struct },
     { no: 4, name: "runner", kind: "message", T: ProcessListResponse_ProcessRunner, opt: true },
+    { no: 5, name: "crashes", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "unhealthy", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 7, name: "owner", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 8, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ProcessListResponse_Process {
@@ -2434,920 +3026,3592 @@ export class ProcessListResponse_Process extends Message<ProcessListResponse_Pro
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.DeployRequest
+ * @generated from message xyz.block.ftl.v1.GetSLOStatusRequest
  */
-export class DeployRequest extends Message<DeployRequest> {
-  /**
-   * @generated from field: string deployment_key = 1;
-   */
-  deploymentKey = "";
-
-  constructor(data?: PartialMessage<DeployRequest>) {
+export class GetSLOStatusRequest extends Message<GetSLOStatusRequest> {
+  constructor(data?: PartialMessage<GetSLOStatusRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "xyz.block.ftl.v1.DeployRequest";
+  static readonly typeName = "xyz.block.ftl.v1.GetSLOStatusRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "deployment_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeployRequest {
-    return new DeployRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSLOStatusRequest {
+    return new GetSLOStatusRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeployRequest {
-    return new DeployRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSLOStatusRequest {
+    return new GetSLOStatusRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeployRequest {
-    return new DeployRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSLOStatusRequest {
+    return new GetSLOStatusRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: DeployRequest | PlainMessage<DeployRequest> | undefined, b: DeployRequest | PlainMessage<DeployRequest> | undefined): boolean {
-    return proto3.util.equals(DeployRequest, a, b);
+  static equals(a: GetSLOStatusRequest | PlainMessage<GetSLOStatusRequest> | undefined, b: GetSLOStatusRequest | PlainMessage<GetSLOStatusRequest> | undefined): boolean {
+    return proto3.util.equals(GetSLOStatusRequest, a, b);
   }
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.DeployResponse
+ * Calls to a verb measured against its service level objectives.
+ *
+ * @generated from message xyz.block.ftl.v1.VerbSLOStatus
  */
-export class DeployResponse extends Message<DeployResponse> {
-  constructor(data?: PartialMessage<DeployResponse>) {
+export class VerbSLOStatus extends Message<VerbSLOStatus> {
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Ref verb = 1;
+   */
+  verb?: Ref;
+
+  /**
+   * Target fraction of calls that succeed.
+   *
+   * @generated from field: double availability_target = 2;
+   */
+  availabilityTarget = 0;
+
+  /**
+   * Target p95 latency.
+   *
+   * @generated from field: google.protobuf.Duration latency_target = 3;
+   */
+  latencyTarget?: Duration;
+
+  /**
+   * Calls over the SLO window.
+   *
+   * @generated from field: int64 calls = 4;
+   */
+  calls = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 errors = 5;
+   */
+  errors = protoInt64.zero;
+
+  /**
+   * Fraction of calls over the SLO window that succeeded.
+   *
+   * @generated from field: double availability = 6;
+   */
+  availability = 0;
+
+  /**
+   * @generated from field: google.protobuf.Duration p95_latency = 7;
+   */
+  p95Latency?: Duration;
+
+  /**
+   * Fraction of the error budget over the SLO window that is left, negative if it has been overspent.
+   *
+   * @generated from field: double error_budget_remaining = 8;
+   */
+  errorBudgetRemaining = 0;
+
+  /**
+   * Rate the error budget was consumed at over the last hour, where 1 exhausts it at the end of the window.
+   *
+   * @generated from field: double burn_rate = 9;
+   */
+  burnRate = 0;
+
+  /**
+   * True if the error budget is being consumed faster than it can be sustained.
+   *
+   * @generated from field: bool burning = 10;
+   */
+  burning = false;
+
+  /**
+   * True if the p95 latency over the SLO window exceeds the target.
+   *
+   * @generated from field: bool latency_breached = 11;
+   */
+  latencyBreached = false;
+
+  constructor(data?: PartialMessage<VerbSLOStatus>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "xyz.block.ftl.v1.DeployResponse";
+  static readonly typeName = "xyz.block.ftl.v1.VerbSLOStatus";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "verb", kind: "message", T: Ref },
+    { no: 2, name: "availability_target", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 3, name: "latency_target", kind: "message", T: Duration },
+    { no: 4, name: "calls", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "errors", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "availability", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 7, name: "p95_latency", kind: "message", T: Duration },
+    { no: 8, name: "error_budget_remaining", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 9, name: "burn_rate", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 10, name: "burning", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 11, name: "latency_breached", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeployResponse {
-    return new DeployResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VerbSLOStatus {
+    return new VerbSLOStatus().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeployResponse {
-    return new DeployResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): VerbSLOStatus {
+    return new VerbSLOStatus().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeployResponse {
-    return new DeployResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): VerbSLOStatus {
+    return new VerbSLOStatus().fromJsonString(jsonString, options);
   }
 
-  static equals(a: DeployResponse | PlainMessage<DeployResponse> | undefined, b: DeployResponse | PlainMessage<DeployResponse> | undefined): boolean {
-    return proto3.util.equals(DeployResponse, a, b);
+  static equals(a: VerbSLOStatus | PlainMessage<VerbSLOStatus> | undefined, b: VerbSLOStatus | PlainMessage<VerbSLOStatus> | undefined): boolean {
+    return proto3.util.equals(VerbSLOStatus, a, b);
   }
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.TerminateRequest
+ * @generated from message xyz.block.ftl.v1.GetSLOStatusResponse
  */
-export class TerminateRequest extends Message<TerminateRequest> {
+export class GetSLOStatusResponse extends Message<GetSLOStatusResponse> {
   /**
-   * @generated from field: string deployment_key = 1;
+   * Period over which the error budget is measured.
+   *
+   * @generated from field: google.protobuf.Duration window = 1;
    */
-  deploymentKey = "";
+  window?: Duration;
 
-  constructor(data?: PartialMessage<TerminateRequest>) {
+  /**
+   * @generated from field: repeated xyz.block.ftl.v1.VerbSLOStatus verbs = 2;
+   */
+  verbs: VerbSLOStatus[] = [];
+
+  constructor(data?: PartialMessage<GetSLOStatusResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "xyz.block.ftl.v1.TerminateRequest";
+  static readonly typeName = "xyz.block.ftl.v1.GetSLOStatusResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "deployment_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "window", kind: "message", T: Duration },
+    { no: 2, name: "verbs", kind: "message", T: VerbSLOStatus, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TerminateRequest {
-    return new TerminateRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSLOStatusResponse {
+    return new GetSLOStatusResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TerminateRequest {
-    return new TerminateRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSLOStatusResponse {
+    return new GetSLOStatusResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TerminateRequest {
-    return new TerminateRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSLOStatusResponse {
+    return new GetSLOStatusResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: TerminateRequest | PlainMessage<TerminateRequest> | undefined, b: TerminateRequest | PlainMessage<TerminateRequest> | undefined): boolean {
-    return proto3.util.equals(TerminateRequest, a, b);
+  static equals(a: GetSLOStatusResponse | PlainMessage<GetSLOStatusResponse> | undefined, b: GetSLOStatusResponse | PlainMessage<GetSLOStatusResponse> | undefined): boolean {
+    return proto3.util.equals(GetSLOStatusResponse, a, b);
   }
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.ReserveRequest
+ * @generated from message xyz.block.ftl.v1.GetPrefetchCandidatesRequest
  */
-export class ReserveRequest extends Message<ReserveRequest> {
+export class GetPrefetchCandidatesRequest extends Message<GetPrefetchCandidatesRequest> {
   /**
-   * @generated from field: string deployment_key = 1;
+   * Languages the runner supports.
+   *
+   * @generated from field: repeated string languages = 1;
    */
-  deploymentKey = "";
+  languages: string[] = [];
 
-  constructor(data?: PartialMessage<ReserveRequest>) {
+  /**
+   * Maximum number of candidates to return.
+   *
+   * @generated from field: int32 limit = 2;
+   */
+  limit = 0;
+
+  constructor(data?: PartialMessage<GetPrefetchCandidatesRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "xyz.block.ftl.v1.ReserveRequest";
+  static readonly typeName = "xyz.block.ftl.v1.GetPrefetchCandidatesRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "deployment_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "languages", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReserveRequest {
-    return new ReserveRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetPrefetchCandidatesRequest {
+    return new GetPrefetchCandidatesRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReserveRequest {
-    return new ReserveRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetPrefetchCandidatesRequest {
+    return new GetPrefetchCandidatesRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReserveRequest {
-    return new ReserveRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetPrefetchCandidatesRequest {
+    return new GetPrefetchCandidatesRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ReserveRequest | PlainMessage<ReserveRequest> | undefined, b: ReserveRequest | PlainMessage<ReserveRequest> | undefined): boolean {
-    return proto3.util.equals(ReserveRequest, a, b);
+  static equals(a: GetPrefetchCandidatesRequest | PlainMessage<GetPrefetchCandidatesRequest> | undefined, b: GetPrefetchCandidatesRequest | PlainMessage<GetPrefetchCandidatesRequest> | undefined): boolean {
+    return proto3.util.equals(GetPrefetchCandidatesRequest, a, b);
   }
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.ReserveResponse
+ * @generated from message xyz.block.ftl.v1.GetPrefetchCandidatesResponse
  */
-export class ReserveResponse extends Message<ReserveResponse> {
-  constructor(data?: PartialMessage<ReserveResponse>) {
+export class GetPrefetchCandidatesResponse extends Message<GetPrefetchCandidatesResponse> {
+  /**
+   * Deployments likely to be scaled up, most likely first.
+   *
+   * @generated from field: repeated string deployment_keys = 1;
+   */
+  deploymentKeys: string[] = [];
+
+  constructor(data?: PartialMessage<GetPrefetchCandidatesResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "xyz.block.ftl.v1.ReserveResponse";
+  static readonly typeName = "xyz.block.ftl.v1.GetPrefetchCandidatesResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "deployment_keys", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReserveResponse {
-    return new ReserveResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetPrefetchCandidatesResponse {
+    return new GetPrefetchCandidatesResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReserveResponse {
-    return new ReserveResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetPrefetchCandidatesResponse {
+    return new GetPrefetchCandidatesResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReserveResponse {
-    return new ReserveResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetPrefetchCandidatesResponse {
+    return new GetPrefetchCandidatesResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ReserveResponse | PlainMessage<ReserveResponse> | undefined, b: ReserveResponse | PlainMessage<ReserveResponse> | undefined): boolean {
-    return proto3.util.equals(ReserveResponse, a, b);
+  static equals(a: GetPrefetchCandidatesResponse | PlainMessage<GetPrefetchCandidatesResponse> | undefined, b: GetPrefetchCandidatesResponse | PlainMessage<GetPrefetchCandidatesResponse> | undefined): boolean {
+    return proto3.util.equals(GetPrefetchCandidatesResponse, a, b);
   }
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.ConfigRef
+ * @generated from message xyz.block.ftl.v1.ReconcileRequest
  */
-export class ConfigRef extends Message<ConfigRef> {
+export class ReconcileRequest extends Message<ReconcileRequest> {
   /**
-   * @generated from field: optional string module = 1;
-   */
-  module?: string;
-
-  /**
-   * @generated from field: string name = 2;
+   * @generated from field: string module = 1;
    */
-  name = "";
+  module = "";
 
-  constructor(data?: PartialMessage<ConfigRef>) {
+  constructor(data?: PartialMessage<ReconcileRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "xyz.block.ftl.v1.ConfigRef";
+  static readonly typeName = "xyz.block.ftl.v1.ReconcileRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "module", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
-    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "module", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConfigRef {
-    return new ConfigRef().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReconcileRequest {
+    return new ReconcileRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConfigRef {
-    return new ConfigRef().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReconcileRequest {
+    return new ReconcileRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConfigRef {
-    return new ConfigRef().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReconcileRequest {
+    return new ReconcileRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ConfigRef | PlainMessage<ConfigRef> | undefined, b: ConfigRef | PlainMessage<ConfigRef> | undefined): boolean {
-    return proto3.util.equals(ConfigRef, a, b);
+  static equals(a: ReconcileRequest | PlainMessage<ReconcileRequest> | undefined, b: ReconcileRequest | PlainMessage<ReconcileRequest> | undefined): boolean {
+    return proto3.util.equals(ReconcileRequest, a, b);
   }
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.ListConfigRequest
+ * @generated from message xyz.block.ftl.v1.ReconcileResponse
  */
-export class ListConfigRequest extends Message<ListConfigRequest> {
+export class ReconcileResponse extends Message<ReconcileResponse> {
   /**
-   * @generated from field: optional string module = 1;
-   */
-  module?: string;
-
-  /**
-   * @generated from field: optional bool include_values = 2;
+   * Deployments of the module that needed reconciliation.
+   *
+   * @generated from field: repeated xyz.block.ftl.v1.ReconcileResponse.Deployment deployments = 1;
    */
-  includeValues?: boolean;
+  deployments: ReconcileResponse_Deployment[] = [];
 
-  constructor(data?: PartialMessage<ListConfigRequest>) {
+  constructor(data?: PartialMessage<ReconcileResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "xyz.block.ftl.v1.ListConfigRequest";
+  static readonly typeName = "xyz.block.ftl.v1.ReconcileResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "module", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
-    { no: 2, name: "include_values", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+    { no: 1, name: "deployments", kind: "message", T: ReconcileResponse_Deployment, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListConfigRequest {
-    return new ListConfigRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReconcileResponse {
+    return new ReconcileResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListConfigRequest {
-    return new ListConfigRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReconcileResponse {
+    return new ReconcileResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListConfigRequest {
-    return new ListConfigRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReconcileResponse {
+    return new ReconcileResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListConfigRequest | PlainMessage<ListConfigRequest> | undefined, b: ListConfigRequest | PlainMessage<ListConfigRequest> | undefined): boolean {
-    return proto3.util.equals(ListConfigRequest, a, b);
+  static equals(a: ReconcileResponse | PlainMessage<ReconcileResponse> | undefined, b: ReconcileResponse | PlainMessage<ReconcileResponse> | undefined): boolean {
+    return proto3.util.equals(ReconcileResponse, a, b);
   }
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.ListConfigResponse
+ * @generated from message xyz.block.ftl.v1.ReconcileResponse.Deployment
  */
-export class ListConfigResponse extends Message<ListConfigResponse> {
+export class ReconcileResponse_Deployment extends Message<ReconcileResponse_Deployment> {
   /**
-   * @generated from field: repeated xyz.block.ftl.v1.ListConfigResponse.Config configs = 1;
+   * @generated from field: string deployment_key = 1;
    */
-  configs: ListConfigResponse_Config[] = [];
+  deploymentKey = "";
 
-  constructor(data?: PartialMessage<ListConfigResponse>) {
+  /**
+   * @generated from field: int32 assigned_replicas = 2;
+   */
+  assignedReplicas = 0;
+
+  /**
+   * @generated from field: int32 required_replicas = 3;
+   */
+  requiredReplicas = 0;
+
+  /**
+   * Set if the deployment could not be reconciled.
+   *
+   * @generated from field: optional string error = 4;
+   */
+  error?: string;
+
+  constructor(data?: PartialMessage<ReconcileResponse_Deployment>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "xyz.block.ftl.v1.ListConfigResponse";
+  static readonly typeName = "xyz.block.ftl.v1.ReconcileResponse.Deployment";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "configs", kind: "message", T: ListConfigResponse_Config, repeated: true },
+    { no: 1, name: "deployment_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "assigned_replicas", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "required_replicas", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListConfigResponse {
-    return new ListConfigResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReconcileResponse_Deployment {
+    return new ReconcileResponse_Deployment().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListConfigResponse {
-    return new ListConfigResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReconcileResponse_Deployment {
+    return new ReconcileResponse_Deployment().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListConfigResponse {
-    return new ListConfigResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReconcileResponse_Deployment {
+    return new ReconcileResponse_Deployment().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListConfigResponse | PlainMessage<ListConfigResponse> | undefined, b: ListConfigResponse | PlainMessage<ListConfigResponse> | undefined): boolean {
-    return proto3.util.equals(ListConfigResponse, a, b);
+  static equals(a: ReconcileResponse_Deployment | PlainMessage<ReconcileResponse_Deployment> | undefined, b: ReconcileResponse_Deployment | PlainMessage<ReconcileResponse_Deployment> | undefined): boolean {
+    return proto3.util.equals(ReconcileResponse_Deployment, a, b);
   }
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.ListConfigResponse.Config
+ * @generated from message xyz.block.ftl.v1.FeatureFlag
  */
-export class ListConfigResponse_Config extends Message<ListConfigResponse_Config> {
+export class FeatureFlag extends Message<FeatureFlag> {
   /**
-   * @generated from field: string refPath = 1;
+   * @generated from field: string module = 1;
    */
-  refPath = "";
+  module = "";
 
   /**
-   * @generated from field: optional bytes value = 2;
+   * @generated from field: string name = 2;
    */
-  value?: Uint8Array;
+  name = "";
 
-  constructor(data?: PartialMessage<ListConfigResponse_Config>) {
+  /**
+   * JSON encoded rules.
+   *
+   * @generated from field: bytes rules = 3;
+   */
+  rules = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<FeatureFlag>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "xyz.block.ftl.v1.ListConfigResponse.Config";
+  static readonly typeName = "xyz.block.ftl.v1.FeatureFlag";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "refPath", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "value", kind: "scalar", T: 12 /* ScalarType.BYTES */, opt: true },
+    { no: 1, name: "module", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "rules", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListConfigResponse_Config {
-    return new ListConfigResponse_Config().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FeatureFlag {
+    return new FeatureFlag().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListConfigResponse_Config {
-    return new ListConfigResponse_Config().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FeatureFlag {
+    return new FeatureFlag().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListConfigResponse_Config {
-    return new ListConfigResponse_Config().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FeatureFlag {
+    return new FeatureFlag().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListConfigResponse_Config | PlainMessage<ListConfigResponse_Config> | undefined, b: ListConfigResponse_Config | PlainMessage<ListConfigResponse_Config> | undefined): boolean {
-    return proto3.util.equals(ListConfigResponse_Config, a, b);
+  static equals(a: FeatureFlag | PlainMessage<FeatureFlag> | undefined, b: FeatureFlag | PlainMessage<FeatureFlag> | undefined): boolean {
+    return proto3.util.equals(FeatureFlag, a, b);
   }
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.GetConfigRequest
+ * @generated from message xyz.block.ftl.v1.ListFeatureFlagsRequest
  */
-export class GetConfigRequest extends Message<GetConfigRequest> {
+export class ListFeatureFlagsRequest extends Message<ListFeatureFlagsRequest> {
   /**
-   * @generated from field: xyz.block.ftl.v1.ConfigRef ref = 1;
+   * @generated from field: optional string module = 1;
    */
-  ref?: ConfigRef;
+  module?: string;
 
-  constructor(data?: PartialMessage<GetConfigRequest>) {
+  constructor(data?: PartialMessage<ListFeatureFlagsRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "xyz.block.ftl.v1.GetConfigRequest";
+  static readonly typeName = "xyz.block.ftl.v1.ListFeatureFlagsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "ref", kind: "message", T: ConfigRef },
+    { no: 1, name: "module", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetConfigRequest {
-    return new GetConfigRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListFeatureFlagsRequest {
+    return new ListFeatureFlagsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetConfigRequest {
-    return new GetConfigRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListFeatureFlagsRequest {
+    return new ListFeatureFlagsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetConfigRequest {
-    return new GetConfigRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListFeatureFlagsRequest {
+    return new ListFeatureFlagsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetConfigRequest | PlainMessage<GetConfigRequest> | undefined, b: GetConfigRequest | PlainMessage<GetConfigRequest> | undefined): boolean {
-    return proto3.util.equals(GetConfigRequest, a, b);
+  static equals(a: ListFeatureFlagsRequest | PlainMessage<ListFeatureFlagsRequest> | undefined, b: ListFeatureFlagsRequest | PlainMessage<ListFeatureFlagsRequest> | undefined): boolean {
+    return proto3.util.equals(ListFeatureFlagsRequest, a, b);
   }
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.GetConfigResponse
+ * @generated from message xyz.block.ftl.v1.ListFeatureFlagsResponse
  */
-export class GetConfigResponse extends Message<GetConfigResponse> {
+export class ListFeatureFlagsResponse extends Message<ListFeatureFlagsResponse> {
   /**
-   * @generated from field: bytes value = 1;
+   * @generated from field: repeated xyz.block.ftl.v1.FeatureFlag flags = 1;
    */
-  value = new Uint8Array(0);
+  flags: FeatureFlag[] = [];
 
-  constructor(data?: PartialMessage<GetConfigResponse>) {
+  constructor(data?: PartialMessage<ListFeatureFlagsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "xyz.block.ftl.v1.GetConfigResponse";
+  static readonly typeName = "xyz.block.ftl.v1.ListFeatureFlagsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "value", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 1, name: "flags", kind: "message", T: FeatureFlag, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetConfigResponse {
-    return new GetConfigResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListFeatureFlagsResponse {
+    return new ListFeatureFlagsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetConfigResponse {
-    return new GetConfigResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListFeatureFlagsResponse {
+    return new ListFeatureFlagsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetConfigResponse {
-    return new GetConfigResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListFeatureFlagsResponse {
+    return new ListFeatureFlagsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetConfigResponse | PlainMessage<GetConfigResponse> | undefined, b: GetConfigResponse | PlainMessage<GetConfigResponse> | undefined): boolean {
-    return proto3.util.equals(GetConfigResponse, a, b);
+  static equals(a: ListFeatureFlagsResponse | PlainMessage<ListFeatureFlagsResponse> | undefined, b: ListFeatureFlagsResponse | PlainMessage<ListFeatureFlagsResponse> | undefined): boolean {
+    return proto3.util.equals(ListFeatureFlagsResponse, a, b);
   }
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.SetConfigRequest
+ * @generated from message xyz.block.ftl.v1.SetFeatureFlagRequest
  */
-export class SetConfigRequest extends Message<SetConfigRequest> {
+export class SetFeatureFlagRequest extends Message<SetFeatureFlagRequest> {
   /**
-   * @generated from field: optional xyz.block.ftl.v1.ConfigProvider provider = 1;
-   */
-  provider?: ConfigProvider;
-
-  /**
-   * @generated from field: xyz.block.ftl.v1.ConfigRef ref = 2;
-   */
-  ref?: ConfigRef;
-
-  /**
-   * @generated from field: bytes value = 3;
+   * @generated from field: xyz.block.ftl.v1.FeatureFlag flag = 1;
    */
-  value = new Uint8Array(0);
+  flag?: FeatureFlag;
 
-  constructor(data?: PartialMessage<SetConfigRequest>) {
+  constructor(data?: PartialMessage<SetFeatureFlagRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "xyz.block.ftl.v1.SetConfigRequest";
+  static readonly typeName = "xyz.block.ftl.v1.SetFeatureFlagRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "provider", kind: "enum", T: proto3.getEnumType(ConfigProvider), opt: true },
-    { no: 2, name: "ref", kind: "message", T: ConfigRef },
-    { no: 3, name: "value", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 1, name: "flag", kind: "message", T: FeatureFlag },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetConfigRequest {
-    return new SetConfigRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetFeatureFlagRequest {
+    return new SetFeatureFlagRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetConfigRequest {
-    return new SetConfigRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetFeatureFlagRequest {
+    return new SetFeatureFlagRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetConfigRequest {
-    return new SetConfigRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetFeatureFlagRequest {
+    return new SetFeatureFlagRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SetConfigRequest | PlainMessage<SetConfigRequest> | undefined, b: SetConfigRequest | PlainMessage<SetConfigRequest> | undefined): boolean {
-    return proto3.util.equals(SetConfigRequest, a, b);
+  static equals(a: SetFeatureFlagRequest | PlainMessage<SetFeatureFlagRequest> | undefined, b: SetFeatureFlagRequest | PlainMessage<SetFeatureFlagRequest> | undefined): boolean {
+    return proto3.util.equals(SetFeatureFlagRequest, a, b);
   }
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.SetConfigResponse
+ * @generated from message xyz.block.ftl.v1.SetFeatureFlagResponse
  */
-export class SetConfigResponse extends Message<SetConfigResponse> {
-  constructor(data?: PartialMessage<SetConfigResponse>) {
+export class SetFeatureFlagResponse extends Message<SetFeatureFlagResponse> {
+  constructor(data?: PartialMessage<SetFeatureFlagResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "xyz.block.ftl.v1.SetConfigResponse";
+  static readonly typeName = "xyz.block.ftl.v1.SetFeatureFlagResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetConfigResponse {
-    return new SetConfigResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetFeatureFlagResponse {
+    return new SetFeatureFlagResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetConfigResponse {
-    return new SetConfigResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetFeatureFlagResponse {
+    return new SetFeatureFlagResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetConfigResponse {
-    return new SetConfigResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetFeatureFlagResponse {
+    return new SetFeatureFlagResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SetConfigResponse | PlainMessage<SetConfigResponse> | undefined, b: SetConfigResponse | PlainMessage<SetConfigResponse> | undefined): boolean {
-    return proto3.util.equals(SetConfigResponse, a, b);
+  static equals(a: SetFeatureFlagResponse | PlainMessage<SetFeatureFlagResponse> | undefined, b: SetFeatureFlagResponse | PlainMessage<SetFeatureFlagResponse> | undefined): boolean {
+    return proto3.util.equals(SetFeatureFlagResponse, a, b);
   }
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.UnsetConfigRequest
+ * @generated from message xyz.block.ftl.v1.UnsetFeatureFlagRequest
  */
-export class UnsetConfigRequest extends Message<UnsetConfigRequest> {
+export class UnsetFeatureFlagRequest extends Message<UnsetFeatureFlagRequest> {
   /**
-   * @generated from field: optional xyz.block.ftl.v1.ConfigProvider provider = 1;
+   * @generated from field: string module = 1;
    */
-  provider?: ConfigProvider;
+  module = "";
 
   /**
-   * @generated from field: xyz.block.ftl.v1.ConfigRef ref = 2;
+   * @generated from field: string name = 2;
    */
-  ref?: ConfigRef;
+  name = "";
 
-  constructor(data?: PartialMessage<UnsetConfigRequest>) {
+  constructor(data?: PartialMessage<UnsetFeatureFlagRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "xyz.block.ftl.v1.UnsetConfigRequest";
+  static readonly typeName = "xyz.block.ftl.v1.UnsetFeatureFlagRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "provider", kind: "enum", T: proto3.getEnumType(ConfigProvider), opt: true },
-    { no: 2, name: "ref", kind: "message", T: ConfigRef },
+    { no: 1, name: "module", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnsetConfigRequest {
-    return new UnsetConfigRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnsetFeatureFlagRequest {
+    return new UnsetFeatureFlagRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnsetConfigRequest {
-    return new UnsetConfigRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnsetFeatureFlagRequest {
+    return new UnsetFeatureFlagRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnsetConfigRequest {
-    return new UnsetConfigRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnsetFeatureFlagRequest {
+    return new UnsetFeatureFlagRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UnsetConfigRequest | PlainMessage<UnsetConfigRequest> | undefined, b: UnsetConfigRequest | PlainMessage<UnsetConfigRequest> | undefined): boolean {
-    return proto3.util.equals(UnsetConfigRequest, a, b);
+  static equals(a: UnsetFeatureFlagRequest | PlainMessage<UnsetFeatureFlagRequest> | undefined, b: UnsetFeatureFlagRequest | PlainMessage<UnsetFeatureFlagRequest> | undefined): boolean {
+    return proto3.util.equals(UnsetFeatureFlagRequest, a, b);
   }
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.UnsetConfigResponse
+ * @generated from message xyz.block.ftl.v1.UnsetFeatureFlagResponse
  */
-export class UnsetConfigResponse extends Message<UnsetConfigResponse> {
-  constructor(data?: PartialMessage<UnsetConfigResponse>) {
+export class UnsetFeatureFlagResponse extends Message<UnsetFeatureFlagResponse> {
+  constructor(data?: PartialMessage<UnsetFeatureFlagResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "xyz.block.ftl.v1.UnsetConfigResponse";
+  static readonly typeName = "xyz.block.ftl.v1.UnsetFeatureFlagResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnsetConfigResponse {
-    return new UnsetConfigResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnsetFeatureFlagResponse {
+    return new UnsetFeatureFlagResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnsetConfigResponse {
-    return new UnsetConfigResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnsetFeatureFlagResponse {
+    return new UnsetFeatureFlagResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnsetConfigResponse {
-    return new UnsetConfigResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnsetFeatureFlagResponse {
+    return new UnsetFeatureFlagResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UnsetConfigResponse | PlainMessage<UnsetConfigResponse> | undefined, b: UnsetConfigResponse | PlainMessage<UnsetConfigResponse> | undefined): boolean {
-    return proto3.util.equals(UnsetConfigResponse, a, b);
+  static equals(a: UnsetFeatureFlagResponse | PlainMessage<UnsetFeatureFlagResponse> | undefined, b: UnsetFeatureFlagResponse | PlainMessage<UnsetFeatureFlagResponse> | undefined): boolean {
+    return proto3.util.equals(UnsetFeatureFlagResponse, a, b);
   }
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.ListSecretsRequest
+ * @generated from message xyz.block.ftl.v1.SetLogLevelRequest
  */
-export class ListSecretsRequest extends Message<ListSecretsRequest> {
+export class SetLogLevelRequest extends Message<SetLogLevelRequest> {
   /**
-   * @generated from field: optional string module = 1;
+   * @generated from field: string module = 1;
    */
-  module?: string;
+  module = "";
 
   /**
-   * @generated from field: optional bool include_values = 2;
+   * Level to log at, or zero to remove any override.
+   *
+   * @generated from field: int32 log_level = 2;
    */
-  includeValues?: boolean;
+  logLevel = 0;
 
-  constructor(data?: PartialMessage<ListSecretsRequest>) {
+  /**
+   * How long the override lasts.
+   *
+   * @generated from field: google.protobuf.Duration duration = 3;
+   */
+  duration?: Duration;
+
+  constructor(data?: PartialMessage<SetLogLevelRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "xyz.block.ftl.v1.ListSecretsRequest";
+  static readonly typeName = "xyz.block.ftl.v1.SetLogLevelRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "module", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
-    { no: 2, name: "include_values", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+    { no: 1, name: "module", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "log_level", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "duration", kind: "message", T: Duration },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSecretsRequest {
-    return new ListSecretsRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetLogLevelRequest {
+    return new SetLogLevelRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSecretsRequest {
-    return new ListSecretsRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetLogLevelRequest {
+    return new SetLogLevelRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSecretsRequest {
-    return new ListSecretsRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetLogLevelRequest {
+    return new SetLogLevelRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListSecretsRequest | PlainMessage<ListSecretsRequest> | undefined, b: ListSecretsRequest | PlainMessage<ListSecretsRequest> | undefined): boolean {
-    return proto3.util.equals(ListSecretsRequest, a, b);
+  static equals(a: SetLogLevelRequest | PlainMessage<SetLogLevelRequest> | undefined, b: SetLogLevelRequest | PlainMessage<SetLogLevelRequest> | undefined): boolean {
+    return proto3.util.equals(SetLogLevelRequest, a, b);
   }
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.ListSecretsResponse
+ * @generated from message xyz.block.ftl.v1.SetLogLevelResponse
  */
-export class ListSecretsResponse extends Message<ListSecretsResponse> {
+export class SetLogLevelResponse extends Message<SetLogLevelResponse> {
   /**
-   * @generated from field: repeated xyz.block.ftl.v1.ListSecretsResponse.Secret secrets = 1;
+   * When the override expires, if one was set.
+   *
+   * @generated from field: optional google.protobuf.Timestamp expires_at = 1;
    */
-  secrets: ListSecretsResponse_Secret[] = [];
+  expiresAt?: Timestamp;
 
-  constructor(data?: PartialMessage<ListSecretsResponse>) {
+  constructor(data?: PartialMessage<SetLogLevelResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "xyz.block.ftl.v1.ListSecretsResponse";
+  static readonly typeName = "xyz.block.ftl.v1.SetLogLevelResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "secrets", kind: "message", T: ListSecretsResponse_Secret, repeated: true },
+    { no: 1, name: "expires_at", kind: "message", T: Timestamp, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSecretsResponse {
-    return new ListSecretsResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetLogLevelResponse {
+    return new SetLogLevelResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSecretsResponse {
-    return new ListSecretsResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetLogLevelResponse {
+    return new SetLogLevelResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSecretsResponse {
-    return new ListSecretsResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetLogLevelResponse {
+    return new SetLogLevelResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListSecretsResponse | PlainMessage<ListSecretsResponse> | undefined, b: ListSecretsResponse | PlainMessage<ListSecretsResponse> | undefined): boolean {
-    return proto3.util.equals(ListSecretsResponse, a, b);
+  static equals(a: SetLogLevelResponse | PlainMessage<SetLogLevelResponse> | undefined, b: SetLogLevelResponse | PlainMessage<SetLogLevelResponse> | undefined): boolean {
+    return proto3.util.equals(SetLogLevelResponse, a, b);
   }
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.ListSecretsResponse.Secret
+ * @generated from message xyz.block.ftl.v1.GetQuotaUsageRequest
  */
-export class ListSecretsResponse_Secret extends Message<ListSecretsResponse_Secret> {
-  /**
-   * @generated from field: string refPath = 1;
-   */
-  refPath = "";
-
+export class GetQuotaUsageRequest extends Message<GetQuotaUsageRequest> {
   /**
-   * @generated from field: optional bytes value = 2;
+   * Only report the usage of this project, defaulting to the project the
+   * request is scoped to, if any.
+   *
+   * @generated from field: optional string project = 1;
    */
-  value?: Uint8Array;
+  project?: string;
 
-  constructor(data?: PartialMessage<ListSecretsResponse_Secret>) {
+  constructor(data?: PartialMessage<GetQuotaUsageRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "xyz.block.ftl.v1.ListSecretsResponse.Secret";
+  static readonly typeName = "xyz.block.ftl.v1.GetQuotaUsageRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "refPath", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "value", kind: "scalar", T: 12 /* ScalarType.BYTES */, opt: true },
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSecretsResponse_Secret {
-    return new ListSecretsResponse_Secret().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetQuotaUsageRequest {
+    return new GetQuotaUsageRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSecretsResponse_Secret {
-    return new ListSecretsResponse_Secret().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetQuotaUsageRequest {
+    return new GetQuotaUsageRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSecretsResponse_Secret {
-    return new ListSecretsResponse_Secret().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetQuotaUsageRequest {
+    return new GetQuotaUsageRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListSecretsResponse_Secret | PlainMessage<ListSecretsResponse_Secret> | undefined, b: ListSecretsResponse_Secret | PlainMessage<ListSecretsResponse_Secret> | undefined): boolean {
-    return proto3.util.equals(ListSecretsResponse_Secret, a, b);
+  static equals(a: GetQuotaUsageRequest | PlainMessage<GetQuotaUsageRequest> | undefined, b: GetQuotaUsageRequest | PlainMessage<GetQuotaUsageRequest> | undefined): boolean {
+    return proto3.util.equals(GetQuotaUsageRequest, a, b);
   }
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.GetSecretRequest
+ * @generated from message xyz.block.ftl.v1.GetQuotaUsageResponse
  */
-export class GetSecretRequest extends Message<GetSecretRequest> {
+export class GetQuotaUsageResponse extends Message<GetQuotaUsageResponse> {
   /**
-   * @generated from field: xyz.block.ftl.v1.ConfigRef ref = 1;
+   * @generated from field: repeated xyz.block.ftl.v1.GetQuotaUsageResponse.Usage usage = 1;
    */
-  ref?: ConfigRef;
+  usage: GetQuotaUsageResponse_Usage[] = [];
 
-  constructor(data?: PartialMessage<GetSecretRequest>) {
+  constructor(data?: PartialMessage<GetQuotaUsageResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "xyz.block.ftl.v1.GetSecretRequest";
+  static readonly typeName = "xyz.block.ftl.v1.GetQuotaUsageResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "usage", kind: "message", T: GetQuotaUsageResponse_Usage, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetQuotaUsageResponse {
+    return new GetQuotaUsageResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetQuotaUsageResponse {
+    return new GetQuotaUsageResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetQuotaUsageResponse {
+    return new GetQuotaUsageResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetQuotaUsageResponse | PlainMessage<GetQuotaUsageResponse> | undefined, b: GetQuotaUsageResponse | PlainMessage<GetQuotaUsageResponse> | undefined): boolean {
+    return proto3.util.equals(GetQuotaUsageResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.GetQuotaUsageResponse.Usage
+ */
+export class GetQuotaUsageResponse_Usage extends Message<GetQuotaUsageResponse_Usage> {
+  /**
+   * Project or module the quota applies to.
+   *
+   * @generated from field: string scope = 1;
+   */
+  scope = "";
+
+  /**
+   * Name of the quota, eg. "replicas".
+   *
+   * @generated from field: string quota = 2;
+   */
+  quota = "";
+
+  /**
+   * @generated from field: int64 used = 3;
+   */
+  used = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 limit = 4;
+   */
+  limit = protoInt64.zero;
+
+  constructor(data?: PartialMessage<GetQuotaUsageResponse_Usage>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.GetQuotaUsageResponse.Usage";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "scope", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "quota", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "used", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "limit", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetQuotaUsageResponse_Usage {
+    return new GetQuotaUsageResponse_Usage().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetQuotaUsageResponse_Usage {
+    return new GetQuotaUsageResponse_Usage().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetQuotaUsageResponse_Usage {
+    return new GetQuotaUsageResponse_Usage().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetQuotaUsageResponse_Usage | PlainMessage<GetQuotaUsageResponse_Usage> | undefined, b: GetQuotaUsageResponse_Usage | PlainMessage<GetQuotaUsageResponse_Usage> | undefined): boolean {
+    return proto3.util.equals(GetQuotaUsageResponse_Usage, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.GetSagaRequest
+ */
+export class GetSagaRequest extends Message<GetSagaRequest> {
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Ref saga = 1;
+   */
+  saga?: Ref;
+
+  /**
+   * @generated from field: string instance = 2;
+   */
+  instance = "";
+
+  constructor(data?: PartialMessage<GetSagaRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.GetSagaRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "saga", kind: "message", T: Ref },
+    { no: 2, name: "instance", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSagaRequest {
+    return new GetSagaRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSagaRequest {
+    return new GetSagaRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSagaRequest {
+    return new GetSagaRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSagaRequest | PlainMessage<GetSagaRequest> | undefined, b: GetSagaRequest | PlainMessage<GetSagaRequest> | undefined): boolean {
+    return proto3.util.equals(GetSagaRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.GetSagaResponse
+ */
+export class GetSagaResponse extends Message<GetSagaResponse> {
+  /**
+   * @generated from field: xyz.block.ftl.v1.GetSagaResponse.Status status = 1;
+   */
+  status = GetSagaResponse_Status.UNSPECIFIED;
+
+  /**
+   * The last step or compensation that completed, if any.
+   *
+   * @generated from field: optional xyz.block.ftl.v1.schema.Ref current_state = 2;
+   */
+  currentState?: Ref;
+
+  /**
+   * The step or compensation currently executing, if any.
+   *
+   * @generated from field: optional xyz.block.ftl.v1.schema.Ref executing_state = 3;
+   */
+  executingState?: Ref;
+
+  constructor(data?: PartialMessage<GetSagaResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.GetSagaResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "status", kind: "enum", T: proto3.getEnumType(GetSagaResponse_Status) },
+    { no: 2, name: "current_state", kind: "message", T: Ref, opt: true },
+    { no: 3, name: "executing_state", kind: "message", T: Ref, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSagaResponse {
+    return new GetSagaResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSagaResponse {
+    return new GetSagaResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSagaResponse {
+    return new GetSagaResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSagaResponse | PlainMessage<GetSagaResponse> | undefined, b: GetSagaResponse | PlainMessage<GetSagaResponse> | undefined): boolean {
+    return proto3.util.equals(GetSagaResponse, a, b);
+  }
+}
+
+/**
+ * @generated from enum xyz.block.ftl.v1.GetSagaResponse.Status
+ */
+export enum GetSagaResponse_Status {
+  /**
+   * @generated from enum value: STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Executing its steps.
+   *
+   * @generated from enum value: STATUS_RUNNING = 1;
+   */
+  RUNNING = 1,
+
+  /**
+   * A step failed and the compensations of earlier steps are executing.
+   *
+   * @generated from enum value: STATUS_COMPENSATING = 2;
+   */
+  COMPENSATING = 2,
+
+  /**
+   * Every step completed.
+   *
+   * @generated from enum value: STATUS_COMPLETED = 3;
+   */
+  COMPLETED = 3,
+
+  /**
+   * A step failed and the compensations of earlier steps completed.
+   *
+   * @generated from enum value: STATUS_COMPENSATED = 4;
+   */
+  COMPENSATED = 4,
+
+  /**
+   * A step failed with nothing to compensate, or a compensation failed.
+   *
+   * @generated from enum value: STATUS_FAILED = 5;
+   */
+  FAILED = 5,
+}
+// Retrieve enum metadata with: proto3.getEnumType(GetSagaResponse_Status)
+proto3.util.setEnumType(GetSagaResponse_Status, "xyz.block.ftl.v1.GetSagaResponse.Status", [
+  { no: 0, name: "STATUS_UNSPECIFIED" },
+  { no: 1, name: "STATUS_RUNNING" },
+  { no: 2, name: "STATUS_COMPENSATING" },
+  { no: 3, name: "STATUS_COMPLETED" },
+  { no: 4, name: "STATUS_COMPENSATED" },
+  { no: 5, name: "STATUS_FAILED" },
+]);
+
+/**
+ * @generated from message xyz.block.ftl.v1.ListProvisionedDatabasesRequest
+ */
+export class ListProvisionedDatabasesRequest extends Message<ListProvisionedDatabasesRequest> {
+  constructor(data?: PartialMessage<ListProvisionedDatabasesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ListProvisionedDatabasesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListProvisionedDatabasesRequest {
+    return new ListProvisionedDatabasesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListProvisionedDatabasesRequest {
+    return new ListProvisionedDatabasesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListProvisionedDatabasesRequest {
+    return new ListProvisionedDatabasesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListProvisionedDatabasesRequest | PlainMessage<ListProvisionedDatabasesRequest> | undefined, b: ListProvisionedDatabasesRequest | PlainMessage<ListProvisionedDatabasesRequest> | undefined): boolean {
+    return proto3.util.equals(ListProvisionedDatabasesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ListProvisionedDatabasesResponse
+ */
+export class ListProvisionedDatabasesResponse extends Message<ListProvisionedDatabasesResponse> {
+  /**
+   * @generated from field: repeated xyz.block.ftl.v1.ListProvisionedDatabasesResponse.Database databases = 1;
+   */
+  databases: ListProvisionedDatabasesResponse_Database[] = [];
+
+  constructor(data?: PartialMessage<ListProvisionedDatabasesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ListProvisionedDatabasesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "databases", kind: "message", T: ListProvisionedDatabasesResponse_Database, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListProvisionedDatabasesResponse {
+    return new ListProvisionedDatabasesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListProvisionedDatabasesResponse {
+    return new ListProvisionedDatabasesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListProvisionedDatabasesResponse {
+    return new ListProvisionedDatabasesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListProvisionedDatabasesResponse | PlainMessage<ListProvisionedDatabasesResponse> | undefined, b: ListProvisionedDatabasesResponse | PlainMessage<ListProvisionedDatabasesResponse> | undefined): boolean {
+    return proto3.util.equals(ListProvisionedDatabasesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ListProvisionedDatabasesResponse.Database
+ */
+export class ListProvisionedDatabasesResponse_Database extends Message<ListProvisionedDatabasesResponse_Database> {
+  /**
+   * @generated from field: string module = 1;
+   */
+  module = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * Provisioner that created the database, eg. "postgres".
+   *
+   * @generated from field: string provisioner = 3;
+   */
+  provisioner = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 4;
+   */
+  createdAt?: Timestamp;
+
+  constructor(data?: PartialMessage<ListProvisionedDatabasesResponse_Database>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ListProvisionedDatabasesResponse.Database";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "module", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "provisioner", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "created_at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListProvisionedDatabasesResponse_Database {
+    return new ListProvisionedDatabasesResponse_Database().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListProvisionedDatabasesResponse_Database {
+    return new ListProvisionedDatabasesResponse_Database().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListProvisionedDatabasesResponse_Database {
+    return new ListProvisionedDatabasesResponse_Database().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListProvisionedDatabasesResponse_Database | PlainMessage<ListProvisionedDatabasesResponse_Database> | undefined, b: ListProvisionedDatabasesResponse_Database | PlainMessage<ListProvisionedDatabasesResponse_Database> | undefined): boolean {
+    return proto3.util.equals(ListProvisionedDatabasesResponse_Database, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.TeardownDatabaseRequest
+ */
+export class TeardownDatabaseRequest extends Message<TeardownDatabaseRequest> {
+  /**
+   * @generated from field: string module = 1;
+   */
+  module = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<TeardownDatabaseRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.TeardownDatabaseRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "module", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TeardownDatabaseRequest {
+    return new TeardownDatabaseRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TeardownDatabaseRequest {
+    return new TeardownDatabaseRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TeardownDatabaseRequest {
+    return new TeardownDatabaseRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TeardownDatabaseRequest | PlainMessage<TeardownDatabaseRequest> | undefined, b: TeardownDatabaseRequest | PlainMessage<TeardownDatabaseRequest> | undefined): boolean {
+    return proto3.util.equals(TeardownDatabaseRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.TeardownDatabaseResponse
+ */
+export class TeardownDatabaseResponse extends Message<TeardownDatabaseResponse> {
+  constructor(data?: PartialMessage<TeardownDatabaseResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.TeardownDatabaseResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TeardownDatabaseResponse {
+    return new TeardownDatabaseResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TeardownDatabaseResponse {
+    return new TeardownDatabaseResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TeardownDatabaseResponse {
+    return new TeardownDatabaseResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TeardownDatabaseResponse | PlainMessage<TeardownDatabaseResponse> | undefined, b: TeardownDatabaseResponse | PlainMessage<TeardownDatabaseResponse> | undefined): boolean {
+    return proto3.util.equals(TeardownDatabaseResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ListCronJobsRequest
+ */
+export class ListCronJobsRequest extends Message<ListCronJobsRequest> {
+  /**
+   * @generated from field: optional string module = 1;
+   */
+  module?: string;
+
+  constructor(data?: PartialMessage<ListCronJobsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ListCronJobsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "module", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListCronJobsRequest {
+    return new ListCronJobsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListCronJobsRequest {
+    return new ListCronJobsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListCronJobsRequest {
+    return new ListCronJobsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListCronJobsRequest | PlainMessage<ListCronJobsRequest> | undefined, b: ListCronJobsRequest | PlainMessage<ListCronJobsRequest> | undefined): boolean {
+    return proto3.util.equals(ListCronJobsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ListCronJobsResponse
+ */
+export class ListCronJobsResponse extends Message<ListCronJobsResponse> {
+  /**
+   * @generated from field: repeated xyz.block.ftl.v1.ListCronJobsResponse.CronJob jobs = 1;
+   */
+  jobs: ListCronJobsResponse_CronJob[] = [];
+
+  constructor(data?: PartialMessage<ListCronJobsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ListCronJobsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "jobs", kind: "message", T: ListCronJobsResponse_CronJob, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListCronJobsResponse {
+    return new ListCronJobsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListCronJobsResponse {
+    return new ListCronJobsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListCronJobsResponse {
+    return new ListCronJobsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListCronJobsResponse | PlainMessage<ListCronJobsResponse> | undefined, b: ListCronJobsResponse | PlainMessage<ListCronJobsResponse> | undefined): boolean {
+    return proto3.util.equals(ListCronJobsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ListCronJobsResponse.CronJob
+ */
+export class ListCronJobsResponse_CronJob extends Message<ListCronJobsResponse_CronJob> {
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Ref verb = 1;
+   */
+  verb?: Ref;
+
+  /**
+   * @generated from field: string schedule = 2;
+   */
+  schedule = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp next_execution = 3;
+   */
+  nextExecution?: Timestamp;
+
+  /**
+   * @generated from field: bool paused = 4;
+   */
+  paused = false;
+
+  /**
+   * Whether the job is currently executing.
+   *
+   * @generated from field: bool executing = 5;
+   */
+  executing = false;
+
+  constructor(data?: PartialMessage<ListCronJobsResponse_CronJob>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ListCronJobsResponse.CronJob";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "verb", kind: "message", T: Ref },
+    { no: 2, name: "schedule", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "next_execution", kind: "message", T: Timestamp },
+    { no: 4, name: "paused", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "executing", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListCronJobsResponse_CronJob {
+    return new ListCronJobsResponse_CronJob().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListCronJobsResponse_CronJob {
+    return new ListCronJobsResponse_CronJob().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListCronJobsResponse_CronJob {
+    return new ListCronJobsResponse_CronJob().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListCronJobsResponse_CronJob | PlainMessage<ListCronJobsResponse_CronJob> | undefined, b: ListCronJobsResponse_CronJob | PlainMessage<ListCronJobsResponse_CronJob> | undefined): boolean {
+    return proto3.util.equals(ListCronJobsResponse_CronJob, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.PauseCronJobsRequest
+ */
+export class PauseCronJobsRequest extends Message<PauseCronJobsRequest> {
+  /**
+   * @generated from field: string module = 1;
+   */
+  module = "";
+
+  /**
+   * Only pause the job of this verb, rather than every job of the module.
+   *
+   * @generated from field: optional string verb = 2;
+   */
+  verb?: string;
+
+  constructor(data?: PartialMessage<PauseCronJobsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.PauseCronJobsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "module", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "verb", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PauseCronJobsRequest {
+    return new PauseCronJobsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PauseCronJobsRequest {
+    return new PauseCronJobsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PauseCronJobsRequest {
+    return new PauseCronJobsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PauseCronJobsRequest | PlainMessage<PauseCronJobsRequest> | undefined, b: PauseCronJobsRequest | PlainMessage<PauseCronJobsRequest> | undefined): boolean {
+    return proto3.util.equals(PauseCronJobsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.PauseCronJobsResponse
+ */
+export class PauseCronJobsResponse extends Message<PauseCronJobsResponse> {
+  constructor(data?: PartialMessage<PauseCronJobsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.PauseCronJobsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PauseCronJobsResponse {
+    return new PauseCronJobsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PauseCronJobsResponse {
+    return new PauseCronJobsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PauseCronJobsResponse {
+    return new PauseCronJobsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PauseCronJobsResponse | PlainMessage<PauseCronJobsResponse> | undefined, b: PauseCronJobsResponse | PlainMessage<PauseCronJobsResponse> | undefined): boolean {
+    return proto3.util.equals(PauseCronJobsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ResumeCronJobsRequest
+ */
+export class ResumeCronJobsRequest extends Message<ResumeCronJobsRequest> {
+  /**
+   * @generated from field: string module = 1;
+   */
+  module = "";
+
+  /**
+   * Only resume the job of this verb, rather than every job of the module.
+   *
+   * @generated from field: optional string verb = 2;
+   */
+  verb?: string;
+
+  constructor(data?: PartialMessage<ResumeCronJobsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ResumeCronJobsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "module", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "verb", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResumeCronJobsRequest {
+    return new ResumeCronJobsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResumeCronJobsRequest {
+    return new ResumeCronJobsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResumeCronJobsRequest {
+    return new ResumeCronJobsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ResumeCronJobsRequest | PlainMessage<ResumeCronJobsRequest> | undefined, b: ResumeCronJobsRequest | PlainMessage<ResumeCronJobsRequest> | undefined): boolean {
+    return proto3.util.equals(ResumeCronJobsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ResumeCronJobsResponse
+ */
+export class ResumeCronJobsResponse extends Message<ResumeCronJobsResponse> {
+  constructor(data?: PartialMessage<ResumeCronJobsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ResumeCronJobsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResumeCronJobsResponse {
+    return new ResumeCronJobsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResumeCronJobsResponse {
+    return new ResumeCronJobsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResumeCronJobsResponse {
+    return new ResumeCronJobsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ResumeCronJobsResponse | PlainMessage<ResumeCronJobsResponse> | undefined, b: ResumeCronJobsResponse | PlainMessage<ResumeCronJobsResponse> | undefined): boolean {
+    return proto3.util.equals(ResumeCronJobsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.TriggerCronJobRequest
+ */
+export class TriggerCronJobRequest extends Message<TriggerCronJobRequest> {
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Ref verb = 1;
+   */
+  verb?: Ref;
+
+  constructor(data?: PartialMessage<TriggerCronJobRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.TriggerCronJobRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "verb", kind: "message", T: Ref },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TriggerCronJobRequest {
+    return new TriggerCronJobRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TriggerCronJobRequest {
+    return new TriggerCronJobRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TriggerCronJobRequest {
+    return new TriggerCronJobRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TriggerCronJobRequest | PlainMessage<TriggerCronJobRequest> | undefined, b: TriggerCronJobRequest | PlainMessage<TriggerCronJobRequest> | undefined): boolean {
+    return proto3.util.equals(TriggerCronJobRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.TriggerCronJobResponse
+ */
+export class TriggerCronJobResponse extends Message<TriggerCronJobResponse> {
+  constructor(data?: PartialMessage<TriggerCronJobResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.TriggerCronJobResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TriggerCronJobResponse {
+    return new TriggerCronJobResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TriggerCronJobResponse {
+    return new TriggerCronJobResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TriggerCronJobResponse {
+    return new TriggerCronJobResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TriggerCronJobResponse | PlainMessage<TriggerCronJobResponse> | undefined, b: TriggerCronJobResponse | PlainMessage<TriggerCronJobResponse> | undefined): boolean {
+    return proto3.util.equals(TriggerCronJobResponse, a, b);
+  }
+}
+
+/**
+ * A fault injected into a percentage of calls to the verbs of a module.
+ *
+ * @generated from message xyz.block.ftl.v1.Fault
+ */
+export class Fault extends Message<Fault> {
+  /**
+   * Assigned by the controller.
+   *
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: xyz.block.ftl.v1.Fault.Kind kind = 2;
+   */
+  kind = Fault_Kind.UNSPECIFIED;
+
+  /**
+   * @generated from field: string module = 3;
+   */
+  module = "";
+
+  /**
+   * Only inject the fault into calls to this verb, rather than every verb of
+   * the module.
+   *
+   * @generated from field: optional string verb = 4;
+   */
+  verb?: string;
+
+  /**
+   * Percentage of calls the fault is injected into, between 0 and 100.
+   *
+   * @generated from field: double percent = 5;
+   */
+  percent = 0;
+
+  /**
+   * @generated from field: optional google.protobuf.Duration latency = 6;
+   */
+  latency?: Duration;
+
+  /**
+   * @generated from field: optional string message = 7;
+   */
+  message?: string;
+
+  /**
+   * Set by the controller.
+   *
+   * @generated from field: google.protobuf.Timestamp expires_at = 8;
+   */
+  expiresAt?: Timestamp;
+
+  constructor(data?: PartialMessage<Fault>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.Fault";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "kind", kind: "enum", T: proto3.getEnumType(Fault_Kind) },
+    { no: 3, name: "module", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "verb", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 5, name: "percent", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 6, name: "latency", kind: "message", T: Duration, opt: true },
+    { no: 7, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 8, name: "expires_at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Fault {
+    return new Fault().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Fault {
+    return new Fault().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Fault {
+    return new Fault().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Fault | PlainMessage<Fault> | undefined, b: Fault | PlainMessage<Fault> | undefined): boolean {
+    return proto3.util.equals(Fault, a, b);
+  }
+}
+
+/**
+ * @generated from enum xyz.block.ftl.v1.Fault.Kind
+ */
+export enum Fault_Kind {
+  /**
+   * @generated from enum value: KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Delay calls by the latency.
+   *
+   * @generated from enum value: KIND_LATENCY = 1;
+   */
+  LATENCY = 1,
+
+  /**
+   * Fail calls with the message.
+   *
+   * @generated from enum value: KIND_ERROR = 2;
+   */
+  ERROR = 2,
+
+  /**
+   * Fail async calls without calling the verb, as if they were lost.
+   *
+   * @generated from enum value: KIND_DROP_ASYNC = 3;
+   */
+  DROP_ASYNC = 3,
+
+  /**
+   * Delay the execution of async calls by the latency.
+   *
+   * @generated from enum value: KIND_DELAY_SCHEDULING = 4;
+   */
+  DELAY_SCHEDULING = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(Fault_Kind)
+proto3.util.setEnumType(Fault_Kind, "xyz.block.ftl.v1.Fault.Kind", [
+  { no: 0, name: "KIND_UNSPECIFIED" },
+  { no: 1, name: "KIND_LATENCY" },
+  { no: 2, name: "KIND_ERROR" },
+  { no: 3, name: "KIND_DROP_ASYNC" },
+  { no: 4, name: "KIND_DELAY_SCHEDULING" },
+]);
+
+/**
+ * @generated from message xyz.block.ftl.v1.InjectFaultRequest
+ */
+export class InjectFaultRequest extends Message<InjectFaultRequest> {
+  /**
+   * @generated from field: xyz.block.ftl.v1.Fault fault = 1;
+   */
+  fault?: Fault;
+
+  /**
+   * How long to inject the fault for.
+   *
+   * @generated from field: google.protobuf.Duration duration = 2;
+   */
+  duration?: Duration;
+
+  constructor(data?: PartialMessage<InjectFaultRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.InjectFaultRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "fault", kind: "message", T: Fault },
+    { no: 2, name: "duration", kind: "message", T: Duration },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): InjectFaultRequest {
+    return new InjectFaultRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): InjectFaultRequest {
+    return new InjectFaultRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): InjectFaultRequest {
+    return new InjectFaultRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: InjectFaultRequest | PlainMessage<InjectFaultRequest> | undefined, b: InjectFaultRequest | PlainMessage<InjectFaultRequest> | undefined): boolean {
+    return proto3.util.equals(InjectFaultRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.InjectFaultResponse
+ */
+export class InjectFaultResponse extends Message<InjectFaultResponse> {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 2;
+   */
+  expiresAt?: Timestamp;
+
+  constructor(data?: PartialMessage<InjectFaultResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.InjectFaultResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "expires_at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): InjectFaultResponse {
+    return new InjectFaultResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): InjectFaultResponse {
+    return new InjectFaultResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): InjectFaultResponse {
+    return new InjectFaultResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: InjectFaultResponse | PlainMessage<InjectFaultResponse> | undefined, b: InjectFaultResponse | PlainMessage<InjectFaultResponse> | undefined): boolean {
+    return proto3.util.equals(InjectFaultResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ListFaultsRequest
+ */
+export class ListFaultsRequest extends Message<ListFaultsRequest> {
+  constructor(data?: PartialMessage<ListFaultsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ListFaultsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListFaultsRequest {
+    return new ListFaultsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListFaultsRequest {
+    return new ListFaultsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListFaultsRequest {
+    return new ListFaultsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListFaultsRequest | PlainMessage<ListFaultsRequest> | undefined, b: ListFaultsRequest | PlainMessage<ListFaultsRequest> | undefined): boolean {
+    return proto3.util.equals(ListFaultsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ListFaultsResponse
+ */
+export class ListFaultsResponse extends Message<ListFaultsResponse> {
+  /**
+   * @generated from field: repeated xyz.block.ftl.v1.Fault faults = 1;
+   */
+  faults: Fault[] = [];
+
+  constructor(data?: PartialMessage<ListFaultsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ListFaultsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "faults", kind: "message", T: Fault, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListFaultsResponse {
+    return new ListFaultsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListFaultsResponse {
+    return new ListFaultsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListFaultsResponse {
+    return new ListFaultsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListFaultsResponse | PlainMessage<ListFaultsResponse> | undefined, b: ListFaultsResponse | PlainMessage<ListFaultsResponse> | undefined): boolean {
+    return proto3.util.equals(ListFaultsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ClearFaultsRequest
+ */
+export class ClearFaultsRequest extends Message<ClearFaultsRequest> {
+  /**
+   * Only clear the fault with this ID, rather than every fault.
+   *
+   * @generated from field: optional int64 id = 1;
+   */
+  id?: bigint;
+
+  constructor(data?: PartialMessage<ClearFaultsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ClearFaultsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ClearFaultsRequest {
+    return new ClearFaultsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ClearFaultsRequest {
+    return new ClearFaultsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ClearFaultsRequest {
+    return new ClearFaultsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ClearFaultsRequest | PlainMessage<ClearFaultsRequest> | undefined, b: ClearFaultsRequest | PlainMessage<ClearFaultsRequest> | undefined): boolean {
+    return proto3.util.equals(ClearFaultsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ClearFaultsResponse
+ */
+export class ClearFaultsResponse extends Message<ClearFaultsResponse> {
+  /**
+   * @generated from field: int64 cleared = 1;
+   */
+  cleared = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ClearFaultsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ClearFaultsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cleared", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ClearFaultsResponse {
+    return new ClearFaultsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ClearFaultsResponse {
+    return new ClearFaultsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ClearFaultsResponse {
+    return new ClearFaultsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ClearFaultsResponse | PlainMessage<ClearFaultsResponse> | undefined, b: ClearFaultsResponse | PlainMessage<ClearFaultsResponse> | undefined): boolean {
+    return proto3.util.equals(ClearFaultsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.DeployRequest
+ */
+export class DeployRequest extends Message<DeployRequest> {
+  /**
+   * @generated from field: string deployment_key = 1;
+   */
+  deploymentKey = "";
+
+  constructor(data?: PartialMessage<DeployRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.DeployRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "deployment_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeployRequest {
+    return new DeployRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeployRequest {
+    return new DeployRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeployRequest {
+    return new DeployRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeployRequest | PlainMessage<DeployRequest> | undefined, b: DeployRequest | PlainMessage<DeployRequest> | undefined): boolean {
+    return proto3.util.equals(DeployRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.DeployResponse
+ */
+export class DeployResponse extends Message<DeployResponse> {
+  constructor(data?: PartialMessage<DeployResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.DeployResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeployResponse {
+    return new DeployResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeployResponse {
+    return new DeployResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeployResponse {
+    return new DeployResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeployResponse | PlainMessage<DeployResponse> | undefined, b: DeployResponse | PlainMessage<DeployResponse> | undefined): boolean {
+    return proto3.util.equals(DeployResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.TerminateRequest
+ */
+export class TerminateRequest extends Message<TerminateRequest> {
+  /**
+   * @generated from field: string deployment_key = 1;
+   */
+  deploymentKey = "";
+
+  /**
+   * Maximum time to wait for in-flight calls to complete before terminating
+   * the deployment. Calls are not drained if unset.
+   *
+   * @generated from field: optional google.protobuf.Duration drain_timeout = 2;
+   */
+  drainTimeout?: Duration;
+
+  constructor(data?: PartialMessage<TerminateRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.TerminateRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "deployment_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "drain_timeout", kind: "message", T: Duration, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TerminateRequest {
+    return new TerminateRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TerminateRequest {
+    return new TerminateRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TerminateRequest {
+    return new TerminateRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TerminateRequest | PlainMessage<TerminateRequest> | undefined, b: TerminateRequest | PlainMessage<TerminateRequest> | undefined): boolean {
+    return proto3.util.equals(TerminateRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ReserveRequest
+ */
+export class ReserveRequest extends Message<ReserveRequest> {
+  /**
+   * @generated from field: string deployment_key = 1;
+   */
+  deploymentKey = "";
+
+  constructor(data?: PartialMessage<ReserveRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ReserveRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "deployment_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReserveRequest {
+    return new ReserveRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReserveRequest {
+    return new ReserveRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReserveRequest {
+    return new ReserveRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReserveRequest | PlainMessage<ReserveRequest> | undefined, b: ReserveRequest | PlainMessage<ReserveRequest> | undefined): boolean {
+    return proto3.util.equals(ReserveRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ReserveResponse
+ */
+export class ReserveResponse extends Message<ReserveResponse> {
+  constructor(data?: PartialMessage<ReserveResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ReserveResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReserveResponse {
+    return new ReserveResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReserveResponse {
+    return new ReserveResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReserveResponse {
+    return new ReserveResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReserveResponse | PlainMessage<ReserveResponse> | undefined, b: ReserveResponse | PlainMessage<ReserveResponse> | undefined): boolean {
+    return proto3.util.equals(ReserveResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ConfigRef
+ */
+export class ConfigRef extends Message<ConfigRef> {
+  /**
+   * @generated from field: optional string module = 1;
+   */
+  module?: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<ConfigRef>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ConfigRef";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "module", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConfigRef {
+    return new ConfigRef().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConfigRef {
+    return new ConfigRef().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConfigRef {
+    return new ConfigRef().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ConfigRef | PlainMessage<ConfigRef> | undefined, b: ConfigRef | PlainMessage<ConfigRef> | undefined): boolean {
+    return proto3.util.equals(ConfigRef, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ListConfigRequest
+ */
+export class ListConfigRequest extends Message<ListConfigRequest> {
+  /**
+   * @generated from field: optional string module = 1;
+   */
+  module?: string;
+
+  /**
+   * @generated from field: optional bool include_values = 2;
+   */
+  includeValues?: boolean;
+
+  constructor(data?: PartialMessage<ListConfigRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ListConfigRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "module", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "include_values", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListConfigRequest {
+    return new ListConfigRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListConfigRequest {
+    return new ListConfigRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListConfigRequest {
+    return new ListConfigRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListConfigRequest | PlainMessage<ListConfigRequest> | undefined, b: ListConfigRequest | PlainMessage<ListConfigRequest> | undefined): boolean {
+    return proto3.util.equals(ListConfigRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ListConfigResponse
+ */
+export class ListConfigResponse extends Message<ListConfigResponse> {
+  /**
+   * @generated from field: repeated xyz.block.ftl.v1.ListConfigResponse.Config configs = 1;
+   */
+  configs: ListConfigResponse_Config[] = [];
+
+  constructor(data?: PartialMessage<ListConfigResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ListConfigResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "configs", kind: "message", T: ListConfigResponse_Config, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListConfigResponse {
+    return new ListConfigResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListConfigResponse {
+    return new ListConfigResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListConfigResponse {
+    return new ListConfigResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListConfigResponse | PlainMessage<ListConfigResponse> | undefined, b: ListConfigResponse | PlainMessage<ListConfigResponse> | undefined): boolean {
+    return proto3.util.equals(ListConfigResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ListConfigResponse.Config
+ */
+export class ListConfigResponse_Config extends Message<ListConfigResponse_Config> {
+  /**
+   * @generated from field: string refPath = 1;
+   */
+  refPath = "";
+
+  /**
+   * @generated from field: optional bytes value = 2;
+   */
+  value?: Uint8Array;
+
+  constructor(data?: PartialMessage<ListConfigResponse_Config>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ListConfigResponse.Config";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "refPath", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "value", kind: "scalar", T: 12 /* ScalarType.BYTES */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListConfigResponse_Config {
+    return new ListConfigResponse_Config().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListConfigResponse_Config {
+    return new ListConfigResponse_Config().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListConfigResponse_Config {
+    return new ListConfigResponse_Config().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListConfigResponse_Config | PlainMessage<ListConfigResponse_Config> | undefined, b: ListConfigResponse_Config | PlainMessage<ListConfigResponse_Config> | undefined): boolean {
+    return proto3.util.equals(ListConfigResponse_Config, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.GetConfigRequest
+ */
+export class GetConfigRequest extends Message<GetConfigRequest> {
+  /**
+   * @generated from field: xyz.block.ftl.v1.ConfigRef ref = 1;
+   */
+  ref?: ConfigRef;
+
+  constructor(data?: PartialMessage<GetConfigRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.GetConfigRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ref", kind: "message", T: ConfigRef },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetConfigRequest {
+    return new GetConfigRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetConfigRequest {
+    return new GetConfigRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetConfigRequest {
+    return new GetConfigRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetConfigRequest | PlainMessage<GetConfigRequest> | undefined, b: GetConfigRequest | PlainMessage<GetConfigRequest> | undefined): boolean {
+    return proto3.util.equals(GetConfigRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.GetConfigResponse
+ */
+export class GetConfigResponse extends Message<GetConfigResponse> {
+  /**
+   * @generated from field: bytes value = 1;
+   */
+  value = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<GetConfigResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.GetConfigResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "value", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetConfigResponse {
+    return new GetConfigResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetConfigResponse {
+    return new GetConfigResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetConfigResponse {
+    return new GetConfigResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetConfigResponse | PlainMessage<GetConfigResponse> | undefined, b: GetConfigResponse | PlainMessage<GetConfigResponse> | undefined): boolean {
+    return proto3.util.equals(GetConfigResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.SetConfigRequest
+ */
+export class SetConfigRequest extends Message<SetConfigRequest> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.ConfigProvider provider = 1;
+   */
+  provider?: ConfigProvider;
+
+  /**
+   * @generated from field: xyz.block.ftl.v1.ConfigRef ref = 2;
+   */
+  ref?: ConfigRef;
+
+  /**
+   * @generated from field: bytes value = 3;
+   */
+  value = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<SetConfigRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.SetConfigRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "provider", kind: "enum", T: proto3.getEnumType(ConfigProvider), opt: true },
+    { no: 2, name: "ref", kind: "message", T: ConfigRef },
+    { no: 3, name: "value", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetConfigRequest {
+    return new SetConfigRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetConfigRequest {
+    return new SetConfigRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetConfigRequest {
+    return new SetConfigRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetConfigRequest | PlainMessage<SetConfigRequest> | undefined, b: SetConfigRequest | PlainMessage<SetConfigRequest> | undefined): boolean {
+    return proto3.util.equals(SetConfigRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.SetConfigResponse
+ */
+export class SetConfigResponse extends Message<SetConfigResponse> {
+  constructor(data?: PartialMessage<SetConfigResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.SetConfigResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetConfigResponse {
+    return new SetConfigResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetConfigResponse {
+    return new SetConfigResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetConfigResponse {
+    return new SetConfigResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetConfigResponse | PlainMessage<SetConfigResponse> | undefined, b: SetConfigResponse | PlainMessage<SetConfigResponse> | undefined): boolean {
+    return proto3.util.equals(SetConfigResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.UnsetConfigRequest
+ */
+export class UnsetConfigRequest extends Message<UnsetConfigRequest> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.ConfigProvider provider = 1;
+   */
+  provider?: ConfigProvider;
+
+  /**
+   * @generated from field: xyz.block.ftl.v1.ConfigRef ref = 2;
+   */
+  ref?: ConfigRef;
+
+  constructor(data?: PartialMessage<UnsetConfigRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.UnsetConfigRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "provider", kind: "enum", T: proto3.getEnumType(ConfigProvider), opt: true },
+    { no: 2, name: "ref", kind: "message", T: ConfigRef },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnsetConfigRequest {
+    return new UnsetConfigRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnsetConfigRequest {
+    return new UnsetConfigRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnsetConfigRequest {
+    return new UnsetConfigRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UnsetConfigRequest | PlainMessage<UnsetConfigRequest> | undefined, b: UnsetConfigRequest | PlainMessage<UnsetConfigRequest> | undefined): boolean {
+    return proto3.util.equals(UnsetConfigRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.UnsetConfigResponse
+ */
+export class UnsetConfigResponse extends Message<UnsetConfigResponse> {
+  constructor(data?: PartialMessage<UnsetConfigResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.UnsetConfigResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnsetConfigResponse {
+    return new UnsetConfigResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnsetConfigResponse {
+    return new UnsetConfigResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnsetConfigResponse {
+    return new UnsetConfigResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UnsetConfigResponse | PlainMessage<UnsetConfigResponse> | undefined, b: UnsetConfigResponse | PlainMessage<UnsetConfigResponse> | undefined): boolean {
+    return proto3.util.equals(UnsetConfigResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ListSecretsRequest
+ */
+export class ListSecretsRequest extends Message<ListSecretsRequest> {
+  /**
+   * @generated from field: optional string module = 1;
+   */
+  module?: string;
+
+  /**
+   * @generated from field: optional bool include_values = 2;
+   */
+  includeValues?: boolean;
+
+  constructor(data?: PartialMessage<ListSecretsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ListSecretsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "module", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "include_values", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSecretsRequest {
+    return new ListSecretsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSecretsRequest {
+    return new ListSecretsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSecretsRequest {
+    return new ListSecretsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListSecretsRequest | PlainMessage<ListSecretsRequest> | undefined, b: ListSecretsRequest | PlainMessage<ListSecretsRequest> | undefined): boolean {
+    return proto3.util.equals(ListSecretsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ListSecretsResponse
+ */
+export class ListSecretsResponse extends Message<ListSecretsResponse> {
+  /**
+   * @generated from field: repeated xyz.block.ftl.v1.ListSecretsResponse.Secret secrets = 1;
+   */
+  secrets: ListSecretsResponse_Secret[] = [];
+
+  constructor(data?: PartialMessage<ListSecretsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ListSecretsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "secrets", kind: "message", T: ListSecretsResponse_Secret, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSecretsResponse {
+    return new ListSecretsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSecretsResponse {
+    return new ListSecretsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSecretsResponse {
+    return new ListSecretsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListSecretsResponse | PlainMessage<ListSecretsResponse> | undefined, b: ListSecretsResponse | PlainMessage<ListSecretsResponse> | undefined): boolean {
+    return proto3.util.equals(ListSecretsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ListSecretsResponse.Secret
+ */
+export class ListSecretsResponse_Secret extends Message<ListSecretsResponse_Secret> {
+  /**
+   * @generated from field: string refPath = 1;
+   */
+  refPath = "";
+
+  /**
+   * @generated from field: optional bytes value = 2;
+   */
+  value?: Uint8Array;
+
+  constructor(data?: PartialMessage<ListSecretsResponse_Secret>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ListSecretsResponse.Secret";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "refPath", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "value", kind: "scalar", T: 12 /* ScalarType.BYTES */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSecretsResponse_Secret {
+    return new ListSecretsResponse_Secret().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSecretsResponse_Secret {
+    return new ListSecretsResponse_Secret().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSecretsResponse_Secret {
+    return new ListSecretsResponse_Secret().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListSecretsResponse_Secret | PlainMessage<ListSecretsResponse_Secret> | undefined, b: ListSecretsResponse_Secret | PlainMessage<ListSecretsResponse_Secret> | undefined): boolean {
+    return proto3.util.equals(ListSecretsResponse_Secret, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.GetSecretRequest
+ */
+export class GetSecretRequest extends Message<GetSecretRequest> {
+  /**
+   * @generated from field: xyz.block.ftl.v1.ConfigRef ref = 1;
+   */
+  ref?: ConfigRef;
+
+  constructor(data?: PartialMessage<GetSecretRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.GetSecretRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "ref", kind: "message", T: ConfigRef },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSecretRequest {
-    return new GetSecretRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSecretRequest {
+    return new GetSecretRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSecretRequest {
+    return new GetSecretRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSecretRequest {
+    return new GetSecretRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSecretRequest | PlainMessage<GetSecretRequest> | undefined, b: GetSecretRequest | PlainMessage<GetSecretRequest> | undefined): boolean {
+    return proto3.util.equals(GetSecretRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.GetSecretResponse
+ */
+export class GetSecretResponse extends Message<GetSecretResponse> {
+  /**
+   * @generated from field: bytes value = 1;
+   */
+  value = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<GetSecretResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.GetSecretResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "value", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSecretResponse {
+    return new GetSecretResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSecretResponse {
+    return new GetSecretResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSecretResponse {
+    return new GetSecretResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSecretResponse | PlainMessage<GetSecretResponse> | undefined, b: GetSecretResponse | PlainMessage<GetSecretResponse> | undefined): boolean {
+    return proto3.util.equals(GetSecretResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.SetSecretRequest
+ */
+export class SetSecretRequest extends Message<SetSecretRequest> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.SecretProvider provider = 1;
+   */
+  provider?: SecretProvider;
+
+  /**
+   * @generated from field: xyz.block.ftl.v1.ConfigRef ref = 2;
+   */
+  ref?: ConfigRef;
+
+  /**
+   * @generated from field: bytes value = 3;
+   */
+  value = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<SetSecretRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.SetSecretRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "provider", kind: "enum", T: proto3.getEnumType(SecretProvider), opt: true },
+    { no: 2, name: "ref", kind: "message", T: ConfigRef },
+    { no: 3, name: "value", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetSecretRequest {
+    return new SetSecretRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetSecretRequest {
+    return new SetSecretRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetSecretRequest {
+    return new SetSecretRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetSecretRequest | PlainMessage<SetSecretRequest> | undefined, b: SetSecretRequest | PlainMessage<SetSecretRequest> | undefined): boolean {
+    return proto3.util.equals(SetSecretRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.SetSecretResponse
+ */
+export class SetSecretResponse extends Message<SetSecretResponse> {
+  constructor(data?: PartialMessage<SetSecretResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.SetSecretResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetSecretResponse {
+    return new SetSecretResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetSecretResponse {
+    return new SetSecretResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetSecretResponse {
+    return new SetSecretResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetSecretResponse | PlainMessage<SetSecretResponse> | undefined, b: SetSecretResponse | PlainMessage<SetSecretResponse> | undefined): boolean {
+    return proto3.util.equals(SetSecretResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.UnsetSecretRequest
+ */
+export class UnsetSecretRequest extends Message<UnsetSecretRequest> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.SecretProvider provider = 1;
+   */
+  provider?: SecretProvider;
+
+  /**
+   * @generated from field: xyz.block.ftl.v1.ConfigRef ref = 2;
+   */
+  ref?: ConfigRef;
+
+  constructor(data?: PartialMessage<UnsetSecretRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.UnsetSecretRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "provider", kind: "enum", T: proto3.getEnumType(SecretProvider), opt: true },
+    { no: 2, name: "ref", kind: "message", T: ConfigRef },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnsetSecretRequest {
+    return new UnsetSecretRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnsetSecretRequest {
+    return new UnsetSecretRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnsetSecretRequest {
+    return new UnsetSecretRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UnsetSecretRequest | PlainMessage<UnsetSecretRequest> | undefined, b: UnsetSecretRequest | PlainMessage<UnsetSecretRequest> | undefined): boolean {
+    return proto3.util.equals(UnsetSecretRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.UnsetSecretResponse
+ */
+export class UnsetSecretResponse extends Message<UnsetSecretResponse> {
+  constructor(data?: PartialMessage<UnsetSecretResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.UnsetSecretResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnsetSecretResponse {
+    return new UnsetSecretResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnsetSecretResponse {
+    return new UnsetSecretResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnsetSecretResponse {
+    return new UnsetSecretResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UnsetSecretResponse | PlainMessage<UnsetSecretResponse> | undefined, b: UnsetSecretResponse | PlainMessage<UnsetSecretResponse> | undefined): boolean {
+    return proto3.util.equals(UnsetSecretResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ProjectSpec
+ */
+export class ProjectSpec extends Message<ProjectSpec> {
+  /**
+   * @generated from field: string description = 1;
+   */
+  description = "";
+
+  /**
+   * Team or person who owns the project.
+   *
+   * @generated from field: string owner = 2;
+   */
+  owner = "";
+
+  constructor(data?: PartialMessage<ProjectSpec>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ProjectSpec";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "owner", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ProjectSpec {
+    return new ProjectSpec().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ProjectSpec {
+    return new ProjectSpec().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ProjectSpec {
+    return new ProjectSpec().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ProjectSpec | PlainMessage<ProjectSpec> | undefined, b: ProjectSpec | PlainMessage<ProjectSpec> | undefined): boolean {
+    return proto3.util.equals(ProjectSpec, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ConfigSpec
+ */
+export class ConfigSpec extends Message<ConfigSpec> {
+  /**
+   * JSON encoded value.
+   *
+   * @generated from field: bytes value = 1;
+   */
+  value = new Uint8Array(0);
+
+  /**
+   * Provider to store the value in when it is created or updated.
+   *
+   * @generated from field: optional xyz.block.ftl.v1.ConfigProvider provider = 2;
+   */
+  provider?: ConfigProvider;
+
+  constructor(data?: PartialMessage<ConfigSpec>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ConfigSpec";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "value", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "provider", kind: "enum", T: proto3.getEnumType(ConfigProvider), opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConfigSpec {
+    return new ConfigSpec().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConfigSpec {
+    return new ConfigSpec().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConfigSpec {
+    return new ConfigSpec().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ConfigSpec | PlainMessage<ConfigSpec> | undefined, b: ConfigSpec | PlainMessage<ConfigSpec> | undefined): boolean {
+    return proto3.util.equals(ConfigSpec, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.SecretRefSpec
+ */
+export class SecretRefSpec extends Message<SecretRefSpec> {
+  /**
+   * URL of the secret in its provider, eg. "op://vault/item".
+   *
+   * @generated from field: string url = 1;
+   */
+  url = "";
+
+  constructor(data?: PartialMessage<SecretRefSpec>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.SecretRefSpec";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SecretRefSpec {
+    return new SecretRefSpec().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SecretRefSpec {
+    return new SecretRefSpec().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SecretRefSpec {
+    return new SecretRefSpec().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SecretRefSpec | PlainMessage<SecretRefSpec> | undefined, b: SecretRefSpec | PlainMessage<SecretRefSpec> | undefined): boolean {
+    return proto3.util.equals(SecretRefSpec, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.QuotaSpec
+ */
+export class QuotaSpec extends Message<QuotaSpec> {
+  /**
+   * @generated from field: int64 limit = 1;
+   */
+  limit = protoInt64.zero;
+
+  constructor(data?: PartialMessage<QuotaSpec>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.QuotaSpec";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "limit", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QuotaSpec {
+    return new QuotaSpec().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QuotaSpec {
+    return new QuotaSpec().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QuotaSpec {
+    return new QuotaSpec().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: QuotaSpec | PlainMessage<QuotaSpec> | undefined, b: QuotaSpec | PlainMessage<QuotaSpec> | undefined): boolean {
+    return proto3.util.equals(QuotaSpec, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.IngressDomainSpec
+ */
+export class IngressDomainSpec extends Message<IngressDomainSpec> {
+  /**
+   * Project whose modules serve the domain, which must exist.
+   *
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  constructor(data?: PartialMessage<IngressDomainSpec>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.IngressDomainSpec";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): IngressDomainSpec {
+    return new IngressDomainSpec().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): IngressDomainSpec {
+    return new IngressDomainSpec().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): IngressDomainSpec {
+    return new IngressDomainSpec().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: IngressDomainSpec | PlainMessage<IngressDomainSpec> | undefined, b: IngressDomainSpec | PlainMessage<IngressDomainSpec> | undefined): boolean {
+    return proto3.util.equals(IngressDomainSpec, a, b);
+  }
+}
+
+/**
+ * A resource managed with the admin resource API.
+ *
+ * @generated from message xyz.block.ftl.v1.AdminResource
+ */
+export class AdminResource extends Message<AdminResource> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * Opaque concurrency token identifying the current state of the resource.
+   *
+   * It is set by the controller, and can be passed to updates and deletions
+   * to fail them if the resource has changed since it was read.
+   *
+   * @generated from field: string version = 2;
+   */
+  version = "";
+
+  /**
+   * @generated from oneof xyz.block.ftl.v1.AdminResource.spec
+   */
+  spec: {
+    /**
+     * @generated from field: xyz.block.ftl.v1.ProjectSpec project = 3;
+     */
+    value: ProjectSpec;
+    case: "project";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.ConfigSpec config = 4;
+     */
+    value: ConfigSpec;
+    case: "config";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.SecretRefSpec secret_ref = 5;
+     */
+    value: SecretRefSpec;
+    case: "secretRef";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.QuotaSpec quota = 6;
+     */
+    value: QuotaSpec;
+    case: "quota";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.IngressDomainSpec ingress_domain = 7;
+     */
+    value: IngressDomainSpec;
+    case: "ingressDomain";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  constructor(data?: PartialMessage<AdminResource>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.AdminResource";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "project", kind: "message", T: ProjectSpec, oneof: "spec" },
+    { no: 4, name: "config", kind: "message", T: ConfigSpec, oneof: "spec" },
+    { no: 5, name: "secret_ref", kind: "message", T: SecretRefSpec, oneof: "spec" },
+    { no: 6, name: "quota", kind: "message", T: QuotaSpec, oneof: "spec" },
+    { no: 7, name: "ingress_domain", kind: "message", T: IngressDomainSpec, oneof: "spec" },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AdminResource {
+    return new AdminResource().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSecretRequest {
-    return new GetSecretRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AdminResource {
+    return new AdminResource().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSecretRequest {
-    return new GetSecretRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AdminResource {
+    return new AdminResource().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetSecretRequest | PlainMessage<GetSecretRequest> | undefined, b: GetSecretRequest | PlainMessage<GetSecretRequest> | undefined): boolean {
-    return proto3.util.equals(GetSecretRequest, a, b);
+  static equals(a: AdminResource | PlainMessage<AdminResource> | undefined, b: AdminResource | PlainMessage<AdminResource> | undefined): boolean {
+    return proto3.util.equals(AdminResource, a, b);
   }
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.GetSecretResponse
+ * @generated from message xyz.block.ftl.v1.ListAdminResourcesRequest
  */
-export class GetSecretResponse extends Message<GetSecretResponse> {
+export class ListAdminResourcesRequest extends Message<ListAdminResourcesRequest> {
   /**
-   * @generated from field: bytes value = 1;
+   * @generated from field: xyz.block.ftl.v1.AdminResourceKind kind = 1;
    */
-  value = new Uint8Array(0);
+  kind = AdminResourceKind.UNSPECIFIED;
 
-  constructor(data?: PartialMessage<GetSecretResponse>) {
+  constructor(data?: PartialMessage<ListAdminResourcesRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "xyz.block.ftl.v1.GetSecretResponse";
+  static readonly typeName = "xyz.block.ftl.v1.ListAdminResourcesRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "value", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 1, name: "kind", kind: "enum", T: proto3.getEnumType(AdminResourceKind) },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSecretResponse {
-    return new GetSecretResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAdminResourcesRequest {
+    return new ListAdminResourcesRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSecretResponse {
-    return new GetSecretResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAdminResourcesRequest {
+    return new ListAdminResourcesRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSecretResponse {
-    return new GetSecretResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAdminResourcesRequest {
+    return new ListAdminResourcesRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetSecretResponse | PlainMessage<GetSecretResponse> | undefined, b: GetSecretResponse | PlainMessage<GetSecretResponse> | undefined): boolean {
-    return proto3.util.equals(GetSecretResponse, a, b);
+  static equals(a: ListAdminResourcesRequest | PlainMessage<ListAdminResourcesRequest> | undefined, b: ListAdminResourcesRequest | PlainMessage<ListAdminResourcesRequest> | undefined): boolean {
+    return proto3.util.equals(ListAdminResourcesRequest, a, b);
   }
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.SetSecretRequest
+ * @generated from message xyz.block.ftl.v1.ListAdminResourcesResponse
  */
-export class SetSecretRequest extends Message<SetSecretRequest> {
+export class ListAdminResourcesResponse extends Message<ListAdminResourcesResponse> {
   /**
-   * @generated from field: optional xyz.block.ftl.v1.SecretProvider provider = 1;
+   * @generated from field: repeated xyz.block.ftl.v1.AdminResource resources = 1;
    */
-  provider?: SecretProvider;
+  resources: AdminResource[] = [];
+
+  constructor(data?: PartialMessage<ListAdminResourcesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ListAdminResourcesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "resources", kind: "message", T: AdminResource, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAdminResourcesResponse {
+    return new ListAdminResourcesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAdminResourcesResponse {
+    return new ListAdminResourcesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAdminResourcesResponse {
+    return new ListAdminResourcesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListAdminResourcesResponse | PlainMessage<ListAdminResourcesResponse> | undefined, b: ListAdminResourcesResponse | PlainMessage<ListAdminResourcesResponse> | undefined): boolean {
+    return proto3.util.equals(ListAdminResourcesResponse, a, b);
+  }
+}
 
+/**
+ * @generated from message xyz.block.ftl.v1.GetAdminResourceRequest
+ */
+export class GetAdminResourceRequest extends Message<GetAdminResourceRequest> {
   /**
-   * @generated from field: xyz.block.ftl.v1.ConfigRef ref = 2;
+   * @generated from field: xyz.block.ftl.v1.AdminResourceKind kind = 1;
    */
-  ref?: ConfigRef;
+  kind = AdminResourceKind.UNSPECIFIED;
 
   /**
-   * @generated from field: bytes value = 3;
+   * @generated from field: string name = 2;
    */
-  value = new Uint8Array(0);
+  name = "";
 
-  constructor(data?: PartialMessage<SetSecretRequest>) {
+  constructor(data?: PartialMessage<GetAdminResourceRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "xyz.block.ftl.v1.SetSecretRequest";
+  static readonly typeName = "xyz.block.ftl.v1.GetAdminResourceRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "provider", kind: "enum", T: proto3.getEnumType(SecretProvider), opt: true },
-    { no: 2, name: "ref", kind: "message", T: ConfigRef },
-    { no: 3, name: "value", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 1, name: "kind", kind: "enum", T: proto3.getEnumType(AdminResourceKind) },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetSecretRequest {
-    return new SetSecretRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAdminResourceRequest {
+    return new GetAdminResourceRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetSecretRequest {
-    return new SetSecretRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAdminResourceRequest {
+    return new GetAdminResourceRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetSecretRequest {
-    return new SetSecretRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAdminResourceRequest {
+    return new GetAdminResourceRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SetSecretRequest | PlainMessage<SetSecretRequest> | undefined, b: SetSecretRequest | PlainMessage<SetSecretRequest> | undefined): boolean {
-    return proto3.util.equals(SetSecretRequest, a, b);
+  static equals(a: GetAdminResourceRequest | PlainMessage<GetAdminResourceRequest> | undefined, b: GetAdminResourceRequest | PlainMessage<GetAdminResourceRequest> | undefined): boolean {
+    return proto3.util.equals(GetAdminResourceRequest, a, b);
   }
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.SetSecretResponse
+ * @generated from message xyz.block.ftl.v1.GetAdminResourceResponse
  */
-export class SetSecretResponse extends Message<SetSecretResponse> {
-  constructor(data?: PartialMessage<SetSecretResponse>) {
+export class GetAdminResourceResponse extends Message<GetAdminResourceResponse> {
+  /**
+   * @generated from field: xyz.block.ftl.v1.AdminResource resource = 1;
+   */
+  resource?: AdminResource;
+
+  constructor(data?: PartialMessage<GetAdminResourceResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "xyz.block.ftl.v1.SetSecretResponse";
+  static readonly typeName = "xyz.block.ftl.v1.GetAdminResourceResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "resource", kind: "message", T: AdminResource },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetSecretResponse {
-    return new SetSecretResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAdminResourceResponse {
+    return new GetAdminResourceResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetSecretResponse {
-    return new SetSecretResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAdminResourceResponse {
+    return new GetAdminResourceResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetSecretResponse {
-    return new SetSecretResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAdminResourceResponse {
+    return new GetAdminResourceResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SetSecretResponse | PlainMessage<SetSecretResponse> | undefined, b: SetSecretResponse | PlainMessage<SetSecretResponse> | undefined): boolean {
-    return proto3.util.equals(SetSecretResponse, a, b);
+  static equals(a: GetAdminResourceResponse | PlainMessage<GetAdminResourceResponse> | undefined, b: GetAdminResourceResponse | PlainMessage<GetAdminResourceResponse> | undefined): boolean {
+    return proto3.util.equals(GetAdminResourceResponse, a, b);
   }
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.UnsetSecretRequest
+ * @generated from message xyz.block.ftl.v1.CreateAdminResourceRequest
  */
-export class UnsetSecretRequest extends Message<UnsetSecretRequest> {
+export class CreateAdminResourceRequest extends Message<CreateAdminResourceRequest> {
   /**
-   * @generated from field: optional xyz.block.ftl.v1.SecretProvider provider = 1;
+   * @generated from field: xyz.block.ftl.v1.AdminResource resource = 1;
    */
-  provider?: SecretProvider;
+  resource?: AdminResource;
+
+  constructor(data?: PartialMessage<CreateAdminResourceRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.CreateAdminResourceRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "resource", kind: "message", T: AdminResource },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateAdminResourceRequest {
+    return new CreateAdminResourceRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateAdminResourceRequest {
+    return new CreateAdminResourceRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateAdminResourceRequest {
+    return new CreateAdminResourceRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateAdminResourceRequest | PlainMessage<CreateAdminResourceRequest> | undefined, b: CreateAdminResourceRequest | PlainMessage<CreateAdminResourceRequest> | undefined): boolean {
+    return proto3.util.equals(CreateAdminResourceRequest, a, b);
+  }
+}
 
+/**
+ * @generated from message xyz.block.ftl.v1.CreateAdminResourceResponse
+ */
+export class CreateAdminResourceResponse extends Message<CreateAdminResourceResponse> {
   /**
-   * @generated from field: xyz.block.ftl.v1.ConfigRef ref = 2;
+   * @generated from field: xyz.block.ftl.v1.AdminResource resource = 1;
    */
-  ref?: ConfigRef;
+  resource?: AdminResource;
 
-  constructor(data?: PartialMessage<UnsetSecretRequest>) {
+  constructor(data?: PartialMessage<CreateAdminResourceResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "xyz.block.ftl.v1.UnsetSecretRequest";
+  static readonly typeName = "xyz.block.ftl.v1.CreateAdminResourceResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "provider", kind: "enum", T: proto3.getEnumType(SecretProvider), opt: true },
-    { no: 2, name: "ref", kind: "message", T: ConfigRef },
+    { no: 1, name: "resource", kind: "message", T: AdminResource },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnsetSecretRequest {
-    return new UnsetSecretRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateAdminResourceResponse {
+    return new CreateAdminResourceResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnsetSecretRequest {
-    return new UnsetSecretRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateAdminResourceResponse {
+    return new CreateAdminResourceResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnsetSecretRequest {
-    return new UnsetSecretRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateAdminResourceResponse {
+    return new CreateAdminResourceResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UnsetSecretRequest | PlainMessage<UnsetSecretRequest> | undefined, b: UnsetSecretRequest | PlainMessage<UnsetSecretRequest> | undefined): boolean {
-    return proto3.util.equals(UnsetSecretRequest, a, b);
+  static equals(a: CreateAdminResourceResponse | PlainMessage<CreateAdminResourceResponse> | undefined, b: CreateAdminResourceResponse | PlainMessage<CreateAdminResourceResponse> | undefined): boolean {
+    return proto3.util.equals(CreateAdminResourceResponse, a, b);
   }
 }
 
 /**
- * @generated from message xyz.block.ftl.v1.UnsetSecretResponse
+ * @generated from message xyz.block.ftl.v1.UpdateAdminResourceRequest
  */
-export class UnsetSecretResponse extends Message<UnsetSecretResponse> {
-  constructor(data?: PartialMessage<UnsetSecretResponse>) {
+export class UpdateAdminResourceRequest extends Message<UpdateAdminResourceRequest> {
+  /**
+   * The resource to update. If its version is set the update fails if the
+   * resource has changed since that version.
+   *
+   * @generated from field: xyz.block.ftl.v1.AdminResource resource = 1;
+   */
+  resource?: AdminResource;
+
+  constructor(data?: PartialMessage<UpdateAdminResourceRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "xyz.block.ftl.v1.UnsetSecretResponse";
+  static readonly typeName = "xyz.block.ftl.v1.UpdateAdminResourceRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "resource", kind: "message", T: AdminResource },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnsetSecretResponse {
-    return new UnsetSecretResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateAdminResourceRequest {
+    return new UpdateAdminResourceRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnsetSecretResponse {
-    return new UnsetSecretResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateAdminResourceRequest {
+    return new UpdateAdminResourceRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnsetSecretResponse {
-    return new UnsetSecretResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateAdminResourceRequest {
+    return new UpdateAdminResourceRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UnsetSecretResponse | PlainMessage<UnsetSecretResponse> | undefined, b: UnsetSecretResponse | PlainMessage<UnsetSecretResponse> | undefined): boolean {
-    return proto3.util.equals(UnsetSecretResponse, a, b);
+  static equals(a: UpdateAdminResourceRequest | PlainMessage<UpdateAdminResourceRequest> | undefined, b: UpdateAdminResourceRequest | PlainMessage<UpdateAdminResourceRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateAdminResourceRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.UpdateAdminResourceResponse
+ */
+export class UpdateAdminResourceResponse extends Message<UpdateAdminResourceResponse> {
+  /**
+   * @generated from field: xyz.block.ftl.v1.AdminResource resource = 1;
+   */
+  resource?: AdminResource;
+
+  constructor(data?: PartialMessage<UpdateAdminResourceResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.UpdateAdminResourceResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "resource", kind: "message", T: AdminResource },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateAdminResourceResponse {
+    return new UpdateAdminResourceResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateAdminResourceResponse {
+    return new UpdateAdminResourceResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateAdminResourceResponse {
+    return new UpdateAdminResourceResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateAdminResourceResponse | PlainMessage<UpdateAdminResourceResponse> | undefined, b: UpdateAdminResourceResponse | PlainMessage<UpdateAdminResourceResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateAdminResourceResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.DeleteAdminResourceRequest
+ */
+export class DeleteAdminResourceRequest extends Message<DeleteAdminResourceRequest> {
+  /**
+   * @generated from field: xyz.block.ftl.v1.AdminResourceKind kind = 1;
+   */
+  kind = AdminResourceKind.UNSPECIFIED;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * If set the deletion fails if the resource has changed since this version.
+   *
+   * @generated from field: string version = 3;
+   */
+  version = "";
+
+  constructor(data?: PartialMessage<DeleteAdminResourceRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.DeleteAdminResourceRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "kind", kind: "enum", T: proto3.getEnumType(AdminResourceKind) },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteAdminResourceRequest {
+    return new DeleteAdminResourceRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteAdminResourceRequest {
+    return new DeleteAdminResourceRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteAdminResourceRequest {
+    return new DeleteAdminResourceRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteAdminResourceRequest | PlainMessage<DeleteAdminResourceRequest> | undefined, b: DeleteAdminResourceRequest | PlainMessage<DeleteAdminResourceRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteAdminResourceRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.DeleteAdminResourceResponse
+ */
+export class DeleteAdminResourceResponse extends Message<DeleteAdminResourceResponse> {
+  constructor(data?: PartialMessage<DeleteAdminResourceResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.DeleteAdminResourceResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteAdminResourceResponse {
+    return new DeleteAdminResourceResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteAdminResourceResponse {
+    return new DeleteAdminResourceResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteAdminResourceResponse {
+    return new DeleteAdminResourceResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteAdminResourceResponse | PlainMessage<DeleteAdminResourceResponse> | undefined, b: DeleteAdminResourceResponse | PlainMessage<DeleteAdminResourceResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteAdminResourceResponse, a, b);
   }
 }
 
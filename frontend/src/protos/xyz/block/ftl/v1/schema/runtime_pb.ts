@@ -83,6 +83,35 @@ export class ModuleRuntime extends Message<ModuleRuntime> {
    */
   arch?: string;
 
+  /**
+   * Project the module is deployed from. Internal declarations are only
+   * visible to modules in the same project.
+   *
+   * @generated from field: optional string project = 6;
+   */
+  project?: string;
+
+  /**
+   * Team or person that owns the module.
+   *
+   * @generated from field: optional string owner = 7;
+   */
+  owner?: string;
+
+  /**
+   * Excerpt from the README of the module.
+   *
+   * @generated from field: optional string readme = 8;
+   */
+  readme?: string;
+
+  /**
+   * Links to the documentation, dashboards, runbooks etc. of the module, by name.
+   *
+   * @generated from field: map<string, string> links = 9;
+   */
+  links: { [key: string]: string } = {};
+
   constructor(data?: PartialMessage<ModuleRuntime>) {
     super();
     proto3.util.initPartial(data, this);
@@ -96,6 +125,10 @@ export class ModuleRuntime extends Message<ModuleRuntime> {
     { no: 3, name: "min_replicas", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
     { no: 4, name: "os", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 5, name: "arch", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 6, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 7, name: "owner", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 8, name: "readme", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 9, name: "links", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ModuleRuntime {
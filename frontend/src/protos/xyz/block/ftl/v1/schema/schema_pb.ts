@@ -257,6 +257,11 @@ export class Data extends Message<Data> {
    */
   metadata: Metadata[] = [];
 
+  /**
+   * @generated from field: bool internal = 8;
+   */
+  internal = false;
+
   constructor(data?: PartialMessage<Data>) {
     super();
     proto3.util.initPartial(data, this);
@@ -272,6 +277,7 @@ export class Data extends Message<Data> {
     { no: 5, name: "typeParameters", kind: "message", T: TypeParameter, repeated: true },
     { no: 6, name: "fields", kind: "message", T: Field, repeated: true },
     { no: 7, name: "metadata", kind: "message", T: Metadata, repeated: true },
+    { no: 8, name: "internal", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Data {
@@ -346,6 +352,55 @@ export class Database extends Message<Database> {
   }
 }
 
+/**
+ * @generated from message xyz.block.ftl.v1.schema.Decimal
+ */
+export class Decimal extends Message<Decimal> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: int64 precision = 2;
+   */
+  precision = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 scale = 3;
+   */
+  scale = protoInt64.zero;
+
+  constructor(data?: PartialMessage<Decimal>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.schema.Decimal";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 2, name: "precision", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "scale", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Decimal {
+    return new Decimal().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Decimal {
+    return new Decimal().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Decimal {
+    return new Decimal().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Decimal | PlainMessage<Decimal> | undefined, b: Decimal | PlainMessage<Decimal> | undefined): boolean {
+    return proto3.util.equals(Decimal, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.v1.schema.Decl
  */
@@ -1226,6 +1281,66 @@ export class Metadata extends Message<Metadata> {
      */
     value: MetadataSubscriber;
     case: "subscriber";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.schema.MetadataStream stream = 8;
+     */
+    value: MetadataStream;
+    case: "stream";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.schema.MetadataError error = 9;
+     */
+    value: MetadataError;
+    case: "error";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.schema.MetadataConcurrency concurrency = 10;
+     */
+    value: MetadataConcurrency;
+    case: "concurrency";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.schema.MetadataValidate validate = 11;
+     */
+    value: MetadataValidate;
+    case: "validate";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.schema.MetadataDeprecated deprecated = 12;
+     */
+    value: MetadataDeprecated;
+    case: "deprecated";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.schema.MetadataAllow allow = 13;
+     */
+    value: MetadataAllow;
+    case: "allow";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.schema.MetadataCache cache = 14;
+     */
+    value: MetadataCache;
+    case: "cache";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.schema.MetadataSaga saga = 15;
+     */
+    value: MetadataSaga;
+    case: "saga";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.schema.MetadataEgress egress = 16;
+     */
+    value: MetadataEgress;
+    case: "egress";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.schema.MetadataResponse response = 17;
+     */
+    value: MetadataResponse;
+    case: "response";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<Metadata>) {
@@ -1243,6 +1358,16 @@ export class Metadata extends Message<Metadata> {
     { no: 5, name: "alias", kind: "message", T: MetadataAlias, oneof: "value" },
     { no: 6, name: "retry", kind: "message", T: MetadataRetry, oneof: "value" },
     { no: 7, name: "subscriber", kind: "message", T: MetadataSubscriber, oneof: "value" },
+    { no: 8, name: "stream", kind: "message", T: MetadataStream, oneof: "value" },
+    { no: 9, name: "error", kind: "message", T: MetadataError, oneof: "value" },
+    { no: 10, name: "concurrency", kind: "message", T: MetadataConcurrency, oneof: "value" },
+    { no: 11, name: "validate", kind: "message", T: MetadataValidate, oneof: "value" },
+    { no: 12, name: "deprecated", kind: "message", T: MetadataDeprecated, oneof: "value" },
+    { no: 13, name: "allow", kind: "message", T: MetadataAllow, oneof: "value" },
+    { no: 14, name: "cache", kind: "message", T: MetadataCache, oneof: "value" },
+    { no: 15, name: "saga", kind: "message", T: MetadataSaga, oneof: "value" },
+    { no: 16, name: "egress", kind: "message", T: MetadataEgress, oneof: "value" },
+    { no: 17, name: "response", kind: "message", T: MetadataResponse, oneof: "value" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Metadata {
@@ -1311,6 +1436,98 @@ export class MetadataAlias extends Message<MetadataAlias> {
   }
 }
 
+/**
+ * @generated from message xyz.block.ftl.v1.schema.MetadataAllow
+ */
+export class MetadataAllow extends Message<MetadataAllow> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: repeated string modules = 2;
+   */
+  modules: string[] = [];
+
+  constructor(data?: PartialMessage<MetadataAllow>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.schema.MetadataAllow";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 2, name: "modules", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetadataAllow {
+    return new MetadataAllow().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetadataAllow {
+    return new MetadataAllow().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetadataAllow {
+    return new MetadataAllow().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetadataAllow | PlainMessage<MetadataAllow> | undefined, b: MetadataAllow | PlainMessage<MetadataAllow> | undefined): boolean {
+    return proto3.util.equals(MetadataAllow, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.schema.MetadataCache
+ */
+export class MetadataCache extends Message<MetadataCache> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: string ttl = 2;
+   */
+  ttl = "";
+
+  /**
+   * @generated from field: repeated string vary = 3;
+   */
+  vary: string[] = [];
+
+  constructor(data?: PartialMessage<MetadataCache>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.schema.MetadataCache";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 2, name: "ttl", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "vary", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetadataCache {
+    return new MetadataCache().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetadataCache {
+    return new MetadataCache().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetadataCache {
+    return new MetadataCache().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetadataCache | PlainMessage<MetadataCache> | undefined, b: MetadataCache | PlainMessage<MetadataCache> | undefined): boolean {
+    return proto3.util.equals(MetadataCache, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.v1.schema.MetadataCalls
  */
@@ -1354,6 +1571,55 @@ export class MetadataCalls extends Message<MetadataCalls> {
   }
 }
 
+/**
+ * @generated from message xyz.block.ftl.v1.schema.MetadataConcurrency
+ */
+export class MetadataConcurrency extends Message<MetadataConcurrency> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: int64 max = 2;
+   */
+  max = protoInt64.zero;
+
+  /**
+   * @generated from field: optional int64 queue = 3;
+   */
+  queue?: bigint;
+
+  constructor(data?: PartialMessage<MetadataConcurrency>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.schema.MetadataConcurrency";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 2, name: "max", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "queue", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetadataConcurrency {
+    return new MetadataConcurrency().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetadataConcurrency {
+    return new MetadataConcurrency().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetadataConcurrency {
+    return new MetadataConcurrency().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetadataConcurrency | PlainMessage<MetadataConcurrency> | undefined, b: MetadataConcurrency | PlainMessage<MetadataConcurrency> | undefined): boolean {
+    return proto3.util.equals(MetadataConcurrency, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.v1.schema.MetadataCronJob
  */
@@ -1440,6 +1706,129 @@ export class MetadataDatabases extends Message<MetadataDatabases> {
   }
 }
 
+/**
+ * @generated from message xyz.block.ftl.v1.schema.MetadataDeprecated
+ */
+export class MetadataDeprecated extends Message<MetadataDeprecated> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message = "";
+
+  constructor(data?: PartialMessage<MetadataDeprecated>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.schema.MetadataDeprecated";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 2, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetadataDeprecated {
+    return new MetadataDeprecated().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetadataDeprecated {
+    return new MetadataDeprecated().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetadataDeprecated {
+    return new MetadataDeprecated().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetadataDeprecated | PlainMessage<MetadataDeprecated> | undefined, b: MetadataDeprecated | PlainMessage<MetadataDeprecated> | undefined): boolean {
+    return proto3.util.equals(MetadataDeprecated, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.schema.MetadataEgress
+ */
+export class MetadataEgress extends Message<MetadataEgress> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: repeated string urls = 2;
+   */
+  urls: string[] = [];
+
+  constructor(data?: PartialMessage<MetadataEgress>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.schema.MetadataEgress";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 2, name: "urls", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetadataEgress {
+    return new MetadataEgress().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetadataEgress {
+    return new MetadataEgress().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetadataEgress {
+    return new MetadataEgress().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetadataEgress | PlainMessage<MetadataEgress> | undefined, b: MetadataEgress | PlainMessage<MetadataEgress> | undefined): boolean {
+    return proto3.util.equals(MetadataEgress, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.schema.MetadataError
+ */
+export class MetadataError extends Message<MetadataError> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
+   */
+  pos?: Position;
+
+  constructor(data?: PartialMessage<MetadataError>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.schema.MetadataError";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetadataError {
+    return new MetadataError().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetadataError {
+    return new MetadataError().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetadataError {
+    return new MetadataError().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetadataError | PlainMessage<MetadataError> | undefined, b: MetadataError | PlainMessage<MetadataError> | undefined): boolean {
+    return proto3.util.equals(MetadataError, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.v1.schema.MetadataIngress
  */
@@ -1495,6 +1884,61 @@ export class MetadataIngress extends Message<MetadataIngress> {
   }
 }
 
+/**
+ * @generated from message xyz.block.ftl.v1.schema.MetadataResponse
+ */
+export class MetadataResponse extends Message<MetadataResponse> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: string status = 2;
+   */
+  status = "";
+
+  /**
+   * @generated from field: repeated xyz.block.ftl.v1.schema.ResponseHeader headers = 3;
+   */
+  headers: ResponseHeader[] = [];
+
+  /**
+   * @generated from field: string body = 4;
+   */
+  body = "";
+
+  constructor(data?: PartialMessage<MetadataResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.schema.MetadataResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 2, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "headers", kind: "message", T: ResponseHeader, repeated: true },
+    { no: 4, name: "body", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetadataResponse {
+    return new MetadataResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetadataResponse {
+    return new MetadataResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetadataResponse {
+    return new MetadataResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetadataResponse | PlainMessage<MetadataResponse> | undefined, b: MetadataResponse | PlainMessage<MetadataResponse> | undefined): boolean {
+    return proto3.util.equals(MetadataResponse, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.v1.schema.MetadataRetry
  */
@@ -1550,6 +1994,86 @@ export class MetadataRetry extends Message<MetadataRetry> {
   }
 }
 
+/**
+ * @generated from message xyz.block.ftl.v1.schema.MetadataSaga
+ */
+export class MetadataSaga extends Message<MetadataSaga> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: repeated xyz.block.ftl.v1.schema.SagaStep steps = 2;
+   */
+  steps: SagaStep[] = [];
+
+  constructor(data?: PartialMessage<MetadataSaga>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.schema.MetadataSaga";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 2, name: "steps", kind: "message", T: SagaStep, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetadataSaga {
+    return new MetadataSaga().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetadataSaga {
+    return new MetadataSaga().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetadataSaga {
+    return new MetadataSaga().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetadataSaga | PlainMessage<MetadataSaga> | undefined, b: MetadataSaga | PlainMessage<MetadataSaga> | undefined): boolean {
+    return proto3.util.equals(MetadataSaga, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.schema.MetadataStream
+ */
+export class MetadataStream extends Message<MetadataStream> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
+   */
+  pos?: Position;
+
+  constructor(data?: PartialMessage<MetadataStream>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.schema.MetadataStream";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetadataStream {
+    return new MetadataStream().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetadataStream {
+    return new MetadataStream().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetadataStream {
+    return new MetadataStream().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetadataStream | PlainMessage<MetadataStream> | undefined, b: MetadataStream | PlainMessage<MetadataStream> | undefined): boolean {
+    return proto3.util.equals(MetadataStream, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.v1.schema.MetadataSubscriber
  */
@@ -1593,6 +2117,55 @@ export class MetadataSubscriber extends Message<MetadataSubscriber> {
   }
 }
 
+/**
+ * @generated from message xyz.block.ftl.v1.schema.MetadataValidate
+ */
+export class MetadataValidate extends Message<MetadataValidate> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: string kind = 2;
+   */
+  kind = "";
+
+  /**
+   * @generated from field: string value = 3;
+   */
+  value = "";
+
+  constructor(data?: PartialMessage<MetadataValidate>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.schema.MetadataValidate";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 2, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetadataValidate {
+    return new MetadataValidate().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetadataValidate {
+    return new MetadataValidate().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetadataValidate {
+    return new MetadataValidate().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetadataValidate | PlainMessage<MetadataValidate> | undefined, b: MetadataValidate | PlainMessage<MetadataValidate> | undefined): boolean {
+    return proto3.util.equals(MetadataValidate, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.v1.schema.Module
  */
@@ -1602,6 +2175,13 @@ export class Module extends Message<Module> {
    */
   runtime?: ModuleRuntime;
 
+  /**
+   * Version of the wire format the module was encoded with, see schema.ProtoVersion.
+   *
+   * @generated from field: int64 proto_version = 31635;
+   */
+  protoVersion = protoInt64.zero;
+
   /**
    * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
    */
@@ -1636,6 +2216,7 @@ export class Module extends Message<Module> {
   static readonly typeName = "xyz.block.ftl.v1.schema.Module";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 31634, name: "runtime", kind: "message", T: ModuleRuntime, opt: true },
+    { no: 31635, name: "proto_version", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 1, name: "pos", kind: "message", T: Position, opt: true },
     { no: 2, name: "comments", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
     { no: 3, name: "builtin", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
@@ -1807,6 +2388,110 @@ export class Ref extends Message<Ref> {
   }
 }
 
+/**
+ * @generated from message xyz.block.ftl.v1.schema.ResponseHeader
+ */
+export class ResponseHeader extends Message<ResponseHeader> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string field = 3;
+   */
+  field = "";
+
+  /**
+   * @generated from field: string value = 4;
+   */
+  value = "";
+
+  constructor(data?: PartialMessage<ResponseHeader>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.schema.ResponseHeader";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "field", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResponseHeader {
+    return new ResponseHeader().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResponseHeader {
+    return new ResponseHeader().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResponseHeader {
+    return new ResponseHeader().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ResponseHeader | PlainMessage<ResponseHeader> | undefined, b: ResponseHeader | PlainMessage<ResponseHeader> | undefined): boolean {
+    return proto3.util.equals(ResponseHeader, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.schema.SagaStep
+ */
+export class SagaStep extends Message<SagaStep> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: xyz.block.ftl.v1.schema.Ref verb = 2;
+   */
+  verb?: Ref;
+
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Ref compensation = 3;
+   */
+  compensation?: Ref;
+
+  constructor(data?: PartialMessage<SagaStep>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.schema.SagaStep";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 2, name: "verb", kind: "message", T: Ref },
+    { no: 3, name: "compensation", kind: "message", T: Ref, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SagaStep {
+    return new SagaStep().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SagaStep {
+    return new SagaStep().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SagaStep {
+    return new SagaStep().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SagaStep | PlainMessage<SagaStep> | undefined, b: SagaStep | PlainMessage<SagaStep> | undefined): boolean {
+    return proto3.util.equals(SagaStep, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.v1.schema.Schema
  */
@@ -2077,6 +2762,43 @@ export class Time extends Message<Time> {
   }
 }
 
+/**
+ * @generated from message xyz.block.ftl.v1.schema.Timestamp
+ */
+export class Timestamp extends Message<Timestamp> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
+   */
+  pos?: Position;
+
+  constructor(data?: PartialMessage<Timestamp>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.schema.Timestamp";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Timestamp {
+    return new Timestamp().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Timestamp {
+    return new Timestamp().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Timestamp {
+    return new Timestamp().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Timestamp | PlainMessage<Timestamp> | undefined, b: Timestamp | PlainMessage<Timestamp> | undefined): boolean {
+    return proto3.util.equals(Timestamp, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.v1.schema.Topic
  */
@@ -2217,6 +2939,24 @@ export class Type extends Message<Type> {
      */
     value: Optional;
     case: "optional";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.schema.UUID uuid = 13;
+     */
+    value: UUID;
+    case: "uuid";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.schema.Decimal decimal = 14;
+     */
+    value: Decimal;
+    case: "decimal";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.v1.schema.Timestamp timestamp = 15;
+     */
+    value: Timestamp;
+    case: "timestamp";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<Type>) {
@@ -2239,6 +2979,9 @@ export class Type extends Message<Type> {
     { no: 10, name: "unit", kind: "message", T: Unit, oneof: "value" },
     { no: 11, name: "ref", kind: "message", T: Ref, oneof: "value" },
     { no: 12, name: "optional", kind: "message", T: Optional, oneof: "value" },
+    { no: 13, name: "uuid", kind: "message", T: UUID, oneof: "value" },
+    { no: 14, name: "decimal", kind: "message", T: Decimal, oneof: "value" },
+    { no: 15, name: "timestamp", kind: "message", T: Timestamp, oneof: "value" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Type {
@@ -2405,6 +3148,43 @@ export class TypeValue extends Message<TypeValue> {
   }
 }
 
+/**
+ * @generated from message xyz.block.ftl.v1.schema.UUID
+ */
+export class UUID extends Message<UUID> {
+  /**
+   * @generated from field: optional xyz.block.ftl.v1.schema.Position pos = 1;
+   */
+  pos?: Position;
+
+  constructor(data?: PartialMessage<UUID>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.schema.UUID";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UUID {
+    return new UUID().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UUID {
+    return new UUID().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UUID {
+    return new UUID().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UUID | PlainMessage<UUID> | undefined, b: UUID | PlainMessage<UUID> | undefined): boolean {
+    return proto3.util.equals(UUID, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.v1.schema.Unit
  */
@@ -2543,6 +3323,11 @@ export class Verb extends Message<Verb> {
    */
   metadata: Metadata[] = [];
 
+  /**
+   * @generated from field: bool internal = 8;
+   */
+  internal = false;
+
   constructor(data?: PartialMessage<Verb>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2559,6 +3344,7 @@ export class Verb extends Message<Verb> {
     { no: 5, name: "request", kind: "message", T: Type },
     { no: 6, name: "response", kind: "message", T: Type },
     { no: 7, name: "metadata", kind: "message", T: Metadata, repeated: true },
+    { no: 8, name: "internal", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Verb {
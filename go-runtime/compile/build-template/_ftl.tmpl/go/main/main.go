@@ -3,10 +3,13 @@ package main
 
 import (
 	"context"
+{{- if .Migrations }}
+	"embed"
+{{- end }}
 
 	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
 	"github.com/TBD54566975/ftl/common/plugin"
-{{- if .SumTypes }}
+{{- if or .SumTypes .ErrorTypes }}
 	"github.com/TBD54566975/ftl/go-runtime/ftl/reflection"
 {{- end }}
 	"github.com/TBD54566975/ftl/go-runtime/server"
@@ -14,7 +17,7 @@ import (
 	"ftl/{{.}}"
 {{- end}}
 )
-{{- if .SumTypes}}
+{{- if or .SumTypes .ErrorTypes}}
 
 func init() {
 	reflection.Register(
@@ -24,15 +27,29 @@ func init() {
 			*new({{.Type}}),
 			{{- end}}
 		),
+{{- end}}
+{{- range .ErrorTypes}}
+		reflection.ErrorType[{{.Package}}.{{.Name}}](),
 {{- end}}
 	)
 }
 {{- end}}
 
+{{- if .Migrations}}
+
+//go:embed migrations
+var migrations embed.FS
+{{- end}}
+
 func main() {
+{{- if .Migrations}}
+	server.RegisterMigrations(migrations)
+{{- end}}
 	verbConstructor := server.NewUserVerbServer("{{.Name}}",
 {{- range .Verbs}}
-	{{- if and .HasRequest .HasResponse}}
+	{{- if .IsStream}}
+		server.HandleStream({{.Package}}.{{.Name}}),
+	{{- else if and .HasRequest .HasResponse}}
 		server.HandleCall({{.Package}}.{{.Name}}),
 	{{- else if .HasRequest}}
 		server.HandleSink({{.Package}}.{{.Name}}),
@@ -43,5 +60,5 @@ func main() {
 	{{- end}}
 {{- end}}
 	)
-	plugin.Start(context.Background(), "{{.Name}}", verbConstructor, ftlv1connect.VerbServiceName, ftlv1connect.NewVerbServiceHandler)
+	plugin.Start(context.Background(), "{{.Name}}", verbConstructor, ftlv1connect.VerbServiceName, ftlv1connect.NewVerbServiceHandler, server.ServeMetrics())
 }
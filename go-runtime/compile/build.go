@@ -2,7 +2,9 @@ package compile
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io/fs"
 	"maps"
 	"os"
 	"path"
@@ -14,10 +16,10 @@ import (
 	"strings"
 	"unicode"
 
+	"github.com/alecthomas/types/optional"
 	sets "github.com/deckarep/golang-set/v2"
 	gomaps "golang.org/x/exp/maps"
 	"golang.org/x/mod/modfile"
-	"golang.org/x/mod/semver"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -31,7 +33,9 @@ import (
 	extract "github.com/TBD54566975/ftl/go-runtime/schema"
 	"github.com/TBD54566975/ftl/internal"
 	"github.com/TBD54566975/ftl/internal/exec"
+	"github.com/TBD54566975/ftl/internal/gotoolchain"
 	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/model"
 	"github.com/TBD54566975/ftl/internal/reflect"
 )
 
@@ -50,6 +54,7 @@ type goVerb struct {
 	MustImport  string
 	HasRequest  bool
 	HasResponse bool
+	IsStream    bool
 }
 
 type mainModuleContext struct {
@@ -59,6 +64,16 @@ type mainModuleContext struct {
 	Verbs        []goVerb
 	Replacements []*modfile.Replace
 	SumTypes     []goSumType
+	ErrorTypes   []goErrorType
+	// True if the module has database migrations to embed.
+	Migrations bool
+}
+
+// goErrorType is a data structure in the module that can be returned as an error.
+type goErrorType struct {
+	Name       string
+	Package    string
+	MustImport string
 }
 
 type goSumType struct {
@@ -95,8 +110,9 @@ func buildDir(moduleDir string) string {
 	return filepath.Join(moduleDir, buildDirName)
 }
 
-// Build the given module.
-func Build(ctx context.Context, moduleDir string, sch *schema.Schema, filesTransaction ModifyFilesTransaction) (err error) {
+// Build the given module for "target", or for the platform selected by the
+// GOOS and GOARCH environment variables if it is zero.
+func Build(ctx context.Context, moduleDir string, target model.Platform, sch *schema.Schema, filesTransaction ModifyFilesTransaction) (err error) {
 	if err := filesTransaction.Begin(); err != nil {
 		return err
 	}
@@ -111,9 +127,12 @@ func Build(ctx context.Context, moduleDir string, sch *schema.Schema, filesTrans
 		return err
 	}
 
-	goVersion := runtime.Version()[2:]
-	if semver.Compare("v"+goVersion, "v"+goModVersion) < 0 {
-		return fmt.Errorf("go version %q is not recent enough for this module, needs minimum version %q", goVersion, goModVersion)
+	toolchainEnv, err := gotoolchain.Env(ctx, filepath.Join(moduleDir, "go.mod"))
+	if err != nil {
+		return fmt.Errorf("failed to set up Go toolchain: %w", err)
+	}
+	if !target.IsZero() {
+		toolchainEnv = append(toolchainEnv, "GOOS="+target.OS, "GOARCH="+target.Arch)
 	}
 
 	ftlVersion := ""
@@ -156,7 +175,7 @@ func Build(ctx context.Context, moduleDir string, sch *schema.Schema, filesTrans
 		// If errors are only at levels below ERROR (e.g. INFO, WARN), the schema can still be used.
 		return nil
 	}
-	if err = writeSchema(config, result.Module); err != nil {
+	if err = writeSchema(config, target, result.Module); err != nil {
 		return fmt.Errorf("failed to write schema: %w", err)
 	}
 
@@ -182,8 +201,30 @@ func Build(ctx context.Context, moduleDir string, sch *schema.Schema, filesTrans
 		if _, ok := verb.Response.(*schema.Unit); !ok {
 			goverb.HasResponse = true
 		}
+		goverb.IsStream = verb.IsStream()
 		goVerbs = append(goVerbs, goverb)
 	}
+	goErrorTypes := []goErrorType{}
+	for _, decl := range result.Module.Decls {
+		data, ok := decl.(*schema.Data)
+		if !ok || !data.IsError() {
+			continue
+		}
+		nativeName, ok := result.NativeNames[data]
+		if !ok {
+			return fmt.Errorf("missing native name for error %s", data.Name)
+		}
+		qualified, err := goVerbFromQualifiedName(nativeName)
+		if err != nil {
+			return err
+		}
+		goErrorTypes = append(goErrorTypes, goErrorType{Name: qualified.Name, Package: qualified.Package, MustImport: qualified.MustImport})
+	}
+	mainDir := filepath.Join(buildDir, "go", "main")
+	hasMigrations, err := copyMigrations(moduleDir, mainDir)
+	if err != nil {
+		return fmt.Errorf("failed to copy migrations: %w", err)
+	}
 	if err := internal.ScaffoldZip(buildTemplateFiles(), moduleDir, mainModuleContext{
 		GoVersion:    goModVersion,
 		FTLVersion:   ftlVersion,
@@ -191,6 +232,8 @@ func Build(ctx context.Context, moduleDir string, sch *schema.Schema, filesTrans
 		Verbs:        goVerbs,
 		Replacements: replacements,
 		SumTypes:     getSumTypes(result.Module, sch, result.NativeNames),
+		ErrorTypes:   goErrorTypes,
+		Migrations:   hasMigrations,
 	}, scaffolder.Exclude("^go.mod$"), scaffolder.Functions(funcs)); err != nil {
 		return err
 	}
@@ -198,21 +241,20 @@ func Build(ctx context.Context, moduleDir string, sch *schema.Schema, filesTrans
 	logger.Debugf("Tidying go.mod files")
 	wg, wgctx := errgroup.WithContext(ctx)
 	wg.Go(func() error {
-		if err := exec.Command(ctx, log.Debug, moduleDir, "go", "mod", "tidy").RunBuffered(ctx); err != nil {
+		if err := goCommand(ctx, toolchainEnv, moduleDir, "mod", "tidy").RunBuffered(ctx); err != nil {
 			return fmt.Errorf("%s: failed to tidy go.mod: %w", moduleDir, err)
 		}
 		return filesTransaction.ModifiedFiles(filepath.Join(moduleDir, "go.mod"), filepath.Join(moduleDir, "go.sum"))
 	})
-	mainDir := filepath.Join(buildDir, "go", "main")
 	wg.Go(func() error {
-		if err := exec.Command(wgctx, log.Debug, mainDir, "go", "mod", "tidy").RunBuffered(wgctx); err != nil {
+		if err := goCommand(wgctx, toolchainEnv, mainDir, "mod", "tidy").RunBuffered(wgctx); err != nil {
 			return fmt.Errorf("%s: failed to tidy go.mod: %w", mainDir, err)
 		}
 		return filesTransaction.ModifiedFiles(filepath.Join(mainDir, "go.mod"), filepath.Join(moduleDir, "go.sum"))
 	})
 	modulesDir := filepath.Join(buildDir, "go", "modules")
 	wg.Go(func() error {
-		if err := exec.Command(wgctx, log.Debug, modulesDir, "go", "mod", "tidy").RunBuffered(wgctx); err != nil {
+		if err := goCommand(wgctx, toolchainEnv, modulesDir, "mod", "tidy").RunBuffered(wgctx); err != nil {
 			return fmt.Errorf("%s: failed to tidy go.mod: %w", modulesDir, err)
 		}
 		return filesTransaction.ModifiedFiles(filepath.Join(modulesDir, "go.mod"), filepath.Join(moduleDir, "go.sum"))
@@ -222,7 +264,58 @@ func Build(ctx context.Context, moduleDir string, sch *schema.Schema, filesTrans
 	}
 
 	logger.Debugf("Compiling")
-	return exec.Command(ctx, log.Debug, mainDir, "go", "build", "-o", "../../main", ".").RunBuffered(ctx)
+	return goCommand(ctx, toolchainEnv, mainDir, "build", "-o", "../../main", ".").RunBuffered(ctx)
+}
+
+// goCommand runs go with the module's toolchain, as configured by "env".
+func goCommand(ctx context.Context, env []string, dir string, args ...string) *exec.Cmd {
+	cmd := exec.Command(ctx, log.Debug, dir, "go", args...)
+	cmd.Env = append(cmd.Env, env...)
+	return cmd
+}
+
+// copyMigrations copies the module's database migrations from "db/migrations"
+// into the main package, where they are embedded into the binary.
+//
+// Returns false if the module has no migrations.
+func copyMigrations(moduleDir, mainDir string) (bool, error) {
+	src := filepath.Join(moduleDir, "db", "migrations")
+	dest := filepath.Join(mainDir, "migrations")
+	if err := os.RemoveAll(dest); err != nil {
+		return false, err
+	}
+	if _, err := os.Stat(src); errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	count := 0
+	err := filepath.WalkDir(src, func(srcPath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || filepath.Ext(srcPath) != ".sql" {
+			return nil
+		}
+		rel, err := filepath.Rel(src, srcPath)
+		if err != nil {
+			return err
+		}
+		data, err := os.ReadFile(srcPath)
+		if err != nil {
+			return err
+		}
+		destPath := filepath.Join(dest, rel)
+		if err := os.MkdirAll(filepath.Dir(destPath), 0700); err != nil {
+			return err
+		}
+		count++
+		return os.WriteFile(destPath, data, 0600)
+	})
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
 }
 
 func GenerateStubsForExternalLibrary(ctx context.Context, dir string, schema *schema.Schema) error {
@@ -262,6 +355,17 @@ var scaffoldFuncs = scaffolder.FuncMap{
 	"is": func(kind string, t schema.Node) bool {
 		return stdreflect.Indirect(stdreflect.ValueOf(t)).Type().Name() == kind
 	},
+	"typeParams": genTypeParams,
+	"deprecated": genDeprecation,
+	"exportedVerbs": func(m *schema.Module) []*schema.Verb {
+		verbs := []*schema.Verb{}
+		for _, decl := range m.Decls {
+			if verb, ok := decl.(*schema.Verb); ok && verb.IsExported() {
+				verbs = append(verbs, verb)
+			}
+		}
+		return verbs
+	},
 	"imports": func(m *schema.Module) map[string]string {
 		imports := map[string]string{}
 		_ = schema.VisitExcludingMetadataChildren(m, func(n schema.Node, next func() error) error { //nolint:errcheck
@@ -272,26 +376,25 @@ var scaffoldFuncs = scaffolder.FuncMap{
 				}
 				imports[path.Join("ftl", n.Module)] = "ftl" + n.Module
 
-				for _, tp := range n.TypeParameters {
-					tpRef, err := schema.ParseRef(tp.String())
-					if err != nil {
-						panic(err)
-					}
-					if tpRef.Module != "" && tpRef.Module != m.Name {
-						imports[path.Join("ftl", tpRef.Module)] = "ftl" + tpRef.Module
-					}
-				}
-
 			case *schema.Time:
 				imports["time"] = "stdtime"
 
-			case *schema.Optional, *schema.Unit:
+			case *schema.UUID:
+				imports["github.com/google/uuid"] = ""
+
+			case *schema.Optional, *schema.Unit, *schema.Decimal, *schema.Timestamp:
 				imports["github.com/TBD54566975/ftl/go-runtime/ftl"] = ""
 
 			case *schema.Topic:
 				if n.IsExported() {
 					imports["github.com/TBD54566975/ftl/go-runtime/ftl"] = ""
 				}
+
+			case *schema.Verb:
+				// Exported verbs are included in the module's typed client.
+				if n.IsExported() {
+					imports["github.com/TBD54566975/ftl/go-runtime/ftl"] = ""
+				}
 			default:
 			}
 			return next()
@@ -339,6 +442,9 @@ var scaffoldFuncs = scaffolder.FuncMap{
 		for _, v := range ctx.Verbs {
 			imports.Add(strings.TrimPrefix(v.MustImport, "ftl/"))
 		}
+		for _, e := range ctx.ErrorTypes {
+			imports.Add(strings.TrimPrefix(e.MustImport, "ftl/"))
+		}
 		for _, st := range ctx.SumTypes {
 			if i := strings.LastIndex(st.Discriminator, "."); i != -1 {
 				imports.Add(st.Discriminator[:i])
@@ -381,12 +487,24 @@ var scaffoldFuncs = scaffolder.FuncMap{
 		}
 		return out
 	},
+	"errorTypes": func(m *schema.Module) []*schema.Data {
+		out := []*schema.Data{}
+		for _, d := range m.Decls {
+			if data, ok := d.(*schema.Data); ok && data.IsError() && data.IsExported() {
+				out = append(out, data)
+			}
+		}
+		return out
+	},
 }
 
 func schemaType(t schema.Type) string {
 	switch t := t.(type) {
-	case *schema.Int, *schema.Bool, *schema.String, *schema.Float, *schema.Unit, *schema.Any, *schema.Bytes, *schema.Time:
+	case *schema.Int, *schema.Bool, *schema.String, *schema.Float, *schema.Unit, *schema.Any, *schema.Bytes, *schema.Time,
+		*schema.UUID, *schema.Timestamp:
 		return fmt.Sprintf("&%s{}", strings.TrimLeft(stdreflect.TypeOf(t).String(), "*"))
+	case *schema.Decimal:
+		return fmt.Sprintf("&schema.Decimal{Precision: %d, Scale: %d}", t.Precision, t.Scale)
 	case *schema.Ref:
 		return fmt.Sprintf("&schema.Ref{Module: %q, Name: %q}", t.Module, t.Name)
 	case *schema.Array:
@@ -399,6 +517,54 @@ func schemaType(t schema.Type) string {
 	panic(fmt.Sprintf("unsupported type %T", t))
 }
 
+// genDeprecation returns the "Deprecated:" doc comment of a deprecated verb
+// or field, or "".
+func genDeprecation(node schema.Node) string {
+	var deprecated optional.Option[*schema.MetadataDeprecated]
+	switch node := node.(type) {
+	case *schema.Verb:
+		deprecated = node.GetMetadataDeprecated()
+	case *schema.Field:
+		deprecated = node.Deprecated()
+	}
+	md, ok := deprecated.Get()
+	if !ok {
+		return ""
+	}
+	if md.Message == "" {
+		return "Deprecated: this will be removed in a future version."
+	}
+	return "Deprecated: " + md.Message
+}
+
+// genTypeParams returns the type parameter list of a generic data structure,
+// eg. "[K comparable, V any]".
+//
+// Type parameters used as map keys are constrained to be comparable.
+func genTypeParams(data *schema.Data) string {
+	if len(data.TypeParameters) == 0 {
+		return ""
+	}
+	mapKeys := map[string]bool{}
+	_ = schema.Visit(data, func(n schema.Node, next func() error) error { //nolint:errcheck
+		if m, ok := n.(*schema.Map); ok {
+			if ref, ok := m.Key.(*schema.Ref); ok && ref.Module == "" {
+				mapKeys[ref.Name] = true
+			}
+		}
+		return next()
+	})
+	params := make([]string, len(data.TypeParameters))
+	for i, tp := range data.TypeParameters {
+		if mapKeys[tp.Name] {
+			params[i] = tp.Name + " comparable"
+		} else {
+			params[i] = tp.Name + " any"
+		}
+	}
+	return "[" + strings.Join(params, ", ") + "]"
+}
+
 func genType(module *schema.Module, t schema.Type) string {
 	switch t := t.(type) {
 	case *schema.Ref:
@@ -428,6 +594,15 @@ func genType(module *schema.Module, t schema.Type) string {
 	case *schema.Time:
 		return "stdtime.Time"
 
+	case *schema.UUID:
+		return "uuid.UUID"
+
+	case *schema.Decimal:
+		return "ftl.Decimal"
+
+	case *schema.Timestamp:
+		return "ftl.Timestamp"
+
 	case *schema.Int, *schema.Bool, *schema.String:
 		return strings.ToLower(t.String())
 
@@ -517,7 +692,7 @@ func shouldUpdateVersion(goModfile *modfile.File) bool {
 	return true
 }
 
-func writeSchema(config moduleconfig.ModuleConfig, module *schema.Module) error {
+func writeSchema(config moduleconfig.ModuleConfig, target model.Platform, module *schema.Module) error {
 	modulepb := module.ToProto().(*schemapb.Module) //nolint:forcetypeassert
 	// If user has overridden GOOS and GOARCH we want to use those values.
 	goos, ok := os.LookupEnv("GOOS")
@@ -528,6 +703,9 @@ func writeSchema(config moduleconfig.ModuleConfig, module *schema.Module) error
 	if !ok {
 		goarch = runtime.GOARCH
 	}
+	if !target.IsZero() {
+		goos, goarch = target.OS, target.Arch
+	}
 
 	modulepb.Runtime = &schemapb.ModuleRuntime{
 		CreateTime: timestamppb.Now(),
@@ -653,9 +831,11 @@ func ExtractModuleSchema(dir string, sch *schema.Schema) (extract.Result, error)
 	if schema.ContainsTerminalError(result.Errors) {
 		return result, nil
 	}
-	err = schema.ValidateModule(result.Module)
-	if err != nil {
-		return extract.Result{}, err
+	if err = schema.ValidateModule(result.Module); err != nil {
+		// Report every validation error at its position, as with extraction errors.
+		result.Errors = append(result.Errors, schema.UnwrapErrors(err)...)
+		schema.SortErrorsByPosition(result.Errors)
+		return result, nil
 	}
 	updateVisibility(result.Module)
 	return result, nil
@@ -39,8 +39,9 @@ type exportable interface {
 type directiveVerb struct {
 	Pos schema.Position
 
-	Verb   bool `parser:"@'verb'"`
-	Export bool `parser:"@'export'?"`
+	Verb     bool `parser:"@'verb'"`
+	Export   bool `parser:"@'export'?"`
+	Internal bool `parser:"@'internal'?"`
 }
 
 func (*directiveVerb) directive() {}
@@ -54,20 +55,25 @@ func (d *directiveVerb) GetPosition() schema.Position {
 }
 
 func (d *directiveVerb) String() string {
-	if d.Export {
+	switch {
+	case d.Internal:
+		return "ftl:verb internal"
+	case d.Export:
 		return "ftl:verb export"
+	default:
+		return "ftl:verb"
 	}
-	return "ftl:verb"
 }
 func (d *directiveVerb) IsExported() bool {
-	return d.Export
+	return d.Export || d.Internal
 }
 
 type directiveData struct {
 	Pos schema.Position
 
-	Data   bool `parser:"@'data'"`
-	Export bool `parser:"@'export'?"`
+	Data     bool `parser:"@'data'"`
+	Export   bool `parser:"@'export'?"`
+	Internal bool `parser:"@'internal'?"`
 }
 
 func (*directiveData) directive() {}
@@ -81,13 +87,17 @@ func (d *directiveData) GetPosition() schema.Position {
 }
 
 func (d *directiveData) String() string {
-	if d.Export {
+	switch {
+	case d.Internal:
+		return "ftl:data internal"
+	case d.Export:
 		return "ftl:data export"
+	default:
+		return "ftl:data"
 	}
-	return "ftl:data"
 }
 func (d *directiveData) IsExported() bool {
-	return d.Export
+	return d.Export || d.Internal
 }
 
 type directiveEnum struct {
@@ -221,11 +231,149 @@ func (d *directiveRetry) String() string {
 	return strings.Join(components, " ")
 }
 
-// used to subscribe a sink to a subscription
+type directiveConcurrency struct {
+	Pos schema.Position
+
+	Max   int  `parser:"'concurrency' @Number"`
+	Queue *int `parser:"('queue' @Number)?"`
+}
+
+func (*directiveConcurrency) directive() {}
+
+func (d *directiveConcurrency) SetPosition(pos schema.Position) {
+	d.Pos = pos
+}
+
+func (d *directiveConcurrency) GetPosition() schema.Position {
+	return d.Pos
+}
+
+func (d *directiveConcurrency) String() string {
+	components := []string{"concurrency", strconv.Itoa(d.Max)}
+	if d.Queue != nil {
+		components = append(components, "queue", strconv.Itoa(*d.Queue))
+	}
+	return strings.Join(components, " ")
+}
+
+type directiveDeprecated struct {
+	Pos schema.Position
+
+	Message string `parser:"'deprecated' @String?"`
+}
+
+func (*directiveDeprecated) directive() {}
+
+func (d *directiveDeprecated) SetPosition(pos schema.Position) {
+	d.Pos = pos
+}
+
+func (d *directiveDeprecated) GetPosition() schema.Position {
+	return d.Pos
+}
+
+func (d *directiveDeprecated) String() string {
+	if d.Message == "" {
+		return "deprecated"
+	}
+	return fmt.Sprintf("deprecated %q", d.Message)
+}
+
+type directiveAllow struct {
+	Pos schema.Position
+
+	Modules []string `parser:"'allow' @Ident (',' @Ident)*"`
+}
+
+func (*directiveAllow) directive() {}
+
+func (d *directiveAllow) SetPosition(pos schema.Position) {
+	d.Pos = pos
+}
+
+func (d *directiveAllow) GetPosition() schema.Position {
+	return d.Pos
+}
+
+func (d *directiveAllow) String() string {
+	return "allow " + strings.Join(d.Modules, ", ")
+}
+
+type directiveCache struct {
+	Pos schema.Position
+
+	TTL  string   `parser:"'cache' @(Number (?! Whitespace) Ident)"`
+	Vary []string `parser:"('vary' @Ident (',' @Ident)*)?"`
+}
+
+func (*directiveCache) directive() {}
+
+func (d *directiveCache) SetPosition(pos schema.Position) {
+	d.Pos = pos
+}
+
+func (d *directiveCache) GetPosition() schema.Position {
+	return d.Pos
+}
+
+func (d *directiveCache) String() string {
+	if len(d.Vary) == 0 {
+		return "cache " + d.TTL
+	}
+	return "cache " + d.TTL + " vary " + strings.Join(d.Vary, ", ")
+}
+
+type directiveEgress struct {
+	Pos schema.Position
+
+	URLs []string `parser:"'egress' @String (',' @String)*"`
+}
+
+func (*directiveEgress) directive() {}
+
+func (d *directiveEgress) SetPosition(pos schema.Position) {
+	d.Pos = pos
+}
+
+func (d *directiveEgress) GetPosition() schema.Position {
+	return d.Pos
+}
+
+func (d *directiveEgress) String() string {
+	urls := make([]string, len(d.URLs))
+	for i, u := range d.URLs {
+		urls[i] = strconv.Quote(u)
+	}
+	return "egress " + strings.Join(urls, ", ")
+}
+
+type directiveResponse struct {
+	Pos schema.Position
+
+	Status  string                   `parser:"'response' ('status' @Ident)?"`
+	Headers []*schema.ResponseHeader `parser:"@@*"`
+	Body    string                   `parser:"('body' @Ident)?"`
+}
+
+func (*directiveResponse) directive() {}
+
+func (d *directiveResponse) SetPosition(pos schema.Position) {
+	d.Pos = pos
+}
+
+func (d *directiveResponse) GetPosition() schema.Position {
+	return d.Pos
+}
+
+func (d *directiveResponse) String() string {
+	return strings.TrimPrefix((&schema.MetadataResponse{Status: d.Status, Headers: d.Headers, Body: d.Body}).String(), "+")
+}
+
+// used to subscribe a sink to a subscription, or directly to a topic
 type directiveSubscriber struct {
 	Pos schema.Position
 
-	Name string `parser:"'subscribe' @Ident"`
+	Name string `parser:"'subscribe' @(Ident ('.' Ident)?)"`
 }
 
 func (*directiveSubscriber) directive() {}
@@ -269,7 +417,7 @@ var directiveParser = participle.MustBuild[directiveWrapper](
 	participle.Unquote(),
 	participle.UseLookahead(2),
 	participle.Union[directive](&directiveVerb{}, &directiveData{}, &directiveEnum{}, &directiveTypeAlias{},
-		&directiveIngress{}, &directiveCronJob{}, &directiveRetry{}, &directiveSubscriber{}, &directiveExport{}),
+		&directiveIngress{}, &directiveCronJob{}, &directiveRetry{}, &directiveConcurrency{}, &directiveDeprecated{}, &directiveAllow{}, &directiveCache{}, &directiveEgress{}, &directiveResponse{}, &directiveSubscriber{}, &directiveExport{}),
 	participle.Union[schema.IngressPathComponent](&schema.IngressPathLiteral{}, &schema.IngressPathParameter{}),
 )
 
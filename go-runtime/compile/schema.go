@@ -19,6 +19,7 @@ import (
 	"github.com/TBD54566975/ftl/backend/schema"
 	"github.com/TBD54566975/ftl/backend/schema/strcase"
 	extract "github.com/TBD54566975/ftl/go-runtime/schema"
+	"github.com/TBD54566975/ftl/go-runtime/schema/common"
 	"github.com/TBD54566975/ftl/internal/goast"
 	"github.com/TBD54566975/golang-tools/go/ast/astutil"
 	"github.com/TBD54566975/golang-tools/go/packages"
@@ -27,20 +28,23 @@ import (
 var (
 	fset = token.NewFileSet()
 
-	ftlPkgPath              = "github.com/TBD54566975/ftl/go-runtime/ftl"
-	ftlCallFuncPath         = "github.com/TBD54566975/ftl/go-runtime/ftl.Call"
-	ftlFSMFuncPath          = "github.com/TBD54566975/ftl/go-runtime/ftl.FSM"
-	ftlTransitionFuncPath   = "github.com/TBD54566975/ftl/go-runtime/ftl.Transition"
-	ftlStartFuncPath        = "github.com/TBD54566975/ftl/go-runtime/ftl.Start"
-	ftlConfigFuncPath       = "github.com/TBD54566975/ftl/go-runtime/ftl.Config"
-	ftlSecretFuncPath       = "github.com/TBD54566975/ftl/go-runtime/ftl.Secret" //nolint:gosec
-	ftlPostgresDBFuncPath   = "github.com/TBD54566975/ftl/go-runtime/ftl.PostgresDatabase"
-	ftlUnitTypePath         = "github.com/TBD54566975/ftl/go-runtime/ftl.Unit"
-	ftlOptionTypePath       = "github.com/TBD54566975/ftl/go-runtime/ftl.Option"
-	ftlTopicFuncPath        = "github.com/TBD54566975/ftl/go-runtime/ftl.Topic"
-	ftlSubscriptionFuncPath = "github.com/TBD54566975/ftl/go-runtime/ftl.Subscription"
-	ftlTopicHandleTypeName  = "TopicHandle"
-	aliasFieldTag           = "json"
+	ftlPkgPath                      = "github.com/TBD54566975/ftl/go-runtime/ftl"
+	ftlCallFuncPath                 = "github.com/TBD54566975/ftl/go-runtime/ftl.Call"
+	ftlFSMFuncPath                  = "github.com/TBD54566975/ftl/go-runtime/ftl.FSM"
+	ftlTransitionFuncPath           = "github.com/TBD54566975/ftl/go-runtime/ftl.Transition"
+	ftlStartFuncPath                = "github.com/TBD54566975/ftl/go-runtime/ftl.Start"
+	ftlSagaFuncPath                 = "github.com/TBD54566975/ftl/go-runtime/ftl.Saga"
+	ftlStepFuncPath                 = "github.com/TBD54566975/ftl/go-runtime/ftl.Step"
+	ftlStepWithCompensationFuncPath = "github.com/TBD54566975/ftl/go-runtime/ftl.StepWithCompensation"
+	ftlConfigFuncPath               = "github.com/TBD54566975/ftl/go-runtime/ftl.Config"
+	ftlSecretFuncPath               = "github.com/TBD54566975/ftl/go-runtime/ftl.Secret" //nolint:gosec
+	ftlPostgresDBFuncPath           = "github.com/TBD54566975/ftl/go-runtime/ftl.PostgresDatabase"
+	ftlUnitTypePath                 = "github.com/TBD54566975/ftl/go-runtime/ftl.Unit"
+	ftlOptionTypePath               = "github.com/TBD54566975/ftl/go-runtime/ftl.Option"
+	ftlTopicFuncPath                = "github.com/TBD54566975/ftl/go-runtime/ftl.Topic"
+	ftlSubscriptionFuncPath         = "github.com/TBD54566975/ftl/go-runtime/ftl.Subscription"
+	ftlTopicHandleTypeName          = "TopicHandle"
+	aliasFieldTag                   = "json"
 )
 
 // NativeNames is a map of top-level declarations to their native Go names.
@@ -137,9 +141,61 @@ func legacyExtractModuleSchema(dir string, sch *schema.Schema, out *extract.Resu
 			out.Errors = append(out.Errors, maps.Values(pctx.errors)...)
 		}
 	}
+	addImplicitSubscriptions(out.Module)
 	return nil
 }
 
+// addImplicitSubscriptions declares a subscription for each verb that
+// subscribes directly to a topic with "//ftl:subscribe <topic>" or
+// "//ftl:subscribe <module>.<topic>", rather than to a subscription declared
+// with ftl.Subscription().
+//
+// The subscription is named after the verb.
+func addImplicitSubscriptions(module *schema.Module) {
+	subscriptions := map[string]bool{}
+	topics := map[string]bool{}
+	for _, decl := range module.Decls {
+		switch decl := decl.(type) {
+		case *schema.Subscription:
+			subscriptions[decl.Name] = true
+		case *schema.Topic:
+			topics[decl.Name] = true
+		default:
+		}
+	}
+	for _, decl := range module.Decls {
+		verb, ok := decl.(*schema.Verb)
+		if !ok {
+			continue
+		}
+		for _, md := range verb.Metadata {
+			md, ok := md.(*schema.MetadataSubscriber)
+			if !ok || subscriptions[md.Name] {
+				continue
+			}
+			var topic *schema.Ref
+			if strings.Contains(md.Name, ".") {
+				ref, err := schema.ParseRef(md.Name)
+				if err != nil {
+					continue
+				}
+				topic = ref
+			} else if topics[md.Name] {
+				topic = &schema.Ref{Module: module.Name, Name: md.Name}
+			} else {
+				// Reported by validation.
+				continue
+			}
+			module.Decls = append(module.Decls, &schema.Subscription{
+				Pos:   md.Pos,
+				Name:  verb.Name,
+				Topic: topic,
+			})
+			md.Name = verb.Name
+		}
+	}
+}
+
 // extractInitialDecls traverses the package's AST and extracts declarations needed up front (type aliases, enums and topics)
 //
 // This allows us to know if a type is a type alias or an enum regardless of ordering when visiting each ast node.
@@ -330,6 +386,9 @@ func visitCallExpr(pctx *parseContext, node *ast.CallExpr, stack []ast.Node) {
 	case ftlFSMFuncPath:
 		parseFSMDecl(pctx, node, stack)
 
+	case ftlSagaFuncPath:
+		parseSagaDecl(pctx, node, stack)
+
 	case ftlPostgresDBFuncPath:
 		parseDatabaseDecl(pctx, node, schema.PostgresDatabaseType)
 
@@ -498,7 +557,53 @@ func parseFSMDecl(pctx *parseContext, node *ast.CallExpr, stack []ast.Node) {
 		}
 		parseFSMTransition(pctx, call, fn, fsm)
 	}
+	parseFSMDirectives(pctx, fsm, stack)
+}
+
+// Parse a saga declaration, which is compiled to the FSM that executes it.
+func parseSagaDecl(pctx *parseContext, node *ast.CallExpr, stack []ast.Node) {
+	name, schemaErr := extractStringLiteralArg(node, 0)
+	if schemaErr != nil {
+		pctx.errors.add(schemaErr)
+		return
+	}
+	if !schema.ValidateName(name) {
+		pctx.errors.add(errorf(node, "saga names must be valid identifiers"))
+	}
+
+	var steps []*schema.SagaStep
+	for _, arg := range node.Args[1:] {
+		call, ok := arg.(*ast.CallExpr)
+		if !ok {
+			pctx.errors.add(errorf(arg, "expected call to Step or StepWithCompensation"))
+			continue
+		}
+		_, fn := deref[*types.Func](pctx.pkg, call.Fun)
+		if fn == nil || (fn.FullName() != ftlStepFuncPath && fn.FullName() != ftlStepWithCompensationFuncPath) {
+			pctx.errors.add(errorf(call, "expected call to Step or StepWithCompensation"))
+			continue
+		}
+		refs := make([]*schema.Ref, len(call.Args))
+		for i, arg := range call.Args {
+			refs[i] = parseVerbRef(pctx, arg)
+			if refs[i] == nil {
+				pctx.errors.add(errorf(arg, "expected a reference to a sink"))
+				return
+			}
+		}
+		step := &schema.SagaStep{Pos: goPosToSchemaPos(call.Pos()), Verb: refs[0]}
+		if len(refs) > 1 {
+			step.Compensation = refs[1]
+		}
+		steps = append(steps, step)
+	}
 
+	fsm := schema.SagaFSM(goPosToSchemaPos(node.Pos()), name, steps)
+	pctx.module.Decls = append(pctx.module.Decls, fsm)
+	parseFSMDirectives(pctx, fsm, stack)
+}
+
+func parseFSMDirectives(pctx *parseContext, fsm *schema.FSM, stack []ast.Node) {
 	varDecl, ok := varDeclForStack(stack)
 	if !ok {
 		return
@@ -1111,8 +1216,10 @@ func visitStruct(pctx *parseContext, pos token.Pos, tnode types.Type, isExported
 		}
 		for i := range named.TypeArgs().Len() {
 			if typeArg, ok := visitType(pctx, pos, named.TypeArgs().At(i), isExported).Get(); ok {
-				// Fully qualify the Ref if needed
-				if ref, okArg := typeArg.(*schema.Ref); okArg {
+				// Fully qualify the Ref if needed, unless it refers to a type
+				// parameter of the enclosing declaration.
+				_, isParam := named.TypeArgs().At(i).(*types.TypeParam)
+				if ref, okArg := typeArg.(*schema.Ref); okArg && !isParam {
 					if ref.Module == "" {
 						ref.Module = destModule
 					}
@@ -1210,6 +1317,12 @@ func visitStruct(pctx *parseContext, pos token.Pos, tnode types.Type, isExported
 					Alias: jsonFieldName,
 				})
 			}
+			constraints, err := common.ParseValidateTag(goPosToSchemaPos(f.Pos()), reflect.StructTag(s.Tag(i)).Get(common.ValidateFieldTag))
+			if err != nil {
+				pctx.errors.add(tokenErrorf(f.Pos(), f.Name(), "invalid %s tag on field %q: %s", common.ValidateFieldTag, f.Name(), err))
+				fieldErrors = true
+			}
+			metadata = append(metadata, constraints...)
 			out.Fields = append(out.Fields, &schema.Field{
 				Pos:      goPosToSchemaPos(f.Pos()),
 				Name:     strcase.ToLowerCamel(f.Name()),
@@ -1259,6 +1372,14 @@ func visitType(pctx *parseContext, pos token.Pos, tnode types.Type, isExported b
 		return optional.Some[schema.Type](&schema.Ref{Pos: goPosToSchemaPos(pos), Name: tparam.Obj().Id()})
 	}
 
+	if typ, ok := common.WellKnownType(tnode, goPosToSchemaPos(pos)).Get(); ok {
+		return optional.Some(typ)
+	}
+
+	if common.IsStringEncoded(tnode) {
+		return optional.Some[schema.Type](&schema.String{Pos: goPosToSchemaPos(pos)})
+	}
+
 	if named, ok := tnode.(*types.Named); ok {
 		// Handle refs to type aliases and enums, rather than the underlying type.
 		decl, ok := pctx.getDeclForTypeName(named.Obj().Name()).Get()
@@ -17,7 +17,6 @@ import (
 
 	"github.com/TBD54566975/ftl/backend/schema"
 	extract "github.com/TBD54566975/ftl/go-runtime/schema"
-	"github.com/TBD54566975/ftl/internal/errors"
 	"github.com/TBD54566975/ftl/internal/exec"
 	"github.com/TBD54566975/ftl/internal/log"
 	"github.com/TBD54566975/ftl/internal/slices"
@@ -205,6 +204,10 @@ func TestExtractModuleSchemaTwo(t *testing.T) {
 		export data Exported {
 		}
 
+		export internal data Ledger {
+		  entries [String]
+		}
+
 		export data Payload<T> {
 		  body T
 		}
@@ -220,6 +223,8 @@ func TestExtractModuleSchemaTwo(t *testing.T) {
 		export data WithoutDirective {
 		}
 
+		export internal verb balance(two.Ledger) two.Ledger
+
 		export verb callsTwo(two.Payload<String>) two.Payload<String>
 			+calls two.two
 
@@ -240,6 +245,24 @@ func TestExtractModuleSchemaFSM(t *testing.T) {
 	assert.Equal(t, nil, r.Errors, "expected no schema errors")
 	actual := schema.Normalise(r.Module)
 	expected := `module fsm {
+		fsm checkout
+			+saga
+				step fsm.reserve compensate fsm.release
+				step fsm.check
+				step fsm.charge compensate fsm.refund
+				step fsm.ship
+			+retry 3 1s
+		{
+			start fsm.reserve
+			transition fsm.reserve to fsm.check
+			transition fsm.reserve to fsm.release
+			transition fsm.check to fsm.charge
+			transition fsm.check to fsm.release
+			transition fsm.charge to fsm.ship
+			transition fsm.charge to fsm.refund
+			transition fsm.refund to fsm.release
+		}
+
 		fsm payment
 			+retry 10 5s 10m
 		{
@@ -265,6 +288,14 @@ func TestExtractModuleSchemaFSM(t *testing.T) {
 		data OnlinePaymentPaid {
 		}
 
+		data Order {
+			id String
+		}
+
+		verb charge(fsm.Order) Unit
+
+		verb check(fsm.Order) Unit
+
 		verb completed(fsm.OnlinePaymentCompleted) Unit
 			+retry 1s
 
@@ -277,6 +308,14 @@ func TestExtractModuleSchemaFSM(t *testing.T) {
 		// The message to be sent when the payment is paid.
 		verb paid(fsm.OnlinePaymentPaid) Unit
 			+retry 5 60s
+
+		verb refund(fsm.Order) Unit
+
+		verb release(fsm.Order) Unit
+
+		verb reserve(fsm.Order) Unit
+
+		verb ship(fsm.Order) Unit
 	}
 `
 	assert.Equal(t, normaliseString(expected), normaliseString(actual.String()))
@@ -374,6 +413,7 @@ func TestExtractModulePubSub(t *testing.T) {
 		// publicBroadcast is a topic that broadcasts payin events to the public.
 		// out of order with subscription registration to test ordering doesn't matter.
 		export topic publicBroadcast pubsub.PayinEvent
+		subscription auditPayin pubsub.payins
 		subscription broadcastSubscription pubsub.publicBroadcast
 		subscription paymentProcessing pubsub.payins
 
@@ -381,6 +421,9 @@ func TestExtractModulePubSub(t *testing.T) {
         	name String
         }
 
+        verb auditPayin(pubsub.PayinEvent) Unit
+        	+subscribe auditPayin
+
 		export verb broadcast(Unit) Unit
 
         verb payin(Unit) Unit
@@ -396,6 +439,129 @@ func TestExtractModulePubSub(t *testing.T) {
 	assert.Equal(t, normaliseString(expected), normaliseString(actual.String()))
 }
 
+func TestExtractModuleMarshalers(t *testing.T) {
+	if testing.Short() {
+		t.SkipNow()
+	}
+	r, err := ExtractModuleSchema("testdata/marshalers", &schema.Schema{})
+	assert.NoError(t, err)
+	assert.Equal(t, nil, r.Errors, "expected no schema errors")
+	actual := schema.Normalise(r.Module)
+	expected := `module marshalers {
+		data Transfer {
+			id UUID
+			from String
+			to [String]
+			amount Decimal
+			executed Time
+			settled Timestamp
+		}
+
+		verb execute(marshalers.Transfer) marshalers.Transfer
+	}
+`
+	assert.Equal(t, normaliseString(expected), normaliseString(actual.String()))
+}
+
+func TestExtractModuleCompositions(t *testing.T) {
+	if testing.Short() {
+		t.SkipNow()
+	}
+	r, err := ExtractModuleSchema("testdata/compositions", &schema.Schema{})
+	assert.NoError(t, err)
+	assert.Equal(t, nil, r.Errors, "expected no schema errors")
+	actual := schema.Normalise(r.Module)
+	expected := `module compositions {
+		enum Color: String {
+			Red = "red"
+			Green = "green"
+		}
+
+		enum Shape {
+			Circle compositions.Circle
+			Square compositions.Square
+		}
+
+		data Circle {
+			radius Float
+		}
+
+		data Compositions {
+			optionalMapValues {String: Int?}
+			optionalMap {String: compositions.Nested?}?
+			optionalArrayValues [String?]
+			optionalArray [compositions.Nested?]?
+			arrayOfEnums [compositions.Color]
+			arrayOfTypeEnums [compositions.Shape]
+			mapOfEnums {compositions.Color: [compositions.Color]}
+			optionalEnum compositions.Color?
+			arrayOfArrays [[Int?]]
+			mapOfArraysOfMaps {String: [{String: compositions.Color?}]}
+		}
+
+		data Nested {
+			value String
+		}
+
+		data Square {
+			side Float
+		}
+
+		verb echo(compositions.Compositions) compositions.Compositions
+	}
+`
+	assert.Equal(t, normaliseString(expected), normaliseString(actual.String()))
+	// The extracted schema must round-trip through the schema parser.
+	_, err = schema.ParseModuleString("", actual.String())
+	assert.NoError(t, err)
+}
+
+func TestExtractModuleGenerics(t *testing.T) {
+	if testing.Short() {
+		t.SkipNow()
+	}
+	r, err := ExtractModuleSchema("testdata/generics", &schema.Schema{})
+	assert.NoError(t, err)
+	assert.Equal(t, nil, r.Errors, "expected no schema errors")
+	actual := schema.Normalise(r.Module)
+	expected := `module generics {
+		export data Envelope<T> {
+			payload T
+			pairs [generics.Pair<String, T>]
+			lookup {String: T}
+		}
+
+		export data Item {
+			name String
+		}
+
+		export data ListRequest {
+			cursor String?
+			offset Int? +deprecated "use cursor"
+		}
+
+		// Paginated is a page of results.
+		export data Paginated<T> {
+			items [T]
+			next String?
+		}
+
+		export data Pair<K, V> {
+			key K
+			value V
+		}
+
+		export verb list(generics.ListRequest) generics.Envelope<generics.Paginated<generics.Item>>
+			+cache 1m vary cursor
+			+egress "https://items.example.com"
+
+		export verb listAll(generics.ListRequest) generics.Envelope<generics.Paginated<generics.Item>>
+			+deprecated "use list"
+	}
+`
+	assert.Equal(t, normaliseString(expected), normaliseString(actual.String()))
+}
+
 func TestExtractModuleSubscriber(t *testing.T) {
 	if testing.Short() {
 		t.SkipNow()
@@ -406,8 +572,12 @@ func TestExtractModuleSubscriber(t *testing.T) {
 	assert.Equal(t, nil, r.Errors, "expected no schema errors")
 	actual := schema.Normalise(r.Module)
 	expected := `module subscriber {
+		subscription consumesExternalTopic pubsub.publicBroadcast
 		subscription subscriptionToExternalTopic pubsub.publicBroadcast
 
+        verb consumesExternalTopic(pubsub.PayinEvent) Unit
+		+subscribe consumesExternalTopic
+
         verb consumesSubscriptionFromExternalTopic(pubsub.PayinEvent) Unit
 		+subscribe subscriptionToExternalTopic
 	}
@@ -415,6 +585,37 @@ func TestExtractModuleSubscriber(t *testing.T) {
 	assert.Equal(t, normaliseString(expected), normaliseString(actual.String()))
 }
 
+func TestExtractModuleStream(t *testing.T) {
+	if testing.Short() {
+		t.SkipNow()
+	}
+	assert.NoError(t, prebuildTestModule(t, "testdata/stream"))
+	r, err := ExtractModuleSchema("testdata/stream", &schema.Schema{})
+	assert.NoError(t, err)
+	assert.Equal(t, nil, r.Errors, "expected no schema errors")
+	actual := schema.Normalise(r.Module)
+	expected := `module stream {
+		export data ExportRequest {
+			rows Int
+		}
+
+		export data ExportRow {
+			row String
+		}
+
+		export data TooManyRows {
+			max Int
+		}
+			+error
+
+		export verb export(stream.ExportRequest) stream.ExportRow
+			+stream
+			+concurrency 4 queue 16
+	}
+`
+	assert.Equal(t, normaliseString(expected), normaliseString(actual.String()))
+}
+
 func TestParsedirectives(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -423,12 +624,32 @@ func TestParsedirectives(t *testing.T) {
 	}{
 		{name: "Verb", input: "ftl:verb", expected: &directiveVerb{Verb: true}},
 		{name: "Verb export", input: "ftl:verb export", expected: &directiveVerb{Verb: true, Export: true}},
+		{name: "Verb internal", input: "ftl:verb internal", expected: &directiveVerb{Verb: true, Internal: true}},
 		{name: "Data", input: "ftl:data", expected: &directiveData{Data: true}},
 		{name: "Data export", input: "ftl:data export", expected: &directiveData{Data: true, Export: true}},
+		{name: "Data internal", input: "ftl:data internal", expected: &directiveData{Data: true, Internal: true}},
 		{name: "Enum", input: "ftl:enum", expected: &directiveEnum{Enum: true}},
 		{name: "Enum export", input: "ftl:enum export", expected: &directiveEnum{Enum: true, Export: true}},
 		{name: "TypeAlias", input: "ftl:typealias", expected: &directiveTypeAlias{TypeAlias: true}},
 		{name: "TypeAlias export", input: "ftl:typealias export", expected: &directiveTypeAlias{TypeAlias: true, Export: true}},
+		{name: "Subscribe", input: "ftl:subscribe payins", expected: &directiveSubscriber{Name: "payins"}},
+		{name: "Subscribe external topic", input: "ftl:subscribe pubsub.publicBroadcast", expected: &directiveSubscriber{Name: "pubsub.publicBroadcast"}},
+		{name: "Concurrency", input: "ftl:concurrency 4", expected: &directiveConcurrency{Max: 4}},
+		{name: "Concurrency with queue", input: "ftl:concurrency 4 queue 16", expected: &directiveConcurrency{Max: 4, Queue: func() *int { q := 16; return &q }()}},
+		{name: "Deprecated", input: "ftl:deprecated", expected: &directiveDeprecated{}},
+		{name: "Deprecated with message", input: `ftl:deprecated "use bar"`, expected: &directiveDeprecated{Message: "use bar"}},
+		{name: "Allow", input: "ftl:allow billing, orders", expected: &directiveAllow{Modules: []string{"billing", "orders"}}},
+		{name: "Cache", input: "ftl:cache 5m", expected: &directiveCache{TTL: "5m"}},
+		{name: "Cache with vary", input: "ftl:cache 1h vary id, region", expected: &directiveCache{TTL: "1h", Vary: []string{"id", "region"}}},
+		{name: "Egress", input: `ftl:egress "https://api.stripe.com", "https://*.amazonaws.com"`, expected: &directiveEgress{URLs: []string{"https://api.stripe.com", "https://*.amazonaws.com"}}},
+		{name: "Response", input: `ftl:response status code header "X-Request-Id" requestId header "Cache-Control" = "no-store" body data`, expected: &directiveResponse{
+			Status: "code",
+			Headers: []*schema.ResponseHeader{
+				{Name: "X-Request-Id", Field: "requestId"},
+				{Name: "Cache-Control", Value: "no-store"},
+			},
+			Body: "data",
+		}},
 		{name: "Ingress", input: `ftl:ingress GET /foo`, expected: &directiveIngress{
 			Method: "GET",
 			Path: []schema.IngressPathComponent{
@@ -583,12 +804,11 @@ func TestValidationFailures(t *testing.T) {
 	assert.NoError(t, err)
 	err = exec.Command(ctx, log.Debug, "testdata/validation", "go", "mod", "tidy").RunBuffered(ctx)
 	assert.NoError(t, err)
-	_, err = ExtractModuleSchema("testdata/validation", &schema.Schema{})
-	assert.Error(t, err)
-	errs := errors.UnwrapAll(err)
+	r, err := ExtractModuleSchema("testdata/validation", &schema.Schema{})
+	assert.NoError(t, err)
 
 	filename := filepath.Join(pwd, `testdata/validation/validation.go`)
-	actual := slices.Map(errs, func(e error) string {
+	actual := slices.Map(r.Errors, func(e *schema.Error) string {
 		return strings.TrimPrefix(e.Error(), filename+":")
 	})
 	expected := []string{
@@ -0,0 +1,48 @@
+package compositions
+
+import (
+	"context"
+
+	"github.com/TBD54566975/ftl/go-runtime/ftl"
+)
+
+//ftl:enum
+type Color string
+
+const (
+	Red   Color = "red"
+	Green Color = "green"
+)
+
+//ftl:enum
+type Shape interface{ shape() }
+
+type Circle struct{ Radius float64 }
+
+func (Circle) shape() {}
+
+type Square struct{ Side float64 }
+
+func (Square) shape() {}
+
+type Nested struct {
+	Value string
+}
+
+type Compositions struct {
+	OptionalMapValues   map[string]ftl.Option[int]
+	OptionalMap         ftl.Option[map[string]ftl.Option[Nested]]
+	OptionalArrayValues []ftl.Option[string]
+	OptionalArray       ftl.Option[[]ftl.Option[Nested]]
+	ArrayOfEnums        []Color
+	ArrayOfTypeEnums    []Shape
+	MapOfEnums          map[Color][]Color
+	OptionalEnum        ftl.Option[Color]
+	ArrayOfArrays       [][]ftl.Option[int]
+	MapOfArraysOfMaps   map[string][]map[string]ftl.Option[Color]
+}
+
+//ftl:verb
+func Echo(ctx context.Context, req Compositions) (Compositions, error) {
+	return req, nil
+}
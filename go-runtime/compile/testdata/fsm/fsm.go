@@ -50,3 +50,45 @@ func Failed(ctx context.Context, in OnlinePaymentFailed) error {
 func Paid(ctx context.Context, in OnlinePaymentPaid) error {
 	return nil
 }
+
+//ftl:retry 3 1s
+var checkoutSaga = ftl.Saga("checkout",
+	ftl.StepWithCompensation(Reserve, Release),
+	ftl.Step(Check),
+	ftl.StepWithCompensation(Charge, Refund),
+	ftl.Step(Ship),
+)
+
+type Order struct {
+	ID string
+}
+
+//ftl:verb
+func Reserve(ctx context.Context, in Order) error {
+	return nil
+}
+
+//ftl:verb
+func Release(ctx context.Context, in Order) error {
+	return nil
+}
+
+//ftl:verb
+func Check(ctx context.Context, in Order) error {
+	return nil
+}
+
+//ftl:verb
+func Charge(ctx context.Context, in Order) error {
+	return nil
+}
+
+//ftl:verb
+func Refund(ctx context.Context, in Order) error {
+	return nil
+}
+
+//ftl:verb
+func Ship(ctx context.Context, in Order) error {
+	return nil
+}
@@ -0,0 +1,52 @@
+package generics
+
+import (
+	"context"
+
+	"github.com/TBD54566975/ftl/go-runtime/ftl"
+)
+
+// Paginated is a page of results.
+//
+//ftl:export
+type Paginated[T any] struct {
+	Items []T
+	Next  ftl.Option[string]
+}
+
+//ftl:export
+type Pair[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+//ftl:export
+type Envelope[T any] struct {
+	Payload T
+	Pairs   []Pair[string, T]
+	Lookup  map[string]T
+}
+
+//ftl:export
+type Item struct {
+	Name string
+}
+
+type ListRequest struct {
+	Cursor ftl.Option[string]
+	//ftl:deprecated "use cursor"
+	Offset ftl.Option[int]
+}
+
+//ftl:verb export
+//ftl:cache 1m vary cursor
+//ftl:egress "https://items.example.com"
+func List(ctx context.Context, req ListRequest) (Envelope[Paginated[Item]], error) {
+	return Envelope[Paginated[Item]]{}, nil
+}
+
+//ftl:verb export
+//ftl:deprecated "use list"
+func ListAll(ctx context.Context, req ListRequest) (Envelope[Paginated[Item]], error) {
+	return Envelope[Paginated[Item]]{}, nil
+}
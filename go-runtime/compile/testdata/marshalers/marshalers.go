@@ -0,0 +1,43 @@
+package marshalers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/TBD54566975/ftl/go-runtime/ftl"
+)
+
+// AccountID is encoded as "<bank>/<number>".
+type AccountID struct {
+	Bank   string
+	Number string
+}
+
+func (a AccountID) MarshalFTL() (string, error) { return a.Bank + "/" + a.Number, nil }
+
+func (a *AccountID) UnmarshalFTL(value string) error {
+	bank, number, ok := strings.Cut(value, "/")
+	if !ok {
+		return fmt.Errorf("invalid account ID %q", value)
+	}
+	*a = AccountID{Bank: bank, Number: number}
+	return nil
+}
+
+type Transfer struct {
+	ID       uuid.UUID
+	From     AccountID
+	To       []AccountID
+	Amount   ftl.Decimal
+	Executed time.Time
+	Settled  ftl.Timestamp
+}
+
+//ftl:verb
+func Execute(ctx context.Context, req Transfer) (Transfer, error) {
+	return req, nil
+}
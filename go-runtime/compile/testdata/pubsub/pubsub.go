@@ -28,6 +28,13 @@ func ProcessPayin(ctx context.Context, event PayinEvent) error {
 
 var _ = ftl.Subscription(payinsVar, "paymentProcessing")
 
+//ftl:subscribe payins
+func AuditPayin(ctx context.Context, event PayinEvent) error {
+	logger := ftl.LoggerFromContext(ctx)
+	logger.Infof("Auditing PubSub event: %v", event)
+	return nil
+}
+
 var payinsVar = ftl.Topic[PayinEvent]("payins")
 
 var _ = ftl.Subscription(broadcast, "broadcastSubscription")
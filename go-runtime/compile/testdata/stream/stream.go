@@ -0,0 +1,39 @@
+package stream
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TBD54566975/ftl/go-runtime/ftl"
+)
+
+type ExportRequest struct {
+	Rows int
+}
+
+type ExportRow struct {
+	Row string
+}
+
+//ftl:verb export
+//ftl:concurrency 4 queue 16
+func Export(ctx context.Context, req ExportRequest, stream ftl.Stream[ExportRow]) error {
+	if req.Rows > 1000 {
+		return TooManyRows{Max: 1000}
+	}
+	for i := range req.Rows {
+		if err := stream.Send(ExportRow{Row: fmt.Sprintf("row %d", i)}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//ftl:data export
+type TooManyRows struct {
+	Max int
+}
+
+func (e TooManyRows) Error() string {
+	return fmt.Sprintf("too many rows, at most %d are allowed", e.Max)
+}
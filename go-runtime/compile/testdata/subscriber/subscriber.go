@@ -13,3 +13,8 @@ var _ = ftl.Subscription(pubsub.PublicBroadcast, "subscriptionToExternalTopic")
 func ConsumesSubscriptionFromExternalTopic(ctx context.Context, req pubsub.PayinEvent) error {
 	return nil
 }
+
+//ftl:subscribe pubsub.publicBroadcast
+func ConsumesExternalTopic(ctx context.Context, req pubsub.PayinEvent) error {
+	return nil
+}
@@ -66,3 +66,13 @@ func ReturnsUser(ctx context.Context) (UserResponse, error) {
 		},
 	}, nil
 }
+
+//ftl:data internal
+type Ledger struct {
+	Entries []string
+}
+
+//ftl:verb internal
+func Balance(ctx context.Context, req Ledger) (Ledger, error) {
+	return req, nil
+}
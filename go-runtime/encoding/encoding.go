@@ -3,15 +3,19 @@
 package encoding
 
 import (
+	"bufio"
 	"bytes"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
 	"reflect"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
+
 	"github.com/TBD54566975/ftl/backend/schema/strcase"
 	"github.com/TBD54566975/ftl/go-runtime/ftl/reflection"
 )
@@ -19,22 +23,61 @@ import (
 var (
 	optionMarshaler   = reflect.TypeFor[OptionMarshaler]()
 	optionUnmarshaler = reflect.TypeFor[OptionUnmarshaler]()
+	stringMarshaler   = reflect.TypeFor[StringMarshaler]()
+	stringUnmarshaler = reflect.TypeFor[StringUnmarshaler]()
 )
 
+// Writer is the destination of encoded values.
+//
+// It is implemented by both *bytes.Buffer and *bufio.Writer.
+type Writer interface {
+	io.Writer
+	io.StringWriter
+	WriteRune(r rune) (int, error)
+}
+
 type OptionMarshaler interface {
-	Marshal(w *bytes.Buffer, encode func(v reflect.Value, w *bytes.Buffer) error) error
+	Marshal(w Writer, encode func(v reflect.Value, w Writer) error) error
 }
 type OptionUnmarshaler interface {
 	Unmarshal(d *json.Decoder, isNull bool, decode func(d *json.Decoder, v reflect.Value) error) error
 }
 
+// StringMarshaler is implemented by types that encode themselves as a string.
+//
+// It is identical to ftl.Marshaler, which can't be referenced here without
+// an import cycle.
+type StringMarshaler interface {
+	MarshalFTL() (string, error)
+}
+
+// StringUnmarshaler is implemented by pointers to types that decode
+// themselves from a string. It is identical to ftl.Unmarshaler.
+type StringUnmarshaler interface {
+	UnmarshalFTL(value string) error
+}
+
 func Marshal(v any) ([]byte, error) {
 	w := &bytes.Buffer{}
 	err := encodeValue(reflect.ValueOf(v), w)
 	return w.Bytes(), err
 }
 
-func encodeValue(v reflect.Value, w *bytes.Buffer) error {
+// MarshalTo encodes "v" directly to "w", without buffering the whole encoded
+// value in memory.
+//
+// Verb requests and responses are carried whole in CallRequest and
+// CallResponse messages, so the runtime encodes them with [Marshal]. MarshalTo
+// is for callers that already have a stream to write to.
+func MarshalTo(w io.Writer, v any) error {
+	bw := bufio.NewWriter(w)
+	if err := encodeValue(reflect.ValueOf(v), bw); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+func encodeValue(v reflect.Value, w Writer) error {
 	if !v.IsValid() {
 		w.WriteString("null")
 		return nil
@@ -55,6 +98,16 @@ func encodeValue(v reflect.Value, w *bytes.Buffer) error {
 		w.Write(data)
 		return nil
 
+	case t == reflect.TypeFor[uuid.UUID]():
+		return encodeJSONString(v.Interface().(uuid.UUID).String(), w) //nolint:forcetypeassert
+
+	case t.Implements(stringMarshaler):
+		value, err := v.Interface().(StringMarshaler).MarshalFTL() //nolint:forcetypeassert
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s: %w", t, err)
+		}
+		return encodeJSONString(value, w)
+
 	case t.Implements(optionMarshaler):
 		enc := v.Interface().(OptionMarshaler) //nolint:forcetypeassert
 		return enc.Marshal(w, encodeValue)
@@ -115,7 +168,7 @@ func encodeValue(v reflect.Value, w *bytes.Buffer) error {
 	}
 }
 
-func encodeStruct(v reflect.Value, w *bytes.Buffer) error {
+func encodeStruct(v reflect.Value, w Writer) error {
 	w.WriteRune('{')
 	afterFirst := false
 	for i := range v.NumField() {
@@ -162,13 +215,13 @@ func isTaggedOmitempty(v reflect.Value, i int) bool {
 	return false
 }
 
-func encodeBytes(v reflect.Value, w *bytes.Buffer) error {
+func encodeBytes(v reflect.Value, w Writer) error {
 	data := base64.StdEncoding.EncodeToString(v.Bytes())
 	fmt.Fprintf(w, "%q", data)
 	return nil
 }
 
-func encodeSlice(v reflect.Value, w *bytes.Buffer) error {
+func encodeSlice(v reflect.Value, w Writer) error {
 	w.WriteRune('[')
 	for i := range v.Len() {
 		if i > 0 {
@@ -182,15 +235,16 @@ func encodeSlice(v reflect.Value, w *bytes.Buffer) error {
 	return nil
 }
 
-func encodeMap(v reflect.Value, w *bytes.Buffer) error {
+func encodeMap(v reflect.Value, w Writer) error {
 	w.WriteRune('{')
 	for i, key := range v.MapKeys() {
 		if i > 0 {
 			w.WriteRune(',')
 		}
-		w.WriteRune('"')
-		w.WriteString(key.String())
-		w.WriteString(`":`)
+		if err := encodeMapKey(key, w); err != nil {
+			return err
+		}
+		w.WriteRune(':')
 		if err := encodeValue(v.MapIndex(key), w); err != nil {
 			return err
 		}
@@ -199,7 +253,20 @@ func encodeMap(v reflect.Value, w *bytes.Buffer) error {
 	return nil
 }
 
-func encodeBool(v reflect.Value, w *bytes.Buffer) error {
+// encodeMapKey encodes a map key as a JSON string, including keys of named
+// types such as enums.
+func encodeMapKey(key reflect.Value, w Writer) error {
+	switch key.Kind() {
+	case reflect.String:
+		return encodeJSONString(key.String(), w)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return encodeJSONString(strconv.FormatInt(key.Int(), 10), w)
+	default:
+		return fmt.Errorf("unsupported map key type: %s", key.Type())
+	}
+}
+
+func encodeBool(v reflect.Value, w Writer) error {
 	if v.Bool() {
 		w.WriteString("true")
 	} else {
@@ -208,28 +275,46 @@ func encodeBool(v reflect.Value, w *bytes.Buffer) error {
 	return nil
 }
 
-func encodeInt(v reflect.Value, w *bytes.Buffer) error {
+func encodeInt(v reflect.Value, w Writer) error {
 	fmt.Fprintf(w, "%d", v.Int())
 	return nil
 }
 
-func encodeFloat(v reflect.Value, w *bytes.Buffer) error {
+func encodeFloat(v reflect.Value, w Writer) error {
 	fmt.Fprintf(w, "%g", v.Float())
 	return nil
 }
 
-func encodeString(v reflect.Value, w *bytes.Buffer) error {
+func encodeString(v reflect.Value, w Writer) error {
 	fmt.Fprintf(w, "%q", v.String())
 	return nil
 }
 
+func encodeJSONString(value string, w Writer) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	w.Write(data)
+	return nil
+}
+
 func Unmarshal(data []byte, v any) error {
+	return UnmarshalFrom(bytes.NewReader(data), v)
+}
+
+// UnmarshalFrom decodes a value from "r" into "v", without first reading all
+// of "r" into memory.
+//
+// Optional values whose start straddles the end of the decoder's buffered
+// input are buffered in full to determine whether they are null.
+func UnmarshalFrom(r io.Reader, v any) error {
 	rv := reflect.ValueOf(v)
 	if rv.Kind() != reflect.Ptr || rv.IsNil() {
 		return fmt.Errorf("unmarshal expects a non-nil pointer")
 	}
 
-	d := json.NewDecoder(bytes.NewReader(data))
+	d := json.NewDecoder(r)
 	return decodeValue(d, rv.Elem())
 }
 
@@ -248,6 +333,19 @@ func decodeValue(d *json.Decoder, v reflect.Value) error {
 	case t == reflect.TypeFor[time.Time]():
 		return d.Decode(v.Addr().Interface())
 
+	case t == reflect.TypeFor[uuid.UUID]():
+		return decodeStringWith(d, t, func(value string) error {
+			id, err := uuid.Parse(value)
+			if err != nil {
+				return err
+			}
+			v.Set(reflect.ValueOf(id))
+			return nil
+		})
+
+	case v.CanAddr() && v.Addr().Type().Implements(stringUnmarshaler):
+		return decodeStringWith(d, t, v.Addr().Interface().(StringUnmarshaler).UnmarshalFTL) //nolint:forcetypeassert
+
 	case v.CanAddr() && v.Addr().Type().Implements(optionUnmarshaler):
 		v = v.Addr()
 		fallthrough
@@ -257,11 +355,26 @@ func decodeValue(d *json.Decoder, v reflect.Value) error {
 			v.Set(reflect.New(t.Elem()))
 		}
 		dec := v.Interface().(OptionUnmarshaler) //nolint:forcetypeassert
-		return handleIfNextTokenIsNull(d, func(d *json.Decoder) error {
-			return dec.Unmarshal(d, true, decodeValue)
-		}, func(d *json.Decoder) error {
-			return dec.Unmarshal(d, false, decodeValue)
-		})
+		isNull, ok := isNextValueNull(d)
+		if !ok {
+			// The start of the value isn't buffered yet, so fall back to
+			// buffering just the optional value to determine whether it is null.
+			var raw json.RawMessage
+			if err := d.Decode(&raw); err != nil {
+				return err
+			}
+			if string(raw) == "null" {
+				return dec.Unmarshal(d, true, decodeValue)
+			}
+			return dec.Unmarshal(json.NewDecoder(bytes.NewReader(raw)), false, decodeValue)
+		}
+		if isNull {
+			// Consume the null token.
+			if _, err := d.Token(); err != nil {
+				return err
+			}
+		}
+		return dec.Unmarshal(d, isNull, decodeValue)
 	}
 
 	switch v.Kind() {
@@ -292,6 +405,43 @@ func decodeValue(d *json.Decoder, v reflect.Value) error {
 	}
 }
 
+// decodeStringWith decodes a JSON string and passes it to "set".
+// isNextValueNull implements a cheap version of `Peek()`, which json.Decoder
+// does not support, by checking whether the next value in the data buffered
+// by "d" is null.
+//
+// "ok" is false if the start of the next value has not been buffered yet.
+func isNextValueNull(d *json.Decoder) (isNull, ok bool) {
+	buffered, canPeek := d.Buffered().(io.ByteReader)
+	if !canPeek {
+		return false, false
+	}
+	b, err := buffered.ReadByte()
+	for ; err == nil; b, err = buffered.ReadByte() {
+		switch b {
+		case ' ', '\t', '\r', '\n':
+			continue
+		case ':', ',':
+			// The separator before the value, which the decoder consumes lazily.
+			continue
+		}
+		// "null" is the only JSON value starting with 'n'.
+		return b == 'n', true
+	}
+	return false, false
+}
+
+func decodeStringWith(d *json.Decoder, t reflect.Type, set func(value string) error) error {
+	var value string
+	if err := d.Decode(&value); err != nil {
+		return err
+	}
+	if err := set(value); err != nil {
+		return fmt.Errorf("failed to unmarshal %s: %w", t, err)
+	}
+	return nil
+}
+
 func decodeStruct(d *json.Decoder, v reflect.Value) error {
 	if err := expectDelim(d, '{'); err != nil {
 		return err
@@ -366,9 +516,14 @@ func decodeMap(d *json.Decoder, v reflect.Value) error {
 		v.Set(reflect.MakeMap(v.Type()))
 	}
 
+	keyType := v.Type().Key()
 	valType := v.Type().Elem()
 	for d.More() {
-		key, err := d.Token()
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		key, err := decodeMapKey(token, keyType)
 		if err != nil {
 			return err
 		}
@@ -378,13 +533,33 @@ func decodeMap(d *json.Decoder, v reflect.Value) error {
 			return err
 		}
 
-		v.SetMapIndex(reflect.ValueOf(key), newElem)
+		v.SetMapIndex(key, newElem)
 	}
 	// consume the closing delimiter of the map
 	_, err := d.Token()
 	return err
 }
 
+// decodeMapKey converts a JSON object key to a map key of type "t".
+func decodeMapKey(token json.Token, t reflect.Type) (reflect.Value, error) {
+	key, ok := token.(string)
+	if !ok {
+		return reflect.Value{}, fmt.Errorf("expected map key to be a string, got %T", token)
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return reflect.ValueOf(key).Convert(t), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(key, 10, t.Bits())
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("failed to unmarshal map key %q as %s: %w", key, t, err)
+		}
+		return reflect.ValueOf(n).Convert(t), nil
+	default:
+		return reflect.Value{}, fmt.Errorf("unsupported map key type: %s", t)
+	}
+}
+
 func decodeSumType(d *json.Decoder, v reflect.Value) error {
 	var sumType struct {
 		Name  string
@@ -429,50 +604,3 @@ func expectDelim(d *json.Decoder, expected json.Delim) error {
 	}
 	return nil
 }
-
-func handleIfNextTokenIsNull(d *json.Decoder, ifNullFn func(*json.Decoder) error, elseFn func(*json.Decoder) error) error {
-	isNull, err := isNextTokenNull(d)
-	if err != nil {
-		return err
-	}
-	if isNull {
-		err = ifNullFn(d)
-		if err != nil {
-			return err
-		}
-		// Consume the null token
-		_, err := d.Token()
-		if err != nil {
-			return err
-		}
-		return nil
-	}
-	return elseFn(d)
-}
-
-// isNextTokenNull implements a cheap/dirty version of `Peek()`, which json.Decoder does
-// not support.
-//
-// It reads the buffered data and checks if the next token is "null" without actually consuming the token.
-func isNextTokenNull(d *json.Decoder) (bool, error) {
-	s, err := io.ReadAll(d.Buffered())
-	if err != nil {
-		return false, err
-	}
-
-	remaining := s[bytes.IndexFunc(s, isDelim)+1:]
-	secondDelim := bytes.IndexFunc(remaining, isDelim)
-	if secondDelim == -1 {
-		secondDelim = len(remaining) // No delimiters found, read until the end
-	}
-
-	return strings.TrimSpace(string(remaining[:secondDelim])) == "null", nil
-}
-
-func isDelim(r rune) bool {
-	switch r {
-	case ',', ':', '{', '}', '[', ']':
-		return true
-	}
-	return false
-}
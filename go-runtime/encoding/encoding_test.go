@@ -1,11 +1,15 @@
 package encoding_test
 
 import (
+	"errors"
 	"reflect"
+	"strings"
 	"testing"
+	"testing/iotest"
 	"time"
 
 	"github.com/alecthomas/assert/v2"
+	"github.com/google/uuid"
 
 	. "github.com/TBD54566975/ftl/go-runtime/encoding"
 	"github.com/TBD54566975/ftl/go-runtime/ftl"
@@ -27,6 +31,32 @@ type variant struct {
 func (variant) tag()          {}
 func (variant) unregistered() {}
 
+// accountID implements ftl.Marshaler.
+type accountID struct {
+	Bank   string
+	Number string
+}
+
+func (a accountID) MarshalFTL() (string, error) { return a.Bank + "/" + a.Number, nil }
+
+func (a *accountID) UnmarshalFTL(value string) error {
+	bank, number, ok := strings.Cut(value, "/")
+	if !ok {
+		return errors.New("expected <bank>/<number>")
+	}
+	*a = accountID{Bank: bank, Number: number}
+	return nil
+}
+
+var (
+	_ ftl.Marshaler   = accountID{}
+	_ ftl.Unmarshaler = (*accountID)(nil)
+)
+
+type color string
+
+var testUUID = uuid.MustParse("0f1e2d3c-4b5a-6978-8796-a5b4c3d2e1f0")
+
 func TestMarshal(t *testing.T) {
 	type inner struct {
 		FooBar string
@@ -69,6 +99,11 @@ func TestMarshal(t *testing.T) {
 			Unit   ftl.Unit
 		}{String: "something", Unit: ftl.Unit{}}, expected: `{"string":"something","unit":{}}`},
 		{name: "Pointer", input: &struct{ String string }{"foo"}, err: `pointer types are not supported: *struct { String string }`},
+		{name: "Time", input: struct{ Time time.Time }{time.Date(2009, time.November, 29, 21, 33, 0, 0, time.UTC)}, expected: `{"time":"2009-11-29T21:33:00Z"}`},
+		{name: "UUID", input: struct{ ID uuid.UUID }{testUUID}, expected: `{"id":"0f1e2d3c-4b5a-6978-8796-a5b4c3d2e1f0"}`},
+		{name: "Marshaler", input: struct{ Account accountID }{accountID{"anz", "1234"}}, expected: `{"account":"anz/1234"}`},
+		{name: "Decimal", input: struct{ Amount ftl.Decimal }{ftl.MustParseDecimal("-1234.50")}, expected: `{"amount":"-1234.50"}`},
+		{name: "Timestamp", input: struct{ At ftl.Timestamp }{ftl.Timestamp{Time: time.Date(2009, time.November, 29, 21, 33, 0, 0, time.UTC)}}, expected: `{"at":"2009-11-29T21:33:00Z"}`},
 		{name: "SumType", input: struct{ D discriminator }{variant{"hello"}}, expected: `{"d":{"name":"Variant","value":{"message":"hello"}}}`},
 		{name: "UnregisteredSumType", input: struct{ D unregistered }{variant{"hello"}}, err: `the only supported interface types are enums or any, not encoding_test.unregistered`},
 		{name: "OmitEmptyNotNull", input: validateOmitempty{"foo", "bar", "baz"}, expected: `{"shouldOmit":"foo","shouldntOmit":"bar","notTagged":"baz"}`},
@@ -119,6 +154,7 @@ func TestUnmarshal(t *testing.T) {
 		{name: "OptionZero", input: `{"option":0}`, expected: struct{ Option ftl.Option[int] }{ftl.Some(0)}},
 		{name: "Option", input: `{"option":42}`, expected: struct{ Option ftl.Option[int] }{ftl.Some(42)}},
 		{name: "OptionStruct", input: `{"option":{"fooBar":"foo"}}`, expected: struct{ Option ftl.Option[inner] }{ftl.Some(inner{"foo"})}},
+		{name: "OptionSlice", input: `{"slice":[null, 1,null ,2]}`, expected: struct{ Slice []ftl.Option[int] }{[]ftl.Option[int]{ftl.None[int](), ftl.Some(1), ftl.None[int](), ftl.Some(2)}}},
 		{name: "Unit", input: `{}`, expected: ftl.Unit{}},
 		{name: "UnitField", input: `{"string":"something"}`, expected: struct {
 			String string
@@ -131,6 +167,10 @@ func TestUnmarshal(t *testing.T) {
 			Bool   bool
 		}{ftl.None[int](), true}},
 		{name: "Pointer", input: `{"string":"foo"}`, expected: &struct{ String string }{}, err: `pointer types are not supported: *struct { String string }`},
+		{name: "UUID", input: `{"id":"0f1e2d3c-4b5a-6978-8796-a5b4c3d2e1f0"}`, expected: struct{ ID uuid.UUID }{testUUID}},
+		{name: "InvalidUUID", input: `{"id":"nope"}`, expected: struct{ ID uuid.UUID }{}, err: `failed to unmarshal uuid.UUID: invalid UUID length: 4`},
+		{name: "Marshaler", input: `{"account":"anz/1234"}`, expected: struct{ Account accountID }{accountID{"anz", "1234"}}},
+		{name: "InvalidMarshaler", input: `{"account":"anz"}`, expected: struct{ Account accountID }{}, err: `failed to unmarshal encoding_test.accountID: expected <bank>/<number>`},
 		{name: "SumType", input: `{"d":{"name":"Variant","value":{"message":"hello"}}}`, expected: struct{ D discriminator }{variant{"hello"}}},
 		{name: "MalformedSumType", input: `{"d":{"message":"hello"}}`, expected: struct{ D discriminator }{}, err: `no name found for type enum variant`},
 		{name: "UnregisteredSumType", input: `{"d":{"name":"Variant","value":{"message":"hello"}}}`, expected: struct{ D unregistered }{}, err: `the only supported interface types are enums or any, not encoding_test.unregistered`},
@@ -171,6 +211,10 @@ func TestRoundTrip(t *testing.T) {
 		{name: "SliceOfStrings", input: struct{ Slice []string }{[]string{"hello", "world"}}},
 		{name: "Map", input: struct{ Map map[string]int }{map[string]int{"foo": 42}}},
 		{name: "Time", input: struct{ Time time.Time }{time.Date(2009, time.November, 29, 21, 33, 0, 0, time.UTC)}},
+		{name: "UUID", input: struct{ ID uuid.UUID }{testUUID}},
+		{name: "Marshaler", input: struct{ Accounts []accountID }{[]accountID{{"anz", "1234"}, {"nab", "5678"}}}},
+		{name: "OptionMarshaler", input: struct{ Account ftl.Option[accountID] }{ftl.Some(accountID{"anz", "1234"})}},
+		{name: "Decimal", input: struct{ Amount ftl.Decimal }{ftl.MustParseDecimal("1234.50")}},
 		{name: "Option", input: struct{ Option ftl.Option[int] }{ftl.Some(42)}},
 		{name: "OptionNull", input: struct{ Option ftl.Option[int] }{ftl.None[int]()}},
 		{name: "OptionStruct", input: struct{ Option ftl.Option[inner] }{ftl.Some(inner{"foo"})}},
@@ -183,6 +227,13 @@ func TestRoundTrip(t *testing.T) {
 			TokenID string `json:"token_id"`
 		}{"123"}},
 		{name: "SumType", input: struct{ D discriminator }{variant{"hello"}}},
+		{name: "OptionalMapValues", input: struct{ Map map[string]ftl.Option[int] }{map[string]ftl.Option[int]{"foo": ftl.Some(42), "bar": ftl.None[int]()}}},
+		{name: "OptionalArrayValues", input: struct {
+			Slice ftl.Option[[]ftl.Option[string]]
+		}{ftl.Some([]ftl.Option[string]{ftl.Some("foo"), ftl.None[string]()})}},
+		{name: "EnumMapKeys", input: struct{ Map map[color][]color }{map[color][]color{"red": {"green", "blue"}}}},
+		{name: "IntMapKeys", input: struct{ Map map[int]string }{map[int]string{42: "foo"}}},
+		{name: "EscapedMapKeys", input: struct{ Map map[string]int }{map[string]int{`"quoted"`: 42}}},
 	}
 
 	reflection.AllowAnyPackageForTesting = true
@@ -204,3 +255,30 @@ func TestRoundTrip(t *testing.T) {
 		})
 	}
 }
+
+func TestStreamingRoundTrip(t *testing.T) {
+	type inner struct {
+		FooBar string
+	}
+	type payload struct {
+		Items  []inner
+		Option ftl.Option[inner]
+		None   ftl.Option[int]
+		Blob   []byte
+	}
+	input := payload{Option: ftl.Some(inner{"foo"}), None: ftl.None[int](), Blob: []byte(strings.Repeat("x", 10000))}
+	for i := range 1000 {
+		input.Items = append(input.Items, inner{FooBar: strings.Repeat("y", i%10)})
+	}
+
+	w := &strings.Builder{}
+	assert.NoError(t, MarshalTo(w, input))
+	marshaled, err := Marshal(input)
+	assert.NoError(t, err)
+	assert.Equal(t, string(marshaled), w.String())
+
+	// Read a byte at a time so values straddle the decoder's buffer.
+	var output payload
+	assert.NoError(t, UnmarshalFrom(iotest.OneByteReader(strings.NewReader(w.String())), &output))
+	assert.Equal(t, input, output)
+}
@@ -2,6 +2,7 @@ package ftl
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 
@@ -12,25 +13,56 @@ import (
 	"github.com/TBD54566975/ftl/backend/schema"
 	"github.com/TBD54566975/ftl/go-runtime/encoding"
 	"github.com/TBD54566975/ftl/go-runtime/ftl/reflection"
+	"github.com/TBD54566975/ftl/go-runtime/internal"
 	"github.com/TBD54566975/ftl/internal/modulecontext"
 	"github.com/TBD54566975/ftl/internal/rpc"
+	"github.com/TBD54566975/ftl/internal/rpc/headers"
 )
 
-func call[Req, Resp any](ctx context.Context, callee reflection.Ref, req Req, inline Verb[Req, Resp]) (resp Resp, err error) {
+// ErrOverloaded is returned when a call is rejected because the Verb is at its
+// concurrency limit and its queue is full.
+var ErrOverloaded = errors.New("verb overloaded")
+
+func call[Req, Resp any](ctx context.Context, callee reflection.Ref, req Req, inline Verb[Req, Resp], options []CallOption) (resp Resp, err error) {
+	opts := newCallOptions(options)
+	uncheckedResp, err := InterceptCall(ctx, &CallInfo{Verb: callee, Outgoing: true, Metadata: opts.metadata}, req, func(ctx context.Context, info *CallInfo, uncheckedReq any) (any, error) {
+		req, ok := uncheckedReq.(Req)
+		if !ok {
+			return nil, fmt.Errorf("%s: interceptor passed invalid request type %T, expected %v", callee, uncheckedReq, reflect.TypeFor[Req]())
+		}
+		opts.metadata = info.Metadata
+		return dispatchCall(ctx, callee, req, inline, opts)
+	})
+	if err != nil {
+		return resp, err
+	}
+	if r, ok := uncheckedResp.(Resp); ok {
+		return r, nil
+	}
+	return resp, fmt.Errorf("%s: interceptor returned invalid response type %T, expected %v", callee, uncheckedResp, reflect.TypeFor[Resp]())
+}
+
+// dispatchCall calls a Verb, either through the FTL controller or by overridden behaviour.
+func dispatchCall[Req, Resp any](ctx context.Context, callee reflection.Ref, req Req, inline Verb[Req, Resp], opts callOptions) (resp Resp, err error) {
 	moduleCtx := modulecontext.FromContext(ctx).CurrentContext()
 	override, err := moduleCtx.BehaviorForVerb(schema.Ref{Module: callee.Module, Name: callee.Name})
 	if err != nil {
 		return resp, fmt.Errorf("%s: %w", callee, err)
 	}
 	if behavior, ok := override.Get(); ok {
-		uncheckedResp, err := behavior.Call(ctx, modulecontext.Verb(widenVerb(inline)), req)
-		if err != nil {
-			return resp, fmt.Errorf("%s: %w", callee, err)
-		}
-		if r, ok := uncheckedResp.(Resp); ok {
-			return r, nil
-		}
-		return resp, fmt.Errorf("%s: overridden verb had invalid response type %T, expected %v", callee, uncheckedResp, reflect.TypeFor[Resp]())
+		err = opts.run(ctx, func(ctx context.Context) error {
+			ctx = internal.ContextWithCallMetadata(ctx, opts.metadata)
+			uncheckedResp, err := behavior.Call(ctx, modulecontext.Verb(widenVerb(inline)), req)
+			if err != nil {
+				return fmt.Errorf("%s: %w", callee, err)
+			}
+			if r, ok := uncheckedResp.(Resp); ok {
+				resp = r
+				return nil
+			}
+			return fmt.Errorf("%s: overridden verb had invalid response type %T, expected %v", callee, uncheckedResp, reflect.TypeFor[Resp]())
+		})
+		return resp, err
 	}
 
 	reqData, err := encoding.Marshal(req)
@@ -39,54 +71,167 @@ func call[Req, Resp any](ctx context.Context, callee reflection.Ref, req Req, in
 	}
 
 	client := rpc.ClientFromContext[ftlv1connect.VerbServiceClient](ctx)
-	cresp, err := client.Call(ctx, connect.NewRequest(&ftlv1.CallRequest{Verb: callee.ToProto(), Body: reqData}))
-	if err != nil {
-		return resp, fmt.Errorf("%s: failed to call Verb: %w", callee, err)
-	}
-	switch cresp := cresp.Msg.Response.(type) {
-	case *ftlv1.CallResponse_Error_:
-		return resp, fmt.Errorf("%s: %s", callee, cresp.Error.Message)
-
-	case *ftlv1.CallResponse_Body:
-		err = encoding.Unmarshal(cresp.Body, &resp)
+	err = opts.run(ctx, func(ctx context.Context) error {
+		creq := connect.NewRequest(&ftlv1.CallRequest{Metadata: opts.metadataToProto(), Verb: callee.ToProto(), Body: reqData})
+		if opts.bypassCache {
+			headers.SetCacheBypass(creq.Header())
+		}
+		cresp, err := client.Call(ctx, creq)
 		if err != nil {
-			return resp, fmt.Errorf("%s: failed to decode response: %w", callee, err)
+			return callError(callee, err)
 		}
-		return resp, nil
+		switch cresp := cresp.Msg.Response.(type) {
+		case *ftlv1.CallResponse_Error_:
+			return fmt.Errorf("%s: %w", callee, internal.ErrorFromProto(cresp.Error))
 
-	default:
-		panic(fmt.Sprintf("%s: invalid response type %T", callee, cresp))
-	}
+		case *ftlv1.CallResponse_Body:
+			err = encoding.Unmarshal(cresp.Body, &resp)
+			if err != nil {
+				return fmt.Errorf("%s: failed to decode response: %w", callee, err)
+			}
+			return nil
+
+		default:
+			panic(fmt.Sprintf("%s: invalid response type %T", callee, cresp))
+		}
+	})
+	return resp, err
 }
 
 // Call a Verb through the FTL Controller.
-func Call[Req, Resp any](ctx context.Context, verb Verb[Req, Resp], req Req) (Resp, error) {
-	return call[Req, Resp](ctx, reflection.FuncRef(verb), req, verb)
+func Call[Req, Resp any](ctx context.Context, verb Verb[Req, Resp], req Req, options ...CallOption) (Resp, error) {
+	return call[Req, Resp](ctx, reflection.FuncRef(verb), req, verb, options)
 }
 
 // CallSink calls a Sink through the FTL controller.
-func CallSink[Req any](ctx context.Context, sink Sink[Req], req Req) error {
+func CallSink[Req any](ctx context.Context, sink Sink[Req], req Req, options ...CallOption) error {
 	_, err := call[Req, Unit](ctx, reflection.FuncRef(sink), req, func(ctx context.Context, req Req) (Unit, error) {
 		return Unit{}, sink(ctx, req)
-	})
+	}, options)
 	return err
 }
 
 // CallSource calls a Source through the FTL controller.
-func CallSource[Resp any](ctx context.Context, source Source[Resp]) (Resp, error) {
+func CallSource[Resp any](ctx context.Context, source Source[Resp], options ...CallOption) (Resp, error) {
 	return call[Unit, Resp](ctx, reflection.FuncRef(source), Unit{}, func(ctx context.Context, req Unit) (Resp, error) {
 		return source(ctx)
-	})
+	}, options)
 }
 
 // CallEmpty calls a Verb with no request or response through the FTL controller.
-func CallEmpty(ctx context.Context, empty Empty) error {
+func CallEmpty(ctx context.Context, empty Empty, options ...CallOption) error {
 	_, err := call[Unit, Unit](ctx, reflection.FuncRef(empty), Unit{}, func(ctx context.Context, req Unit) (Unit, error) {
 		return Unit{}, empty(ctx)
+	}, options)
+	return err
+}
+
+// CallStream calls a StreamVerb through the FTL controller, passing each of its
+// outputs to "recv" as they are sent.
+//
+// If "recv" returns an error the stream is closed and the error is returned.
+// As outputs may already have been passed to "recv", retries should only be
+// enabled if "recv" is idempotent.
+func CallStream[Req, Resp any](ctx context.Context, verb StreamVerb[Req, Resp], req Req, recv func(resp Resp) error, options ...CallOption) error {
+	opts := newCallOptions(options)
+	callee := reflection.FuncRef(verb)
+	_, err := InterceptCall(ctx, &CallInfo{Verb: callee, Outgoing: true, Metadata: opts.metadata}, req, func(ctx context.Context, info *CallInfo, uncheckedReq any) (any, error) {
+		req, ok := uncheckedReq.(Req)
+		if !ok {
+			return nil, fmt.Errorf("%s: interceptor passed invalid request type %T, expected %v", callee, uncheckedReq, reflect.TypeFor[Req]())
+		}
+		opts.metadata = info.Metadata
+		return nil, dispatchStream(ctx, callee, verb, req, recv, opts)
 	})
 	return err
 }
 
+// dispatchStream calls a StreamVerb, either through the FTL controller or by overridden behaviour.
+func dispatchStream[Req, Resp any](ctx context.Context, callee reflection.Ref, verb StreamVerb[Req, Resp], req Req, recv func(resp Resp) error, opts callOptions) error {
+	moduleCtx := modulecontext.FromContext(ctx).CurrentContext()
+	override, err := moduleCtx.BehaviorForVerb(schema.Ref{Module: callee.Module, Name: callee.Name})
+	if err != nil {
+		return fmt.Errorf("%s: %w", callee, err)
+	}
+	if behavior, ok := override.Get(); ok {
+		// Direct calls send outputs straight to "recv", while mocks return a single output.
+		inline := func(ctx context.Context, uncheckedReq any) (any, error) {
+			req, ok := uncheckedReq.(Req)
+			if !ok {
+				return nil, fmt.Errorf("invalid request type %T for %v, expected %v", uncheckedReq, callee, reflect.TypeFor[Req]())
+			}
+			return nil, verb(ctx, req, streamFunc[Resp](recv))
+		}
+		return opts.run(ctx, func(ctx context.Context) error {
+			ctx = internal.ContextWithCallMetadata(ctx, opts.metadata)
+			uncheckedResp, err := behavior.Call(ctx, inline, req)
+			if err != nil {
+				return fmt.Errorf("%s: %w", callee, err)
+			}
+			if uncheckedResp == nil {
+				return nil
+			}
+			if r, ok := uncheckedResp.(Resp); ok {
+				return recv(r)
+			}
+			return fmt.Errorf("%s: overridden verb had invalid response type %T, expected %v", callee, uncheckedResp, reflect.TypeFor[Resp]())
+		})
+	}
+
+	reqData, err := encoding.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("%s: failed to marshal request: %w", callee, err)
+	}
+
+	client := rpc.ClientFromContext[ftlv1connect.VerbServiceClient](ctx)
+	return opts.run(ctx, func(ctx context.Context) error {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		stream, err := client.CallStream(ctx, connect.NewRequest(&ftlv1.CallRequest{Metadata: opts.metadataToProto(), Verb: callee.ToProto(), Body: reqData}))
+		if err != nil {
+			return callError(callee, err)
+		}
+		defer stream.Close()
+		for stream.Receive() {
+			switch cresp := stream.Msg().Response.(type) {
+			case *ftlv1.CallResponse_Error_:
+				return fmt.Errorf("%s: %w", callee, internal.ErrorFromProto(cresp.Error))
+
+			case *ftlv1.CallResponse_Body:
+				var resp Resp
+				err = encoding.Unmarshal(cresp.Body, &resp)
+				if err != nil {
+					return fmt.Errorf("%s: failed to decode response: %w", callee, err)
+				}
+				if err := recv(resp); err != nil {
+					return err
+				}
+
+			default:
+				panic(fmt.Sprintf("%s: invalid response type %T", callee, cresp))
+			}
+		}
+		if err := stream.Err(); err != nil {
+			return callError(callee, err)
+		}
+		return nil
+	})
+}
+
+// callError wraps an error returned by the FTL controller for a call to "callee".
+func callError(callee reflection.Ref, err error) error {
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) && headers.IsOverloaded(connectErr.Meta()) {
+		return fmt.Errorf("%s: %w: %s", callee, ErrOverloaded, connectErr.Message())
+	}
+	return fmt.Errorf("%s: failed to call Verb: %w", callee, err)
+}
+
+// streamFunc adapts a function to a Stream.
+type streamFunc[Resp any] func(resp Resp) error
+
+func (s streamFunc[Resp]) Send(resp Resp) error { return s(resp) }
+
 func widenVerb[Req, Resp any](verb Verb[Req, Resp]) Verb[any, any] {
 	return func(ctx context.Context, uncheckedReq any) (any, error) {
 		req, ok := uncheckedReq.(Req)
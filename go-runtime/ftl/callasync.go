@@ -0,0 +1,93 @@
+package ftl
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/alecthomas/types/optional"
+	"github.com/jpillora/backoff"
+
+	"github.com/TBD54566975/ftl/go-runtime/ftl/reflection"
+	"github.com/TBD54566975/ftl/go-runtime/internal"
+)
+
+// asyncCallPollInterval bounds the interval between polls of the result of an
+// async call by [AsyncCall.Await].
+var asyncCallPollInterval = backoff.Backoff{Min: 100 * time.Millisecond, Max: 5 * time.Second, Factor: 2}
+
+// AsyncCall is a handle to a call enqueued with [CallAsync].
+//
+// Handles can only be used by Verbs in the module that made the call.
+type AsyncCall[Resp any] struct {
+	id   int64
+	verb reflection.Ref
+}
+
+// ID of the async call.
+func (a AsyncCall[Resp]) ID() int64 { return a.id }
+
+// Poll returns the response of the call if it has completed, including any
+// retries.
+//
+// "done" is false while the call is pending, or true once it has completed, in
+// which case "err" is the error of a failed call.
+func (a AsyncCall[Resp]) Poll(ctx context.Context) (resp Resp, done bool, err error) {
+	done, err = internal.FromContext(ctx).AsyncCallResult(ctx, a.id, &resp)
+	if err != nil {
+		return resp, done, fmt.Errorf("%s: %w", a.verb, err)
+	}
+	return resp, done, nil
+}
+
+// Await waits for the call to complete, including any retries, and returns
+// its response.
+//
+// To limit the time spent waiting, pass a context with a deadline, eg. from
+// [context.WithTimeout]. The call continues in the background if the wait is
+// abandoned.
+func (a AsyncCall[Resp]) Await(ctx context.Context) (Resp, error) {
+	interval := asyncCallPollInterval
+	for {
+		resp, done, err := a.Poll(ctx)
+		if done || err != nil {
+			return resp, err
+		}
+		select {
+		case <-ctx.Done():
+			return resp, fmt.Errorf("%s: async call %d did not complete: %w", a.verb, a.id, context.Cause(ctx))
+		case <-time.After(interval.Duration()):
+		}
+	}
+}
+
+// CallAsync enqueues a call to a Verb, returning without waiting for it to
+// complete.
+//
+// The call is durable: it is executed by the FTL controller even if the calling
+// Verb fails or is redeployed, and failed calls are retried with [WithRetry] or,
+// if not given, the +retry policy of the Verb. Calls that exhaust their retries
+// are kept in the error state for inspection.
+//
+// [WithTimeout] bounds the time taken to enqueue the call. Other options are
+// ignored.
+func CallAsync[Req, Resp any](ctx context.Context, verb Verb[Req, Resp], req Req, options ...CallOption) (AsyncCall[Resp], error) {
+	opts := newCallOptions(options)
+	callee := reflection.FuncRef(verb)
+	retry := optional.None[internal.AsyncCallRetry]()
+	if opts.retrySet {
+		retry = optional.Some(internal.AsyncCallRetry{Count: opts.retries, Backoff: opts.backoff})
+	}
+	if opts.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.timeout)
+		defer cancel()
+	}
+	id, err := internal.FromContext(ctx).CallAsync(ctx, callee, req, retry, func(ctx context.Context) (any, error) {
+		return dispatchCall(ctx, callee, req, verb, callOptions{})
+	})
+	if err != nil {
+		return AsyncCall[Resp]{}, fmt.Errorf("%s: %w", callee, err)
+	}
+	return AsyncCall[Resp]{id: id, verb: callee}, nil
+}
@@ -0,0 +1,46 @@
+package ftl
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/go-runtime/ftl/reflection"
+	"github.com/TBD54566975/ftl/go-runtime/internal"
+)
+
+// pollingFTL completes async calls after they have been polled "polls" times.
+type pollingFTL struct {
+	internal.FTL
+	polls int
+}
+
+func (p *pollingFTL) AsyncCallResult(ctx context.Context, id int64, dest any) (bool, error) {
+	p.polls--
+	if p.polls > 0 {
+		return false, nil
+	}
+	reflect.ValueOf(dest).Elem().Set(reflect.ValueOf("done"))
+	return true, nil
+}
+
+func TestAsyncCallAwait(t *testing.T) {
+	ctx := internal.WithContext(context.Background(), &pollingFTL{polls: 3})
+	call := AsyncCall[string]{id: 1, verb: reflection.Ref{Module: "test", Name: "echo"}}
+	resp, err := call.Await(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "done", resp)
+}
+
+func TestAsyncCallAwaitTimeout(t *testing.T) {
+	ctx := internal.WithContext(context.Background(), &pollingFTL{polls: 1000})
+	ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	call := AsyncCall[string]{id: 1, verb: reflection.Ref{Module: "test", Name: "echo"}}
+	_, err := call.Await(ctx)
+	assert.IsError(t, err, context.DeadlineExceeded)
+	assert.EqualError(t, err, "test.echo: async call 1 did not complete: context deadline exceeded")
+}
@@ -0,0 +1,60 @@
+package ftl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/TBD54566975/ftl/go-runtime/ftl/reflection"
+	"github.com/TBD54566975/ftl/internal/rpc"
+)
+
+// Claims of the JWT an ingress request was authenticated with.
+type Claims map[string]any
+
+// Subject returns the "sub" claim, if any.
+func (c Claims) Subject() string {
+	sub, _ := c["sub"].(string) //nolint:errcheck
+	return sub
+}
+
+// Caller describes the origin of the current call to a Verb.
+type Caller struct {
+	// Verb that called the current Verb, if it was called by another Verb.
+	Verb Option[reflection.Ref]
+	// Principal is the authenticated principal of the ingress request that
+	// started the call chain, if any.
+	//
+	// Principals are authenticated by the controller when it is configured
+	// with a JWT secret, and are propagated to all calls made while handling
+	// the request.
+	Principal Option[Claims]
+	// RequestKey identifies the request that started the call chain.
+	RequestKey string
+}
+
+// CallerInfo returns information about the origin of the current call to a
+// Verb, eg. for authorization or auditing.
+func CallerInfo(ctx context.Context) (Caller, error) {
+	caller := Caller{}
+	// The verb chain includes the current Verb.
+	if verbs, ok := rpc.VerbsFromContext(ctx); ok && len(verbs) > 1 {
+		verb := verbs[len(verbs)-2]
+		caller.Verb = Some(reflection.Ref{Module: verb.Module, Name: verb.Name})
+	}
+	if claims, ok := rpc.PrincipalFromContext(ctx).Get(); ok {
+		principal := Claims{}
+		if err := json.Unmarshal(claims, &principal); err != nil {
+			return Caller{}, fmt.Errorf("invalid principal claims: %w", err)
+		}
+		caller.Principal = Some(principal)
+	}
+	key, err := rpc.RequestKeyFromContext(ctx)
+	if err != nil {
+		return Caller{}, err
+	}
+	if key, ok := key.Get(); ok {
+		caller.RequestKey = key.String()
+	}
+	return caller, nil
+}
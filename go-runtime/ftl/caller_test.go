@@ -0,0 +1,37 @@
+package ftl
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/go-runtime/ftl/reflection"
+	"github.com/TBD54566975/ftl/internal/model"
+	"github.com/TBD54566975/ftl/internal/rpc"
+)
+
+func TestCallerInfo(t *testing.T) {
+	caller, err := CallerInfo(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, Caller{}, caller)
+
+	key := model.NewRequestKey(model.OriginIngress, "test")
+	ctx := rpc.WithVerbs(context.Background(), []*schema.Ref{
+		{Module: "gateway", Name: "handle"},
+		{Module: "accounts", Name: "balance"},
+	})
+	ctx = rpc.WithRequestName(ctx, key)
+	ctx = rpc.WithPrincipal(ctx, json.RawMessage(`{"sub":"alice","admin":true}`))
+
+	caller, err = CallerInfo(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, Caller{
+		Verb:       Some(reflection.Ref{Module: "gateway", Name: "handle"}),
+		Principal:  Some(Claims{"sub": "alice", "admin": true}),
+		RequestKey: key.String(),
+	}, caller)
+	assert.Equal(t, "alice", caller.Principal.MustGet().Subject())
+}
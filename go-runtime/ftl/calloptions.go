@@ -0,0 +1,108 @@
+package ftl
+
+import (
+	"context"
+	"time"
+
+	"github.com/jpillora/backoff"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/go-runtime/internal"
+)
+
+// maxCallBackoff caps the backoff between retries of a call.
+const maxCallBackoff = time.Minute
+
+// A CallOption configures an individual call to a Verb.
+type CallOption func(*callOptions)
+
+type callOptions struct {
+	timeout  time.Duration
+	retries  int
+	backoff  time.Duration
+	// WithRetry was given.
+	retrySet bool
+	metadata map[string]string
+	// Skip the cached response of a verb with +cache metadata.
+	bypassCache bool
+}
+
+// WithTimeout fails the call, including any retries, if it does not complete within "timeout".
+func WithTimeout(timeout time.Duration) CallOption {
+	return func(o *callOptions) { o.timeout = timeout }
+}
+
+// WithRetry retries a failed call up to "count" times, with exponential
+// backoff starting at "backoff".
+func WithRetry(count int, backoff time.Duration) CallOption {
+	return func(o *callOptions) {
+		o.retries = count
+		o.backoff = backoff
+		o.retrySet = true
+	}
+}
+
+// WithMetadata sends a key/value pair with the call.
+//
+// The callee can retrieve metadata with [CallMetadata].
+func WithMetadata(key, value string) CallOption {
+	return func(o *callOptions) {
+		if o.metadata == nil {
+			o.metadata = map[string]string{}
+		}
+		o.metadata[key] = value
+	}
+}
+
+// WithoutCache calls the Verb even if it has declared its responses
+// cacheable and a cached response exists, refreshing the cached response.
+func WithoutCache() CallOption {
+	return func(o *callOptions) { o.bypassCache = true }
+}
+
+// CallMetadata returns the metadata sent with [WithMetadata] by the caller of
+// the current Verb.
+func CallMetadata(ctx context.Context) map[string]string {
+	metadata := internal.CallMetadataFromContext(ctx)
+	if metadata == nil {
+		return map[string]string{}
+	}
+	return metadata
+}
+
+func newCallOptions(options []CallOption) callOptions {
+	o := callOptions{}
+	for _, option := range options {
+		option(&o)
+	}
+	return o
+}
+
+// run "call", applying the timeout and retry options.
+func (o callOptions) run(ctx context.Context, call func(ctx context.Context) error) error {
+	if o.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, o.timeout)
+		defer cancel()
+	}
+	retry := backoff.Backoff{Min: o.backoff, Max: max(o.backoff, maxCallBackoff), Factor: 2, Jitter: true}
+	for attempt := 0; ; attempt++ {
+		err := call(ctx)
+		if err == nil || attempt >= o.retries {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(retry.Duration()):
+		}
+	}
+}
+
+func (o callOptions) metadataToProto() *ftlv1.Metadata {
+	metadata := &ftlv1.Metadata{}
+	for key, value := range o.metadata {
+		metadata.Values = append(metadata.Values, &ftlv1.Metadata_Pair{Key: key, Value: value})
+	}
+	return metadata
+}
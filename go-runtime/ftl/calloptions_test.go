@@ -0,0 +1,55 @@
+package ftl
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/go-runtime/internal"
+)
+
+func TestCallOptionsRetry(t *testing.T) {
+	attempts := 0
+	opts := newCallOptions([]CallOption{WithRetry(2, time.Millisecond)})
+	err := opts.run(context.Background(), func(ctx context.Context) error {
+		attempts++
+		if attempts < 3 {
+			return errors.New("failed")
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, attempts)
+
+	attempts = 0
+	err = opts.run(context.Background(), func(ctx context.Context) error {
+		attempts++
+		return errors.New("failed")
+	})
+	assert.EqualError(t, err, "failed")
+	assert.Equal(t, 3, attempts)
+}
+
+func TestCallOptionsTimeout(t *testing.T) {
+	opts := newCallOptions([]CallOption{WithTimeout(10 * time.Millisecond), WithRetry(100, time.Second)})
+	start := time.Now()
+	err := opts.run(context.Background(), func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	assert.IsError(t, err, context.DeadlineExceeded)
+	assert.True(t, time.Since(start) < time.Second, "retries should stop once the timeout expires")
+}
+
+func TestCallMetadata(t *testing.T) {
+	opts := newCallOptions([]CallOption{WithMetadata("a", "1"), WithMetadata("b", "2")})
+	assert.Equal(t, 2, len(opts.metadataToProto().Values))
+
+	ctx := context.Background()
+	assert.Equal(t, map[string]string{}, CallMetadata(ctx))
+	ctx = internal.ContextWithCallMetadata(ctx, opts.metadata)
+	assert.Equal(t, map[string]string{"a": "1", "b": "2"}, CallMetadata(ctx))
+}
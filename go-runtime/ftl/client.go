@@ -0,0 +1,58 @@
+package ftl
+
+import (
+	"context"
+)
+
+// A VerbClient calls a Verb.
+//
+// Typed clients are generated for the exported Verbs of each module, and can be
+// replaced with fakes in tests.
+type VerbClient[Req, Resp any] func(ctx context.Context, req Req, options ...CallOption) (Resp, error)
+
+// NewVerbClient returns a client that calls a Verb through the FTL controller.
+func NewVerbClient[Req, Resp any](verb Verb[Req, Resp]) VerbClient[Req, Resp] {
+	return func(ctx context.Context, req Req, options ...CallOption) (Resp, error) {
+		return Call(ctx, verb, req, options...)
+	}
+}
+
+// A SinkClient calls a Sink.
+type SinkClient[Req any] func(ctx context.Context, req Req, options ...CallOption) error
+
+// NewSinkClient returns a client that calls a Sink through the FTL controller.
+func NewSinkClient[Req any](sink Sink[Req]) SinkClient[Req] {
+	return func(ctx context.Context, req Req, options ...CallOption) error {
+		return CallSink(ctx, sink, req, options...)
+	}
+}
+
+// A SourceClient calls a Source.
+type SourceClient[Resp any] func(ctx context.Context, options ...CallOption) (Resp, error)
+
+// NewSourceClient returns a client that calls a Source through the FTL controller.
+func NewSourceClient[Resp any](source Source[Resp]) SourceClient[Resp] {
+	return func(ctx context.Context, options ...CallOption) (Resp, error) {
+		return CallSource(ctx, source, options...)
+	}
+}
+
+// An EmptyClient calls a Verb with no request or response.
+type EmptyClient func(ctx context.Context, options ...CallOption) error
+
+// NewEmptyClient returns a client that calls a Verb with no request or response through the FTL controller.
+func NewEmptyClient(empty Empty) EmptyClient {
+	return func(ctx context.Context, options ...CallOption) error {
+		return CallEmpty(ctx, empty, options...)
+	}
+}
+
+// A StreamClient calls a StreamVerb, passing each of its outputs to "recv".
+type StreamClient[Req, Resp any] func(ctx context.Context, req Req, recv func(resp Resp) error, options ...CallOption) error
+
+// NewStreamClient returns a client that calls a StreamVerb through the FTL controller.
+func NewStreamClient[Req, Resp any](verb StreamVerb[Req, Resp]) StreamClient[Req, Resp] {
+	return func(ctx context.Context, req Req, recv func(resp Resp) error, options ...CallOption) error {
+		return CallStream(ctx, verb, req, recv, options...)
+	}
+}
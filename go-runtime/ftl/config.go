@@ -40,6 +40,17 @@ func (c ConfigValue[T]) Get(ctx context.Context) (out T) {
 	return
 }
 
+// Watch returns a channel that receives the current value of the
+// configuration key, and each new value when it changes, eg. for feature flags.
+//
+// The channel is closed when the context is cancelled.
+func (c ConfigValue[T]) Watch(ctx context.Context) <-chan T {
+	return watch(ctx, c.String(), func() (out T, err error) {
+		err = internal.FromContext(ctx).GetConfig(ctx, c.Name, &out)
+		return
+	})
+}
+
 func callerModule() string {
 	pc, _, _, ok := runtime.Caller(2)
 	if !ok {
@@ -30,3 +30,39 @@ func TestConfig(t *testing.T) {
 	config := Config[C]("test")
 	assert.Equal(t, C{"one", "two"}, config.Get(ctx))
 }
+
+type manualContextSupplier struct {
+	initial modulecontext.ModuleContext
+	sink    func(ctx context.Context, moduleCtx modulecontext.ModuleContext)
+}
+
+func (m *manualContextSupplier) Subscribe(ctx context.Context, _ string, sink func(ctx context.Context, moduleCtx modulecontext.ModuleContext)) {
+	sink(ctx, m.initial)
+	m.sink = sink
+}
+
+func TestConfigWatch(t *testing.T) {
+	ctx, cancel := context.WithCancel(log.ContextWithNewDefaultLogger(context.Background()))
+	defer cancel()
+
+	withFlag := func(value string) modulecontext.ModuleContext {
+		return modulecontext.NewBuilder("test").AddConfigs(map[string][]byte{"flag": []byte(value)}).Build()
+	}
+	supplier := &manualContextSupplier{initial: withFlag("false")}
+	dynamicCtx, err := modulecontext.NewDynamicContext(ctx, supplier, "test")
+	assert.NoError(t, err)
+	ctx = dynamicCtx.ApplyToContext(ctx)
+	ctx = internal.WithContext(ctx, internal.New(dynamicCtx))
+
+	values := Config[bool]("flag").Watch(ctx)
+	assert.Equal(t, false, <-values)
+
+	// Unrelated changes are not sent.
+	supplier.sink(ctx, withFlag("false"))
+	supplier.sink(ctx, withFlag("true"))
+	assert.Equal(t, true, <-values)
+
+	cancel()
+	for range values {
+	}
+}
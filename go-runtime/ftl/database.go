@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 
+	"github.com/jackc/pgx/v5/pgxpool"
 	_ "github.com/jackc/pgx/v5/stdlib" // Register Postgres driver
 
 	"github.com/TBD54566975/ftl/internal/modulecontext"
@@ -34,3 +35,16 @@ func (d Database) Get(ctx context.Context) *sql.DB {
 	}
 	return db
 }
+
+// Pool returns a managed pgx connection pool for the database.
+//
+// The pool is provisioned with the DSN supplied by FTL, and is replaced if the
+// DSN changes.
+func (d Database) Pool(ctx context.Context) *pgxpool.Pool {
+	provider := modulecontext.FromContext(ctx).CurrentContext()
+	pool, err := provider.GetPool(d.Name, d.DBType)
+	if err != nil {
+		panic(err.Error())
+	}
+	return pool
+}
@@ -0,0 +1,116 @@
+package ftl
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+// Decimal is an exact decimal number, represented in the schema as Decimal.
+//
+// Decimals are encoded as strings to avoid loss of precision, eg. "1234.50".
+// The zero value is 0.
+type Decimal struct {
+	// The value is unscaled * 10^-scale.
+	unscaled *big.Int
+	scale    int
+}
+
+var (
+	_ Marshaler     = Decimal{}
+	_ Unmarshaler   = (*Decimal)(nil)
+	_ driver.Valuer = Decimal{}
+)
+
+// NewDecimal returns the decimal number unscaled * 10^-scale, eg.
+// NewDecimal(12345, 2) is 123.45.
+func NewDecimal(unscaled int64, scale int) Decimal {
+	return Decimal{unscaled: big.NewInt(unscaled), scale: scale}
+}
+
+// ParseDecimal parses a decimal number such as "-1234.50".
+func ParseDecimal(value string) (Decimal, error) {
+	_, scale, err := schema.DecimalDigits(value)
+	if err != nil {
+		return Decimal{}, err
+	}
+	unscaled, ok := new(big.Int).SetString(strings.Replace(value, ".", "", 1), 10)
+	if !ok {
+		return Decimal{}, fmt.Errorf("%q is not a decimal number", value)
+	}
+	return Decimal{unscaled: unscaled, scale: scale}, nil
+}
+
+// MustParseDecimal is like [ParseDecimal] but panics on error.
+func MustParseDecimal(value string) Decimal {
+	d, err := ParseDecimal(value)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// Scale returns the number of digits after the decimal point.
+func (d Decimal) Scale() int { return d.scale }
+
+// Rat returns the value of the decimal as a [big.Rat].
+func (d Decimal) Rat() *big.Rat {
+	if d.unscaled == nil {
+		return new(big.Rat)
+	}
+	denom := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(d.scale)), nil)
+	return new(big.Rat).SetFrac(d.unscaled, denom)
+}
+
+// Cmp compares the values of two decimals, ignoring their scale, returning
+// -1, 0 or +1.
+func (d Decimal) Cmp(other Decimal) int { return d.Rat().Cmp(other.Rat()) }
+
+func (d Decimal) String() string {
+	if d.unscaled == nil {
+		return "0"
+	}
+	digits := new(big.Int).Abs(d.unscaled).String()
+	if d.scale > 0 {
+		if len(digits) <= d.scale {
+			digits = strings.Repeat("0", d.scale-len(digits)+1) + digits
+		}
+		digits = digits[:len(digits)-d.scale] + "." + digits[len(digits)-d.scale:]
+	}
+	if d.unscaled.Sign() < 0 {
+		return "-" + digits
+	}
+	return digits
+}
+
+func (d Decimal) MarshalFTL() (string, error) { return d.String(), nil }
+
+func (d *Decimal) UnmarshalFTL(value string) error {
+	parsed, err := ParseDecimal(value)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// Value implements [driver.Valuer], storing the decimal as a string.
+func (d Decimal) Value() (driver.Value, error) { return d.String(), nil }
+
+// Scan implements [sql.Scanner] for database numeric columns.
+func (d *Decimal) Scan(src any) error {
+	switch src := src.(type) {
+	case string:
+		return d.UnmarshalFTL(src)
+	case []byte:
+		return d.UnmarshalFTL(string(src))
+	case int64:
+		*d = NewDecimal(src, 0)
+		return nil
+	default:
+		return fmt.Errorf("cannot scan %T into Decimal", src)
+	}
+}
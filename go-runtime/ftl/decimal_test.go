@@ -0,0 +1,44 @@
+package ftl
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+)
+
+func TestDecimal(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		scale    int
+		err      string
+	}{
+		{input: "0", expected: "0"},
+		{input: "1234.50", expected: "1234.50", scale: 2},
+		{input: "-0.05", expected: "-0.05", scale: 2},
+		{input: "+12", expected: "12"},
+		{input: "1e5", err: `"1e5" is not a decimal number`},
+		{input: "1.", err: `"1." is not a decimal number`},
+	}
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			d, err := ParseDecimal(test.input)
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, d.String())
+			assert.Equal(t, test.scale, d.Scale())
+		})
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	assert.Equal(t, "123.45", NewDecimal(12345, 2).String())
+	assert.Equal(t, "0", Decimal{}.String())
+	assert.Equal(t, 0, MustParseDecimal("1.50").Cmp(MustParseDecimal("1.5")))
+	assert.Equal(t, -1, MustParseDecimal("-1").Cmp(Decimal{}))
+	assert.Equal(t, big.NewRat(3, 2), MustParseDecimal("1.50").Rat())
+}
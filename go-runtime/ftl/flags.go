@@ -0,0 +1,48 @@
+package ftl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TBD54566975/ftl/go-runtime/ftl/reflection"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/modulecontext"
+)
+
+// Flag declares a feature flag for the current module.
+//
+// Flags are managed with "ftl flags", and changes to them are pushed to
+// running modules.
+func Flag(name string) FeatureFlag {
+	module := callerModule()
+	return FeatureFlag{reflection.Ref{Module: module, Name: name}}
+}
+
+// FeatureFlag is a feature flag of the current module.
+type FeatureFlag struct {
+	reflection.Ref
+}
+
+func (f FeatureFlag) String() string { return fmt.Sprintf("flag \"%s\"", f.Ref) }
+
+func (f FeatureFlag) GoString() string { return fmt.Sprintf("ftl.FeatureFlag(\"%s\")", f.Ref) }
+
+// Enabled returns true if the flag is on for everyone.
+//
+// Flags that are only on for a percentage of keys or for specific keys are
+// off unless evaluated with [FeatureFlag.EnabledFor].
+func (f FeatureFlag) Enabled(ctx context.Context) bool {
+	return f.EnabledFor(ctx, "")
+}
+
+// EnabledFor returns true if the flag is on for "key", eg. a user ID.
+//
+// Flags that don't exist, or whose rules are invalid, are off.
+func (f FeatureFlag) EnabledFor(ctx context.Context, key string) bool {
+	flag, ok, err := modulecontext.FromContext(ctx).CurrentContext().GetFlag(f.Name)
+	if err != nil {
+		log.FromContext(ctx).Warnf("%s is off: %s", f, err)
+		return false
+	}
+	return ok && flag.EnabledFor(f.Name, key)
+}
@@ -0,0 +1,28 @@
+package ftl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/modulecontext"
+	. "github.com/TBD54566975/ftl/testutils/modulecontext"
+)
+
+func TestFlag(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	moduleCtx := modulecontext.NewBuilder("test").AddFlags(map[string][]byte{
+		"on":       []byte(`{"enabled":true}`),
+		"targeted": []byte(`{"enabled":true,"targets":["alice"]}`),
+		"invalid":  []byte(`"on"`),
+	}).Build()
+	ctx = MakeDynamic(ctx, moduleCtx).ApplyToContext(ctx)
+
+	assert.True(t, Flag("on").Enabled(ctx))
+	assert.False(t, Flag("targeted").Enabled(ctx))
+	assert.True(t, Flag("targeted").EnabledFor(ctx, "alice"))
+	assert.False(t, Flag("invalid").Enabled(ctx))
+	assert.False(t, Flag("missing").Enabled(ctx))
+}
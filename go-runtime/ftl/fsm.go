@@ -56,6 +56,11 @@ func FSM(name string, transitions ...FSMTransition) *FSMHandle {
 //
 // If the FSM instance is not executing, a new one will be started. If the event
 // is not valid for the current state, an error will be returned.
+//
+// Within [WithTransaction] the event is only sent once the transaction commits.
 func (f *FSMHandle) Send(ctx context.Context, instance string, event any) error {
+	if outbox, ok := internal.OutboxFromContext(ctx); ok {
+		return outbox.FSMSend(ctx, f.name, instance, event)
+	}
 	return internal.FromContext(ctx).FSMSend(ctx, f.name, instance, event)
 }
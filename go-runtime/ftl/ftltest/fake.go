@@ -6,12 +6,17 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	"github.com/TBD54566975/ftl/backend/schema"
 	"github.com/TBD54566975/ftl/common/configuration"
 	"github.com/TBD54566975/ftl/go-runtime/ftl"
+	"github.com/TBD54566975/ftl/go-runtime/ftl/reflection"
 	"github.com/TBD54566975/ftl/go-runtime/internal"
+	"github.com/TBD54566975/ftl/internal/log"
 	"github.com/alecthomas/types/optional"
+	"github.com/puzpuzpuz/xsync/v3"
 )
 
 // pubSubEvent is a sum type for all events that can be published to the pubsub system.
@@ -25,8 +30,9 @@ type pubSubEvent interface {
 
 // publishEvent holds an event to be published to a topic
 type publishEvent struct {
-	topic   *schema.Ref
-	content any
+	topic       *schema.Ref
+	content     any
+	orderingKey string
 }
 
 func (publishEvent) pubSubEvent() {}
@@ -44,6 +50,8 @@ type subscription struct {
 	topic       *schema.Ref
 	cursor      optional.Option[int]
 	isExecuting bool
+	// ordering key of the executing event, if any
+	orderingKey string
 	errors      map[int]error
 }
 
@@ -57,6 +65,15 @@ type fakeFTL struct {
 	configValues  map[string][]byte
 	secretValues  map[string][]byte
 	pubSub        *fakePubSub
+	// ID of the last async call.
+	asyncCallID atomic.Int64
+	// Results of async calls, by ID.
+	asyncCallResults *xsync.MapOf[int64, asyncCallResult]
+}
+
+type asyncCallResult struct {
+	resp any
+	err  error
 }
 
 // mapImpl is a function that takes an object and returns an object of a potentially different
@@ -65,12 +82,13 @@ type mapImpl func(context.Context) (any, error)
 
 func newFakeFTL(ctx context.Context) *fakeFTL {
 	fake := &fakeFTL{
-		fsm:           newFakeFSMManager(),
-		mockMaps:      map[uintptr]mapImpl{},
-		allowMapCalls: false,
-		configValues:  map[string][]byte{},
-		secretValues:  map[string][]byte{},
-		pubSub:        newFakePubSub(ctx),
+		fsm:              newFakeFSMManager(),
+		mockMaps:         map[uintptr]mapImpl{},
+		allowMapCalls:    false,
+		configValues:     map[string][]byte{},
+		secretValues:     map[string][]byte{},
+		pubSub:           newFakePubSub(ctx),
+		asyncCallResults: xsync.NewMapOf[int64, asyncCallResult](),
 	}
 
 	return fake
@@ -127,11 +145,19 @@ func addMapMock[T, U any](f *fakeFTL, mapper *ftl.MapHandle[T, U], mockMap func(
 	}
 }
 
+// addResourceMock saves a new mock of ftl.Resource to the internal map in fakeFTL.
+func addResourceMock[T any](f *fakeFTL, resource *ftl.ResourceHandle[T], mockResource func(context.Context) (T, error)) {
+	key := makeMapKey(resource)
+	f.mockMaps[key] = func(ctx context.Context) (any, error) {
+		return mockResource(ctx)
+	}
+}
+
 func (f *fakeFTL) startAllowingMapCalls() {
 	f.allowMapCalls = true
 }
 
-func (f *fakeFTL) CallMap(ctx context.Context, mapper any, value any, mapImpl func(context.Context) (any, error)) any {
+func (f *fakeFTL) CallMap(ctx context.Context, mapper any, value any, ttl time.Duration, mapImpl func(context.Context) (any, error)) any {
 	key := makeMapKey(mapper)
 	mockMap, ok := f.mockMaps[key]
 	if ok {
@@ -140,17 +166,20 @@ func (f *fakeFTL) CallMap(ctx context.Context, mapper any, value any, mapImpl fu
 	if f.allowMapCalls {
 		return actuallyCallMap(ctx, mapImpl)
 	}
-	panic("map calls not allowed in tests by default. ftltest.Context should be instantiated with either ftltest.WithMapsAllowed() or a mock for the specific map being called using ftltest.WhenMap(...)")
+	panic("map calls not allowed in tests by default. ftltest.Context should be instantiated with either ftltest.WithMapsAllowed() or a mock for the specific map being called using ftltest.WhenMap(...) or ftltest.WhenResource(...)")
 }
 
+// InvalidateMap is a no-op, as map calls are not cached in tests.
+func (f *fakeFTL) InvalidateMap(ctx context.Context, mapper any) {}
+
 func makeMapKey(mapper any) uintptr {
 	v := reflect.ValueOf(mapper)
 	if v.Kind() != reflect.Pointer {
 		panic("fakeFTL received object that was not a pointer, expected *MapHandle")
 	}
 	underlying := v.Elem().Type().Name()
-	if !strings.HasPrefix(underlying, "MapHandle[") {
-		panic(fmt.Sprintf("fakeFTL received *%s, expected *MapHandle", underlying))
+	if !strings.HasPrefix(underlying, "MapHandle[") && !strings.HasPrefix(underlying, "ResourceHandle[") {
+		panic(fmt.Sprintf("fakeFTL received *%s, expected *MapHandle or *ResourceHandle", underlying))
 	}
 	return v.Pointer()
 }
@@ -163,6 +192,31 @@ func actuallyCallMap(ctx context.Context, impl mapImpl) any {
 	return out
 }
 
-func (f *fakeFTL) PublishEvent(ctx context.Context, topic *schema.Ref, event any) error {
-	return f.pubSub.publishEvent(topic, event)
+func (f *fakeFTL) PublishEvent(ctx context.Context, topic *schema.Ref, event any, orderingKey string) error {
+	return f.pubSub.publishEvent(topic, event, orderingKey)
+}
+
+// CallAsync makes the call immediately, rather than enqueueing it, so that its
+// effects are visible as soon as ftl.CallAsync returns. Retries are not
+// attempted, and a failed call is logged as well as returned when awaited.
+func (f *fakeFTL) CallAsync(ctx context.Context, verb reflection.Ref, request any, retry optional.Option[internal.AsyncCallRetry], call func(context.Context) (any, error)) (int64, error) {
+	id := f.asyncCallID.Add(1)
+	resp, err := call(ctx)
+	if err != nil {
+		log.FromContext(ctx).Warnf("Async call %d to %s failed: %s", id, verb, err)
+	}
+	f.asyncCallResults.Store(id, asyncCallResult{resp: resp, err: err})
+	return id, nil
+}
+
+func (f *fakeFTL) AsyncCallResult(ctx context.Context, id int64, dest any) (bool, error) {
+	result, ok := f.asyncCallResults.Load(id)
+	if !ok {
+		return false, fmt.Errorf("async call %d not found", id)
+	}
+	if result.err != nil {
+		return true, fmt.Errorf("async call %d failed: %w", id, result.err)
+	}
+	reflect.ValueOf(dest).Elem().Set(reflect.ValueOf(result.resp))
+	return true, nil
 }
@@ -2,6 +2,7 @@ package ftltest
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 
@@ -49,6 +50,11 @@ func (f *fakeFSMManager) SendEvent(ctx context.Context, fsm string, instance str
 		return fmt.Errorf("fsm %q instance %q is terminated", fsm, instance)
 	}
 
+	if len(rfsm.Saga) > 0 {
+		fsmInstance.terminated = true
+		return runSaga(ctx, rfsm.Saga, event)
+	}
+
 	// The function to execute.
 	var transition reflection.Transition
 
@@ -85,3 +91,34 @@ func (f *fakeFSMManager) SendEvent(ctx context.Context, fsm string, instance str
 	}
 	return err
 }
+
+// runSaga executes the steps of a saga in order. If a step fails, the
+// compensations of the steps that completed before it are executed in reverse
+// order.
+func runSaga(ctx context.Context, steps []reflection.SagaStep, data any) error {
+	call := func(fn reflect.Value) error {
+		out := fn.Call([]reflect.Value{reflect.ValueOf(ctx), reflect.ValueOf(data)})
+		if out[0].IsNil() {
+			return nil
+		}
+		return out[0].Interface().(error) //nolint:forcetypeassert
+	}
+	for i, step := range steps {
+		err := call(step.Verb)
+		if err == nil {
+			continue
+		}
+		errs := []error{fmt.Errorf("saga step %s failed: %w", reflection.FuncRef(step.Verb.Interface()), err)}
+		for j := i - 1; j >= 0; j-- {
+			if !steps[j].Compensation.IsValid() {
+				continue
+			}
+			if err := call(steps[j].Compensation); err != nil {
+				errs = append(errs, fmt.Errorf("saga compensation %s failed: %w", reflection.FuncRef(steps[j].Compensation.Interface()), err))
+				break
+			}
+		}
+		return errors.Join(errs...)
+	}
+	return nil
+}
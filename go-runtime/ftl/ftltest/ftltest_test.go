@@ -0,0 +1,39 @@
+package ftltest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/backend/controller/sql/sqltest"
+	"github.com/TBD54566975/ftl/go-runtime/ftl"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/modulecontext"
+)
+
+func TestWithDatabaseDSN(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	conn := sqltest.OpenForTesting(ctx, t)
+	_, err := conn.Exec(ctx, "CREATE TABLE ftltest_orders (id INT); INSERT INTO ftltest_orders VALUES (1)")
+	assert.NoError(t, err)
+	t.Cleanup(func() { _, _ = conn.Exec(context.Background(), "DROP TABLE ftltest_orders") }) //nolint:errcheck
+
+	dsn := "postgres://localhost:15432/ftl-test?user=postgres&password=secret&sslmode=disable"
+	state := &OptionsState{databases: map[string]modulecontext.Database{}}
+	assert.NoError(t, WithDatabaseDSN(ftl.PostgresDatabase("orders"), dsn)(ctx, state))
+	assert.Equal(t, dsn, state.databases["orders"].DSN)
+
+	var count int
+	assert.NoError(t, conn.QueryRow(ctx, "SELECT COUNT(*) FROM ftltest_orders").Scan(&count))
+	assert.Equal(t, 0, count, "tables should be cleared")
+}
+
+func TestWithDatabaseDSNInvalid(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	state := &OptionsState{databases: map[string]modulecontext.Database{}}
+	err := WithDatabaseDSN(ftl.PostgresDatabase("orders"), "postgres://localhost:%zz/orders")(ctx, state)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `database "orders"`)
+	assert.Equal(t, map[string]modulecontext.Database{}, state.databases)
+}
@@ -0,0 +1,127 @@
+package ftltest
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/go-runtime/ftl"
+	"github.com/TBD54566975/ftl/go-runtime/ftl/reflection"
+)
+
+// VerbMock is a fake implementation of a verb that records the requests it is called with.
+//
+// To be used when setting up a context for a test:
+//
+//	mock := ftltest.MockVerb(example.Verb, func(ctx context.Context, req example.Req) (example.Resp, error) {
+//		// ...
+//	})
+//	ctx := ftltest.Context(
+//		mock.Option(),
+//		// ... other options
+//	)
+//	// ... call the verb under test
+//	assert.Equal(t, 1, len(mock.CallsMatching(ftltest.Partial(example.Req{ID: 42}))))
+type VerbMock[Req, Resp any] struct {
+	verb ftl.Verb[Req, Resp]
+	fake ftl.Verb[Req, Resp]
+
+	lock  sync.Mutex
+	calls []Req
+}
+
+// MockVerb creates a VerbMock replacing the implementation of "verb" with "fake".
+func MockVerb[Req, Resp any](verb ftl.Verb[Req, Resp], fake ftl.Verb[Req, Resp]) *VerbMock[Req, Resp] {
+	return &VerbMock[Req, Resp]{verb: verb, fake: fake}
+}
+
+// Option installs the mock in a test context.
+func (m *VerbMock[Req, Resp]) Option() Option {
+	return func(ctx context.Context, state *OptionsState) error {
+		ref := reflection.FuncRef(m.verb)
+		state.mockVerbs[schema.RefKey(ref)] = func(ctx context.Context, req any) (resp any, err error) {
+			request, ok := req.(Req)
+			if !ok {
+				return nil, fmt.Errorf("invalid request type %T for %v, expected %v", req, ref, reflect.TypeFor[Req]())
+			}
+			return m.call(ctx, request)
+		}
+		return nil
+	}
+}
+
+func (m *VerbMock[Req, Resp]) call(ctx context.Context, req Req) (Resp, error) {
+	m.lock.Lock()
+	m.calls = append(m.calls, req)
+	m.lock.Unlock()
+	return m.fake(ctx, req)
+}
+
+// Calls returns the requests the mock has been called with, in order.
+func (m *VerbMock[Req, Resp]) Calls() []Req {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return slices.Clone(m.calls)
+}
+
+// CallsMatching returns the requests the mock has been called with that match "matcher", in order.
+func (m *VerbMock[Req, Resp]) CallsMatching(matcher Matcher[Req]) []Req {
+	out := []Req{}
+	for _, call := range m.Calls() {
+		if matcher(call) {
+			out = append(out, call)
+		}
+	}
+	return out
+}
+
+// Matcher reports whether a request matches an expectation.
+type Matcher[T any] func(T) bool
+
+// Equal matches requests equal to "expected".
+func Equal[T any](expected T) Matcher[T] {
+	return func(actual T) bool {
+		return reflect.DeepEqual(expected, actual)
+	}
+}
+
+// Partial matches requests whose fields are equal to the non-zero fields of
+// "expected", recursively. Zero fields of "expected" match any value.
+func Partial[T any](expected T) Matcher[T] {
+	return func(actual T) bool {
+		return partialMatch(reflect.ValueOf(expected), reflect.ValueOf(actual))
+	}
+}
+
+func partialMatch(expected, actual reflect.Value) bool {
+	if !expected.IsValid() || expected.IsZero() {
+		return true
+	}
+	if !actual.IsValid() || expected.Type() != actual.Type() {
+		return false
+	}
+	switch expected.Kind() {
+	case reflect.Struct:
+		for i := range expected.NumField() {
+			if !partialMatch(expected.Field(i), actual.Field(i)) {
+				return false
+			}
+		}
+		return true
+
+	case reflect.Pointer, reflect.Interface:
+		if actual.IsNil() {
+			return false
+		}
+		return partialMatch(expected.Elem(), actual.Elem())
+
+	default:
+		if !expected.CanInterface() {
+			return expected.Comparable() && expected.Equal(actual)
+		}
+		return reflect.DeepEqual(expected.Interface(), actual.Interface())
+	}
+}
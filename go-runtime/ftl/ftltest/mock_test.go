@@ -0,0 +1,52 @@
+package ftltest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+)
+
+type mockAddress struct {
+	City    string
+	Country string
+}
+
+type mockRequest struct {
+	Name    string
+	Age     int
+	Address *mockAddress
+	Tags    []string
+}
+
+func TestVerbMockRecordsCalls(t *testing.T) {
+	ctx := context.Background()
+	mock := MockVerb(func(ctx context.Context, req mockRequest) (string, error) { return "", nil },
+		func(ctx context.Context, req mockRequest) (string, error) {
+			return "hello " + req.Name, nil
+		})
+
+	resp, err := mock.call(ctx, mockRequest{Name: "alice", Age: 30})
+	assert.NoError(t, err)
+	assert.Equal(t, "hello alice", resp)
+	_, err = mock.call(ctx, mockRequest{Name: "bob", Age: 30, Address: &mockAddress{City: "Sydney", Country: "AU"}})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []mockRequest{
+		{Name: "alice", Age: 30},
+		{Name: "bob", Age: 30, Address: &mockAddress{City: "Sydney", Country: "AU"}},
+	}, mock.Calls())
+	assert.Equal(t, 2, len(mock.CallsMatching(Partial(mockRequest{Age: 30}))))
+	assert.Equal(t, 1, len(mock.CallsMatching(Partial(mockRequest{Address: &mockAddress{City: "Sydney"}}))))
+	assert.Equal(t, 0, len(mock.CallsMatching(Partial(mockRequest{Name: "carol"}))))
+	assert.Equal(t, 1, len(mock.CallsMatching(Equal(mockRequest{Name: "alice", Age: 30}))))
+}
+
+func TestPartial(t *testing.T) {
+	actual := mockRequest{Name: "alice", Tags: []string{"a", "b"}}
+	assert.True(t, Partial(mockRequest{})(actual))
+	assert.True(t, Partial(mockRequest{Tags: []string{"a", "b"}})(actual))
+	assert.False(t, Partial(mockRequest{Tags: []string{"a"}})(actual))
+	assert.False(t, Partial(mockRequest{Address: &mockAddress{}})(actual))
+	assert.False(t, Partial(mockRequest{Address: &mockAddress{City: "Sydney"}})(actual))
+}
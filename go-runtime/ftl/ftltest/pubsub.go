@@ -22,9 +22,13 @@ type fakePubSub struct {
 	// publishWaitGroup can be used to wait for all events to be published
 	publishWaitGroup sync.WaitGroup
 
-	// pubSubLock required to access [topics, subscriptions, subscribers]
-	pubSubLock    sync.Mutex
-	topics        map[schema.RefKey][]any
+	// pubSubLock required to access [topics, orderingKeys, executingKeys, subscriptions, subscribers]
+	pubSubLock sync.Mutex
+	topics     map[schema.RefKey][]any
+	// ordering keys of the events in topics
+	orderingKeys map[schema.RefKey][]string
+	// ordering keys of the events being consumed
+	executingKeys map[string]bool
 	subscriptions map[string]*subscription
 	subscribers   map[string][]subscriber
 }
@@ -33,6 +37,8 @@ func newFakePubSub(ctx context.Context) *fakePubSub {
 	f := &fakePubSub{
 		globalTopic:   pubsub.New[pubSubEvent](),
 		topics:        map[schema.RefKey][]any{},
+		orderingKeys:  map[schema.RefKey][]string{},
+		executingKeys: map[string]bool{},
 		subscriptions: map[string]*subscription{},
 		subscribers:   map[string][]subscriber{},
 	}
@@ -40,9 +46,9 @@ func newFakePubSub(ctx context.Context) *fakePubSub {
 	return f
 }
 
-func (f *fakePubSub) publishEvent(topic *schema.Ref, event any) error {
+func (f *fakePubSub) publishEvent(topic *schema.Ref, event any, orderingKey string) error {
 	f.publishWaitGroup.Add(1)
-	return f.globalTopic.PublishSync(publishEvent{topic: topic, content: event})
+	return f.globalTopic.PublishSync(publishEvent{topic: topic, content: event, orderingKey: orderingKey})
 }
 
 // addSubscriber adds a subscriber to the fake FTL instance. Each subscriber included in the test must be manually added
@@ -159,6 +165,7 @@ func (f *fakePubSub) handlePubSubEvent(ctx context.Context, e pubSubEvent) {
 		} else {
 			f.topics[event.topic.ToRefKey()] = append(f.topics[event.topic.ToRefKey()], event.content)
 		}
+		f.orderingKeys[event.topic.ToRefKey()] = append(f.orderingKeys[event.topic.ToRefKey()], event.orderingKey)
 		f.publishWaitGroup.Done()
 	case subscriptionDidConsumeEvent:
 		sub, ok := f.subscriptions[event.subscription]
@@ -169,6 +176,8 @@ func (f *fakePubSub) handlePubSubEvent(ctx context.Context, e pubSubEvent) {
 			sub.errors[sub.cursor.MustGet()] = event.err
 		}
 		sub.isExecuting = false
+		delete(f.executingKeys, sub.orderingKey)
+		sub.orderingKey = ""
 	}
 
 	for _, sub := range f.subscriptions {
@@ -191,10 +200,19 @@ func (f *fakePubSub) handlePubSubEvent(ctx context.Context, e pubSubEvent) {
 			// no subscribers
 			continue
 		}
+		orderingKey := f.orderingKeys[sub.topic.ToRefKey()][cursor+1]
+		if orderingKey != "" && f.executingKeys[orderingKey] {
+			// another subscription is consuming an event with the same ordering key
+			continue
+		}
 		chosenSubscriber := subscribers[rand.Intn(len(subscribers))] //nolint:gosec
 
 		sub.cursor = optional.Some(cursor + 1)
 		sub.isExecuting = true
+		if orderingKey != "" {
+			sub.orderingKey = orderingKey
+			f.executingKeys[orderingKey] = true
+		}
 
 		go func(sub string, chosenSubscriber subscriber, event any) {
 			err := chosenSubscriber(ctx, event)
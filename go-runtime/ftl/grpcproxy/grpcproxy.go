@@ -0,0 +1,252 @@
+// Package grpcproxy calls external gRPC services from the modules generated by
+// "ftl import grpc".
+//
+// Requests and responses are converted between the FTL encoding of the
+// module's types and protobuf, using the descriptors of the service, so that
+// no generated protobuf code is required.
+package grpcproxy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/TBD54566975/ftl/backend/schema/strcase"
+	"github.com/TBD54566975/ftl/go-runtime/encoding"
+	"github.com/TBD54566975/ftl/go-runtime/ftl"
+	"github.com/TBD54566975/ftl/internal/rpc"
+)
+
+// Service is an external gRPC service.
+type Service struct {
+	descriptor protoreflect.ServiceDescriptor
+	types      *dynamicpb.Types
+	endpoint   ftl.ConfigValue[string]
+}
+
+// New returns the gRPC service "name" defined by "descriptors", a serialised
+// FileDescriptorSet, served at the URL in configuration "endpoint".
+//
+// It panics if the descriptors are invalid or don't define the service, as
+// they are generated along with the module.
+func New(descriptors []byte, name string, endpoint ftl.ConfigValue[string]) *Service {
+	set := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(descriptors, set); err != nil {
+		panic(fmt.Errorf("invalid descriptors for %s: %w", name, err))
+	}
+	files, err := protodesc.NewFiles(set)
+	if err != nil {
+		panic(fmt.Errorf("invalid descriptors for %s: %w", name, err))
+	}
+	desc, err := files.FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		panic(fmt.Errorf("invalid descriptors for %s: %w", name, err))
+	}
+	service, ok := desc.(protoreflect.ServiceDescriptor)
+	if !ok {
+		panic(fmt.Errorf("%s is not a service", name))
+	}
+	return &Service{descriptor: service, types: dynamicpb.NewTypes(files), endpoint: endpoint}
+}
+
+// Call calls unary method "method" of the service.
+func Call[Req, Resp any](ctx context.Context, s *Service, method string, req Req) (resp Resp, err error) {
+	md := s.descriptor.Methods().ByName(protoreflect.Name(method))
+	if md == nil {
+		return resp, fmt.Errorf("%s has no method %s", s.descriptor.FullName(), method)
+	}
+	procedure := fmt.Sprintf("/%s/%s", s.descriptor.FullName(), method)
+
+	in, err := s.fromFTL(md.Input(), req)
+	if err != nil {
+		return resp, fmt.Errorf("%s: invalid request: %w", procedure, err)
+	}
+	endpoint := strings.TrimSuffix(s.endpoint.Get(ctx), "/")
+	client := connect.NewClient[dynamicpb.Message, dynamicpb.Message](
+		rpc.GetHTTPClient(endpoint), endpoint+procedure,
+		connect.WithGRPC(),
+		connect.WithResponseInitializer(func(_ connect.Spec, msg any) error {
+			*msg.(*dynamicpb.Message) = *dynamicpb.NewMessage(md.Output()) //nolint:forcetypeassert
+			return nil
+		}),
+	)
+	out, err := client.CallUnary(ctx, connect.NewRequest(in))
+	if err != nil {
+		return resp, fmt.Errorf("%s: %w", procedure, err)
+	}
+	if err := s.toFTL(out.Msg, &resp); err != nil {
+		return resp, fmt.Errorf("%s: invalid response: %w", procedure, err)
+	}
+	return resp, nil
+}
+
+// FieldName returns the name of the FTL field imported for proto field "fd".
+func FieldName(fd protoreflect.FieldDescriptor) string {
+	return strcase.ToLowerCamel(fd.JSONName())
+}
+
+// fromFTL converts "v" from its FTL encoding to a message of type "md".
+func (s *Service) fromFTL(md protoreflect.MessageDescriptor, v any) (*dynamicpb.Message, error) {
+	data, err := encoding.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	data, err = json.Marshal(protoJSONNames(md, value))
+	if err != nil {
+		return nil, err
+	}
+	msg := dynamicpb.NewMessage(md)
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true, Resolver: s.types}).Unmarshal(data, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// protoJSONNames renames the fields of "value", decoded from the JSON
+// encoding of the FTL type of messages of type "md", to the JSON names of
+// their proto fields.
+func protoJSONNames(md protoreflect.MessageDescriptor, value any) any {
+	object, ok := value.(map[string]any)
+	if !ok || wellKnownType(md) {
+		return value
+	}
+	fields := md.Fields()
+	for i := range fields.Len() {
+		fd := fields.Get(i)
+		name := FieldName(fd)
+		v, ok := object[name]
+		if !ok {
+			continue
+		}
+		delete(object, name)
+		switch {
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			if entries, ok := v.(map[string]any); ok {
+				for key, entry := range entries {
+					entries[key] = protoJSONNames(fd.MapValue().Message(), entry)
+				}
+			}
+		case fd.IsList() && fd.Message() != nil:
+			if elements, ok := v.([]any); ok {
+				for j, element := range elements {
+					elements[j] = protoJSONNames(fd.Message(), element)
+				}
+			}
+		case fd.Message() != nil:
+			v = protoJSONNames(fd.Message(), v)
+		}
+		object[fd.JSONName()] = v
+	}
+	return object
+}
+
+// toFTL converts "msg" to the FTL type "out" points to.
+func (s *Service) toFTL(msg protoreflect.ProtoMessage, out any) error {
+	value, err := s.ftlValue(msg.ProtoReflect())
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return encoding.Unmarshal(data, out)
+}
+
+func (s *Service) ftlValue(msg protoreflect.Message) (any, error) {
+	md := msg.Descriptor()
+	if isWrapper(md) {
+		fd := md.Fields().ByName("value")
+		return s.ftlFieldValue(fd, msg.Get(fd))
+	}
+	if wellKnownType(md) {
+		data, err := (protojson.MarshalOptions{Resolver: s.types}).Marshal(msg.Interface())
+		if err != nil {
+			return nil, err
+		}
+		var value any
+		return value, json.Unmarshal(data, &value)
+	}
+	out := map[string]any{}
+	fields := md.Fields()
+	for i := range fields.Len() {
+		fd := fields.Get(i)
+		if fd.HasPresence() && !msg.Has(fd) {
+			continue
+		}
+		value := msg.Get(fd)
+		var err error
+		switch {
+		case fd.IsMap():
+			entries := map[string]any{}
+			value.Map().Range(func(key protoreflect.MapKey, entry protoreflect.Value) bool {
+				entries[key.String()], err = s.ftlFieldValue(fd.MapValue(), entry)
+				return err == nil
+			})
+			out[FieldName(fd)] = entries
+		case fd.IsList():
+			list := value.List()
+			elements := make([]any, list.Len())
+			for j := range list.Len() {
+				if elements[j], err = s.ftlFieldValue(fd, list.Get(j)); err != nil {
+					break
+				}
+			}
+			out[FieldName(fd)] = elements
+		default:
+			out[FieldName(fd)], err = s.ftlFieldValue(fd, value)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", fd.Name(), err)
+		}
+	}
+	return out, nil
+}
+
+// ftlFieldValue converts a single value of field "fd", ie. an element of a
+// list or a value of a map.
+func (s *Service) ftlFieldValue(fd protoreflect.FieldDescriptor, value protoreflect.Value) (any, error) {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return s.ftlValue(value.Message())
+	case protoreflect.EnumKind:
+		return int64(value.Enum()), nil
+	default:
+		// Integers are numbers, rather than the strings 64-bit integers are
+		// encoded as in protobuf JSON.
+		return value.Interface(), nil
+	}
+}
+
+// wellKnownType returns true if messages of type "md" are encoded as their
+// protobuf JSON representation, eg. Timestamp as an RFC 3339 string.
+func wellKnownType(md protoreflect.MessageDescriptor) bool {
+	return md.ParentFile().Package() == "google.protobuf" && md.FullName() != "google.protobuf.Empty"
+}
+
+// isWrapper returns true if "md" is a wrapper type, eg. StringValue, which are
+// imported as optional values.
+func isWrapper(md protoreflect.MessageDescriptor) bool {
+	switch md.FullName() {
+	case "google.protobuf.DoubleValue", "google.protobuf.FloatValue",
+		"google.protobuf.Int64Value", "google.protobuf.UInt64Value",
+		"google.protobuf.Int32Value", "google.protobuf.UInt32Value",
+		"google.protobuf.BoolValue", "google.protobuf.StringValue", "google.protobuf.BytesValue":
+		return true
+	default:
+		return false
+	}
+}
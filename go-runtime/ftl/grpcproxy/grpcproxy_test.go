@@ -0,0 +1,101 @@
+package grpcproxy
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/assert/v2"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/go-runtime/ftl"
+	"github.com/TBD54566975/ftl/go-runtime/internal"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/modulecontext"
+	. "github.com/TBD54566975/ftl/testutils/modulecontext"
+)
+
+type controller struct {
+	ftlv1connect.UnimplementedControllerServiceHandler
+}
+
+func (controller) GetArtefactDiffs(ctx context.Context, req *connect.Request[ftlv1.GetArtefactDiffsRequest]) (*connect.Response[ftlv1.GetArtefactDiffsResponse], error) {
+	return connect.NewResponse(&ftlv1.GetArtefactDiffsResponse{
+		MissingDigests:  req.Msg.ClientDigests[1:],
+		ClientArtefacts: []*ftlv1.DeploymentArtefact{{Digest: req.Msg.ClientDigests[0], Path: "main", Executable: true}},
+	}), nil
+}
+
+type artefact struct {
+	Digest     string
+	Path       string
+	Executable bool
+}
+
+type diffsRequest struct {
+	ClientDigests []string
+}
+
+type diffsResponse struct {
+	MissingDigests      []string
+	ClientArtefacts     []artefact
+	MissingChunkDigests []string
+	AcceptsChunks       bool
+}
+
+func TestCall(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.Handle(ftlv1connect.NewControllerServiceHandler(controller{}))
+	server := httptest.NewServer(h2c.NewHandler(mux, &http2.Server{}))
+	t.Cleanup(server.Close)
+
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	endpoint, err := json.Marshal(server.URL)
+	assert.NoError(t, err)
+	moduleCtx := modulecontext.NewBuilder("test").AddConfigs(map[string][]byte{"endpoint": endpoint}).Build()
+	ctx = internal.WithContext(ctx, internal.New(MakeDynamic(ctx, moduleCtx)))
+
+	service := New(descriptors(t, ftlv1.File_xyz_block_ftl_v1_ftl_proto), "xyz.block.ftl.v1.ControllerService", ftl.Config[string]("endpoint"))
+	resp, err := Call[diffsRequest, diffsResponse](ctx, service, "GetArtefactDiffs", diffsRequest{ClientDigests: []string{"a", "b"}})
+	assert.NoError(t, err)
+	assert.Equal(t, diffsResponse{
+		MissingDigests:  []string{"b"},
+		ClientArtefacts: []artefact{{Digest: "a", Path: "main", Executable: true}},
+	}, resp)
+
+	_, err = Call[diffsRequest, diffsResponse](ctx, service, "Ping", diffsRequest{})
+	assert.EqualError(t, err, "/xyz.block.ftl.v1.ControllerService/Ping: unimplemented: xyz.block.ftl.v1.ControllerService.Ping is not implemented")
+}
+
+// descriptors returns the serialised FileDescriptorSet of "file" and its
+// dependencies.
+func descriptors(t *testing.T, file protoreflect.FileDescriptor) []byte {
+	t.Helper()
+	set := &descriptorpb.FileDescriptorSet{}
+	seen := map[string]bool{}
+	var add func(file protoreflect.FileDescriptor)
+	add = func(file protoreflect.FileDescriptor) {
+		if seen[file.Path()] {
+			return
+		}
+		seen[file.Path()] = true
+		for i := range file.Imports().Len() {
+			add(file.Imports().Get(i).FileDescriptor)
+		}
+		set.File = append(set.File, protodesc.ToFileDescriptorProto(file))
+	}
+	add(file)
+	data, err := proto.Marshal(set)
+	assert.NoError(t, err)
+	return data
+}
@@ -0,0 +1,70 @@
+package ftl
+
+import (
+	"net/http"
+	"strings"
+)
+
+// Helpers for the headers of builtin.HttpRequest and builtin.HttpResponse,
+// used by HTTP ingress verbs.
+//
+// Helpers that modify headers return the modified headers, allocating them if
+// they are nil, eg.
+//
+//	resp.Headers = ftl.SetCacheControl(resp.Headers, "no-store")
+
+// Cookies returns the cookies sent with an HTTP ingress request.
+func Cookies(headers map[string][]string) []*http.Cookie {
+	r := http.Request{Header: canonicalHeaders(headers)}
+	return r.Cookies()
+}
+
+// Cookie returns the named cookie sent with an HTTP ingress request, if any.
+func Cookie(headers map[string][]string, name string) Option[*http.Cookie] {
+	r := http.Request{Header: canonicalHeaders(headers)}
+	cookie, err := r.Cookie(name)
+	if err != nil {
+		return None[*http.Cookie]()
+	}
+	return Some(cookie)
+}
+
+// SetCookie adds a Set-Cookie header to an HTTP ingress response.
+func SetCookie(headers map[string][]string, cookie *http.Cookie) map[string][]string {
+	out := canonicalHeaders(headers)
+	out.Add("Set-Cookie", cookie.String())
+	return out
+}
+
+// SetCacheControl sets the Cache-Control header of an HTTP ingress response
+// to the given directives, eg. "public", "max-age=3600".
+func SetCacheControl(headers map[string][]string, directives ...string) map[string][]string {
+	out := canonicalHeaders(headers)
+	out.Set("Cache-Control", strings.Join(directives, ", "))
+	return out
+}
+
+// Redirect sets the Location header of an HTTP ingress response, returning
+// the headers and "status", which should be a 3xx status code.
+//
+//	resp.Headers, resp.Status = ftl.Redirect(resp.Headers, "/login", http.StatusFound)
+func Redirect(headers map[string][]string, location string, status int) (map[string][]string, int) {
+	out := canonicalHeaders(headers)
+	out.Set("Location", location)
+	return out, status
+}
+
+// canonicalHeaders returns "headers" as an [http.Header] with canonical keys,
+// allocating it if it is nil.
+func canonicalHeaders(headers map[string][]string) http.Header {
+	if headers == nil {
+		return http.Header{}
+	}
+	for key, values := range headers {
+		if canonical := http.CanonicalHeaderKey(key); canonical != key {
+			delete(headers, key)
+			headers[canonical] = append(headers[canonical], values...)
+		}
+	}
+	return headers
+}
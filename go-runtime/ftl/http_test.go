@@ -0,0 +1,26 @@
+package ftl
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+)
+
+func TestHTTPHelpers(t *testing.T) {
+	request := map[string][]string{"cookie": {"session=abc123; theme=dark"}}
+	assert.Equal(t, 2, len(Cookies(request)))
+	assert.Equal(t, "abc123", Cookie(request, "session").MustGet().Value)
+	assert.False(t, Cookie(request, "missing").Ok())
+
+	var headers map[string][]string
+	headers = SetCookie(headers, &http.Cookie{Name: "session", Value: "def456", HttpOnly: true})
+	headers = SetCacheControl(headers, "private", "max-age=60")
+	headers, status := Redirect(headers, "/home", http.StatusFound)
+	assert.Equal(t, http.StatusFound, status)
+	assert.Equal(t, map[string][]string{
+		"Set-Cookie":    {"session=def456; HttpOnly"},
+		"Cache-Control": {"private, max-age=60"},
+		"Location":      {"/home"},
+	}, headers)
+}
@@ -0,0 +1,67 @@
+package ftl
+
+import (
+	"context"
+	"maps"
+	"sync"
+
+	"github.com/TBD54566975/ftl/go-runtime/ftl/reflection"
+)
+
+// CallInfo describes a call to a Verb passing through an Interceptor.
+type CallInfo struct {
+	// Verb being called.
+	Verb reflection.Ref
+	// Outgoing is true for calls made by this module, and false for calls to
+	// this module's Verbs.
+	Outgoing bool
+	// Metadata sent with the call.
+	//
+	// Interceptors may modify the metadata, eg. to inject an auth token into
+	// outgoing calls.
+	Metadata map[string]string
+}
+
+// A CallFunc performs a call to a Verb.
+//
+// The response of a StreamVerb is always nil.
+type CallFunc func(ctx context.Context, call *CallInfo, req any) (resp any, err error)
+
+// An Interceptor wraps calls to Verbs, eg. for logging, metrics or auth.
+type Interceptor func(next CallFunc) CallFunc
+
+var interceptors struct {
+	lock  sync.RWMutex
+	chain []Interceptor
+}
+
+// RegisterInterceptors adds interceptors applied to both outgoing calls from
+// and incoming calls to this module's Verbs.
+//
+// Interceptors are applied in the order they are registered, with the first
+// registered Interceptor being the outermost. This function is intended to be
+// called from an init() function in the module.
+func RegisterInterceptors(interceptor ...Interceptor) {
+	interceptors.lock.Lock()
+	defer interceptors.lock.Unlock()
+	interceptors.chain = append(interceptors.chain, interceptor...)
+}
+
+// InterceptCall applies the registered interceptors to a call to a Verb, then
+// performs the call with "dispatch".
+//
+// This function is intended to be used by the FTL runtime.
+func InterceptCall(ctx context.Context, call *CallInfo, req any, dispatch CallFunc) (any, error) {
+	interceptors.lock.RLock()
+	chain := interceptors.chain
+	interceptors.lock.RUnlock()
+	if call.Metadata == nil {
+		call.Metadata = map[string]string{}
+	} else {
+		call.Metadata = maps.Clone(call.Metadata)
+	}
+	for i := len(chain) - 1; i >= 0; i-- {
+		dispatch = chain[i](dispatch)
+	}
+	return dispatch(ctx, call, req)
+}
@@ -0,0 +1,38 @@
+package ftl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/go-runtime/ftl/reflection"
+)
+
+func TestInterceptCall(t *testing.T) {
+	t.Cleanup(func() { interceptors.chain = nil })
+
+	order := []string{}
+	record := func(name string) Interceptor {
+		return func(next CallFunc) CallFunc {
+			return func(ctx context.Context, call *CallInfo, req any) (any, error) {
+				order = append(order, name)
+				call.Metadata[name] = "true"
+				return next(ctx, call, req)
+			}
+		}
+	}
+	RegisterInterceptors(record("first"), record("second"))
+
+	metadata := map[string]string{"caller": "test"}
+	call := &CallInfo{Verb: reflection.Ref{Module: "test", Name: "echo"}, Outgoing: true, Metadata: metadata}
+	resp, err := InterceptCall(context.Background(), call, "hello", func(ctx context.Context, call *CallInfo, req any) (any, error) {
+		order = append(order, "dispatch")
+		assert.Equal(t, map[string]string{"caller": "test", "first": "true", "second": "true"}, call.Metadata)
+		return req.(string) + " world", nil //nolint:forcetypeassert
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "hello world", resp)
+	assert.Equal(t, []string{"first", "second", "dispatch"}, order)
+	assert.Equal(t, map[string]string{"caller": "test"}, metadata, "caller's metadata should not be modified")
+}
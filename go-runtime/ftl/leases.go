@@ -24,18 +24,37 @@ import (
 // already held.
 var ErrLeaseHeld = fmt.Errorf("lease already held")
 
+// errLeaseReleased is the cause of the cancellation of a lease's context when
+// the lease is released.
+var errLeaseReleased = errors.New("lease released")
+
 type LeaseHandle struct {
 	client modulecontext.LeaseClient
 	key    []string
-	errMu  *sync.Mutex
-	err    error
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+	// Shared by copies of the handle.
+	state *leaseState
+}
+
+type leaseState struct {
+	lock sync.Mutex
+	err  error
 }
 
 // Err returns an error if the lease heartbeat fails.
 func (l LeaseHandle) Err() error {
-	l.errMu.Lock()
-	defer l.errMu.Unlock()
-	return l.err
+	l.state.lock.Lock()
+	defer l.state.lock.Unlock()
+	return l.state.err
+}
+
+// Context returns a context that is cancelled when the lease is lost or
+// released, eg. to stop singleton work guarded by the lease.
+//
+// If the lease was lost, [context.Cause] returns the heartbeat error.
+func (l LeaseHandle) Context() context.Context {
+	return l.ctx
 }
 
 // Release attempts to release the lease.
@@ -43,13 +62,14 @@ func (l LeaseHandle) Err() error {
 // Will return an error if the heartbeat failed. In this situation there are no
 // guarantees that the lease was held to completion.
 func (l LeaseHandle) Release() error {
-	l.errMu.Lock()
-	defer l.errMu.Unlock()
+	l.cancel(errLeaseReleased)
+	l.state.lock.Lock()
+	defer l.state.lock.Unlock()
 	err := l.client.Release(context.Background(), l.key)
 	if err != nil {
 		return err
 	}
-	return l.err
+	return l.state.err
 }
 
 // Lease acquires a new exclusive [lease] on a resource uniquely identified by [key].
@@ -59,14 +79,18 @@ func (l LeaseHandle) Release() error {
 //
 // Each [key] is scoped to the module that acquires the lease.
 //
+// The lease is heartbeated until it is released, it is lost, or [ctx] is
+// cancelled. [LeaseHandle.Context] is cancelled when any of these happen.
+//
 // Returns [ErrLeaseHeld] if the lease is already held.
 //
 // [lease]: https://hackmd.io/@ftl/Sym_GKEb0
 func Lease(ctx context.Context, ttl time.Duration, key ...string) (LeaseHandle, error) {
-	logger := log.FromContext(ctx).Scope("lease(" + strings.Join(key, "/"))
-	client := newClient(ctx)
+	return acquireLease(ctx, newClient(ctx), reflection.Module(), ttl, key)
+}
 
-	module := reflection.Module()
+func acquireLease(ctx context.Context, client modulecontext.LeaseClient, module string, ttl time.Duration, key []string) (LeaseHandle, error) {
+	logger := log.FromContext(ctx).Scope("lease(" + strings.Join(key, "/"))
 	logger.Tracef("Acquiring lease")
 	err := client.Acquire(ctx, module, key, ttl)
 	if err != nil {
@@ -77,22 +101,31 @@ func Lease(ctx context.Context, ttl time.Duration, key ...string) (LeaseHandle,
 		return LeaseHandle{}, err
 	}
 
-	lease := LeaseHandle{key: key, errMu: &sync.Mutex{}, client: client}
+	leaseCtx, cancel := context.WithCancelCause(ctx)
+	lease := LeaseHandle{key: key, ctx: leaseCtx, cancel: cancel, state: &leaseState{}, client: client}
 	// Heartbeat the lease.
 	go func() {
 		for {
+			select {
+			case <-leaseCtx.Done():
+				return
+			case <-time.After(ttl / 2):
+			}
 			logger.Tracef("Heartbeating lease")
-			err := client.Heartbeat(ctx, module, key, ttl)
+			err := client.Heartbeat(leaseCtx, module, key, ttl)
 			if err == nil {
-				time.Sleep(ttl / 2)
 				continue
 			}
+			if leaseCtx.Err() != nil {
+				return
+			}
 			logger.Warnf("Lease heartbeat terminated: %s", err)
 
 			// Notify the handle.
-			lease.errMu.Lock()
-			lease.err = err
-			lease.errMu.Unlock()
+			lease.state.lock.Lock()
+			lease.state.err = err
+			lease.state.lock.Unlock()
+			cancel(err)
 			return
 		}
 	}()
@@ -141,14 +174,15 @@ func (c *leaseClient) Acquire(ctx context.Context, module string, key []string,
 
 func (c *leaseClient) Heartbeat(_ context.Context, module string, key []string, ttl time.Duration) error {
 	req := &ftlv1.AcquireLeaseRequest{Key: key, Module: module, Ttl: durationpb.New(ttl)}
-	err := c.stream.Send(req)
-	if err == nil {
-		return nil
+	// If the controller closed the stream, Send returns io.EOF and the reason
+	// is returned by Receive.
+	if err := c.stream.Send(req); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("lease heartbeat failed: %w", err)
 	}
-	if errors.Is(err, io.EOF) {
-		return nil
+	if _, err := c.stream.Receive(); err != nil {
+		return fmt.Errorf("lease heartbeat failed: %w", err)
 	}
-	return err
+	return nil
 }
 
 func (c *leaseClient) Release(_ context.Context, _ []string) error {
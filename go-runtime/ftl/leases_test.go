@@ -0,0 +1,66 @@
+package ftl
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+type failingLeaseClient struct {
+	lock       sync.Mutex
+	heartbeats int
+	fail       error
+}
+
+func (f *failingLeaseClient) Acquire(context.Context, string, []string, time.Duration) error {
+	return nil
+}
+
+func (f *failingLeaseClient) Heartbeat(context.Context, string, []string, time.Duration) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.heartbeats++
+	return f.fail
+}
+
+func (f *failingLeaseClient) Release(context.Context, []string) error { return nil }
+
+func TestLeaseContextCancelledOnLoss(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	lost := errors.New("lease lost")
+	client := &failingLeaseClient{fail: lost}
+	lease, err := acquireLease(ctx, client, "test", 20*time.Millisecond, []string{"poller"})
+	assert.NoError(t, err)
+
+	select {
+	case <-lease.Context().Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("lease context was not cancelled")
+	}
+	assert.Equal(t, lost, context.Cause(lease.Context()))
+	assert.Equal(t, lost, lease.Err())
+}
+
+func TestLeaseContextCancelledOnRelease(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	client := &failingLeaseClient{}
+	lease, err := acquireLease(ctx, client, "test", 20*time.Millisecond, []string{"poller"})
+	assert.NoError(t, err)
+	time.Sleep(50 * time.Millisecond)
+	assert.NoError(t, lease.Context().Err())
+
+	assert.NoError(t, lease.Release())
+	assert.Equal(t, errLeaseReleased, context.Cause(lease.Context()))
+	assert.NoError(t, lease.Err())
+
+	client.lock.Lock()
+	heartbeats := client.heartbeats
+	client.lock.Unlock()
+	assert.True(t, heartbeats > 0, "expected the lease to be heartbeated")
+}
@@ -3,19 +3,41 @@ package ftl
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/TBD54566975/ftl/go-runtime/internal"
 )
 
+// CacheOption configures how the value of an [ftl.Map] or [ftl.Resource] is cached.
+type CacheOption func(*cacheOptions)
+
+type cacheOptions struct {
+	ttl time.Duration
+}
+
+func newCacheOptions(options []CacheOption) cacheOptions {
+	out := cacheOptions{}
+	for _, option := range options {
+		option(&out)
+	}
+	return out
+}
+
+// WithTTL recomputes the cached value once it is older than ttl.
+func WithTTL(ttl time.Duration) CacheOption {
+	return func(o *cacheOptions) { o.ttl = ttl }
+}
+
 type MapHandle[T, U any] struct {
-	fn     func(context.Context, T) (U, error)
-	handle Handle[T]
+	fn      func(context.Context, T) (U, error)
+	handle  Handle[T]
+	options cacheOptions
 }
 
 // Get the mapped value.
 func (mh *MapHandle[T, U]) Get(ctx context.Context) U {
 	value := mh.handle.Get(ctx)
-	out := internal.FromContext(ctx).CallMap(ctx, mh, value, func(ctx context.Context) (any, error) {
+	out := internal.FromContext(ctx).CallMap(ctx, mh, value, mh.options.ttl, func(ctx context.Context) (any, error) {
 		return mh.fn(ctx, value)
 	})
 	u, ok := out.(U)
@@ -25,10 +47,19 @@ func (mh *MapHandle[T, U]) Get(ctx context.Context) U {
 	return u
 }
 
+// Invalidate the cached value, so that it is recomputed by the next call to Get.
+func (mh *MapHandle[T, U]) Invalidate(ctx context.Context) {
+	internal.FromContext(ctx).InvalidateMap(ctx, mh)
+}
+
 // Map an FTL resource type to a new type.
-func Map[T, U any](getter Handle[T], fn func(context.Context, T) (U, error)) *MapHandle[T, U] {
+//
+// The mapped value is cached, and recomputed when the value of the underlying
+// resource changes.
+func Map[T, U any](getter Handle[T], fn func(context.Context, T) (U, error), options ...CacheOption) *MapHandle[T, U] {
 	return &MapHandle[T, U]{
-		fn:     fn,
-		handle: getter,
+		fn:      fn,
+		handle:  getter,
+		options: newCacheOptions(options),
 	}
 }
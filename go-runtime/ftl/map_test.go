@@ -6,6 +6,7 @@ import (
 	"github.com/TBD54566975/ftl/internal/log"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/alecthomas/assert/v2"
 
@@ -39,3 +40,32 @@ func TestMapGet(t *testing.T) {
 	})
 	assert.Equal(t, once.Get(ctx), "1")
 }
+
+func TestMapCaching(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	ctx = internal.WithContext(context.Background(), internal.New(MakeDynamic(ctx, modulecontext.Empty("test"))))
+	calls := 0
+	mapped := Map(intHandle(1), func(ctx context.Context, n int) (int, error) {
+		calls++
+		return calls, nil
+	})
+	assert.Equal(t, 1, mapped.Get(ctx))
+	assert.Equal(t, 1, mapped.Get(ctx))
+
+	mapped.Invalidate(ctx)
+	assert.Equal(t, 2, mapped.Get(ctx))
+}
+
+func TestMapTTL(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	ctx = internal.WithContext(context.Background(), internal.New(MakeDynamic(ctx, modulecontext.Empty("test"))))
+	calls := 0
+	mapped := Map(intHandle(1), func(ctx context.Context, n int) (int, error) {
+		calls++
+		return calls, nil
+	}, WithTTL(time.Millisecond*50))
+	assert.Equal(t, 1, mapped.Get(ctx))
+	assert.Equal(t, 1, mapped.Get(ctx))
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, 2, mapped.Get(ctx))
+}
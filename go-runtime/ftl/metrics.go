@@ -0,0 +1,92 @@
+package ftl
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/TBD54566975/ftl/go-runtime/ftl/reflection"
+	"github.com/TBD54566975/ftl/go-runtime/internal"
+	"github.com/TBD54566975/ftl/internal/metrics"
+	"github.com/TBD54566975/ftl/internal/rpc"
+)
+
+var metricNameRe = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// CounterHandle is a counter declared with [Counter].
+type CounterHandle struct {
+	name   string
+	module string
+}
+
+// Counter declares a counter, eg.
+//
+//	var ordersCreated = ftl.Counter("orders_created")
+//
+// Counters are labelled with the module and the Verb they are incremented
+// from, and are aggregated across all runners by the controller's /metrics
+// endpoint.
+func Counter(name string) CounterHandle {
+	validateMetricName(name)
+	return CounterHandle{name: name, module: reflection.Module()}
+}
+
+// Inc increments the counter by one.
+func (c CounterHandle) Inc(ctx context.Context) {
+	c.Add(ctx, 1)
+}
+
+// Add "delta" to the counter, which must not be negative.
+func (c CounterHandle) Add(ctx context.Context, delta int64) {
+	if delta < 0 {
+		panic(fmt.Sprintf("counter %q can not be decremented", c.name))
+	}
+	internal.Metrics.Add(c.name, metricLabels(ctx, c.module), float64(delta))
+}
+
+// HistogramHandle is a histogram declared with [Histogram].
+type HistogramHandle struct {
+	name    string
+	module  string
+	buckets []float64
+}
+
+// Histogram declares a histogram, eg.
+//
+//	var chargeLatency = ftl.Histogram("charge_latency_ms")
+//
+// "buckets" are the upper bounds of the histogram's buckets, which default to
+// a range suitable for latencies in milliseconds.
+//
+// Histograms are labelled with the module and the Verb they are recorded from,
+// and are aggregated across all runners by the controller's /metrics endpoint.
+func Histogram(name string, buckets ...float64) HistogramHandle {
+	validateMetricName(name)
+	if len(buckets) == 0 {
+		buckets = metrics.DefaultBuckets
+	}
+	if !slices.IsSorted(buckets) {
+		panic(fmt.Sprintf("buckets of histogram %q must be sorted", name))
+	}
+	return HistogramHandle{name: name, module: reflection.Module(), buckets: slices.Clone(buckets)}
+}
+
+// Record an observation in the histogram.
+func (h HistogramHandle) Record(ctx context.Context, value float64) {
+	internal.Metrics.Observe(h.name, metricLabels(ctx, h.module), h.buckets, value)
+}
+
+func validateMetricName(name string) {
+	if !metricNameRe.MatchString(name) {
+		panic(fmt.Sprintf("invalid metric name %q, must match %s", name, metricNameRe))
+	}
+}
+
+func metricLabels(ctx context.Context, module string) map[string]string {
+	labels := map[string]string{"module": module}
+	if verb, ok := rpc.VerbFromContext(ctx); ok {
+		labels["verb"] = verb.Name
+	}
+	return labels
+}
@@ -0,0 +1,35 @@
+package ftl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/go-runtime/internal"
+	"github.com/TBD54566975/ftl/internal/metrics"
+	"github.com/TBD54566975/ftl/internal/rpc"
+)
+
+func TestMetrics(t *testing.T) {
+	before := internal.Metrics
+	internal.Metrics = metrics.NewRegistry()
+	t.Cleanup(func() { internal.Metrics = before })
+
+	ctx := rpc.WithVerbs(context.Background(), []*schema.Ref{{Module: "orders", Name: "create"}})
+	counter := CounterHandle{name: "orders_created", module: "orders"}
+	counter.Inc(ctx)
+	counter.Add(ctx, 2)
+	counter.Inc(context.Background())
+	histogram := HistogramHandle{name: "charge_latency_ms", module: "orders", buckets: []float64{10}}
+	histogram.Record(ctx, 20)
+
+	assert.Equal(t, []metrics.Sample{
+		{Name: "charge_latency_ms", Kind: metrics.KindHistogram, Labels: map[string]string{"module": "orders", "verb": "create"}, Buckets: []float64{10}, Counts: []uint64{0, 1}, Sum: 20, Count: 1},
+		{Name: "orders_created", Kind: metrics.KindCounter, Labels: map[string]string{"module": "orders"}, Value: 1},
+		{Name: "orders_created", Kind: metrics.KindCounter, Labels: map[string]string{"module": "orders", "verb": "create"}, Value: 3},
+	}, internal.Metrics.Snapshot())
+
+	assert.Panics(t, func() { validateMetricName("orders-created") })
+}
@@ -2,13 +2,14 @@
 package ftl
 
 import (
-	"bytes"
 	"database/sql"
 	"database/sql/driver"
 	"encoding"
 	"encoding/json"
 	"fmt"
 	"reflect"
+
+	ftlencoding "github.com/TBD54566975/ftl/go-runtime/encoding"
 )
 
 // Stdlib interfaces types implement.
@@ -198,8 +199,8 @@ func (o Option[T]) GoString() string {
 }
 
 func (o Option[T]) Marshal(
-	w *bytes.Buffer,
-	encode func(v reflect.Value, w *bytes.Buffer) error,
+	w ftlencoding.Writer,
+	encode func(v reflect.Value, w ftlencoding.Writer) error,
 ) error {
 	if o.ok {
 		return encode(reflect.ValueOf(&o.value).Elem(), w)
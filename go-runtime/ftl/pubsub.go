@@ -22,9 +22,32 @@ type TopicHandle[E any] struct {
 	Ref *schema.Ref
 }
 
+// A PublishOption configures the publishing of an event.
+type PublishOption func(*publishOptions)
+
+type publishOptions struct {
+	orderingKey string
+}
+
+// WithOrderingKey ensures that the event is never processed concurrently with
+// other events published with the same key, across all subscriptions.
+//
+// This is typically used for per-entity ordering, eg. keying events by the ID
+// of the customer they relate to.
+func WithOrderingKey(key string) PublishOption {
+	return func(o *publishOptions) { o.orderingKey = key }
+}
+
 // Publish publishes an event to a topic
-func (t TopicHandle[E]) Publish(ctx context.Context, event E) error {
-	return internal.FromContext(ctx).PublishEvent(ctx, t.Ref, event)
+func (t TopicHandle[E]) Publish(ctx context.Context, event E, options ...PublishOption) error {
+	o := publishOptions{}
+	for _, option := range options {
+		option(&o)
+	}
+	if outbox, ok := internal.OutboxFromContext(ctx); ok {
+		return outbox.PublishEvent(ctx, t.Ref, event, o.orderingKey)
+	}
+	return internal.FromContext(ctx).PublishEvent(ctx, t.Ref, event, o.orderingKey)
 }
 
 type SubscriptionHandle[E any] struct {
@@ -48,3 +48,13 @@ func GetDiscriminatorByVariant(variant reflect.Type) optional.Option[reflect.Typ
 func IsSumTypeDiscriminator(discriminator reflect.Type) bool {
 	return singletonTypeRegistry.isSumTypeDiscriminator(discriminator)
 }
+
+// GetErrorRef returns the schema reference for a registered error type.
+func GetErrorRef(errorType reflect.Type) optional.Option[Ref] {
+	return singletonTypeRegistry.getErrorRef(errorType)
+}
+
+// GetErrorType returns the registered error type for a schema reference.
+func GetErrorType(ref Ref) optional.Option[reflect.Type] {
+	return singletonTypeRegistry.getErrorType(ref)
+}
@@ -16,6 +16,8 @@ type TypeRegistry struct {
 	sumTypes                 map[reflect.Type][]sumTypeVariant
 	variantsToDiscriminators map[reflect.Type]reflect.Type
 	fsm                      map[string]ReflectedFSM
+	errorTypes               map[reflect.Type]Ref
+	errorsByRef              map[Ref]reflect.Type
 }
 
 type sumTypeVariant struct {
@@ -44,9 +46,17 @@ type Transition struct {
 	To   reflect.Value
 }
 
+// SagaStep represents a step of a saga, and the function that compensates it, if any.
+type SagaStep struct {
+	Verb         reflect.Value
+	Compensation reflect.Value
+}
+
 type ReflectedFSM struct {
 	Transitions []Transition
 	Schema      *schema.FSM
+	// Steps of the FSM, if it is a saga.
+	Saga []SagaStep
 }
 
 // FSM adds a finite state machine to the type registry.
@@ -54,6 +64,19 @@ func FSM(name string, transitions ...Transition) Registree {
 	return func(t *TypeRegistry) { t.registerFSM(name, transitions) }
 }
 
+// Saga adds a saga, and the finite state machine that executes it, to the type registry.
+func Saga(name string, steps ...SagaStep) Registree {
+	return func(t *TypeRegistry) { t.registerSaga(name, steps) }
+}
+
+// ErrorType adds a data structure that can be returned as an error by Verbs to the type registry.
+func ErrorType[T any]() Registree {
+	return func(t *TypeRegistry) {
+		var v T
+		t.registerErrorType(reflect.TypeOf(v), TypeRefFromValue(v))
+	}
+}
+
 // newTypeRegistry creates a new [TypeRegistry] for instantiating types by their qualified
 // name at runtime.
 func newTypeRegistry(options ...Registree) *TypeRegistry {
@@ -61,6 +84,8 @@ func newTypeRegistry(options ...Registree) *TypeRegistry {
 		sumTypes:                 map[reflect.Type][]sumTypeVariant{},
 		variantsToDiscriminators: map[reflect.Type]reflect.Type{},
 		fsm:                      map[string]ReflectedFSM{},
+		errorTypes:               map[reflect.Type]Ref{},
+		errorsByRef:              map[Ref]reflect.Type{},
 	}
 	for _, o := range options {
 		o(t)
@@ -101,6 +126,33 @@ func (t *TypeRegistry) registerFSM(name string, transitions []Transition) {
 	}
 }
 
+func (t *TypeRegistry) registerSaga(name string, steps []SagaStep) {
+	schemaSteps := make([]*schema.SagaStep, len(steps))
+	for i, step := range steps {
+		schemaSteps[i] = &schema.SagaStep{Verb: FuncRef(step.Verb.Interface()).ToSchema()}
+		if step.Compensation.IsValid() {
+			schemaSteps[i].Compensation = FuncRef(step.Compensation.Interface()).ToSchema()
+		}
+	}
+	t.fsm[name] = ReflectedFSM{
+		Schema: schema.SagaFSM(schema.Position{}, name, schemaSteps),
+		Saga:   steps,
+	}
+}
+
+func (t *TypeRegistry) registerErrorType(errorType reflect.Type, ref Ref) {
+	t.errorTypes[errorType] = ref
+	t.errorsByRef[ref] = errorType
+}
+
+func (t *TypeRegistry) getErrorRef(errorType reflect.Type) optional.Option[Ref] {
+	return optional.Zero(t.errorTypes[errorType])
+}
+
+func (t *TypeRegistry) getErrorType(ref Ref) optional.Option[reflect.Type] {
+	return optional.Zero(t.errorsByRef[ref])
+}
+
 func (t *TypeRegistry) isSumTypeDiscriminator(discriminator reflect.Type) bool {
 	return t.getSumTypeVariants(discriminator).Ok()
 }
@@ -32,3 +32,26 @@ func TestTypeRegistry(t *testing.T) {
 	_, ok = GetVariantByType(reflect.TypeFor[MySumType](), reflect.TypeFor[Variant1]()).Get()
 	assert.False(t, ok) // test ResetTypeRegistry()
 }
+
+type MyError struct{ Reason string }
+
+func (e MyError) Error() string { return e.Reason }
+
+func TestTypeRegistryErrorTypes(t *testing.T) {
+	AllowAnyPackageForTesting = true
+	defer func() { AllowAnyPackageForTesting = false }()
+	ResetTypeRegistry()
+	Register(ErrorType[MyError]())
+
+	ref, ok := GetErrorRef(reflect.TypeFor[MyError]()).Get()
+	assert.True(t, ok)
+	assert.Equal(t, Ref{Module: "reflection", Name: "MyError"}, ref)
+
+	errorType, ok := GetErrorType(ref).Get()
+	assert.True(t, ok)
+	assert.Equal(t, reflect.TypeFor[MyError](), errorType)
+
+	_, ok = GetErrorRef(reflect.TypeFor[Variant1]()).Get()
+	assert.False(t, ok)
+	ResetTypeRegistry()
+}
@@ -0,0 +1,45 @@
+package ftl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/TBD54566975/ftl/go-runtime/internal"
+	"github.com/TBD54566975/ftl/internal/modulecontext"
+)
+
+// ResourceHandle is a lazily computed value that is shared by all Verbs in a module.
+type ResourceHandle[T any] struct {
+	fn      func(context.Context) (T, error)
+	options cacheOptions
+}
+
+// Get the value of the resource, computing it if it is not cached.
+func (r *ResourceHandle[T]) Get(ctx context.Context) T {
+	version := modulecontext.FromContext(ctx).Version()
+	out := internal.FromContext(ctx).CallMap(ctx, r, version, r.options.ttl, func(ctx context.Context) (any, error) {
+		return r.fn(ctx)
+	})
+	t, ok := out.(T)
+	if !ok {
+		panic(fmt.Sprintf("output object %v is not compatible with expected type %T", out, *new(T)))
+	}
+	return t
+}
+
+// Invalidate the cached value, so that it is recomputed by the next call to Get.
+func (r *ResourceHandle[T]) Invalidate(ctx context.Context) {
+	internal.FromContext(ctx).InvalidateMap(ctx, r)
+}
+
+// Resource declares a value, such as an API client, that is computed by fn on
+// first use and then cached.
+//
+// The cached value is recomputed whenever the module's configuration or
+// secrets change.
+func Resource[T any](fn func(context.Context) (T, error), options ...CacheOption) *ResourceHandle[T] {
+	return &ResourceHandle[T]{
+		fn:      fn,
+		options: newCacheOptions(options),
+	}
+}
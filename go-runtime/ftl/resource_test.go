@@ -0,0 +1,45 @@
+package ftl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/go-runtime/internal"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/modulecontext"
+)
+
+type updatableContextSupplier struct {
+	sink func(ctx context.Context, mCtx modulecontext.ModuleContext)
+}
+
+func (u *updatableContextSupplier) Subscribe(ctx context.Context, _ string, sink func(ctx context.Context, mCtx modulecontext.ModuleContext)) {
+	u.sink = sink
+	sink(ctx, modulecontext.Empty("test"))
+}
+
+func TestResource(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	supplier := &updatableContextSupplier{}
+	dynamic, err := modulecontext.NewDynamicContext(ctx, supplier, "test")
+	assert.NoError(t, err)
+	ctx = internal.WithContext(dynamic.ApplyToContext(ctx), internal.New(dynamic))
+
+	calls := 0
+	resource := Resource(func(ctx context.Context) (int, error) {
+		calls++
+		return calls, nil
+	})
+	assert.Equal(t, 1, resource.Get(ctx))
+	assert.Equal(t, 1, resource.Get(ctx))
+
+	// Recomputed after the module context changes.
+	supplier.sink(ctx, modulecontext.NewBuilder("test").AddConfigs(map[string][]byte{"key": []byte(`"value"`)}).Build())
+	assert.Equal(t, 2, resource.Get(ctx))
+	assert.Equal(t, 2, resource.Get(ctx))
+
+	resource.Invalidate(ctx)
+	assert.Equal(t, 3, resource.Get(ctx))
+}
@@ -0,0 +1,57 @@
+package ftl
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/TBD54566975/ftl/go-runtime/ftl/reflection"
+)
+
+// SagaStep is a step of a [Saga].
+type SagaStep[T any] struct {
+	verb         reflect.Value
+	compensation reflect.Value
+}
+
+// Step specifies a step of a saga that doesn't need to be undone if a later
+// step fails, eg. because it has no side effects.
+func Step[T any](verb Sink[T]) SagaStep[T] {
+	return SagaStep[T]{verb: reflect.ValueOf(verb)}
+}
+
+// StepWithCompensation specifies a step of a saga, and the Sink that undoes
+// it if a later step fails.
+func StepWithCompensation[T any](verb Sink[T], compensation Sink[T]) SagaStep[T] {
+	return SagaStep[T]{verb: reflect.ValueOf(verb), compensation: reflect.ValueOf(compensation)}
+}
+
+// SagaHandle is a handle to a [Saga].
+type SagaHandle[T any] struct {
+	fsm *FSMHandle
+}
+
+// Saga creates a new saga, a multi-step transaction whose steps are each
+// executed once, in order.
+//
+// If a step fails, once its retries are exhausted, the compensations of the
+// steps that completed before it are executed in reverse order.
+//
+// A saga is a finite-state machine whose states are its steps and
+// compensations, so retries are configured in the same way as FSMs.
+func Saga[T any](name string, steps ...SagaStep[T]) *SagaHandle[T] {
+	rsteps := make([]reflection.SagaStep, len(steps))
+	for i, step := range steps {
+		rsteps[i] = reflection.SagaStep{Verb: step.verb, Compensation: step.compensation}
+	}
+	reflection.Register(reflection.Saga(name, rsteps...))
+	return &SagaHandle[T]{fsm: &FSMHandle{name: name}}
+}
+
+// Start an instance of the saga, which executes its steps with "data".
+//
+// "instance" must uniquely identify the instance of the saga.
+//
+// Within [WithTransaction] the saga is only started once the transaction commits.
+func (s *SagaHandle[T]) Start(ctx context.Context, instance string, data T) error {
+	return s.fsm.Send(ctx, instance, data)
+}
@@ -36,3 +36,14 @@ func (s SecretValue[T]) Get(ctx context.Context) (out T) {
 	}
 	return
 }
+
+// Watch returns a channel that receives the current value of the secret, and
+// each new value when it changes, eg. when credentials are rotated.
+//
+// The channel is closed when the context is cancelled.
+func (s SecretValue[T]) Watch(ctx context.Context) <-chan T {
+	return watch(ctx, s.String(), func() (out T, err error) {
+		err = internal.FromContext(ctx).GetSecret(ctx, s.Name, &out)
+		return
+	})
+}
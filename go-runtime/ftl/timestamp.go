@@ -0,0 +1,34 @@
+package ftl
+
+import (
+	"time"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+// Timestamp is a point in time in a named time zone, represented in the
+// schema as Timestamp.
+//
+// Unlike time.Time, which is encoded with only its UTC offset, a Timestamp is
+// encoded with the name of its time zone, eg.
+// "2024-03-10T09:00:00-04:00[America/New_York]", so that it is decoded in the
+// same time zone.
+type Timestamp struct {
+	time.Time
+}
+
+var (
+	_ Marshaler   = Timestamp{}
+	_ Unmarshaler = (*Timestamp)(nil)
+)
+
+func (t Timestamp) MarshalFTL() (string, error) { return schema.FormatTimestamp(t.Time), nil }
+
+func (t *Timestamp) UnmarshalFTL(value string) error {
+	parsed, err := schema.ParseTimestamp(value)
+	if err != nil {
+		return err
+	}
+	t.Time = parsed
+	return nil
+}
@@ -0,0 +1,31 @@
+package ftl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+)
+
+func TestTimestamp(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+	ts := Timestamp{Time: time.Date(2024, time.March, 10, 9, 0, 0, 0, newYork)}
+
+	encoded, err := ts.MarshalFTL()
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-03-10T09:00:00-04:00[America/New_York]", encoded)
+
+	var decoded Timestamp
+	assert.NoError(t, decoded.UnmarshalFTL(encoded))
+	assert.True(t, ts.Equal(decoded.Time))
+	assert.Equal(t, "America/New_York", decoded.Location().String())
+
+	utc := Timestamp{Time: time.Date(2024, time.March, 10, 13, 0, 0, 0, time.UTC)}
+	encoded, err = utc.MarshalFTL()
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-03-10T13:00:00Z", encoded)
+
+	assert.Error(t, decoded.UnmarshalFTL("2024-03-10T09:00:00-04:00[Nowhere/Special]"))
+	assert.Error(t, decoded.UnmarshalFTL("2024-03-10T09:00:00-04:00[America/New_York"))
+}
@@ -0,0 +1,48 @@
+package ftl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/TBD54566975/ftl/go-runtime/internal"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+// WithTransaction calls fn within a transaction on the database.
+//
+// The transaction is committed if fn returns nil, and rolled back otherwise.
+// FSM events sent and pubsub events published with the context passed to fn
+// are written to an outbox in the same transaction, and only delivered once it
+// commits, so they are never sent for changes that were rolled back.
+func WithTransaction(ctx context.Context, db Database, fn func(ctx context.Context, tx pgx.Tx) error) error {
+	if _, ok := internal.OutboxFromContext(ctx); ok {
+		return errors.New("nested transactions are not supported")
+	}
+	pool := db.Pool(ctx)
+	if err := internal.EnsureOutbox(ctx, pool); err != nil {
+		return err
+	}
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction on %s: %w", db, err)
+	}
+	txCtx, outbox := internal.ContextWithOutbox(ctx, tx)
+	if err := fn(txCtx, tx); err != nil {
+		if rerr := tx.Rollback(ctx); rerr != nil {
+			return errors.Join(err, fmt.Errorf("failed to roll back transaction on %s: %w", db, rerr))
+		}
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction on %s: %w", db, err)
+	}
+	// The transaction has committed at this point, so undelivered events are
+	// left in the outbox to be retried rather than failing the caller.
+	if err := outbox.Deliver(ctx, pool); err != nil {
+		log.FromContext(ctx).Warnf("Failed to deliver events, they will be retried: %s", err)
+	}
+	return nil
+}
@@ -26,3 +26,29 @@ type Source[Resp any] func(context.Context) (Resp, error)
 
 // An Empty is a function that does not accept input or return output.
 type Empty func(context.Context) error
+
+// A StreamVerb is a function that accepts input and sends zero or more outputs
+// to a Stream before returning.
+type StreamVerb[Req, Resp any] func(context.Context, Req, Stream[Resp]) error
+
+// A Stream sends the outputs of a StreamVerb to its caller.
+type Stream[Resp any] interface {
+	// Send an output to the caller.
+	Send(resp Resp) error
+}
+
+// Marshaler is implemented by types that encode themselves as a string, such
+// as identifiers from external packages. They are represented in the schema
+// as String.
+//
+// Types implementing Marshaler must also implement Unmarshaler on their
+// pointer.
+type Marshaler interface {
+	MarshalFTL() (string, error)
+}
+
+// Unmarshaler is implemented by pointers to types that decode themselves
+// from a string. See [Marshaler].
+type Unmarshaler interface {
+	UnmarshalFTL(value string) error
+}
@@ -0,0 +1,45 @@
+package ftl
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/modulecontext"
+)
+
+// watch sends the current value returned by "get", and each new value when the
+// module context is updated, until the context is cancelled.
+func watch[T any](ctx context.Context, description string, get func() (T, error)) <-chan T {
+	dynamicCtx := modulecontext.FromContext(ctx)
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		logger := log.FromContext(ctx)
+		var last T
+		sent := false
+		for {
+			// Retrieve the change notification before reading the value, so
+			// that updates in between are not missed.
+			changed := dynamicCtx.Changed()
+			value, err := get()
+			if err != nil {
+				logger.Warnf("Failed to watch %s: %s", description, err)
+			} else if !sent || !reflect.DeepEqual(value, last) {
+				select {
+				case out <- value:
+					last = value
+					sent = true
+				case <-ctx.Done():
+					return
+				}
+			}
+			select {
+			case <-changed:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
@@ -2,8 +2,12 @@ package internal
 
 import (
 	"context"
+	"time"
+
+	"github.com/alecthomas/types/optional"
 
 	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/go-runtime/ftl/reflection"
 )
 
 // FTL is the interface that the FTL runtime provides to user code.
@@ -18,14 +22,33 @@ type FTL interface {
 	FSMSend(ctx context.Context, fsm, instance string, data any) error
 
 	// PublishEvent sends an event to a pubsub topic.
-	PublishEvent(ctx context.Context, topic *schema.Ref, event any) error
+	//
+	// Events sharing a non-empty "orderingKey" are processed one at a time.
+	PublishEvent(ctx context.Context, topic *schema.Ref, event any, orderingKey string) error
+
+	// CallAsync enqueues a call to a Verb, returning the ID of the async call.
+	//
+	// "retry", if set, overrides the retry policy of the Verb. "call" makes the
+	// call inline, for implementations that do not enqueue calls.
+	CallAsync(ctx context.Context, verb reflection.Ref, request any, retry optional.Option[AsyncCallRetry], call func(context.Context) (any, error)) (int64, error)
 
-	// CallMap calls Get on an instance of an ftl.Map.
+	// AsyncCallResult retrieves the result of a call made with CallAsync.
 	//
-	// "mapper" is a pointer to an instance of an ftl.MapHandle. "value" is the
-	// value being mapped. "mapImpl" is a function that will be called to
-	// compute the mapped value.
-	CallMap(ctx context.Context, mapper any, value any, mapImpl func(context.Context) (any, error)) any
+	// If the call has completed successfully its response is unmarshalled into
+	// "dest". "done" is true if the call has completed, in which case "err" is
+	// the error of a failed call.
+	AsyncCallResult(ctx context.Context, id int64, dest any) (done bool, err error)
+
+	// CallMap calls Get on an instance of an ftl.Map or ftl.Resource.
+	//
+	// "mapper" is a pointer to an instance of an ftl.MapHandle or
+	// ftl.ResourceHandle. "value" is the value being mapped. "mapImpl" is a
+	// function that will be called to compute the mapped value. If "ttl" is
+	// non-zero the mapped value is recomputed once it is older than "ttl".
+	CallMap(ctx context.Context, mapper any, value any, ttl time.Duration, mapImpl func(context.Context) (any, error)) any
+
+	// InvalidateMap discards the cached value of an ftl.Map or ftl.Resource, if any.
+	InvalidateMap(ctx context.Context, mapper any)
 
 	// GetConfig unmarshals a configuration value into dest.
 	GetConfig(ctx context.Context, name string, dest any) error
@@ -34,6 +57,14 @@ type FTL interface {
 	GetSecret(ctx context.Context, name string, dest any) error
 }
 
+// AsyncCallRetry is the retry policy of an async call.
+type AsyncCallRetry struct {
+	// Count is the maximum number of retries.
+	Count int
+	// Backoff is the initial delay between retries, doubling on each retry.
+	Backoff time.Duration
+}
+
 type ftlContextKey struct{}
 
 // WithContext returns a new context with the FTL instance.
@@ -49,3 +80,16 @@ func FromContext(ctx context.Context) FTL {
 	}
 	return ftl
 }
+
+type callMetadataKey struct{}
+
+// ContextWithCallMetadata returns a new context with the metadata sent by the caller of a Verb.
+func ContextWithCallMetadata(ctx context.Context, metadata map[string]string) context.Context {
+	return context.WithValue(ctx, callMetadataKey{}, metadata)
+}
+
+// CallMetadataFromContext returns the metadata sent by the caller of a Verb, if any.
+func CallMetadataFromContext(ctx context.Context) map[string]string {
+	metadata, _ := ctx.Value(callMetadataKey{}).(map[string]string) //nolint:forcetypeassert
+	return metadata
+}
@@ -0,0 +1,88 @@
+package internal
+
+import (
+	"errors"
+	"reflect"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/go-runtime/encoding"
+	"github.com/TBD54566975/ftl/go-runtime/ftl/reflection"
+)
+
+// ErrorToProto converts an error returned by a Verb to its wire representation.
+//
+// If the error wraps an error type declared in the schema, the first such
+// error is encoded along with its type so that callers can decode it.
+func ErrorToProto(err error) *ftlv1.CallResponse_Error {
+	out := &ftlv1.CallResponse_Error{Message: err.Error()}
+	typed, ref, ok := findTypedError(err)
+	if !ok {
+		return out
+	}
+	body, merr := encoding.Marshal(typed)
+	if merr != nil {
+		return out
+	}
+	out.Type = ref.ToProto()
+	out.Body = body
+	return out
+}
+
+// ErrorFromProto converts the wire representation of an error returned by a Verb back to an error.
+//
+// If the error has a type registered with the type registry, the returned
+// error wraps a decoded instance of it, so it can be retrieved with [errors.As].
+func ErrorFromProto(e *ftlv1.CallResponse_Error) error {
+	if e.Type == nil {
+		return errors.New(e.Message)
+	}
+	errorType, ok := reflection.GetErrorType(reflection.RefFromProto(e.Type)).Get()
+	if !ok {
+		return errors.New(e.Message)
+	}
+	value := reflect.New(errorType)
+	if err := encoding.Unmarshal(e.Body, value.Interface()); err != nil {
+		return errors.New(e.Message)
+	}
+	if typed, ok := value.Elem().Interface().(error); ok {
+		return callError{message: e.Message, typed: typed}
+	}
+	if typed, ok := value.Interface().(error); ok {
+		return callError{message: e.Message, typed: typed}
+	}
+	return errors.New(e.Message)
+}
+
+// callError is an error returned by a Verb that wraps a typed error.
+type callError struct {
+	message string
+	typed   error
+}
+
+func (c callError) Error() string { return c.message }
+func (c callError) Unwrap() error { return c.typed }
+
+// findTypedError returns the first error in the chain with a registered error type.
+func findTypedError(err error) (error, reflection.Ref, bool) {
+	queue := []error{err}
+	for len(queue) > 0 {
+		err, queue = queue[0], queue[1:]
+		if err == nil {
+			continue
+		}
+		t := reflect.TypeOf(err)
+		if t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		if ref, ok := reflection.GetErrorRef(t).Get(); ok {
+			return err, ref, true
+		}
+		switch err := err.(type) { //nolint:errorlint
+		case interface{ Unwrap() error }:
+			queue = append(queue, err.Unwrap())
+		case interface{ Unwrap() []error }:
+			queue = append(queue, err.Unwrap()...)
+		}
+	}
+	return nil, reflection.Ref{}, false
+}
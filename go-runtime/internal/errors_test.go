@@ -0,0 +1,41 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/go-runtime/ftl/reflection"
+)
+
+type notFound struct {
+	ID string
+}
+
+func (n notFound) Error() string { return "not found: " + n.ID }
+
+func TestErrorRoundTrip(t *testing.T) {
+	reflection.AllowAnyPackageForTesting = true
+	defer func() { reflection.AllowAnyPackageForTesting = false }()
+	reflection.ResetTypeRegistry()
+	defer reflection.ResetTypeRegistry()
+	reflection.Register(reflection.ErrorType[notFound]())
+
+	wire := ErrorToProto(fmt.Errorf("call failed: %w", notFound{ID: "123"}))
+	assert.Equal(t, "call failed: not found: 123", wire.Message)
+	assert.Equal(t, "NotFound", wire.Type.Name)
+
+	err := ErrorFromProto(wire)
+	assert.EqualError(t, err, "call failed: not found: 123")
+	var typed notFound
+	assert.True(t, errors.As(err, &typed))
+	assert.Equal(t, notFound{ID: "123"}, typed)
+
+	wire = ErrorToProto(errors.New("untyped"))
+	assert.Zero(t, wire.Type)
+	err = ErrorFromProto(wire)
+	assert.EqualError(t, err, "untyped")
+	assert.False(t, errors.As(err, &typed))
+}
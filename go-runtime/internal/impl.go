@@ -6,10 +6,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"time"
 
 	"connectrpc.com/connect"
-
+	"github.com/alecthomas/types/optional"
 	"github.com/puzpuzpuz/xsync/v3"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
 	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
@@ -24,6 +26,8 @@ import (
 type mapCacheEntry struct {
 	checksum [32]byte
 	output   any
+	// Zero if the entry does not expire.
+	expires time.Time
 }
 
 // RealFTL is the real implementation of the [internal.FTL] interface using the Controller.
@@ -52,15 +56,71 @@ func (r *RealFTL) GetSecret(_ context.Context, name string, dest any) error {
 }
 
 func (r *RealFTL) FSMSend(ctx context.Context, fsm, instance string, event any) error {
-	client := rpc.ClientFromContext[ftlv1connect.VerbServiceClient](ctx)
 	body, err := encoding.Marshal(event)
 	if err != nil {
 		return fmt.Errorf("failed to marshal event: %w", err)
 	}
-	_, err = client.SendFSMEvent(ctx, connect.NewRequest(&ftlv1.SendFSMEventRequest{
-		Fsm:      &schemapb.Ref{Module: reflection.Module(), Name: fsm},
+	return sendFSMEvent(ctx, &schema.Ref{Module: reflection.Module(), Name: fsm}, instance, schema.TypeToProto(reflection.ReflectTypeToSchemaType(reflect.TypeOf(event))), body)
+}
+
+func (r *RealFTL) PublishEvent(ctx context.Context, topic *schema.Ref, event any, orderingKey string) error {
+	if topic.Module != reflection.Module() {
+		return fmt.Errorf("can not publish to another module's topic: %s", topic)
+	}
+	body, err := encoding.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+	return publishEvent(ctx, topic, body, orderingKey)
+}
+
+func (r *RealFTL) CallAsync(ctx context.Context, verb reflection.Ref, request any, retry optional.Option[AsyncCallRetry], _ func(context.Context) (any, error)) (int64, error) {
+	body, err := encoding.Marshal(request)
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal request: %w", err)
+	}
+	req := &ftlv1.CallAsyncRequest{Verb: verb.ToProto(), Body: body}
+	if retry, ok := retry.Get(); ok {
+		req.Retry = &ftlv1.CallAsyncRequest_Retry{Count: int32(retry.Count), MinBackoff: durationpb.New(retry.Backoff)} //nolint:gosec
+	}
+	client := rpc.ClientFromContext[ftlv1connect.VerbServiceClient](ctx)
+	resp, err := client.CallAsync(ctx, connect.NewRequest(req))
+	if err != nil {
+		return 0, fmt.Errorf("failed to enqueue async call: %w", err)
+	}
+	return resp.Msg.Id, nil
+}
+
+func (r *RealFTL) AsyncCallResult(ctx context.Context, id int64, dest any) (bool, error) {
+	client := rpc.ClientFromContext[ftlv1connect.VerbServiceClient](ctx)
+	resp, err := client.GetAsyncCallResult(ctx, connect.NewRequest(&ftlv1.GetAsyncCallResultRequest{Id: id}))
+	if err != nil {
+		return false, fmt.Errorf("failed to get result of async call %d: %w", id, err)
+	}
+	switch result := resp.Msg.Result.(type) {
+	case *ftlv1.GetAsyncCallResultResponse_Body:
+		if err := encoding.Unmarshal(result.Body, dest); err != nil {
+			return true, fmt.Errorf("failed to decode response of async call %d: %w", id, err)
+		}
+		return true, nil
+
+	case *ftlv1.GetAsyncCallResultResponse_Error:
+		return true, fmt.Errorf("async call %d failed: %s", id, result.Error)
+
+	case nil:
+		return false, nil
+
+	default:
+		panic(fmt.Sprintf("invalid result type %T", result))
+	}
+}
+
+func sendFSMEvent(ctx context.Context, fsm *schema.Ref, instance string, eventType *schemapb.Type, body []byte) error {
+	client := rpc.ClientFromContext[ftlv1connect.VerbServiceClient](ctx)
+	_, err := client.SendFSMEvent(ctx, connect.NewRequest(&ftlv1.SendFSMEventRequest{
+		Fsm:      &schemapb.Ref{Module: fsm.Module, Name: fsm.Name},
 		Instance: instance,
-		Event:    schema.TypeToProto(reflection.ReflectTypeToSchemaType(reflect.TypeOf(event))),
+		Event:    eventType,
 		Body:     body,
 	}))
 	if err != nil {
@@ -69,26 +129,23 @@ func (r *RealFTL) FSMSend(ctx context.Context, fsm, instance string, event any)
 	return nil
 }
 
-func (r *RealFTL) PublishEvent(ctx context.Context, topic *schema.Ref, event any) error {
-	if topic.Module != reflection.Module() {
-		return fmt.Errorf("can not publish to another module's topic: %s", topic)
-	}
+func publishEvent(ctx context.Context, topic *schema.Ref, body []byte, orderingKey string) error {
 	client := rpc.ClientFromContext[ftlv1connect.VerbServiceClient](ctx)
-	body, err := encoding.Marshal(event)
-	if err != nil {
-		return fmt.Errorf("failed to marshal event: %w", err)
-	}
-	_, err = client.PublishEvent(ctx, connect.NewRequest(&ftlv1.PublishEventRequest{
+	req := &ftlv1.PublishEventRequest{
 		Topic: topic.ToProto().(*schemapb.Ref), //nolint: forcetypeassert
 		Body:  body,
-	}))
+	}
+	if orderingKey != "" {
+		req.OrderingKey = &orderingKey
+	}
+	_, err := client.PublishEvent(ctx, connect.NewRequest(req))
 	if err != nil {
 		return fmt.Errorf("failed to publish event: %w", err)
 	}
 	return nil
 }
 
-func (r *RealFTL) CallMap(ctx context.Context, mapper any, value any, mapImpl func(context.Context) (any, error)) any {
+func (r *RealFTL) CallMap(ctx context.Context, mapper any, value any, ttl time.Duration, mapImpl func(context.Context) (any, error)) any {
 	// Compute checksum of the input.
 	inputData, err := json.Marshal(value)
 	if err != nil {
@@ -99,7 +156,7 @@ func (r *RealFTL) CallMap(ctx context.Context, mapper any, value any, mapImpl fu
 	// Check cache.
 	key := reflect.ValueOf(mapper).Pointer()
 	cached, ok := r.mapped.Load(key)
-	if ok && checksum == cached.checksum {
+	if ok && checksum == cached.checksum && (cached.expires.IsZero() || time.Now().Before(cached.expires)) {
 		return cached.output
 	}
 
@@ -110,9 +167,17 @@ func (r *RealFTL) CallMap(ctx context.Context, mapper any, value any, mapImpl fu
 	}
 
 	// Write the cache back.
-	r.mapped.Store(key, mapCacheEntry{
+	entry := mapCacheEntry{
 		checksum: checksum,
 		output:   t,
-	})
+	}
+	if ttl > 0 {
+		entry.expires = time.Now().Add(ttl)
+	}
+	r.mapped.Store(key, entry)
 	return t
 }
+
+func (r *RealFTL) InvalidateMap(ctx context.Context, mapper any) {
+	r.mapped.Delete(reflect.ValueOf(mapper).Pointer())
+}
@@ -0,0 +1,9 @@
+package internal
+
+import (
+	"github.com/TBD54566975/ftl/internal/metrics"
+)
+
+// Metrics is the registry of the counters and histograms recorded by the
+// module.
+var Metrics = metrics.NewRegistry()
@@ -0,0 +1,233 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/protobuf/proto"
+
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/go-runtime/encoding"
+	"github.com/TBD54566975/ftl/go-runtime/ftl/reflection"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+const (
+	outboxKindFSM   = "fsm"
+	outboxKindTopic = "topic"
+
+	// Events are normally delivered as soon as their transaction commits, so
+	// the relay only delivers events that are older than this.
+	outboxRelayDelay = 30 * time.Second
+)
+
+const createOutboxTable = `
+CREATE TABLE IF NOT EXISTS ftl_outbox (
+  id BIGSERIAL PRIMARY KEY,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  kind TEXT NOT NULL,
+  -- "<module>.<fsm>" or "<module>.<topic>"
+  name TEXT NOT NULL,
+  -- FSM instance, or ordering key of pubsub messages
+  instance TEXT NOT NULL DEFAULT '',
+  -- Serialised schema.Type of FSM events
+  event_type BYTEA,
+  body BYTEA NOT NULL
+)`
+
+// Pools for which the outbox table is known to exist.
+var outboxTables sync.Map
+
+// EnsureOutbox creates the outbox table in the database, if it does not already exist.
+func EnsureOutbox(ctx context.Context, pool *pgxpool.Pool) error {
+	if _, ok := outboxTables.Load(pool); ok {
+		return nil
+	}
+	if _, err := pool.Exec(ctx, createOutboxTable); err != nil {
+		return fmt.Errorf("failed to create outbox table: %w", err)
+	}
+	outboxTables.Store(pool, struct{}{})
+	return nil
+}
+
+// Outbox collects the FSM events and pubsub messages sent during a database
+// transaction.
+//
+// Events are written to the "ftl_outbox" table as part of the transaction, and
+// delivered once it commits.
+type Outbox struct {
+	tx pgx.Tx
+
+	lock    sync.Mutex
+	pending []pendingEvent
+}
+
+type pendingEvent struct {
+	id   int64
+	send func(ctx context.Context) error
+}
+
+type outboxKey struct{}
+
+// ContextWithOutbox returns a new context with an outbox for events sent during the transaction "tx".
+func ContextWithOutbox(ctx context.Context, tx pgx.Tx) (context.Context, *Outbox) {
+	outbox := &Outbox{tx: tx}
+	return context.WithValue(ctx, outboxKey{}, outbox), outbox
+}
+
+// OutboxFromContext returns the outbox of the current transaction, if any.
+func OutboxFromContext(ctx context.Context) (*Outbox, bool) {
+	outbox, ok := ctx.Value(outboxKey{}).(*Outbox)
+	return outbox, ok
+}
+
+// FSMSend enqueues an event to an instance of an FSM.
+func (o *Outbox) FSMSend(ctx context.Context, fsm, instance string, event any) error {
+	body, err := encoding.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+	eventType, err := proto.Marshal(schema.TypeToProto(reflection.ReflectTypeToSchemaType(reflect.TypeOf(event))))
+	if err != nil {
+		return fmt.Errorf("failed to marshal event type: %w", err)
+	}
+	ref := &schema.Ref{Module: reflection.Module(), Name: fsm}
+	return o.enqueue(ctx, outboxKindFSM, ref.String(), instance, eventType, body, func(ctx context.Context) error {
+		return FromContext(ctx).FSMSend(ctx, fsm, instance, event)
+	})
+}
+
+// PublishEvent enqueues an event to a pubsub topic.
+func (o *Outbox) PublishEvent(ctx context.Context, topic *schema.Ref, event any, orderingKey string) error {
+	if topic.Module != reflection.Module() {
+		return fmt.Errorf("can not publish to another module's topic: %s", topic)
+	}
+	body, err := encoding.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+	return o.enqueue(ctx, outboxKindTopic, topic.String(), orderingKey, nil, body, func(ctx context.Context) error {
+		return FromContext(ctx).PublishEvent(ctx, topic, event, orderingKey)
+	})
+}
+
+func (o *Outbox) enqueue(ctx context.Context, kind, name, instance string, eventType, body []byte, send func(ctx context.Context) error) error {
+	var id int64
+	err := o.tx.QueryRow(ctx, `
+		INSERT INTO ftl_outbox (kind, name, instance, event_type, body)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id`, kind, name, instance, eventType, body).Scan(&id)
+	if err != nil {
+		return fmt.Errorf("failed to write event to outbox: %w", err)
+	}
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	o.pending = append(o.pending, pendingEvent{id: id, send: send})
+	return nil
+}
+
+// Deliver the events enqueued during the transaction, which must have committed.
+//
+// Events that fail to be delivered remain in the outbox, and are delivered
+// later by [RelayOutbox].
+func (o *Outbox) Deliver(ctx context.Context, pool *pgxpool.Pool) error {
+	o.lock.Lock()
+	pending := o.pending
+	o.pending = nil
+	o.lock.Unlock()
+	var errs []error
+	for _, event := range pending {
+		if err := event.send(ctx); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if _, err := pool.Exec(ctx, "DELETE FROM ftl_outbox WHERE id = $1", event.id); err != nil {
+			errs = append(errs, fmt.Errorf("failed to remove event from outbox: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// RelayOutbox delivers events left in the outbox of a database, eg. because
+// the module exited before they could be delivered.
+func RelayOutbox(ctx context.Context, pool *pgxpool.Pool) error {
+	var exists bool
+	if err := pool.QueryRow(ctx, "SELECT to_regclass('ftl_outbox') IS NOT NULL").Scan(&exists); err != nil {
+		return fmt.Errorf("failed to check for outbox: %w", err)
+	}
+	if !exists {
+		return nil
+	}
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+	rows, err := tx.Query(ctx, `
+		SELECT id, kind, name, instance, event_type, body
+		FROM ftl_outbox
+		WHERE created_at < NOW() - make_interval(secs => $1)
+		ORDER BY id
+		FOR UPDATE SKIP LOCKED`, outboxRelayDelay.Seconds())
+	if err != nil {
+		return fmt.Errorf("failed to read outbox: %w", err)
+	}
+	type row struct {
+		id        int64
+		kind      string
+		name      string
+		instance  string
+		eventType []byte
+		body      []byte
+	}
+	events, err := pgx.CollectRows(rows, func(r pgx.CollectableRow) (row, error) {
+		var out row
+		err := r.Scan(&out.id, &out.kind, &out.name, &out.instance, &out.eventType, &out.body)
+		return out, err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to read outbox: %w", err)
+	}
+	logger := log.FromContext(ctx)
+	for _, event := range events {
+		if err := relayEvent(ctx, event.kind, event.name, event.instance, event.eventType, event.body); err != nil {
+			logger.Warnf("Failed to relay event %d from outbox: %s", event.id, err)
+			continue
+		}
+		if _, err := tx.Exec(ctx, "DELETE FROM ftl_outbox WHERE id = $1", event.id); err != nil {
+			return fmt.Errorf("failed to remove event from outbox: %w", err)
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit outbox: %w", err)
+	}
+	return nil
+}
+
+func relayEvent(ctx context.Context, kind, name, instance string, eventType, body []byte) error {
+	ref, err := schema.ParseRef(name)
+	if err != nil {
+		return fmt.Errorf("invalid reference %q: %w", name, err)
+	}
+	switch kind {
+	case outboxKindFSM:
+		pb := &schemapb.Type{}
+		if err := proto.Unmarshal(eventType, pb); err != nil {
+			return fmt.Errorf("invalid event type: %w", err)
+		}
+		return sendFSMEvent(ctx, ref, instance, pb, body)
+
+	case outboxKindTopic:
+		return publishEvent(ctx, ref, body, instance)
+
+	default:
+		return fmt.Errorf("unknown outbox event kind %q", kind)
+	}
+}
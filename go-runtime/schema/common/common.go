@@ -22,6 +22,22 @@ var (
 	// FtlUnitTypePath is the path to the FTL unit type.
 	FtlUnitTypePath   = "github.com/TBD54566975/ftl/go-runtime/ftl.Unit"
 	FtlOptionTypePath = "github.com/TBD54566975/ftl/go-runtime/ftl.Option"
+	FtlStreamTypePath = "github.com/TBD54566975/ftl/go-runtime/ftl.Stream"
+	// FtlDecimalTypePath is the path to the FTL decimal type, represented in the schema as Decimal.
+	FtlDecimalTypePath = "github.com/TBD54566975/ftl/go-runtime/ftl.Decimal"
+	// FtlTimestampTypePath is the path to the FTL timestamp type, represented in the schema as Timestamp.
+	FtlTimestampTypePath = "github.com/TBD54566975/ftl/go-runtime/ftl.Timestamp"
+	// UUIDTypePath is the path to the uuid.UUID type, represented in the schema as UUID.
+	UUIDTypePath = "github.com/google/uuid.UUID"
+
+	// ftlMarshaler matches the method set of ftl.Marshaler.
+	ftlMarshaler = types.NewInterfaceType([]*types.Func{
+		types.NewFunc(token.NoPos, nil, "MarshalFTL", types.NewSignatureType(nil, nil, nil, nil,
+			types.NewTuple(
+				types.NewVar(token.NoPos, nil, "", types.Typ[types.String]),
+				types.NewVar(token.NoPos, nil, "", types.Universe.Lookup("error").Type()),
+			), false)),
+	}, nil).Complete()
 
 	extractorRegistery = xsync.NewMapOf[reflect.Type, ExtractDeclFunc[schema.Decl, ast.Node]]()
 )
@@ -127,6 +143,14 @@ func ExtractType(pass *analysis.Pass, pos token.Pos, tnode types.Type) optional.
 		return optional.Some[schema.Type](&schema.Ref{Pos: GoPosToSchemaPos(fset, pos), Name: tparam.Obj().Id()})
 	}
 
+	if typ, ok := WellKnownType(tnode, GoPosToSchemaPos(fset, pos)).Get(); ok {
+		return optional.Some(typ)
+	}
+
+	if IsStringEncoded(tnode) {
+		return optional.Some[schema.Type](&schema.String{Pos: GoPosToSchemaPos(fset, pos)})
+	}
+
 	switch underlying := tnode.Underlying().(type) {
 	case *types.Basic:
 		if named, ok := tnode.(*types.Named); ok {
@@ -195,6 +219,39 @@ func ExtractType(pass *analysis.Pass, pos token.Pos, tnode types.Type) optional.
 	}
 }
 
+// WellKnownType returns the schema type of "t" if it is a named type with a
+// dedicated schema type, such as uuid.UUID.
+func WellKnownType(t types.Type, pos schema.Position) optional.Option[schema.Type] {
+	named, ok := t.(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return optional.None[schema.Type]()
+	}
+	switch named.Obj().Pkg().Path() + "." + named.Obj().Name() {
+	case UUIDTypePath:
+		return optional.Some[schema.Type](&schema.UUID{Pos: pos})
+	case FtlDecimalTypePath:
+		return optional.Some[schema.Type](&schema.Decimal{Pos: pos})
+	case FtlTimestampTypePath:
+		return optional.Some[schema.Type](&schema.Timestamp{Pos: pos})
+	default:
+		return optional.None[schema.Type]()
+	}
+}
+
+// IsStringEncoded returns true if values of the named type "t" are encoded as
+// strings and represented in the schema as String, because it implements
+// ftl.Marshaler and is not a [WellKnownType].
+func IsStringEncoded(t types.Type) bool {
+	named, ok := t.(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return false
+	}
+	if _, ok := WellKnownType(t, schema.Position{}).Get(); ok {
+		return false
+	}
+	return types.Implements(named, ftlMarshaler)
+}
+
 func ExtractFuncForDecl(t schema.Decl) (ExtractDeclFunc[schema.Decl, ast.Node], error) {
 	if f, ok := extractorRegistery.Load(reflect.TypeOf(t)); ok {
 		return f, nil
@@ -329,7 +386,12 @@ func extractRef(pass *analysis.Pass, pos token.Pos, named *types.Named) optional
 			continue
 		}
 
-		// Fully qualify the Ref if needed
+		// Fully qualify the Ref if needed, unless it refers to a type parameter
+		// of the enclosing declaration, eg. "T" in "Pair[string, T]".
+		if _, isParam := named.TypeArgs().At(i).(*types.TypeParam); isParam {
+			ref.TypeParameters = append(ref.TypeParameters, typeArg)
+			continue
+		}
 		if r, okArg := typeArg.(*schema.Ref); okArg {
 			if r.Module == "" {
 				r.Module = moduleName
@@ -0,0 +1,43 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/backend/schema/strcase"
+)
+
+// ValidateFieldTag is the struct tag declaring validation constraints on a field, eg.
+//
+//	Name string `validate:"minlen=1,maxlen=64,pattern=^[a-z]+$"`
+//
+// Constraints are separated by commas. As patterns may contain commas, a
+// pattern must be the last constraint in the tag.
+const ValidateFieldTag = "validate"
+
+// ParseValidateTag parses the constraints declared in a [ValidateFieldTag].
+func ParseValidateTag(pos schema.Position, tag string) ([]schema.Metadata, error) {
+	var out []schema.Metadata
+	for tag = strings.TrimSpace(tag); tag != ""; tag = strings.TrimSpace(tag) {
+		var constraint string
+		if strings.HasPrefix(tag, schema.ConstraintPattern+"=") {
+			constraint, tag = tag, ""
+		} else {
+			constraint, tag, _ = strings.Cut(tag, ",")
+		}
+		key, value, ok := strings.Cut(constraint, "=")
+		if !ok || value == "" {
+			return nil, fmt.Errorf("invalid constraint %q, expected <constraint>=<value>", constraint)
+		}
+		switch key {
+		case schema.ConstraintMin, schema.ConstraintMax, schema.ConstraintMinLen, schema.ConstraintMaxLen, schema.ConstraintPattern:
+		case schema.ConstraintRequiredIf:
+			value = strcase.ToLowerCamel(value)
+		default:
+			return nil, fmt.Errorf("unknown constraint %q", key)
+		}
+		out = append(out, &schema.MetadataValidate{Pos: pos, Kind: key, Value: value})
+	}
+	return out, nil
+}
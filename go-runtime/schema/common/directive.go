@@ -37,22 +37,36 @@ type Exportable interface {
 	IsExported() bool
 }
 
+// Internalable is a directive that can make a declaration visible only to
+// modules in the same project.
+type Internalable interface {
+	IsInternal() bool
+}
+
 type DirectiveVerb struct {
 	Pos token.Pos
 
-	Verb   bool `parser:"@'verb'"`
-	Export bool `parser:"@'export'?"`
+	Verb     bool `parser:"@'verb'"`
+	Export   bool `parser:"@'export'?"`
+	Internal bool `parser:"@'internal'?"`
 }
 
 func (*DirectiveVerb) directive() {}
 func (d *DirectiveVerb) String() string {
-	if d.Export {
+	switch {
+	case d.Internal:
+		return "ftl:verb internal"
+	case d.Export:
 		return "ftl:verb export"
+	default:
+		return "ftl:verb"
 	}
-	return "ftl:verb"
 }
 func (d *DirectiveVerb) IsExported() bool {
-	return d.Export
+	return d.Export || d.Internal
+}
+func (d *DirectiveVerb) IsInternal() bool {
+	return d.Internal
 }
 func (*DirectiveVerb) GetTypeName() string { return "verb" }
 func (d *DirectiveVerb) SetPosition(pos token.Pos) {
@@ -66,19 +80,27 @@ func (*DirectiveVerb) MustAnnotate() []ast.Node { return []ast.Node{&ast.FuncDec
 type DirectiveData struct {
 	Pos token.Pos
 
-	Data   bool `parser:"@'data'"`
-	Export bool `parser:"@'export'?"`
+	Data     bool `parser:"@'data'"`
+	Export   bool `parser:"@'export'?"`
+	Internal bool `parser:"@'internal'?"`
 }
 
 func (*DirectiveData) directive() {}
 func (d *DirectiveData) String() string {
-	if d.Export {
+	switch {
+	case d.Internal:
+		return "ftl:data internal"
+	case d.Export:
 		return "ftl:data export"
+	default:
+		return "ftl:data"
 	}
-	return "ftl:data"
 }
 func (d *DirectiveData) IsExported() bool {
-	return d.Export
+	return d.Export || d.Internal
+}
+func (d *DirectiveData) IsInternal() bool {
+	return d.Internal
 }
 func (*DirectiveData) GetTypeName() string { return "data" }
 func (d *DirectiveData) SetPosition(pos token.Pos) {
@@ -231,11 +253,168 @@ func (*DirectiveRetry) MustAnnotate() []ast.Node {
 	return []ast.Node{&ast.FuncDecl{}, &ast.GenDecl{}}
 }
 
-// DirectiveSubscriber is used to subscribe a sink to a subscription
+// DirectiveConcurrency limits the number of concurrent executions of a verb.
+type DirectiveConcurrency struct {
+	Pos token.Pos
+
+	Max   int  `parser:"'concurrency' @Number"`
+	Queue *int `parser:"('queue' @Number)?"`
+}
+
+func (*DirectiveConcurrency) directive() {}
+
+func (d *DirectiveConcurrency) String() string {
+	components := []string{"concurrency", strconv.Itoa(d.Max)}
+	if d.Queue != nil {
+		components = append(components, "queue", strconv.Itoa(*d.Queue))
+	}
+	return strings.Join(components, " ")
+}
+func (*DirectiveConcurrency) GetTypeName() string { return "concurrency" }
+func (d *DirectiveConcurrency) SetPosition(pos token.Pos) {
+	d.Pos = pos
+}
+func (d *DirectiveConcurrency) GetPosition() token.Pos {
+	return d.Pos
+}
+func (*DirectiveConcurrency) MustAnnotate() []ast.Node {
+	return []ast.Node{&ast.FuncDecl{}}
+}
+
+// DirectiveDeprecated marks a verb or a field of a data structure as deprecated.
+type DirectiveDeprecated struct {
+	Pos token.Pos
+
+	Message string `parser:"'deprecated' @String?"`
+}
+
+func (*DirectiveDeprecated) directive() {}
+
+func (d *DirectiveDeprecated) String() string {
+	if d.Message == "" {
+		return "deprecated"
+	}
+	return fmt.Sprintf("deprecated %q", d.Message)
+}
+func (*DirectiveDeprecated) GetTypeName() string { return "deprecated" }
+func (d *DirectiveDeprecated) SetPosition(pos token.Pos) {
+	d.Pos = pos
+}
+func (d *DirectiveDeprecated) GetPosition() token.Pos {
+	return d.Pos
+}
+func (*DirectiveDeprecated) MustAnnotate() []ast.Node {
+	return []ast.Node{&ast.FuncDecl{}, &ast.Field{}}
+}
+
+// DirectiveAllow restricts the modules that can call an exported verb.
+type DirectiveAllow struct {
+	Pos token.Pos
+
+	Modules []string `parser:"'allow' @Ident (',' @Ident)*"`
+}
+
+func (*DirectiveAllow) directive() {}
+
+func (d *DirectiveAllow) String() string {
+	return "allow " + strings.Join(d.Modules, ", ")
+}
+func (*DirectiveAllow) GetTypeName() string { return "allow" }
+func (d *DirectiveAllow) SetPosition(pos token.Pos) {
+	d.Pos = pos
+}
+func (d *DirectiveAllow) GetPosition() token.Pos {
+	return d.Pos
+}
+func (*DirectiveAllow) MustAnnotate() []ast.Node {
+	return []ast.Node{&ast.FuncDecl{}}
+}
+
+// DirectiveCache declares that the responses of a verb can be cached.
+type DirectiveCache struct {
+	Pos token.Pos
+
+	TTL  string   `parser:"'cache' @(Number (?! Whitespace) Ident)"`
+	Vary []string `parser:"('vary' @Ident (',' @Ident)*)?"`
+}
+
+func (*DirectiveCache) directive() {}
+
+func (d *DirectiveCache) String() string {
+	if len(d.Vary) == 0 {
+		return "cache " + d.TTL
+	}
+	return "cache " + d.TTL + " vary " + strings.Join(d.Vary, ", ")
+}
+func (*DirectiveCache) GetTypeName() string { return "cache" }
+func (d *DirectiveCache) SetPosition(pos token.Pos) {
+	d.Pos = pos
+}
+func (d *DirectiveCache) GetPosition() token.Pos {
+	return d.Pos
+}
+func (*DirectiveCache) MustAnnotate() []ast.Node {
+	return []ast.Node{&ast.FuncDecl{}}
+}
+
+// DirectiveEgress declares the external HTTP origins a verb calls.
+type DirectiveEgress struct {
+	Pos token.Pos
+
+	URLs []string `parser:"'egress' @String (',' @String)*"`
+}
+
+func (*DirectiveEgress) directive() {}
+
+func (d *DirectiveEgress) String() string {
+	urls := make([]string, len(d.URLs))
+	for i, u := range d.URLs {
+		urls[i] = strconv.Quote(u)
+	}
+	return "egress " + strings.Join(urls, ", ")
+}
+func (*DirectiveEgress) GetTypeName() string { return "egress" }
+func (d *DirectiveEgress) SetPosition(pos token.Pos) {
+	d.Pos = pos
+}
+func (d *DirectiveEgress) GetPosition() token.Pos {
+	return d.Pos
+}
+func (*DirectiveEgress) MustAnnotate() []ast.Node {
+	return []ast.Node{&ast.FuncDecl{}}
+}
+
+// DirectiveResponse maps the fields of the response body of an HTTP ingress
+// verb onto its status, headers and body.
+type DirectiveResponse struct {
+	Pos token.Pos
+
+	Status  string                   `parser:"'response' ('status' @Ident)?"`
+	Headers []*schema.ResponseHeader `parser:"@@*"`
+	Body    string                   `parser:"('body' @Ident)?"`
+}
+
+func (*DirectiveResponse) directive() {}
+
+func (d *DirectiveResponse) String() string {
+	return strings.TrimPrefix((&schema.MetadataResponse{Status: d.Status, Headers: d.Headers, Body: d.Body}).String(), "+")
+}
+func (*DirectiveResponse) GetTypeName() string { return "response" }
+func (d *DirectiveResponse) SetPosition(pos token.Pos) {
+	d.Pos = pos
+}
+func (d *DirectiveResponse) GetPosition() token.Pos {
+	return d.Pos
+}
+func (*DirectiveResponse) MustAnnotate() []ast.Node {
+	return []ast.Node{&ast.FuncDecl{}}
+}
+
+// DirectiveSubscriber is used to subscribe a sink to a subscription, or directly to a topic
 type DirectiveSubscriber struct {
 	Pos token.Pos
 
-	Name string `parser:"'subscribe' @Ident"`
+	Name string `parser:"'subscribe' @(Ident ('.' Ident)?)"`
 }
 
 func (*DirectiveSubscriber) directive() {}
@@ -281,7 +460,7 @@ var directiveParser = participle.MustBuild[directiveWrapper](
 	participle.Unquote(),
 	participle.UseLookahead(2),
 	participle.Union[Directive](&DirectiveVerb{}, &DirectiveData{}, &DirectiveEnum{}, &DirectiveTypeAlias{},
-		&DirectiveIngress{}, &DirectiveCronJob{}, &DirectiveRetry{}, &DirectiveSubscriber{}, &DirectiveExport{}),
+		&DirectiveIngress{}, &DirectiveCronJob{}, &DirectiveRetry{}, &DirectiveConcurrency{}, &DirectiveDeprecated{}, &DirectiveAllow{}, &DirectiveCache{}, &DirectiveEgress{}, &DirectiveResponse{}, &DirectiveSubscriber{}, &DirectiveExport{}),
 	participle.Union[schema.IngressPathComponent](&schema.IngressPathLiteral{}, &schema.IngressPathParameter{}),
 )
 
@@ -54,6 +54,7 @@ func (*ExtractedDecl) schemaFactValue() {}
 type ExtractedMetadata struct {
 	Type       schema.Decl
 	IsExported bool
+	IsInternal bool
 	Metadata   []schema.Metadata
 	Comments   []string
 }
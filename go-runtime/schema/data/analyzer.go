@@ -2,9 +2,9 @@ package data
 
 import (
 	"go/ast"
-	"go/token"
 	"go/types"
 	"reflect"
+	"slices"
 	"strings"
 	"unicode"
 
@@ -31,15 +31,16 @@ func Extract(pass *analysis.Pass, node *ast.TypeSpec, obj types.Object) optional
 	if _, ok := named.Underlying().(*types.Struct); !ok {
 		return optional.None[*schema.Data]()
 	}
-	decl, ok := extractData(pass, node.Pos(), named).Get()
+	decl, ok := extractData(pass, node, named).Get()
 	if !ok {
 		return optional.None[*schema.Data]()
 	}
 	return optional.Some(decl)
 }
 
-func extractData(pass *analysis.Pass, pos token.Pos, named *types.Named) optional.Option[*schema.Data] {
+func extractData(pass *analysis.Pass, node *ast.TypeSpec, named *types.Named) optional.Option[*schema.Data] {
 	fset := pass.Fset
+	pos := node.Pos()
 	nodePath := named.Obj().Pkg().Path()
 	if !common.IsPathInPkg(pass.Pkg, nodePath) {
 		return optional.None[*schema.Data]()
@@ -55,6 +56,7 @@ func extractData(pass *analysis.Pass, pos token.Pos, named *types.Named) optiona
 		}
 		out.Comments = md.Comments
 		out.Export = md.IsExported
+		out.Internal = md.IsInternal
 	}
 
 	for i := range named.TypeParams().Len() {
@@ -103,6 +105,13 @@ func extractData(pass *analysis.Pass, pos token.Pos, named *types.Named) optiona
 					Alias: jsonFieldName,
 				})
 			}
+			constraints, err := common.ParseValidateTag(common.GoPosToSchemaPos(pass.Fset, f.Pos()), reflect.StructTag(s.Tag(i)).Get(common.ValidateFieldTag))
+			if err != nil {
+				common.TokenErrorf(pass, f.Pos(), f.Name(), "invalid %s tag on field %q: %s", common.ValidateFieldTag, f.Name(), err)
+				fieldErrors = true
+			}
+			metadata = append(metadata, constraints...)
+			metadata = append(metadata, fieldDirectives(pass, node, f.Name())...)
 			out.Fields = append(out.Fields, &schema.Field{
 				Pos:      common.GoPosToSchemaPos(pass.Fset, pos),
 				Name:     strcase.ToLowerCamel(f.Name()),
@@ -117,5 +126,42 @@ func extractData(pass *analysis.Pass, pos token.Pos, named *types.Named) optiona
 	if fieldErrors {
 		return optional.None[*schema.Data]()
 	}
+	if isError(named) {
+		out.Metadata = append(out.Metadata, &schema.MetadataError{Pos: common.GoPosToSchemaPos(fset, pos), Error: true})
+	}
 	return optional.Some(out)
 }
+
+// fieldDirectives returns the metadata declared by directives in the doc
+// comment of a struct field, eg. "//ftl:deprecated".
+func fieldDirectives(pass *analysis.Pass, node *ast.TypeSpec, name string) []schema.Metadata {
+	st, ok := node.Type.(*ast.StructType)
+	if !ok {
+		return nil
+	}
+	var metadata []schema.Metadata
+	for _, field := range st.Fields.List {
+		if !slices.ContainsFunc(field.Names, func(ident *ast.Ident) bool { return ident.Name == name }) {
+			continue
+		}
+		for _, dir := range common.ParseDirectives(pass, field, field.Doc) {
+			switch dir := dir.(type) {
+			case *common.DirectiveDeprecated:
+				metadata = append(metadata, &schema.MetadataDeprecated{
+					Pos:        common.GoPosToSchemaPos(pass.Fset, dir.Pos),
+					Deprecated: true,
+					Message:    dir.Message,
+				})
+			default:
+				common.NoEndColumnErrorf(pass, dir.GetPosition(), "unexpected directive \"ftl:%s\" attached for field", dir.GetTypeName())
+			}
+		}
+	}
+	return metadata
+}
+
+// isError returns true if the type, or a pointer to it, implements error.
+func isError(named *types.Named) bool {
+	errorType := types.Universe.Lookup("error").Type().Underlying().(*types.Interface) //nolint:forcetypeassert
+	return types.Implements(named, errorType) || types.Implements(types.NewPointer(named), errorType)
+}
@@ -119,6 +119,47 @@ func extractMetadata(pass *analysis.Pass, node ast.Node, doc *ast.CommentGroup)
 				MinBackoff: dt.MinBackoff,
 				MaxBackoff: dt.MaxBackoff,
 			})
+		case *common.DirectiveConcurrency:
+			newSchType = &schema.Verb{}
+			metadata = append(metadata, &schema.MetadataConcurrency{
+				Pos:   common.GoPosToSchemaPos(pass.Fset, dt.Pos),
+				Max:   dt.Max,
+				Queue: dt.Queue,
+			})
+		case *common.DirectiveAllow:
+			newSchType = &schema.Verb{}
+			metadata = append(metadata, &schema.MetadataAllow{
+				Pos:     common.GoPosToSchemaPos(pass.Fset, dt.Pos),
+				Modules: dt.Modules,
+			})
+		case *common.DirectiveCache:
+			newSchType = &schema.Verb{}
+			metadata = append(metadata, &schema.MetadataCache{
+				Pos:  common.GoPosToSchemaPos(pass.Fset, dt.Pos),
+				TTL:  dt.TTL,
+				Vary: dt.Vary,
+			})
+		case *common.DirectiveEgress:
+			newSchType = &schema.Verb{}
+			metadata = append(metadata, &schema.MetadataEgress{
+				Pos:  common.GoPosToSchemaPos(pass.Fset, dt.Pos),
+				URLs: dt.URLs,
+			})
+		case *common.DirectiveResponse:
+			newSchType = &schema.Verb{}
+			metadata = append(metadata, &schema.MetadataResponse{
+				Pos:     common.GoPosToSchemaPos(pass.Fset, dt.Pos),
+				Status:  dt.Status,
+				Headers: dt.Headers,
+				Body:    dt.Body,
+			})
+		case *common.DirectiveDeprecated:
+			newSchType = &schema.Verb{}
+			metadata = append(metadata, &schema.MetadataDeprecated{
+				Pos:        common.GoPosToSchemaPos(pass.Fset, dt.Pos),
+				Deprecated: true,
+				Message:    dt.Message,
+			})
 		case *common.DirectiveSubscriber:
 			newSchType = &schema.Verb{}
 			metadata = append(metadata, &schema.MetadataSubscriber{
@@ -146,6 +187,7 @@ func extractMetadata(pass *analysis.Pass, node ast.Node, doc *ast.CommentGroup)
 		Type:       declType,
 		Metadata:   metadata,
 		IsExported: exported,
+		IsInternal: isInternal(directives),
 		Comments:   common.ExtractComments(doc),
 	})
 }
@@ -179,6 +221,15 @@ func isExported(directives []common.Directive) bool {
 	return false
 }
 
+func isInternal(directives []common.Directive) bool {
+	for _, d := range directives {
+		if internalable, ok := d.(common.Internalable); ok {
+			return internalable.IsInternal()
+		}
+	}
+	return false
+}
+
 func isAnnotatingValidGoNode(dir common.Directive, node ast.Node) bool {
 	for _, n := range dir.MustAnnotate() {
 		if reflect.TypeOf(n) == reflect.TypeOf(node) {
@@ -27,6 +27,7 @@ func Extract(pass *analysis.Pass, root *ast.FuncDecl, obj types.Object) optional
 		Name:     strcase.ToLowerCamel(root.Name.Name),
 		Comments: md.Comments,
 		Export:   md.IsExported,
+		Internal: md.IsInternal,
 		Metadata: md.Metadata,
 	}
 
@@ -37,15 +38,20 @@ func Extract(pass *analysis.Pass, root *ast.FuncDecl, obj types.Object) optional
 		return optional.None[*schema.Verb]()
 	}
 	params := sig.Params()
-	results := sig.Results()
-	reqt, respt := checkSignature(pass, root, sig)
+	reqt, respt, streamt := checkSignature(pass, root, sig)
 	req := optional.Some[schema.Type](&schema.Unit{})
 	if reqt.Ok() {
 		req = common.ExtractType(pass, params.At(1).Pos(), params.At(1).Type())
 	}
 	resp := optional.Some[schema.Type](&schema.Unit{})
-	if respt.Ok() {
-		resp = common.ExtractType(pass, results.At(0).Pos(), results.At(0).Type())
+	var respVar *types.Var
+	if v, ok := respt.Get(); ok {
+		respVar = v
+		resp = common.ExtractType(pass, v.Pos(), v.Type())
+	} else if t, ok := streamt.Get(); ok {
+		respVar = params.At(params.Len() - 1)
+		resp = common.ExtractType(pass, respVar.Pos(), t)
+		verb.Metadata = append(verb.Metadata, &schema.MetadataStream{Pos: common.GoPosToSchemaPos(pass.Fset, respVar.Pos()), Stream: true})
 	}
 	reqV, ok := req.Get()
 	if !ok {
@@ -54,8 +60,8 @@ func Extract(pass *analysis.Pass, root *ast.FuncDecl, obj types.Object) optional
 	}
 	resV, ok := resp.Get()
 	if !ok {
-		common.TokenErrorf(pass, results.At(0).Pos(), results.At(0).Name(),
-			"unsupported response type %q", results.At(0).Type())
+		common.TokenErrorf(pass, respVar.Pos(), respVar.Name(),
+			"unsupported response type %q", respVar.Type())
 	}
 	verb.Request = reqV
 	verb.Response = resV
@@ -63,13 +69,29 @@ func Extract(pass *analysis.Pass, root *ast.FuncDecl, obj types.Object) optional
 	return optional.Some(verb)
 }
 
-func checkSignature(pass *analysis.Pass, node *ast.FuncDecl, sig *types.Signature) (req, resp optional.Option[*types.Var]) {
+func checkSignature(pass *analysis.Pass, node *ast.FuncDecl, sig *types.Signature) (req, resp optional.Option[*types.Var], stream optional.Option[types.Type]) {
 	params := sig.Params()
 	results := sig.Results()
 
-	if params.Len() > 2 {
+	// Streaming verbs take a trailing ftl.Stream[Resp] parameter.
+	nparams := params.Len()
+	if nparams > 1 {
+		last := params.At(nparams - 1)
+		if respt, ok := streamType(last.Type()).Get(); ok {
+			if !common.IsType[*types.Struct](respt) {
+				common.TokenErrorf(pass, last.Pos(), last.Name(), "stream must be of a struct but is of %s", respt)
+			}
+			stream = optional.Some(respt)
+			nparams--
+		}
+	}
+
+	if nparams > 2 {
 		common.Errorf(pass, node, "must have at most two parameters (context.Context, struct)")
 	}
+	if stream.Ok() && nparams != 2 {
+		common.Errorf(pass, node, "streaming verbs must have parameters (context.Context, struct, ftl.Stream[struct])")
+	}
 
 	loaded := pass.ResultOf[initialize.Analyzer].(initialize.Result) //nolint:forcetypeassert
 	if params.Len() == 0 {
@@ -78,7 +100,7 @@ func checkSignature(pass *analysis.Pass, node *ast.FuncDecl, sig *types.Signatur
 		common.TokenErrorf(pass, params.At(0).Pos(), params.At(0).Name(), "first parameter must be of type context.Context but is %s", params.At(0).Type())
 	}
 
-	if params.Len() == 2 {
+	if nparams == 2 {
 		if !common.IsType[*types.Struct](params.At(1).Type()) {
 			common.TokenErrorf(pass, params.At(1).Pos(), params.At(1).Name(), "second parameter must be a struct but is %s", params.At(1).Type())
 		}
@@ -97,6 +119,10 @@ func checkSignature(pass *analysis.Pass, node *ast.FuncDecl, sig *types.Signatur
 	} else if !loaded.IsFtlErrorType(results.At(results.Len() - 1).Type()) {
 		common.TokenErrorf(pass, results.At(results.Len()-1).Pos(), results.At(results.Len()-1).Name(), "must return an error but is %s", results.At(0).Type())
 	}
+	if stream.Ok() && results.Len() > 1 {
+		common.Errorf(pass, node, "streaming verbs must only return an error")
+		return req, resp, stream
+	}
 	if results.Len() == 2 {
 		if !common.IsType[*types.Struct](results.At(0).Type()) {
 			common.TokenErrorf(pass, results.At(0).Pos(), results.At(0).Name(), "first result must be a struct but is %s", results.At(0).Type())
@@ -106,5 +132,17 @@ func checkSignature(pass *analysis.Pass, node *ast.FuncDecl, sig *types.Signatur
 		}
 		resp = optional.Some(results.At(0))
 	}
-	return req, resp
+	return req, resp, stream
+}
+
+// streamType returns the type of responses sent to "t" if it is an ftl.Stream.
+func streamType(t types.Type) optional.Option[types.Type] {
+	named, ok := t.(*types.Named)
+	if !ok || named.Obj().Pkg() == nil || named.TypeArgs().Len() != 1 {
+		return optional.None[types.Type]()
+	}
+	if named.Obj().Pkg().Path()+"."+named.Obj().Name() != common.FtlStreamTypePath {
+		return optional.None[types.Type]()
+	}
+	return optional.Some(named.TypeArgs().At(0))
 }
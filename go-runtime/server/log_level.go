@@ -0,0 +1,23 @@
+package server
+
+import (
+	"context"
+
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/modulecontext"
+)
+
+// followLogLevel applies the log level override of the module context to
+// "override" each time the module context changes, until the context is
+// cancelled.
+func followLogLevel(ctx context.Context, dynamicCtx *modulecontext.DynamicModuleContext, override *log.LevelOverride) {
+	for {
+		changed := dynamicCtx.Changed()
+		override.Set(dynamicCtx.CurrentContext().LogLevel())
+		select {
+		case <-ctx.Done():
+			return
+		case <-changed:
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"path"
+
+	"github.com/amacneil/dbmate/v2/pkg/dbmate"
+	_ "github.com/amacneil/dbmate/v2/pkg/driver/postgres"
+	_ "github.com/jackc/pgx/v5/stdlib" // SQL driver
+
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/modulecontext"
+)
+
+// Arbitrary key for the advisory lock held while migrating, so that replicas
+// of a module don't apply migrations concurrently.
+const migrationLockKey = 0x46544c6d6967
+
+var migrations fs.FS
+
+// RegisterMigrations registers the database migrations embedded in the module.
+//
+// This function is intended to be used by the code generator.
+func RegisterMigrations(fsys fs.FS) {
+	migrations = fsys
+}
+
+// migrate applies any pending migrations to each of the module's databases.
+//
+// Migrations for a database are read from "migrations/<name>", or directly
+// from "migrations" if the module has a single database.
+func migrate(ctx context.Context, moduleContext modulecontext.ModuleContext) error {
+	if migrations == nil {
+		return nil
+	}
+	databases := moduleContext.Databases()
+	for name, db := range databases {
+		dir := path.Join("migrations", name)
+		if _, err := fs.Stat(migrations, dir); err != nil {
+			if len(databases) != 1 {
+				continue
+			}
+			dir = "migrations"
+		}
+		if err := migrateDatabase(ctx, db.DSN, dir); err != nil {
+			return fmt.Errorf("failed to migrate database %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func migrateDatabase(ctx context.Context, dsn, dir string) error {
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return fmt.Errorf("invalid DSN: %w", err)
+	}
+	conn, err := sql.Open("pgx", dsn)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer conn.Close()
+	lock, err := conn.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer lock.Close()
+	if _, err := lock.ExecContext(ctx, "SELECT pg_advisory_lock($1)", migrationLockKey); err != nil {
+		return fmt.Errorf("failed to acquire migration lock: %w", err)
+	}
+	defer lock.ExecContext(context.WithoutCancel(ctx), "SELECT pg_advisory_unlock($1)", migrationLockKey) //nolint:errcheck
+
+	db := dbmate.New(u)
+	db.FS = migrations
+	db.Log = log.FromContext(ctx).Scope("migrate").WriterAt(log.Debug)
+	db.MigrationsDir = []string{dir}
+	db.AutoDumpSchema = false
+	if err := db.Migrate(); err != nil {
+		return err
+	}
+	return nil
+}
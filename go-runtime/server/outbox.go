@@ -0,0 +1,36 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/TBD54566975/ftl/go-runtime/internal"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/modulecontext"
+)
+
+const outboxRelayInterval = 10 * time.Second
+
+// relayOutboxes periodically delivers events left in the outboxes of the
+// module's databases by ftl.WithTransaction, until the context is cancelled.
+func relayOutboxes(ctx context.Context, dynamicCtx *modulecontext.DynamicModuleContext) {
+	logger := log.FromContext(ctx).Scope("outbox")
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(outboxRelayInterval):
+		}
+		moduleContext := dynamicCtx.CurrentContext()
+		for name, db := range moduleContext.Databases() {
+			pool, err := moduleContext.GetPool(name, db.DBType)
+			if err != nil {
+				logger.Warnf("Failed to get pool for database %q: %s", name, err)
+				continue
+			}
+			if err := internal.RelayOutbox(ctx, pool); err != nil {
+				logger.Warnf("Failed to relay outbox for database %q: %s", name, err)
+			}
+		}
+	}
+}
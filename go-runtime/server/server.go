@@ -4,12 +4,14 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"reflect"
 	"runtime/debug"
 
 	"connectrpc.com/connect"
 
 	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
 	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	schemapb "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/schema"
 	"github.com/TBD54566975/ftl/common/plugin"
 	"github.com/TBD54566975/ftl/go-runtime/encoding"
 	"github.com/TBD54566975/ftl/go-runtime/ftl"
@@ -17,15 +19,17 @@ import (
 	"github.com/TBD54566975/ftl/go-runtime/internal"
 	"github.com/TBD54566975/ftl/internal/log"
 	"github.com/TBD54566975/ftl/internal/maps"
+	"github.com/TBD54566975/ftl/internal/metrics"
 	"github.com/TBD54566975/ftl/internal/modulecontext"
 	"github.com/TBD54566975/ftl/internal/observability"
 	"github.com/TBD54566975/ftl/internal/rpc"
 )
 
 type UserVerbConfig struct {
-	FTLEndpoint         *url.URL             `help:"FTL endpoint." env:"FTL_ENDPOINT" required:""`
-	ObservabilityConfig observability.Config `embed:"" prefix:"o11y-"`
-	Config              []string             `name:"config" short:"C" help:"Paths to FTL project configuration files." env:"FTL_CONFIG" placeholder:"FILE[,FILE,...]" type:"existingfile"`
+	FTLEndpoint          *url.URL             `help:"FTL endpoint." env:"FTL_ENDPOINT" required:""`
+	FTLFailoverEndpoints []*url.URL           `help:"FTL endpoints to fail over to if the FTL endpoint is unavailable." env:"FTL_FAILOVER_ENDPOINTS"`
+	ObservabilityConfig  observability.Config `embed:"" prefix:"o11y-"`
+	Config               []string             `name:"config" short:"C" help:"Paths to FTL project configuration files." env:"FTL_CONFIG" placeholder:"FILE[,FILE,...]" type:"existingfile"`
 }
 
 // NewUserVerbServer starts a new code-generated drive for user Verbs.
@@ -33,7 +37,7 @@ type UserVerbConfig struct {
 // This function is intended to be used by the code generator.
 func NewUserVerbServer(moduleName string, handlers ...Handler) plugin.Constructor[ftlv1connect.VerbServiceHandler, UserVerbConfig] {
 	return func(ctx context.Context, uc UserVerbConfig) (context.Context, ftlv1connect.VerbServiceHandler, error) {
-		verbServiceClient := rpc.Dial(ftlv1connect.NewVerbServiceClient, uc.FTLEndpoint.String(), log.Error)
+		verbServiceClient := rpc.DialFailover(ctx, ftlv1connect.NewVerbServiceClient, rpc.EndpointURLs(uc.FTLEndpoint, uc.FTLFailoverEndpoints), log.Error)
 		ctx = rpc.ContextWithClient(ctx, verbServiceClient)
 
 		moduleContextSupplier := modulecontext.NewModuleContextSupplier(verbServiceClient)
@@ -42,9 +46,18 @@ func NewUserVerbServer(moduleName string, handlers ...Handler) plugin.Constructo
 			return nil, nil, fmt.Errorf("could not get config: %w", err)
 		}
 
+		logLevel := &log.LevelOverride{}
+		ctx = log.ContextWithLogger(ctx, log.FromContext(ctx).LevelOverride(logLevel))
+		go followLogLevel(ctx, dynamicCtx, logLevel)
+
 		ctx = dynamicCtx.ApplyToContext(ctx)
 		ctx = internal.WithContext(ctx, internal.New(dynamicCtx))
 
+		if err := migrate(ctx, dynamicCtx.CurrentContext()); err != nil {
+			return nil, nil, err
+		}
+		go relayOutboxes(ctx, dynamicCtx)
+
 		err = observability.Init(ctx, moduleName, "HEAD", uc.ObservabilityConfig)
 		if err != nil {
 			return nil, nil, err
@@ -54,10 +67,22 @@ func NewUserVerbServer(moduleName string, handlers ...Handler) plugin.Constructo
 	}
 }
 
+// ServeMetrics serves the counters and histograms recorded by the module, for
+// scraping by the runner.
+//
+// This function is intended to be used by the code generator.
+func ServeMetrics() plugin.StartOption[ftlv1connect.VerbServiceHandler] {
+	return plugin.RegisterAdditionalHandler[ftlv1connect.VerbServiceHandler](metrics.Path, metrics.JSONHandler(func(context.Context) ([]metrics.Sample, error) {
+		return internal.Metrics.Snapshot(), nil
+	}))
+}
+
 // Handler for a Verb.
 type Handler struct {
 	ref reflection.Ref
 	fn  func(ctx context.Context, req []byte) ([]byte, error)
+	// Set instead of fn for streaming Verbs.
+	stream func(ctx context.Context, req []byte, send func(resp []byte) error) error
 }
 
 func handler[Req, Resp any](ref reflection.Ref, verb func(ctx context.Context, req Req) (Resp, error)) Handler {
@@ -72,10 +97,20 @@ func handler[Req, Resp any](ref reflection.Ref, verb func(ctx context.Context, r
 			}
 
 			// Call Verb.
-			resp, err := verb(ctx, req)
+			uncheckedResp, err := ftl.InterceptCall(ctx, &ftl.CallInfo{Verb: ref, Metadata: ftl.CallMetadata(ctx)}, req, func(ctx context.Context, info *ftl.CallInfo, uncheckedReq any) (any, error) {
+				req, ok := uncheckedReq.(Req)
+				if !ok {
+					return nil, fmt.Errorf("interceptor passed invalid request type %T, expected %v", uncheckedReq, reflect.TypeFor[Req]())
+				}
+				return verb(internal.ContextWithCallMetadata(ctx, info.Metadata), req)
+			})
 			if err != nil {
 				return nil, fmt.Errorf("call to verb %s failed: %w", ref, err)
 			}
+			resp, ok := uncheckedResp.(Resp)
+			if !ok {
+				return nil, fmt.Errorf("call to verb %s failed: interceptor returned invalid response type %T, expected %v", ref, uncheckedResp, reflect.TypeFor[Resp]())
+			}
 
 			respdata, err := encoding.Marshal(resp)
 			if err != nil {
@@ -115,6 +150,46 @@ func HandleEmpty(empty func(ctx context.Context) error) Handler {
 	})
 }
 
+// HandleStream creates a Handler from a Verb that streams its responses.
+func HandleStream[Req, Resp any](verb func(ctx context.Context, req Req, stream ftl.Stream[Resp]) error) Handler {
+	ref := reflection.FuncRef(verb)
+	return Handler{
+		ref: ref,
+		stream: func(ctx context.Context, reqdata []byte, send func(resp []byte) error) error {
+			// Decode request.
+			var req Req
+			err := encoding.Unmarshal(reqdata, &req)
+			if err != nil {
+				return fmt.Errorf("invalid request to verb %s: %w", ref, err)
+			}
+
+			// Call Verb.
+			_, err = ftl.InterceptCall(ctx, &ftl.CallInfo{Verb: ref, Metadata: ftl.CallMetadata(ctx)}, req, func(ctx context.Context, info *ftl.CallInfo, uncheckedReq any) (any, error) {
+				req, ok := uncheckedReq.(Req)
+				if !ok {
+					return nil, fmt.Errorf("interceptor passed invalid request type %T, expected %v", uncheckedReq, reflect.TypeFor[Req]())
+				}
+				return nil, verb(internal.ContextWithCallMetadata(ctx, info.Metadata), req, streamSender[Resp](send))
+			})
+			if err != nil {
+				return fmt.Errorf("call to verb %s failed: %w", ref, err)
+			}
+			return nil
+		},
+	}
+}
+
+// streamSender encodes responses sent to a ftl.Stream.
+type streamSender[Resp any] func(resp []byte) error
+
+func (s streamSender[Resp]) Send(resp Resp) error {
+	respdata, err := encoding.Marshal(resp)
+	if err != nil {
+		return err
+	}
+	return s(respdata)
+}
+
 var _ ftlv1connect.VerbServiceHandler = (*moduleServer)(nil)
 
 // This is the server that is compiled into the same binary as user-defined Verbs.
@@ -127,30 +202,24 @@ func (m *moduleServer) Call(ctx context.Context, req *connect.Request[ftlv1.Call
 	// Recover from panics and return an error ftlv1.CallResponse.
 	defer func() {
 		if r := recover(); r != nil {
-			var err error
-			if rerr, ok := r.(error); ok {
-				err = rerr
-			} else {
-				err = fmt.Errorf("%v", r)
-			}
-			stack := string(debug.Stack())
-			logger.Errorf(err, "panic in verb %s.%s", req.Msg.Verb.Module, req.Msg.Verb.Name)
-			response = connect.NewResponse(&ftlv1.CallResponse{Response: &ftlv1.CallResponse_Error_{Error: &ftlv1.CallResponse_Error{
-				Message: err.Error(),
-				Stack:   &stack,
-			}}})
+			response = connect.NewResponse(&ftlv1.CallResponse{Response: &ftlv1.CallResponse_Error_{Error: panicToProto(logger, req.Msg.Verb, r)}})
 		}
 	}()
 	handler, ok := m.handlers[reflection.RefFromProto(req.Msg.Verb)]
 	if !ok {
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("verb %q not found", req.Msg.Verb))
 	}
+	if handler.fn == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("verb %q streams its responses", req.Msg.Verb))
+	}
 
+	ctx = internal.ContextWithCallMetadata(ctx, metadataFromProto(req.Msg.Metadata))
+	ctx = contextWithRequestLogger(ctx)
 	respdata, err := handler.fn(ctx, req.Msg.Body)
 	if err != nil {
 		// This makes me slightly ill.
 		return connect.NewResponse(&ftlv1.CallResponse{
-			Response: &ftlv1.CallResponse_Error_{Error: &ftlv1.CallResponse_Error{Message: err.Error()}},
+			Response: &ftlv1.CallResponse_Error_{Error: internal.ErrorToProto(err)},
 		}), nil
 	}
 
@@ -159,6 +228,75 @@ func (m *moduleServer) Call(ctx context.Context, req *connect.Request[ftlv1.Call
 	}), nil
 }
 
+func (m *moduleServer) CallStream(ctx context.Context, req *connect.Request[ftlv1.CallRequest], stream *connect.ServerStream[ftlv1.CallResponse]) error {
+	logger := log.FromContext(ctx)
+	// Recover from panics and send an error ftlv1.CallResponse.
+	defer func() {
+		if r := recover(); r != nil {
+			_ = stream.Send(&ftlv1.CallResponse{Response: &ftlv1.CallResponse_Error_{Error: panicToProto(logger, req.Msg.Verb, r)}}) //nolint:errcheck
+		}
+	}()
+	handler, ok := m.handlers[reflection.RefFromProto(req.Msg.Verb)]
+	if !ok {
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("verb %q not found", req.Msg.Verb))
+	}
+	if handler.stream == nil {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("verb %q does not stream its responses", req.Msg.Verb))
+	}
+
+	ctx = internal.ContextWithCallMetadata(ctx, metadataFromProto(req.Msg.Metadata))
+	ctx = contextWithRequestLogger(ctx)
+	err := handler.stream(ctx, req.Msg.Body, func(respdata []byte) error {
+		return stream.Send(&ftlv1.CallResponse{Response: &ftlv1.CallResponse_Body{Body: respdata}})
+	})
+	if err != nil {
+		return stream.Send(&ftlv1.CallResponse{
+			Response: &ftlv1.CallResponse_Error_{Error: internal.ErrorToProto(err)},
+		})
+	}
+	return nil
+}
+
+// panicToProto converts a value recovered from a panic in a verb to an error
+// response, including the stack trace of the panic so it is recorded in the
+// call log and can be grouped with other occurrences of the same crash.
+func panicToProto(logger *log.Logger, verb *schemapb.Ref, r any) *ftlv1.CallResponse_Error {
+	var err error
+	if rerr, ok := r.(error); ok {
+		err = rerr
+	} else {
+		err = fmt.Errorf("%v", r)
+	}
+	stack := string(debug.Stack())
+	logger.Errorf(err, "panic in verb %s.%s", verb.Module, verb.Name)
+	return &ftlv1.CallResponse_Error{
+		Message: "panic: " + err.Error(),
+		Stack:   &stack,
+	}
+}
+
+// contextWithRequestLogger attaches the key of the current request, if any, to
+// entries logged during the call, so they are associated with the request in
+// the deployment logs.
+func contextWithRequestLogger(ctx context.Context) context.Context {
+	key, err := rpc.RequestKeyFromContext(ctx)
+	if err != nil {
+		return ctx
+	}
+	if key, ok := key.Get(); ok {
+		return log.ContextWithLogger(ctx, log.FromContext(ctx).With("request", key))
+	}
+	return ctx
+}
+
+func metadataFromProto(metadata *ftlv1.Metadata) map[string]string {
+	out := map[string]string{}
+	for _, pair := range metadata.GetValues() {
+		out[pair.Key] = pair.Value
+	}
+	return out
+}
+
 func (m *moduleServer) GetModuleContext(_ context.Context, _ *connect.Request[ftlv1.ModuleContextRequest], _ *connect.ServerStream[ftlv1.ModuleContextResponse]) error {
 	return connect.NewError(connect.CodeUnimplemented, fmt.Errorf("GetModuleContext not implemented"))
 }
@@ -178,3 +316,11 @@ func (m *moduleServer) SendFSMEvent(context.Context, *connect.Request[ftlv1.Send
 func (m *moduleServer) PublishEvent(context.Context, *connect.Request[ftlv1.PublishEventRequest]) (*connect.Response[ftlv1.PublishEventResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("PublishEvent not implemented"))
 }
+
+func (m *moduleServer) CallAsync(context.Context, *connect.Request[ftlv1.CallAsyncRequest]) (*connect.Response[ftlv1.CallAsyncResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("CallAsync not implemented"))
+}
+
+func (m *moduleServer) GetAsyncCallResult(context.Context, *connect.Request[ftlv1.GetAsyncCallResultRequest]) (*connect.Response[ftlv1.GetAsyncCallResultResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, fmt.Errorf("GetAsyncCallResult not implemented"))
+}
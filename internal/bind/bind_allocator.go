@@ -1,16 +1,39 @@
 package bind
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net"
 	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
 	"strconv"
+	"sync"
 
-	"github.com/alecthomas/atomic"
+	"github.com/TBD54566975/ftl/internal/log"
 )
 
+// BindAllocator allocates free ports, starting from the port of a base URL.
+//
+// Ports allocated by name with [BindAllocator.NextFor] can be persisted, so
+// that eg. runners keep the same port across restarts of the dev loop.
 type BindAllocator struct {
+	lock    sync.Mutex
 	baseURL *url.URL
-	port    atomic.Int32
+	// Last port tried.
+	port int
+
+	// File persisted ports are stored in, if any.
+	statePath string
+	// Ports by name, persisted across restarts.
+	persisted map[string]int
+	// Ports by name that take precedence over persisted ports.
+	overrides map[string]int
+	// Ports already allocated by NextFor, and their names.
+	allocated map[int]string
 }
 
 func NewBindAllocator(url *url.URL) (*BindAllocator, error) {
@@ -25,25 +48,174 @@ func NewBindAllocator(url *url.URL) (*BindAllocator, error) {
 	}
 
 	return &BindAllocator{
-		baseURL: url,
-		port:    atomic.NewInt32(int32(port) - 1), //nolint:gosec
+		baseURL:   url,
+		port:      port - 1,
+		persisted: map[string]int{},
+		overrides: map[string]int{},
+		allocated: map[int]string{},
 	}, nil
 }
 
+// NewPersistentBindAllocator creates a [BindAllocator] that persists ports
+// allocated by name to "statePath", unless it is empty.
+//
+// "overrides" are fixed ports by name, which are used in preference to
+// persisted ports.
+func NewPersistentBindAllocator(url *url.URL, statePath string, overrides map[string]int) (*BindAllocator, error) {
+	b, err := NewBindAllocator(url)
+	if err != nil {
+		return nil, err
+	}
+	b.statePath = statePath
+	if statePath != "" {
+		data, err := os.ReadFile(statePath)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("failed to read ports: %w", err)
+		} else if err == nil {
+			if err := json.Unmarshal(data, &b.persisted); err != nil {
+				return nil, fmt.Errorf("failed to parse ports in %s: %w", statePath, err)
+			}
+		}
+	}
+	byPort := map[int]string{}
+	for _, name := range sortedKeys(overrides) {
+		port := overrides[name]
+		if port <= 0 || port > 65535 {
+			return nil, fmt.Errorf("invalid port %d for %s", port, name)
+		}
+		if other, ok := byPort[port]; ok {
+			return nil, fmt.Errorf("%s and %s cannot both use port %d", other, name, port)
+		}
+		byPort[port] = name
+		b.overrides[name] = port
+	}
+	return b, nil
+}
+
+// Next allocates the next free port.
+//
+// Ports persisted or overridden for names are skipped.
 func (b *BindAllocator) Next() *url.URL {
-	var l *net.TCPListener
-	var err error
-	for {
-		b.port.Add(1)
-		l, err = net.ListenTCP("tcp", &net.TCPAddr{IP: net.ParseIP(b.baseURL.Hostname()), Port: int(b.port.Load())})
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.urlFor(b.next())
+}
 
-		if err != nil {
+// NextFor allocates a port for "name".
+//
+// If the port overridden for "name" is in use an error is returned. If the
+// port persisted for "name" is in use by something else, a new port is
+// allocated and persisted in its place.
+func (b *BindAllocator) NextFor(ctx context.Context, name string) (*url.URL, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if port, ok := b.overrides[name]; ok {
+		if other, ok := b.allocated[port]; ok {
+			return nil, fmt.Errorf("port %d for %s is already allocated to %s", port, name, other)
+		}
+		if !b.available(port) {
+			return nil, fmt.Errorf("port %d for %s is in use", port, name)
+		}
+		b.allocated[port] = name
+		return b.urlFor(port), nil
+	}
+	previous, persisted := b.persisted[name]
+	if persisted {
+		if _, allocated := b.allocated[previous]; !allocated && !b.reservedByOverride(previous) && b.available(previous) {
+			b.allocated[previous] = name
+			return b.urlFor(previous), nil
+		}
+		delete(b.persisted, name)
+	}
+	port := b.next()
+	if persisted {
+		log.FromContext(ctx).Warnf("Port %d previously used by %s is unavailable, using %d instead", previous, name, port)
+	}
+	b.allocated[port] = name
+	b.persisted[name] = port
+	if err := b.save(); err != nil {
+		return nil, err
+	}
+	return b.urlFor(port), nil
+}
+
+func (b *BindAllocator) next() int {
+	for {
+		b.port++
+		if _, ok := b.allocated[b.port]; ok || b.reserved(b.port) {
+			continue
+		}
+		if !b.available(b.port) {
 			continue
 		}
-		_ = l.Close()
+		return b.port
+	}
+}
+
+// reserved returns true if the port belongs to a name, even if it hasn't
+// been allocated yet.
+func (b *BindAllocator) reserved(port int) bool {
+	if b.reservedByOverride(port) {
+		return true
+	}
+	for _, p := range b.persisted {
+		if p == port {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *BindAllocator) reservedByOverride(port int) bool {
+	for _, p := range b.overrides {
+		if p == port {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *BindAllocator) available(port int) bool {
+	l, err := net.ListenTCP("tcp", &net.TCPAddr{IP: net.ParseIP(b.baseURL.Hostname()), Port: port})
+	if err != nil {
+		return false
+	}
+	_ = l.Close()
+	return true
+}
+
+func (b *BindAllocator) urlFor(port int) *url.URL {
+	newURL := *b.baseURL
+	newURL.Host = net.JoinHostPort(b.baseURL.Hostname(), strconv.Itoa(port))
+	return &newURL
+}
+
+func (b *BindAllocator) save() error {
+	if b.statePath == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(b.persisted, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(b.statePath), 0700); err != nil {
+		return fmt.Errorf("failed to create state directory: %w", err)
+	}
+	tmp := b.statePath + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return fmt.Errorf("failed to write ports: %w", err)
+	}
+	if err := os.Rename(tmp, b.statePath); err != nil {
+		return fmt.Errorf("failed to write ports: %w", err)
+	}
+	return nil
+}
 
-		newURL := *b.baseURL
-		newURL.Host = net.JoinHostPort(b.baseURL.Hostname(), strconv.Itoa(int(b.port.Load())))
-		return &newURL
+func sortedKeys(m map[string]int) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
 	}
+	sort.Strings(keys)
+	return keys
 }
@@ -0,0 +1,79 @@
+package bind
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"path/filepath"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+// freeBaseURL returns a base URL on a port that was free.
+func freeBaseURL(t *testing.T) *url.URL {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	assert.NoError(t, l.Close())
+	u, err := url.Parse("http://" + l.Addr().String())
+	assert.NoError(t, err)
+	return u
+}
+
+func TestPersistentBindAllocator(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	base := freeBaseURL(t)
+	statePath := filepath.Join(t.TempDir(), ".ftl", "ports.json")
+
+	allocator, err := NewPersistentBindAllocator(base, statePath, nil)
+	assert.NoError(t, err)
+	runner0, err := allocator.NextFor(ctx, "runner0")
+	assert.NoError(t, err)
+	runner1, err := allocator.NextFor(ctx, "runner1")
+	assert.NoError(t, err)
+	assert.NotEqual(t, runner0.Host, runner1.Host)
+
+	// Ports are stable across restarts, regardless of allocation order, and
+	// persisted ports are not handed out to other names.
+	allocator, err = NewPersistentBindAllocator(base, statePath, nil)
+	assert.NoError(t, err)
+	anonymous := allocator.Next()
+	restarted1, err := allocator.NextFor(ctx, "runner1")
+	assert.NoError(t, err)
+	restarted0, err := allocator.NextFor(ctx, "runner0")
+	assert.NoError(t, err)
+	assert.Equal(t, runner0.Host, restarted0.Host)
+	assert.Equal(t, runner1.Host, restarted1.Host)
+	assert.NotEqual(t, runner0.Host, anonymous.Host)
+	assert.NotEqual(t, runner1.Host, anonymous.Host)
+
+	// A persisted port that is in use is replaced.
+	l, err := net.Listen("tcp", runner0.Host)
+	assert.NoError(t, err)
+	defer l.Close()
+	allocator, err = NewPersistentBindAllocator(base, statePath, nil)
+	assert.NoError(t, err)
+	moved, err := allocator.NextFor(ctx, "runner0")
+	assert.NoError(t, err)
+	assert.NotEqual(t, runner0.Host, moved.Host)
+}
+
+func TestBindAllocatorOverrides(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	base := freeBaseURL(t)
+
+	_, err := NewPersistentBindAllocator(base, "", map[string]int{"runner0": 9000, "runner1": 9000})
+	assert.EqualError(t, err, "runner0 and runner1 cannot both use port 9000")
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer l.Close()
+	busy := l.Addr().(*net.TCPAddr).Port //nolint:forcetypeassert
+	allocator, err := NewPersistentBindAllocator(base, "", map[string]int{"runner0": busy})
+	assert.NoError(t, err)
+	_, err = allocator.NextFor(ctx, "runner0")
+	assert.Error(t, err)
+}
@@ -0,0 +1,61 @@
+// Package cdc splits content into content-defined chunks.
+//
+// Chunk boundaries are chosen by a rolling hash of the content rather than
+// by offset, so content shared by two artefacts, eg. an embedded dependency,
+// is split into the same chunks wherever it appears in each.
+package cdc
+
+const (
+	// MinSize is the minimum size of a chunk, other than the last.
+	MinSize = 64 * 1024
+	// MaxSize is the maximum size of a chunk.
+	MaxSize = 1024 * 1024
+	// maskBits is log2 of the average size of a chunk beyond MinSize.
+	maskBits = 18
+)
+
+// The boundary is tested against the top bits of the hash, which depend on
+// the previous 64 bytes of content.
+const mask = uint64(1<<maskBits-1) << (64 - maskBits)
+
+// gear maps each byte to a pseudo-random value, generated deterministically
+// so that every client and controller chooses the same boundaries.
+var gear = func() (table [256]uint64) {
+	// SplitMix64.
+	state := uint64(0x6674_6c2d_6364_6300)
+	for i := range table {
+		state += 0x9e3779b97f4a7c15
+		z := state
+		z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+		z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+		table[i] = z ^ (z >> 31)
+	}
+	return
+}()
+
+// Split "content" into chunks, which share its backing array.
+func Split(content []byte) [][]byte {
+	var chunks [][]byte
+	for len(content) > 0 {
+		n := boundary(content)
+		chunks = append(chunks, content[:n:n])
+		content = content[n:]
+	}
+	return chunks
+}
+
+// boundary returns the size of the first chunk of "content".
+func boundary(content []byte) int {
+	if len(content) <= MinSize {
+		return len(content)
+	}
+	end := min(len(content), MaxSize)
+	var hash uint64
+	for i := MinSize; i < end; i++ {
+		hash = (hash << 1) + gear[content[i]]
+		if hash&mask == 0 {
+			return i + 1
+		}
+	}
+	return end
+}
@@ -0,0 +1,40 @@
+package cdc
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+)
+
+func TestSplit(t *testing.T) {
+	assert.Equal(t, 0, len(Split(nil)))
+	assert.Equal(t, [][]byte{[]byte("small")}, Split([]byte("small")))
+
+	content := make([]byte, 8*1024*1024)
+	rand.New(rand.NewSource(1)).Read(content) //nolint:errcheck
+	chunks := Split(content)
+	assert.Equal(t, content, bytes.Join(chunks, nil))
+	for i, chunk := range chunks {
+		assert.True(t, len(chunk) <= MaxSize)
+		if i < len(chunks)-1 {
+			assert.True(t, len(chunk) >= MinSize)
+		}
+	}
+
+	// Content that is shifted by an insertion is split into the same chunks
+	// after the first.
+	shifted := Split(append([]byte("prefix"), content...))
+	seen := map[string]bool{}
+	for _, chunk := range chunks {
+		seen[string(chunk)] = true
+	}
+	shared := 0
+	for _, chunk := range shifted {
+		if seen[string(chunk)] {
+			shared++
+		}
+	}
+	assert.True(t, shared >= len(chunks)-2, "only %d of %d chunks shared", shared, len(chunks))
+}
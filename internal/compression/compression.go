@@ -0,0 +1,125 @@
+// Package compression compresses artefacts and schemas for storage and
+// transfer.
+//
+// Compressed content is a sequence of one or more zstd frames, so content
+// compressed in parts, eg. chunk by chunk, can be concatenated and
+// decompressed as a whole.
+package compression
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// Zstd is the name of zstd compression, as stored and passed in request
+// metadata.
+const Zstd = "zstd"
+
+// MaxDecompressedSize is the maximum size of content that is decompressed, so
+// that small compressed content can't exhaust memory.
+const MaxDecompressedSize = 1 << 30
+
+// maxWindowSize is the maximum window size of compressed content, which
+// bounds the memory used to decompress it. Content compressed by [Compress]
+// has a window of at most 8MiB.
+const maxWindowSize = 64 << 20
+
+// ErrTooLarge is returned if decompressed content would exceed its limit.
+var ErrTooLarge = errors.New("decompressed content is too large")
+
+// magic is the start of every zstd frame.
+var magic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+
+// Encoders and decoders are safe for concurrent use with EncodeAll and
+// DecodeAll, so are shared.
+var (
+	encoder = func() *zstd.Encoder {
+		enc, err := zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+		if err != nil {
+			panic(err)
+		}
+		return enc
+	}()
+	decoder = func() *zstd.Decoder {
+		dec, err := zstd.NewReader(nil, zstd.WithDecoderConcurrency(0), zstd.WithDecoderMaxMemory(MaxDecompressedSize), zstd.WithDecoderMaxWindow(maxWindowSize))
+		if err != nil {
+			panic(err)
+		}
+		return dec
+	}()
+)
+
+// Check returns an error if "name" is not a supported compression.
+func Check(name string) error {
+	if name != Zstd {
+		return fmt.Errorf("unsupported compression %q", name)
+	}
+	return nil
+}
+
+// Compress "content" with zstd.
+//
+// The output is deterministic, so the compressed forms of identical content
+// are identical.
+func Compress(content []byte) []byte {
+	return encoder.EncodeAll(content, make([]byte, 0, len(content)/2))
+}
+
+// Decompress content compressed with zstd, of up to [MaxDecompressedSize]
+// bytes.
+func Decompress(compressed []byte) ([]byte, error) {
+	if len(compressed) == 0 {
+		return []byte{}, nil
+	}
+	content, err := decoder.DecodeAll(compressed, nil)
+	if errors.Is(err, zstd.ErrDecoderSizeExceeded) {
+		return nil, fmt.Errorf("%w: exceeds %d bytes", ErrTooLarge, MaxDecompressedSize)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not decompress content: %w", err)
+	}
+	return content, nil
+}
+
+// DecompressLimit decompresses content compressed with zstd, returning
+// [ErrTooLarge] as soon as more than "limit" bytes are decompressed.
+func DecompressLimit(compressed []byte, limit int) ([]byte, error) {
+	if len(compressed) == 0 {
+		return []byte{}, nil
+	}
+	dec, err := zstd.NewReader(bytes.NewReader(compressed), zstd.WithDecoderConcurrency(1), zstd.WithDecoderMaxWindow(maxWindowSize))
+	if err != nil {
+		return nil, fmt.Errorf("could not decompress content: %w", err)
+	}
+	defer dec.Close()
+	content, err := io.ReadAll(io.LimitReader(dec, int64(limit)+1))
+	if err != nil {
+		return nil, fmt.Errorf("could not decompress content: %w", err)
+	}
+	if len(content) > limit {
+		return nil, fmt.Errorf("%w: exceeds %d bytes", ErrTooLarge, limit)
+	}
+	return content, nil
+}
+
+// IsCompressed returns true if "data" starts with a zstd frame.
+//
+// This can only be used to detect compression of content that can never
+// start with a zstd frame, such as protobuf encoded messages.
+func IsCompressed(data []byte) bool {
+	return bytes.HasPrefix(data, magic)
+}
+
+// CompressIfSmaller returns the compressed form of "content" and true, or
+// "content" and false if compressing it does not make it smaller, as is the
+// case for content that is already compressed.
+func CompressIfSmaller(content []byte) ([]byte, bool) {
+	compressed := Compress(content)
+	if len(compressed) >= len(content) {
+		return content, false
+	}
+	return compressed, true
+}
@@ -0,0 +1,72 @@
+package compression
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+)
+
+func TestCompression(t *testing.T) {
+	content := bytes.Repeat([]byte("hello world "), 1000)
+	compressed := Compress(content)
+	assert.True(t, len(compressed) < len(content))
+	assert.True(t, IsCompressed(compressed))
+	assert.False(t, IsCompressed(content))
+	assert.Equal(t, compressed, Compress(content), "compression should be deterministic")
+
+	decompressed, err := Decompress(compressed)
+	assert.NoError(t, err)
+	assert.Equal(t, content, decompressed)
+
+	// Content compressed in parts decompresses as a whole.
+	concatenated := append(Compress(content[:5000]), Compress(content[5000:])...)
+	decompressed, err = Decompress(concatenated)
+	assert.NoError(t, err)
+	assert.Equal(t, content, decompressed)
+
+	decompressed, err = Decompress(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(decompressed))
+
+	_, err = Decompress(content)
+	assert.Error(t, err)
+}
+
+func TestCompressIfSmaller(t *testing.T) {
+	random := make([]byte, 4096)
+	rand.New(rand.NewSource(1)).Read(random) //nolint:errcheck
+	out, ok := CompressIfSmaller(random)
+	assert.False(t, ok)
+	assert.Equal(t, random, out)
+
+	out, ok = CompressIfSmaller(make([]byte, 4096))
+	assert.True(t, ok)
+	assert.True(t, len(out) < 4096)
+}
+
+func TestCheck(t *testing.T) {
+	assert.NoError(t, Check(Zstd))
+	assert.EqualError(t, Check("gzip"), `unsupported compression "gzip"`)
+}
+
+func TestDecompressLimit(t *testing.T) {
+	// A small input that decompresses to a lot of content.
+	bomb := Compress(make([]byte, 16*1024*1024))
+	assert.True(t, len(bomb) < 4096)
+
+	_, err := DecompressLimit(bomb, 1024*1024)
+	assert.IsError(t, err, ErrTooLarge)
+
+	content, err := DecompressLimit(bomb, 16*1024*1024)
+	assert.NoError(t, err)
+	assert.Equal(t, 16*1024*1024, len(content))
+
+	content, err = DecompressLimit(nil, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(content))
+
+	_, err = DecompressLimit([]byte("hello"), 1024)
+	assert.Error(t, err)
+}
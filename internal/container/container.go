@@ -180,6 +180,21 @@ func Start(ctx context.Context, name string) error {
 	return nil
 }
 
+// Remove forcibly stops and removes the container with the given name, along with its anonymous volumes.
+func Remove(ctx context.Context, name string) error {
+	cli, err := dockerClient.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = cli.ContainerRemove(ctx, name, container.RemoveOptions{Force: true, RemoveVolumes: true})
+	if err != nil {
+		return fmt.Errorf("failed to remove container: %w", err)
+	}
+
+	return nil
+}
+
 // Exec runs a command in the given container, stream to stderr. Return an error if the command fails.
 func Exec(ctx context.Context, name string, command ...string) error {
 	logger := log.FromContext(ctx)
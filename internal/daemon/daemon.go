@@ -0,0 +1,254 @@
+// Package daemon implements the control socket of a background process.
+//
+// The socket streams the daemon's logs to attached clients, and allows clients
+// to ask the daemon to stop.
+package daemon
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+const (
+	commandAttach = "attach"
+	commandStop   = "stop"
+
+	// Number of log entries replayed to clients when they attach.
+	backlogSize = 1000
+	// Number of log entries buffered per client before entries are dropped.
+	clientBufferSize = 1000
+)
+
+var _ log.Sink = (*Server)(nil)
+
+// Server is the daemon side of the control socket.
+//
+// It is a [log.Sink], streaming all entries logged to it to attached clients.
+type Server struct {
+	listener net.Listener
+	stop     func()
+
+	lock    sync.Mutex
+	backlog []log.Entry
+	clients map[chan log.Entry]struct{}
+}
+
+// Listen on the control socket at "path".
+//
+// "stop" is called when a client requests that the daemon stop.
+func Listen(path string, stop func()) (*Server, error) {
+	if Running(path) {
+		return nil, fmt.Errorf("a daemon is already listening on %s", path)
+	}
+	// Remove any stale socket left behind by a daemon that did not exit cleanly.
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on control socket: %w", err)
+	}
+	return &Server{
+		listener: listener,
+		stop:     stop,
+		clients:  map[chan log.Entry]struct{}{},
+	}, nil
+}
+
+// Log implements [log.Sink].
+func (s *Server) Log(entry log.Entry) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.backlog = append(s.backlog, entry)
+	if len(s.backlog) > backlogSize {
+		s.backlog = s.backlog[len(s.backlog)-backlogSize:]
+	}
+	for client := range s.clients {
+		select {
+		case client <- entry:
+		default: // Drop entries for clients that can't keep up.
+		}
+	}
+	return nil
+}
+
+// Serve clients until the context is cancelled, then remove the socket.
+func (s *Server) Serve(ctx context.Context) error {
+	go func() {
+		<-ctx.Done()
+		_ = s.listener.Close() //nolint:errcheck
+	}()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("control socket failed: %w", err)
+		}
+		go s.handle(ctx, conn)
+	}
+}
+
+func (s *Server) handle(ctx context.Context, conn net.Conn) {
+	defer conn.Close() //nolint:errcheck
+	command, err := bufio.NewReader(conn).ReadString('\n')
+	if err != nil {
+		return
+	}
+	switch strings.TrimSpace(command) {
+	case commandAttach:
+		s.attach(ctx, conn)
+
+	case commandStop:
+		s.stop()
+		_, _ = io.WriteString(conn, "ok\n") //nolint:errcheck
+
+	default:
+		_, _ = fmt.Fprintf(conn, "unknown command %q\n", command) //nolint:errcheck
+	}
+}
+
+func (s *Server) attach(ctx context.Context, conn net.Conn) {
+	entries := make(chan log.Entry, clientBufferSize)
+	s.lock.Lock()
+	backlog := append([]log.Entry{}, s.backlog...)
+	s.clients[entries] = struct{}{}
+	s.lock.Unlock()
+	defer func() {
+		s.lock.Lock()
+		delete(s.clients, entries)
+		s.lock.Unlock()
+	}()
+
+	// Detect the client going away.
+	closed := make(chan struct{})
+	go func() {
+		_, _ = io.Copy(io.Discard, conn) //nolint:errcheck
+		close(closed)
+	}()
+
+	enc := json.NewEncoder(conn)
+	for _, entry := range backlog {
+		if err := enc.Encode(wireFromEntry(entry)); err != nil {
+			return
+		}
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-closed:
+			return
+		case entry := <-entries:
+			if err := enc.Encode(wireFromEntry(entry)); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// Running returns true if a daemon is listening on the control socket at "path".
+func Running(path string) bool {
+	conn, err := net.DialTimeout("unix", path, time.Second)
+	if err != nil {
+		return false
+	}
+	_ = conn.Close() //nolint:errcheck
+	return true
+}
+
+// Attach to the daemon listening at "path", logging its entries to "logger"
+// until the daemon exits or the context is cancelled.
+func Attach(ctx context.Context, path string, logger *log.Logger) error {
+	conn, err := dial(ctx, path, commandAttach)
+	if err != nil {
+		return err
+	}
+	defer conn.Close() //nolint:errcheck
+	go func() {
+		<-ctx.Done()
+		_ = conn.Close() //nolint:errcheck
+	}()
+	dec := json.NewDecoder(conn)
+	for {
+		var entry wireEntry
+		if err := dec.Decode(&entry); err != nil {
+			if ctx.Err() != nil || errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("failed to read from daemon: %w", err)
+		}
+		logger.Attrs(entry.Attributes).Log(entry.toEntry())
+	}
+}
+
+// Stop the daemon listening at "path".
+func Stop(ctx context.Context, path string) error {
+	conn, err := dial(ctx, path, commandStop)
+	if err != nil {
+		return err
+	}
+	defer conn.Close() //nolint:errcheck
+	response, err := bufio.NewReader(conn).ReadString('\n')
+	if err != nil {
+		return fmt.Errorf("failed to stop daemon: %w", err)
+	}
+	if response != "ok\n" {
+		return fmt.Errorf("failed to stop daemon: %s", strings.TrimSpace(response))
+	}
+	return nil
+}
+
+func dial(ctx context.Context, path, command string) (net.Conn, error) {
+	conn, err := (&net.Dialer{}).DialContext(ctx, "unix", path)
+	if err != nil {
+		return nil, fmt.Errorf("daemon is not running: %w", err)
+	}
+	if _, err := io.WriteString(conn, command+"\n"); err != nil {
+		_ = conn.Close() //nolint:errcheck
+		return nil, err
+	}
+	return conn, nil
+}
+
+// wireEntry is the JSON encoding of a [log.Entry] on the control socket.
+type wireEntry struct {
+	Time       time.Time         `json:"time"`
+	Level      log.Level         `json:"level"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+	Message    string            `json:"message"`
+	Error      string            `json:"error,omitempty"`
+}
+
+func wireFromEntry(entry log.Entry) wireEntry {
+	out := wireEntry{
+		Time:       entry.Time,
+		Level:      entry.Level,
+		Attributes: entry.Attributes,
+		Message:    entry.Message,
+	}
+	if entry.Error != nil {
+		out.Error = entry.Error.Error()
+	}
+	return out
+}
+
+func (w wireEntry) toEntry() log.Entry {
+	entry := log.Entry{Time: w.Time, Level: w.Level, Message: w.Message}
+	if w.Error != "" {
+		entry.Error = errors.New(w.Error)
+	}
+	return entry
+}
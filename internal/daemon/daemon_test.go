@@ -0,0 +1,84 @@
+package daemon
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+type recordingSink struct {
+	lock    sync.Mutex
+	entries []log.Entry
+}
+
+func (r *recordingSink) Log(entry log.Entry) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.entries = append(r.entries, entry)
+	return nil
+}
+
+func (r *recordingSink) messages() []string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	out := []string{}
+	for _, entry := range r.entries {
+		out = append(out, entry.Attributes["scope"]+": "+entry.Message)
+	}
+	return out
+}
+
+func TestDaemon(t *testing.T) {
+	ctx, cancel := context.WithCancel(log.ContextWithNewDefaultLogger(context.Background()))
+	defer cancel()
+	path := filepath.Join(t.TempDir(), "daemon.sock")
+
+	stopped := make(chan struct{})
+	server, err := Listen(path, func() { close(stopped) })
+	assert.NoError(t, err)
+	served := make(chan error)
+	go func() { served <- server.Serve(ctx) }()
+	assert.True(t, Running(path))
+
+	_, err = Listen(path, func() {})
+	assert.Error(t, err)
+
+	daemonLogger := log.New(log.Info, server)
+	daemonLogger.Scope("build").Infof("before attach")
+
+	sink := &recordingSink{}
+	attachCtx, detach := context.WithCancel(ctx)
+	attached := make(chan error)
+	go func() { attached <- Attach(attachCtx, path, log.New(log.Trace, sink)) }()
+
+	waitFor(t, func() bool { return len(sink.messages()) == 1 })
+	daemonLogger.Scope("deploy").Infof("after attach")
+	waitFor(t, func() bool { return len(sink.messages()) == 2 })
+	assert.Equal(t, []string{"build: before attach", "deploy: after attach"}, sink.messages())
+
+	detach()
+	assert.NoError(t, <-attached)
+
+	assert.NoError(t, Stop(ctx, path))
+	<-stopped
+	cancel()
+	assert.NoError(t, <-served)
+	assert.False(t, Running(path))
+}
+
+func waitFor(t *testing.T, condition func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
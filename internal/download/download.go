@@ -1,68 +1,211 @@
 package download
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"hash"
+	"io"
 	"os"
 	"path/filepath"
 	"time"
 
 	"connectrpc.com/connect"
+	"github.com/alecthomas/types/optional"
 
 	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
 	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/internal/compression"
 	"github.com/TBD54566975/ftl/internal/log"
 	"github.com/TBD54566975/ftl/internal/model"
+	"github.com/TBD54566975/ftl/internal/rpc/headers"
+	"github.com/TBD54566975/ftl/internal/sha256"
 )
 
+// Cache stores downloaded artefacts by digest.
+type Cache interface {
+	// Get copies a cached artefact to "dest", returning false if it is not
+	// cached.
+	Get(artefact *ftlv1.DeploymentArtefact, dest string) (bool, error)
+	// Put adds a downloaded artefact at "path" to the cache.
+	Put(artefact *ftlv1.DeploymentArtefact, path string) error
+}
+
 // Artefacts downloads artefacts for a deployment from the Controller.
+//
+// The artefacts are streamed in a single request, compressed if the
+// Controller supports it, and the content of each artefact is verified against
+// its digest. An artefact that fails verification is removed and an error is
+// returned.
 func Artefacts(ctx context.Context, client ftlv1connect.ControllerServiceClient, key model.DeploymentKey, dest string) error {
+	return CachedArtefacts(ctx, client, key, dest, nil)
+}
+
+// CachedArtefacts is like [Artefacts], but only downloads the artefacts that
+// are not in "cache", and adds those it downloads to it.
+func CachedArtefacts(ctx context.Context, client ftlv1connect.ControllerServiceClient, key model.DeploymentKey, dest string, cache Cache) error {
 	logger := log.FromContext(ctx)
-	stream, err := client.GetDeploymentArtefacts(ctx, connect.NewRequest(&ftlv1.GetDeploymentArtefactsRequest{
+	var have []*ftlv1.DeploymentArtefact
+	if cache != nil {
+		resp, err := client.GetDeployment(ctx, connect.NewRequest(&ftlv1.GetDeploymentRequest{DeploymentKey: key.String()}))
+		if err != nil {
+			return err
+		}
+		for _, artefact := range resp.Msg.Artefacts {
+			if !filepath.IsLocal(artefact.Path) {
+				return fmt.Errorf("path %q is not local", artefact.Path)
+			}
+			path := filepath.Join(dest, artefact.Path)
+			if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+				return err
+			}
+			cached, err := cache.Get(artefact, path)
+			if err != nil {
+				return err
+			}
+			if cached {
+				logger.Debugf("Using cached %s", path)
+				have = append(have, artefact)
+			}
+		}
+	}
+	req := connect.NewRequest(&ftlv1.GetDeploymentArtefactsRequest{
 		DeploymentKey: key.String(),
-	}))
+		HaveArtefacts: have,
+	})
+	headers.SetAcceptCompression(req.Header(), compression.Zstd)
+	stream, err := client.GetDeploymentArtefacts(ctx, req)
 	if err != nil {
 		return err
 	}
+	defer stream.Close()
 	start := time.Now()
 	count := 0
-	var digest string
-	var w *os.File
+	var current *artefactWriter
+	finish := func() error {
+		if current == nil {
+			return nil
+		}
+		if err := current.Close(); err != nil {
+			return err
+		}
+		if cache != nil {
+			if err := cache.Put(current.artefact, current.path); err != nil {
+				logger.Warnf("Failed to cache %s: %s", current.path, err)
+			}
+		}
+		return nil
+	}
 	for stream.Receive() {
 		msg := stream.Msg()
 		artefact := msg.Artefact
-		if digest != artefact.Digest {
-			if w != nil {
-				w.Close()
+		if current == nil || current.artefact.Digest != artefact.Digest {
+			if err := finish(); err != nil {
+				return err
 			}
 			count++
 			if !filepath.IsLocal(artefact.Path) {
 				return fmt.Errorf("path %q is not local", artefact.Path)
 			}
 			logger.Debugf("Downloading %s", filepath.Join(dest, artefact.Path))
-			err = os.MkdirAll(filepath.Join(dest, filepath.Dir(artefact.Path)), 0700)
+			current, err = newArtefactWriter(dest, artefact, headers.GetCompression(stream.ResponseHeader()))
 			if err != nil {
 				return err
 			}
-			var mode os.FileMode = 0600
-			if artefact.Executable {
-				mode = 0700
-			}
-			w, err = os.OpenFile(filepath.Join(dest, artefact.Path), os.O_CREATE|os.O_WRONLY, mode)
-			if err != nil {
-				return err
-			}
-			digest = artefact.Digest
 		}
-
-		if _, err := w.Write(msg.Chunk); err != nil {
-			_ = w.Close()
+		if _, err := current.Write(msg.Chunk); err != nil {
+			current.abort()
 			return err
 		}
 	}
-	if w != nil {
-		w.Close()
+	if err := stream.Err(); err != nil {
+		if current != nil {
+			current.abort()
+		}
+		return err
+	}
+	if err := finish(); err != nil {
+		return err
 	}
 	logger.Debugf("Downloaded %d artefacts in %s", count, time.Since(start))
-	return stream.Err()
+	return nil
+}
+
+// artefactWriter writes an artefact to a file, hashing its content so that it
+// can be verified when it is closed.
+//
+// Compressed artefacts are buffered and decompressed when they are closed.
+type artefactWriter struct {
+	artefact   *ftlv1.DeploymentArtefact
+	digest     sha256.SHA256
+	path       string
+	file       *os.File
+	hash       hash.Hash
+	w          io.Writer
+	compressed *bytes.Buffer
+}
+
+func newArtefactWriter(dest string, artefact *ftlv1.DeploymentArtefact, contentCompression optional.Option[string]) (*artefactWriter, error) {
+	var compressed *bytes.Buffer
+	if name, ok := contentCompression.Get(); ok {
+		if err := compression.Check(name); err != nil {
+			return nil, err
+		}
+		compressed = &bytes.Buffer{}
+	}
+	digest, err := sha256.ParseSHA256(artefact.Digest)
+	if err != nil {
+		return nil, fmt.Errorf("invalid digest for %s: %w", artefact.Path, err)
+	}
+	path := filepath.Join(dest, artefact.Path)
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, err
+	}
+	var mode os.FileMode = 0600
+	if artefact.Executable {
+		mode = 0700
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		return nil, err
+	}
+	h := sha256.New()
+	return &artefactWriter{artefact: artefact, digest: digest, path: path, file: file, hash: h, w: io.MultiWriter(file, h), compressed: compressed}, nil
+}
+
+func (a *artefactWriter) Write(p []byte) (int, error) {
+	if a.compressed != nil {
+		return a.compressed.Write(p)
+	}
+	return a.w.Write(p)
+}
+
+// Close the artefact, returning an error and removing it if its content does
+// not match its digest.
+func (a *artefactWriter) Close() error {
+	if a.compressed != nil {
+		content, err := compression.Decompress(a.compressed.Bytes())
+		if err != nil {
+			a.abort()
+			return fmt.Errorf("downloaded artefact %s: %w", a.path, err)
+		}
+		if _, err := a.w.Write(content); err != nil {
+			a.abort()
+			return err
+		}
+	}
+	if err := a.file.Close(); err != nil {
+		_ = os.Remove(a.path)
+		return err
+	}
+	if actual := sha256.FromBytes(a.hash.Sum(nil)); actual != a.digest {
+		_ = os.Remove(a.path)
+		return fmt.Errorf("downloaded artefact %s has digest %s, expected %s", a.path, actual, a.digest)
+	}
+	return nil
+}
+
+func (a *artefactWriter) abort() {
+	_ = a.file.Close()
+	_ = os.Remove(a.path)
 }
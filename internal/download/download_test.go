@@ -0,0 +1,114 @@
+package download
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/assert/v2"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/internal/compression"
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/model"
+	"github.com/TBD54566975/ftl/internal/rpc"
+	"github.com/TBD54566975/ftl/internal/rpc/headers"
+	"github.com/TBD54566975/ftl/internal/sha256"
+)
+
+type artefactController struct {
+	ftlv1connect.UnimplementedControllerServiceHandler
+	responses []*ftlv1.GetDeploymentArtefactsResponse
+	// Compress chunks for clients that accept compression.
+	compress bool
+}
+
+func (a *artefactController) Ping(ctx context.Context, req *connect.Request[ftlv1.PingRequest]) (*connect.Response[ftlv1.PingResponse], error) {
+	return connect.NewResponse(&ftlv1.PingResponse{}), nil
+}
+
+func (a *artefactController) GetDeploymentArtefacts(ctx context.Context, req *connect.Request[ftlv1.GetDeploymentArtefactsRequest], resp *connect.ServerStream[ftlv1.GetDeploymentArtefactsResponse]) error {
+	compress := a.compress && headers.AcceptsCompression(req.Header(), compression.Zstd)
+	if compress {
+		headers.SetCompression(resp.ResponseHeader(), compression.Zstd)
+	}
+	for _, msg := range a.responses {
+		if compress {
+			msg = &ftlv1.GetDeploymentArtefactsResponse{Artefact: msg.Artefact, Chunk: compression.Compress(msg.Chunk)}
+		}
+		if err := resp.Send(msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestArtefacts(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	controller := &artefactController{}
+	mux := http.NewServeMux()
+	mux.Handle(ftlv1connect.NewControllerServiceHandler(controller))
+	server := httptest.NewServer(h2c.NewHandler(mux, &http2.Server{}))
+	t.Cleanup(server.Close)
+	client := rpc.Dial(ftlv1connect.NewControllerServiceClient, server.URL, log.Error)
+	key := model.NewDeploymentKey("echo")
+
+	main := &ftlv1.DeploymentArtefact{Digest: sha256.Sum([]byte("hello world")).String(), Path: "main", Executable: true}
+	empty := &ftlv1.DeploymentArtefact{Digest: sha256.Sum(nil).String(), Path: "dir/empty"}
+	controller.responses = []*ftlv1.GetDeploymentArtefactsResponse{
+		{Artefact: main, Chunk: []byte("hello ")},
+		{Artefact: main, Chunk: []byte("world")},
+		{Artefact: empty},
+	}
+	dest := t.TempDir()
+	assert.NoError(t, Artefacts(ctx, client, key, dest))
+	content, err := os.ReadFile(filepath.Join(dest, "main"))
+	assert.NoError(t, err)
+	assert.Equal(t, "hello world", string(content))
+	info, err := os.Stat(filepath.Join(dest, "dir", "empty"))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), info.Size())
+
+	controller.responses = []*ftlv1.GetDeploymentArtefactsResponse{
+		{Artefact: main, Chunk: []byte("hello there")},
+	}
+	dest = t.TempDir()
+	err = Artefacts(ctx, client, key, dest)
+	assert.EqualError(t, err, "downloaded artefact "+filepath.Join(dest, "main")+" has digest "+sha256.Sum([]byte("hello there")).String()+", expected "+main.Digest)
+	_, err = os.Stat(filepath.Join(dest, "main"))
+	assert.True(t, os.IsNotExist(err), "corrupt artefacts should be removed")
+}
+
+func TestCompressedArtefacts(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	controller := &artefactController{compress: true}
+	mux := http.NewServeMux()
+	mux.Handle(ftlv1connect.NewControllerServiceHandler(controller))
+	server := httptest.NewServer(h2c.NewHandler(mux, &http2.Server{}))
+	t.Cleanup(server.Close)
+	client := rpc.Dial(ftlv1connect.NewControllerServiceClient, server.URL, log.Error)
+	key := model.NewDeploymentKey("echo")
+
+	main := &ftlv1.DeploymentArtefact{Digest: sha256.Sum([]byte("hello world")).String(), Path: "main", Executable: true}
+	empty := &ftlv1.DeploymentArtefact{Digest: sha256.Sum(nil).String(), Path: "empty"}
+	controller.responses = []*ftlv1.GetDeploymentArtefactsResponse{
+		{Artefact: main, Chunk: []byte("hello ")},
+		{Artefact: main, Chunk: []byte("world")},
+		{Artefact: empty},
+	}
+	dest := t.TempDir()
+	assert.NoError(t, Artefacts(ctx, client, key, dest))
+	content, err := os.ReadFile(filepath.Join(dest, "main"))
+	assert.NoError(t, err)
+	assert.Equal(t, "hello world", string(content))
+	info, err := os.Stat(filepath.Join(dest, "empty"))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), info.Size())
+}
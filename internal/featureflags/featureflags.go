@@ -0,0 +1,86 @@
+// Package featureflags defines the rules of module feature flags and evaluates them.
+package featureflags
+
+import (
+	"fmt"
+	"hash/fnv"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/alecthomas/types/optional"
+)
+
+var nameRe = regexp.MustCompile(`^[a-z][a-z0-9]*(-[a-z0-9]+)*$`)
+
+// ValidateName returns an error if "name" is not a valid flag name, eg. "new-checkout".
+func ValidateName(name string) error {
+	if !nameRe.MatchString(name) {
+		return fmt.Errorf("invalid flag name %q, must be lower-case words separated by hyphens", name)
+	}
+	return nil
+}
+
+// Flag is the set of rules for a feature flag of a module.
+//
+// A flag that is not enabled is off for everyone. An enabled flag is on for
+// the keys in Targets, and for Percentage percent of all other keys. If
+// neither is set, it is on for everyone.
+type Flag struct {
+	Enabled    bool                 `json:"enabled"`
+	Percentage optional.Option[int] `json:"percentage,omitempty"`
+	Targets    []string             `json:"targets,omitempty"`
+}
+
+func (f Flag) Validate() error {
+	if percentage, ok := f.Percentage.Get(); ok && (percentage < 0 || percentage > 100) {
+		return fmt.Errorf("flag percentage must be between 0 and 100, got %d", percentage)
+	}
+	return nil
+}
+
+// EnabledFor returns true if the flag "name" is on for "key".
+//
+// An empty key, such as when there is nothing to target, is only matched by
+// flags that are on for everyone.
+func (f Flag) EnabledFor(name, key string) bool {
+	if !f.Enabled {
+		return false
+	}
+	percentage, ok := f.Percentage.Get()
+	if !ok && len(f.Targets) == 0 {
+		return true
+	}
+	if key == "" {
+		return percentage >= 100
+	}
+	if slices.Contains(f.Targets, key) {
+		return true
+	}
+	return bucket(name, key) < percentage
+}
+
+// bucket assigns "key" to one of 100 buckets, so that a flag rolled out to a
+// growing percentage of keys stays on for the keys it was already on for.
+func bucket(name, key string) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(name + "/" + key)) //nolint:errcheck
+	return int(h.Sum32() % 100)
+}
+
+func (f Flag) String() string {
+	if !f.Enabled {
+		return "off"
+	}
+	rules := []string{}
+	if percentage, ok := f.Percentage.Get(); ok {
+		rules = append(rules, fmt.Sprintf("%d%%", percentage))
+	}
+	if len(f.Targets) > 0 {
+		rules = append(rules, "targets "+strings.Join(f.Targets, ","))
+	}
+	if len(rules) == 0 {
+		return "on"
+	}
+	return "on for " + strings.Join(rules, " and ")
+}
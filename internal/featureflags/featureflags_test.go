@@ -0,0 +1,55 @@
+package featureflags
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+)
+
+func TestEnabledFor(t *testing.T) {
+	assert.False(t, Flag{}.EnabledFor("checkout", "alice"))
+	assert.True(t, Flag{Enabled: true}.EnabledFor("checkout", ""))
+
+	targeted := Flag{Enabled: true, Targets: []string{"alice"}}
+	assert.True(t, targeted.EnabledFor("checkout", "alice"))
+	assert.False(t, targeted.EnabledFor("checkout", "bob"))
+	assert.False(t, targeted.EnabledFor("checkout", ""))
+
+	// Keys stay enabled as the percentage grows.
+	enabled := map[string]bool{}
+	for _, percentage := range []int{0, 10, 50, 100} {
+		flag := Flag{Enabled: true, Percentage: optional.Some(percentage)}
+		count := 0
+		for i := range 1000 {
+			key := fmt.Sprintf("user%d", i)
+			if flag.EnabledFor("checkout", key) {
+				count++
+				enabled[key] = true
+			} else {
+				assert.False(t, enabled[key], "%s was enabled at a lower percentage", key)
+			}
+		}
+		assert.True(t, count >= percentage*10-50 && count <= percentage*10+50, "%d%% enabled %d of 1000 keys", percentage, count)
+	}
+}
+
+func TestFlagJSON(t *testing.T) {
+	flag := Flag{Enabled: true, Percentage: optional.Some(25), Targets: []string{"alice"}}
+	data, err := json.Marshal(flag)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"enabled":true,"percentage":25,"targets":["alice"]}`, string(data))
+	var decoded Flag
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, flag, decoded)
+	assert.Equal(t, "on for 25% and targets alice", flag.String())
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, ValidateName("new-checkout"))
+	assert.Error(t, ValidateName("NewCheckout"))
+	assert.Error(t, ValidateName("new--checkout"))
+	assert.Error(t, Flag{Percentage: optional.Some(101)}.Validate())
+}
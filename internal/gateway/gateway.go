@@ -0,0 +1,131 @@
+// Package gateway exposes FTL services that are bound to localhost on a public
+// address, terminating TLS and optionally requiring a bearer token.
+package gateway
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"time"
+
+	"golang.org/x/crypto/acme/autocert"
+	"golang.org/x/net/http2"
+
+	"github.com/TBD54566975/ftl/internal/log"
+	"github.com/TBD54566975/ftl/internal/rpc"
+)
+
+// TLSConfig creates a TLS configuration for the gateway.
+//
+// If "domains" is non-empty, certificates for them are obtained from Let's
+// Encrypt via ACME and cached in "cacheDir". Otherwise the certificate and key
+// are loaded from "certFile" and "keyFile".
+//
+// ACME uses the TLS-ALPN-01 challenge, so the gateway must be reachable on port
+// 443 of each domain.
+func TLSConfig(certFile, keyFile string, domains []string, cacheDir string) (*tls.Config, error) {
+	if len(domains) > 0 {
+		manager := &autocert.Manager{
+			Prompt:     autocert.AcceptTOS,
+			HostPolicy: autocert.HostWhitelist(domains...),
+			Cache:      autocert.DirCache(cacheDir),
+		}
+		return manager.TLSConfig(), nil
+	}
+	if certFile == "" || keyFile == "" {
+		return nil, errors.New("either a TLS certificate and key or ACME domains must be provided")
+	}
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load TLS certificate: %w", err)
+	}
+	return &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}, nil
+}
+
+// GenerateToken returns a new random bearer token.
+func GenerateToken() (string, error) {
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(token), nil
+}
+
+// Handler returns a reverse proxy to "upstream", which must accept HTTP/2
+// without TLS, as all FTL servers do.
+//
+// If "token" is non-empty, requests must include an "Authorization: Bearer
+// <token>" header.
+func Handler(upstream *url.URL, token string) http.Handler {
+	proxy := &httputil.ReverseProxy{
+		Rewrite: func(r *httputil.ProxyRequest) {
+			r.SetURL(upstream)
+			r.SetXForwarded()
+			r.Out.Header.Del("Authorization")
+		},
+		Transport: &http2.Transport{
+			AllowHTTP: true,
+			DialTLSContext: func(ctx context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, network, addr)
+			},
+		},
+		// Flush immediately so that streaming RPCs are not buffered.
+		FlushInterval: -1,
+	}
+	if token == "" {
+		return proxy
+	}
+	expected := []byte("Bearer " + token)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/healthz" {
+			proxy.ServeHTTP(w, r)
+			return
+		}
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), expected) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		// Authenticators are verified with a HEAD request to the root of the endpoint.
+		if r.Method == http.MethodHead && r.URL.Path == "/" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		proxy.ServeHTTP(w, r)
+	})
+}
+
+// Serve "handler" with TLS on "bind" until the context is cancelled.
+func Serve(ctx context.Context, bind *url.URL, config *tls.Config, handler http.Handler) error {
+	logger := log.FromContext(ctx)
+	listener, err := net.Listen("tcp", bind.Host)
+	if err != nil {
+		return err
+	}
+	server := &http.Server{
+		Handler:           handler,
+		TLSConfig:         config,
+		ReadHeaderTimeout: time.Second * 30,
+		BaseContext:       func(net.Listener) context.Context { return ctx },
+	}
+	go func() {
+		<-ctx.Done()
+		ctx, cancel := context.WithTimeout(context.Background(), rpc.ShutdownGracePeriod)
+		defer cancel()
+		_ = server.Shutdown(ctx) //nolint:errcheck
+	}()
+	logger.Debugf("Gateway listening on %s", bind)
+	err = server.ServeTLS(listener, "", "")
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
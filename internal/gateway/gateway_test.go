@@ -0,0 +1,52 @@
+package gateway
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+)
+
+func TestHandler(t *testing.T) {
+	upstream := httptest.NewServer(h2c.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "", r.Header.Get("Authorization"))
+		_, _ = io.WriteString(w, "hello "+r.URL.Path) //nolint:errcheck
+	}), &http2.Server{}))
+	defer upstream.Close()
+	upstreamURL, err := url.Parse(upstream.URL)
+	assert.NoError(t, err)
+
+	handler := Handler(upstreamURL, "secret")
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		auth   string
+		status int
+		body   string
+	}{
+		{"Unauthenticated", http.MethodGet, "/echo", "", http.StatusUnauthorized, "unauthorized\n"},
+		{"WrongToken", http.MethodGet, "/echo", "Bearer wrong", http.StatusUnauthorized, "unauthorized\n"},
+		{"Authenticated", http.MethodGet, "/echo", "Bearer secret", http.StatusOK, "hello /echo"},
+		{"HealthCheck", http.MethodGet, "/healthz", "", http.StatusOK, "hello /healthz"},
+		{"AuthenticatorProbe", http.MethodHead, "/", "Bearer secret", http.StatusOK, ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := httptest.NewRequest(test.method, test.path, nil)
+			if test.auth != "" {
+				r.Header.Set("Authorization", test.auth)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			assert.Equal(t, test.status, w.Code)
+			assert.Equal(t, test.body, w.Body.String())
+		})
+	}
+}
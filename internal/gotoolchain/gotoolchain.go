@@ -0,0 +1,282 @@
+// Package gotoolchain downloads and caches the Go toolchains that modules are
+// built with, so that builds don't depend on the version of Go on the PATH.
+package gotoolchain
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/semver"
+
+	"github.com/TBD54566975/ftl/internal/exec"
+	"github.com/TBD54566975/ftl/internal/flock"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+// DownloadURL is where toolchains and their checksums are downloaded from.
+var DownloadURL = "https://go.dev/dl/"
+
+// Version returns the Go toolchain that the module with "goModPath" is pinned
+// to by its "toolchain" directive, eg. "go1.22.4", or "" if it isn't pinned,
+// and the minimum version of Go its "go" directive requires, eg. "1.22.2".
+func Version(goModPath string) (toolchain string, minimum string, err error) {
+	data, err := os.ReadFile(goModPath)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to read %s: %w", goModPath, err)
+	}
+	file, err := modfile.Parse(goModPath, data, nil)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to parse %s: %w", goModPath, err)
+	}
+	if file.Go == nil {
+		return "", "", fmt.Errorf("%s has no go directive", goModPath)
+	}
+	if file.Toolchain != nil && file.Toolchain.Name != "default" {
+		toolchain = file.Toolchain.Name
+	}
+	return toolchain, file.Go.Version, nil
+}
+
+// Env returns environment variables that build the module with "goModPath"
+// with its Go toolchain, downloading it into the cache if necessary.
+//
+// Only a "toolchain" directive pins the toolchain. Otherwise the module is
+// built with the go on the PATH, which must be at least the version of its
+// "go" directive.
+//
+// If GOTOOLCHAIN is set other than to "auto" it already selects a toolchain,
+// so no variables are returned. Likewise if the go on the PATH is already the
+// right version.
+func Env(ctx context.Context, goModPath string) ([]string, error) {
+	if mode := os.Getenv("GOTOOLCHAIN"); mode != "" && mode != "auto" {
+		return nil, nil
+	}
+	toolchain, minimum, err := Version(goModPath)
+	if err != nil {
+		return nil, err
+	}
+	local := localVersion(ctx)
+	if toolchain == "" {
+		// Versions that aren't releases, such as development builds, are
+		// assumed to be recent enough.
+		localSemver := "v" + strings.TrimPrefix(local, "go")
+		if semver.IsValid(localSemver) && semver.Compare(localSemver, "v"+minimum) < 0 {
+			return nil, fmt.Errorf("go version %q is not recent enough for this module, needs minimum version %q", strings.TrimPrefix(local, "go"), minimum)
+		}
+		return nil, nil
+	}
+	if local == toolchain {
+		return nil, nil
+	}
+	goroot, err := Ensure(ctx, toolchain)
+	if err != nil {
+		return nil, err
+	}
+	return []string{
+		"GOROOT=" + goroot,
+		"GOTOOLCHAIN=local",
+		"PATH=" + filepath.Join(goroot, "bin") + string(os.PathListSeparator) + os.Getenv("PATH"),
+	}, nil
+}
+
+// localVersion returns the version of the go on the PATH, if any.
+func localVersion(ctx context.Context) string {
+	cmd := exec.Command(ctx, log.Trace, ".", "go", "env", "GOVERSION")
+	cmd.Env = append(cmd.Env, "GOTOOLCHAIN=local")
+	cmd.Stdout = nil
+	cmd.Stderr = nil
+	out, err := cmd.Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// CacheDir returns the directory toolchains are cached in, shared by every
+// project.
+func CacheDir() (string, error) {
+	if dir := os.Getenv("FTL_GO_TOOLCHAIN_CACHE"); dir != "" {
+		return dir, nil
+	}
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to find cache directory: %w", err)
+	}
+	return filepath.Join(dir, "ftl", "go"), nil
+}
+
+// Ensure downloads Go toolchain "version" into the cache if it isn't already,
+// and returns its GOROOT.
+func Ensure(ctx context.Context, version string) (string, error) {
+	cache, err := CacheDir()
+	if err != nil {
+		return "", err
+	}
+	goroot := filepath.Join(cache, version)
+	if _, err := os.Stat(filepath.Join(goroot, "bin", "go")); err == nil {
+		return goroot, nil
+	}
+	if err := os.MkdirAll(cache, 0700); err != nil {
+		return "", fmt.Errorf("failed to create toolchain cache: %w", err)
+	}
+	// Builds of several modules may need the same toolchain at once.
+	unlock, err := flock.Acquire(ctx, filepath.Join(cache, version+".lock"), 10*time.Minute)
+	if err != nil {
+		return "", err
+	}
+	defer unlock() //nolint:errcheck
+	if _, err := os.Stat(filepath.Join(goroot, "bin", "go")); err == nil {
+		return goroot, nil
+	}
+
+	logger := log.FromContext(ctx)
+	logger.Infof("Downloading Go toolchain %s", version)
+	file, err := findFile(ctx, version)
+	if err != nil {
+		return "", err
+	}
+	tmp, err := os.MkdirTemp(cache, "."+version+"-")
+	if err != nil {
+		return "", fmt.Errorf("failed to create toolchain directory: %w", err)
+	}
+	defer os.RemoveAll(tmp)
+	if err := download(ctx, file, tmp); err != nil {
+		return "", fmt.Errorf("failed to download Go toolchain %s: %w", version, err)
+	}
+	// Archives contain a single "go" directory.
+	if err := os.Rename(filepath.Join(tmp, "go"), goroot); err != nil {
+		return "", fmt.Errorf("failed to install Go toolchain %s: %w", version, err)
+	}
+	return goroot, nil
+}
+
+type release struct {
+	Version string        `json:"version"`
+	Files   []releaseFile `json:"files"`
+}
+
+type releaseFile struct {
+	Filename string `json:"filename"`
+	OS       string `json:"os"`
+	Arch     string `json:"arch"`
+	SHA256   string `json:"sha256"`
+	Kind     string `json:"kind"`
+}
+
+// findFile returns the archive of toolchain "version" for this platform.
+func findFile(ctx context.Context, version string) (releaseFile, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, DownloadURL+"?mode=json&include=all", nil)
+	if err != nil {
+		return releaseFile{}, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return releaseFile{}, fmt.Errorf("failed to list Go releases: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return releaseFile{}, fmt.Errorf("failed to list Go releases: %s", resp.Status)
+	}
+	var releases []release
+	if err := json.NewDecoder(resp.Body).Decode(&releases); err != nil {
+		return releaseFile{}, fmt.Errorf("failed to decode Go releases: %w", err)
+	}
+	for _, r := range releases {
+		if r.Version != version {
+			continue
+		}
+		for _, file := range r.Files {
+			if file.OS == runtime.GOOS && file.Arch == runtime.GOARCH && file.Kind == "archive" && strings.HasSuffix(file.Filename, ".tar.gz") {
+				return file, nil
+			}
+		}
+		return releaseFile{}, fmt.Errorf("go toolchain %s is not available for %s/%s", version, runtime.GOOS, runtime.GOARCH)
+	}
+	return releaseFile{}, fmt.Errorf("unknown go toolchain %s", version)
+}
+
+// download and extract "file" into "dir", verifying its checksum.
+func download(ctx context.Context, file releaseFile, dir string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, DownloadURL+file.Filename, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: %s", file.Filename, resp.Status)
+	}
+	hash := sha256.New()
+	body := io.TeeReader(resp.Body, hash)
+	gz, err := gzip.NewReader(body)
+	if err != nil {
+		return err
+	}
+	if err := untar(tar.NewReader(gz), dir); err != nil {
+		return err
+	}
+	// Read the rest of the archive so that all of it is included in the hash.
+	if _, err := io.Copy(io.Discard, body); err != nil {
+		return err
+	}
+	if sum := hex.EncodeToString(hash.Sum(nil)); sum != file.SHA256 {
+		return fmt.Errorf("%s has checksum %s, expected %s", file.Filename, sum, file.SHA256)
+	}
+	return nil
+}
+
+func untar(r *tar.Reader, dir string) error {
+	for {
+		header, err := r.Next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		path := filepath.Join(dir, header.Name) //nolint:gosec
+		if !strings.HasPrefix(path, filepath.Clean(dir)+string(os.PathSeparator)) {
+			return fmt.Errorf("invalid file path: %q", header.Name)
+		}
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(path, 0700); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+				return err
+			}
+			w, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, os.FileMode(header.Mode).Perm())
+			if err != nil {
+				return err
+			}
+			_, err = io.Copy(w, r) //nolint:gosec
+			if cerr := w.Close(); err == nil {
+				err = cerr
+			}
+			if err != nil {
+				return err
+			}
+		case tar.TypeSymlink:
+			if err := os.Symlink(header.Linkname, path); err != nil {
+				return err
+			}
+		}
+	}
+}
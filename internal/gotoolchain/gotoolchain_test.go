@@ -0,0 +1,122 @@
+package gotoolchain
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+func TestVersion(t *testing.T) {
+	tests := []struct {
+		name      string
+		goMod     string
+		toolchain string
+		minimum   string
+	}{
+		{"Toolchain", "module test\n\ngo 1.22.2\n\ntoolchain go1.22.3\n", "go1.22.3", "1.22.2"},
+		{"DefaultToolchain", "module test\n\ngo 1.22.2\n\ntoolchain default\n", "", "1.22.2"},
+		{"GoPatch", "module test\n\ngo 1.22.2\n", "", "1.22.2"},
+		{"GoMinor", "module test\n\ngo 1.22\n", "", "1.22"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			goMod := filepath.Join(t.TempDir(), "go.mod")
+			assert.NoError(t, os.WriteFile(goMod, []byte(test.goMod), 0600))
+			toolchain, minimum, err := Version(goMod)
+			assert.NoError(t, err)
+			assert.Equal(t, test.toolchain, toolchain)
+			assert.Equal(t, test.minimum, minimum)
+		})
+	}
+}
+
+func TestEnv(t *testing.T) {
+	t.Setenv("GOTOOLCHAIN", "auto")
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	local := localVersion(ctx)
+	if local == "" {
+		t.Skip("go is not on the PATH")
+	}
+
+	// The go on the PATH satisfies the minimum version, so nothing is
+	// downloaded.
+	goMod := filepath.Join(t.TempDir(), "go.mod")
+	assert.NoError(t, os.WriteFile(goMod, []byte("module test\n\ngo 1.20\n"), 0600))
+	env, err := Env(ctx, goMod)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(env))
+
+	assert.NoError(t, os.WriteFile(goMod, []byte("module test\n\ngo 9000.1.1\n"), 0600))
+	_, err = Env(ctx, goMod)
+	assert.EqualError(t, err, fmt.Sprintf("go version %q is not recent enough for this module, needs minimum version \"9000.1.1\"", strings.TrimPrefix(local, "go")))
+
+	assert.NoError(t, os.WriteFile(goMod, []byte("module test\n\ngo 1.20\n\ntoolchain "+local+"\n"), 0600))
+	env, err = Env(ctx, goMod)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(env))
+}
+
+func TestEnsure(t *testing.T) {
+	archive := &bytes.Buffer{}
+	gz := gzip.NewWriter(archive)
+	tw := tar.NewWriter(gz)
+	script := []byte("#!/bin/sh\necho go1.99.1\n")
+	assert.NoError(t, tw.WriteHeader(&tar.Header{Name: "go/bin/go", Mode: 0700, Size: int64(len(script)), Typeflag: tar.TypeReg}))
+	_, err := tw.Write(script)
+	assert.NoError(t, err)
+	assert.NoError(t, tw.Close())
+	assert.NoError(t, gz.Close())
+	sum := sha256.Sum256(archive.Bytes())
+
+	filename := "go1.99.1." + runtime.GOOS + "-" + runtime.GOARCH + ".tar.gz"
+	downloads := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/":
+			_ = json.NewEncoder(w).Encode([]release{{Version: "go1.99.1", Files: []releaseFile{{ //nolint:errchkjson
+				Filename: filename, OS: runtime.GOOS, Arch: runtime.GOARCH, SHA256: hex.EncodeToString(sum[:]), Kind: "archive",
+			}}}})
+		case "/" + filename:
+			downloads++
+			_, _ = w.Write(archive.Bytes())
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+	oldURL := DownloadURL
+	DownloadURL = server.URL + "/"
+	t.Cleanup(func() { DownloadURL = oldURL })
+	t.Setenv("FTL_GO_TOOLCHAIN_CACHE", t.TempDir())
+
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	goroot, err := Ensure(ctx, "go1.99.1")
+	assert.NoError(t, err)
+	data, err := os.ReadFile(filepath.Join(goroot, "bin", "go"))
+	assert.NoError(t, err)
+	assert.Equal(t, script, data)
+
+	// Toolchains are only downloaded once.
+	_, err = Ensure(ctx, "go1.99.1")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, downloads)
+
+	_, err = Ensure(ctx, "go1.99.2")
+	assert.EqualError(t, err, "unknown go toolchain go1.99.2")
+}
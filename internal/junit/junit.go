@@ -0,0 +1,194 @@
+// Package junit converts "go test -json" output into JUnit XML reports.
+package junit
+
+import (
+	"bufio"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// TestSuites is the root element of a JUnit XML report.
+type TestSuites struct {
+	XMLName  xml.Name    `xml:"testsuites"`
+	Tests    int         `xml:"tests,attr"`
+	Failures int         `xml:"failures,attr"`
+	Skipped  int         `xml:"skipped,attr"`
+	Suites   []TestSuite `xml:"testsuite"`
+}
+
+// TestSuite contains the results of a single Go package.
+type TestSuite struct {
+	Name     string     `xml:"name,attr"`
+	Tests    int        `xml:"tests,attr"`
+	Failures int        `xml:"failures,attr"`
+	Skipped  int        `xml:"skipped,attr"`
+	Time     string     `xml:"time,attr"`
+	Cases    []TestCase `xml:"testcase"`
+}
+
+// TestCase is the result of a single test.
+type TestCase struct {
+	Name      string   `xml:"name,attr"`
+	ClassName string   `xml:"classname,attr"`
+	Time      string   `xml:"time,attr"`
+	Failure   *Message `xml:"failure,omitempty"`
+	Skipped   *Message `xml:"skipped,omitempty"`
+}
+
+// Message is the body of a failure or skip.
+type Message struct {
+	Message  string `xml:"message,attr"`
+	Contents string `xml:",chardata"`
+}
+
+// Failed returns true if any test failed.
+func (t *TestSuites) Failed() bool { return t.Failures > 0 }
+
+// Write the report as XML.
+func (t *TestSuites) Write(w io.Writer) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(t); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// Merge the suites of another report into this one.
+func (t *TestSuites) Merge(other *TestSuites) {
+	t.Tests += other.Tests
+	t.Failures += other.Failures
+	t.Skipped += other.Skipped
+	t.Suites = append(t.Suites, other.Suites...)
+}
+
+// event is a single "go test -json" event. See "go doc test2json".
+type event struct {
+	Action  string
+	Package string
+	Test    string
+	Elapsed float64
+	Output  string
+}
+
+type result struct {
+	action  string
+	elapsed float64
+	output  strings.Builder
+}
+
+// FromGoTestJSON parses the output of "go test -json" into a JUnit report.
+//
+// If echo is non-nil, test output is written to it as it is read.
+func FromGoTestJSON(r io.Reader, echo io.Writer) (*TestSuites, error) {
+	packages := map[string]map[string]*result{}
+	packageElapsed := map[string]float64{}
+	packageFailed := map[string]bool{}
+	packageOutput := map[string]*strings.Builder{}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		ev := event{}
+		if err := json.Unmarshal(line, &ev); err != nil {
+			// Non-JSON output, eg. from a build failure.
+			if echo != nil {
+				fmt.Fprintf(echo, "%s\n", line)
+			}
+			continue
+		}
+		if echo != nil && ev.Output != "" {
+			fmt.Fprint(echo, ev.Output)
+		}
+		tests, ok := packages[ev.Package]
+		if !ok {
+			tests = map[string]*result{}
+			packages[ev.Package] = tests
+		}
+		if ev.Test == "" {
+			switch ev.Action {
+			case "output":
+				if _, ok := packageOutput[ev.Package]; !ok {
+					packageOutput[ev.Package] = &strings.Builder{}
+				}
+				packageOutput[ev.Package].WriteString(ev.Output)
+			case "pass", "fail", "skip":
+				packageElapsed[ev.Package] = ev.Elapsed
+				packageFailed[ev.Package] = ev.Action == "fail"
+			}
+			continue
+		}
+		res, ok := tests[ev.Test]
+		if !ok {
+			res = &result{}
+			tests[ev.Test] = res
+		}
+		switch ev.Action {
+		case "output":
+			res.output.WriteString(ev.Output)
+		case "pass", "fail", "skip":
+			res.action = ev.Action
+			res.elapsed = ev.Elapsed
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	out := &TestSuites{}
+	pkgNames := make([]string, 0, len(packages))
+	for name := range packages {
+		pkgNames = append(pkgNames, name)
+	}
+	sort.Strings(pkgNames)
+	for _, pkg := range pkgNames {
+		tests := packages[pkg]
+		suite := TestSuite{Name: pkg, Time: formatSeconds(packageElapsed[pkg])}
+		names := make([]string, 0, len(tests))
+		for name := range tests {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			res := tests[name]
+			tc := TestCase{Name: name, ClassName: pkg, Time: formatSeconds(res.elapsed)}
+			switch res.action {
+			case "fail", "":
+				tc.Failure = &Message{Message: "Failed", Contents: res.output.String()}
+				suite.Failures++
+			case "skip":
+				tc.Skipped = &Message{Message: "Skipped", Contents: res.output.String()}
+				suite.Skipped++
+			}
+			suite.Tests++
+			suite.Cases = append(suite.Cases, tc)
+		}
+		// A package can fail without any failing tests, eg. if it does not compile.
+		if packageFailed[pkg] && suite.Failures == 0 {
+			output := ""
+			if buf, ok := packageOutput[pkg]; ok {
+				output = buf.String()
+			}
+			suite.Cases = append(suite.Cases, TestCase{Name: pkg, ClassName: pkg, Time: suite.Time, Failure: &Message{Message: "Package failed", Contents: output}})
+			suite.Tests++
+			suite.Failures++
+		}
+		out.Tests += suite.Tests
+		out.Failures += suite.Failures
+		out.Skipped += suite.Skipped
+		out.Suites = append(out.Suites, suite)
+	}
+	return out, nil
+}
+
+func formatSeconds(s float64) string {
+	return fmt.Sprintf("%.3f", s)
+}
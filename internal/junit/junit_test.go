@@ -0,0 +1,58 @@
+package junit
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+)
+
+func TestFromGoTestJSON(t *testing.T) {
+	input := `{"Action":"run","Package":"ftl/echo","Test":"TestEcho"}
+{"Action":"output","Package":"ftl/echo","Test":"TestEcho","Output":"=== RUN   TestEcho\n"}
+{"Action":"pass","Package":"ftl/echo","Test":"TestEcho","Elapsed":0.01}
+{"Action":"run","Package":"ftl/echo","Test":"TestBroken"}
+{"Action":"output","Package":"ftl/echo","Test":"TestBroken","Output":"echo_test.go:10: boom\n"}
+{"Action":"fail","Package":"ftl/echo","Test":"TestBroken","Elapsed":0.02}
+{"Action":"run","Package":"ftl/echo","Test":"TestSkipped"}
+{"Action":"skip","Package":"ftl/echo","Test":"TestSkipped","Elapsed":0}
+{"Action":"fail","Package":"ftl/echo","Elapsed":0.5}
+`
+	echo := &strings.Builder{}
+	report, err := FromGoTestJSON(strings.NewReader(input), echo)
+	assert.NoError(t, err)
+	assert.Equal(t, "=== RUN   TestEcho\necho_test.go:10: boom\n", echo.String())
+	assert.True(t, report.Failed())
+	assert.Equal(t, &TestSuites{
+		Tests:    3,
+		Failures: 1,
+		Skipped:  1,
+		Suites: []TestSuite{{
+			Name:     "ftl/echo",
+			Tests:    3,
+			Failures: 1,
+			Skipped:  1,
+			Time:     "0.500",
+			Cases: []TestCase{
+				{Name: "TestBroken", ClassName: "ftl/echo", Time: "0.020", Failure: &Message{Message: "Failed", Contents: "echo_test.go:10: boom\n"}},
+				{Name: "TestEcho", ClassName: "ftl/echo", Time: "0.010"},
+				{Name: "TestSkipped", ClassName: "ftl/echo", Time: "0.000", Skipped: &Message{Message: "Skipped"}},
+			},
+		}},
+	}, report)
+
+	xml := &strings.Builder{}
+	assert.NoError(t, report.Write(xml))
+	assert.Contains(t, xml.String(), `<testsuites tests="3" failures="1" skipped="1">`)
+}
+
+func TestPackageFailure(t *testing.T) {
+	input := `{"Action":"output","Package":"ftl/echo","Output":"# ftl/echo\n"}
+{"Action":"output","Package":"ftl/echo","Output":"echo.go:3:1: syntax error\n"}
+{"Action":"fail","Package":"ftl/echo","Elapsed":0}
+`
+	report, err := FromGoTestJSON(strings.NewReader(input), nil)
+	assert.NoError(t, err)
+	assert.True(t, report.Failed())
+	assert.Equal(t, "# ftl/echo\necho.go:3:1: syntax error\n", report.Suites[0].Cases[0].Failure.Contents)
+}
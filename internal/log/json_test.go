@@ -0,0 +1,32 @@
+package log
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+)
+
+type recordingSink struct {
+	entries []Entry
+}
+
+func (r *recordingSink) Log(entry Entry) error {
+	r.entries = append(r.entries, entry)
+	return nil
+}
+
+func TestJSONStreamerPreservesAttributes(t *testing.T) {
+	w := &bytes.Buffer{}
+	New(Info, newJSONSink(w)).Scope("module").With("order_id", 42).Infof("Processed order")
+
+	sink := &recordingSink{}
+	err := JSONStreamer(w, New(Info, sink).Attrs(map[string]string{"deployment": "dpl-echo-1", "scope": "runner"}), Error)
+	assert.NoError(t, err)
+	assert.Equal(t, []Entry{{
+		Level:      Info,
+		Attributes: map[string]string{"deployment": "dpl-echo-1", "order_id": "42", "scope": "runner"},
+		Message:    "Processed order",
+	}}, sink.entries, assert.Exclude[time.Time]())
+}
@@ -29,6 +29,7 @@ type Logger struct {
 	level      Level
 	attributes map[string]string
 	sink       Sink
+	override   *LevelOverride
 }
 
 // New returns a new logger.
@@ -44,6 +45,13 @@ func (l Logger) Scope(scope string) *Logger {
 	return l.Attrs(map[string]string{scopeKey: scope})
 }
 
+// With creates a new logger with a structured attribute.
+//
+// The value is formatted with fmt.Sprint.
+func (l Logger) With(key string, value any) *Logger {
+	return l.Attrs(map[string]string{key: fmt.Sprint(value)})
+}
+
 // Attrs creates a new logger with the given attributes.
 func (l Logger) Attrs(attributes map[string]string) *Logger {
 	attr := make(map[string]string, len(l.attributes)+len(attributes))
@@ -63,18 +71,35 @@ func (l Logger) Level(level Level) *Logger {
 	return &l
 }
 
+// GetLevel returns the effective level of the logger, taking any override into account.
 func (l Logger) GetLevel() Level {
+	if l.override != nil {
+		if level := l.override.Get(); level != Default {
+			return level
+		}
+	}
 	return l.level
 }
 
+// Log an entry.
+//
+// Attributes of the entry are merged with those of the logger, with the
+// logger's attributes taking precedence.
 func (l *Logger) Log(entry Entry) {
-	if entry.Level < l.level {
+	if entry.Level < l.GetLevel() {
 		return
 	}
 	if entry.Time.IsZero() {
 		entry.Time = time.Now()
 	}
-	entry.Attributes = l.attributes
+	if len(entry.Attributes) == 0 {
+		entry.Attributes = l.attributes
+	} else {
+		attrs := make(map[string]string, len(entry.Attributes)+len(l.attributes))
+		maps.Copy(attrs, entry.Attributes)
+		maps.Copy(attrs, l.attributes)
+		entry.Attributes = attrs
+	}
 	if err := l.sink.Log(entry); err != nil {
 		fmt.Fprintf(os.Stderr, "ftl:log: failed to log entry: %v", err)
 	}
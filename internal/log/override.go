@@ -0,0 +1,29 @@
+package log
+
+import (
+	"sync/atomic"
+)
+
+// LevelOverride is a log level that can be changed at runtime.
+//
+// While set, it takes precedence over the level of every logger it is attached
+// to with [Logger.LevelOverride]. The zero value is unset.
+type LevelOverride struct {
+	level atomic.Int32
+}
+
+// Set the override, or clear it if "level" is [Default].
+func (o *LevelOverride) Set(level Level) {
+	o.level.Store(int32(level))
+}
+
+// Get the override, or [Default] if it is not set.
+func (o *LevelOverride) Get() Level {
+	return Level(o.level.Load())
+}
+
+// LevelOverride creates a new logger whose level is overridden by "override" while it is set.
+func (l Logger) LevelOverride(override *LevelOverride) *Logger {
+	l.override = override
+	return &l
+}
@@ -0,0 +1,27 @@
+package log
+
+import (
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+)
+
+func TestLevelOverride(t *testing.T) {
+	sink := &recordingSink{}
+	override := &LevelOverride{}
+	logger := New(Info, sink).LevelOverride(override).Scope("module")
+
+	logger.Debugf("hidden")
+	override.Set(Debug)
+	assert.Equal(t, Debug, logger.GetLevel())
+	logger.Debugf("shown")
+	override.Set(Default)
+	assert.Equal(t, Info, logger.GetLevel())
+	logger.Debugf("hidden again")
+
+	messages := []string{}
+	for _, entry := range sink.entries {
+		messages = append(messages, entry.Message)
+	}
+	assert.Equal(t, []string{"shown"}, messages)
+}
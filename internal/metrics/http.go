@@ -0,0 +1,138 @@
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/exp/maps"
+)
+
+// Path metrics are served on by modules, runners and the controller.
+const Path = "/metrics"
+
+// JSONHandler serves the snapshot returned by "snapshot" as JSON, for scraping with [Scrape].
+func JSONHandler(snapshot func(ctx context.Context) ([]Sample, error)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		samples, err := snapshot(r.Context())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(samples) //nolint:errcheck
+	})
+}
+
+// Scrape the metrics served by a [JSONHandler] at "endpoint".
+//
+// Endpoints that do not serve metrics, eg. modules built with an older
+// version of FTL, have no metrics.
+func Scrape(ctx context.Context, client *http.Client, endpoint string) ([]Sample, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(endpoint, "/")+Path, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scrape metrics: %w", err)
+	}
+	defer resp.Body.Close() //nolint:errcheck
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to scrape metrics from %s: %s", endpoint, resp.Status)
+	}
+	var samples []Sample
+	if err := json.NewDecoder(resp.Body).Decode(&samples); err != nil {
+		return nil, fmt.Errorf("invalid metrics from %s: %w", endpoint, err)
+	}
+	return samples, nil
+}
+
+// WriteText writes samples in the Prometheus text exposition format.
+func WriteText(w io.Writer, samples []Sample) error {
+	sortSamples(samples)
+	lastName := ""
+	for _, sample := range samples {
+		if sample.Name != lastName {
+			if _, err := fmt.Fprintf(w, "# TYPE %s %s\n", sample.Name, sample.Kind); err != nil {
+				return err
+			}
+			lastName = sample.Name
+		}
+		var err error
+		switch sample.Kind {
+		case KindCounter:
+			err = writeLine(w, sample.Name, sample.Labels, "", sample.Value)
+
+		case KindHistogram:
+			cumulative := uint64(0)
+			for i, count := range sample.Counts {
+				cumulative += count
+				bound := math.Inf(1)
+				if i < len(sample.Buckets) {
+					bound = sample.Buckets[i]
+				}
+				if err = writeLine(w, sample.Name+"_bucket", sample.Labels, formatFloat(bound), float64(cumulative)); err != nil {
+					return err
+				}
+			}
+			if err = writeLine(w, sample.Name+"_sum", sample.Labels, "", sample.Sum); err != nil {
+				return err
+			}
+			err = writeLine(w, sample.Name+"_count", sample.Labels, "", float64(sample.Count))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TextHandler serves the snapshot returned by "snapshot" in the Prometheus text exposition format.
+func TextHandler(snapshot func(ctx context.Context) ([]Sample, error)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		samples, err := snapshot(r.Context())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		_ = WriteText(w, samples) //nolint:errcheck
+	})
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func writeLine(w io.Writer, name string, labels map[string]string, le string, value float64) error {
+	keys := maps.Keys(labels)
+	sort.Strings(keys)
+	pairs := make([]string, 0, len(keys)+1)
+	for _, key := range keys {
+		pairs = append(pairs, key+`="`+labelEscaper.Replace(labels[key])+`"`)
+	}
+	if le != "" {
+		pairs = append(pairs, `le="`+le+`"`)
+	}
+	series := name
+	if len(pairs) > 0 {
+		series += "{" + strings.Join(pairs, ",") + "}"
+	}
+	_, err := fmt.Fprintf(w, "%s %s\n", series, formatFloat(value))
+	return err
+}
+
+func formatFloat(value float64) string {
+	if math.IsInf(value, 1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
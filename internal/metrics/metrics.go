@@ -0,0 +1,167 @@
+// Package metrics implements the counters and histograms declared by modules.
+//
+// Each module keeps its metrics in a [Registry]. Runners scrape the registry of
+// their module, and the controller aggregates the metrics of all runners and
+// exposes them in the Prometheus text format.
+package metrics
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"golang.org/x/exp/maps"
+)
+
+// Kind of a metric.
+type Kind string
+
+const (
+	KindCounter   Kind = "counter"
+	KindHistogram Kind = "histogram"
+)
+
+// DefaultBuckets are the upper bounds of the buckets of histograms declared
+// without explicit buckets.
+var DefaultBuckets = []float64{1, 2.5, 5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000, 10000}
+
+// A Sample is the current value of a metric with a set of labels.
+type Sample struct {
+	Name   string            `json:"name"`
+	Kind   Kind              `json:"kind"`
+	Labels map[string]string `json:"labels,omitempty"`
+	// Value of a counter.
+	Value float64 `json:"value,omitempty"`
+	// Upper bounds of the buckets of a histogram.
+	Buckets []float64 `json:"buckets,omitempty"`
+	// Number of observations in each bucket of a histogram, not cumulative.
+	// The final count is for observations greater than the last bound.
+	Counts []uint64 `json:"counts,omitempty"`
+	// Sum of the observations of a histogram.
+	Sum float64 `json:"sum,omitempty"`
+	// Count of the observations of a histogram.
+	Count uint64 `json:"count,omitempty"`
+}
+
+func (s *Sample) key() string {
+	keys := maps.Keys(s.Labels)
+	sort.Strings(keys)
+	out := &strings.Builder{}
+	out.WriteString(s.Name)
+	for _, key := range keys {
+		fmt.Fprintf(out, "\x00%s=%s", key, s.Labels[key])
+	}
+	return out.String()
+}
+
+func (s *Sample) merge(other Sample) error {
+	if s.Kind != other.Kind {
+		return fmt.Errorf("metric %q is both a %s and a %s", s.Name, s.Kind, other.Kind)
+	}
+	switch s.Kind {
+	case KindCounter:
+		s.Value += other.Value
+
+	case KindHistogram:
+		if !slices.Equal(s.Buckets, other.Buckets) {
+			return fmt.Errorf("histogram %q has inconsistent buckets", s.Name)
+		}
+		for i, count := range other.Counts {
+			s.Counts[i] += count
+		}
+		s.Sum += other.Sum
+		s.Count += other.Count
+	}
+	return nil
+}
+
+// Registry of the metrics of a module.
+type Registry struct {
+	lock    sync.Mutex
+	samples map[string]*Sample
+}
+
+// NewRegistry creates a new, empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{samples: map[string]*Sample{}}
+}
+
+// Add "delta" to a counter.
+func (r *Registry) Add(name string, labels map[string]string, delta float64) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	sample := r.sample(name, KindCounter, labels, nil)
+	sample.Value += delta
+}
+
+// Observe a value in a histogram.
+func (r *Registry) Observe(name string, labels map[string]string, buckets []float64, value float64) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	sample := r.sample(name, KindHistogram, labels, buckets)
+	sample.Counts[sort.SearchFloat64s(sample.Buckets, value)]++
+	sample.Sum += value
+	sample.Count++
+}
+
+func (r *Registry) sample(name string, kind Kind, labels map[string]string, buckets []float64) *Sample {
+	candidate := &Sample{Name: name, Kind: kind, Labels: labels}
+	key := candidate.key()
+	if sample, ok := r.samples[key]; ok {
+		return sample
+	}
+	if kind == KindHistogram {
+		candidate.Buckets = slices.Clone(buckets)
+		candidate.Counts = make([]uint64, len(buckets)+1)
+	}
+	candidate.Labels = maps.Clone(labels)
+	r.samples[key] = candidate
+	return candidate
+}
+
+// Snapshot returns the current value of every metric in the registry.
+func (r *Registry) Snapshot() []Sample {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	out := make([]Sample, 0, len(r.samples))
+	for _, sample := range r.samples {
+		clone := *sample
+		clone.Labels = maps.Clone(sample.Labels)
+		clone.Buckets = slices.Clone(sample.Buckets)
+		clone.Counts = slices.Clone(sample.Counts)
+		out = append(out, clone)
+	}
+	sortSamples(out)
+	return out
+}
+
+// Merge snapshots, summing samples of the same metric with the same labels.
+func Merge(snapshots ...[]Sample) ([]Sample, error) {
+	merged := map[string]*Sample{}
+	for _, snapshot := range snapshots {
+		for _, sample := range snapshot {
+			key := sample.key()
+			if existing, ok := merged[key]; ok {
+				if err := existing.merge(sample); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			clone := sample
+			clone.Counts = slices.Clone(sample.Counts)
+			merged[key] = &clone
+		}
+	}
+	out := make([]Sample, 0, len(merged))
+	for _, sample := range merged {
+		out = append(out, *sample)
+	}
+	sortSamples(out)
+	return out, nil
+}
+
+func sortSamples(samples []Sample) {
+	sort.Slice(samples, func(i, j int) bool { return samples[i].key() < samples[j].key() })
+}
@@ -0,0 +1,63 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+)
+
+func TestMergeAndWriteText(t *testing.T) {
+	labels := map[string]string{"module": "orders", "verb": "create"}
+	a := NewRegistry()
+	a.Add("orders_created", labels, 1)
+	a.Add("orders_created", labels, 2)
+	a.Observe("charge_latency_ms", labels, []float64{10, 100}, 5)
+	a.Observe("charge_latency_ms", labels, []float64{10, 100}, 50)
+
+	b := NewRegistry()
+	b.Add("orders_created", labels, 4)
+	b.Add("orders_created", map[string]string{"module": "orders", "verb": "import"}, 1)
+	b.Observe("charge_latency_ms", labels, []float64{10, 100}, 500)
+
+	merged, err := Merge(a.Snapshot(), b.Snapshot())
+	assert.NoError(t, err)
+
+	out := &strings.Builder{}
+	assert.NoError(t, WriteText(out, merged))
+	assert.Equal(t, `# TYPE charge_latency_ms histogram
+charge_latency_ms_bucket{module="orders",verb="create",le="10"} 1
+charge_latency_ms_bucket{module="orders",verb="create",le="100"} 2
+charge_latency_ms_bucket{module="orders",verb="create",le="+Inf"} 3
+charge_latency_ms_sum{module="orders",verb="create"} 555
+charge_latency_ms_count{module="orders",verb="create"} 3
+# TYPE orders_created counter
+orders_created{module="orders",verb="create"} 7
+orders_created{module="orders",verb="import"} 1
+`, out.String())
+}
+
+func TestMergeConflictingKinds(t *testing.T) {
+	a := NewRegistry()
+	a.Add("latency", nil, 1)
+	b := NewRegistry()
+	b.Observe("latency", nil, DefaultBuckets, 1)
+	_, err := Merge(a.Snapshot(), b.Snapshot())
+	assert.EqualError(t, err, `metric "latency" is both a counter and a histogram`)
+}
+
+func TestScrape(t *testing.T) {
+	registry := NewRegistry()
+	registry.Add("orders_created", map[string]string{"module": "orders"}, 3)
+	server := httptest.NewServer(JSONHandler(func(ctx context.Context) ([]Sample, error) {
+		return registry.Snapshot(), nil
+	}))
+	t.Cleanup(server.Close)
+
+	samples, err := Scrape(context.Background(), http.DefaultClient, server.URL)
+	assert.NoError(t, err)
+	assert.Equal(t, registry.Snapshot(), samples)
+}
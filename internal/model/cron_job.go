@@ -21,4 +21,6 @@ type CronJob struct {
 	StartTime     time.Time
 	NextExecution time.Time
 	State         CronJobState
+	// Paused jobs advance through their schedule without being executed.
+	Paused bool
 }
@@ -15,6 +15,7 @@ type Deployment struct {
 	Language  string
 	Key       DeploymentKey
 	Schema    *schema.Module
+	Labels    Labels
 	Artefacts []*Artefact
 }
 
@@ -37,6 +38,17 @@ type Artefact struct {
 
 type Labels map[string]any
 
+// ProjectLabel is the deployment label naming the project a module was
+// deployed from.
+const ProjectLabel = "project"
+
+// Deployment labels describing a module, from its ftl.toml and README.
+const (
+	OwnerLabel  = "owner"
+	ReadmeLabel = "readme"
+	LinksLabel  = "links"
+)
+
 func (l Labels) String() string {
 	w := strings.Builder{}
 	i := 0
@@ -0,0 +1,56 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// OSLabel is the runner and deployment label naming the operating system
+	// that a runner runs on, or that a deployment was built for.
+	OSLabel = "os"
+	// ArchLabel is the runner and deployment label naming the architecture
+	// that a runner runs on, or that a deployment was built for.
+	ArchLabel = "arch"
+)
+
+// Platform that modules are built for, eg. linux/amd64.
+//
+// The zero value is the platform FTL is running on.
+type Platform struct {
+	OS   string
+	Arch string
+}
+
+// ParsePlatform parses a platform in the form "<os>/<arch>".
+func ParsePlatform(platform string) (Platform, error) {
+	var p Platform
+	if err := p.UnmarshalText([]byte(platform)); err != nil {
+		return Platform{}, err
+	}
+	return p, nil
+}
+
+func (p Platform) IsZero() bool { return p == Platform{} }
+
+func (p Platform) String() string {
+	if p.IsZero() {
+		return ""
+	}
+	return p.OS + "/" + p.Arch
+}
+
+func (p Platform) MarshalText() ([]byte, error) { return []byte(p.String()), nil }
+
+func (p *Platform) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*p = Platform{}
+		return nil
+	}
+	os, arch, ok := strings.Cut(string(text), "/")
+	if !ok || os == "" || arch == "" || strings.Contains(arch, "/") {
+		return fmt.Errorf("invalid platform %q, expected <os>/<arch>, eg. linux/amd64", text)
+	}
+	*p = Platform{OS: os, Arch: arch}
+	return nil
+}
@@ -0,0 +1,32 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+)
+
+func TestParsePlatform(t *testing.T) {
+	for _, test := range []struct {
+		platform    string
+		expected    Platform
+		expectedErr string
+	}{
+		{platform: "linux/amd64", expected: Platform{OS: "linux", Arch: "amd64"}},
+		{platform: "", expected: Platform{}},
+		{platform: "linux", expectedErr: `invalid platform "linux", expected <os>/<arch>, eg. linux/amd64`},
+		{platform: "linux/", expectedErr: `invalid platform "linux/", expected <os>/<arch>, eg. linux/amd64`},
+		{platform: "linux/arm/v7", expectedErr: `invalid platform "linux/arm/v7", expected <os>/<arch>, eg. linux/amd64`},
+	} {
+		t.Run(test.platform, func(t *testing.T) {
+			actual, err := ParsePlatform(test.platform)
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+			assert.Equal(t, test.platform, actual.String())
+		})
+	}
+}
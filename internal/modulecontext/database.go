@@ -1,20 +1,24 @@
 package modulecontext
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"strconv"
 
+	"github.com/jackc/pgx/v5/pgxpool"
+
 	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
 )
 
 // Database represents a database connection based on a DSN
-// It holds a private field for the database which is accessible through moduleCtx.GetDatabase(name)
+// It holds private fields for the database which are accessible through moduleCtx.GetDatabase(name) and moduleCtx.GetPool(name)
 type Database struct {
 	DSN      string
 	DBType   DBType
 	isTestDB bool
 	db       *sql.DB
+	pool     *pgxpool.Pool
 }
 
 // NewDatabase creates a Database that can be added to ModuleContext
@@ -23,10 +27,16 @@ func NewDatabase(dbType DBType, dsn string) (Database, error) {
 	if err != nil {
 		return Database{}, err
 	}
+	// Connections are established lazily, so this does not connect to the database.
+	pool, err := pgxpool.New(context.Background(), dsn)
+	if err != nil {
+		return Database{}, err
+	}
 	return Database{
 		DSN:    dsn,
 		DBType: dbType,
 		db:     db,
+		pool:   pool,
 	}, nil
 }
 
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/internal/log"
 )
 
 func FromProto(response *ftlv1.ModuleContextResponse) (ModuleContext, error) {
@@ -15,5 +16,5 @@ func FromProto(response *ftlv1.ModuleContextResponse) (ModuleContext, error) {
 		}
 		databases[entry.Name] = db
 	}
-	return NewBuilder(response.Module).AddConfigs(response.Configs).AddSecrets(response.Secrets).AddDatabases(databases).Build(), nil
+	return NewBuilder(response.Module).AddConfigs(response.Configs).AddSecrets(response.Secrets).AddDatabases(databases).AddFlags(response.Flags).SetLogLevel(log.Level(response.LogLevel)).Build(), nil
 }
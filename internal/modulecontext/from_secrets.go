@@ -8,6 +8,13 @@ import (
 	"github.com/TBD54566975/ftl/go-runtime/encoding"
 )
 
+// DSNSecretKey returns the key of the secret holding the DSN of database "name" of "module".
+//
+// The format is FTL_DSN_<MODULE>_<DBNAME>
+func DSNSecretKey(module, name string) string {
+	return fmt.Sprintf("FTL_DSN_%s_%s", strings.ToUpper(module), strings.ToUpper(name))
+}
+
 // DatabasesFromSecrets finds DSNs in secrets and creates a map of databases.
 //
 // Secret keys should be in the format FTL_DSN_<MODULENAME>_<DBNAME>
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"strings"
 	"sync"
 	"time"
@@ -18,9 +19,12 @@ import (
 	"github.com/TBD54566975/ftl/internal/rpc"
 
 	"github.com/alecthomas/types/optional"
+	"github.com/jackc/pgx/v5/pgxpool"
 	_ "github.com/jackc/pgx/v5/stdlib" // SQL driver
 
 	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/internal/featureflags"
+	"github.com/TBD54566975/ftl/internal/log"
 	"github.com/TBD54566975/ftl/internal/reflect"
 )
 
@@ -37,16 +41,25 @@ type ModuleContext struct {
 	configs   map[string][]byte
 	secrets   map[string][]byte
 	databases map[string]Database
+	flags     map[string][]byte
+	logLevel  log.Level
 
 	isTesting               bool
 	mockVerbs               map[schema.RefKey]Verb
 	allowDirectVerbBehavior bool
+	allowControllerCalls    bool
 	leaseClient             optional.Option[LeaseClient]
 }
 
 // DynamicModuleContext provides up-to-date ModuleContext instances supplied by the controller
 type DynamicModuleContext struct {
 	current atomic.Value[ModuleContext]
+	// Incremented after each update to current.
+	version atomic.Value[int64]
+
+	lock sync.Mutex
+	// Closed and replaced after each update to current.
+	changed chan struct{}
 }
 
 // Builder is used to build a ModuleContext
@@ -65,6 +78,7 @@ func NewBuilder(module string) *Builder {
 		configs:   map[string][]byte{},
 		secrets:   map[string][]byte{},
 		databases: map[string]Database{},
+		flags:     map[string][]byte{},
 		mockVerbs: map[schema.RefKey]Verb{},
 	}
 }
@@ -93,6 +107,20 @@ func (b *Builder) AddDatabases(databases map[string]Database) *Builder {
 	return b
 }
 
+// AddFlags adds feature flags (as JSON encoded rules) to the builder
+func (b *Builder) AddFlags(flags map[string][]byte) *Builder {
+	for name, flag := range flags {
+		b.flags[name] = flag
+	}
+	return b
+}
+
+// SetLogLevel sets the log level override of the module, or clears it if "level" is [log.Default]
+func (b *Builder) SetLogLevel(level log.Level) *Builder {
+	b.logLevel = level
+	return b
+}
+
 // UpdateForTesting marks the builder as part of a test environment and adds mock verbs and flags for other test features.
 func (b *Builder) UpdateForTesting(mockVerbs map[schema.RefKey]Verb, allowDirectVerbBehavior bool, leaseClient LeaseClient) *Builder {
 	b.isTesting = true
@@ -104,6 +132,13 @@ func (b *Builder) UpdateForTesting(mockVerbs map[schema.RefKey]Verb, allowDirect
 	return b
 }
 
+// AllowControllerCalls lets tests call verbs that are not mocked through the
+// controller, when testing against modules deployed to a cluster.
+func (b *Builder) AllowControllerCalls() *Builder {
+	b.allowControllerCalls = true
+	return b
+}
+
 func (b *Builder) Build() ModuleContext {
 	return ModuleContext(reflect.DeepCopy(*b))
 }
@@ -130,22 +165,66 @@ func (m ModuleContext) GetSecret(name string, value any) error {
 	return json.Unmarshal(data, value)
 }
 
+// GetFlag reads the rules of a feature flag of the module.
+//
+// Returns false if the flag does not exist.
+func (m ModuleContext) GetFlag(name string) (featureflags.Flag, bool, error) {
+	data, ok := m.flags[name]
+	if !ok {
+		return featureflags.Flag{}, false, nil
+	}
+	var flag featureflags.Flag
+	if err := json.Unmarshal(data, &flag); err != nil {
+		return featureflags.Flag{}, false, fmt.Errorf("invalid rules for flag %q: %w", name, err)
+	}
+	return flag, true, nil
+}
+
+// LogLevel returns the log level override of the module, or [log.Default] if there is none.
+func (m ModuleContext) LogLevel() log.Level {
+	return m.logLevel
+}
+
 // GetDatabase gets a database connection
 //
 // Returns an error if no database with that name is found or it is not the expected type
 // When in a testing context (via ftltest), an error is returned if the database is not a test database
 func (m ModuleContext) GetDatabase(name string, dbType DBType) (*sql.DB, error) {
+	db, err := m.lookupDatabase(name, dbType)
+	if err != nil {
+		return nil, err
+	}
+	return db.db, nil
+}
+
+// GetPool gets a connection pool for a database
+//
+// The same restrictions as GetDatabase apply.
+func (m ModuleContext) GetPool(name string, dbType DBType) (*pgxpool.Pool, error) {
+	db, err := m.lookupDatabase(name, dbType)
+	if err != nil {
+		return nil, err
+	}
+	return db.pool, nil
+}
+
+// Databases returns the databases available to the module, keyed by name.
+func (m ModuleContext) Databases() map[string]Database {
+	return maps.Clone(m.databases)
+}
+
+func (m ModuleContext) lookupDatabase(name string, dbType DBType) (Database, error) {
 	db, ok := m.databases[name]
 	if !ok {
-		return nil, fmt.Errorf("missing DSN for database %s", name)
+		return Database{}, fmt.Errorf("missing DSN for database %s", name)
 	}
 	if db.DBType != dbType {
-		return nil, fmt.Errorf("database %s does not match expected type of %s", name, dbType)
+		return Database{}, fmt.Errorf("database %s does not match expected type of %s", name, dbType)
 	}
 	if m.isTesting && !db.isTestDB {
-		return nil, fmt.Errorf("accessing non-test database %q while testing: try adding ftltest.WithDatabase(db) as an option with ftltest.Context(...)", name)
+		return Database{}, fmt.Errorf("accessing non-test database %q while testing: try adding ftltest.WithDatabase(db) as an option with ftltest.Context(...)", name)
 	}
-	return db.db, nil
+	return db, nil
 }
 
 // LeaseClient is the interface for acquiring, heartbeating and releasing leases
@@ -170,7 +249,7 @@ func (m ModuleContext) BehaviorForVerb(ref schema.Ref) (optional.Option[VerbBeha
 		return optional.Some(VerbBehavior(MockBehavior{Mock: mock})), nil
 	} else if m.allowDirectVerbBehavior && ref.Module == m.module {
 		return optional.Some(VerbBehavior(DirectBehavior{})), nil
-	} else if m.isTesting {
+	} else if m.isTesting && !m.allowControllerCalls {
 		if ref.Module == m.module {
 			return optional.None[VerbBehavior](), fmt.Errorf("no mock found: provide a mock with ftltest.WhenVerb(%s, ...) or enable all calls within the module with ftltest.WithCallsAllowedWithinModule()", strings.ToUpper(ref.Name[:1])+ref.Name[1:])
 		}
@@ -211,7 +290,7 @@ func (g grpcModuleContextSupplier) Subscribe(ctx context.Context, moduleName str
 // are streamed from the controller. This operation may time out if the first
 // module context is not supplied quickly enough (fixed at 5 seconds).
 func NewDynamicContext(ctx context.Context, supplier ModuleContextSupplier, moduleName string) (*DynamicModuleContext, error) {
-	result := &DynamicModuleContext{}
+	result := &DynamicModuleContext{changed: make(chan struct{})}
 
 	await := sync.WaitGroup{}
 	await.Add(1)
@@ -220,6 +299,8 @@ func NewDynamicContext(ctx context.Context, supplier ModuleContextSupplier, modu
 	// asynchronously consumes a subscription of ModuleContext changes and signals the arrival of the first
 	supplier.Subscribe(ctx, moduleName, func(ctx context.Context, moduleContext ModuleContext) {
 		result.current.Store(moduleContext)
+		result.version.Store(result.version.Load() + 1)
+		result.notifyChanged()
 		releaseOnce.Do(func() {
 			await.Done()
 		})
@@ -247,6 +328,27 @@ func (m *DynamicModuleContext) CurrentContext() ModuleContext {
 	return m.current.Load()
 }
 
+// Version returns a number that changes each time an updated ModuleContext
+// is supplied, eg. when configuration or secrets change.
+func (m *DynamicModuleContext) Version() int64 {
+	return m.version.Load()
+}
+
+// Changed returns a channel that is closed when an updated ModuleContext is
+// next supplied.
+func (m *DynamicModuleContext) Changed() <-chan struct{} {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.changed
+}
+
+func (m *DynamicModuleContext) notifyChanged() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	close(m.changed)
+	m.changed = make(chan struct{})
+}
+
 // FromContext returns the DynamicModuleContext attached to a context.
 func FromContext(ctx context.Context) *DynamicModuleContext {
 	m, ok := ctx.Value(contextKeyDynamicModuleContext{}).(*DynamicModuleContext)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/alecthomas/assert/v2"
 
+	"github.com/TBD54566975/ftl/backend/schema"
 	"github.com/TBD54566975/ftl/internal/log"
 	. "github.com/TBD54566975/ftl/internal/modulecontext"
 	. "github.com/TBD54566975/ftl/testutils/modulecontext"
@@ -32,11 +33,40 @@ func TestDynamicContextUpdate(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotEqual(t, nil, dynamic)
 	assert.Equal(t, mc1, dynamic.CurrentContext())
+	version := dynamic.Version()
+	changed := dynamic.Changed()
 	mcs.sink(ctx, mc2)
 	assert.Equal(t, mc2, dynamic.CurrentContext())
+	assert.NotEqual(t, version, dynamic.Version())
+	select {
+	case <-changed:
+	default:
+		t.Fatal("expected change to be notified")
+	}
 }
 
 func (mcs *manualContextSupplier) Subscribe(ctx context.Context, _ string, sink func(ctx context.Context, mCtx ModuleContext)) {
 	sink(ctx, mcs.initialCtx)
 	mcs.sink = sink
 }
+
+func TestBehaviorForVerbWithControllerCalls(t *testing.T) {
+	ref := schema.Ref{Module: "other", Name: "echo"}
+	_, err := NewBuilder("test").UpdateForTesting(nil, false, nil).Build().BehaviorForVerb(ref)
+	assert.EqualError(t, err, "no mock found: provide a mock with ftltest.WhenVerb(other.Echo, ...)")
+
+	mock := func(ctx context.Context, req any) (any, error) { return req, nil }
+	moduleCtx := NewBuilder("test").
+		UpdateForTesting(map[schema.RefKey]Verb{ref.ToRefKey(): mock}, false, nil).
+		AllowControllerCalls().
+		Build()
+	behavior, err := moduleCtx.BehaviorForVerb(ref)
+	assert.NoError(t, err)
+	_, ok := behavior.Get()
+	assert.True(t, ok, "mocks take precedence over calls through the controller")
+
+	behavior, err = moduleCtx.BehaviorForVerb(schema.Ref{Module: "other", Name: "time"})
+	assert.NoError(t, err)
+	_, ok = behavior.Get()
+	assert.False(t, ok, "verbs that are not mocked should be called through the controller")
+}
@@ -19,5 +19,7 @@ func (m ModuleContext) ToProto() *ftlv1.ModuleContextResponse {
 		Configs:   m.configs,
 		Secrets:   m.secrets,
 		Databases: databases,
+		Flags:     m.flags,
+		LogLevel:  int32(m.logLevel),
 	}
 }
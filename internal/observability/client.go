@@ -29,18 +29,28 @@ func (e *ExportOTELFlag) UnmarshalText(text []byte) error {
 }
 
 type Config struct {
-	LogLevel   log.Level      `default:"error" help:"OTEL log level." env:"FTL_O11Y_LOG_LEVEL"`
-	ExportOTEL ExportOTELFlag `help:"Export observability data to OTEL." env:"OTEL_EXPORTER_OTLP_ENDPOINT"`
+	LogLevel    log.Level         `default:"error" help:"OTEL log level." env:"FTL_O11Y_LOG_LEVEL"`
+	ExportOTEL  ExportOTELFlag    `help:"Export observability data to OTEL." env:"OTEL_EXPORTER_OTLP_ENDPOINT"`
+	Endpoint    string            `help:"OTLP gRPC endpoint to export to, eg. http://otel-collector:4317. Defaults to OTEL_EXPORTER_OTLP_ENDPOINT." env:"FTL_O11Y_ENDPOINT" placeholder:"URL"`
+	Headers     map[string]string `help:"Headers to send to the OTLP endpoint, eg. for authentication." mapsep:"," env:"FTL_O11Y_HEADERS" placeholder:"KEY=VALUE,…"`
+	SampleRatio float64           `default:"1" help:"Fraction of traces to sample, from 0 to 1. Traces continued from a remote parent follow the parent's sampling decision." env:"FTL_O11Y_SAMPLE_RATIO"`
 }
 
 func Init(ctx context.Context, serviceName, serviceVersion string, config Config) error {
 	logger := log.FromContext(ctx)
-	if !config.ExportOTEL {
+	if !config.ExportOTEL && config.Endpoint == "" {
 		logger.Tracef("OTEL export is disabled, set OTEL_EXPORTER_OTLP_ENDPOINT to enable")
 		return nil
 	}
+	if config.SampleRatio < 0 || config.SampleRatio > 1 {
+		return fmt.Errorf("OTEL sample ratio must be between 0 and 1, got %v", config.SampleRatio)
+	}
 
-	logger.Debugf("OTEL is enabled, exporting to %s", os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT"))
+	endpoint := config.Endpoint
+	if endpoint == "" {
+		endpoint = os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT")
+	}
+	logger.Debugf("OTEL is enabled, exporting to %s", endpoint)
 
 	otelLogger := NewOtelLogger(logger, config.LogLevel)
 	otel.SetLogger(otelLogger)
@@ -56,7 +66,18 @@ func Init(ctx context.Context, serviceName, serviceVersion string, config Config
 		return fmt.Errorf("failed to create OTEL resource: %w", err)
 	}
 
-	otelMetricExporter, err := otlpmetricgrpc.New(ctx)
+	var metricOptions []otlpmetricgrpc.Option
+	var traceOptions []otlptracegrpc.Option
+	if config.Endpoint != "" {
+		metricOptions = append(metricOptions, otlpmetricgrpc.WithEndpointURL(config.Endpoint))
+		traceOptions = append(traceOptions, otlptracegrpc.WithEndpointURL(config.Endpoint))
+	}
+	if len(config.Headers) > 0 {
+		metricOptions = append(metricOptions, otlpmetricgrpc.WithHeaders(config.Headers))
+		traceOptions = append(traceOptions, otlptracegrpc.WithHeaders(config.Headers))
+	}
+
+	otelMetricExporter, err := otlpmetricgrpc.New(ctx, metricOptions...)
 	if err != nil {
 		return fmt.Errorf("failed to create OTEL metric exporter: %w", err)
 	}
@@ -64,11 +85,15 @@ func Init(ctx context.Context, serviceName, serviceVersion string, config Config
 	meterProvider := metric.NewMeterProvider(metric.WithReader(metric.NewPeriodicReader(otelMetricExporter)), metric.WithResource(res))
 	otel.SetMeterProvider(meterProvider)
 
-	otelTraceExporter, err := otlptracegrpc.New(ctx)
+	otelTraceExporter, err := otlptracegrpc.New(ctx, traceOptions...)
 	if err != nil {
 		return fmt.Errorf("failed to create OTEL trace exporter: %w", err)
 	}
-	traceProvider := trace.NewTracerProvider(trace.WithBatcher(otelTraceExporter), trace.WithResource(res))
+	traceProvider := trace.NewTracerProvider(
+		trace.WithBatcher(otelTraceExporter),
+		trace.WithResource(res),
+		trace.WithSampler(trace.ParentBased(trace.TraceIDRatioBased(config.SampleRatio))),
+	)
 	otel.SetTracerProvider(traceProvider)
 
 	return nil
@@ -12,3 +12,9 @@ func TestSchemaMismatch(t *testing.T) {
 	dflt := resource.Default()
 	assert.Equal(t, dflt.SchemaURL(), schemaURL, `change import in client.go to: semconv "go.opentelemetry.io/otel/semconv/v%s"`, path.Base(dflt.SchemaURL()))
 }
+
+func TestQueryName(t *testing.T) {
+	assert.Equal(t, "GetActiveRunners", queryName("-- name: GetActiveRunners :many\nSELECT * FROM runners"))
+	assert.Equal(t, "SELECT", queryName("\n\tselect id, key FROM deployments"))
+	assert.Equal(t, "query", queryName(""))
+}
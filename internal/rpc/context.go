@@ -2,6 +2,7 @@ package rpc
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"runtime/debug"
@@ -21,6 +22,9 @@ import (
 type ftlDirectRoutingKey struct{}
 type ftlVerbKey struct{}
 type requestIDKey struct{}
+type principalKey struct{}
+type projectKey struct{}
+type schemaVersionKey struct{}
 
 // WithDirectRouting ensures any hops in Verb routing do not redirect.
 //
@@ -79,8 +83,58 @@ func WithRequestName(ctx context.Context, key model.RequestKey) context.Context
 	return context.WithValue(ctx, requestIDKey{}, key.String())
 }
 
+// WithPrincipal adds the claims of the authenticated principal of the current
+// request to the context.
+//
+// A nil value removes any principal from the context.
+func WithPrincipal(ctx context.Context, claims json.RawMessage) context.Context {
+	return context.WithValue(ctx, principalKey{}, claims)
+}
+
+// PrincipalFromContext returns the claims of the authenticated principal of
+// the current request, if any.
+func PrincipalFromContext(ctx context.Context) optional.Option[json.RawMessage] {
+	claims, ok := ctx.Value(principalKey{}).(json.RawMessage)
+	if !ok || claims == nil {
+		return optional.None[json.RawMessage]()
+	}
+	return optional.Some(claims)
+}
+
+// WithProject scopes requests made with the context to "project".
+//
+// An empty project removes any scope.
+func WithProject(ctx context.Context, project string) context.Context {
+	return context.WithValue(ctx, projectKey{}, project)
+}
+
+// ProjectFromContext returns the project requests are scoped to, if any.
+func ProjectFromContext(ctx context.Context) optional.Option[string] {
+	project, ok := ctx.Value(projectKey{}).(string)
+	if !ok || project == "" {
+		return optional.None[string]()
+	}
+	return optional.Some(project)
+}
+
+// WithSchemaVersion records the version of the schema wire format understood by
+// the client of the current request.
+func WithSchemaVersion(ctx context.Context, version int64) context.Context {
+	return context.WithValue(ctx, schemaVersionKey{}, version)
+}
+
+// SchemaVersionFromContext returns the version of the schema wire format
+// understood by the client of the current request.
+//
+// Clients that predate versioning, and contexts not derived from a request,
+// are assumed to only understand version 0.
+func SchemaVersionFromContext(ctx context.Context) int64 {
+	version, _ := ctx.Value(schemaVersionKey{}).(int64)
+	return version
+}
+
 func DefaultClientOptions(level log.Level) []connect.ClientOption {
-	interceptors := []connect.Interceptor{PanicInterceptor(), MetadataInterceptor(log.Debug), otelInterceptor()}
+	interceptors := []connect.Interceptor{PanicInterceptor(), MetadataInterceptor(log.Debug), otelInterceptor(), RetryInterceptor(DefaultRetryPolicy)}
 	if ftl.Version != "dev" {
 		interceptors = append(interceptors, versionInterceptor{})
 	}
@@ -243,6 +297,13 @@ func propagateHeaders(ctx context.Context, isClient bool, header http.Header) (c
 		} else if key, ok := key.Get(); ok {
 			headers.SetRequestKey(header, key)
 		}
+		if claims, ok := PrincipalFromContext(ctx).Get(); ok {
+			headers.SetPrincipal(header, claims)
+		}
+		if project, ok := ProjectFromContext(ctx).Get(); ok {
+			header.Set(headers.ProjectHeader, project)
+		}
+		headers.SetSchemaVersion(header)
 	} else {
 		if headers.IsDirectRouted(header) {
 			ctx = WithDirectRouting(ctx)
@@ -257,6 +318,19 @@ func propagateHeaders(ctx context.Context, isClient bool, header http.Header) (c
 		} else if ok {
 			ctx = WithRequestName(ctx, key)
 		}
+		if claims, err := headers.GetPrincipal(header); err != nil {
+			return nil, err
+		} else if claims, ok := claims.Get(); ok {
+			ctx = WithPrincipal(ctx, claims)
+		}
+		if project := header.Get(headers.ProjectHeader); project != "" {
+			ctx = WithProject(ctx, project)
+		}
+		if version, err := headers.GetSchemaVersion(header); err != nil {
+			return nil, err
+		} else { //nolint:revive
+			ctx = WithSchemaVersion(ctx, version)
+		}
 	}
 	return ctx, nil
 }
@@ -2,11 +2,14 @@ package rpc
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"testing"
 
-	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
 	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
 )
 
 func TestRPCContext(t *testing.T) {
@@ -19,3 +22,31 @@ func TestRPCContext(t *testing.T) {
 	assert.Equal(t, verbClient, ClientFromContext[ftlv1connect.VerbServiceClient](ctx))
 	assert.Equal(t, controllerClient, ClientFromContext[ftlv1connect.ControllerServiceClient](ctx))
 }
+
+func TestPropagatePrincipal(t *testing.T) {
+	claims := json.RawMessage(`{"sub":"alice"}`)
+	header := http.Header{}
+	_, err := propagateHeaders(WithPrincipal(context.Background(), claims), true, header)
+	assert.NoError(t, err)
+
+	ctx, err := propagateHeaders(context.Background(), false, header)
+	assert.NoError(t, err)
+	assert.Equal(t, optional.Some(claims), PrincipalFromContext(ctx))
+
+	ctx = WithPrincipal(ctx, nil)
+	assert.Equal(t, optional.None[json.RawMessage](), PrincipalFromContext(ctx))
+}
+
+func TestPropagateProject(t *testing.T) {
+	header := http.Header{}
+	_, err := propagateHeaders(WithProject(context.Background(), "billing"), true, header)
+	assert.NoError(t, err)
+
+	ctx, err := propagateHeaders(context.Background(), false, header)
+	assert.NoError(t, err)
+	assert.Equal(t, optional.Some("billing"), ProjectFromContext(ctx))
+
+	ctx, err = propagateHeaders(context.Background(), false, http.Header{})
+	assert.NoError(t, err)
+	assert.Equal(t, optional.None[string](), ProjectFromContext(ctx))
+}
@@ -0,0 +1,217 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"connectrpc.com/connect"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+// FailoverHealthCheckInterval is the interval between health checks of the
+// endpoints of clients created with [DialFailover].
+var FailoverHealthCheckInterval = time.Second * 5
+
+// EndpointURLs returns the base URLs of a primary endpoint followed by the
+// endpoints to fail over to, for [DialFailover].
+func EndpointURLs(primary *url.URL, failover []*url.URL) []string {
+	urls := []string{primary.String()}
+	for _, endpoint := range failover {
+		urls = append(urls, endpoint.String())
+	}
+	return urls
+}
+
+// DialFailover creates a client that sends each RPC to the first healthy
+// endpoint of "baseURLs", failing over to the next endpoint if it can not be
+// connected to.
+//
+// Endpoints are health checked with Ping until "ctx" is done, and endpoints
+// that are unavailable or not ready are skipped until they recover. If every
+// endpoint is unhealthy they are all tried, in order.
+//
+// Unary and server streaming RPCs are retried on the next endpoint if the
+// connection to an endpoint fails, as the request is known not to have been
+// sent. Client and bidirectional streams are not.
+//
+// With a single endpoint this is equivalent to [Dial].
+func DialFailover[Client Pingable](ctx context.Context, factory ClientFactory[Client], baseURLs []string, errorLevel log.Level, opts ...connect.ClientOption) Client {
+	if len(baseURLs) == 1 {
+		return Dial(factory, baseURLs[0], errorLevel, opts...)
+	}
+	key := strings.Join(baseURLs, ",")
+	failoverClientsLock.Lock()
+	client, ok := failoverClients[key]
+	if !ok {
+		var err error
+		client, err = newFailoverClient(ctx, defaultPool, factory, baseURLs)
+		if err != nil {
+			failoverClientsLock.Unlock()
+			panic(err)
+		}
+		failoverClients[key] = client
+		go client.healthCheck(ctx)
+	}
+	failoverClientsLock.Unlock()
+	opts = append(opts, DefaultClientOptions(errorLevel)...)
+	return factory(client, baseURLs[0], opts...)
+}
+
+var (
+	failoverClientsLock sync.Mutex
+	// Shared by clients created with DialFailover for the same endpoints, so
+	// that each endpoint is health checked once.
+	failoverClients = map[string]*failoverClient{}
+)
+
+// failoverClient is a [connect.HTTPClient] that sends requests to the first
+// healthy endpoint.
+//
+// Clients are created with the base URL of the first endpoint, which is
+// replaced in each request with that of the endpoint it is sent to.
+type failoverClient struct {
+	logger    *log.Logger
+	primary   *url.URL
+	endpoints []*failoverEndpoint
+}
+
+type failoverEndpoint struct {
+	url     *url.URL
+	client  *http.Client
+	ping    func(ctx context.Context) error
+	healthy atomic.Bool
+}
+
+func newFailoverClient[Client Pingable](ctx context.Context, pool *Pool, factory ClientFactory[Client], baseURLs []string) (*failoverClient, error) {
+	f := &failoverClient{logger: log.FromContext(ctx)}
+	for i, baseURL := range baseURLs {
+		u, err := url.Parse(baseURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid endpoint %q: %w", baseURL, err)
+		}
+		if i == 0 {
+			f.primary = u
+		}
+		httpClient := pool.HTTPClient(baseURL)
+		// Health checks are not retried, as a failure marks the endpoint unhealthy.
+		pinger := factory(httpClient, baseURL, connect.WithGRPC())
+		endpoint := &failoverEndpoint{url: u, client: httpClient}
+		endpoint.ping = func(ctx context.Context) error {
+			resp, err := pinger.Ping(ctx, connect.NewRequest(&ftlv1.PingRequest{}))
+			if err != nil {
+				return err
+			}
+			if resp.Msg.NotReady != nil {
+				return fmt.Errorf("not ready: %s", *resp.Msg.NotReady)
+			}
+			return nil
+		}
+		endpoint.healthy.Store(true)
+		f.endpoints = append(f.endpoints, endpoint)
+	}
+	return f, nil
+}
+
+func (f *failoverClient) Do(req *http.Request) (*http.Response, error) {
+	var lastErr error
+	for i, endpoint := range f.candidates() {
+		attempt, err := f.rewrite(req, endpoint, i > 0)
+		if err != nil {
+			return nil, lastErr
+		}
+		resp, err := endpoint.client.Do(attempt)
+		if err == nil {
+			return resp, nil
+		}
+		if req.Context().Err() != nil || !isDialError(err) {
+			return nil, err
+		}
+		if endpoint.healthy.CompareAndSwap(true, false) {
+			f.logger.Warnf("Endpoint %s is unavailable, failing over: %s", endpoint.url, err)
+		}
+		lastErr = err
+	}
+	return nil, lastErr
+}
+
+// candidates returns the healthy endpoints, in order, followed by the
+// unhealthy endpoints.
+func (f *failoverClient) candidates() []*failoverEndpoint {
+	candidates := make([]*failoverEndpoint, 0, len(f.endpoints))
+	for _, endpoint := range f.endpoints {
+		if endpoint.healthy.Load() {
+			candidates = append(candidates, endpoint)
+		}
+	}
+	for _, endpoint := range f.endpoints {
+		if !endpoint.healthy.Load() {
+			candidates = append(candidates, endpoint)
+		}
+	}
+	return candidates
+}
+
+// rewrite "req" to be sent to "endpoint".
+//
+// If "retry" is true the body of the request is replayed, returning an error
+// if that is not possible.
+func (f *failoverClient) rewrite(req *http.Request, endpoint *failoverEndpoint, retry bool) (*http.Request, error) {
+	out := req.Clone(req.Context())
+	out.URL.Scheme = endpoint.url.Scheme
+	out.URL.Host = endpoint.url.Host
+	out.URL.Path = strings.TrimSuffix(endpoint.url.Path, "/") + strings.TrimPrefix(req.URL.Path, strings.TrimSuffix(f.primary.Path, "/"))
+	out.Host = ""
+	if retry && req.Body != nil && req.Body != http.NoBody {
+		if req.GetBody == nil {
+			return nil, errors.New("request body can not be replayed")
+		}
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		out.Body = body
+	}
+	return out, nil
+}
+
+func (f *failoverClient) healthCheck(ctx context.Context) {
+	for {
+		for _, endpoint := range f.endpoints {
+			pingCtx, cancel := context.WithTimeout(ctx, FailoverHealthCheckInterval)
+			err := endpoint.ping(pingCtx)
+			cancel()
+			if ctx.Err() != nil {
+				return
+			}
+			if err == nil {
+				if endpoint.healthy.CompareAndSwap(false, true) {
+					f.logger.Infof("Endpoint %s is available", endpoint.url)
+				}
+			} else if endpoint.healthy.CompareAndSwap(true, false) {
+				f.logger.Warnf("Endpoint %s failed its health check: %s", endpoint.url, err)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(FailoverHealthCheckInterval):
+		}
+	}
+}
+
+// isDialError returns true if "err" is a failure to connect to an endpoint,
+// in which case the request was not sent.
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
@@ -0,0 +1,89 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/assert/v2"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+// statusController responds to Status with the number of its runners.
+type statusController struct {
+	ftlv1connect.UnimplementedControllerServiceHandler
+	runners int
+}
+
+func (s *statusController) Ping(ctx context.Context, req *connect.Request[ftlv1.PingRequest]) (*connect.Response[ftlv1.PingResponse], error) {
+	return connect.NewResponse(&ftlv1.PingResponse{}), nil
+}
+
+func (s *statusController) Status(ctx context.Context, req *connect.Request[ftlv1.StatusRequest]) (*connect.Response[ftlv1.StatusResponse], error) {
+	return connect.NewResponse(&ftlv1.StatusResponse{Runners: make([]*ftlv1.StatusResponse_Runner, s.runners)}), nil
+}
+
+func startController(t *testing.T, runners int) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.Handle(ftlv1connect.NewControllerServiceHandler(&statusController{runners: runners}))
+	server := httptest.NewServer(h2c.NewHandler(mux, &http2.Server{}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// unusedURL returns the URL of a port that nothing is listening on.
+func unusedURL(t *testing.T) string {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	url := "http://" + listener.Addr().String()
+	assert.NoError(t, listener.Close())
+	return url
+}
+
+func TestDialFailover(t *testing.T) {
+	ctx, cancel := context.WithCancel(log.ContextWithNewDefaultLogger(context.Background()))
+	t.Cleanup(cancel)
+	primary := unusedURL(t)
+	secondary := startController(t, 2)
+
+	pool := NewPool()
+	f, err := newFailoverClient(ctx, pool, ftlv1connect.NewControllerServiceClient, []string{primary, secondary.URL})
+	assert.NoError(t, err)
+	client := ftlv1connect.NewControllerServiceClient(f, primary, connect.WithGRPC())
+
+	// The primary can't be connected to, so the request fails over.
+	resp, err := client.Status(ctx, connect.NewRequest(&ftlv1.StatusRequest{}))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(resp.Msg.Runners))
+	assert.False(t, f.endpoints[0].healthy.Load())
+	candidates := f.candidates()
+	assert.True(t, candidates[0] == f.endpoints[1] && candidates[1] == f.endpoints[0], "unhealthy endpoints should be tried last")
+
+	// Health checks restore endpoints once they recover.
+	f.endpoints[0].ping = func(ctx context.Context) error { return nil }
+	go f.healthCheck(ctx)
+	waitFor(t, f.endpoints[0].healthy.Load)
+}
+
+func TestDialFailoverAllUnavailable(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	primary, secondary := unusedURL(t), unusedURL(t)
+	f, err := newFailoverClient(ctx, NewPool(), ftlv1connect.NewControllerServiceClient, []string{primary, secondary})
+	assert.NoError(t, err)
+	client := ftlv1connect.NewControllerServiceClient(f, primary, connect.WithGRPC())
+
+	_, err = client.Status(ctx, connect.NewRequest(&ftlv1.StatusRequest{}))
+	assert.Equal(t, connect.CodeUnavailable, connect.CodeOf(err))
+	assert.False(t, f.endpoints[0].healthy.Load())
+	assert.False(t, f.endpoints[1].healthy.Load())
+}
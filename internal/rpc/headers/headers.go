@@ -1,8 +1,12 @@
 package headers
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/alecthomas/types/optional"
 
@@ -19,6 +23,27 @@ const (
 	VerbHeader = "Ftl-Verb"
 	// RequestIDHeader is the header used to pass the inbound request ID.
 	RequestIDHeader = "Ftl-Request-Id"
+	// PrincipalHeader is the header used to pass the claims of the JWT an
+	// ingress request was authenticated with, as base64 encoded JSON.
+	PrincipalHeader = "Ftl-Principal"
+	// OverloadedHeader is set on errors returned when a verb rejects a call
+	// because it is at its concurrency limit and its queue is full.
+	OverloadedHeader = "Ftl-Overloaded"
+	// CacheBypassHeader is set on calls that must not be served from the
+	// controller's cache of verb responses.
+	CacheBypassHeader = "Ftl-Cache-Bypass"
+	// ProjectHeader is the header used to pass the project a client is
+	// operating on, scoping the deployments it can see and change.
+	ProjectHeader = "Ftl-Project"
+	// SchemaVersionHeader is the header used to pass the version of the schema
+	// wire format a client understands. Clients without it predate versioning.
+	SchemaVersionHeader = "Ftl-Schema-Version"
+	// AcceptCompressionHeader is the header used to pass the compressions of
+	// artefact content that the sender of a request or response can decode.
+	AcceptCompressionHeader = "Ftl-Accept-Compression"
+	// CompressionHeader is the header used to pass the compression of the
+	// artefact content in a request or response.
+	CompressionHeader = "Ftl-Compression"
 )
 
 func IsDirectRouted(header http.Header) bool {
@@ -29,6 +54,22 @@ func SetDirectRouted(header http.Header) {
 	header.Set(DirectRoutingHeader, "1")
 }
 
+func IsOverloaded(header http.Header) bool {
+	return header.Get(OverloadedHeader) != ""
+}
+
+func SetOverloaded(header http.Header) {
+	header.Set(OverloadedHeader, "1")
+}
+
+func IsCacheBypass(header http.Header) bool {
+	return header.Get(CacheBypassHeader) != ""
+}
+
+func SetCacheBypass(header http.Header) {
+	header.Set(CacheBypassHeader, "1")
+}
+
 func SetRequestKey(header http.Header, key model.RequestKey) {
 	header.Set(RequestIDHeader, key.String())
 }
@@ -49,6 +90,73 @@ func GetRequestKey(header http.Header) (model.RequestKey, bool, error) {
 	return key, true, nil
 }
 
+// SetPrincipal sets the claims of the authenticated principal of a request.
+func SetPrincipal(header http.Header, claims json.RawMessage) {
+	header.Set(PrincipalHeader, base64.RawURLEncoding.EncodeToString(claims))
+}
+
+// GetPrincipal returns the claims of the authenticated principal of an incoming request, if any.
+func GetPrincipal(header http.Header) (optional.Option[json.RawMessage], error) {
+	value := header.Get(PrincipalHeader)
+	if value == "" {
+		return optional.None[json.RawMessage](), nil
+	}
+	claims, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return optional.None[json.RawMessage](), fmt.Errorf("invalid %s header: %w", PrincipalHeader, err)
+	}
+	return optional.Some[json.RawMessage](claims), nil
+}
+
+// SetSchemaVersion declares the version of the schema wire format understood
+// by the client making an outgoing request.
+func SetSchemaVersion(header http.Header) {
+	header.Set(SchemaVersionHeader, strconv.Itoa(schema.ProtoVersion))
+}
+
+// GetSchemaVersion returns the version of the schema wire format understood by
+// the client making an incoming request, which is 0 if it didn't declare one.
+func GetSchemaVersion(header http.Header) (int64, error) {
+	value := header.Get(SchemaVersionHeader)
+	if value == "" {
+		return 0, nil
+	}
+	version, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || version < 0 {
+		return 0, fmt.Errorf("invalid %s header %q", SchemaVersionHeader, value)
+	}
+	return version, nil
+}
+
+// SetAcceptCompression declares the compressions of artefact content that can
+// be decoded.
+func SetAcceptCompression(header http.Header, compressions ...string) {
+	header.Set(AcceptCompressionHeader, strings.Join(compressions, ","))
+}
+
+// AcceptsCompression returns true if the sender of "header" can decode
+// artefact content with "compression".
+func AcceptsCompression(header http.Header, compression string) bool {
+	for _, accepted := range strings.Split(header.Get(AcceptCompressionHeader), ",") {
+		if strings.TrimSpace(accepted) == compression {
+			return true
+		}
+	}
+	return false
+}
+
+// SetCompression sets the compression of the artefact content in a request or
+// response.
+func SetCompression(header http.Header, compression string) {
+	header.Set(CompressionHeader, compression)
+}
+
+// GetCompression returns the compression of the artefact content in a request
+// or response, if it is compressed.
+func GetCompression(header http.Header) optional.Option[string] {
+	return optional.Zero(header.Get(CompressionHeader))
+}
+
 // GetCallers history from an incoming request.
 func GetCallers(header http.Header) ([]*schema.Ref, error) {
 	headers := header.Values(VerbHeader)
@@ -0,0 +1,227 @@
+package rpc
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"golang.org/x/net/http2"
+
+	"github.com/TBD54566975/ftl/authn"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+// Pool creates RPC clients that share HTTP/2 connections.
+//
+// Each endpoint has its own transport, over which concurrent RPCs from every
+// client of that endpoint are multiplexed. When an endpoint goes away or
+// changes, [Pool.Drain] it so that its connections are closed once in-flight
+// RPCs complete.
+type Pool struct {
+	lock      sync.Mutex
+	endpoints map[string]*poolEndpoint
+}
+
+func NewPool() *Pool {
+	return &Pool{endpoints: map[string]*poolEndpoint{}}
+}
+
+// DialPool creates a client for "baseURL" sharing the pool's connections to it.
+func DialPool[Client Pingable](pool *Pool, factory ClientFactory[Client], baseURL string, errorLevel log.Level, opts ...connect.ClientOption) Client {
+	opts = append(opts, DefaultClientOptions(errorLevel)...)
+	return factory(pool.HTTPClient(baseURL), baseURL, opts...)
+}
+
+// HTTPClient returns the HTTP client for the endpoint of "baseURL".
+//
+// The endpoint is identified by the scheme and host of the URL.
+func (p *Pool) HTTPClient(baseURL string) *http.Client {
+	key := endpointKey(baseURL)
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	endpoint, ok := p.endpoints[key]
+	if !ok {
+		endpoint = newPoolEndpoint(key)
+		p.endpoints[key] = endpoint
+	}
+	return endpoint.client
+}
+
+// Drain the endpoint of "baseURL".
+//
+// Clients already created for the endpoint continue to work, but its
+// connections are closed once there are no RPCs in flight, and subsequent
+// clients will use new connections.
+func (p *Pool) Drain(baseURL string) {
+	key := endpointKey(baseURL)
+	p.lock.Lock()
+	endpoint, ok := p.endpoints[key]
+	delete(p.endpoints, key)
+	p.lock.Unlock()
+	if ok {
+		endpoint.drain()
+	}
+}
+
+// Close drains every endpoint in the pool.
+func (p *Pool) Close() {
+	p.lock.Lock()
+	endpoints := p.endpoints
+	p.endpoints = map[string]*poolEndpoint{}
+	p.lock.Unlock()
+	for _, endpoint := range endpoints {
+		endpoint.drain()
+	}
+}
+
+func endpointKey(baseURL string) string {
+	u, err := url.Parse(baseURL)
+	if err != nil || u.Host == "" {
+		return baseURL
+	}
+	return u.Scheme + "://" + u.Host
+}
+
+type poolEndpoint struct {
+	transport *http2.Transport
+	client    *http.Client
+	attrs     metric.MeasurementOption
+	inflight  atomic.Int64
+	draining  atomic.Bool
+}
+
+func newPoolEndpoint(key string) *poolEndpoint {
+	e := &poolEndpoint{attrs: metric.WithAttributes(attribute.String("ftl.rpc.endpoint", key))}
+	if strings.HasPrefix(key, "http://") {
+		e.transport = &http2.Transport{
+			AllowHTTP: true,
+			DialTLSContext: func(ctx context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
+				return e.dial(ctx, dialer.DialContext, network, addr)
+			},
+		}
+	} else {
+		e.transport = &http2.Transport{
+			TLSClientConfig: &tls.Config{
+				InsecureSkipVerify: clientConfig.allowInsecure, // #nosec G402
+			},
+			DialTLSContext: func(ctx context.Context, network, addr string, config *tls.Config) (net.Conn, error) {
+				tlsDialer := tls.Dialer{Config: config, NetDialer: dialer}
+				return e.dial(ctx, tlsDialer.DialContext, network, addr)
+			},
+		}
+	}
+	// We can't have a client-wide timeout because it also applies to
+	// streaming RPCs, timing them out.
+	e.client = &http.Client{Transport: &poolTransport{endpoint: e, next: authn.Transport(e.transport, clientConfig.authenticators)}}
+	return e
+}
+
+func (e *poolEndpoint) dial(ctx context.Context, dial func(ctx context.Context, network, addr string) (net.Conn, error), network, addr string) (net.Conn, error) {
+	conn, err := dial(ctx, network, addr)
+	if err != nil {
+		poolMetrics.dialErrors.Add(ctx, 1, e.attrs)
+		return nil, err
+	}
+	poolMetrics.connections.Add(ctx, 1, e.attrs)
+	return &poolConn{Conn: conn, endpoint: e}, nil
+}
+
+func (e *poolEndpoint) drain() {
+	e.draining.Store(true)
+	if e.inflight.Load() == 0 {
+		e.transport.CloseIdleConnections()
+	}
+}
+
+func (e *poolEndpoint) finish(ctx context.Context) {
+	poolMetrics.inflight.Add(ctx, -1, e.attrs)
+	if e.inflight.Add(-1) == 0 && e.draining.Load() {
+		e.transport.CloseIdleConnections()
+	}
+}
+
+// poolTransport tracks the RPCs in flight to an endpoint. Streaming RPCs are
+// in flight until their response body is closed.
+type poolTransport struct {
+	endpoint *poolEndpoint
+	next     http.RoundTripper
+}
+
+func (p *poolTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	p.endpoint.inflight.Add(1)
+	poolMetrics.inflight.Add(ctx, 1, p.endpoint.attrs)
+	resp, err := p.next.RoundTrip(req)
+	if err != nil {
+		poolMetrics.requestErrors.Add(ctx, 1, p.endpoint.attrs)
+		p.endpoint.finish(ctx)
+		return nil, err
+	}
+	resp.Body = &poolBody{ReadCloser: resp.Body, finish: func() { p.endpoint.finish(context.WithoutCancel(ctx)) }}
+	return resp, nil
+}
+
+type poolBody struct {
+	io.ReadCloser
+	once   sync.Once
+	finish func()
+}
+
+func (p *poolBody) Close() error {
+	err := p.ReadCloser.Close()
+	p.once.Do(p.finish)
+	return err
+}
+
+type poolConn struct {
+	net.Conn
+	endpoint *poolEndpoint
+	once     sync.Once
+}
+
+func (p *poolConn) Close() error {
+	err := p.Conn.Close()
+	p.once.Do(func() { poolMetrics.connections.Add(context.Background(), -1, p.endpoint.attrs) })
+	return err
+}
+
+var poolMetrics = func() (m struct {
+	connections   metric.Int64UpDownCounter
+	inflight      metric.Int64UpDownCounter
+	dialErrors    metric.Int64Counter
+	requestErrors metric.Int64Counter
+}) {
+	meter := otel.GetMeterProvider().Meter("ftl.rpc")
+	var err error
+	if m.connections, err = meter.Int64UpDownCounter("ftl.rpc.pool.connections",
+		metric.WithDescription("number of open connections to an endpoint"),
+		metric.WithUnit("{count}")); err != nil {
+		panic(err)
+	}
+	if m.inflight, err = meter.Int64UpDownCounter("ftl.rpc.pool.inflight",
+		metric.WithDescription("number of RPCs in flight to an endpoint"),
+		metric.WithUnit("{count}")); err != nil {
+		panic(err)
+	}
+	if m.dialErrors, err = meter.Int64Counter("ftl.rpc.pool.dial_errors",
+		metric.WithDescription("number of failed attempts to connect to an endpoint"),
+		metric.WithUnit("{count}")); err != nil {
+		panic(err)
+	}
+	if m.requestErrors, err = meter.Int64Counter("ftl.rpc.pool.request_errors",
+		metric.WithDescription("number of RPCs to an endpoint that failed without a response"),
+		metric.WithUnit("{count}")); err != nil {
+		panic(err)
+	}
+	return m
+}()
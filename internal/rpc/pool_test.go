@@ -0,0 +1,137 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/assert/v2"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+// blockingController blocks Status until release is closed.
+type blockingController struct {
+	ftlv1connect.UnimplementedControllerServiceHandler
+	started chan struct{}
+	release chan struct{}
+}
+
+func (b *blockingController) Ping(ctx context.Context, req *connect.Request[ftlv1.PingRequest]) (*connect.Response[ftlv1.PingResponse], error) {
+	return connect.NewResponse(&ftlv1.PingResponse{}), nil
+}
+
+func (b *blockingController) Status(ctx context.Context, req *connect.Request[ftlv1.StatusRequest]) (*connect.Response[ftlv1.StatusResponse], error) {
+	b.started <- struct{}{}
+	<-b.release
+	return connect.NewResponse(&ftlv1.StatusResponse{}), nil
+}
+
+// connTracker records the connections accepted and closed by a listener.
+type connTracker struct {
+	net.Listener
+	lock   sync.Mutex
+	opened int
+	closed int
+}
+
+func (c *connTracker) Accept() (net.Conn, error) {
+	conn, err := c.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.opened++
+	return &trackedConn{Conn: conn, tracker: c}, nil
+}
+
+func (c *connTracker) counts() (int, int) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.opened, c.closed
+}
+
+type trackedConn struct {
+	net.Conn
+	tracker *connTracker
+	once    sync.Once
+}
+
+func (t *trackedConn) Close() error {
+	t.once.Do(func() {
+		t.tracker.lock.Lock()
+		defer t.tracker.lock.Unlock()
+		t.tracker.closed++
+	})
+	return t.Conn.Close()
+}
+
+func TestPool(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	controller := &blockingController{started: make(chan struct{}, 2), release: make(chan struct{})}
+	mux := http.NewServeMux()
+	mux.Handle(ftlv1connect.NewControllerServiceHandler(controller))
+	server := httptest.NewUnstartedServer(h2c.NewHandler(mux, &http2.Server{}))
+	tracker := &connTracker{Listener: server.Listener}
+	server.Listener = tracker
+	server.Start()
+	t.Cleanup(server.Close)
+
+	pool := NewPool()
+	a := DialPool(pool, ftlv1connect.NewControllerServiceClient, server.URL, log.Error)
+	b := DialPool(pool, ftlv1connect.NewControllerServiceClient, server.URL+"/", log.Error)
+
+	// Concurrent RPCs from both clients are multiplexed over a single connection.
+	done := make(chan error, 2)
+	for _, client := range []ftlv1connect.ControllerServiceClient{a, b} {
+		go func() {
+			_, err := client.Status(ctx, connect.NewRequest(&ftlv1.StatusRequest{}))
+			done <- err
+		}()
+	}
+	<-controller.started
+	<-controller.started
+	opened, closed := tracker.counts()
+	assert.Equal(t, 1, opened)
+
+	// Draining waits for in-flight RPCs before closing the connection.
+	pool.Drain(server.URL)
+	time.Sleep(time.Millisecond * 50)
+	_, closed = tracker.counts()
+	assert.Equal(t, 0, closed)
+	close(controller.release)
+	assert.NoError(t, <-done)
+	assert.NoError(t, <-done)
+	waitFor(t, func() bool {
+		_, closed := tracker.counts()
+		return closed == 1
+	})
+
+	// Clients of a drained endpoint continue to work, over a new connection.
+	_, err := a.Ping(ctx, connect.NewRequest(&ftlv1.PingRequest{}))
+	assert.NoError(t, err)
+	opened, closed = tracker.counts()
+	assert.Equal(t, 2, opened)
+	assert.Equal(t, 1, closed)
+}
+
+func waitFor(t *testing.T, condition func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second * 5)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for condition")
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+}
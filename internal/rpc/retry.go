@@ -0,0 +1,228 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jpillora/backoff"
+
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+// RetryPolicy controls how failed unary RPCs are retried by [RetryInterceptor].
+//
+// Only RPCs declared with an idempotency_level of IDEMPOTENT or
+// NO_SIDE_EFFECTS are ever retried.
+type RetryPolicy struct {
+	// Maximum number of attempts, including the first. 1 disables retries.
+	//
+	// If zero or less the RPC is retried until its context is done, and
+	// retries are not limited by the retry budget. This is intended for
+	// waiting for a server to come up.
+	MaxAttempts int
+	// Delay between attempts.
+	Backoff backoff.Backoff
+	// Codes of errors that are retried.
+	Codes []connect.Code
+}
+
+// DefaultRetryPolicy is used by [DefaultClientOptions] unless overridden
+// with [WithRetryPolicy].
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	Backoff:     backoff.Backoff{Min: time.Millisecond * 50, Max: time.Second, Factor: 2, Jitter: true},
+	Codes:       []connect.Code{connect.CodeUnavailable},
+}
+
+// HedgePolicy controls how [Hedge] issues concurrent attempts of an RPC.
+type HedgePolicy struct {
+	// Time to wait for a response before issuing another attempt.
+	Delay time.Duration
+	// Maximum number of attempts, including the first.
+	MaxAttempts int
+}
+
+type retryPolicyKey struct{}
+type hedgedKey struct{}
+
+// WithRetryPolicy overrides the retry policy of RPCs made with the returned context.
+func WithRetryPolicy(ctx context.Context, policy RetryPolicy) context.Context {
+	return context.WithValue(ctx, retryPolicyKey{}, policy)
+}
+
+// RetryPolicyFromContext returns the retry policy set by [WithRetryPolicy], or "fallback".
+func RetryPolicyFromContext(ctx context.Context, fallback RetryPolicy) RetryPolicy {
+	if policy, ok := ctx.Value(retryPolicyKey{}).(RetryPolicy); ok {
+		return policy
+	}
+	return fallback
+}
+
+// Shared by all clients in the process, so that a struggling server isn't
+// overwhelmed by retries.
+var retryBudget = NewRetryBudget(10, 0.1)
+
+// RetryBudget limits the rate of retries and hedged attempts.
+//
+// It is a token bucket as used by gRPC retry throttling: each failed attempt
+// removes a token, each successful attempt adds "tokenRatio" tokens, and
+// retries are only allowed while more than half of "maxTokens" remain.
+type RetryBudget struct {
+	lock       sync.Mutex
+	maxTokens  float64
+	tokenRatio float64
+	tokens     float64
+}
+
+func NewRetryBudget(maxTokens, tokenRatio float64) *RetryBudget {
+	return &RetryBudget{maxTokens: maxTokens, tokenRatio: tokenRatio, tokens: maxTokens}
+}
+
+// Allow returns true if a retry may be attempted.
+func (b *RetryBudget) Allow() bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.tokens > b.maxTokens/2
+}
+
+// Record the outcome of an attempt.
+func (b *RetryBudget) Record(failed bool) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if failed {
+		b.tokens = max(b.tokens-1, 0)
+	} else {
+		b.tokens = min(b.tokens+b.tokenRatio, b.maxTokens)
+	}
+}
+
+// RetryInterceptor retries failed idempotent unary client RPCs according to
+// "policy", or the policy in the request context if set with [WithRetryPolicy].
+func RetryInterceptor(policy RetryPolicy) connect.Interceptor {
+	return &retryInterceptor{policy: policy, budget: retryBudget}
+}
+
+type retryInterceptor struct {
+	policy RetryPolicy
+	budget *RetryBudget
+}
+
+func (*retryInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (*retryInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+func (r *retryInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		spec := req.Spec()
+		if !spec.IsClient {
+			return next(ctx, req)
+		}
+		policy := RetryPolicyFromContext(ctx, r.policy)
+		if ctx.Value(hedgedKey{}) != nil {
+			if spec.IdempotencyLevel != connect.IdempotencyNoSideEffects {
+				return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s has side effects and cannot be hedged", spec.Procedure))
+			}
+			// Hedge issues further attempts itself.
+			return r.attempt(ctx, next, req, policy)
+		}
+		if spec.IdempotencyLevel == connect.IdempotencyUnknown {
+			return next(ctx, req)
+		}
+		logger := log.FromContext(ctx)
+		retry := policy.Backoff
+		for attempt := 1; ; attempt++ {
+			resp, err := r.attempt(ctx, next, req, policy)
+			if err == nil || !slices.Contains(policy.Codes, connect.CodeOf(err)) {
+				return resp, err
+			}
+			if policy.MaxAttempts > 0 && (attempt >= policy.MaxAttempts || !r.budget.Allow()) {
+				return nil, err
+			}
+			delay := retry.Duration()
+			logger.Tracef("%s failed, retrying in %s: %s", spec.Procedure, delay, err)
+			select {
+			case <-ctx.Done():
+				return nil, err
+			case <-time.After(delay):
+			}
+		}
+	}
+}
+
+// attempt an RPC once, recording the outcome in the retry budget.
+func (r *retryInterceptor) attempt(ctx context.Context, next connect.UnaryFunc, req connect.AnyRequest, policy RetryPolicy) (connect.AnyResponse, error) {
+	resp, err := next(ctx, req)
+	r.budget.Record(err != nil && slices.Contains(policy.Codes, connect.CodeOf(err)))
+	return resp, err
+}
+
+// Hedge reduces the tail latency of a unary RPC without side effects by
+// issuing another attempt if no response has been received after
+// policy.Delay, or as soon as an attempt fails, and returning the first
+// successful response.
+//
+// Attempts after the first are limited by the retry budget, and if every
+// attempt fails the last error is returned. It is an error to hedge an RPC
+// that is not declared with an idempotency_level of NO_SIDE_EFFECTS.
+func Hedge[Req, Resp any](
+	ctx context.Context,
+	policy HedgePolicy,
+	rpc func(context.Context, *connect.Request[Req]) (*connect.Response[Resp], error),
+	req *Req,
+) (*connect.Response[Resp], error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	ctx = context.WithValue(ctx, hedgedKey{}, true)
+
+	retryable := RetryPolicyFromContext(ctx, DefaultRetryPolicy).Codes
+	type result struct {
+		resp *connect.Response[Resp]
+		err  error
+	}
+	results := make(chan result, max(policy.MaxAttempts, 1))
+	started, inflight := 0, 0
+	var hedge <-chan time.Time
+	start := func() {
+		started++
+		inflight++
+		hedge = time.After(policy.Delay)
+		go func() {
+			resp, err := rpc(ctx, connect.NewRequest(req))
+			results <- result{resp, err}
+		}()
+	}
+	start()
+	for {
+		select {
+		case result := <-results:
+			inflight--
+			if result.err == nil {
+				return result.resp, nil
+			}
+			if !slices.Contains(retryable, connect.CodeOf(result.err)) {
+				return nil, result.err
+			}
+			if started < policy.MaxAttempts && retryBudget.Allow() {
+				start()
+			} else if inflight == 0 {
+				return nil, result.err
+			}
+
+		case <-hedge:
+			if started < policy.MaxAttempts && retryBudget.Allow() {
+				start()
+			}
+
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
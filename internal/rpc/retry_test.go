@@ -0,0 +1,143 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/assert/v2"
+	"github.com/jpillora/backoff"
+
+	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+// flakyController fails the first "failures" calls to each RPC.
+type flakyController struct {
+	ftlv1connect.UnimplementedControllerServiceHandler
+	failures int32
+	calls    atomic.Int32
+	// Delay before responding to the first call to Ping.
+	pingDelay time.Duration
+}
+
+func (f *flakyController) fail() error {
+	if f.calls.Add(1) <= f.failures {
+		return connect.NewError(connect.CodeUnavailable, errors.New("unavailable"))
+	}
+	return nil
+}
+
+func (f *flakyController) Status(ctx context.Context, req *connect.Request[ftlv1.StatusRequest]) (*connect.Response[ftlv1.StatusResponse], error) {
+	if err := f.fail(); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&ftlv1.StatusResponse{}), nil
+}
+
+func (f *flakyController) CreateDeployment(ctx context.Context, req *connect.Request[ftlv1.CreateDeploymentRequest]) (*connect.Response[ftlv1.CreateDeploymentResponse], error) {
+	if err := f.fail(); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&ftlv1.CreateDeploymentResponse{}), nil
+}
+
+func (f *flakyController) Ping(ctx context.Context, req *connect.Request[ftlv1.PingRequest]) (*connect.Response[ftlv1.PingResponse], error) {
+	if f.calls.Add(1) == 1 {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(f.pingDelay):
+		}
+	}
+	return connect.NewResponse(&ftlv1.PingResponse{}), nil
+}
+
+func newFlakyController(t *testing.T, controller *flakyController, policy RetryPolicy, budget *RetryBudget) ftlv1connect.ControllerServiceClient {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.Handle(ftlv1connect.NewControllerServiceHandler(controller))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	interceptor := &retryInterceptor{policy: policy, budget: budget}
+	return ftlv1connect.NewControllerServiceClient(http.DefaultClient, server.URL, connect.WithInterceptors(interceptor))
+}
+
+var testRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	Backoff:     backoff.Backoff{Min: time.Millisecond, Max: time.Millisecond},
+	Codes:       []connect.Code{connect.CodeUnavailable},
+}
+
+func TestRetryIdempotent(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	controller := &flakyController{failures: 2}
+	client := newFlakyController(t, controller, testRetryPolicy, NewRetryBudget(10, 0.1))
+	_, err := client.Status(ctx, connect.NewRequest(&ftlv1.StatusRequest{}))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), controller.calls.Load())
+
+	controller = &flakyController{failures: 3}
+	client = newFlakyController(t, controller, testRetryPolicy, NewRetryBudget(10, 0.1))
+	_, err = client.Status(ctx, connect.NewRequest(&ftlv1.StatusRequest{}))
+	assert.Equal(t, connect.CodeUnavailable, connect.CodeOf(err))
+	assert.Equal(t, int32(3), controller.calls.Load())
+}
+
+func TestRetrySkipsNonIdempotent(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	controller := &flakyController{failures: 1}
+	client := newFlakyController(t, controller, testRetryPolicy, NewRetryBudget(10, 0.1))
+	_, err := client.CreateDeployment(ctx, connect.NewRequest(&ftlv1.CreateDeploymentRequest{}))
+	assert.Equal(t, connect.CodeUnavailable, connect.CodeOf(err))
+	assert.Equal(t, int32(1), controller.calls.Load())
+}
+
+func TestRetryPolicyFromContext(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	controller := &flakyController{failures: 5}
+	client := newFlakyController(t, controller, testRetryPolicy, NewRetryBudget(10, 0.1))
+	policy := testRetryPolicy
+	policy.MaxAttempts = 0
+	_, err := client.Status(WithRetryPolicy(ctx, policy), connect.NewRequest(&ftlv1.StatusRequest{}))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(6), controller.calls.Load())
+}
+
+func TestRetryBudget(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	budget := NewRetryBudget(4, 0.5)
+	assert.True(t, budget.Allow())
+	budget.Record(true)
+	budget.Record(true)
+	assert.False(t, budget.Allow())
+	budget.Record(false)
+	assert.True(t, budget.Allow())
+
+	// Retries stop once the budget is exhausted.
+	controller := &flakyController{failures: 3}
+	client := newFlakyController(t, controller, testRetryPolicy, NewRetryBudget(2, 0.1))
+	_, err := client.Status(ctx, connect.NewRequest(&ftlv1.StatusRequest{}))
+	assert.Equal(t, connect.CodeUnavailable, connect.CodeOf(err))
+	assert.Equal(t, int32(1), controller.calls.Load())
+}
+
+func TestHedge(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	controller := &flakyController{pingDelay: time.Second * 10}
+	client := newFlakyController(t, controller, testRetryPolicy, NewRetryBudget(10, 0.1))
+	start := time.Now()
+	_, err := Hedge(ctx, HedgePolicy{Delay: time.Millisecond * 10, MaxAttempts: 2}, client.Ping, &ftlv1.PingRequest{})
+	assert.NoError(t, err)
+	assert.True(t, time.Since(start) < time.Second*5, "hedged attempt should not wait for the slow attempt")
+	assert.Equal(t, int32(2), controller.calls.Load())
+
+	_, err = Hedge(ctx, HedgePolicy{Delay: time.Millisecond * 10, MaxAttempts: 2}, client.CreateDeployment, &ftlv1.CreateDeploymentRequest{})
+	assert.EqualError(t, err, "invalid_argument: /xyz.block.ftl.v1.ControllerService/CreateDeployment has side effects and cannot be hedged")
+}
@@ -2,19 +2,15 @@ package rpc
 
 import (
 	"context"
-	"crypto/tls"
 	"errors"
 	"fmt"
 	"net"
 	"net/http"
-	"strings"
 	"time"
 
 	"connectrpc.com/connect"
 	"github.com/jpillora/backoff"
-	"golang.org/x/net/http2"
 
-	"github.com/TBD54566975/ftl/authn"
 	ftlv1 "github.com/TBD54566975/ftl/backend/protos/xyz/block/ftl/v1"
 	"github.com/TBD54566975/ftl/internal/log"
 )
@@ -25,33 +21,12 @@ import (
 // value is the path to the authenticator executable.
 //
 // "allowInsecure" skips certificate verification, making TLS susceptible to machine-in-the-middle attacks.
+//
+// This must be called before any clients are created.
 func InitialiseClients(authenticators map[string]string, allowInsecure bool) {
-	// We can't have a client-wide timeout because it also applies to
-	// streaming RPCs, timing them out.
-	h2cClient = &http.Client{
-		Transport: authn.Transport(&http2.Transport{
-			AllowHTTP: true,
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: allowInsecure, // #nosec G402
-			},
-			DialTLSContext: func(ctx context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
-				conn, err := dialer.Dial(network, addr)
-				return conn, err
-			},
-		}, authenticators),
-	}
-	tlsClient = &http.Client{
-		Transport: authn.Transport(&http2.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: allowInsecure, // #nosec G402
-			},
-			DialTLSContext: func(ctx context.Context, network, addr string, config *tls.Config) (net.Conn, error) {
-				tlsDialer := tls.Dialer{Config: config, NetDialer: dialer}
-				conn, err := tlsDialer.DialContext(ctx, network, addr)
-				return conn, err
-			},
-		}, authenticators),
-	}
+	clientConfig.authenticators = authenticators
+	clientConfig.allowInsecure = allowInsecure
+	defaultPool = NewPool()
 }
 
 func init() {
@@ -62,8 +37,12 @@ var (
 	dialer = &net.Dialer{
 		Timeout: time.Second * 10,
 	}
-	h2cClient *http.Client
-	tlsClient *http.Client
+	clientConfig struct {
+		authenticators map[string]string
+		allowInsecure  bool
+	}
+	// Shared by clients created with Dial.
+	defaultPool *Pool
 )
 
 type Pingable interface {
@@ -71,24 +50,20 @@ type Pingable interface {
 }
 
 // GetHTTPClient returns a HTTP client usable for the given URL.
+//
+// Prefer [Dial] or [DialPool] for RPC clients.
 func GetHTTPClient(url string) *http.Client {
-	if h2cClient == nil {
-		panic("rpc.InitialiseClients() must be called before GetHTTPClient()")
-	}
-	if strings.HasPrefix(url, "http://") {
-		return h2cClient
-	}
-	return tlsClient
+	return defaultPool.HTTPClient(url)
 }
 
 // ClientFactory is a function that creates a new client and is typically one of
 // the New*Client functions generated by protoc-gen-connect-go.
 type ClientFactory[Client Pingable] func(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) Client
 
+// Dial creates a client for "baseURL" sharing connections with every other
+// client created by Dial.
 func Dial[Client Pingable](factory ClientFactory[Client], baseURL string, errorLevel log.Level, opts ...connect.ClientOption) Client {
-	client := GetHTTPClient(baseURL)
-	opts = append(opts, DefaultClientOptions(errorLevel)...)
-	return factory(client, baseURL, opts...)
+	return DialPool(defaultPool, factory, baseURL, errorLevel, opts...)
 }
 
 // ContextValuesMiddleware injects values from a Context into the request Context.
@@ -3,6 +3,7 @@ package sha256
 import (
 	"crypto/sha256"
 	"encoding/hex"
+	"hash"
 	"io"
 	"os"
 	"strconv"
@@ -14,6 +15,9 @@ type SHA256 [sha256.Size]byte
 // Sum "data" and return the SHA256 hash.
 func Sum(data []byte) SHA256 { return sha256.Sum256(data) }
 
+// New returns a hash.Hash computing a SHA256 incrementally.
+func New() hash.Hash { return sha256.New() }
+
 // SumReader "r" and return the SHA256 hash.
 func SumReader(r io.Reader) (SHA256, error) {
 	h := sha256.New()
@@ -33,6 +33,10 @@ data class {{.Name|title}}
 )
 {{end}}
 
+{{- else if is "TypeAlias" . }}
+{{.Comments|comment -}}
+typealias {{.Name|title}} = {{aliasType $ .Type}}{{"\n"}}
+
 {{- else if is "Verb" . }}
 {{.Comments|comment -}}@Verb
 @Ignore
@@ -93,8 +93,11 @@ import xyz.block.ftl.v1.schema.Value
 import xyz.block.ftl.v1.schema.Verb
 import java.io.File
 import java.io.FileOutputStream
+import java.math.BigDecimal
 import java.nio.file.Path
 import java.time.OffsetDateTime
+import java.time.ZonedDateTime
+import java.util.UUID
 import kotlin.io.path.createDirectories
 import io.gitlab.arturbosch.detekt.api.Config as DetektConfig
 
@@ -675,6 +678,12 @@ class SchemaExtractor(
       this.isAnyOrNullableAny() -> Type(any = xyz.block.ftl.v1.schema.Any())
       this.fqNameOrNull()
         ?.asString() == OffsetDateTime::class.qualifiedName -> Type(time = xyz.block.ftl.v1.schema.Time())
+      this.fqNameOrNull()
+        ?.asString() == ZonedDateTime::class.qualifiedName -> Type(timestamp = xyz.block.ftl.v1.schema.Timestamp())
+      this.fqNameOrNull()
+        ?.asString() == UUID::class.qualifiedName -> Type(uuid = xyz.block.ftl.v1.schema.UUID())
+      this.fqNameOrNull()
+        ?.asString() == BigDecimal::class.qualifiedName -> Type(decimal = xyz.block.ftl.v1.schema.Decimal())
 
       else -> {
         val descriptor = this.toClassDescriptor()
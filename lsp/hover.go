@@ -2,10 +2,17 @@ package lsp
 
 import (
 	_ "embed"
+	"fmt"
+	"sort"
 	"strings"
+	"unicode"
 
+	"github.com/alecthomas/types/optional"
 	"github.com/tliron/glsp"
 	protocol "github.com/tliron/glsp/protocol_3_16"
+	"golang.org/x/exp/maps"
+
+	"github.com/TBD54566975/ftl/backend/schema"
 )
 
 func (s *Server) textDocumentHover() protocol.TextDocumentHoverFunc {
@@ -32,15 +39,121 @@ func (s *Server) textDocumentHover() protocol.TextDocumentHoverFunc {
 		for hoverString, hoverContent := range hoverMap {
 			startIndex := strings.Index(lineContent, hoverString)
 			if startIndex != -1 && startIndex <= character && character <= startIndex+len(hoverString) {
-				return &protocol.Hover{
-					Contents: &protocol.MarkupContent{
-						Kind:  protocol.MarkupKindMarkdown,
-						Value: hoverContent,
-					},
-				}, nil
+				return markdownHover(hoverContent), nil
 			}
 		}
 
+		if content, ok := s.schemaHover(uri, wordAt(lineContent, character)).Get(); ok {
+			return markdownHover(content), nil
+		}
+
 		return nil, nil
 	}
 }
+
+func markdownHover(content string) *protocol.Hover {
+	return &protocol.Hover{
+		Contents: &protocol.MarkupContent{
+			Kind:  protocol.MarkupKindMarkdown,
+			Value: content,
+		},
+	}
+}
+
+// wordAt returns the identifier, including any package qualifier, at the given column of a line.
+func wordAt(line string, character int) string {
+	start, end := wordRange(line, character)
+	return line[start:end]
+}
+
+// wordRange returns the start and end offsets of the identifier at the given column of a line.
+func wordRange(line string, character int) (start, end int) {
+	isIdent := func(char byte) bool {
+		return char == '_' || char == '.' || unicode.IsLetter(rune(char)) || unicode.IsDigit(rune(char))
+	}
+	start = character
+	for start > 0 && isIdent(line[start-1]) {
+		start--
+	}
+	end = character
+	for end < len(line) && isIdent(line[end]) {
+		end++
+	}
+	for start < end && line[start] == '.' {
+		start++
+	}
+	for end > start && line[end-1] == '.' {
+		end--
+	}
+	return start, end
+}
+
+// schemaHover describes the verb or data type referred to by "word", as
+// resolved from the live schema.
+func (s *Server) schemaHover(uri protocol.DocumentUri, word string) optional.Option[string] {
+	resolved, ok := s.resolveDecl(uri, word).Get()
+	if !ok {
+		return optional.None[string]()
+	}
+	module := resolved.module.Name
+	switch decl := resolved.decl.(type) {
+	case *schema.Verb:
+		return optional.Some(describeDecl(resolved.schema, module, decl, decl.Export, decl.Internal, resolved.engine.DeploymentKey(module)))
+	case *schema.Data:
+		return optional.Some(describeDecl(resolved.schema, module, decl, decl.Export, decl.Internal, resolved.engine.DeploymentKey(module)))
+	}
+	return optional.None[string]()
+}
+
+func describeDecl(sch *schema.Schema, module string, decl schema.Decl, export, internal bool, deployment optional.Option[string]) string {
+	out := &strings.Builder{}
+	fmt.Fprintf(out, "```ftl\n%s\n```\n\n", decl)
+	visibility := "private to `" + module + "`"
+	if internal {
+		visibility = "internal to the project"
+	} else if export {
+		visibility = "exported"
+	}
+	fmt.Fprintf(out, "- Visibility: %s\n", visibility)
+	if key, ok := deployment.Get(); ok {
+		fmt.Fprintf(out, "- Deployment: `%s`\n", key)
+	} else {
+		fmt.Fprintf(out, "- Deployment: not deployed\n")
+	}
+	if _, ok := decl.(*schema.Verb); ok {
+		callers := callingModules(sch, &schema.Ref{Module: module, Name: decl.GetName()})
+		if len(callers) == 0 {
+			fmt.Fprintf(out, "- Called by: no modules\n")
+		} else {
+			fmt.Fprintf(out, "- Called by: `%s`\n", strings.Join(callers, "`, `"))
+		}
+	}
+	return out.String()
+}
+
+// callingModules returns the sorted names of the modules with verbs that call "ref".
+func callingModules(sch *schema.Schema, ref *schema.Ref) []string {
+	callers := map[string]bool{}
+	for _, module := range sch.Modules {
+		for _, decl := range module.Decls {
+			verb, ok := decl.(*schema.Verb)
+			if !ok {
+				continue
+			}
+			for _, md := range verb.Metadata {
+				calls, ok := md.(*schema.MetadataCalls)
+				if !ok {
+					continue
+				}
+				for _, call := range calls.Calls {
+					if call.Module == ref.Module && call.Name == ref.Name {
+						callers[module.Name] = true
+					}
+				}
+			}
+		}
+	}
+	out := maps.Keys(callers)
+	sort.Strings(out)
+	return out
+}
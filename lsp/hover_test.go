@@ -0,0 +1,80 @@
+package lsp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/buildengine"
+	"github.com/TBD54566975/ftl/common/moduleconfig"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+type fakeEngine struct {
+	schema      *schema.Schema
+	deployments map[string]string
+	builds      [][]string
+}
+
+func (e *fakeEngine) Schema() *schema.Schema { return e.schema }
+func (e *fakeEngine) DeploymentKey(module string) optional.Option[string] {
+	key, ok := e.deployments[module]
+	return optional.From(key, ok)
+}
+func (e *fakeEngine) QueueBuild(moduleNames ...string) { e.builds = append(e.builds, moduleNames) }
+
+func TestSchemaHover(t *testing.T) {
+	sch, err := schema.ParseString("", `
+		module echo {
+			data EchoRequest {
+				name String
+			}
+
+			export verb echo(echo.EchoRequest) Unit
+		}
+
+		module greeter {
+			verb greet(Unit) Unit
+				+calls echo.echo
+		}
+	`)
+	assert.NoError(t, err)
+
+	server := NewServer(log.ContextWithNewDefaultLogger(context.Background()))
+	server.OnBuildStarted(buildengine.Module{Config: moduleconfig.ModuleConfig{Module: "echo", Dir: "/src/echo"}})
+
+	_, ok := server.schemaHover("file:///src/echo/echo.go", "EchoRequest").Get()
+	assert.False(t, ok, "no engine")
+
+	server.SetEngine(&fakeEngine{schema: sch, deployments: map[string]string{"echo": "dpl-echo-1"}})
+
+	content, ok := server.schemaHover("file:///src/greeter/greeter.go", "echo.Echo").Get()
+	assert.True(t, ok)
+	assert.Equal(t, "```ftl\nexport verb echo(echo.EchoRequest) Unit\n```\n\n"+
+		"- Visibility: exported\n"+
+		"- Deployment: `dpl-echo-1`\n"+
+		"- Called by: `greeter`\n", content)
+
+	content, ok = server.schemaHover("file:///src/echo/echo.go", "EchoRequest").Get()
+	assert.True(t, ok)
+	assert.Equal(t, "```ftl\ndata EchoRequest {\n  name String\n}\n```\n\n"+
+		"- Visibility: private to `echo`\n"+
+		"- Deployment: `dpl-echo-1`\n", content)
+
+	content, ok = server.schemaHover("file:///src/greeter/greeter.go", "greeter.Greet").Get()
+	assert.True(t, ok)
+	assert.Contains(t, content, "- Deployment: not deployed\n- Called by: no modules\n")
+
+	_, ok = server.schemaHover("file:///src/greeter/greeter.go", "Missing").Get()
+	assert.False(t, ok, "unknown module")
+}
+
+func TestWordAt(t *testing.T) {
+	line := "\tresp, err := ftl.Call(ctx, echo.Echo, req)"
+	assert.Equal(t, "echo.Echo", wordAt(line, 30))
+	assert.Equal(t, "ftl.Call", wordAt(line, 15))
+	assert.Equal(t, "", wordAt(line, 0))
+}
@@ -2,11 +2,11 @@
 package lsp
 
 var hoverMap = map[string]string{
-	"//ftl:cron": "## Cron\n\nA cron job is an Empty verb that will be called on a schedule. The syntax is described [here](https://pubs.opengroup.org/onlinepubs/9699919799.2018edition/utilities/crontab.html).\n\nYou can also use a shorthand syntax for the cron job, supporting seconds (`s`), minutes (`m`), hours (`h`), and specific days of the week (e.g. `Mon`).\n\n### Examples\n\nThe following function will be called hourly:\n\n```go\n//ftl:cron 0 * * * *\nfunc Hourly(ctx context.Context) error {\n  // ...\n}\n```\n\nEvery 12 hours, starting at UTC midnight:\n\n```go\n//ftl:cron 12h\nfunc TwiceADay(ctx context.Context) error {\n  // ...\n}\n```\n\nEvery Monday at UTC midnight:\n\n```go\n//ftl:cron Mon\nfunc Mondays(ctx context.Context) error {\n  // ...\n}\n```\n\n",
+	"//ftl:cron": "## Cron\n\nA cron job is an Empty verb that will be called on a schedule. The syntax is described [here](https://pubs.opengroup.org/onlinepubs/9699919799.2018edition/utilities/crontab.html).\n\nYou can also use a shorthand syntax for the cron job, supporting seconds (`s`), minutes (`m`), hours (`h`), and specific days of the week (e.g. `Mon`).\n\n### Examples\n\nThe following function will be called hourly:\n\n```go\n//ftl:cron 0 * * * *\nfunc Hourly(ctx context.Context) error {\n  // ...\n}\n```\n\nEvery 12 hours, starting at UTC midnight:\n\n```go\n//ftl:cron 12h\nfunc TwiceADay(ctx context.Context) error {\n  // ...\n}\n```\n\nEvery Monday at UTC midnight:\n\n```go\n//ftl:cron Mon\nfunc Mondays(ctx context.Context) error {\n  // ...\n}\n```\n\n\n## Managing cron jobs\n\nThe cron jobs of active deployments can be listed with `ftl cron list [<module>]`.\n\nA module's cron jobs can be paused with `ftl cron pause <module>`, or a single job with `ftl cron pause <module>.<job>`, and resumed with `ftl cron resume`. Paused jobs are skipped when they are due and keep advancing through their schedule, so resuming a job doesn't execute it immediately. Pauses are stored by the controller, so they survive controller restarts, but not deploying a new version of the module.\n\n`ftl cron run <module>.<job>` executes a job immediately, whether or not it is paused, without affecting its schedule:\n\n```sh\n$ ftl cron run echo.hourly\n```\n",
 	"//ftl:enum": "## Type enums (sum types)\n\n[Sum types](https://en.wikipedia.org/wiki/Tagged_union) are supported by FTL's type system, but aren't directly supported by Go. However they can be approximated with the use of [sealed interfaces](https://blog.chewxy.com/2018/03/18/golang-interfaces/). To declare a sum type in FTL use the comment directive `//ftl:enum`:\n\n```go\n//ftl:enum\ntype Animal interface { animal() }\n\ntype Cat struct {}\nfunc (Cat) animal() {}\n\ntype Dog struct {}\nfunc (Dog) animal() {}\n```\n## Value enums\n\nA value enum is an enumerated set of string or integer values.\n\n```go\n//ftl:enum\ntype Colour string\n\nconst (\n  Red   Colour = \"red\"\n  Green Colour = \"green\"\n  Blue  Colour = \"blue\"\n)\n```\n",
-	"//ftl:ingress": "## HTTP Ingress\n\nVerbs annotated with `ftl:ingress` will be exposed via HTTP (`http` is the default ingress type). These endpoints will then be available on one of our default `ingress` ports (local development defaults to `http://localhost:8891`).\n\nThe following will be available at `http://localhost:8891/http/users/123/posts?postId=456`.\n\n```go\ntype GetRequest struct {\n\tUserID string `json:\"userId\"`\n\tPostID string `json:\"postId\"`\n}\n\ntype GetResponse struct {\n\tMessage string `json:\"msg\"`\n}\n\n//ftl:ingress GET /http/users/{userId}/posts\nfunc Get(ctx context.Context, req builtin.HttpRequest[GetRequest]) (builtin.HttpResponse[GetResponse, ErrorResponse], error) {\n  // ...\n}\n```\n\n> **NOTE!**\n> The `req` and `resp` types of HTTP `ingress` [verbs](../verbs) must be `builtin.HttpRequest` and `builtin.HttpResponse` respectively. These types provide the necessary fields for HTTP `ingress` (`headers`, `statusCode`, etc.)\n> \n> You will need to import `ftl/builtin`.\n\nKey points to note\n\n- `path`, `query`, and `body` parameters are automatically mapped to the `req` and `resp` structures. In the example above, `{userId}` is extracted from the path parameter and `postId` is extracted from the query parameter.\n- `ingress` verbs will be automatically exported by default.\n",
-	"//ftl:retry": "## Retries\n\nAny verb called asynchronously (specifically, PubSub subscribers and FSM states), may optionally specify a basic exponential backoff retry policy via a Go comment directive. The directive has the following syntax:\n\n```go\n//ftl:retry [<attempts>] <min-backoff> [<max-backoff>]\n```\n\n`attempts` and `max-backoff` default to unlimited if not specified.\n\nFor example, the following function will retry up to 10 times, with a delay of 5s, 10s, 20s, 40s, 60s, 60s, etc.\n\n```go\n//ftl:retry 10 5s 1m\nfunc Invoiced(ctx context.Context, in Invoice) error {\n  // ...\n}\n```\n",
-	"//ftl:subscribe": "## PubSub\n\nFTL has first-class support for PubSub, modelled on the concepts of topics (where events are sent), subscriptions (a cursor over the topic), and subscribers (functions events are delivered to). Subscribers are, as you would expect, sinks. Each subscription is a cursor over the topic it is associated with. Each topic may have multiple subscriptions. Each subscription may have multiple subscribers, in which case events will be distributed among them.\n\nFirst, declare a new topic:\n\n```go\nvar invoicesTopic = ftl.Topic[Invoice](\"invoices\")\n```\n\nThen declare each subscription on the topic:\n\n```go\nvar _ = ftl.Subscription(invoicesTopic, \"emailInvoices\")\n```\n\nAnd finally define a Sink to consume from the subscription:\n\n```go\n//ftl:subscribe emailInvoices\nfunc SendInvoiceEmail(ctx context.Context, in Invoice) error {\n  // ...\n}\n```\n\nEvents can be published to a topic like so:\n\n```go\ninvoicesTopic.Publish(ctx, Invoice{...})\n```\n\n> **NOTE!**\n> PubSub topics cannot be published to from outside the module that declared them, they can only be subscribed to. That is, if a topic is declared in module `A`, module `B` cannot publish to it.\n",
-	"//ftl:typealias": "## Type aliases\n\nA type alias is an alternate name for an existing type. It can be declared like so:\n\n```go\n//ftl:typealias\ntype Alias Target\n```\n\neg.\n\n```go\n//ftl:typealias\ntype UserID string\n```\n",
-	"//ftl:verb": "## Verbs\n\n## Defining Verbs\n\nTo declare a Verb, write a normal Go function with the following signature, annotated with the Go [comment directive](https://tip.golang.org/doc/comment#syntax) `//ftl:verb`:\n\n```go\n//ftl:verb\nfunc F(context.Context, In) (Out, error) { }\n```\n\neg.\n\n```go\ntype EchoRequest struct {}\n\ntype EchoResponse struct {}\n\n//ftl:verb\nfunc Echo(ctx context.Context, in EchoRequest) (EchoResponse, error) {\n  // ...\n}\n```\n\nBy default verbs are only [visible](../visibility) to other verbs in the same module.\n\n## Calling Verbs\n\nTo call a verb use `ftl.Call()`. eg.\n\n```go\nout, err := ftl.Call(ctx, echo.Echo, echo.EchoRequest{})\n```\n",
+	"//ftl:ingress": "## HTTP Ingress\n\nVerbs annotated with `ftl:ingress` will be exposed via HTTP (`http` is the default ingress type). These endpoints will then be available on one of our default `ingress` ports (local development defaults to `http://localhost:8891`).\n\nThe following will be available at `http://localhost:8891/http/users/123/posts?postId=456`.\n\n```go\ntype GetRequest struct {\n\tUserID string `json:\"userId\"`\n\tPostID string `json:\"postId\"`\n}\n\ntype GetResponse struct {\n\tMessage string `json:\"msg\"`\n}\n\n//ftl:ingress GET /http/users/{userId}/posts\nfunc Get(ctx context.Context, req builtin.HttpRequest[GetRequest]) (builtin.HttpResponse[GetResponse, ErrorResponse], error) {\n  // ...\n}\n```\n\n> **NOTE!**\n> The `req` and `resp` types of HTTP `ingress` [verbs](../verbs) must be `builtin.HttpRequest` and `builtin.HttpResponse` respectively. These types provide the necessary fields for HTTP `ingress` (`headers`, `statusCode`, etc.)\n> \n> You will need to import `ftl/builtin`.\n\nKey points to note\n\n- `path`, `query`, and `body` parameters are automatically mapped to the `req` and `resp` structures. In the example above, `{userId}` is extracted from the path parameter and `postId` is extracted from the query parameter.\n- `ingress` verbs will be automatically exported by default.\n\n## Headers, cookies and redirects\n\nRequest and response headers are available via `req.Headers` and `resp.Headers`, and the status code of the response can be set with `resp.Status`. To receive or send a raw body rather than JSON, use `[]byte` as the body type.\n\nThe `ftl` package includes helpers for common headers:\n\n```go\n//ftl:ingress GET /http/account\nfunc Account(ctx context.Context, req builtin.HttpRequest[ftl.Unit]) (builtin.HttpResponse[AccountResponse, ftl.Unit], error) {\n  session, ok := ftl.Cookie(req.Headers, \"session\").Get()\n  if !ok {\n    resp := builtin.HttpResponse[AccountResponse, ftl.Unit]{Error: ftl.Some(ftl.Unit{})}\n    resp.Headers, resp.Status = ftl.Redirect(resp.Headers, \"/login\", http.StatusFound)\n    return resp, nil\n  }\n  resp := builtin.HttpResponse[AccountResponse, ftl.Unit]{Body: ftl.Some(lookupAccount(session.Value))}\n  resp.Headers = ftl.SetCacheControl(resp.Headers, \"private\", \"max-age=60\")\n  return resp, nil\n}\n```\n\n`ftl.Cookies(headers)` returns all cookies sent with a request, and `ftl.SetCookie(headers, cookie)` adds a cookie to a response.\n\n## Response mapping\n\nRather than setting headers and statuses in the verb, `ftl:response` maps fields of the response body onto the HTTP response, so the verb can return plain data:\n\n```go\ntype CreateResponse struct {\n\tCode      ftl.Option[int]\n\tRequestID string\n\tUser      ftl.Option[User]\n}\n\n//ftl:ingress POST /http/users\n//ftl:response status code header \"X-Request-Id\" requestId header \"Cache-Control\" = \"no-store\" body user\nfunc Create(ctx context.Context, req builtin.HttpRequest[CreateRequest]) (builtin.HttpResponse[CreateResponse, ErrorResponse], error) {\n  // ...\n}\n```\n\nEach part of the mapping is optional:\n\n- `status <field>` sets the status code from an `int` field, overriding `resp.Status`. Null fields leave the status unchanged.\n- `header \"<name>\" <field>` sets a header from a `string`, `int`, `bool` or `[]string` field, and `header \"<name>\" = \"<value>\"` sets it to a fixed value.\n- `body <field>` replaces the body with one of its fields, stripping the envelope. A null field results in an empty body.\n\nFields are referred to by their names in the schema (`RequestID` is `requestId`), and mapped fields are removed from the body. Mappings only apply to successful responses, not to `resp.Error`.\n\n## Conditional requests\n\nSuccessful responses to `GET` requests carry an `ETag` header computed from the response body. A verb can provide its own, for example a version number, by setting the `ETag` header itself. When a client sends an `If-None-Match` header matching the ETag, it receives a `304 Not Modified` response with no body, so polling clients only download responses that have changed.\n\nThe verb is still called for conditional requests, so they save bandwidth rather than work.\n\n## Authentication\n\nIf the controller is started with `--ingress-jwt-secret`, ingress requests with an `Authorization: Bearer <token>` header must carry a valid JWT signed with HS256 using that secret. Requests with invalid or expired tokens are rejected with `401 Unauthorized`.\n\nThe claims of a verified token are available to the ingress verb, and to every verb it calls, via `ftl.CallerInfo(ctx)`. See [caller information](../verbs#caller-information).\n\n## Access logs\n\nThe controller can log every ingress request with its route, verb, status, latency, the bytes read and written, and the subject of its verified bearer token:\n\n```sh\nftl-controller --ingress-access-log-output=stdout --ingress-access-log-sample-rate=0.1\n```\n\n| Flag                                | Description                                                                                   |\n| ----------------------------------- | --------------------------------------------------------------------------------------------- |\n| `--ingress-access-log-output`       | `none` (the default), `stdout`, or `deployment` to store requests with the deployment's logs   |\n| `--ingress-access-log-format`       | `json` (the default) or `text`, for logs written to stdout                                    |\n| `--ingress-access-log-sample-rate`  | Fraction of requests to log, between 0 and 1                                                  |\n\nRequests failing with a 5xx status are logged regardless of the sample rate. Requests that don't match a route have no deployment, so they are only logged when the output is `stdout`.\n\n## Contract tests\n\nIngress traffic can be recorded and replayed to check that a new build of a module still responds the same way before it is deployed.\n\nStart FTL with `--contract-dir` to record the first response to each distinct request, up to `--contract-limit` per route, as a JSON fixture per verb:\n\n```sh\nftl serve --contract-dir=fixtures\n```\n\nRecordings are sanitised: credential headers such as `Authorization` and `Cookie` are dropped, and the values of headers, query parameters and JSON fields named like `password` or `token` are replaced with `[REDACTED]`. Further names can be redacted with `--contract-redact`.\n\nOnce the new build is running, replay the fixtures against it:\n\n```sh\nftl contract verify fixtures --header Authorization=\"Bearer $TOKEN\"\n```\n\nEach response must have the recorded status, `Content-Type` and body. JSON bodies are compared field by field, and redacted values match anything. The command exits with an error and describes each difference if any response changed.\n\n## API gateways\n\nConfiguration for an API gateway in front of the FTL ingress can be generated from the ingress routes of the deployed modules, so that the gateway only forwards the routes FTL serves:\n\n```sh\nftl export gateway --format=envoy --cluster=ftl-ingress -o routes.yaml\n```\n\nThe supported formats are:\n\n| Format        | Output                                                                                   |\n|---------------|------------------------------------------------------------------------------------------|\n| `aws`         | An OpenAPI document with AWS API Gateway HTTP proxy integrations to `--upstream`.        |\n| `envoy`       | An Envoy `RouteConfiguration` forwarding each route to `--cluster`.                      |\n| `ingress`     | A Kubernetes `Ingress` routing to `--service` on `--service-port`.                       |\n| `gateway-api` | A Kubernetes Gateway API `HTTPRoute` attached to `--gateway`, routing to `--service`.    |\n\nRoutes without path parameters are listed before routes with them, so that gateways matching the first route prefer them as the FTL ingress does. Kubernetes Ingresses can't match methods or parameters, so routes with parameters are exported as a `Prefix` path up to their first parameter.\n\n## Importing OpenAPI specs\n\nExisting REST services can be moved onto FTL by scaffolding a Go module from their OpenAPI 3 spec, in JSON or YAML:\n\n```sh\nftl init myproject --from-openapi petstore.yaml --module petstore\nftl new go . petstore --from-openapi petstore.yaml\n```\n\nThe module has a data type, enum or type alias for each schema in the components of the spec, and an ingress verb for each operation, named after its `operationId`. The path and query parameters of an operation are merged with the fields of its request body into one request type, and the JSON bodies of its first 2xx and first error responses become its response body and error types. Verbs are stubs returning an error until they are implemented.\n\nProperty names that aren't valid FTL field names, eg. `pet_id`, are mapped with JSON aliases, so that requests and responses keep their wire format. `oneOf` and `anyOf` schemas are imported as `Any`, and operations with methods other than `GET`, `POST`, `PUT` and `DELETE` are skipped.\n",
+	"//ftl:retry": "## Retries\n\nAny verb called asynchronously (specifically, PubSub subscribers and FSM states), may optionally specify a basic exponential backoff retry policy via a Go comment directive. The directive has the following syntax:\n\n```go\n//ftl:retry [<attempts>] <min-backoff> [<max-backoff>]\n```\n\n`attempts` defaults to 100 and `max-backoff` to `1d` if not specified. Backoffs must be between `1s` and `1d`.\n\nFor example, the following function will retry up to 10 times, with a delay of 5s, 10s, 20s, 40s, 60s, 60s, etc.\n\n```go\n//ftl:retry 10 5s 1m\nfunc Invoiced(ctx context.Context, in Invoice) error {\n  // ...\n}\n```\n\nRetry policies are marked with `+retry` in the schema, and are only valid on subscribers and the states of FSMs. A retry policy on an FSM applies to each of its states that doesn't declare its own:\n\n```go\n//ftl:retry 5 1s 1m\nvar payment = ftl.FSM(\n  \"payment\",\n  ftl.Start(Invoiced),\n  ftl.Transition(Invoiced, Paid),\n)\n```\n\nThe controller applies the policy of the deployed verb when it creates each asynchronous call, ie. when an event is published or a transition is started, so changes to a policy apply to calls created after the module is redeployed.\n",
+	"//ftl:subscribe": "## PubSub\n\nFTL has first-class support for PubSub, modelled on the concepts of topics (where events are sent), subscriptions (a cursor over the topic), and subscribers (functions events are delivered to). Subscribers are, as you would expect, sinks. Each subscription is a cursor over the topic it is associated with. Each topic may have multiple subscriptions. Each subscription may have multiple subscribers, in which case events will be distributed among them.\n\nFirst, declare a new topic:\n\n```go\nvar invoicesTopic = ftl.Topic[Invoice](\"invoices\")\n```\n\nThen declare each subscription on the topic:\n\n```go\nvar _ = ftl.Subscription(invoicesTopic, \"emailInvoices\")\n```\n\nAnd finally define a Sink to consume from the subscription:\n\n```go\n//ftl:subscribe emailInvoices\nfunc SendInvoiceEmail(ctx context.Context, in Invoice) error {\n  // ...\n}\n```\n\nIf a topic only needs a single subscription, a sink can subscribe directly to the topic instead. This declares a subscription named after the verb:\n\n```go\n//ftl:subscribe invoices\nfunc SendInvoiceEmail(ctx context.Context, in Invoice) error {\n  // ...\n}\n```\n\nTopics exported by other modules can be subscribed to with `//ftl:subscribe <module>.<topic>`.\n\nEvents can be published to a topic like so:\n\n```go\ninvoicesTopic.Publish(ctx, Invoice{...})\n```\n\nEach subscription consumes its events one at a time, but different subscriptions consume events concurrently. To ensure that events relating to the same entity are never processed concurrently, even across subscriptions and topics, publish them with an ordering key:\n\n```go\ninvoicesTopic.Publish(ctx, invoice, ftl.WithOrderingKey(invoice.CustomerID))\n```\n\nEvents sharing an ordering key are delivered one at a time, including retries, in the order they are dispatched to subscribers. Ordering keys are shared by all topics, so choose keys that identify the entity, eg. `customer/123`.\n\n> **NOTE!**\n> PubSub topics cannot be published to from outside the module that declared them, they can only be subscribed to. That is, if a topic is declared in module `A`, module `B` cannot publish to it.\n",
+	"//ftl:typealias": "## Type aliases\n\nA type alias is an alternate name for an existing type. It can be declared like so:\n\n```go\n//ftl:typealias\ntype Alias Target\n```\n\neg.\n\n```go\n//ftl:typealias\ntype UserID string\n```\n\nExported type aliases are preserved in the schema and in the generated code of modules that depend on them, so eg. `UserID` is still a `UserID` rather than a `string` when called from another module.\n",
+	"//ftl:verb": "## Verbs\n\n## Defining Verbs\n\nTo declare a Verb, write a normal Go function with the following signature, annotated with the Go [comment directive](https://tip.golang.org/doc/comment#syntax) `//ftl:verb`:\n\n```go\n//ftl:verb\nfunc F(context.Context, In) (Out, error) { }\n```\n\neg.\n\n```go\ntype EchoRequest struct {}\n\ntype EchoResponse struct {}\n\n//ftl:verb\nfunc Echo(ctx context.Context, in EchoRequest) (EchoResponse, error) {\n  // ...\n}\n```\n\nBy default verbs are only [visible](../visibility) to other verbs in the same module.\n\n## Calling Verbs\n\nTo call a verb use `ftl.Call()`. eg.\n\n```go\nout, err := ftl.Call(ctx, echo.Echo, echo.EchoRequest{})\n```\n\nIndividual calls can be configured with options, eg. to time out, retry on failure, or send metadata to the callee:\n\n```go\nout, err := ftl.Call(ctx, echo.Echo, echo.EchoRequest{},\n  ftl.WithTimeout(5*time.Second),\n  ftl.WithRetry(3, 100*time.Millisecond),\n  ftl.WithMetadata(\"tenant\", \"acme\"),\n)\n```\n\nThe callee can retrieve metadata sent by its caller with `ftl.CallMetadata(ctx)`.\n\nAlternatively, each module's generated stubs include a typed client with a field for each exported verb, eg.\n\n```go\nclient := echo.NewEchoClient()\nout, err := client.Echo(ctx, echo.EchoRequest{})\n```\n\nAccepting a client rather than calling `ftl.Call()` directly allows individual verbs to be replaced with fakes in tests.\n\n## Logging\n\nVerbs should log with the logger from their context, rather than writing to stdout. Attributes added with `With()` are preserved as structured fields in the deployment logs, along with the key of the current request:\n\n```go\nlogger := ftl.LoggerFromContext(ctx).With(\"order_id\", order.ID)\nlogger.Infof(\"Processing order\")\n```\n\n## Interceptors\n\nInterceptors wrap every call made by a module, and every call to its verbs, eg. for logging, metrics or injecting auth tokens. Register them from an `init()` function in the module:\n\n```go\nfunc init() {\n  ftl.RegisterInterceptors(func(next ftl.CallFunc) ftl.CallFunc {\n    return func(ctx context.Context, call *ftl.CallInfo, req any) (any, error) {\n      if call.Outgoing {\n        call.Metadata[\"authorization\"] = token\n      }\n      return next(ctx, call, req)\n    }\n  })\n}\n```\n\nInterceptors are applied in the order they are registered, with the first being the outermost.\n\n## Caller information\n\n`ftl.CallerInfo(ctx)` describes where the current call came from, eg. for authorization or auditing:\n\n```go\ncaller, err := ftl.CallerInfo(ctx)\nif err != nil {\n  return err\n}\nif principal, ok := caller.Principal.Get(); !ok || principal.Subject() != req.Owner {\n  return errors.New(\"forbidden\")\n}\n```\n\n- `Verb` is the verb that made the call, if it was called by another verb.\n- `Principal` holds the JWT claims of the [ingress](../ingress) request that started the call chain, if it was authenticated.\n- `RequestKey` identifies the request that started the call chain.\n\n## Streaming Verbs\n\nA Verb can stream zero or more responses back to its caller, eg. for exports or progress updates, by accepting an `ftl.Stream` as its final parameter and returning only an error:\n\n```go\n//ftl:verb\nfunc Export(ctx context.Context, in ExportRequest, stream ftl.Stream[ExportRow]) error {\n  for _, row := range rows {\n    if err := stream.Send(row); err != nil {\n      return err\n    }\n  }\n  return nil\n}\n```\n\nStreaming Verbs are marked with `+stream` in the schema. To call one use `ftl.CallStream()`, which calls the provided function with each response as it arrives:\n\n```go\nerr := ftl.CallStream(ctx, export.Export, export.ExportRequest{}, func(row export.ExportRow) error {\n  // ...\n  return nil\n})\n```\n\n## Errors\n\nErrors returned by a Verb are sent to the caller as a message. To allow callers to handle specific failures, export a data structure that implements `error`:\n\n```go\n//ftl:data export\ntype NotFound struct {\n  ID int\n}\n\nfunc (e NotFound) Error() string { return fmt.Sprintf(\"%d not found\", e.ID) }\n\n//ftl:verb export\nfunc Get(ctx context.Context, req GetRequest) (GetResponse, error) {\n  return GetResponse{}, NotFound{ID: req.ID}\n}\n```\n\nError data structures are marked with `+error` in the schema. When a Verb returns one, including wrapped with `fmt.Errorf(\"...: %w\", err)`, it is encoded into the response and decoded back into the generated type on the caller's side:\n\n```go\n_, err := ftl.Call(ctx, store.Get, store.GetRequest{ID: 1})\nvar notFound store.NotFound\nif errors.As(err, &notFound) {\n  // ...\n}\n```\n\n## Concurrency limits\n\nVerbs that wrap resources that are not safe to use concurrently, or that can only handle a limited number of calls at once, can declare a concurrency limit:\n\n```go\n//ftl:verb\n//ftl:concurrency 4 queue 16\nfunc Charge(ctx context.Context, req ChargeRequest) (ChargeResponse, error) {\n  // ...\n}\n```\n\nEach runner executes at most 4 calls to `Charge` at once, and up to 16 further calls wait for a free slot. When the queue is full calls are rejected, and `ftl.Call()` returns an error wrapping `ftl.ErrOverloaded`. If `queue` is omitted, up to 100 calls wait.\n\n```go\n_, err := ftl.Call(ctx, payments.Charge, req)\nif errors.Is(err, ftl.ErrOverloaded) {\n  // ...\n}\n```\n\nConcurrency limits are marked with `+concurrency` in the schema.\n\n## Payload sizes\n\nThe size of the request and response bodies of each call is shown in the console's call log, and recorded in the `ftl.call.request.size` and `ftl.call.response.size` histograms, labelled with the verb.\n\nTo catch accidentally large payloads before they reach a runner, the controller can limit the size of request and response bodies, with per-verb overrides for verbs that legitimately handle large payloads:\n\n```sh\nftl-controller --call-payload-max-request-bytes=1048576 --call-payload-max-response-bytes=4194304 \\\n  --call-payload-verb-limits=media.upload=67108864\n```\n\nCalls exceeding a limit fail with a `ResourceExhausted` error. Responses that are too large are not stored in the call log, but their size is. The limits are unlimited by default, and take effect when the controller's configuration is reloaded.\n\n## Deprecation\n\nVerbs and the fields of data structures can be marked as deprecated, optionally with a message describing what to use instead:\n\n```go\ntype ListRequest struct {\n  Cursor ftl.Option[string]\n  //ftl:deprecated \"use Cursor\"\n  Offset ftl.Option[int]\n}\n\n//ftl:verb export\n//ftl:deprecated \"use orders.Query\"\nfunc List(ctx context.Context, req ListRequest) (ListResponse, error) {\n  // ...\n}\n```\n\nDeprecated verbs can still be called, but the controller logs a warning for each call, and the `ftl.call.requests` metric is labelled with `ftl.verb.deprecated` and the calling verb so that remaining callers can be tracked down. The Go stubs generated for other modules carry a `// Deprecated:` doc comment, so editors and linters flag their use.\n\nDeprecations are marked with `+deprecated` in the schema.\n\n## Caching\n\nVerbs whose responses only depend on their request, such as lookups of rarely changing data, can declare their responses cacheable for a period of time:\n\n```go\n//ftl:verb export\n//ftl:cache 5m vary id, region\nfunc GetUser(ctx context.Context, req GetUserRequest) (GetUserResponse, error) {\n  // ...\n}\n```\n\nThe controller caches the response of each successful call for the TTL, and serves calls with the same request from the cache rather than calling the verb. With `vary`, calls share a cached response if the listed request fields are equal, regardless of the rest of the request. Error responses are never cached, and a module's cached responses are dropped when it is redeployed. The TTL can be at most `1d`.\n\nCached responses are shared between all callers, so verbs that return data specific to the caller, eg. from `ftl.CallerInfo(ctx)` or `ftl.CallMetadata(ctx)`, should not be cached.\n\nTo skip the cache for an individual call, refreshing the cached response, use `ftl.WithoutCache()`, or `ftl call --no-cache` from the command line:\n\n```go\nresp, err := ftl.Call(ctx, users.GetUser, users.GetUserRequest{ID: id}, ftl.WithoutCache())\n```\n\nThe `ftl.call.cache.hits` and `ftl.call.cache.misses` metrics count the calls to each cacheable verb served from and missing the cache. Each controller caches up to `--call-cache-size` responses.\n\nCaching is marked with `+cache` in the schema.\n",
 }
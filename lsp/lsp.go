@@ -6,8 +6,11 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
+	"sync/atomic"
 
+	"github.com/alecthomas/types/optional"
 	"github.com/puzpuzpuz/xsync/v3"
 	_ "github.com/tliron/commonlog/simple"
 	"github.com/tliron/glsp"
@@ -31,6 +34,19 @@ type Server struct {
 	logger      log.Logger
 	diagnostics *xsync.MapOf[protocol.DocumentUri, []protocol.Diagnostic]
 	documents   *documentStore
+	// Module names keyed by module directory.
+	moduleDirs *xsync.MapOf[string, string]
+	engine     atomic.Value // Engine
+	renames    *pendingRenames
+}
+
+// Engine is the subset of [buildengine.Engine] used by the language server.
+type Engine interface {
+	// Schema returns the live schema, shown when hovering over references.
+	Schema() *schema.Schema
+	DeploymentKey(module string) optional.Option[string]
+	// QueueBuild builds the modules changed by a rename together.
+	QueueBuild(moduleNames ...string)
 }
 
 // NewServer creates a new language server.
@@ -48,6 +64,8 @@ func NewServer(ctx context.Context) *Server {
 		logger:      *log.FromContext(ctx).Scope("lsp"),
 		diagnostics: xsync.NewMapOf[protocol.DocumentUri, []protocol.Diagnostic](),
 		documents:   newDocumentStore(),
+		moduleDirs:  xsync.NewMapOf[string, string](),
+		renames:     newPendingRenames(),
 	}
 
 	handler.TextDocumentDidOpen = server.textDocumentDidOpen()
@@ -57,6 +75,8 @@ func NewServer(ctx context.Context) *Server {
 	handler.TextDocumentCompletion = server.textDocumentCompletion()
 	handler.CompletionItemResolve = server.completionItemResolve()
 	handler.TextDocumentHover = server.textDocumentHover()
+	handler.TextDocumentPrepareRename = server.textDocumentPrepareRename()
+	handler.TextDocumentRename = server.textDocumentRename()
 	handler.Initialize = server.initialize()
 
 	return server
@@ -70,12 +90,36 @@ func (s *Server) Run() error {
 	return nil
 }
 
+var _ Engine = (*buildengine.Engine)(nil)
+
+// SetEngine sets the engine providing the schema used for hover and rename.
+func (s *Server) SetEngine(engine Engine) {
+	s.engine.Store(engine)
+}
+
+// moduleForURI returns the name of the module containing a document.
+func (s *Server) moduleForURI(uri protocol.DocumentUri) optional.Option[string] {
+	var out string
+	longest := 0
+	s.moduleDirs.Range(func(dir string, module string) bool {
+		if strings.HasPrefix(uri, "file://"+dir+"/") && len(dir) > longest {
+			out, longest = module, len(dir)
+		}
+		return true
+	})
+	if longest == 0 {
+		return optional.None[string]()
+	}
+	return optional.Some(out)
+}
+
 type errSet []*schema.Error
 
 // OnBuildStarted clears diagnostics for the given directory. New errors will arrive later if they still exist.
 // Also emit an FTL message to set the status.
 func (s *Server) OnBuildStarted(module buildengine.Module) {
 	dirURI := "file://" + module.Config.Dir
+	s.moduleDirs.Store(module.Config.Dir, module.Config.Module)
 
 	s.diagnostics.Range(func(uri protocol.DocumentUri, diagnostics []protocol.Diagnostic) bool {
 		if strings.HasPrefix(uri, dirURI) {
@@ -96,29 +140,45 @@ func (s *Server) OnBuildFailed(err error) {
 	s.publishBuildState(buildStateFailure, err)
 }
 
-// Post sends diagnostics to the client.
-func (s *Server) post(err error) {
+// OnBuildDiagnostics publishes the positional errors of a module build as
+// diagnostics, so that editors can highlight the offending lines.
+//
+// Errors without a position are reported as alerts by [LogSink].
+func (s *Server) OnBuildDiagnostics(module buildengine.Module, errs []*schema.Error) {
 	errByFilename := make(map[string]errSet)
+	for _, e := range errs {
+		filename := e.Pos.Filename
+		if filename == "" {
+			continue
+		}
+		if !filepath.IsAbs(filename) {
+			filename = filepath.Join(module.Config.Dir, filename)
+		}
+		errByFilename[filename] = append(errByFilename[filename], e)
+	}
+	publishPositionalErrors(errByFilename, s)
+}
+
+// OnDeployProgress is ignored, as editors only show the state of builds.
+func (s *Server) OnDeployProgress(module buildengine.Module, uploaded, total int64) {}
+
+// Post sends errors without a position to the client as alerts.
+//
+// Positional errors are published as diagnostics by [Server.OnBuildDiagnostics].
+func (s *Server) post(err error) {
 	errUnspecified := []error{}
 
-	// Deduplicate and associate by filename.
 	for _, e := range ftlErrors.DeduplicateErrors(ftlErrors.UnwrapAll(err)) {
 		if !ftlErrors.Innermost(e) {
 			continue
 		}
 		var ce *schema.Error
-		if errors.As(e, &ce) {
-			filename := ce.Pos.Filename
-			if _, exists := errByFilename[filename]; !exists {
-				errByFilename[filename] = errSet{}
-			}
-			errByFilename[filename] = append(errByFilename[filename], ce)
-		} else {
-			errUnspecified = append(errUnspecified, err)
+		if errors.As(e, &ce) && ce.Pos.Filename != "" {
+			continue
 		}
+		errUnspecified = append(errUnspecified, e)
 	}
 
-	go publishPositionalErrors(errByFilename, s)
 	go publishUnspecifiedErrors(errUnspecified, s)
 }
 
@@ -229,11 +289,18 @@ func (s *Server) initialize() protocol.InitializeFunc {
 			protocol.SetTraceValue(*params.Trace)
 		}
 
+		trueValue := true
 		serverCapabilities := s.handler.CreateServerCapabilities()
-		serverCapabilities.TextDocumentSync = protocol.TextDocumentSyncKindIncremental
+		// Saves are required to build the modules changed by a rename.
+		syncKind := protocol.TextDocumentSyncKindIncremental
+		serverCapabilities.TextDocumentSync = protocol.TextDocumentSyncOptions{
+			OpenClose: &trueValue,
+			Change:    &syncKind,
+			Save:      &trueValue,
+		}
 		serverCapabilities.HoverProvider = true
+		serverCapabilities.RenameProvider = protocol.RenameOptions{PrepareProvider: &trueValue}
 
-		trueValue := true
 		serverCapabilities.CompletionProvider = &protocol.CompletionOptions{
 			ResolveProvider:   &trueValue,
 			TriggerCharacters: []string{"/", "f"},
@@ -298,6 +365,11 @@ func (s *Server) textDocumentDidClose() protocol.TextDocumentDidCloseFunc {
 
 func (s *Server) textDocumentDidSave() protocol.TextDocumentDidSaveFunc {
 	return func(context *glsp.Context, params *protocol.DidSaveTextDocumentParams) error {
+		if modules, ok := s.renames.saved(params.TextDocument.URI).Get(); ok {
+			if engine, ok := s.engine.Load().(Engine); ok {
+				engine.QueueBuild(modules...)
+			}
+		}
 		return nil
 	}
 }
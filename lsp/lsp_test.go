@@ -0,0 +1,48 @@
+package lsp
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+	protocol "github.com/tliron/glsp/protocol_3_16"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/buildengine"
+	"github.com/TBD54566975/ftl/common/moduleconfig"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+func TestOnBuildDiagnostics(t *testing.T) {
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "echo.go")
+	assert.NoError(t, os.WriteFile(filename, []byte("package echo\n\nfunc Echo() {}\n"), 0600))
+
+	server := NewServer(log.ContextWithNewDefaultLogger(context.Background()))
+	module := buildengine.Module{Config: moduleconfig.ModuleConfig{Module: "echo", Dir: dir}}
+	server.OnBuildDiagnostics(module, []*schema.Error{
+		schema.Errorf(schema.Position{Filename: "echo.go", Line: 3, Column: 6}, 0, "unsupported verb"),
+		schema.Errorf(schema.Position{}, 0, "no position"),
+	})
+
+	diagnostics, ok := server.diagnostics.Load("file://" + filename)
+	assert.True(t, ok)
+	severity := protocol.DiagnosticSeverityError
+	source := "ftl"
+	assert.Equal(t, []protocol.Diagnostic{{
+		Range: protocol.Range{
+			Start: protocol.Position{Line: 2, Character: 5},
+			End:   protocol.Position{Line: 2, Character: 9},
+		},
+		Severity: &severity,
+		Source:   &source,
+		Message:  "unsupported verb",
+	}}, diagnostics)
+	assert.Equal(t, 1, server.diagnostics.Size())
+
+	// A new build clears the diagnostics of the module.
+	server.OnBuildStarted(module)
+	assert.Equal(t, 0, server.diagnostics.Size())
+}
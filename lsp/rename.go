@@ -0,0 +1,296 @@
+package lsp
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/alecthomas/types/optional"
+	"github.com/tliron/glsp"
+	protocol "github.com/tliron/glsp/protocol_3_16"
+	"golang.org/x/exp/maps"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+)
+
+var identifierRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// resolvedDecl is a verb or data type referred to from a document.
+type resolvedDecl struct {
+	engine Engine
+	schema *schema.Schema
+	module *schema.Module
+	decl   schema.Decl
+}
+
+// resolveDecl resolves the verb or data type referred to by "word" from the live schema.
+//
+// Unqualified references are resolved in the module containing the document.
+func (s *Server) resolveDecl(uri protocol.DocumentUri, word string) optional.Option[resolvedDecl] {
+	engine, ok := s.engine.Load().(Engine)
+	if !ok || word == "" {
+		return optional.None[resolvedDecl]()
+	}
+	moduleName, name := "", word
+	if i := strings.LastIndex(word, "."); i != -1 {
+		moduleName, name = word[:i], word[i+1:]
+		if j := strings.LastIndex(moduleName, "."); j != -1 {
+			moduleName = moduleName[j+1:]
+		}
+	} else if moduleName, ok = s.moduleForURI(uri).Get(); !ok {
+		return optional.None[resolvedDecl]()
+	}
+	sch := engine.Schema()
+	module, ok := sch.Module(moduleName).Get()
+	if !ok {
+		return optional.None[resolvedDecl]()
+	}
+	for _, decl := range module.Decls {
+		switch decl.(type) {
+		case *schema.Verb, *schema.Data:
+			if decl.GetName() == name || sourceName(decl, uri) == name {
+				return optional.Some(resolvedDecl{engine: engine, schema: sch, module: module, decl: decl})
+			}
+		}
+	}
+	return optional.None[resolvedDecl]()
+}
+
+// sourceName returns the identifier of a declaration in a source file.
+//
+// Go verbs are exported functions, so their names are capitalised.
+func sourceName(decl schema.Decl, filename string) string {
+	name := decl.GetName()
+	if _, ok := decl.(*schema.Verb); ok && strings.HasSuffix(filename, ".go") {
+		return capitalise(name)
+	}
+	return name
+}
+
+func capitalise(name string) string {
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+func (s *Server) textDocumentPrepareRename() protocol.TextDocumentPrepareRenameFunc {
+	return func(context *glsp.Context, params *protocol.PrepareRenameParams) (any, error) {
+		line, start, end, ok := s.wordAtPosition(params.TextDocument.URI, params.Position)
+		if !ok {
+			return nil, nil
+		}
+		word := line[start:end]
+		if _, ok := s.resolveDecl(params.TextDocument.URI, word).Get(); !ok {
+			return nil, nil
+		}
+		if i := strings.LastIndex(word, "."); i != -1 {
+			start += i + 1
+		}
+		return protocol.RangeWithPlaceholder{
+			Range: protocol.Range{
+				Start: protocol.Position{Line: params.Position.Line, Character: uint32(start)},
+				End:   protocol.Position{Line: params.Position.Line, Character: uint32(end)},
+			},
+			Placeholder: line[start:end],
+		}, nil
+	}
+}
+
+// textDocumentRename renames a verb or data type in every local module.
+//
+// References are rewritten in the declaring module, in the generated stubs of
+// dependent modules, and wherever dependent modules refer to it by its
+// module-qualified name. Once all of the edited documents are saved, the
+// modules are built together so that dependents build against the new schema.
+func (s *Server) textDocumentRename() protocol.TextDocumentRenameFunc {
+	return func(context *glsp.Context, params *protocol.RenameParams) (*protocol.WorkspaceEdit, error) {
+		uri := params.TextDocument.URI
+		line, start, end, ok := s.wordAtPosition(uri, params.Position)
+		if !ok {
+			return nil, fmt.Errorf("no verb or data type to rename")
+		}
+		resolved, ok := s.resolveDecl(uri, line[start:end]).Get()
+		if !ok {
+			return nil, fmt.Errorf("%q is not a verb or data type in the FTL schema", line[start:end])
+		}
+		if !identifierRe.MatchString(params.NewName) {
+			return nil, fmt.Errorf("%q is not a valid identifier", params.NewName)
+		}
+		var renamed schema.Decl
+		if _, ok := resolved.decl.(*schema.Verb); ok {
+			renamed = &schema.Verb{Name: strings.ToLower(params.NewName[:1]) + params.NewName[1:]}
+		} else {
+			renamed = &schema.Data{Name: capitalise(params.NewName)}
+		}
+		// Go capitalises verb names, so names differing only by the case of their first letter conflict.
+		for _, decl := range resolved.module.Decls {
+			if capitalise(decl.GetName()) == capitalise(renamed.GetName()) {
+				return nil, fmt.Errorf("%s.%s already exists", resolved.module.Name, decl.GetName())
+			}
+		}
+
+		changes, modules, err := s.renameEdits(resolved.module.Name, resolved.decl, renamed)
+		if err != nil {
+			return nil, err
+		}
+		s.renames.add(maps.Keys(changes), modules)
+		return &protocol.WorkspaceEdit{Changes: changes}, nil
+	}
+}
+
+// wordAtPosition returns the line of an open document at "position", and the
+// offsets of the identifier at that position.
+func (s *Server) wordAtPosition(uri protocol.DocumentUri, position protocol.Position) (line string, start, end int, ok bool) {
+	doc, ok := s.documents.get(uri)
+	if !ok || int(position.Line) >= len(doc.lines) {
+		return "", 0, 0, false
+	}
+	line = doc.lines[position.Line]
+	start, end = wordRange(line, min(int(position.Character), len(line)))
+	return line, start, end, start < end
+}
+
+// renameEdits returns the edits renaming "from" to "to" in the source files of
+// every local module, and the names of the modules that were edited.
+func (s *Server) renameEdits(module string, from, to schema.Decl) (map[protocol.DocumentUri][]protocol.TextEdit, []string, error) {
+	changes := map[protocol.DocumentUri][]protocol.TextEdit{}
+	modules := map[string]bool{}
+	var err error
+	s.moduleDirs.Range(func(dir string, moduleName string) bool {
+		err = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				switch d.Name() {
+				case ".git", "node_modules", "target":
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if ext := filepath.Ext(path); ext != ".go" && ext != ".kt" {
+				return nil
+			}
+			uri := "file://" + path
+			content, err := s.documentContent(uri, path)
+			if err != nil {
+				return err
+			}
+			edits := renameInSource(content, packageModule(dir, moduleName, path), module, sourceName(from, path), sourceName(to, path))
+			if len(edits) > 0 {
+				changes[uri] = edits
+				modules[moduleName] = true
+			}
+			return nil
+		})
+		return err == nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to rename %s.%s: %w", module, from.GetName(), err)
+	}
+	out := maps.Keys(modules)
+	sort.Strings(out)
+	return changes, out, nil
+}
+
+// documentContent returns the content of an open document, or of the file on disk.
+func (s *Server) documentContent(uri protocol.DocumentUri, path string) (string, error) {
+	if doc, ok := s.documents.get(uri); ok {
+		return doc.Content, nil
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
+
+// packageModule returns the module whose declarations a source file refers to
+// without qualification.
+//
+// This is the module owning the file, except for the generated Go stubs of
+// other modules.
+func packageModule(dir, moduleName, path string) string {
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		return moduleName
+	}
+	parts := strings.Split(filepath.ToSlash(rel), "/")
+	if len(parts) > 4 && parts[0] == "_ftl" && parts[1] == "go" && parts[2] == "modules" {
+		return parts[3]
+	}
+	return moduleName
+}
+
+// renameInSource returns the edits renaming references to "module.from" in
+// source code whose unqualified references are to "pkgModule".
+func renameInSource(content, pkgModule, module, from, to string) []protocol.TextEdit {
+	re := regexp.MustCompile(`(?:\b([A-Za-z_][A-Za-z0-9_]*)\.)?\b` + regexp.QuoteMeta(from) + `\b`)
+	var edits []protocol.TextEdit
+	for i, line := range strings.Split(content, "\n") {
+		for _, match := range re.FindAllStringSubmatchIndex(line, -1) {
+			start := match[1] - len(from)
+			if match[2] == -1 {
+				// Unqualified references, excluding fields and methods of other types.
+				if pkgModule != module || (start > 0 && line[start-1] == '.') {
+					continue
+				}
+			} else if line[match[2]:match[3]] != module {
+				continue
+			}
+			edits = append(edits, protocol.TextEdit{
+				Range: protocol.Range{
+					Start: protocol.Position{Line: uint32(i), Character: uint32(start)},
+					End:   protocol.Position{Line: uint32(i), Character: uint32(match[1])},
+				},
+				NewText: to,
+			})
+		}
+	}
+	return edits
+}
+
+// pendingRenames tracks the documents edited by renames that have not been
+// saved yet, so that the modules they belong to can be built together once
+// they have been.
+type pendingRenames struct {
+	lock    sync.Mutex
+	files   map[protocol.DocumentUri]bool
+	modules map[string]bool
+}
+
+func newPendingRenames() *pendingRenames {
+	return &pendingRenames{files: map[protocol.DocumentUri]bool{}, modules: map[string]bool{}}
+}
+
+func (p *pendingRenames) add(files []protocol.DocumentUri, modules []string) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	for _, file := range files {
+		p.files[file] = true
+	}
+	for _, module := range modules {
+		p.modules[module] = true
+	}
+}
+
+// saved records that a document has been saved, returning the modules to
+// build if it was the last unsaved document edited by a rename.
+func (p *pendingRenames) saved(uri protocol.DocumentUri) optional.Option[[]string] {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if !p.files[uri] {
+		return optional.None[[]string]()
+	}
+	delete(p.files, uri)
+	if len(p.files) > 0 {
+		return optional.None[[]string]()
+	}
+	modules := maps.Keys(p.modules)
+	sort.Strings(modules)
+	p.modules = map[string]bool{}
+	return optional.Some(modules)
+}
@@ -0,0 +1,115 @@
+package lsp
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+	protocol "github.com/tliron/glsp/protocol_3_16"
+
+	"github.com/TBD54566975/ftl/backend/schema"
+	"github.com/TBD54566975/ftl/buildengine"
+	"github.com/TBD54566975/ftl/common/moduleconfig"
+	"github.com/TBD54566975/ftl/internal/log"
+)
+
+func TestRename(t *testing.T) {
+	sch, err := schema.ParseString("", `
+		module echo {
+			export data EchoRequest {
+				name String
+			}
+
+			export verb echo(echo.EchoRequest) Unit
+		}
+
+		module greeter {
+			verb greet(Unit) Unit
+				+calls echo.echo
+		}
+	`)
+	assert.NoError(t, err)
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"echo/echo.go": `package echo
+
+type EchoRequest struct{ Name string }
+
+//ftl:verb export
+func Echo(ctx context.Context, req EchoRequest) error { return nil }
+`,
+		"greeter/greeter.go": `package greeter
+
+//ftl:verb
+func Greet(ctx context.Context) error {
+	return ftl.CallSink(ctx, echo.Echo, echo.EchoRequest{Name: "bob"})
+}
+
+func (g greeter) Echo() {}
+`,
+		"greeter/_ftl/go/modules/echo/external_module.go": `package echo
+
+func Echo(context.Context, EchoRequest) error {
+	panic("Verb stubs should not be called directly")
+}
+`,
+	}
+	for name, content := range files {
+		assert.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0700))
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0600))
+	}
+
+	server := NewServer(log.ContextWithNewDefaultLogger(context.Background()))
+	for _, module := range []string{"echo", "greeter"} {
+		server.OnBuildStarted(buildengine.Module{Config: moduleconfig.ModuleConfig{Module: module, Dir: filepath.Join(dir, module)}})
+	}
+	engine := &fakeEngine{schema: sch}
+	server.SetEngine(engine)
+
+	greeterURI := "file://" + filepath.Join(dir, "greeter/greeter.go")
+	server.documents.set(greeterURI, files["greeter/greeter.go"])
+	position := protocol.TextDocumentPositionParams{
+		TextDocument: protocol.TextDocumentIdentifier{URI: greeterURI},
+		Position:     protocol.Position{Line: 4, Character: 31},
+	}
+
+	prepared, err := server.textDocumentPrepareRename()(nil, &protocol.PrepareRenameParams{TextDocumentPositionParams: position})
+	assert.NoError(t, err)
+	assert.Equal(t, any(protocol.RangeWithPlaceholder{
+		Range: protocol.Range{
+			Start: protocol.Position{Line: 4, Character: 31},
+			End:   protocol.Position{Line: 4, Character: 35},
+		},
+		Placeholder: "Echo",
+	}), prepared)
+
+	edit, err := server.textDocumentRename()(nil, &protocol.RenameParams{TextDocumentPositionParams: position, NewName: "Shout"})
+	assert.NoError(t, err)
+	edits := func(line, start uint32) []protocol.TextEdit {
+		return []protocol.TextEdit{{
+			Range: protocol.Range{
+				Start: protocol.Position{Line: line, Character: start},
+				End:   protocol.Position{Line: line, Character: start + 4},
+			},
+			NewText: "Shout",
+		}}
+	}
+	assert.Equal(t, map[protocol.DocumentUri][]protocol.TextEdit{
+		"file://" + filepath.Join(dir, "echo/echo.go"): edits(5, 5),
+		greeterURI: edits(4, 31),
+		"file://" + filepath.Join(dir, "greeter/_ftl/go/modules/echo/external_module.go"): edits(2, 5),
+	}, edit.Changes)
+
+	_, err = server.textDocumentRename()(nil, &protocol.RenameParams{TextDocumentPositionParams: position, NewName: "EchoRequest"})
+	assert.EqualError(t, err, "echo.EchoRequest already exists")
+
+	// The edited modules are built together once every edited document is saved.
+	for uri := range edit.Changes {
+		assert.Equal(t, 0, len(engine.builds))
+		assert.NoError(t, server.textDocumentDidSave()(nil, &protocol.DidSaveTextDocumentParams{TextDocument: protocol.TextDocumentIdentifier{URI: uri}}))
+	}
+	assert.Equal(t, [][]string{{"echo", "greeter"}}, engine.builds)
+}